@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"aliyun-tui-viewer/internal/client"
+	"aliyun-tui-viewer/internal/config"
+	"aliyun-tui-viewer/internal/service"
+)
+
+// runDNSImport checks a CSV of DNS changes against the current records and
+// prints the dry run, applying it with --apply: alidash dns-import [--apply] changes.csv
+func runDNSImport(args []string) {
+	flags := flag.NewFlagSet("dns-import", flag.ExitOnError)
+	apply := flags.Bool("apply", false, "apply the changes instead of only showing the dry run")
+	profile := flags.String("profile", "", "profile to use instead of the current one (env "+config.EnvProfile+")")
+	region := flags.String("region", "", "region to use instead of the profile's region_id (env "+config.EnvRegion+")")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: alidash dns-import [--apply] [--profile name] [--region id] changes.csv")
+		fmt.Fprintln(flags.Output(), "Columns: domain, rr, type, value, ttl, action (add, update or delete)")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	path := flags.Arg(0)
+
+	changes, invalid, err := service.ReadDNSChangesFile(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	cfg, err := config.LoadProfileConfig(config.StartupSelection(*profile, *region))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+	clients, err := client.NewAliyunClients(client.NewProfileConfig(cfg))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating clients: %v\n", err)
+		os.Exit(1)
+	}
+	dns := service.NewDNSService(clients.DNS)
+
+	ctx := context.Background()
+	plan, err := dns.PlanDNSChanges(ctx, path, changes, invalid, nil)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	printDNSImportPlan(plan)
+
+	// Applying part of a migration is worse than fixing the file first
+	if n := plan.Count(service.DNSChangeInvalid); n > 0 {
+		fmt.Fprintf(os.Stderr, "%d invalid row(s), nothing applied\n", n)
+		os.Exit(1)
+	}
+	if *apply && cfg.ReadOnly {
+		fmt.Fprintf(os.Stderr, "Profile '%s' is read-only, nothing applied\n", cfg.Profile)
+		os.Exit(1)
+	}
+	if !*apply {
+		fmt.Printf("Dry run: %d change(s) to apply, %d unchanged. Run again with --apply to apply them\n",
+			plan.Count(service.DNSChangeReady), plan.Count(service.DNSChangeUnchanged))
+		return
+	}
+
+	fmt.Println()
+	plan, err = dns.ApplyDNSImport(ctx, plan, func(done, total int64) {
+		if total > 0 {
+			fmt.Fprintf(os.Stderr, "\rApplying %d/%d", done, total)
+		}
+	})
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		// Show what was applied before the import stopped
+		if plan != nil {
+			printDNSImportPlan(plan)
+		}
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	printDNSImportPlan(plan)
+
+	fmt.Printf("%d applied, %d failed, %d unchanged\n", plan.Count(service.DNSChangeApplied),
+		plan.Count(service.DNSChangeFailed), plan.Count(service.DNSChangeUnchanged))
+	if plan.Count(service.DNSChangeFailed) > 0 {
+		os.Exit(1)
+	}
+}
+
+// printDNSImportPlan prints a plan as a table, one row per change
+func printDNSImportPlan(plan *service.DNSImportPlan) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "LINE\tACTION\tNAME\tTYPE\tBEFORE\tAFTER\tSTATUS")
+	for _, c := range plan.Changes {
+		status := c.Status.String()
+		if c.Reason != "" {
+			status += ": " + c.Reason
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n", c.Line, c.Action, c.Name(), c.Type,
+			valueOrDash(c.Before()), valueOrDash(c.After()), status)
+	}
+	w.Flush()
+}
+
+// valueOrDash returns "-" for an empty value
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -1,17 +1,51 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"aliyun-tui-viewer/internal/config"
+	"aliyun-tui-viewer/internal/logging"
 	"aliyun-tui-viewer/internal/tui"
 )
 
 func main() {
+	closeLog, err := logging.Open()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error opening debug log: %v\n", err)
+		os.Exit(1)
+	}
+	defer closeLog()
+
+	if len(os.Args) > 1 && os.Args[1] == "serve" {
+		runServe(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "dns-import" {
+		runDNSImport(os.Args[2:])
+		return
+	}
+
+	workspace := flag.String("workspace", "", "open a workspace defined in ~/.aliyun/config.json")
+	profile := flag.String("profile", "", "profile to use instead of the current one (env "+config.EnvProfile+")")
+	region := flag.String("region", "", "region to use instead of the profile's region_id (env "+config.EnvRegion+")")
+	resume := flag.Bool("resume", false, "restore the profile, region and pages open on the last quit")
+	readOnly := flag.Bool("read-only", false, "disable every action that changes cloud resources, whatever the profile's readonly setting")
+	flag.Parse()
+
+	// The flags override a workspace's profile and region; the environment
+	// only applies without a workspace, which names its own
+	opts := tui.Options{Workspace: *workspace, Profile: *profile, Region: *region, ReadOnly: *readOnly}
+	if opts.Workspace == "" {
+		opts.Profile, opts.Region = config.StartupSelection(opts.Profile, opts.Region)
+		opts.Resume = *resume || config.GetResume()
+	}
+
 	// Create new application model
-	model, err := tui.New()
+	model, err := tui.New(opts)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error initializing application: %v\n", err)
 		os.Exit(1)
@@ -22,9 +56,13 @@ func main() {
 		model,
 		tea.WithAltScreen(),
 		tea.WithMouseCellMotion(),
+		tea.WithReportFocus(),
 	)
 
-	if _, err := p.Run(); err != nil {
+	final, err := p.Run()
+	tui.SaveSession(final)
+	model.Close()
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error running application: %v\n", err)
 		os.Exit(1)
 	}
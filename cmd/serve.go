@@ -0,0 +1,46 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"aliyun-tui-viewer/internal/client"
+	"aliyun-tui-viewer/internal/config"
+	"aliyun-tui-viewer/internal/web"
+)
+
+// runServe runs the read-only web view, JSON API and Prometheus metrics:
+// alidash serve --listen 127.0.0.1:8080
+func runServe(args []string) {
+	flags := flag.NewFlagSet("serve", flag.ExitOnError)
+	listen := flags.String("listen", "127.0.0.1:8080", "address to serve the read-only web view and API on")
+	refresh := flags.Duration("refresh", 5*time.Minute, "how often to refresh the inventories behind /metrics and /api/summary, 0 to fetch them on request")
+	profile := flags.String("profile", "", "profile to use instead of the current one (env "+config.EnvProfile+")")
+	region := flags.String("region", "", "region to use instead of the profile's region_id (env "+config.EnvRegion+")")
+	flags.Parse(args)
+
+	cfg, err := config.LoadProfileConfig(config.StartupSelection(*profile, *region))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+
+	clients, err := client.NewAliyunClients(client.NewProfileConfig(cfg))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating clients: %v\n", err)
+		os.Exit(1)
+	}
+
+	server := web.NewServer(clients, cfg.Profile)
+	if *refresh > 0 {
+		server.StartRefresh(*refresh)
+	}
+
+	fmt.Printf("Serving profile %s (%s) on http://%s (JSON API under /api/, metrics on /metrics)\n", cfg.Profile, cfg.RegionID, *listen)
+	if err := server.ListenAndServe(*listen); err != nil {
+		fmt.Fprintf(os.Stderr, "Error serving web view: %v\n", err)
+		os.Exit(1)
+	}
+}
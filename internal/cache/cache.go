@@ -0,0 +1,154 @@
+// Package cache keeps the results of service calls in memory for a while, by
+// profile, region and resource, so reopening a page does not call the API
+// again
+package cache
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTTL is how long results are reused when the config file sets no
+// cache_ttl
+const DefaultTTL = time.Minute
+
+// Default is the cache the services of the TUI share
+var Default = New(DefaultTTL)
+
+// entry is a cached result
+type entry struct {
+	value    any
+	storedAt time.Time
+}
+
+// Cache holds results by profile, region and resource
+type Cache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]entry
+}
+
+// New creates a cache reusing results for ttl. A zero ttl disables it
+func New(ttl time.Duration) *Cache {
+	return &Cache{ttl: ttl, entries: make(map[string]entry)}
+}
+
+// SetTTL changes how long results are reused
+func (c *Cache) SetTTL(ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ttl = ttl
+}
+
+// Scope returns the part of the cache holding the results of a profile and
+// region
+func (c *Cache) Scope(profile, region string) *Scope {
+	return &Scope{cache: c, prefix: profile + "|" + region + "|"}
+}
+
+// Invalidate drops the results of a profile and region, or of every region
+// of the profile when region is ""
+func (c *Cache) Invalidate(profile, region string) {
+	prefix := profile + "|"
+	if region != "" {
+		prefix += region + "|"
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Scope is the part of a cache holding the results of one profile and region
+type Scope struct {
+	cache  *Cache
+	prefix string
+}
+
+// Age returns how long ago the result of a resource was stored, also when it
+// is too old to be reused
+func (s *Scope) Age(resource string) (time.Duration, bool) {
+	if s == nil {
+		return 0, false
+	}
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+	e, ok := s.cache.entries[s.prefix+resource]
+	if !ok {
+		return 0, false
+	}
+	return time.Since(e.storedAt), true
+}
+
+// Drop removes the result of a resource, so the next fetch calls the API
+func (s *Scope) Drop(resource string) {
+	if s == nil {
+		return
+	}
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+	delete(s.cache.entries, s.prefix+resource)
+}
+
+// lookup returns the result of a resource while it is younger than the TTL
+func (s *Scope) lookup(resource string) (any, bool) {
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+	e, ok := s.cache.entries[s.prefix+resource]
+	if !ok || time.Since(e.storedAt) >= s.cache.ttl {
+		return nil, false
+	}
+	return e.value, true
+}
+
+// Put saves the result of a resource, unless the cache is disabled
+func (s *Scope) Put(resource string, value any) {
+	if s == nil {
+		return
+	}
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+	if s.cache.ttl > 0 {
+		s.cache.entries[s.prefix+resource] = entry{value: value, storedAt: time.Now()}
+	}
+}
+
+// Lookup returns the cached result of a resource while it is younger than the
+// TTL
+func Lookup[T any](s *Scope, resource string) (T, bool) {
+	var zero T
+	if s == nil {
+		return zero, false
+	}
+	value, ok := s.lookup(resource)
+	if !ok {
+		return zero, false
+	}
+	result, ok := value.(T)
+	return result, ok
+}
+
+// Fetch returns the cached result of a resource while it is younger than the
+// TTL, and otherwise calls fetch with ctx and caches what it returns. Errors
+// are not cached. A nil scope always calls fetch
+func Fetch[T any](ctx context.Context, s *Scope, resource string, fetch func(context.Context) (T, error)) (T, error) {
+	if s == nil {
+		return fetch(ctx)
+	}
+	if result, ok := Lookup[T](s, resource); ok {
+		return result, nil
+	}
+
+	result, err := fetch(ctx)
+	if err != nil {
+		return result, err
+	}
+	s.Put(resource, result)
+	return result, nil
+}
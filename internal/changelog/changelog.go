@@ -0,0 +1,97 @@
+package changelog
+
+import (
+	_ "embed"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// changelogJSON holds the release notes shown on the What's New page,
+// embedded so they always match the running binary
+//
+//go:embed changelog.json
+var changelogJSON []byte
+
+// Release lists the pages and key bindings a version added
+type Release struct {
+	Version string    `json:"version"`
+	Date    string    `json:"date"`
+	Pages   []Page    `json:"pages"`
+	Keys    []Binding `json:"keys"`
+}
+
+// Page is a page added by a release and how to open it
+type Page struct {
+	Name    string `json:"name"`
+	Open    string `json:"open"`
+	Summary string `json:"summary"`
+}
+
+// Binding is a key binding added by a release and where it applies
+type Binding struct {
+	Key     string `json:"key"`
+	Where   string `json:"where"`
+	Summary string `json:"summary"`
+}
+
+// releases are the embedded releases, newest first
+var releases = mustParse(changelogJSON)
+
+func mustParse(data []byte) []Release {
+	var list []Release
+	if err := json.Unmarshal(data, &list); err != nil {
+		panic("changelog: parsing changelog.json: " + err.Error())
+	}
+	return list
+}
+
+// Releases returns every release, newest first
+func Releases() []Release {
+	return releases
+}
+
+// Current returns the version of the running binary, its newest release
+func Current() string {
+	if len(releases) == 0 {
+		return ""
+	}
+	return releases[0].Version
+}
+
+// Since returns the releases newer than version, newest first
+func Since(version string) []Release {
+	var newer []Release
+	for _, r := range releases {
+		if CompareVersions(r.Version, version) > 0 {
+			newer = append(newer, r)
+		}
+	}
+	return newer
+}
+
+// CompareVersions compares dotted versions such as 1.10.0 and 1.9, ignoring
+// a leading v. It returns -1, 0 or 1 as a is older than, equal to or newer
+// than b; missing and non-numeric parts count as 0
+func CompareVersions(a, b string) int {
+	pa := strings.Split(strings.TrimPrefix(a, "v"), ".")
+	pb := strings.Split(strings.TrimPrefix(b, "v"), ".")
+	for i := 0; i < max(len(pa), len(pb)); i++ {
+		na, nb := versionPart(pa, i), versionPart(pb, i)
+		switch {
+		case na < nb:
+			return -1
+		case na > nb:
+			return 1
+		}
+	}
+	return 0
+}
+
+func versionPart(parts []string, i int) int {
+	if i >= len(parts) {
+		return 0
+	}
+	n, _ := strconv.Atoi(parts[i])
+	return n
+}
@@ -2,15 +2,33 @@ package client
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
 	ons20190214 "github.com/alibabacloud-go/ons-20190214/v3/client"
 	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alb"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/bssopenapi"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cdn"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cms"
+	cloudconfig "github.com/aliyun/alibaba-cloud-sdk-go/services/config"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr_ee"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/dds"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/elasticsearch"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/nlb"
 	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/resourcemanager"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/sts"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/tag"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 )
 
@@ -19,73 +37,168 @@ type AliyunClients struct {
 	ECS      *ecs.Client
 	DNS      *alidns.Client
 	SLB      *slb.Client
+	ALB      *alb.Client   // Application Load Balancer
+	NLB      *nlb.Client   // Network Load Balancer
+	CS       *cs.Client    // Container Service for Kubernetes (ACK)
+	CR       *cr_ee.Client // Container Registry Enterprise Edition (ACR)
 	RDS      *rds.Client
 	OSS      *oss.Client
 	Redis    *r_kvstore.Client
+	MongoDB  *dds.Client           // ApsaraDB for MongoDB
+	ES       *elasticsearch.Client // Elasticsearch
+	Kafka    *alikafka.Client      // ApsaraMQ for Kafka
 	RocketMQ *ons20190214.Client
-	config   *Config
+	VPC      *vpc.Client
+	CMS      *cms.Client // CloudMonitor
+	SLS      *SLSClient  // Log Service
+	FC       *FCClient   // Function Compute
+	CDN      *cdn.Client // Global, domains are not regional
+	Tag      *tag.Client // Resource tags of every product
+	// ResourceManager is a global service, used to resolve resource group names
+	ResourceManager *resourcemanager.Client
+	// RAM is a global service, used to audit users, roles and policies
+	RAM *ram.Client
+	// CloudConfig evaluates compliance rules for the whole account
+	CloudConfig *cloudconfig.Client
+	// RocketMQ5 lists the RocketMQ 5.x instances, which the ONS API does not
+	RocketMQ5 *RocketMQ5Client
+	// BSS reports the account's billing and balance
+	BSS    *bssopenapi.Client
+	config *Config
 }
 
 // Config represents the client configuration
 type Config struct {
 	AccessKeyID     string
 	AccessKeySecret string
+	SecurityToken   string // Set for STS credentials
 	RegionID        string
 	OssEndpoint     string
+	Profile         string        // Name of the profile the config was built from, if any
+	Timeout         time.Duration // How long an API call may take; config.DefaultAPITimeout when zero
+	QPS             float64       // Most API calls a second of the profile; config.DefaultQPS when zero
+
+	// Credentials shared by all clients; built from the keys above when nil
+	Credentials *Credentials
 }
 
 // NewAliyunClients creates and initializes all Aliyun service clients
 func NewAliyunClients(cfg *Config) (*AliyunClients, error) {
+	if cfg.Credentials == nil {
+		cfg.Credentials = NewStaticCredentials(CredentialValue{
+			AccessKeyID:     cfg.AccessKeyID,
+			AccessKeySecret: cfg.AccessKeySecret,
+			SecurityToken:   cfg.SecurityToken,
+		})
+	}
+	// Provider credentials are fetched up front, so a missing source fails here
+	// instead of every request going out unsigned
+	if cfg.Credentials.provider != nil {
+		if err := cfg.Credentials.Refresh(); err != nil {
+			return nil, fmt.Errorf("resolving credentials: %w", err)
+		}
+	}
 	clients := &AliyunClients{config: cfg}
+	credential := SDKCredential(cfg.Credentials)
 
 	// Initialize ECS client
-	ecsClient, err := ecs.NewClientWithAccessKey(cfg.RegionID, cfg.AccessKeyID, cfg.AccessKeySecret)
+	ecsClient, err := ecs.NewClientWithOptions(cfg.RegionID, cfg.sdkConfig(), credential)
 	if err != nil {
 		return nil, fmt.Errorf("creating ECS client: %w", err)
 	}
 	clients.ECS = ecsClient
 
 	// Initialize DNS client
-	dnsClient, err := alidns.NewClientWithAccessKey(cfg.RegionID, cfg.AccessKeyID, cfg.AccessKeySecret)
+	dnsClient, err := alidns.NewClientWithOptions(cfg.RegionID, cfg.sdkConfig(), credential)
 	if err != nil {
 		return nil, fmt.Errorf("creating DNS client: %w", err)
 	}
 	clients.DNS = dnsClient
 
 	// Initialize SLB client
-	slbClient, err := slb.NewClientWithAccessKey(cfg.RegionID, cfg.AccessKeyID, cfg.AccessKeySecret)
+	slbClient, err := slb.NewClientWithOptions(cfg.RegionID, cfg.sdkConfig(), credential)
 	if err != nil {
 		return nil, fmt.Errorf("creating SLB client: %w", err)
 	}
 	clients.SLB = slbClient
 
+	// Initialize ALB client
+	albClient, err := alb.NewClientWithOptions(cfg.RegionID, cfg.sdkConfig(), credential)
+	if err != nil {
+		return nil, fmt.Errorf("creating ALB client: %w", err)
+	}
+	clients.ALB = albClient
+
+	// Initialize NLB client
+	nlbClient, err := nlb.NewClientWithOptions(cfg.RegionID, cfg.sdkConfig(), credential)
+	if err != nil {
+		return nil, fmt.Errorf("creating NLB client: %w", err)
+	}
+	clients.NLB = nlbClient
+
+	// Initialize ACK client
+	csClient, err := cs.NewClientWithOptions(cfg.RegionID, cfg.sdkConfig(), credential)
+	if err != nil {
+		return nil, fmt.Errorf("creating ACK client: %w", err)
+	}
+	clients.CS = csClient
+
+	// Initialize ACR client
+	crClient, err := cr_ee.NewClientWithOptions(cfg.RegionID, cfg.sdkConfig(), credential)
+	if err != nil {
+		return nil, fmt.Errorf("creating ACR client: %w", err)
+	}
+	clients.CR = crClient
+
 	// Initialize RDS client
-	rdsClient, err := rds.NewClientWithAccessKey(cfg.RegionID, cfg.AccessKeyID, cfg.AccessKeySecret)
+	rdsClient, err := rds.NewClientWithOptions(cfg.RegionID, cfg.sdkConfig(), credential)
 	if err != nil {
 		return nil, fmt.Errorf("creating RDS client: %w", err)
 	}
 	clients.RDS = rdsClient
 
 	// Initialize OSS client
-	ossClient, err := oss.New(cfg.OssEndpoint, cfg.AccessKeyID, cfg.AccessKeySecret)
+	ossTimeout := int64(cfg.apiTimeout() / time.Second)
+	ossClient, err := oss.New(cfg.OssEndpoint, "", "", OSSCredentialsOption(cfg.Credentials), oss.Timeout(ossTimeout, ossTimeout))
 	if err != nil {
 		return nil, fmt.Errorf("creating OSS client: %w", err)
 	}
 	clients.OSS = ossClient
 
 	// Initialize Redis client
-	redisClient, err := r_kvstore.NewClientWithAccessKey(cfg.RegionID, cfg.AccessKeyID, cfg.AccessKeySecret)
+	redisClient, err := r_kvstore.NewClientWithOptions(cfg.RegionID, cfg.sdkConfig(), credential)
 	if err != nil {
 		return nil, fmt.Errorf("creating Redis client: %w", err)
 	}
 	clients.Redis = redisClient
 
+	// Initialize MongoDB client
+	mongoClient, err := dds.NewClientWithOptions(cfg.RegionID, cfg.sdkConfig(), credential)
+	if err != nil {
+		return nil, fmt.Errorf("creating MongoDB client: %w", err)
+	}
+	clients.MongoDB = mongoClient
+
+	// Initialize Elasticsearch client
+	esClient, err := elasticsearch.NewClientWithOptions(cfg.RegionID, cfg.sdkConfig(), credential)
+	if err != nil {
+		return nil, fmt.Errorf("creating Elasticsearch client: %w", err)
+	}
+	clients.ES = esClient
+
+	// Initialize Kafka client
+	kafkaClient, err := alikafka.NewClientWithOptions(cfg.RegionID, cfg.sdkConfig(), credential)
+	if err != nil {
+		return nil, fmt.Errorf("creating Kafka client: %w", err)
+	}
+	clients.Kafka = kafkaClient
+
 	// Initialize RocketMQ client using V2.0 SDK
 	rocketmqConfig := &openapi.Config{
-		AccessKeyId:     tea.String(cfg.AccessKeyID),
-		AccessKeySecret: tea.String(cfg.AccessKeySecret),
-		RegionId:        tea.String(cfg.RegionID),
-		Endpoint:        tea.String(fmt.Sprintf("ons.%s.aliyuncs.com", cfg.RegionID)),
+		Credential:  TeaCredential(cfg.Credentials),
+		RegionId:    tea.String(cfg.RegionID),
+		Endpoint:    tea.String(fmt.Sprintf("ons.%s.aliyuncs.com", cfg.RegionID)),
+		ReadTimeout: tea.Int(int(cfg.apiTimeout() / time.Millisecond)),
 	}
 	rocketmqClient, err := ons20190214.NewClient(rocketmqConfig)
 	if err != nil {
@@ -93,21 +206,108 @@ func NewAliyunClients(cfg *Config) (*AliyunClients, error) {
 	}
 	clients.RocketMQ = rocketmqClient
 
+	// Initialize RocketMQ 5.x client
+	rocketmq5Client, err := NewRocketMQ5Client(cfg.RegionID, cfg.Credentials, cfg.apiTimeout())
+	if err != nil {
+		return nil, fmt.Errorf("creating RocketMQ 5.x client: %w", err)
+	}
+	clients.RocketMQ5 = rocketmq5Client
+
+	// Initialize VPC client
+	vpcClient, err := vpc.NewClientWithOptions(cfg.RegionID, cfg.sdkConfig(), credential)
+	if err != nil {
+		return nil, fmt.Errorf("creating VPC client: %w", err)
+	}
+	clients.VPC = vpcClient
+
+	// Initialize CloudMonitor client
+	cmsClient, err := cms.NewClientWithOptions(cfg.RegionID, cfg.sdkConfig(), credential)
+	if err != nil {
+		return nil, fmt.Errorf("creating CloudMonitor client: %w", err)
+	}
+	clients.CMS = cmsClient
+
+	// Initialize Log Service client
+	clients.SLS = NewSLSClient(cfg.RegionID, cfg.Credentials, cfg.httpClient())
+
+	// Initialize Function Compute client, which needs STS for the account ID
+	stsClient, err := sts.NewClientWithOptions(cfg.RegionID, cfg.sdkConfig(), credential)
+	if err != nil {
+		return nil, fmt.Errorf("creating STS client: %w", err)
+	}
+	clients.FC = NewFCClient(cfg.RegionID, cfg.Credentials, stsClient, cfg.httpClient())
+
+	// Initialize CDN client
+	cdnClient, err := cdn.NewClientWithOptions(cfg.RegionID, cfg.sdkConfig(), credential)
+	if err != nil {
+		return nil, fmt.Errorf("creating CDN client: %w", err)
+	}
+	clients.CDN = cdnClient
+
+	// Initialize Tag client
+	tagClient, err := tag.NewClientWithOptions(cfg.RegionID, cfg.sdkConfig(), credential)
+	if err != nil {
+		return nil, fmt.Errorf("creating Tag client: %w", err)
+	}
+	clients.Tag = tagClient
+
+	// Initialize Resource Manager client
+	rmClient, err := resourcemanager.NewClientWithOptions(cfg.RegionID, cfg.sdkConfig(), credential)
+	if err != nil {
+		return nil, fmt.Errorf("creating Resource Manager client: %w", err)
+	}
+	clients.ResourceManager = rmClient
+
+	// Initialize RAM client
+	ramClient, err := ram.NewClientWithOptions(cfg.RegionID, cfg.sdkConfig(), credential)
+	if err != nil {
+		return nil, fmt.Errorf("creating RAM client: %w", err)
+	}
+	clients.RAM = ramClient
+
+	// Initialize Cloud Config client, only served from one region per site
+	configClient, err := cloudconfig.NewClientWithOptions(CloudConfigRegion(cfg.RegionID), cfg.sdkConfig(), credential)
+	if err != nil {
+		return nil, fmt.Errorf("creating Cloud Config client: %w", err)
+	}
+	clients.CloudConfig = configClient
+
+	// Initialize billing client
+	bssClient, err := bssopenapi.NewClientWithOptions(cfg.RegionID, cfg.sdkConfig(), credential)
+	if err != nil {
+		return nil, fmt.Errorf("creating billing client: %w", err)
+	}
+	clients.BSS = bssClient
+
 	return clients, nil
 }
 
+// CloudConfigRegion returns the region serving Cloud Config for a region:
+// cn-shanghai for the China site, ap-southeast-1 for the international site
+func CloudConfigRegion(regionID string) string {
+	if strings.HasPrefix(regionID, "cn-") {
+		return "cn-shanghai"
+	}
+	return "ap-southeast-1"
+}
+
 // GetConfig returns the client configuration
 func (c *AliyunClients) GetConfig() *Config {
 	return c.config
 }
 
-// UpdateRegion creates new clients with a different region
+// UpdateRegion creates new clients with a different region, sharing the same credentials
 func (c *AliyunClients) UpdateRegion(regionID string) (*AliyunClients, error) {
 	newConfig := &Config{
 		AccessKeyID:     c.config.AccessKeyID,
 		AccessKeySecret: c.config.AccessKeySecret,
+		SecurityToken:   c.config.SecurityToken,
 		RegionID:        regionID,
 		OssEndpoint:     fmt.Sprintf("oss-%s.aliyuncs.com", regionID),
+		Profile:         c.config.Profile,
+		Timeout:         c.config.Timeout,
+		QPS:             c.config.QPS,
+		Credentials:     c.config.Credentials,
 	}
 	return NewAliyunClients(newConfig)
 }
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+
+	"aliyun-tui-viewer/internal/config"
+)
+
+// contextHeader carries the ID of the context an API request is bound to
+// from BindContext to the transport, which removes it before sending. The
+// SDK builds its HTTP requests without a context, so the header is the only
+// way to tie one to a call
+const contextHeader = "x-alidash-context"
+
+var (
+	contextsMu sync.Mutex
+	contexts   = make(map[string]context.Context) // Bound contexts by ID
+	contextIDs = make(map[context.Context]string) // IDs of the bound contexts
+	contextSeq int64
+)
+
+// BindContext ties an SDK request to ctx, so cancelling ctx aborts the
+// request, also while it waits for the response. A context that is never
+// done, such as context.Background(), leaves the request unbound
+func BindContext(ctx context.Context, request requests.AcsRequest) {
+	if ctx.Done() == nil {
+		return
+	}
+
+	contextsMu.Lock()
+	id, ok := contextIDs[ctx]
+	if !ok {
+		contextSeq++
+		id = strconv.FormatInt(contextSeq, 10)
+		contexts[id] = ctx
+		contextIDs[ctx] = id
+		// Forget the context once it is done; its requests are aborted by then
+		context.AfterFunc(ctx, func() {
+			contextsMu.Lock()
+			defer contextsMu.Unlock()
+			delete(contexts, id)
+			delete(contextIDs, ctx)
+		})
+	}
+	contextsMu.Unlock()
+
+	request.GetHeaders()[contextHeader] = id
+}
+
+// boundContext returns the context an HTTP request was bound to, nil when
+// it is unbound or its context is already forgotten
+func boundContext(request *http.Request) context.Context {
+	ids := request.Header[contextHeader]
+	if len(ids) == 0 {
+		return nil
+	}
+	contextsMu.Lock()
+	defer contextsMu.Unlock()
+	ctx, ok := contexts[ids[0]]
+	if !ok {
+		// A forgotten context was done, so its request must not go out
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		return ctx
+	}
+	return ctx
+}
+
+// contextTransport sends the SDK's requests with the context they are
+// bound to, through base
+type contextTransport struct {
+	base http.RoundTripper
+}
+
+func (t contextTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if _, ok := request.Header[contextHeader]; ok {
+		ctx := boundContext(request)
+		request = request.Clone(ctx)
+		delete(request.Header, contextHeader)
+	}
+	return t.base.RoundTrip(request)
+}
+
+// apiTimeout returns how long an API call of cfg's clients may take
+func (cfg *Config) apiTimeout() time.Duration {
+	if cfg.Timeout > 0 {
+		return cfg.Timeout
+	}
+	return config.DefaultAPITimeout
+}
+
+// sdkConfig returns the configuration of an SDK client: requests bound to a
+// context and retried, each attempt limited to the API timeout. The SDK's
+// own timeout spans the retries, so it only bounds the whole call
+func (cfg *Config) sdkConfig() *sdk.Config {
+	c := sdk.NewConfig().WithTimeout(cfg.callTimeout())
+	c.Transport = contextTransport{base: cfg.retryTransport()}
+	return c
+}
+
+// httpClient returns the HTTP client of the REST APIs signed here, retrying
+// with each attempt limited to the API timeout as the SDK clients do
+func (cfg *Config) httpClient() *http.Client {
+	return &http.Client{Transport: cfg.retryTransport()}
+}
@@ -0,0 +1,307 @@
+package client
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	sdkcredentials "github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	teacredentials "github.com/aliyun/credentials-go/credentials"
+	"github.com/aliyun/credentials-go/credentials/providers"
+)
+
+// refreshCooldown is how long a refresh is reused, so that requests failing
+// together (e.g. a multi-region fetch) only refresh once
+const refreshCooldown = 10 * time.Second
+
+// expiryMargin is how long before they expire credentials with a known
+// expiration are refreshed, so requests never carry an expired token
+const expiryMargin = 2 * time.Minute
+
+// ErrNotRefreshable is returned by Refresh for credentials without a source to re-read
+var ErrNotRefreshable = errors.New("credentials cannot be refreshed")
+
+// expiredMarkers are error codes and messages Aliyun APIs return for expired STS tokens
+var expiredMarkers = []string{
+	"InvalidSecurityToken.Expired",
+	"SecurityTokenExpired",
+	"SecurityToken.Expired",
+	"security token you provided has expired",
+}
+
+// CredentialValue is a set of access keys, with a security token for STS credentials
+type CredentialValue struct {
+	AccessKeyID     string
+	AccessKeySecret string
+	SecurityToken   string
+	Expiration      time.Time // Zero when unknown
+}
+
+// expiresSoon reports whether the value has a known expiration within expiryMargin
+func (v CredentialValue) expiresSoon() bool {
+	return !v.Expiration.IsZero() && time.Until(v.Expiration) < expiryMargin
+}
+
+// Credentials is a credential source shared by every client of a profile.
+// Refresh replaces the value in place; clients pick it up on their next request
+type Credentials struct {
+	mu          sync.RWMutex
+	value       CredentialValue
+	refresh     func() (CredentialValue, error)
+	refreshMu   sync.Mutex
+	refreshedAt time.Time
+	attemptedAt time.Time // Last refresh before expiry, successful or not
+	provider    providers.CredentialsProvider
+	newProvider func() (providers.CredentialsProvider, error)
+	providerMu  sync.Mutex // Providers are not safe for concurrent use
+}
+
+// NewStaticCredentials creates credentials that never change
+func NewStaticCredentials(value CredentialValue) *Credentials {
+	return &Credentials{value: value}
+}
+
+// NewRefreshableCredentials creates credentials that call refresh to obtain a new value
+func NewRefreshableCredentials(value CredentialValue, refresh func() (CredentialValue, error)) *Credentials {
+	return &Credentials{value: value, refresh: refresh}
+}
+
+// NewProviderCredentials creates credentials asked on every use of a provider
+// newProvider builds. The provider caches temporary credentials, such as an
+// instance role's, and renews them before they expire; Refresh builds a new
+// one, so credentials the API rejected are fetched again rather than re-read
+func NewProviderCredentials(newProvider func() (providers.CredentialsProvider, error)) *Credentials {
+	provider, err := newProvider()
+	if err != nil {
+		provider = failedProvider{err: err}
+	}
+	return &Credentials{provider: provider, newProvider: newProvider}
+}
+
+// providerValue returns the provider's current credentials. A failure keeps
+// the last value, so a metadata service hiccup does not blank them
+func (c *Credentials) providerValue() (CredentialValue, error) {
+	c.providerMu.Lock()
+	cc, err := c.provider.GetCredentials()
+	c.providerMu.Unlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil {
+		return c.value, err
+	}
+	c.value = providerCredentialValue(cc)
+	return c.value, nil
+}
+
+// renewProvider replaces the provider with a new one, whose empty cache makes
+// it fetch credentials again, and keeps what it fetched. A failure keeps the
+// provider and the last value
+func (c *Credentials) renewProvider() error {
+	c.providerMu.Lock()
+	defer c.providerMu.Unlock()
+
+	provider, err := c.newProvider()
+	if err != nil {
+		return err
+	}
+	cc, err := provider.GetCredentials()
+	if err != nil {
+		return err
+	}
+	c.provider = provider
+
+	c.mu.Lock()
+	c.value = providerCredentialValue(cc)
+	c.mu.Unlock()
+	return nil
+}
+
+// providerCredentialValue converts the credentials of a provider
+func providerCredentialValue(cc *providers.Credentials) CredentialValue {
+	return CredentialValue{
+		AccessKeyID:     cc.AccessKeyId,
+		AccessKeySecret: cc.AccessKeySecret,
+		SecurityToken:   cc.SecurityToken,
+	}
+}
+
+// Get returns the current credential value, refreshing it first when it is
+// about to expire
+func (c *Credentials) Get() CredentialValue {
+	if c.provider != nil {
+		value, _ := c.providerValue()
+		return value
+	}
+
+	c.mu.RLock()
+	value := c.value
+	c.mu.RUnlock()
+
+	if value.expiresSoon() && c.refresh != nil {
+		c.refreshBeforeExpiry()
+		c.mu.RLock()
+		value = c.value
+		c.mu.RUnlock()
+	}
+	return value
+}
+
+// refreshBeforeExpiry refreshes credentials about to expire. A failure keeps
+// the current value, still valid for a while, and is retried after the
+// cooldown rather than on every request
+func (c *Credentials) refreshBeforeExpiry() {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	c.mu.RLock()
+	soon := c.value.expiresSoon()
+	c.mu.RUnlock()
+	if !soon || time.Since(c.attemptedAt) < refreshCooldown {
+		return
+	}
+	c.attemptedAt = time.Now()
+
+	value, err := c.refresh()
+	if err != nil {
+		return
+	}
+	c.mu.Lock()
+	c.value = value
+	c.mu.Unlock()
+	c.refreshedAt = time.Now()
+}
+
+// Refresh obtains a new credential value. Concurrent callers share one refresh
+func (c *Credentials) Refresh() error {
+	if c.provider == nil && c.refresh == nil {
+		return ErrNotRefreshable
+	}
+
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+	if time.Since(c.refreshedAt) < refreshCooldown {
+		return nil
+	}
+
+	if c.provider != nil {
+		if err := c.renewProvider(); err != nil {
+			return err
+		}
+		c.refreshedAt = time.Now()
+		return nil
+	}
+	value, err := c.refresh()
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.value = value
+	c.mu.Unlock()
+	c.refreshedAt = time.Now()
+	return nil
+}
+
+// IsCredentialExpired reports whether err was caused by expired temporary credentials
+func IsCredentialExpired(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	for _, marker := range expiredMarkers {
+		if strings.Contains(msg, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// sdkProvider adapts Credentials for the core SDK clients (ECS, DNS, SLB, RDS, Redis)
+type sdkProvider struct {
+	creds *Credentials
+}
+
+func (p sdkProvider) GetCredentials() (*sdkcredentials.Credentials, error) {
+	v := p.creds.Get()
+	return &sdkcredentials.Credentials{
+		AccessKeyId:     v.AccessKeyID,
+		AccessKeySecret: v.AccessKeySecret,
+		SecurityToken:   v.SecurityToken,
+		ProviderName:    p.GetProviderName(),
+	}, nil
+}
+
+func (p sdkProvider) GetProviderName() string {
+	return "alidash"
+}
+
+// ossProvider adapts Credentials for OSS clients
+type ossProvider struct {
+	creds *Credentials
+}
+
+func (p ossProvider) GetCredentials() oss.Credentials {
+	return ossCredentials(p.creds.Get())
+}
+
+type ossCredentials CredentialValue
+
+func (c ossCredentials) GetAccessKeyID() string     { return c.AccessKeyID }
+func (c ossCredentials) GetAccessKeySecret() string { return c.AccessKeySecret }
+func (c ossCredentials) GetSecurityToken() string   { return c.SecurityToken }
+
+// teaCredential adapts Credentials for clients built on darabonba-openapi (RocketMQ, Resource Center)
+type teaCredential struct {
+	creds *Credentials
+}
+
+func (c teaCredential) GetAccessKeyId() (*string, error) {
+	return tea.String(c.creds.Get().AccessKeyID), nil
+}
+
+func (c teaCredential) GetAccessKeySecret() (*string, error) {
+	return tea.String(c.creds.Get().AccessKeySecret), nil
+}
+
+func (c teaCredential) GetSecurityToken() (*string, error) {
+	return tea.String(c.creds.Get().SecurityToken), nil
+}
+
+func (c teaCredential) GetBearerToken() *string {
+	return tea.String("")
+}
+
+func (c teaCredential) GetType() *string {
+	if c.creds.Get().SecurityToken != "" {
+		return tea.String("sts")
+	}
+	return tea.String("access_key")
+}
+
+func (c teaCredential) GetCredential() (*teacredentials.CredentialModel, error) {
+	v := c.creds.Get()
+	return &teacredentials.CredentialModel{
+		AccessKeyId:     tea.String(v.AccessKeyID),
+		AccessKeySecret: tea.String(v.AccessKeySecret),
+		SecurityToken:   tea.String(v.SecurityToken),
+		Type:            c.GetType(),
+	}, nil
+}
+
+// SDKCredential returns creds in a form accepted by core SDK clients
+func SDKCredential(creds *Credentials) sdkcredentials.CredentialsProvider {
+	return sdkProvider{creds: creds}
+}
+
+// OSSCredentialsOption returns an OSS client option that signs requests with creds
+func OSSCredentialsOption(creds *Credentials) oss.ClientOption {
+	return oss.SetCredentialsProvider(ossProvider{creds: creds})
+}
+
+// TeaCredential returns creds in a form accepted by darabonba-openapi clients
+func TeaCredential(creds *Credentials) teacredentials.Credential {
+	return teaCredential{creds: creds}
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/credentials-go/credentials/providers"
+)
+
+// cachingProvider returns the token it fetched first until it is rebuilt, as
+// the instance role provider does until its cached token nears expiry
+type cachingProvider struct {
+	token string
+}
+
+func (p *cachingProvider) GetCredentials() (*providers.Credentials, error) {
+	return &providers.Credentials{AccessKeyId: "ak", AccessKeySecret: "secret", SecurityToken: p.token}, nil
+}
+
+func (p *cachingProvider) GetProviderName() string {
+	return "caching"
+}
+
+func TestRefreshRebuildsProvider(t *testing.T) {
+	built := 0
+	creds := NewProviderCredentials(func() (providers.CredentialsProvider, error) {
+		built++
+		return &cachingProvider{token: fmt.Sprintf("token-%d", built)}, nil
+	})
+	if got := creds.Get().SecurityToken; got != "token-1" {
+		t.Fatalf("SecurityToken = %q, want token-1", got)
+	}
+
+	if err := creds.Refresh(); err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+	if built != 2 {
+		t.Errorf("provider built %d times, want 2", built)
+	}
+	if got := creds.Get().SecurityToken; got != "token-2" {
+		t.Errorf("SecurityToken after Refresh = %q, want token-2", got)
+	}
+}
+
+func TestRefreshKeepsProviderWhenRebuildFails(t *testing.T) {
+	built := 0
+	creds := NewProviderCredentials(func() (providers.CredentialsProvider, error) {
+		built++
+		if built > 1 {
+			return nil, fmt.Errorf("metadata service unreachable")
+		}
+		return &cachingProvider{token: "token-1"}, nil
+	})
+
+	if err := creds.Refresh(); err == nil {
+		t.Fatal("Refresh succeeded, want the rebuild error")
+	}
+	if got := creds.Get().SecurityToken; got != "token-1" {
+		t.Errorf("SecurityToken after a failed Refresh = %q, want token-1", got)
+	}
+}
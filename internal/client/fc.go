@@ -0,0 +1,154 @@
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/sts"
+)
+
+// fcAPIVersion is the Function Compute 2.0 REST API version requests go to
+const fcAPIVersion = "2021-04-06"
+
+// FCClient calls the Function Compute (FC) REST API. The Alibaba Cloud SDK
+// has no Function Compute package, so requests are signed here with the FC
+// signature. FC endpoints are per account, so the account ID is looked up
+// with STS on the first request
+type FCClient struct {
+	region      string
+	credentials *Credentials
+	sts         *sts.Client
+	httpClient  *http.Client
+
+	mu        sync.Mutex
+	accountID string
+}
+
+// FCError is an error response of the Function Compute API
+type FCError struct {
+	Status    int
+	Code      string `json:"ErrorCode"`
+	Message   string `json:"ErrorMessage"`
+	RequestID string
+}
+
+func (e *FCError) Error() string {
+	return fmt.Sprintf("FC %s: %s (status %d, request %s)", e.Code, e.Message, e.Status, e.RequestID)
+}
+
+// NewFCClient creates a Function Compute client for a region. stsClient
+// resolves the account ID of the credentials
+func NewFCClient(region string, credentials *Credentials, stsClient *sts.Client, httpClient *http.Client) *FCClient {
+	return &FCClient{
+		region:      region,
+		credentials: credentials,
+		sts:         stsClient,
+		httpClient:  httpClient,
+	}
+}
+
+// AccountID returns the ID of the account the credentials belong to
+func (c *FCClient) AccountID(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.accountID != "" {
+		return c.accountID, nil
+	}
+
+	request := sts.CreateGetCallerIdentityRequest()
+	request.Scheme = "https"
+	BindContext(ctx, request)
+	response, err := c.sts.GetCallerIdentity(request)
+	if err != nil {
+		return "", fmt.Errorf("getting caller identity: %w", err)
+	}
+	c.accountID = response.AccountId
+	return c.accountID, nil
+}
+
+// Region returns the region the client calls
+func (c *FCClient) Region() string {
+	return c.region
+}
+
+// Get sends a GET request for path, relative to the API version, and decodes
+// the JSON response into out
+func (c *FCClient) Get(ctx context.Context, path string, query url.Values, out interface{}) error {
+	accountID, err := c.AccountID(ctx)
+	if err != nil {
+		return err
+	}
+
+	fullPath := "/" + fcAPIVersion + path
+	host := fmt.Sprintf("%s.%s.fc.aliyuncs.com", accountID, c.region)
+	target := url.URL{Scheme: "https", Host: host, Path: fullPath, RawQuery: query.Encode()}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+	if err != nil {
+		return err
+	}
+	c.sign(request, fullPath)
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("calling FC %s: %w", path, err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("reading FC %s response: %w", path, err)
+	}
+	if response.StatusCode != http.StatusOK {
+		fcErr := &FCError{Status: response.StatusCode, RequestID: response.Header.Get("X-Fc-Request-Id")}
+		if json.Unmarshal(body, fcErr) != nil || fcErr.Code == "" {
+			fcErr.Code, fcErr.Message = http.StatusText(response.StatusCode), strings.TrimSpace(string(body))
+		}
+		return fcErr
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("decoding FC %s response: %w", path, err)
+	}
+	return nil
+}
+
+// sign adds the Function Compute headers and the HMAC-SHA256 Authorization
+// header
+func (c *FCClient) sign(request *http.Request, path string) {
+	creds := c.credentials.Get()
+	date := time.Now().UTC().Format(http.TimeFormat)
+
+	request.Header.Set("Date", date)
+	request.Header.Set("Accept", "application/json")
+	if creds.SecurityToken != "" {
+		request.Header.Set("x-fc-security-token", creds.SecurityToken)
+	}
+
+	// Canonicalized x-fc-* headers, sorted by name
+	var headers []string
+	for name := range request.Header {
+		lower := strings.ToLower(name)
+		if strings.HasPrefix(lower, "x-fc-") {
+			headers = append(headers, lower+":"+request.Header.Get(name)+"\n")
+		}
+	}
+	sort.Strings(headers)
+
+	// VERB, Content-MD5 and Content-Type (both empty without a body), Date,
+	// the headers and the path without the query
+	stringToSign := strings.Join([]string{http.MethodGet, "", "", date, strings.Join(headers, "") + path}, "\n")
+	mac := hmac.New(sha256.New, []byte(creds.AccessKeySecret))
+	mac.Write([]byte(stringToSign))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	request.Header.Set("Authorization", "FC "+creds.AccessKeyID+":"+signature)
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"github.com/aliyun/credentials-go/credentials/providers"
+
+	"aliyun-tui-viewer/internal/config"
+)
+
+// NewProfileConfig builds the client configuration for a loaded profile. Its
+// credentials re-resolve the profile (re-reading sts_token, re-running
+// process_command or assuming the role again) when temporary credentials
+// expire, and ahead of an assumed role's known expiration. Profiles in a
+// provider mode read theirs from the environment, the credentials file or the
+// instance metadata instead
+func NewProfileConfig(cfg *config.Config) *Config {
+	if config.IsProviderMode(cfg.Mode) {
+		newProvider := func() (providers.CredentialsProvider, error) { return profileProvider(cfg) }
+		return &Config{
+			RegionID:    cfg.RegionID,
+			OssEndpoint: cfg.OssEndpoint,
+			Profile:     cfg.Profile,
+			Timeout:     config.GetAPITimeout(),
+			QPS:         cfg.QPS,
+			Credentials: NewProviderCredentials(newProvider),
+		}
+	}
+
+	profile := cfg.Profile
+	value := CredentialValue{
+		AccessKeyID:     cfg.AccessKeyID,
+		AccessKeySecret: cfg.AccessKeySecret,
+		SecurityToken:   cfg.SecurityToken,
+		Expiration:      cfg.Expiration,
+	}
+
+	return &Config{
+		AccessKeyID:     cfg.AccessKeyID,
+		AccessKeySecret: cfg.AccessKeySecret,
+		SecurityToken:   cfg.SecurityToken,
+		RegionID:        cfg.RegionID,
+		OssEndpoint:     cfg.OssEndpoint,
+		Profile:         profile,
+		Timeout:         config.GetAPITimeout(),
+		QPS:             cfg.QPS,
+		Credentials: NewRefreshableCredentials(value, func() (CredentialValue, error) {
+			creds, err := config.LoadProfileCredentials(profile)
+			if err != nil {
+				return CredentialValue{}, err
+			}
+			return CredentialValue{
+				AccessKeyID:     creds.AccessKeyID,
+				AccessKeySecret: creds.AccessKeySecret,
+				SecurityToken:   creds.SecurityToken,
+				Expiration:      creds.Expiration,
+			}, nil
+		}),
+	}
+}
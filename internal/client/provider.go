@@ -0,0 +1,104 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/aliyun/credentials-go/credentials/providers"
+
+	"aliyun-tui-viewer/internal/config"
+)
+
+// chainProvider tries its providers in order and keeps using the first one
+// that returns credentials
+type chainProvider struct {
+	mu        sync.Mutex
+	providers []providers.CredentialsProvider
+	current   providers.CredentialsProvider
+}
+
+func (p *chainProvider) GetCredentials() (*providers.Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.current != nil {
+		return p.current.GetCredentials()
+	}
+	var errs []error
+	for _, provider := range p.providers {
+		cc, err := provider.GetCredentials()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", provider.GetProviderName(), err))
+			continue
+		}
+		p.current = provider
+		return cc, nil
+	}
+	return nil, fmt.Errorf("no credential provider returned credentials: %w", errors.Join(errs...))
+}
+
+func (p *chainProvider) GetProviderName() string {
+	return "chain"
+}
+
+// failedProvider reports the error of a provider that could not be built
+type failedProvider struct {
+	err error
+}
+
+func (p failedProvider) GetCredentials() (*providers.Credentials, error) {
+	return nil, p.err
+}
+
+func (p failedProvider) GetProviderName() string {
+	return "failed"
+}
+
+// environmentProvider reads ALIBABA_CLOUD_ACCESS_KEY_ID,
+// ALIBABA_CLOUD_ACCESS_KEY_SECRET and ALIBABA_CLOUD_SECURITY_TOKEN
+func environmentProvider() (providers.CredentialsProvider, error) {
+	return providers.NewEnvironmentVariableCredentialsProviderBuilder().Build()
+}
+
+// ecsRAMRoleProvider reads the credentials of an ECS instance's RAM role from
+// the metadata service, the role the instance has when roleName is empty
+func ecsRAMRoleProvider(roleName string) (providers.CredentialsProvider, error) {
+	return providers.NewECSRAMRoleCredentialsProviderBuilder().WithRoleName(roleName).Build()
+}
+
+// credentialsFileProvider reads a section of the credentials ini file,
+// ~/.alibabacloud/credentials or ALIBABA_CLOUD_CREDENTIALS_FILE
+func credentialsFileProvider(section string) (providers.CredentialsProvider, error) {
+	return providers.NewProfileCredentialsProviderBuilder().WithProfileName(section).Build()
+}
+
+// profileProvider returns the credential provider of a profile whose mode
+// config.IsProviderMode accepts
+func profileProvider(cfg *config.Config) (providers.CredentialsProvider, error) {
+	switch cfg.Mode {
+	case config.ModeEnvironment:
+		return environmentProvider()
+	case config.ModeEcsRamRole:
+		return ecsRAMRoleProvider(cfg.RamRoleName)
+	case config.ModeCredentialsFile:
+		return credentialsFileProvider(cfg.CredentialsProfile)
+	case config.ModeProviderChain:
+		chain := &chainProvider{}
+		builders := []func() (providers.CredentialsProvider, error){
+			environmentProvider,
+			func() (providers.CredentialsProvider, error) { return credentialsFileProvider(cfg.CredentialsProfile) },
+			func() (providers.CredentialsProvider, error) { return ecsRAMRoleProvider(cfg.RamRoleName) },
+		}
+		for _, build := range builders {
+			if provider, err := build(); err == nil {
+				chain.providers = append(chain.providers, provider)
+			}
+		}
+		if len(chain.providers) == 0 {
+			return nil, fmt.Errorf("profile '%s': no credential provider is available", cfg.Profile)
+		}
+		return chain, nil
+	}
+	return nil, fmt.Errorf("profile '%s': mode %s does not use a credential provider", cfg.Profile, cfg.Mode)
+}
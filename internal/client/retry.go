@@ -0,0 +1,403 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth"
+	sdkcredentials "github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/utils"
+	"golang.org/x/time/rate"
+
+	"aliyun-tui-viewer/internal/config"
+	"aliyun-tui-viewer/internal/logging"
+)
+
+const (
+	// MaxRetries is how many times a throttled or failed API call is retried
+	MaxRetries = 5
+	// retryBaseDelay is the wait before the first retry, doubled for each
+	// further one up to retryMaxDelay
+	retryBaseDelay = 250 * time.Millisecond
+	retryMaxDelay  = 8 * time.Second
+	// maxErrorBody bounds how much of an error response is read for its code
+	maxErrorBody = 64 << 10
+)
+
+// rejectedCodes are the prefixes of error codes with which Aliyun turns a
+// call away without running it, so any call can be sent again
+var rejectedCodes = []string{"Throttling", "ServiceUnavailable", "SystemBusy"}
+
+// Patterns finding the fields of the XML error responses
+var (
+	xmlCodePattern      = regexp.MustCompile(`<Code>([^<]+)</Code>`)
+	xmlMessagePattern   = regexp.MustCompile(`<Message>([^<]+)</Message>`)
+	xmlRequestIDPattern = regexp.MustCompile(`<RequestId>([^<]+)</RequestId>`)
+)
+
+// readActions are the prefixes of RPC actions that change nothing, which may
+// be sent again even when an earlier attempt may have reached the server
+var readActions = []string{"Describe", "List", "Get", "Query", "Check"}
+
+// connections is the transport all clients send through, so they share
+// connections
+var connections = http.DefaultTransport.(*http.Transport).Clone()
+
+// retryTransport sends API requests at most at the profile's QPS and retries
+// those that were throttled or failed on the way, with exponential backoff.
+// Each attempt may take timeout, the backoff between them not counted, and
+// RPC requests are signed again for it. Each call is traced once it returned
+type retryTransport struct {
+	base        http.RoundTripper
+	limiter     *rate.Limiter
+	credentials sdkcredentials.CredentialsProvider // Signs retried RPC requests, nil to send them as they are
+	timeout     time.Duration
+	profile     string
+	region      string
+}
+
+// retryTransport returns the transport of cfg's clients
+func (cfg *Config) retryTransport() http.RoundTripper {
+	t := retryTransport{
+		base:    connections,
+		limiter: profileLimiter(cfg.Profile, cfg.QPS),
+		timeout: cfg.apiTimeout(),
+		profile: cfg.Profile,
+		region:  cfg.RegionID,
+	}
+	if cfg.Credentials != nil {
+		t.credentials = SDKCredential(cfg.Credentials)
+	}
+	return t
+}
+
+// callTimeout bounds a whole call of cfg's clients, every attempt taking up
+// to the API timeout and the backoff between them at most retryMaxDelay
+func (cfg *Config) callTimeout() time.Duration {
+	return time.Duration(MaxRetries+1)*cfg.apiTimeout() + MaxRetries*retryMaxDelay
+}
+
+var (
+	limitersMu sync.Mutex
+	limiters   = make(map[string]*rate.Limiter) // By profile, shared by its regions
+)
+
+// profileLimiter returns the limiter of a profile's calls, following a
+// changed qps
+func profileLimiter(profile string, qps float64) *rate.Limiter {
+	if qps <= 0 {
+		qps = config.DefaultQPS
+	}
+	burst := max(int(qps), 1)
+
+	limitersMu.Lock()
+	defer limitersMu.Unlock()
+	limiter, ok := limiters[profile]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(qps), burst)
+		limiters[profile] = limiter
+	} else if limiter.Limit() != rate.Limit(qps) {
+		limiter.SetLimit(rate.Limit(qps))
+		limiter.SetBurst(burst)
+	}
+	return limiter
+}
+
+func (t retryTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	start := time.Now()
+	response, attempts, err := t.send(request)
+	t.trace(request, response, err, start, attempts)
+	return response, err
+}
+
+// send sends request, again while it should be retried, and returns the
+// last response with the number of attempts made
+func (t retryTransport) send(request *http.Request) (*http.Response, int, error) {
+	ctx := request.Context()
+	// A request whose body cannot be read again is sent once
+	replayable := request.Body == nil || request.Body == http.NoBody || request.GetBody != nil
+	defer endRetries(request)
+
+	for attempt := 0; ; attempt++ {
+		if err := t.limiter.Wait(ctx); err != nil {
+			return nil, attempt, err
+		}
+
+		send := request
+		if attempt > 0 {
+			var err error
+			if send, err = t.again(request); err != nil {
+				return nil, attempt, err
+			}
+		}
+
+		response, err := t.roundTrip(send)
+		retry := false
+		if err != nil {
+			retry = ctx.Err() == nil && (notSent(err) || isRead(request))
+		} else {
+			retry, err = shouldRetry(request, response)
+		}
+		if !retry || !replayable || attempt == MaxRetries {
+			return response, attempt + 1, err
+		}
+
+		logging.Debug("retrying api call",
+			"action", callAction(request), "attempt", attempt+1, "status", statusOf(response), "error", errorText(response, err))
+		if response != nil {
+			response.Body.Close()
+		}
+		startRetry(request, attempt+1)
+		if err := sleep(ctx, backoff(attempt)); err != nil {
+			return nil, attempt + 1, err
+		}
+	}
+}
+
+// roundTrip sends one attempt within the timeout, which also bounds reading
+// the response body
+func (t retryTransport) roundTrip(request *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.base.RoundTrip(request)
+	}
+	ctx, cancel := context.WithTimeout(request.Context(), t.timeout)
+	response, err := t.base.RoundTrip(request.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	response.Body = cancelOnClose{ReadCloser: response.Body, cancel: cancel}
+	return response, nil
+}
+
+// cancelOnClose is a response body that ends the attempt's context once closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// again returns request to send once more, with its body read again. RPC
+// requests are signed again too, as Aliyun turns away a SignatureNonce it
+// has seen; ROA and REST requests carry no nonce, only a date that stays
+// valid for 15 minutes, and go out as they are
+func (t retryTransport) again(request *http.Request) (*http.Request, error) {
+	send := request.Clone(request.Context())
+	if request.GetBody != nil {
+		body, err := request.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		send.Body = body
+	}
+	if t.credentials == nil || send.URL.Query().Get("SignatureNonce") == "" {
+		return send, nil
+	}
+	return send, t.resign(send)
+}
+
+// resign signs an RPC request again with the SDK's signer, which gives it a
+// new nonce and timestamp and the credentials as they are now
+func (t retryTransport) resign(request *http.Request) error {
+	query := request.URL.Query()
+	rpc := &requests.RpcRequest{}
+	rpc.InitWithApiInfo("", query.Get("Version"), query.Get("Action"), "", "")
+	rpc.Method = request.Method
+	rpc.AcceptFormat = query.Get("Format")
+	for key := range query {
+		rpc.QueryParams[key] = query.Get(key)
+	}
+	// Credentials refreshed since may have no token
+	delete(rpc.QueryParams, "SecurityToken")
+	delete(rpc.QueryParams, "BearerToken")
+
+	if request.Body != nil {
+		body, err := io.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return err
+		}
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return err
+		}
+		for key := range form {
+			rpc.FormParams[key] = form.Get(key)
+		}
+	}
+
+	if err := auth.Sign(rpc, nil, query.Get("RegionId"), t.credentials); err != nil {
+		return err
+	}
+	request.URL.RawQuery = utils.GetUrlFormedMap(rpc.QueryParams)
+	content := rpc.GetContent()
+	request.ContentLength = int64(len(content))
+	request.GetBody = func() (io.ReadCloser, error) {
+		if len(content) == 0 {
+			return http.NoBody, nil
+		}
+		return io.NopCloser(bytes.NewReader(content)), nil
+	}
+	request.Body, _ = request.GetBody()
+	return nil
+}
+
+// shouldRetry reports whether a response is an error worth trying again:
+// Aliyun turned the call away, or the server failed a call that changes
+// nothing. The body is read for the error code and left readable
+func shouldRetry(request *http.Request, response *http.Response) (bool, error) {
+	if response.StatusCode < http.StatusBadRequest {
+		return false, nil
+	}
+	if response.StatusCode == http.StatusTooManyRequests {
+		return true, nil
+	}
+
+	body, err := errorBody(response)
+	if err != nil {
+		return false, err
+	}
+
+	code, _, _ := errorDetail(body)
+	for _, prefix := range rejectedCodes {
+		if strings.HasPrefix(code, prefix) {
+			return true, nil
+		}
+	}
+	return response.StatusCode >= http.StatusInternalServerError && isRead(request), nil
+}
+
+// errorBody reads the body of an error response, leaving it readable for
+// the client
+func errorBody(response *http.Response) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxErrorBody))
+	response.Body.Close()
+	response.Body = io.NopCloser(bytes.NewReader(body))
+	return body, err
+}
+
+// errorDetail returns the code, message and request ID of a JSON or XML
+// error response, "" for those it has not
+func errorDetail(body []byte) (code, message, requestID string) {
+	// Field names match case-insensitively, so also the "code" of the ROA APIs
+	var response struct {
+		Code      string
+		Message   string
+		RequestID string `json:"RequestId"`
+	}
+	if json.Unmarshal(body, &response) == nil {
+		return response.Code, response.Message, response.RequestID
+	}
+	if match := xmlCodePattern.FindSubmatch(body); match != nil {
+		code = string(match[1])
+	}
+	if match := xmlMessagePattern.FindSubmatch(body); match != nil {
+		message = string(match[1])
+	}
+	if match := xmlRequestIDPattern.FindSubmatch(body); match != nil {
+		requestID = string(match[1])
+	}
+	return code, message, requestID
+}
+
+// notSent reports whether a network error happened before the request went
+// out, e.g. on DNS lookup or dial
+func notSent(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// isRead reports whether a request changes nothing, so sending it twice does
+// no harm
+func isRead(request *http.Request) bool {
+	if request.Method == http.MethodGet || request.Method == http.MethodHead {
+		return true
+	}
+	action := request.URL.Query().Get("Action")
+	for _, prefix := range readActions {
+		if strings.HasPrefix(action, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns how long to wait before retry attempt+1: the base delay
+// doubled attempt times, capped, with the upper half jittered so throttled
+// calls sent together do not come back together
+func backoff(attempt int) time.Duration {
+	delay := min(retryBaseDelay<<attempt, retryMaxDelay)
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// sleep waits for d, returning early with the error of ctx once it is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RetryStatus tells how far the retries of an API call got
+type RetryStatus struct {
+	Attempt int // The retry waited for or running, from 1
+	Max     int
+}
+
+var (
+	retriesMu sync.Mutex
+	retries   = make(map[*http.Request]RetryStatus) // Calls being retried
+)
+
+// startRetry records that request is retried for the attempt-th time
+func startRetry(request *http.Request, attempt int) {
+	retriesMu.Lock()
+	defer retriesMu.Unlock()
+	retries[request] = RetryStatus{Attempt: attempt, Max: MaxRetries}
+}
+
+// endRetries forgets a call once it returned
+func endRetries(request *http.Request) {
+	retriesMu.Lock()
+	defer retriesMu.Unlock()
+	delete(retries, request)
+}
+
+// Retrying returns the status of the call furthest into its retries, false
+// when no call is being retried
+func Retrying() (RetryStatus, bool) {
+	retriesMu.Lock()
+	defer retriesMu.Unlock()
+	var furthest RetryStatus
+	for _, status := range retries {
+		if status.Attempt > furthest.Attempt {
+			furthest = status
+		}
+	}
+	return furthest, furthest.Attempt > 0
+}
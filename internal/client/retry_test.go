@@ -0,0 +1,109 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/utils"
+	"golang.org/x/time/rate"
+)
+
+// roundTripFunc answers requests with a function
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+// sentRequest is what one attempt sent
+type sentRequest struct {
+	query url.Values
+	form  url.Values
+}
+
+func TestRetryResignsRPCRequests(t *testing.T) {
+	creds := SDKCredential(NewStaticCredentials(CredentialValue{AccessKeyID: "ak", AccessKeySecret: "secret"}))
+
+	rpc := &requests.RpcRequest{}
+	rpc.InitWithApiInfo("Ecs", "2014-05-26", "DescribeInstances", "", "")
+	rpc.Domain = "ecs.cn-hangzhou.aliyuncs.com"
+	rpc.Scheme = "https"
+	rpc.FormParams["PageSize"] = "100"
+	if err := auth.Sign(rpc, nil, "cn-hangzhou", creds); err != nil {
+		t.Fatalf("signing: %v", err)
+	}
+	request, err := http.NewRequest(rpc.Method, rpc.BuildUrl(), rpc.GetBodyReader())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for key, value := range rpc.GetHeaders() {
+		request.Header[key] = []string{value}
+	}
+
+	var sent []sentRequest
+	transport := retryTransport{
+		base: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(r.Body)
+			form, _ := url.ParseQuery(string(body))
+			sent = append(sent, sentRequest{query: r.URL.Query(), form: form})
+			if len(sent) == 1 {
+				return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(strings.NewReader(`{"Code":"ServiceUnavailable"}`))}, nil
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+		}),
+		limiter:     rate.NewLimiter(rate.Inf, 1),
+		credentials: creds,
+		timeout:     time.Minute,
+	}
+
+	response, attempts, err := transport.send(request)
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	response.Body.Close()
+	if attempts != 2 || len(sent) != 2 {
+		t.Fatalf("attempts = %d, sent %d, want 2", attempts, len(sent))
+	}
+
+	first, second := sent[0], sent[1]
+	if first.query.Get("SignatureNonce") == second.query.Get("SignatureNonce") {
+		t.Errorf("both attempts carry SignatureNonce %q", first.query.Get("SignatureNonce"))
+	}
+	if first.query.Get("Signature") == second.query.Get("Signature") {
+		t.Errorf("both attempts carry Signature %q", first.query.Get("Signature"))
+	}
+	for _, key := range []string{"Action", "Version", "AccessKeyId", "RegionId"} {
+		if first.query.Get(key) != second.query.Get(key) {
+			t.Errorf("%s = %q on the retry, want %q", key, second.query.Get(key), first.query.Get(key))
+		}
+	}
+	if second.form.Get("PageSize") != "100" {
+		t.Errorf("retry form = %v, want PageSize=100", second.form)
+	}
+	for i, attempt := range sent {
+		if got, want := attempt.query.Get("Signature"), rpcSignature(request.Method, attempt, "secret"); got != want {
+			t.Errorf("attempt %d Signature = %q, want %q", i+1, got, want)
+		}
+	}
+}
+
+// rpcSignature computes the signature of an RPC request as Aliyun checks it
+func rpcSignature(method string, sent sentRequest, secret string) string {
+	params := make(map[string]string)
+	for key := range sent.query {
+		if key != "Signature" {
+			params[key] = sent.query.Get(key)
+		}
+	}
+	for key := range sent.form {
+		params[key] = sent.form.Get(key)
+	}
+	canonical := strings.NewReplacer("+", "%20", "*", "%2A", "%7E", "~").Replace(utils.GetUrlFormedMap(params))
+	return utils.ShaHmac1(method+"&%2F&"+url.QueryEscape(canonical), secret+"&")
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
+	"github.com/alibabacloud-go/tea/dara"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+// rocketMQ5APIVersion is the RocketMQ 5.x OpenAPI version requests go to
+const rocketMQ5APIVersion = "2022-08-01"
+
+// RocketMQ5Client calls the RocketMQ 5.x OpenAPI, which serves the 5.x
+// (including serverless) instances the ONS API of 4.x does not list. The
+// module has no SDK package for it, so the generic OpenAPI client signs the
+// ROA requests
+type RocketMQ5Client struct {
+	client *openapi.Client
+}
+
+// rocketMQ5Response is the envelope of every RocketMQ 5.x response
+type rocketMQ5Response struct {
+	Success bool            `json:"success"`
+	Code    string          `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// NewRocketMQ5Client creates a RocketMQ 5.x client for a region
+func NewRocketMQ5Client(regionID string, credentials *Credentials, timeout time.Duration) (*RocketMQ5Client, error) {
+	client, err := openapi.NewClient(&openapi.Config{
+		Credential:  TeaCredential(credentials),
+		RegionId:    tea.String(regionID),
+		Endpoint:    tea.String(fmt.Sprintf("rocketmq.%s.aliyuncs.com", regionID)),
+		ReadTimeout: tea.Int(int(timeout / time.Millisecond)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &RocketMQ5Client{client: client}, nil
+}
+
+// Get calls the GET operation action at pathname and decodes the data of the
+// response into out
+func (c *RocketMQ5Client) Get(ctx context.Context, action, pathname string, query url.Values, out interface{}) error {
+	request := &openapi.OpenApiRequest{Query: make(map[string]*string)}
+	for name := range query {
+		request.Query[name] = tea.String(query.Get(name))
+	}
+
+	params := &openapi.Params{
+		Action:      tea.String(action),
+		Version:     tea.String(rocketMQ5APIVersion),
+		Protocol:    tea.String("HTTPS"),
+		Pathname:    tea.String(pathname),
+		Method:      tea.String("GET"),
+		AuthType:    tea.String("AK"),
+		Style:       tea.String("ROA"),
+		ReqBodyType: tea.String("json"),
+		BodyType:    tea.String("json"),
+	}
+
+	result, err := c.client.CallApiWithCtx(ctx, params, request, &dara.RuntimeOptions{})
+	if err != nil {
+		return fmt.Errorf("calling RocketMQ %s: %w", action, err)
+	}
+
+	// The body is decoded into a map; encode it again to decode the envelope
+	body, err := json.Marshal(result["body"])
+	if err != nil {
+		return fmt.Errorf("reading RocketMQ %s response: %w", action, err)
+	}
+	var response rocketMQ5Response
+	if err := json.Unmarshal(body, &response); err != nil {
+		return fmt.Errorf("decoding RocketMQ %s response: %w", action, err)
+	}
+	if !response.Success {
+		return fmt.Errorf("RocketMQ %s: %s: %s", action, response.Code, response.Message)
+	}
+	if err := json.Unmarshal(response.Data, out); err != nil {
+		return fmt.Errorf("decoding RocketMQ %s data: %w", action, err)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"net/http"
+	"time"
+
+	"aliyun-tui-viewer/internal/logging"
+)
+
+// requestIDHeaders are the response headers carrying the request ID, by
+// gateway: OpenAPI, Log Service, Function Compute and OSS
+var requestIDHeaders = []string{"x-acs-request-id", "x-log-requestid", "x-fc-request-id", "x-oss-request-id"}
+
+// trace records a call that returned after attempts attempts
+func (t retryTransport) trace(request *http.Request, response *http.Response, err error, start time.Time, attempts int) {
+	logging.Record(logging.Call{
+		Time:      start,
+		Profile:   t.profile,
+		Region:    t.region,
+		Host:      request.URL.Host,
+		Action:    callAction(request),
+		Latency:   time.Since(start),
+		Attempts:  attempts,
+		Status:    statusOf(response),
+		RequestID: requestID(response),
+		Err:       errorText(response, err),
+	})
+}
+
+// callAction names the API a request calls: the action of RPC APIs, the
+// method and path of REST ones
+func callAction(request *http.Request) string {
+	if action := request.URL.Query().Get("Action"); action != "" {
+		return action
+	}
+	if action := request.Header.Get("x-acs-action"); action != "" {
+		return action
+	}
+	return request.Method + " " + request.URL.Path
+}
+
+// statusOf returns the HTTP status of a response, 0 without one
+func statusOf(response *http.Response) int {
+	if response == nil {
+		return 0
+	}
+	return response.StatusCode
+}
+
+// requestID returns the ID Aliyun gave a call, from the response headers or
+// the body of an error response
+func requestID(response *http.Response) string {
+	if response == nil {
+		return ""
+	}
+	for _, header := range requestIDHeaders {
+		if id := response.Header.Get(header); id != "" {
+			return id
+		}
+	}
+	if response.StatusCode >= http.StatusBadRequest {
+		if body, err := errorBody(response); err == nil {
+			_, _, id := errorDetail(body)
+			return id
+		}
+	}
+	return ""
+}
+
+// errorText describes how a call failed, "" when it succeeded
+func errorText(response *http.Response, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	if response == nil || response.StatusCode < http.StatusBadRequest {
+		return ""
+	}
+	body, err := errorBody(response)
+	if err != nil {
+		return response.Status
+	}
+	code, message, _ := errorDetail(body)
+	switch {
+	case code == "":
+		return response.Status
+	case message == "":
+		return code
+	default:
+		return code + ": " + message
+	}
+}
@@ -0,0 +1,185 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/sts"
+)
+
+const (
+	// defaultRoleSessionName names the sessions of profiles without ram_session_name
+	defaultRoleSessionName = "alidash"
+	// defaultRoleDuration is how long assumed credentials last when the
+	// profile sets no expired_seconds, the Aliyun CLI's default
+	defaultRoleDuration = 900
+	// stsRegion is where AssumeRole is called for profiles without a region
+	stsRegion = "cn-hangzhou"
+	// roleCacheMargin is how long cached credentials must still be valid for
+	// to be reused instead of assuming the role again
+	roleCacheMargin = 5 * time.Minute
+)
+
+// roleCacheMu serializes reads and writes of the role credentials cache
+var roleCacheMu sync.Mutex
+
+// cachedRoleCredentials are the temporary credentials of an assumed role
+type cachedRoleCredentials struct {
+	AccessKeyID     string    `json:"access_key_id"`
+	AccessKeySecret string    `json:"access_key_secret"`
+	SecurityToken   string    `json:"sts_token"`
+	Expiration      time.Time `json:"expiration"`
+}
+
+// roleCacheFilePath returns the path to the role credentials cache
+func roleCacheFilePath() string {
+	usr, err := user.Current()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(usr.HomeDir, ".aliyun", "alidash_sts_cache.json")
+}
+
+// loadRoleCache reads the cached role credentials, keyed by profile and role
+func loadRoleCache() map[string]cachedRoleCredentials {
+	cache := make(map[string]cachedRoleCredentials)
+	path := roleCacheFilePath()
+	if path == "" {
+		return cache
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cache
+	}
+	_ = json.Unmarshal(data, &cache) // A corrupt cache is rebuilt
+	return cache
+}
+
+// saveRoleCache writes the cache readable by the user only, since it holds
+// credentials
+func saveRoleCache(cache map[string]cachedRoleCredentials) error {
+	path := roleCacheFilePath()
+	if path == "" {
+		return fmt.Errorf("cannot determine role cache path")
+	}
+	data, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// roleCacheKey identifies the credentials of a profile's role, so editing
+// the role in config.json does not reuse the old one's
+func roleCacheKey(profile *ConfigProfile) string {
+	return profile.Name + "|" + profile.RamRoleArn
+}
+
+// RoleName returns the role name of a RAM role ARN,
+// e.g. "admin" for acs:ram::123456:role/admin
+func RoleName(arn string) string {
+	if i := strings.LastIndex(arn, "role/"); i >= 0 {
+		return arn[i+len("role/"):]
+	}
+	return arn
+}
+
+// AssumedRole returns the name of the RAM role the profile assumes or, for
+// EcsRamRole profiles, the instance role it names; "" when it uses its own
+// credentials
+func (c *Config) AssumedRole() string {
+	if c.Mode == ModeEcsRamRole {
+		return c.RamRoleName
+	}
+	if c.RoleArn == "" {
+		return ""
+	}
+	return RoleName(c.RoleArn)
+}
+
+// assumeRole returns temporary credentials of a RamRoleArn or
+// ChainableRamRoleArn profile's role, from the cache while they stay valid
+// for a while longer and otherwise by calling STS AssumeRole with the source
+// credentials
+func assumeRole(profile *ConfigProfile, source *Credentials) (*Credentials, error) {
+	if profile.RamRoleArn == "" {
+		return nil, fmt.Errorf("profile '%s' has mode %s but no ram_role_arn", profile.Name, profile.Mode)
+	}
+
+	roleCacheMu.Lock()
+	defer roleCacheMu.Unlock()
+
+	cache := loadRoleCache()
+	key := roleCacheKey(profile)
+	if cached, ok := cache[key]; ok && time.Until(cached.Expiration) > roleCacheMargin {
+		return &Credentials{
+			AccessKeyID:     cached.AccessKeyID,
+			AccessKeySecret: cached.AccessKeySecret,
+			SecurityToken:   cached.SecurityToken,
+			Expiration:      cached.Expiration,
+		}, nil
+	}
+
+	region := profile.RegionID
+	if region == "" {
+		region = stsRegion
+	}
+	var client *sts.Client
+	var err error
+	if source.SecurityToken != "" {
+		client, err = sts.NewClientWithStsToken(region, source.AccessKeyID, source.AccessKeySecret, source.SecurityToken)
+	} else {
+		client, err = sts.NewClientWithAccessKey(region, source.AccessKeyID, source.AccessKeySecret)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("creating STS client: %w", err)
+	}
+
+	request := sts.CreateAssumeRoleRequest()
+	request.Scheme = "https"
+	request.RoleArn = profile.RamRoleArn
+	request.RoleSessionName = profile.RamSessionName
+	if request.RoleSessionName == "" {
+		request.RoleSessionName = defaultRoleSessionName
+	}
+	duration := profile.ExpiredSeconds
+	if duration <= 0 {
+		duration = defaultRoleDuration
+	}
+	request.DurationSeconds = requests.NewInteger(duration)
+	request.ExternalId = profile.ExternalID
+
+	response, err := client.AssumeRole(request)
+	if err != nil {
+		return nil, fmt.Errorf("assuming role %s for profile '%s': %w", profile.RamRoleArn, profile.Name, err)
+	}
+	expiration, err := time.Parse(time.RFC3339, response.Credentials.Expiration)
+	if err != nil {
+		return nil, fmt.Errorf("parsing expiration %q of role %s: %w", response.Credentials.Expiration, profile.RamRoleArn, err)
+	}
+
+	creds := &Credentials{
+		AccessKeyID:     response.Credentials.AccessKeyId,
+		AccessKeySecret: response.Credentials.AccessKeySecret,
+		SecurityToken:   response.Credentials.SecurityToken,
+		Expiration:      expiration,
+	}
+	cache[key] = cachedRoleCredentials{
+		AccessKeyID:     creds.AccessKeyID,
+		AccessKeySecret: creds.AccessKeySecret,
+		SecurityToken:   creds.SecurityToken,
+		Expiration:      creds.Expiration,
+	}
+	_ = saveRoleCache(cache) // The credentials work uncached too
+	return creds, nil
+}
@@ -0,0 +1,32 @@
+package config
+
+// ColumnLayout is how a table page shows its columns, as picked with the
+// column chooser
+type ColumnLayout struct {
+	Visible []int `json:"visible"` // Indices of the columns shown, in order
+	Count   int   `json:"count"`   // How many columns the page had, so that columns added later still show
+}
+
+// LoadColumnLayouts returns the column layouts saved by page
+func LoadColumnLayouts() map[string]ColumnLayout {
+	layouts := LoadState().ColumnLayouts
+	if layouts == nil {
+		layouts = make(map[string]ColumnLayout)
+	}
+	return layouts
+}
+
+// SaveColumnLayout replaces the column layout saved for page, or removes it
+// when layout is nil so that the page shows its default columns again
+func SaveColumnLayout(page string, layout *ColumnLayout) error {
+	state := LoadState()
+	if layout == nil {
+		delete(state.ColumnLayouts, page)
+	} else {
+		if state.ColumnLayouts == nil {
+			state.ColumnLayouts = make(map[string]ColumnLayout)
+		}
+		state.ColumnLayouts[page] = *layout
+	}
+	return state.Save()
+}
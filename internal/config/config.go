@@ -7,6 +7,9 @@ import (
 	"os/user"
 	"path/filepath"
 	"strings"
+	"time"
+
+	"aliyun-tui-viewer/internal/cache"
 )
 
 // ConfigProfile represents a single profile in the Aliyun CLI config
@@ -15,8 +18,21 @@ type ConfigProfile struct {
 	Mode            string `json:"mode"`
 	AccessKeyID     string `json:"access_key_id"`
 	AccessKeySecret string `json:"access_key_secret"`
+	StsToken        string `json:"sts_token,omitempty"`       // StsToken mode
+	ProcessCommand  string `json:"process_command,omitempty"` // External mode: prints credentials as JSON
+	RamRoleArn      string `json:"ram_role_arn,omitempty"`    // RamRoleArn modes: the role to assume
+	RamSessionName  string `json:"ram_session_name,omitempty"`
+	ExpiredSeconds  int    `json:"expired_seconds,omitempty"` // Lifetime of the assumed credentials
+	ExternalID      string `json:"external_id,omitempty"`
+	SourceProfile   string `json:"source_profile,omitempty"` // ChainableRamRoleArn mode: whose credentials assume the role
+	RamRoleName     string `json:"ram_role_name,omitempty"`  // EcsRamRole mode: the instance's role, detected when empty
 	RegionID        string `json:"region_id"`
 	OssEndpoint     string `json:"oss_endpoint,omitempty"` // Custom field for OSS endpoint
+
+	// CredentialsFile mode: section of ~/.alibabacloud/credentials, "default" when empty
+	CredentialsProfile string  `json:"credentials_profile,omitempty"`
+	ReadOnly           bool    `json:"readonly,omitempty"` // Disables changes to cloud resources with this profile
+	QPS                float64 `json:"qps,omitempty"`      // Most API calls a second, DefaultQPS when unset
 	// Other fields like output_format, language can be added if needed
 }
 
@@ -27,21 +43,84 @@ type AliyunConfig struct {
 	Editor   string          `json:"editor,omitempty"` // Global editor command
 	Pager    string          `json:"pager,omitempty"`  // Global pager command
 	Locale   string          `json:"locale,omitempty"` // UI language: zh_CN or en_US
+	Bell     string          `json:"bell,omitempty"`   // Bell after long loads: unfocused, always or off
+
+	MenuOrder string `json:"menu_order,omitempty"` // Menu order: usage or fixed
+	Resume    bool   `json:"resume,omitempty"`     // Restore the last session on every start, as --resume does
+
+	Workspaces []Workspace `json:"workspaces,omitempty"` // Named profile + region + page layouts
+
+	ConnectCommands map[string]string `json:"connect_commands,omitempty"` // Client command templates by engine
+
+	ReadOnly bool `json:"readonly,omitempty"` // Disables changes to cloud resources with every profile
+
+	CacheTTL string `json:"cache_ttl,omitempty"` // How long list results are reused, e.g. "2m"; "0" disables the cache
+
+	APITimeout string `json:"api_timeout,omitempty"` // How long an API call may take, e.g. "45s"
+
+	Theme       string            `json:"theme,omitempty"`        // Colors: dark, light or high-contrast
+	ThemeColors map[string]string `json:"theme_colors,omitempty"` // Hex colors overriding the theme's, by role
+
+	LocaleFile string `json:"locale_file,omitempty"` // JSON or YAML translations by locale and key, added to the built-in ones
+
+	TimeFormat string `json:"time_format,omitempty"` // Timestamps: absolute, relative or both
 }
 
 // Config holds the application configuration
 type Config struct {
+	Profile         string
+	Mode            string
 	AccessKeyID     string
 	AccessKeySecret string
+	SecurityToken   string
+	Expiration      time.Time // When temporary credentials of an assumed role expire
+	RoleArn         string    // Role a RamRoleArn profile assumes
+	RamRoleName     string    // Instance role of an EcsRamRole profile
 	RegionID        string
 	OssEndpoint     string
 	Editor          string
 	Pager           string
 	Locale          string
+	Bell            string
+
+	// Section of the credentials file a CredentialsFile profile reads
+	CredentialsProfile string
+	// ReadOnly disables changes to cloud resources, set by the profile or
+	// the whole config file
+	ReadOnly bool
+	// QPS is how many API calls a second the profile makes at most
+	QPS float64
+}
+
+// Environment variables that select the profile and region at startup, named
+// as the Aliyun CLI names them. The --profile and --region flags take precedence
+const (
+	EnvProfile = "ALIBABA_CLOUD_PROFILE"
+	EnvRegion  = "ALIBABA_CLOUD_REGION_ID"
+)
+
+// StartupSelection returns the profile and region to start with, falling back
+// to the environment variables for the ones not given. Empty results mean the
+// config file's current profile and the profile's region_id
+func StartupSelection(profileName, regionID string) (string, string) {
+	if profileName == "" {
+		profileName = os.Getenv(EnvProfile)
+	}
+	if regionID == "" {
+		regionID = os.Getenv(EnvRegion)
+	}
+	return profileName, regionID
 }
 
 // LoadAliyunConfig loads configuration from ~/.aliyun/config.json
 func LoadAliyunConfig() (*Config, error) {
+	return LoadProfileConfig("", "")
+}
+
+// LoadProfileConfig loads the named profile without making it the current one.
+// An empty profileName selects the current profile, and a non-empty regionID
+// overrides the profile's region_id
+func LoadProfileConfig(profileName, regionID string) (*Config, error) {
 	usr, err := user.Current()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get current user: %w", err)
@@ -63,7 +142,10 @@ func LoadAliyunConfig() (*Config, error) {
 		return nil, fmt.Errorf("no profiles found in aliyun config file: %s", configPath)
 	}
 
-	activeProfileName := config.Current
+	activeProfileName := profileName
+	if activeProfileName == "" {
+		activeProfileName = config.Current
+	}
 	if activeProfileName == "" {
 		if len(config.Profiles) == 1 {
 			activeProfileName = config.Profiles[0].Name
@@ -92,14 +174,24 @@ func LoadAliyunConfig() (*Config, error) {
 		return nil, fmt.Errorf("current profile '%s' not found in aliyun config file: %s", activeProfileName, configPath)
 	}
 
-	if activeProfile.AccessKeyID == "" || activeProfile.AccessKeySecret == "" || activeProfile.RegionID == "" {
-		return nil, fmt.Errorf("profile '%s' in %s is missing access_key_id, access_key_secret, or region_id", activeProfile.Name, configPath)
+	region := activeProfile.RegionID
+	if regionID != "" {
+		region = regionID
+	}
+
+	if region == "" {
+		return nil, fmt.Errorf("profile '%s' in %s is missing region_id", activeProfile.Name, configPath)
+	}
+
+	creds, err := resolveCredentials(config.Profiles, activeProfile)
+	if err != nil {
+		return nil, fmt.Errorf("resolving credentials from %s: %w", configPath, err)
 	}
 
-	// Resolve OSS Endpoint
+	// Resolve OSS Endpoint; a custom endpoint only applies to the profile's own region
 	ossEndpoint := activeProfile.OssEndpoint
-	if ossEndpoint == "" && activeProfile.RegionID != "" {
-		ossEndpoint = fmt.Sprintf("oss-%s.aliyuncs.com", activeProfile.RegionID)
+	if ossEndpoint == "" || region != activeProfile.RegionID {
+		ossEndpoint = fmt.Sprintf("oss-%s.aliyuncs.com", region)
 	}
 
 	if ossEndpoint == "" {
@@ -110,16 +202,48 @@ func LoadAliyunConfig() (*Config, error) {
 	}
 
 	return &Config{
-		AccessKeyID:     activeProfile.AccessKeyID,
-		AccessKeySecret: activeProfile.AccessKeySecret,
-		RegionID:        activeProfile.RegionID,
+		Profile:         activeProfile.Name,
+		Mode:            activeProfile.Mode,
+		AccessKeyID:     creds.AccessKeyID,
+		AccessKeySecret: creds.AccessKeySecret,
+		SecurityToken:   creds.SecurityToken,
+		Expiration:      creds.Expiration,
+		RoleArn:         activeProfile.RamRoleArn,
+		RamRoleName:     activeProfile.RamRoleName,
+		RegionID:        region,
 		OssEndpoint:     ossEndpoint,
 		Editor:          config.Editor,
 		Pager:           config.Pager,
 		Locale:          config.Locale,
+		Bell:            config.Bell,
+
+		CredentialsProfile: activeProfile.CredentialsProfile,
+		ReadOnly:           config.ReadOnly || activeProfile.ReadOnly,
+		QPS:                activeProfile.QPS,
 	}, nil
 }
 
+// loadConfigFile reads ~/.aliyun/config.json without resolving credentials.
+// Used for global settings so External profiles don't run their process on every lookup
+func loadConfigFile() (*AliyunConfig, error) {
+	usr, err := user.Current()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+	configPath := filepath.Join(usr.HomeDir, ".aliyun", "config.json")
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read aliyun config file at %s: %w", configPath, err)
+	}
+
+	var config AliyunConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse aliyun config file %s: %w", configPath, err)
+	}
+	return &config, nil
+}
+
 // GetCurrentProfileName returns the name of the current active profile
 func GetCurrentProfileName() (string, error) {
 	usr, err := user.Current()
@@ -243,7 +367,7 @@ func SwitchProfile(profileName string) error {
 // 3. EDITOR environment variable
 // 4. Default to "vim"
 func GetEditor() (string, error) {
-	config, err := LoadAliyunConfig()
+	config, err := loadConfigFile()
 	if err != nil {
 		return "", err
 	}
@@ -272,7 +396,7 @@ func GetEditor() (string, error) {
 // 2. PAGER environment variable
 // 3. Default to "less"
 func GetPager() (string, error) {
-	config, err := LoadAliyunConfig()
+	config, err := loadConfigFile()
 	if err != nil {
 		return "", err
 	}
@@ -291,6 +415,159 @@ func GetPager() (string, error) {
 	return "less", nil
 }
 
+// Bell mode constants
+const (
+	BellUnfocused = "unfocused" // Ring only when the terminal has lost focus
+	BellAlways    = "always"
+	BellOff       = "off"
+)
+
+// GetBellMode returns when to ring the terminal bell after a long load,
+// from the config file "bell" field. Defaults to "unfocused"
+func GetBellMode() string {
+	config, err := loadConfigFile()
+	if err != nil {
+		return BellUnfocused
+	}
+
+	switch mode := strings.ToLower(strings.TrimSpace(config.Bell)); mode {
+	case BellAlways, BellOff:
+		return mode
+	default:
+		return BellUnfocused
+	}
+}
+
+// Menu order constants
+const (
+	MenuOrderUsage = "usage" // Most opened services first, from the local usage statistics
+	MenuOrderFixed = "fixed"
+)
+
+// GetMenuOrder returns how to order the main menu, from the config file
+// "menu_order" field. Defaults to "usage"
+func GetMenuOrder() string {
+	config, err := loadConfigFile()
+	if err != nil {
+		return MenuOrderUsage
+	}
+
+	if strings.ToLower(strings.TrimSpace(config.MenuOrder)) == MenuOrderFixed {
+		return MenuOrderFixed
+	}
+	return MenuOrderUsage
+}
+
+// GetCacheTTL returns how long list results are reused before the API is
+// called again, from the config file "cache_ttl" field. Defaults to a minute;
+// an invalid or negative value also falls back to the default
+func GetCacheTTL() time.Duration {
+	config, err := loadConfigFile()
+	if err != nil || strings.TrimSpace(config.CacheTTL) == "" {
+		return cache.DefaultTTL
+	}
+	ttl, err := time.ParseDuration(strings.TrimSpace(config.CacheTTL))
+	if err != nil || ttl < 0 {
+		return cache.DefaultTTL
+	}
+	return ttl
+}
+
+// DefaultAPITimeout is how long an API call may take when the config file
+// sets no "api_timeout"
+const DefaultAPITimeout = 30 * time.Second
+
+// DefaultQPS is how many API calls a second a profile without "qps" makes at
+// most. Aliyun throttles most APIs of an account well above it
+const DefaultQPS = 20
+
+// GetAPITimeout returns how long an API call may take before it is aborted,
+// from the config file "api_timeout" field. An invalid or non-positive value
+// falls back to the default
+func GetAPITimeout() time.Duration {
+	config, err := loadConfigFile()
+	if err != nil || strings.TrimSpace(config.APITimeout) == "" {
+		return DefaultAPITimeout
+	}
+	timeout, err := time.ParseDuration(strings.TrimSpace(config.APITimeout))
+	if err != nil || timeout <= 0 {
+		return DefaultAPITimeout
+	}
+	return timeout
+}
+
+// GetTheme returns the name of the theme to draw with and the colors
+// overriding it, from the config file "theme" and "theme_colors" fields. The
+// name is "" when unset
+func GetTheme() (string, map[string]string) {
+	config, err := loadConfigFile()
+	if err != nil {
+		return "", nil
+	}
+	return config.Theme, config.ThemeColors
+}
+
+// Time format constants
+const (
+	TimeFormatAbsolute = "absolute" // Local date and time, e.g. "2025-06-01 15:04:05"
+	TimeFormatRelative = "relative" // Time from now, e.g. "2h ago" or "in 3d"
+	TimeFormatBoth     = "both"     // Local date and time followed by the time from now
+)
+
+// GetTimeFormat returns how to show timestamps, from the config file
+// "time_format" field. Defaults to "absolute"
+func GetTimeFormat() string {
+	config, err := loadConfigFile()
+	if err != nil {
+		return TimeFormatAbsolute
+	}
+
+	switch format := strings.ToLower(strings.TrimSpace(config.TimeFormat)); format {
+	case TimeFormatRelative, TimeFormatBoth:
+		return format
+	default:
+		return TimeFormatAbsolute
+	}
+}
+
+// GetLocaleFile returns the path of the file with translations to add to
+// the built-in ones, from the config file "locale_file" field, with ~
+// expanded. It is "" when unset
+func GetLocaleFile() string {
+	config, err := loadConfigFile()
+	if err != nil || config.LocaleFile == "" {
+		return ""
+	}
+	path := config.LocaleFile
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, strings.TrimPrefix(path, "~"))
+		}
+	}
+	return path
+}
+
+// GetLocaleSetting returns the config file "locale" field as written, ""
+// when unset, so a locale only the locale file has can be told from the
+// built-in ones
+func GetLocaleSetting() string {
+	config, err := loadConfigFile()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(config.Locale)
+}
+
+// GetResume reports whether to restore the last session on startup, from
+// the config file "resume" field
+func GetResume() bool {
+	config, err := loadConfigFile()
+	if err != nil {
+		return false
+	}
+	return config.Resume
+}
+
 // Locale constants
 const (
 	LocaleEnUS = "en_US"
@@ -306,7 +583,7 @@ const (
 // Only zh_CN and en_US are supported, others default to en_US
 func GetLocale() string {
 	// First try config file
-	config, err := LoadAliyunConfig()
+	config, err := loadConfigFile()
 	if err == nil && config.Locale != "" {
 		if normalizedLocale := normalizeLocale(config.Locale); normalizedLocale != "" {
 			return normalizedLocale
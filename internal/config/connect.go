@@ -0,0 +1,55 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Engines of the quick-connect client commands, as keys of the config file
+// "connect_commands" field
+const (
+	EngineMySQL      = "mysql"
+	EngineMariaDB    = "mariadb"
+	EnginePostgreSQL = "postgresql"
+	EngineSQLServer  = "sqlserver"
+	EngineRedis      = "redis"
+)
+
+// defaultConnectCommands are the client commands used for engines the config
+// file does not set. {host}, {port} and {user} are replaced in each argument
+var defaultConnectCommands = map[string]string{
+	EngineMySQL:      "mysql -h {host} -P {port} -u {user} -p",
+	EngineMariaDB:    "mysql -h {host} -P {port} -u {user} -p",
+	EnginePostgreSQL: "psql -h {host} -p {port} -U {user} -d postgres",
+	EngineSQLServer:  "sqlcmd -S {host},{port} -U {user}",
+	EngineRedis:      "redis-cli -h {host} -p {port} --user {user} --askpass",
+}
+
+// GetConnectCommand returns the client command template for an engine, from
+// the config file "connect_commands" field or the built-in default, e.g.
+//
+//	"connect_commands": {"mysql": "mycli -h {host} -P {port} -u {user}"}
+func GetConnectCommand(engine string) (string, error) {
+	engine = strings.ToLower(engine)
+	if config, err := loadConfigFile(); err == nil {
+		if command := strings.TrimSpace(config.ConnectCommands[engine]); command != "" {
+			return command, nil
+		}
+	}
+	if command, ok := defaultConnectCommands[engine]; ok {
+		return command, nil
+	}
+	return "", fmt.Errorf("no client command for engine %s: add one to connect_commands in ~/.aliyun/config.json", engine)
+}
+
+// ExpandConnectCommand splits a command template into its arguments and fills
+// in the endpoint and account. Values are substituted after splitting, so a
+// value with spaces stays a single argument
+func ExpandConnectCommand(template, host, port, user string) []string {
+	replacer := strings.NewReplacer("{host}", host, "{port}", port, "{user}", user)
+	args := strings.Fields(template)
+	for i, arg := range args {
+		args[i] = replacer.Replace(arg)
+	}
+	return args
+}
@@ -0,0 +1,35 @@
+package config
+
+import "time"
+
+// CountSnapshot is the number of resources of each type a profile had in a
+// region when the menu last counted them
+type CountSnapshot struct {
+	Counts  map[string]int `json:"counts"` // By Resource Center type
+	TakenAt time.Time      `json:"taken_at"`
+}
+
+// countsKey identifies the counts of a profile in a region
+func countsKey(profile, region string) string {
+	return profile + "|" + region
+}
+
+// LoadResourceCounts returns the counts saved for a profile and region, nil
+// when there are none
+func LoadResourceCounts(profile, region string) *CountSnapshot {
+	snapshot, ok := LoadState().ResourceCounts[countsKey(profile, region)]
+	if !ok {
+		return nil
+	}
+	return &snapshot
+}
+
+// SaveResourceCounts replaces the counts saved for a profile and region
+func SaveResourceCounts(profile, region string, counts map[string]int) error {
+	state := LoadState()
+	if state.ResourceCounts == nil {
+		state.ResourceCounts = make(map[string]CountSnapshot)
+	}
+	state.ResourceCounts[countsKey(profile, region)] = CountSnapshot{Counts: counts, TakenAt: time.Now()}
+	return state.Save()
+}
@@ -0,0 +1,181 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// Credential modes of aliyun CLI profiles
+const (
+	ModeAK       = "AK"
+	ModeStsToken = "StsToken"
+	ModeExternal = "External"
+	// ModeRamRoleArn assumes ram_role_arn with the profile's own access keys
+	ModeRamRoleArn = "RamRoleArn"
+	// ModeChainableRamRoleArn assumes ram_role_arn with the credentials of
+	// source_profile
+	ModeChainableRamRoleArn = "ChainableRamRoleArn"
+	// ModeEcsRamRole reads the credentials of ram_role_name from the ECS
+	// instance metadata service
+	ModeEcsRamRole = "EcsRamRole"
+	// ModeEnvironment reads ALIBABA_CLOUD_ACCESS_KEY_ID,
+	// ALIBABA_CLOUD_ACCESS_KEY_SECRET and ALIBABA_CLOUD_SECURITY_TOKEN
+	ModeEnvironment = "Environment"
+	// ModeCredentialsFile reads credentials_profile from the credentials ini
+	// file shared with the Alibaba Cloud SDKs
+	ModeCredentialsFile = "CredentialsFile"
+	// ModeProviderChain tries the environment, the credentials file and the
+	// instance RAM role in turn
+	ModeProviderChain = "ProviderChain"
+)
+
+// IsProviderMode reports whether a mode's credentials come from a provider in
+// internal/client instead of the config file, so they are not resolved here
+func IsProviderMode(mode string) bool {
+	switch mode {
+	case ModeEcsRamRole, ModeEnvironment, ModeCredentialsFile, ModeProviderChain:
+		return true
+	}
+	return false
+}
+
+// maxSourceProfileDepth bounds source_profile chains, so that a loop fails
+// instead of recursing forever
+const maxSourceProfileDepth = 5
+
+// Credentials holds the keys resolved for a profile
+type Credentials struct {
+	AccessKeyID     string
+	AccessKeySecret string
+	SecurityToken   string    // Empty for long-term access keys
+	Expiration      time.Time // When assumed role credentials expire, zero for others
+}
+
+// externalCredentials is the JSON printed by an External profile's process_command
+type externalCredentials struct {
+	Mode            string `json:"mode"`
+	AccessKeyID     string `json:"access_key_id"`
+	AccessKeySecret string `json:"access_key_secret"`
+	StsToken        string `json:"sts_token"`
+}
+
+// resolveCredentials returns the keys of a profile, running process_command
+// for External profiles and assuming the role of RamRoleArn ones. Provider
+// modes resolve to empty keys. profiles are the config file's, where
+// source_profile is looked up
+func resolveCredentials(profiles []ConfigProfile, profile *ConfigProfile) (*Credentials, error) {
+	return resolveCredentialsDepth(profiles, profile, 0)
+}
+
+// resolveCredentialsDepth is resolveCredentials for a profile depth links
+// down a source_profile chain
+func resolveCredentialsDepth(profiles []ConfigProfile, profile *ConfigProfile, depth int) (*Credentials, error) {
+	if IsProviderMode(profile.Mode) {
+		return &Credentials{}, nil
+	}
+
+	switch profile.Mode {
+	case ModeRamRoleArn:
+		if profile.AccessKeyID == "" || profile.AccessKeySecret == "" {
+			return nil, fmt.Errorf("profile '%s' is missing access_key_id or access_key_secret", profile.Name)
+		}
+		return assumeRole(profile, &Credentials{
+			AccessKeyID:     profile.AccessKeyID,
+			AccessKeySecret: profile.AccessKeySecret,
+		})
+
+	case ModeChainableRamRoleArn:
+		if depth >= maxSourceProfileDepth {
+			return nil, fmt.Errorf("profile '%s': source_profile chain is longer than %d profiles", profile.Name, maxSourceProfileDepth)
+		}
+		source := findProfile(profiles, profile.SourceProfile)
+		if source == nil {
+			return nil, fmt.Errorf("profile '%s' has source_profile '%s', which is not found", profile.Name, profile.SourceProfile)
+		}
+		if IsProviderMode(source.Mode) {
+			return nil, fmt.Errorf("profile '%s' has source_profile '%s', whose %s credentials cannot assume a role", profile.Name, source.Name, source.Mode)
+		}
+		sourceCreds, err := resolveCredentialsDepth(profiles, source, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		return assumeRole(profile, sourceCreds)
+
+	case ModeExternal:
+		if profile.ProcessCommand == "" {
+			return nil, fmt.Errorf("profile '%s' has mode External but no process_command", profile.Name)
+		}
+		return runCredentialProcess(profile.ProcessCommand)
+
+	case ModeStsToken:
+		if profile.AccessKeyID == "" || profile.AccessKeySecret == "" || profile.StsToken == "" {
+			return nil, fmt.Errorf("profile '%s' is missing access_key_id, access_key_secret, or sts_token", profile.Name)
+		}
+		return &Credentials{
+			AccessKeyID:     profile.AccessKeyID,
+			AccessKeySecret: profile.AccessKeySecret,
+			SecurityToken:   profile.StsToken,
+		}, nil
+
+	default:
+		if profile.AccessKeyID == "" || profile.AccessKeySecret == "" {
+			return nil, fmt.Errorf("profile '%s' is missing access_key_id or access_key_secret", profile.Name)
+		}
+		return &Credentials{
+			AccessKeyID:     profile.AccessKeyID,
+			AccessKeySecret: profile.AccessKeySecret,
+		}, nil
+	}
+}
+
+// runCredentialProcess runs an External profile's command and parses the keys it prints
+func runCredentialProcess(command string) (*Credentials, error) {
+	out, err := exec.Command("sh", "-c", command).Output()
+	if err != nil {
+		return nil, fmt.Errorf("running credential process %q: %w", command, err)
+	}
+
+	var ext externalCredentials
+	if err := json.Unmarshal(out, &ext); err != nil {
+		return nil, fmt.Errorf("parsing output of credential process %q: %w", command, err)
+	}
+	if ext.AccessKeyID == "" || ext.AccessKeySecret == "" {
+		return nil, fmt.Errorf("credential process %q returned no access_key_id or access_key_secret", command)
+	}
+	if strings.EqualFold(ext.Mode, ModeStsToken) && ext.StsToken == "" {
+		return nil, fmt.Errorf("credential process %q returned mode StsToken without sts_token", command)
+	}
+
+	return &Credentials{
+		AccessKeyID:     ext.AccessKeyID,
+		AccessKeySecret: ext.AccessKeySecret,
+		SecurityToken:   ext.StsToken,
+	}, nil
+}
+
+// LoadProfileCredentials re-reads the config file and resolves fresh keys for a profile.
+// It is used to refresh temporary credentials that expired mid-session
+func LoadProfileCredentials(profileName string) (*Credentials, error) {
+	config, err := loadConfigFile()
+	if err != nil {
+		return nil, err
+	}
+
+	if profile := findProfile(config.Profiles, profileName); profile != nil {
+		return resolveCredentials(config.Profiles, profile)
+	}
+	return nil, fmt.Errorf("profile '%s' not found in aliyun config file", profileName)
+}
+
+// findProfile returns the named profile, or nil
+func findProfile(profiles []ConfigProfile, name string) *ConfigProfile {
+	for i := range profiles {
+		if profiles[i].Name == name {
+			return &profiles[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"time"
+)
+
+// maxRecentResources is how many viewed resources the recent history keeps
+const maxRecentResources = 50
+
+// RecentResource is a resource whose detail page was opened, with the
+// profile and region it was viewed in
+type RecentResource struct {
+	Type     string    `json:"type"` // Product, e.g. ECS or RDS
+	ID       string    `json:"id"`
+	Name     string    `json:"name,omitempty"`
+	Profile  string    `json:"profile"`
+	Region   string    `json:"region"`
+	ViewedAt time.Time `json:"viewed_at"`
+}
+
+// RecentHistory manages the recently viewed resources, newest first
+type RecentHistory struct {
+	Items []RecentResource `json:"items"`
+}
+
+// recentFilePath returns the path to the recent resources file
+func recentFilePath() string {
+	usr, err := user.Current()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(usr.HomeDir, ".aliyun", "alidash_recent.json")
+}
+
+// LoadRecentHistory loads the recently viewed resources from file
+func LoadRecentHistory() *RecentHistory {
+	h := &RecentHistory{Items: []RecentResource{}}
+
+	path := recentFilePath()
+	if path == "" {
+		return h
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return h
+	}
+
+	_ = json.Unmarshal(data, h)
+	return h
+}
+
+// Save saves the recently viewed resources to file
+func (h *RecentHistory) Save() error {
+	path := recentFilePath()
+	if path == "" {
+		return fmt.Errorf("could not determine recent history file path")
+	}
+
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+// Add puts a viewed resource first, replacing an earlier view of it in the
+// same profile and region
+func (h *RecentHistory) Add(item RecentResource) {
+	if item.ID == "" {
+		return
+	}
+
+	newItems := []RecentResource{item}
+	for _, existing := range h.Items {
+		if existing.Type != item.Type || existing.ID != item.ID || existing.Profile != item.Profile || existing.Region != item.Region {
+			newItems = append(newItems, existing)
+		}
+	}
+
+	if len(newItems) > maxRecentResources {
+		newItems = newItems[:maxRecentResources]
+	}
+
+	h.Items = newItems
+}
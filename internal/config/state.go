@@ -0,0 +1,75 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"time"
+)
+
+// State is what alidash remembers between runs on its own, kept apart from
+// config.json which only the user edits
+type State struct {
+	LastSeenVersion string   `json:"last_seen_version,omitempty"` // Newest release shown on What's New
+	Session         *Session `json:"session,omitempty"`           // Where the last run was left, for --resume
+
+	// Resource counts shown on the menu by the last run, by profile and region
+	ResourceCounts map[string]CountSnapshot `json:"resource_counts,omitempty"`
+
+	// Columns picked with the column chooser, by page
+	ColumnLayouts map[string]ColumnLayout `json:"column_layouts,omitempty"`
+}
+
+// Session is where alidash was left on quit: the profile, the region and
+// the pages open, restored by --resume
+type Session struct {
+	Profile  string          `json:"profile"`
+	Region   string          `json:"region"`
+	Pages    []string        `json:"pages,omitempty"`    // Workspace page names of the back stack, oldest first
+	Resource *RecentResource `json:"resource,omitempty"` // Detail page open on top of Pages, if any
+	SavedAt  time.Time       `json:"saved_at"`
+}
+
+// stateFilePath returns the path to the state file
+func stateFilePath() string {
+	usr, err := user.Current()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(usr.HomeDir, ".aliyun", "alidash_state.json")
+}
+
+// LoadState loads the state from file, empty when there is none yet
+func LoadState() *State {
+	s := &State{}
+	path := stateFilePath()
+	if path == "" {
+		return s
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return s
+	}
+	_ = json.Unmarshal(data, s)
+	return s
+}
+
+// Save saves the state to file
+func (s *State) Save() error {
+	path := stateFilePath()
+	if path == "" {
+		return fmt.Errorf("could not determine state file path")
+	}
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,76 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"time"
+)
+
+// UsageStats counts the pages opened and actions taken, kept on this machine
+// only and never uploaded
+type UsageStats struct {
+	Since   time.Time      `json:"since"`   // When counting started
+	Pages   map[string]int `json:"pages"`   // Opens by page name
+	Actions map[string]int `json:"actions"` // Uses by action name
+}
+
+// usageFilePath returns the path to the usage statistics file
+func usageFilePath() string {
+	usr, err := user.Current()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(usr.HomeDir, ".aliyun", "alidash_usage.json")
+}
+
+// LoadUsageStats loads the usage statistics from file, starting them now
+// when there are none yet
+func LoadUsageStats() *UsageStats {
+	s := &UsageStats{}
+	if path := usageFilePath(); path != "" {
+		if data, err := os.ReadFile(path); err == nil {
+			_ = json.Unmarshal(data, s)
+		}
+	}
+
+	if s.Since.IsZero() {
+		s.Since = time.Now()
+	}
+	if s.Pages == nil {
+		s.Pages = make(map[string]int)
+	}
+	if s.Actions == nil {
+		s.Actions = make(map[string]int)
+	}
+	return s
+}
+
+// Save saves the usage statistics to file
+func (s *UsageStats) Save() error {
+	path := usageFilePath()
+	if path == "" {
+		return fmt.Errorf("could not determine usage file path")
+	}
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// RecordPage counts an opening of a page
+func (s *UsageStats) RecordPage(name string) {
+	s.Pages[name]++
+}
+
+// RecordAction counts a use of an action
+func (s *UsageStats) RecordAction(name string) {
+	s.Actions[name]++
+}
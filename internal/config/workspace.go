@@ -0,0 +1,47 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Workspace is a named layout opened with --workspace: a profile, a region
+// and the pages to open, e.g.
+//
+//	{"name": "payments-prod", "profile": "payments", "region": "cn-shanghai", "pages": ["ecs", "slb"]}
+type Workspace struct {
+	Name    string   `json:"name"`
+	Profile string   `json:"profile,omitempty"` // Defaults to the current profile
+	Region  string   `json:"region,omitempty"`  // Defaults to the profile's region_id
+	Pages   []string `json:"pages,omitempty"`   // Opened in order; the last one is shown
+}
+
+// GetWorkspace returns the workspace with the given name
+func GetWorkspace(name string) (*Workspace, error) {
+	config, err := loadConfigFile()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for i := range config.Workspaces {
+		if config.Workspaces[i].Name == name {
+			return &config.Workspaces[i], nil
+		}
+		names = append(names, config.Workspaces[i].Name)
+	}
+
+	if len(names) == 0 {
+		return nil, fmt.Errorf("workspace '%s' not found: no workspaces defined in ~/.aliyun/config.json", name)
+	}
+	return nil, fmt.Errorf("workspace '%s' not found, available: %s", name, strings.Join(names, ", "))
+}
+
+// LoadConfig loads the workspace's profile with its region applied
+func (w *Workspace) LoadConfig() (*Config, error) {
+	cfg, err := LoadProfileConfig(w.Profile, w.Region)
+	if err != nil {
+		return nil, fmt.Errorf("workspace '%s': %w", w.Name, err)
+	}
+	return cfg, nil
+}
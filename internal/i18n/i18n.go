@@ -64,6 +64,7 @@ const (
 	// Header
 	KeyHeaderProfile = "header.profile"
 	KeyHeaderRegion  = "header.region"
+	KeyHeaderRole    = "header.role"
 
 	// Modal
 	KeyModalInfo          = "modal.info"
@@ -80,6 +81,8 @@ const (
 	KeyModalInputExample  = "modal.input_example"
 	KeyModalHistory       = "modal.history"
 	KeyModalCurrent       = "modal.current"
+	KeyModalNextField     = "modal.next_field"
+	KeyModalSubmit        = "modal.submit"
 
 	// Common columns
 	KeyColInstanceID   = "col.instance_id"
@@ -90,6 +93,8 @@ const (
 	KeyColPrivateIP    = "col.private_ip"
 	KeyColPublicIP     = "col.public_ip"
 	KeyColExpired      = "col.expired"
+	KeyColImageID      = "col.image_id"
+	KeyColOSName       = "col.os_name"
 	KeyColType         = "col.type"
 	KeyColDescription  = "col.description"
 	KeyColCreatedAt    = "col.created_at"
@@ -176,6 +181,8 @@ const (
 	KeyFinderRDS          = "finder.rds"
 	KeyFinderRedis        = "finder.redis"
 	KeyFinderRocketMQ     = "finder.rocketmq"
+	KeyFinderEIP          = "finder.eip"
+	KeyFinderNAT          = "finder.nat"
 
 	// ENI Types
 	KeyENIPrimary   = "eni.primary"
@@ -203,6 +210,7 @@ const (
 	KeySectionOtherInfo    = "section.other_info"
 	KeySectionUsageOverview = "section.usage_overview"
 	KeySectionStorageOverview = "section.storage_overview"
+	KeySectionZoomHint      = "section.zoom_hint"
 
 	// ECS Detail labels
 	KeyLabelInstanceID     = "label.instance_id"
@@ -278,10 +286,653 @@ const (
 	KeyCountENI            = "count.eni"
 	KeyCountDisks          = "count.disks"
 
+	// DNS record management
+	KeyDNSAddRecord     = "dns.add_record"
+	KeyDNSEditRecord    = "dns.edit_record"
+	KeyDNSConfirmDelete = "dns.confirm_delete"
+	KeyDNSRecordAdded   = "dns.record_added"
+	KeyDNSRecordUpdated = "dns.record_updated"
+	KeyDNSRecordDeleted = "dns.record_deleted"
+	KeyDNSRecordEnabled = "dns.record_enabled"
+	KeyDNSRecordPaused  = "dns.record_paused"
+	KeyDNSInvalidTTL    = "dns.invalid_ttl"
+	KeyDNSFieldRequired = "dns.field_required"
+	KeyLabelPriority    = "label.priority"
+
+	// OSS download
+	KeyOSSDownload     = "oss.download"
+	KeyOSSDownloadPath = "oss.download_path"
+	KeyOSSDownloaded   = "oss.downloaded"
+
+	// OSS preview
+	KeyPageOSSPreview      = "page.oss_preview"
+	KeyOSSPreviewTruncated = "oss.preview_truncated"
+	KeyOSSPreviewBinary    = "oss.preview_binary"
+
+	// All regions mode
+	KeyAllRegions        = "region.all"
+	KeyAllRegionsEnabled = "region.all_enabled"
+	KeyAllRegionsPartial = "region.all_partial"
+
+	// Background jobs
+	KeyPageJobs           = "page.jobs"
+	KeyColJob             = "col.job"
+	KeyColProgress        = "col.progress"
+	KeyColElapsed         = "col.elapsed"
+	KeyColJobError        = "col.job_error"
+	KeyJobStatusRunning   = "job.status_running"
+	KeyJobStatusDone      = "job.status_done"
+	KeyJobStatusFailed    = "job.status_failed"
+	KeyJobStatusCancelled = "job.status_cancelled"
+	KeyJobsRunning        = "job.running"
+	KeyJobCancelled       = "job.cancelled"
+	KeyJobDownload        = "job.download"
+	KeyJobFetchAllRegions = "job.fetch_all_regions"
+
+	// RAM
+	KeyMenuRAM             = "menu.ram"
+	KeyMenuRAMDesc         = "menu.ram_desc"
+	KeyPageRAMUsers        = "page.ram_users"
+	KeyPageRAMRoles        = "page.ram_roles"
+	KeyPageRAMPolicies     = "page.ram_policies"
+	KeyPageRAMUserPolicies = "page.ram_user_policies"
+	KeyPageRAMDetail       = "page.ram_detail"
+
+	// ECS metrics
+	KeyPageECSMetrics       = "page.ecs_metrics"
+	KeyMetricsNoData        = "metrics.no_data"
+	KeyMetricsAgentRequired = "metrics.agent_required"
+
+	// ECS spot and GPU instances
+	KeyColSpot               = "col.spot"
+	KeyColGPU                = "col.gpu"
+	KeySectionSpot           = "section.spot"
+	KeySectionGPU            = "section.gpu"
+	KeyLabelSpotStrategy     = "label.spot_strategy"
+	KeyLabelSpotPriceLimit   = "label.spot_price_limit"
+	KeyLabelSpotDuration     = "label.spot_duration"
+	KeyLabelSpotInterruption = "label.spot_interruption"
+	KeyLabelSpotStatus       = "label.spot_status"
+	KeyLabelGPUSpec          = "label.gpu_spec"
+	KeyLabelGPUAmount        = "label.gpu_amount"
+	KeySpotActive            = "spot.active"
+	KeySpotReclaiming        = "spot.reclaiming"
+	KeySpotAsPriceGo         = "spot.as_price_go"
+	KeySpotWithPriceLimit    = "spot.with_price_limit"
+	KeySpotNoProtection      = "spot.no_protection"
+	KeyFilterSpotOnly        = "filter.spot_only"
+	KeyFilterGPUOnly         = "filter.gpu_only"
+
+	// RDS metrics
+	KeyPageRDSMetrics = "page.rds_metrics"
+
+	// ECS right-sizing
+	KeySizingTitle       = "sizing.title"
+	KeySizingKeep        = "sizing.keep"
+	KeySizingDownsize    = "sizing.downsize"
+	KeySizingUpsize      = "sizing.upsize"
+	KeySizingNoSmaller   = "sizing.no_smaller"
+	KeySizingNoLarger    = "sizing.no_larger"
+	KeySizingNoHistory   = "sizing.no_history"
+	KeySizingUnavailable = "sizing.unavailable"
+	KeySizingLoading     = "sizing.loading"
+
+	// Log Service
+	KeyMenuSLS               = "menu.sls"
+	KeyMenuSLSDesc           = "menu.sls_desc"
+	KeyPageSLSProjects       = "page.sls_projects"
+	KeyPageSLSLogtailConfigs = "page.sls_logtail_configs"
+	KeyPageSLSMachineGroups  = "page.sls_machine_groups"
+	KeyPageSLSMachines       = "page.sls_machines"
+	KeyPageSLSCoverage       = "page.sls_coverage"
+	KeyPageSLSDetail         = "page.sls_detail"
+
+	// Security group rule management
+	KeySGAddRule           = "sg.add_rule"
+	KeySGEditRule          = "sg.edit_rule"
+	KeySGConfirmRevoke     = "sg.confirm_revoke"
+	KeySGRuleAdded         = "sg.rule_added"
+	KeySGRuleUpdated       = "sg.rule_updated"
+	KeySGRuleRevoked       = "sg.rule_revoked"
+	KeySGFieldRequired     = "sg.field_required"
+	KeySGInvalidDirection  = "sg.invalid_direction"
+	KeySGInvalidProtocol   = "sg.invalid_protocol"
+	KeySGInvalidPortRange  = "sg.invalid_port_range"
+	KeySGInvalidCIDR       = "sg.invalid_cidr"
+	KeySGInvalidPolicy     = "sg.invalid_policy"
+	KeySGInvalidPriority   = "sg.invalid_priority"
+	KeySGDirectionFixed    = "sg.direction_fixed"
+	KeySGGroupRuleReadOnly = "sg.group_rule_read_only"
+	KeyLabelCIDR           = "label.cidr"
+
+	// Cloud Config
+	KeyMenuConfig        = "menu.config"
+	KeyMenuConfigDesc    = "menu.config_desc"
+	KeyPageConfigRules   = "page.config_rules"
+	KeyPageConfigResults = "page.config_results"
+	KeyPageConfigDetail  = "page.config_detail"
+
+	// Resource tags
+	KeyMenuTags         = "menu.tags"
+	KeyMenuTagsDesc     = "menu.tags_desc"
+	KeyPageTagBrowser   = "page.tag_browser"
+	KeyPageTagResources = "page.tag_resources"
+	KeyPageTagDetail    = "page.tag_detail"
+	KeyTagFilter        = "tag.filter"
+	KeyTagFilterLabel   = "tag.filter_label"
+	KeyTagFilterInvalid = "tag.filter_invalid"
+
+	// Billing
+	KeyMenuBilling           = "menu.billing"
+	KeyMenuBillingDesc       = "menu.billing_desc"
+	KeyPageBilling           = "page.billing"
+	KeyPageBillingDetail     = "page.billing_detail"
+	KeyBillingLoading        = "billing.loading"
+	KeyBillingUnavailable    = "billing.unavailable"
+	KeyBillingCycle          = "billing.cycle"
+	KeyBillingMonthToDate    = "billing.month_to_date"
+	KeyBillingYesterday      = "billing.yesterday"
+	KeyBillingBalance        = "billing.balance"
+	KeyBillingBeforeDiscount = "billing.before_discount"
+	KeyBillingCost           = "billing.cost"
+
+	// Dangling DNS records
+	KeyPageDNSDangling    = "page.dns_dangling"
+	KeyJobScanDanglingDNS = "job.scan_dangling_dns"
+
+	// Instance RAM role
+	KeyPageRAMRolePolicies = "page.ram_role_policies"
+	KeyLabelRAMRole        = "label.ram_role"
+	KeyRAMRoleNone         = "ram_role.none"
+	KeyRAMRoleLoading      = "ram_role.loading"
+	KeyRAMRoleUnavailable  = "ram_role.unavailable"
+
+	// NAT gateways
+	KeyMenuNAT            = "menu.nat"
+	KeyMenuNATDesc        = "menu.nat_desc"
+	KeyPageNATList        = "page.nat_list"
+	KeyPageNATDetail      = "page.nat_detail"
+	KeyPageSNATEntries    = "page.snat_entries"
+	KeyPageDNATEntries    = "page.dnat_entries"
+	KeyDNATTargetNotFound = "nat.dnat_target_not_found"
+
+	// Finder EIP and NAT columns
+	KeyColAllocationID = "col.allocation_id"
+	KeyColBandwidth    = "col.bandwidth"
+	KeyColBoundTo      = "col.bound_to"
+	KeyColNATGatewayID = "col.nat_gateway_id"
+	KeyColVPC          = "col.vpc"
+
+	// OSS object versions
+	KeyPageOSSVersions    = "page.oss_versions"
+	KeyOSSDeleteMarker    = "oss.delete_marker"
+	KeyOSSVersionCurrent  = "oss.version_current"
+	KeyOSSConfirmRestore  = "oss.confirm_restore"
+	KeyOSSVersionRestored = "oss.version_restored"
+	KeyJobDownloadVersion = "job.download_version"
+
+	// ALB and NLB
+	KeyMenuALB             = "menu.alb"
+	KeyMenuALBDesc         = "menu.alb_desc"
+	KeyMenuNLB             = "menu.nlb"
+	KeyMenuNLBDesc         = "menu.nlb_desc"
+	KeyPageALBList         = "page.alb_list"
+	KeyPageALBDetail       = "page.alb_detail"
+	KeyPageALBListeners    = "page.alb_listeners"
+	KeyPageALBRules        = "page.alb_rules"
+	KeyPageALBRuleDetail   = "page.alb_rule_detail"
+	KeyPageALBServerGroups = "page.alb_server_groups"
+	KeyPageNLBList         = "page.nlb_list"
+	KeyPageNLBDetail       = "page.nlb_detail"
+	KeyPageNLBListeners    = "page.nlb_listeners"
+	KeyPageNLBServerGroups = "page.nlb_server_groups"
+	KeyPageLBServers       = "page.lb_servers"
+
+	// OSS bucket detail
+	KeyPageOSSBucketDetail = "page.oss_bucket_detail"
+
+	// ACK
+	KeyMenuACK                 = "menu.ack"
+	KeyMenuACKDesc             = "menu.ack_desc"
+	KeyPageACKClusters         = "page.ack_clusters"
+	KeyPageACKClusterDetail    = "page.ack_cluster_detail"
+	KeyPageACKNodePools        = "page.ack_node_pools"
+	KeyPageACKNodes            = "page.ack_nodes"
+	KeyACKNodeInstanceNotFound = "ack.node_instance_not_found"
+
+	// OSS object scans
+	KeyPageOSSScan       = "page.oss_scan"
+	KeyOSSPrefixSearch   = "oss.prefix_search"
+	KeyOSSScanPrefix     = "oss.scan_prefix"
+	KeyJobScanOSSObjects = "job.scan_oss_objects"
+
+	// OSS archive restore
+	KeyOSSRestoreArchive     = "oss.restore_archive"
+	KeyOSSRestoreDays        = "oss.restore_days"
+	KeyOSSRestoreTier        = "oss.restore_tier"
+	KeyOSSRestoreInvalidDays = "oss.restore_invalid_days"
+	KeyOSSRestoreInvalidTier = "oss.restore_invalid_tier"
+	KeyOSSRestoreStarted     = "oss.restore_started"
+	KeyOSSRestoreRequired    = "oss.restore_required"
+
+	// ACR
+	KeyMenuACR             = "menu.acr"
+	KeyMenuACRDesc         = "menu.acr_desc"
+	KeyPageACRInstances    = "page.acr_instances"
+	KeyPageACRNamespaces   = "page.acr_namespaces"
+	KeyPageACRRepositories = "page.acr_repositories"
+	KeyPageACRTags         = "page.acr_tags"
+	KeyPageACRTagDetail    = "page.acr_tag_detail"
+
+	// FC
+	KeyMenuFC               = "menu.fc"
+	KeyMenuFCDesc           = "menu.fc_desc"
+	KeyPageFCServices       = "page.fc_services"
+	KeyPageFCFunctions      = "page.fc_functions"
+	KeyPageFCFunctionDetail = "page.fc_function_detail"
+
+	// SLB detail
+	KeySectionNetwork        = "section.network"
+	KeySectionListeners      = "section.listeners"
+	KeySectionBilling        = "section.billing"
+	KeyLabelIPVersion        = "label.ip_version"
+	KeyLabelMasterZone       = "label.master_zone"
+	KeyLabelSlaveZone        = "label.slave_zone"
+	KeyLabelSpecCharge       = "label.spec_charge"
+	KeyLabelDeleteProtection = "label.delete_protection"
+	KeyLabelModProtection    = "label.mod_protection"
+	KeyLabelDefaultServers   = "label.default_servers"
+	KeySLBNoListeners        = "slb.no_listeners"
+	KeyDetailUnavailable     = "detail.unavailable"
+	KeyPageSLBJSONDetail     = "page.slb_json_detail"
+
+	// MongoDB
+	KeyMenuMongoDB           = "menu.mongodb"
+	KeyMenuMongoDBDesc       = "menu.mongodb_desc"
+	KeyPageMongoDBList       = "page.mongodb_list"
+	KeyPageMongoDBDetail     = "page.mongodb_detail"
+	KeyPageMongoDBJSONDetail = "page.mongodb_json_detail"
+	KeyPageMongoDBAccounts   = "page.mongodb_accounts"
+	KeySectionConnections    = "section.connections"
+	KeySectionReplicaSet     = "section.replica_set"
+	KeySectionMongos         = "section.mongos"
+	KeySectionShards         = "section.shards"
+	KeySectionConfigServers  = "section.config_servers"
+	KeyLabelArchitecture     = "label.architecture"
+	KeyLabelEngineVersion    = "label.engine_version"
+	KeyLabelStorageEngine    = "label.storage_engine"
+	KeyLabelStorage          = "label.storage"
+	KeyLabelMaxConnections   = "label.max_connections"
+	KeyLabelMaintenance      = "label.maintenance"
+	KeyMongoDBReplicaSet     = "mongodb.replica_set"
+	KeyMongoDBSharded        = "mongodb.sharded"
+	KeyMongoDBNoNodes        = "mongodb.no_nodes"
+
+	// Instance details
+	KeyPageRDSJSONDetail      = "page.rds_json_detail"
+	KeyPageRedisJSONDetail    = "page.redis_json_detail"
+	KeyPageRocketMQJSONDetail = "page.rocketmq_json_detail"
+	KeySectionSpec            = "section.spec"
+	KeySectionEndpoints       = "section.endpoints"
+	KeySectionWhitelist       = "section.whitelist"
+	KeySectionMaintenance     = "section.maintenance"
+	KeyLabelEdition           = "label.edition"
+	KeyLabelMaxIOPS           = "label.max_iops"
+	KeyLabelMinorUpgrade      = "label.minor_upgrade"
+	KeyLabelKernelVersion     = "label.kernel_version"
+	KeyLabelQPS               = "label.qps"
+	KeyLabelShards            = "label.shards"
+	KeyLabelMaxTPS            = "label.max_tps"
+	KeyLabelTopicCapacity     = "label.topic_capacity"
+	KeyLabelIndependentNaming = "label.independent_naming"
+	KeyLabelReleaseTime       = "label.release_time"
+
+	// Maintenance windows
+	KeyMaintenanceWindowTitle   = "maintenance.window_title"
+	KeyMaintenanceWindowChanged = "maintenance.window_changed"
+
+	// Elasticsearch
+	KeyMenuElasticsearch           = "menu.elasticsearch"
+	KeyMenuElasticsearchDesc       = "menu.elasticsearch_desc"
+	KeyPageElasticsearchList       = "page.elasticsearch_list"
+	KeyPageElasticsearchDetail     = "page.elasticsearch_detail"
+	KeyPageElasticsearchJSONDetail = "page.elasticsearch_json_detail"
+	KeyLabelDataNodes              = "label.data_nodes"
+	KeyLabelMasterNodes            = "label.master_nodes"
+	KeyLabelKibanaNodes            = "label.kibana_nodes"
+
+	// Zone capacity
+	KeyPageZoneCapacity  = "page.zone_capacity"
+	KeyCapacityAvailable = "capacity.available"
+	KeyCapacityLow       = "capacity.low"
+	KeyCapacitySoldOut   = "capacity.sold_out"
+
+	// Kafka
+	KeyMenuKafka             = "menu.kafka"
+	KeyMenuKafkaDesc         = "menu.kafka_desc"
+	KeyPageKafkaList         = "page.kafka_list"
+	KeyPageKafkaDetail       = "page.kafka_detail"
+	KeyPageKafkaJSONDetail   = "page.kafka_json_detail"
+	KeyPageKafkaTopics       = "page.kafka_topics"
+	KeyPageKafkaGroups       = "page.kafka_groups"
+	KeyLabelTrafficSpec      = "label.traffic_spec"
+	KeyLabelMessageRetention = "label.message_retention"
+	KeyLabelPartitions       = "label.partitions"
+	KeyLabelConsumerGroups   = "label.consumer_groups"
+
+	// Finder across profiles
+	KeyFinderProfilesTotal   = "finder.profiles_total"
+	KeyFinderProfilesNoMatch = "finder.profiles_no_match"
+	KeyFinderProfileFailed   = "finder.profile_failed"
+	KeyColErrorMessage       = "col.error_message"
+
+	// RocketMQ lag and messages
+	KeyPageRocketMQLag      = "page.rocketmq_lag"
+	KeyPageRocketMQMessages = "page.rocketmq_messages"
+
+	// RocketMQ 5.x
+	KeyLabelAPIVersion = "label.api_version"
+
+	// Empty list state
+	KeyEmptyStateTitle       = "empty_state.title"
+	KeyEmptyStateCounting    = "empty_state.counting"
+	KeyEmptyStateCountFailed = "empty_state.count_failed"
+	KeyEmptyStateNoOthers    = "empty_state.no_others"
+	KeyEmptyStateOthers      = "empty_state.others"
+	KeyEmptyStateJump        = "empty_state.jump"
+	KeyEmptyStateCached      = "empty_state.cached"
+
+	// Redis parameters and performance
+	KeyPageRedisParameters = "page.redis_parameters"
+	KeyPageRedisMetrics    = "page.redis_metrics"
+
+	// Recycle bin
+	KeyMenuRecycleBin          = "menu.recycle_bin"
+	KeyMenuRecycleBinDesc      = "menu.recycle_bin_desc"
+	KeyPageRecycleBin          = "page.recycle_bin"
+	KeyPageOSSDeleted          = "page.oss_deleted"
+	KeyRecyclePartial          = "recycle.partial"
+	KeyRecycleConfirmCancel    = "recycle.confirm_cancel"
+	KeyRecycleReleaseCancelled = "recycle.release_cancelled"
+	KeyRecycleRenewInConsole   = "recycle.renew_in_console"
+	KeyOSSDeletedTruncated     = "oss.deleted_truncated"
+	KeyOSSConfirmUndelete      = "oss.confirm_undelete"
+	KeyOSSUndeleted            = "oss.undeleted"
+
+	// Redis backups
+	KeyPageRedisBackups = "page.redis_backups"
+
+	// RDS backups
+	KeyPageRDSBackups = "page.rds_backups"
+	KeyPageRDSBinlogs = "page.rds_binlogs"
+
+	// Connection test
+	KeyConnTestTitle         = "conn_test.title"
+	KeyConnTestAddress       = "conn_test.address"
+	KeyConnTestUsername      = "conn_test.username"
+	KeyConnTestPassword      = "conn_test.password"
+	KeyConnTestNoAddress     = "conn_test.no_address"
+	KeyConnTestConnected     = "conn_test.connected"
+	KeyConnTestServer        = "conn_test.server"
+	KeyConnTestLoggedIn      = "conn_test.logged_in"
+	KeyConnTestNotLoggedIn   = "conn_test.not_logged_in"
+	KeyConnTestConnectFailed = "conn_test.connect_failed"
+	KeyConnTestAuthFailed    = "conn_test.auth_failed"
+
+	// RDS slow log
+	KeyPageRDSSlowLog       = "page.rds_slow_log"
+	KeyRDSSlowLogRangeTitle = "rds.slow_log_range_title"
+	KeyRDSSlowLogRangeDays  = "rds.slow_log_range_days"
+	KeyRDSSlowLogRangeToday = "rds.slow_log_range_today"
+
+	// Quick connect
+	KeyConnectTitle     = "connect.title"
+	KeyConnectAccount   = "connect.account"
+	KeyConnectNoAccount = "connect.no_account"
+	KeyConnectNoClient  = "connect.no_client"
+
+	// Latency probe
+	KeyProbeTitle   = "probe.title"
+	KeyProbeHost    = "probe.host"
+	KeyProbePort    = "probe.port"
+	KeyProbeNoHost  = "probe.no_host"
+	KeyProbeBadPort = "probe.bad_port"
+	KeyProbeSummary = "probe.summary"
+	KeyProbeStats   = "probe.stats"
+	KeyProbeFailed  = "probe.failed"
+	KeyProbeNoReply = "probe.no_reply"
+
+	// SSH port forward
+	KeyPortForwardBastion     = "port_forward.bastion"
+	KeyPortForwardNoBastion   = "port_forward.no_bastion"
+	KeyPortForwardTitle       = "port_forward.title"
+	KeyPortForwardLocalPort   = "port_forward.local_port"
+	KeyPortForwardEndpoint    = "port_forward.endpoint"
+	KeyPortForwardBastionHost = "port_forward.bastion_host"
+	KeyPortForwardBadPort     = "port_forward.bad_port"
+	KeyPortForwardBadBastion  = "port_forward.bad_bastion"
+	KeyPortForwardPortBusy    = "port_forward.port_busy"
+	KeyPortForwardNoSSH       = "port_forward.no_ssh"
+	KeyPortForwardStarted     = "port_forward.started"
+	KeyJobTunnel              = "job.tunnel"
+
+	// What's New
+	KeyPageChangelog     = "page.changelog"
+	KeyChangelogSince    = "changelog.since"
+	KeyChangelogAll      = "changelog.all"
+	KeyMenuChangelog     = "menu.changelog"
+	KeyMenuChangelogDesc = "menu.changelog_desc"
+
+	// Recent Resources
+	KeyPageRecent         = "page.recent"
+	KeyMenuRecent         = "menu.recent"
+	KeyMenuRecentDesc     = "menu.recent_desc"
+	KeyRecentPick         = "recent.pick"
+	KeyRecentEmpty        = "recent.empty"
+	KeyRecentSwitchFailed = "recent.switch_failed"
+	KeyRecentGone         = "recent.gone"
+
+	// Usage Statistics
+	KeyPageUsage     = "page.usage"
+	KeyUsageTitle    = "usage.title"
+	KeyMenuUsage     = "menu.usage"
+	KeyMenuUsageDesc = "menu.usage_desc"
+
+	// OSS copy and move
+	KeyOSSCopy               = "oss.copy"
+	KeyOSSMove               = "oss.move"
+	KeyOSSCopyBucket         = "oss.copy_bucket"
+	KeyOSSCopyPrefix         = "oss.copy_prefix"
+	KeyOSSCopyWhatKeys       = "oss.copy_what_keys"
+	KeyOSSCopyWhatFolder     = "oss.copy_what_folder"
+	KeyOSSCopyBucketRequired = "oss.copy_bucket_required"
+	KeyJobOSSCopy            = "job.oss_copy"
+	KeyJobOSSMove            = "job.oss_move"
+	KeyOSSCopied             = "oss.copied"
+	KeyOSSMoved              = "oss.moved"
+	KeyOSSCopyCrossRegion    = "oss.copy_cross_region"
+	KeyOSSCopyFailed         = "oss.copy_failed"
+	KeyOSSCopyStopped        = "oss.copy_stopped"
+	KeyOSSMoveStopped        = "oss.move_stopped"
+
+	// DNS import
+	KeyPageDNSImport         = "page.dns_import"
+	KeyDNSImport             = "dns.import"
+	KeyDNSImportPath         = "dns.import_path"
+	KeyDNSImportTitle        = "dns.import_title"
+	KeyDNSImportAppliedTitle = "dns.import_applied_title"
+	KeyDNSImportInvalid      = "dns.import_invalid"
+	KeyDNSImportNothing      = "dns.import_nothing"
+	KeyDNSImportConfirm      = "dns.import_confirm"
+	KeyDNSImportDone         = "dns.import_done"
+	KeyDNSImportFailed       = "dns.import_failed"
+	KeyDNSImportCancelled    = "dns.import_cancelled"
+	KeyJobDNSImportPlan      = "job.dns_import_plan"
+	KeyJobDNSImportApply     = "job.dns_import_apply"
+
+	// SLB backends
+	KeySLBBackendNoneMarked   = "slb.backend_none_marked"
+	KeySLBBackendNoSLB        = "slb.backend_no_slb"
+	KeySLBBackendPickSLB      = "slb.backend_pick_slb"
+	KeySLBBackendPickGroup    = "slb.backend_pick_group"
+	KeySLBBackendNewGroup     = "slb.backend_new_group"
+	KeySLBBackendAddTitle     = "slb.backend_add_title"
+	KeySLBBackendCreateTitle  = "slb.backend_create_title"
+	KeySLBBackendGroupName    = "slb.backend_group_name"
+	KeySLBBackendPort         = "slb.backend_port"
+	KeySLBBackendWeight       = "slb.backend_weight"
+	KeySLBBackendNameRequired = "slb.backend_name_required"
+	KeySLBBackendBadPort      = "slb.backend_bad_port"
+	KeySLBBackendBadWeight    = "slb.backend_bad_weight"
+	KeySLBBackendsAdded       = "slb.backends_added"
+	KeySLBBackendGroupCreated = "slb.backend_group_created"
+
+	// SLB certificates
+	KeySLBCertHTTPSOnly    = "slb.cert_https_only"
+	KeySLBCertPick         = "slb.cert_pick"
+	KeySLBCertUploadOption = "slb.cert_upload_option"
+	KeySLBCertCurrent      = "slb.cert_current"
+	KeySLBCertExpires      = "slb.cert_expires"
+	KeySLBCertExpiresSoon  = "slb.cert_expires_soon"
+	KeySLBCertExpired      = "slb.cert_expired"
+	KeySLBCertUploadTitle  = "slb.cert_upload_title"
+	KeySLBCertName         = "slb.cert_name"
+	KeySLBCertNameHint     = "slb.cert_name_hint"
+	KeySLBCertCertificate  = "slb.cert_certificate"
+	KeySLBCertPrivateKey   = "slb.cert_private_key"
+	KeySLBCertPEMHint      = "slb.cert_pem_hint"
+	KeySLBCertBindConfirm  = "slb.cert_bind_confirm"
+	KeySLBCertBound        = "slb.cert_bound"
+
+	// Read-only mode
+	KeyHeaderReadOnly  = "header.read_only"
+	KeyReadOnlyRefused = "readonly.refused"
+
+	// Prefetching
+	KeyPrefetchStale         = "prefetch.stale"
+	KeyPrefetchRefreshFailed = "prefetch.refresh_failed"
+
+	// Cache status
+	KeyCacheAge = "cache.age"
+
+	// ECS paging
+	KeyECSLoadProgress        = "ecs.load_progress"
+	KeyECSLoadProgressUnknown = "ecs.load_progress_unknown"
+
+	// Resource finder progress
+	KeyJobFind               = "job.find"
+	KeyFinderSearching       = "finder.searching"
+	KeyFinderProgressPages   = "finder.progress_pages"
+	KeyFinderProgressDomains = "finder.progress_domains"
+	KeyFinderCancelHint      = "finder.cancel_hint"
+
+	// API retries
+	KeyActionRetrying = "action.retrying"
+
+	// API trace
+	KeyPageAPITrace      = "page.api_trace"
+	KeyAPITraceTitle     = "api_trace.title"
+	KeyColTraceTime      = "col.trace_time"
+	KeyColTraceAction    = "col.trace_action"
+	KeyColTraceHost      = "col.trace_host"
+	KeyColTraceLatency   = "col.trace_latency"
+	KeyColTraceAttempts  = "col.trace_attempts"
+	KeyColTraceRequestID = "col.trace_request_id"
+	KeyColTraceError     = "col.trace_error"
+
+	// Permissions
+	KeyNoPermission    = "no_permission"
+	KeyFinderDenied    = "finder_denied"
+	KeyNoPermissionFor = "no_permission_for"
+
+	// Help overlay
+	KeyHelpTitle      = "help_title"
+	KeyHelpNavigation = "help_navigation"
+	KeyHelpSearch     = "help_search"
+	KeyHelpGoTo       = "help_go_to"
+	KeyHelpGeneral    = "help_general"
+	KeyHelpPage       = "help_page"
+	KeyHelpTable      = "help_table"
+	KeyHelpViewer     = "help_viewer"
+	KeyHelpHint       = "help_hint"
+	KeyHelpNoMatch    = "help_no_match"
+
+	// Split view
+	KeySplitPreview = "split_preview"
+	KeySplitNothing = "split_nothing"
+
+	// Tabs
+	KeyHelpTabs = "help_tabs"
+
+	// Remaining literals
+	KeyColHealthCheck  = "col.health_check"
+	KeyColScheduler    = "col.scheduler"
+	KeyColCertificate  = "col.certificate"
+	KeyDiskLocalSSD    = "disk.local_ssd"
+	KeyTableRowsOf     = "table.rows_of"
+	KeyTableFilterInfo = "table.filter_info"
+	KeySearchInfo      = "search.info"
+	KeyViewportHelp    = "viewport.help"
+	KeyOSSObjectsTitle = "oss.objects_title"
+	KeyOSSPage         = "oss.page"
+	KeyOSSPrevPage     = "oss.prev_page"
+	KeyOSSNextPage     = "oss.next_page"
+	KeyOSSFirstPage    = "oss.first_page"
+	KeyProfileSwitched = "profile.switched"
+	KeyUnknownPage     = "page.unknown"
+
+	// Timestamps
+	KeyTimeAgo     = "time.ago"
+	KeyTimeIn      = "time.in"
+	KeyTimeJustNow = "time.just_now"
+	KeyTimeMinutes = "time.minutes"
+	KeyTimeHours   = "time.hours"
+	KeyTimeDays    = "time.days"
+	KeyTimeMonths  = "time.months"
+	KeyTimeYears   = "time.years"
+
+	// Table selection
+	KeyTableSelectedTitle = "table.selected_title"
+	KeyTableSelectionInfo = "table.selection_info"
+	KeyTableRangeInfo     = "table.range_info"
+
+	// ECS batch copy
+	KeyECSCopyTitle      = "ecs.copy_title"
+	KeyECSCopyIDs        = "ecs.copy_ids"
+	KeyECSCopyPrivateIPs = "ecs.copy_private_ips"
+	KeyECSCopyPublicIPs  = "ecs.copy_public_ips"
+	KeyECSCopyNone       = "ecs.copy_none"
+
+	// Column chooser
+	KeyColumnsTitle      = "columns.title"
+	KeyColumnsHint       = "columns.hint"
+	KeyColumnsSaveFailed = "columns.save_failed"
+
+	// Finder match reasons
+	KeyFinderMatchedBy    = "finder.matched_by"
+	KeyFinderMatchIP      = "finder.match_ip"
+	KeyFinderMatchAddress = "finder.match_address"
+	KeyFinderMatchID      = "finder.match_id"
+	KeyFinderMatchName    = "finder.match_name"
+	KeyFinderMatchTag     = "finder.match_tag"
+
+	// DNS propagation check
+	KeyDNSPropagationTitle    = "dns.propagation_title"
+	KeyDNSPropagationExpected = "dns.propagation_expected"
+	KeyDNSPropagationMatch    = "dns.propagation_match"
+	KeyDNSPropagationStale    = "dns.propagation_stale"
+	KeyDNSPropagationMissing  = "dns.propagation_missing"
+	KeyDNSPropagationFailed   = "dns.propagation_failed"
+	KeyDNSPropagationAll      = "dns.propagation_all"
+	KeyDNSPropagationSome     = "dns.propagation_some"
+	KeyDNSPropagationPaused   = "dns.propagation_paused"
+	KeyDNSPropagationLine     = "dns.propagation_line"
+
 	// Common actions
-	KeyActionCopied   = "action.copied"
-	KeyActionLoading  = "action.loading"
-	KeyNoData         = "common.no_data"
+	KeyActionCopied        = "action.copied"
+	KeyActionOpenedBrowser = "action.opened_browser"
+	KeyActionLoading       = "action.loading"
+	KeyNoData              = "common.no_data"
 )
 
 // Region display names (Chinese)
@@ -433,6 +1084,7 @@ var enUS = map[string]string{
 	// Header
 	KeyHeaderProfile: "Profile",
 	KeyHeaderRegion:  "Region",
+	KeyHeaderRole:    "Role",
 
 	// Modal
 	KeyModalInfo:          "Info",
@@ -445,10 +1097,12 @@ var enUS = map[string]string{
 	KeyModalSelectRegion:  "Select Region",
 	KeyModalLoading:       "Loading regions with resources...",
 	KeyModalResourceFind:  "Resource Finder",
-	KeyModalInputPrompt:   "Enter IP address or domain:",
-	KeyModalInputExample:  "e.g.: 192.168.1.1 or example.com",
+	KeyModalInputPrompt:   "Enter IP, domain, resource ID, name or tag=key:value:",
+	KeyModalInputExample:  "e.g.: 192.168.1.1, example.com, i-bp1abc2def3ghi4jkl or tag=env:prod",
 	KeyModalHistory:       "History",
 	KeyModalCurrent:       "current",
+	KeyModalNextField:     "Next Field",
+	KeyModalSubmit:        "Submit",
 
 	// Common columns
 	KeyColInstanceID:   "Instance ID",
@@ -459,6 +1113,8 @@ var enUS = map[string]string{
 	KeyColPrivateIP:    "Private IP",
 	KeyColPublicIP:     "Public IP",
 	KeyColExpired:      "Expired",
+	KeyColImageID:      "Image ID",
+	KeyColOSName:       "OS",
 	KeyColType:         "Type",
 	KeyColDescription:  "Description",
 	KeyColCreatedAt:    "Created At",
@@ -545,6 +1201,8 @@ var enUS = map[string]string{
 	KeyFinderRDS:          "RDS Instances",
 	KeyFinderRedis:        "Redis Instances",
 	KeyFinderRocketMQ:     "RocketMQ Instances",
+	KeyFinderEIP:          "EIP",
+	KeyFinderNAT:          "NAT Gateway",
 
 	// ENI Types
 	KeyENIPrimary:   "Primary",
@@ -572,6 +1230,7 @@ var enUS = map[string]string{
 	KeySectionOtherInfo:      "Other Information",
 	KeySectionUsageOverview:  "Usage Overview",
 	KeySectionStorageOverview: "Storage Overview",
+	KeySectionZoomHint:       "z: show all sections",
 
 	// ECS Detail labels
 	KeyLabelInstanceID:      "Instance ID",
@@ -647,10 +1306,653 @@ var enUS = map[string]string{
 	KeyCountENI:   "%d ENIs",
 	KeyCountDisks: "%d disks",
 
+	// DNS record management
+	KeyDNSAddRecord:     "Add DNS Record",
+	KeyDNSEditRecord:    "Edit DNS Record",
+	KeyDNSConfirmDelete: "Delete record %s (%s → %s)?",
+	KeyDNSRecordAdded:   "Record %s added",
+	KeyDNSRecordUpdated: "Record %s updated",
+	KeyDNSRecordDeleted: "Record %s deleted",
+	KeyDNSRecordEnabled: "Record %s enabled",
+	KeyDNSRecordPaused:  "Record %s paused",
+	KeyDNSInvalidTTL:    "Invalid TTL: %s",
+	KeyDNSFieldRequired: "Host record, type and value are required",
+	KeyLabelPriority:    "MX Priority",
+
+	// OSS download
+	KeyOSSDownload:     "Download Object",
+	KeyOSSDownloadPath: "Save to",
+	KeyOSSDownloaded:   "Downloaded %s to %s",
+
+	// OSS preview
+	KeyPageOSSPreview:      "OSS Object Preview",
+	KeyOSSPreviewTruncated: "showing first %s",
+	KeyOSSPreviewBinary:    "%s does not look like text; preview is only available for text content",
+
+	// All regions mode
+	KeyAllRegions:        "All Regions",
+	KeyAllRegionsEnabled: "Listing resources across all regions with resources",
+	KeyAllRegionsPartial: "Some regions failed to load:\n%s",
+
+	// Background jobs
+	KeyPageJobs:           "Background Jobs",
+	KeyColJob:             "Job",
+	KeyColProgress:        "Progress",
+	KeyColElapsed:         "Elapsed",
+	KeyColJobError:        "Error",
+	KeyJobStatusRunning:   "Running",
+	KeyJobStatusDone:      "Done",
+	KeyJobStatusFailed:    "Failed",
+	KeyJobStatusCancelled: "Cancelled",
+	KeyJobsRunning:        "%d jobs running",
+	KeyJobCancelled:       "Cancelled: %s",
+	KeyJobDownload:        "Download oss://%s/%s",
+	KeyJobFetchAllRegions: "Fetch %s from all regions",
+
+	// RAM
+	KeyMenuRAM:             "(a) RAM Identities",
+	KeyMenuRAMDesc:         "Audit RAM users, roles and policies",
+	KeyPageRAMUsers:        "RAM Users",
+	KeyPageRAMRoles:        "RAM Roles",
+	KeyPageRAMPolicies:     "RAM Policies",
+	KeyPageRAMUserPolicies: "RAM User Policies",
+	KeyPageRAMDetail:       "RAM Detail",
+
+	// ECS metrics
+	KeyPageECSMetrics:       "ECS Metrics",
+	KeyMetricsNoData:        "No data points in this window",
+	KeyMetricsAgentRequired: "memory metrics need the CloudMonitor agent on the instance",
+
+	// ECS spot and GPU instances
+	KeyColSpot:               "Spot",
+	KeyColGPU:                "GPU",
+	KeySectionSpot:           "Spot Instance",
+	KeySectionGPU:            "GPU",
+	KeyLabelSpotStrategy:     "Bidding Strategy",
+	KeyLabelSpotPriceLimit:   "Price Limit",
+	KeyLabelSpotDuration:     "Protection Period",
+	KeyLabelSpotInterruption: "Interruption Behavior",
+	KeyLabelSpotStatus:       "Interruption Status",
+	KeyLabelGPUSpec:          "GPU Type",
+	KeyLabelGPUAmount:        "GPU Count",
+	KeySpotActive:            "Active",
+	KeySpotReclaiming:        "Reclaiming",
+	KeySpotAsPriceGo:         "Market price",
+	KeySpotWithPriceLimit:    "Price limit",
+	KeySpotNoProtection:      "None",
+	KeyFilterSpotOnly:        "Spot only",
+	KeyFilterGPUOnly:         "GPU only",
+
+	// RDS metrics
+	KeyPageRDSMetrics: "RDS Metrics",
+
+	// ECS right-sizing
+	KeySizingTitle:       "Right-sizing",
+	KeySizingKeep:        "the instance type fits its load",
+	KeySizingDownsize:    "consider downsizing to %s",
+	KeySizingUpsize:      "consider upsizing to %s",
+	KeySizingNoSmaller:   "no smaller type in family %s",
+	KeySizingNoLarger:    "no larger type in family %s",
+	KeySizingNoHistory:   "No CPU history to base a recommendation on",
+	KeySizingUnavailable: "Recommendation unavailable",
+	KeySizingLoading:     "Analyzing CPU history...",
+
+	// Log Service
+	KeyMenuSLS:               "(l) Log Service",
+	KeyMenuSLSDesc:           "Diagnose Logtail configs, machine groups and ECS coverage",
+	KeyPageSLSProjects:       "Log Service Projects",
+	KeyPageSLSLogtailConfigs: "Logtail Configs",
+	KeyPageSLSMachineGroups:  "Machine Groups",
+	KeyPageSLSMachines:       "Machine Group Machines",
+	KeyPageSLSCoverage:       "Logtail ECS Coverage",
+	KeyPageSLSDetail:         "Log Service Detail",
+
+	// Security group rule management
+	KeySGAddRule:           "Add Security Group Rule",
+	KeySGEditRule:          "Edit Security Group Rule",
+	KeySGConfirmRevoke:     "Revoke %s rule %s %s %s (%s)?",
+	KeySGRuleAdded:         "Rule %s %s added to %s",
+	KeySGRuleUpdated:       "Rule %s of %s updated",
+	KeySGRuleRevoked:       "Rule %s %s revoked from %s",
+	KeySGFieldRequired:     "Direction, protocol and CIDR are required",
+	KeySGInvalidDirection:  "Direction must be ingress or egress: %s",
+	KeySGInvalidProtocol:   "Protocol must be TCP, UDP, ICMP, GRE or ALL: %s",
+	KeySGInvalidPortRange:  "Invalid port range: %s (use start/end, e.g. 22/22)",
+	KeySGInvalidCIDR:       "Invalid CIDR or IP: %s",
+	KeySGInvalidPolicy:     "Policy must be accept or drop: %s",
+	KeySGInvalidPriority:   "Priority must be between 1 and 100: %s",
+	KeySGDirectionFixed:    "The direction of an existing rule cannot be changed",
+	KeySGGroupRuleReadOnly: "Rules that reference a security group or prefix list can only be revoked",
+	KeyLabelCIDR:           "CIDR",
+
+	// Cloud Config
+	KeyMenuConfig:        "(c) Cloud Config",
+	KeyMenuConfigDesc:    "Review compliance rules and non-compliant resources",
+	KeyPageConfigRules:   "Cloud Config Rules",
+	KeyPageConfigResults: "Non-compliant Resources",
+	KeyPageConfigDetail:  "Cloud Config Detail",
+
+	// Resource tags
+	KeyMenuTags:         "(t) Tags",
+	KeyMenuTagsDesc:     "Browse tag keys and values and the resources carrying them",
+	KeyPageTagBrowser:   "Resource Tags",
+	KeyPageTagResources: "Tagged Resources",
+	KeyPageTagDetail:    "Tagged Resource Detail",
+	KeyTagFilter:        "Filter by tag",
+	KeyTagFilterLabel:   "Tag (key=value, empty to clear)",
+	KeyTagFilterInvalid: "Invalid tag filter %q, expected key=value",
+
+	// Billing
+	KeyMenuBilling:           "($) Billing",
+	KeyMenuBillingDesc:       "Balance, month-to-date spend per product and yesterday's spend",
+	KeyPageBilling:           "Billing",
+	KeyPageBillingDetail:     "Billing Detail",
+	KeyBillingLoading:        "Loading billing...",
+	KeyBillingUnavailable:    "Cost unavailable",
+	KeyBillingCycle:          "Cycle",
+	KeyBillingMonthToDate:    "Month to date",
+	KeyBillingYesterday:      "Yesterday",
+	KeyBillingBalance:        "Balance",
+	KeyBillingBeforeDiscount: "before discounts",
+	KeyBillingCost:           "Cost",
+
+	// Dangling DNS records
+	KeyPageDNSDangling:    "Dangling DNS Records",
+	KeyJobScanDanglingDNS: "Scan DNS records for dangling targets",
+
+	// Instance RAM role
+	KeyPageRAMRolePolicies: "RAM Role Policies",
+	KeyLabelRAMRole:        "RAM Role",
+	KeyRAMRoleNone:         "None",
+	KeyRAMRoleLoading:      "Loading...",
+	KeyRAMRoleUnavailable:  "Role unavailable",
+
+	// NAT gateways
+	KeyMenuNAT:            "(n) NAT Gateways",
+	KeyMenuNATDesc:        "NAT gateways with their SNAT and DNAT entries",
+	KeyPageNATList:        "NAT Gateways",
+	KeyPageNATDetail:      "NAT Gateway Detail",
+	KeyPageSNATEntries:    "SNAT Entries",
+	KeyPageDNATEntries:    "DNAT Entries",
+	KeyDNATTargetNotFound: "No ECS instance has private IP %s in %s",
+
+	// Finder EIP and NAT columns
+	KeyColAllocationID: "Allocation ID",
+	KeyColBandwidth:    "Bandwidth",
+	KeyColBoundTo:      "Bound To",
+	KeyColNATGatewayID: "NAT Gateway ID",
+	KeyColVPC:          "VPC",
+
+	// OSS object versions
+	KeyPageOSSVersions:    "OSS Object Versions",
+	KeyOSSDeleteMarker:    "Delete marker",
+	KeyOSSVersionCurrent:  "Current",
+	KeyOSSConfirmRestore:  "Restore oss://%s/%s to version %s from %s? The current content is kept as a previous version.",
+	KeyOSSVersionRestored: "Restored %s to version %s",
+	KeyJobDownloadVersion: "Download oss://%s/%s (version %s)",
+
+	// ALB and NLB
+	KeyMenuALB:             "(p) ALB",
+	KeyMenuALBDesc:         "Application Load Balancers with listeners, rules and server groups",
+	KeyMenuNLB:             "(w) NLB",
+	KeyMenuNLBDesc:         "Network Load Balancers with listeners and server groups",
+	KeyPageALBList:         "Application Load Balancers",
+	KeyPageALBDetail:       "ALB Detail",
+	KeyPageALBListeners:    "ALB Listeners",
+	KeyPageALBRules:        "ALB Forwarding Rules",
+	KeyPageALBRuleDetail:   "ALB Rule Detail",
+	KeyPageALBServerGroups: "ALB Server Groups",
+	KeyPageNLBList:         "Network Load Balancers",
+	KeyPageNLBDetail:       "NLB Detail",
+	KeyPageNLBListeners:    "NLB Listeners",
+	KeyPageNLBServerGroups: "NLB Server Groups",
+	KeyPageLBServers:       "Backend Servers",
+
+	// OSS bucket detail
+	KeyPageOSSBucketDetail: "OSS Bucket Detail",
+
+	// ACK
+	KeyMenuACK:                 "(K) ACK Clusters",
+	KeyMenuACKDesc:             "Kubernetes clusters with their node pools and nodes",
+	KeyPageACKClusters:         "ACK Clusters",
+	KeyPageACKClusterDetail:    "ACK Cluster Detail",
+	KeyPageACKNodePools:        "ACK Node Pools",
+	KeyPageACKNodes:            "ACK Nodes",
+	KeyACKNodeInstanceNotFound: "ECS instance %s not found in %s",
+
+	// OSS object scans
+	KeyPageOSSScan:       "OSS Object Scan",
+	KeyOSSPrefixSearch:   "Prefix Search - oss://%s/",
+	KeyOSSScanPrefix:     "Key prefix",
+	KeyJobScanOSSObjects: "Scan objects in %s",
+
+	// OSS archive restore
+	KeyOSSRestoreArchive:     "Restore - oss://%s/%s (%s)",
+	KeyOSSRestoreDays:        "Days to keep the restored copy (1-%d)",
+	KeyOSSRestoreTier:        "Tier (%s)",
+	KeyOSSRestoreInvalidDays: "Days must be a number from 1 to %d",
+	KeyOSSRestoreInvalidTier: "Tier must be one of %s",
+	KeyOSSRestoreStarted:     "Restoring %s. It can be downloaded once the Restore column shows Restored",
+	KeyOSSRestoreRequired:    "%[1]s is in the %[2]s storage class (%[3]s). Press r to restore it before downloading",
+
+	// ACR
+	KeyMenuACR:             "(C) Container Registry",
+	KeyMenuACRDesc:         "ACR Enterprise Edition namespaces, repositories and image tags",
+	KeyPageACRInstances:    "ACR Instances",
+	KeyPageACRNamespaces:   "ACR Namespaces",
+	KeyPageACRRepositories: "ACR Repositories",
+	KeyPageACRTags:         "ACR Image Tags",
+	KeyPageACRTagDetail:    "ACR Image Tag Detail",
+
+	// FC
+	KeyMenuFC:               "(u) Function Compute",
+	KeyMenuFCDesc:           "FC services and functions with 24h invocation and error counts",
+	KeyPageFCServices:       "FC Services",
+	KeyPageFCFunctions:      "FC Functions",
+	KeyPageFCFunctionDetail: "FC Function Detail",
+
+	// SLB detail
+	KeySectionNetwork:        "Network",
+	KeySectionListeners:      "Listeners",
+	KeySectionBilling:        "Billing",
+	KeyLabelIPVersion:        "IP Version",
+	KeyLabelMasterZone:       "Primary Zone",
+	KeyLabelSlaveZone:        "Secondary Zone",
+	KeyLabelSpecCharge:       "Instance Billing",
+	KeyLabelDeleteProtection: "Deletion Protection",
+	KeyLabelModProtection:    "Change Protection",
+	KeyLabelDefaultServers:   "Default Servers",
+	KeySLBNoListeners:        "No listeners",
+	KeyDetailUnavailable:     "Unavailable",
+	KeyPageSLBJSONDetail:     "SLB JSON Detail",
+
+	// MongoDB
+	KeyMenuMongoDB:           "(M) MongoDB Instances",
+	KeyMenuMongoDBDesc:       "MongoDB replica sets and sharded clusters with connection strings and accounts",
+	KeyPageMongoDBList:       "MongoDB Instances",
+	KeyPageMongoDBDetail:     "MongoDB Detail",
+	KeyPageMongoDBJSONDetail: "MongoDB JSON Detail",
+	KeyPageMongoDBAccounts:   "MongoDB Accounts",
+	KeySectionConnections:    "Connection Strings",
+	KeySectionReplicaSet:     "Replica Set",
+	KeySectionMongos:         "Mongos",
+	KeySectionShards:         "Shards",
+	KeySectionConfigServers:  "Config Servers",
+	KeyLabelArchitecture:     "Architecture",
+	KeyLabelEngineVersion:    "Engine Version",
+	KeyLabelStorageEngine:    "Storage Engine",
+	KeyLabelStorage:          "Storage",
+	KeyLabelMaxConnections:   "Max Connections",
+	KeyLabelMaintenance:      "Maintenance (UTC)",
+	KeyMongoDBReplicaSet:     "Replica Set",
+	KeyMongoDBSharded:        "Sharded Cluster",
+	KeyMongoDBNoNodes:        "No nodes",
+
+	// Instance details
+	KeyPageRDSJSONDetail:      "RDS JSON Detail",
+	KeyPageRedisJSONDetail:    "Redis JSON Detail",
+	KeyPageRocketMQJSONDetail: "RocketMQ JSON Detail",
+	KeySectionSpec:            "Specifications",
+	KeySectionEndpoints:       "Endpoints",
+	KeySectionWhitelist:       "IP Whitelist",
+	KeySectionMaintenance:     "Maintenance",
+	KeyLabelEdition:           "Edition",
+	KeyLabelMaxIOPS:           "Max IOPS",
+	KeyLabelMinorUpgrade:      "Minor Version Upgrade",
+	KeyLabelKernelVersion:     "Kernel Version",
+	KeyLabelQPS:               "QPS",
+	KeyLabelShards:            "Shards",
+	KeyLabelMaxTPS:            "Max TPS",
+	KeyLabelTopicCapacity:     "Topic Capacity",
+	KeyLabelIndependentNaming: "Independent Namespace",
+	KeyLabelReleaseTime:       "Release Time",
+
+	// Maintenance windows
+	KeyMaintenanceWindowTitle:   "Maintenance window of %s (UTC, local time in brackets)",
+	KeyMaintenanceWindowChanged: "Maintenance window of %s changed to %s",
+
+	// Elasticsearch
+	KeyMenuElasticsearch:           "(e) Elasticsearch Instances",
+	KeyMenuElasticsearchDesc:       "Elasticsearch clusters with their node specs and Elasticsearch and Kibana endpoints",
+	KeyPageElasticsearchList:       "Elasticsearch Instances",
+	KeyPageElasticsearchDetail:     "Elasticsearch Detail",
+	KeyPageElasticsearchJSONDetail: "Elasticsearch JSON Detail",
+	KeyLabelDataNodes:              "Data Nodes",
+	KeyLabelMasterNodes:            "Master Nodes",
+	KeyLabelKibanaNodes:            "Kibana Nodes",
+
+	// Zone capacity
+	KeyPageZoneCapacity:  "Zone Capacity",
+	KeyCapacityAvailable: "Available",
+	KeyCapacityLow:       "Low",
+	KeyCapacitySoldOut:   "Sold out",
+
+	// Kafka
+	KeyMenuKafka:             "(A) Kafka Instances",
+	KeyMenuKafkaDesc:         "ApsaraMQ for Kafka instances, topics with partitions and consumer groups with lag",
+	KeyPageKafkaList:         "Kafka Instances",
+	KeyPageKafkaDetail:       "Kafka Detail",
+	KeyPageKafkaJSONDetail:   "Kafka JSON Detail",
+	KeyPageKafkaTopics:       "Kafka Topics",
+	KeyPageKafkaGroups:       "Kafka Consumer Groups",
+	KeyLabelTrafficSpec:      "Traffic Spec",
+	KeyLabelMessageRetention: "Message Retention",
+	KeyLabelPartitions:       "Partitions",
+	KeyLabelConsumerGroups:   "Consumer Groups",
+
+	// Finder across profiles
+	KeyFinderProfilesTotal:   "Found %d matching resources in %d profiles",
+	KeyFinderProfilesNoMatch: "No matches in: %s",
+	KeyFinderProfileFailed:   "Search failed",
+	KeyColErrorMessage:       "Error",
+
+	// RocketMQ lag and messages
+	KeyPageRocketMQLag:      "RocketMQ Consumer Lag",
+	KeyPageRocketMQMessages: "RocketMQ Messages",
+
+	// RocketMQ 5.x
+	KeyLabelAPIVersion: "API Version",
+
+	// Empty list state
+	KeyEmptyStateTitle:       "No %s in %s",
+	KeyEmptyStateCounting:    "Checking the other regions...",
+	KeyEmptyStateCountFailed: "The other regions could not be checked: %v",
+	KeyEmptyStateNoOthers:    "No other region has any either",
+	KeyEmptyStateOthers:      "%d other regions have some:",
+	KeyEmptyStateJump:        "Press enter to switch to %s",
+	KeyEmptyStateCached:      "Counts come from the Resource Center region cache and can be a few days old",
+
+	// Redis parameters and performance
+	KeyPageRedisParameters: "Redis Parameters",
+	KeyPageRedisMetrics:    "Redis Performance",
+
+	// Recycle bin
+	KeyMenuRecycleBin:          "(x) Recycle Bin",
+	KeyMenuRecycleBinDesc:      "Expired, locked and soon-released ECS and RDS instances",
+	KeyPageRecycleBin:          "Recycle Bin",
+	KeyPageOSSDeleted:          "Deleted Objects",
+	KeyRecyclePartial:          "Could not list the recycled %s instances: %v",
+	KeyRecycleConfirmCancel:    "Cancel the scheduled release of ECS instance %s (%s) at %s?",
+	KeyRecycleReleaseCancelled: "Cancelled the scheduled release of %s",
+	KeyRecycleRenewInConsole:   "%s instance %s: %s. Renew it in the console to keep it; renewals are charged, so they are not made from here",
+	KeyOSSDeletedTruncated:     "(truncated)",
+	KeyOSSConfirmUndelete:      "Remove the delete marker of oss://%s/%s and restore its previous version?",
+	KeyOSSUndeleted:            "Restored %s",
+
+	// Redis backups
+	KeyPageRedisBackups: "Redis Backups",
+
+	// RDS backups
+	KeyPageRDSBackups: "RDS Backups",
+	KeyPageRDSBinlogs: "RDS Binlogs",
+
+	// Connection test
+	KeyConnTestTitle:         "Test connection to %s",
+	KeyConnTestAddress:       "Endpoint (host:port)",
+	KeyConnTestUsername:      "Username (optional)",
+	KeyConnTestPassword:      "Password (optional, to check the login)",
+	KeyConnTestNoAddress:     "Enter the endpoint as host:port",
+	KeyConnTestConnected:     "Connected to %s in %s",
+	KeyConnTestServer:        "Server version: %s",
+	KeyConnTestLoggedIn:      "Logged in: the credentials are valid",
+	KeyConnTestNotLoggedIn:   "Credentials were not checked",
+	KeyConnTestConnectFailed: "Could not connect to %s: %v\n\nThis is a network problem: check the IP whitelist and security groups, and that the endpoint is reachable from this machine (internal endpoints only are from within the VPC)",
+	KeyConnTestAuthFailed:    "Connected to %s in %s, but the login failed: %v\n\nThe network is fine: check the username and password",
+
+	// RDS slow log
+	KeyPageRDSSlowLog:       "RDS Slow Queries",
+	KeyRDSSlowLogRangeTitle: "Slow queries of %s",
+	KeyRDSSlowLogRangeDays:  "Last %d days",
+	KeyRDSSlowLogRangeToday: "Today",
+
+	// Quick connect
+	KeyConnectTitle:     "Connect to %s with the %s client",
+	KeyConnectAccount:   "Account",
+	KeyConnectNoAccount: "Enter the account to connect as",
+	KeyConnectNoClient:  "%s was not found: install it, or set the command for %s in connect_commands of ~/.aliyun/config.json",
+
+	// Latency probe
+	KeyProbeTitle:   "Latency probe of %s",
+	KeyProbeHost:    "Host",
+	KeyProbePort:    "TCP port (optional)",
+	KeyProbeNoHost:  "Enter the host to probe",
+	KeyProbeBadPort: "Enter the TCP port as a number from 1 to 65535, or leave it empty to only ping",
+	KeyProbeSummary: "%s %s: %d/%d replies, %.0f%% loss",
+	KeyProbeStats:   "min/avg/max: %s / %s / %s",
+	KeyProbeFailed:  "%s %s could not run: %v",
+	KeyProbeNoReply: "No replies: the address is unreachable from this machine, or a security group or firewall drops the probe",
+
+	// SSH port forward
+	KeyPortForwardBastion:     "Bastion to reach %s through",
+	KeyPortForwardNoBastion:   "No running ECS instance in this region to use as a bastion",
+	KeyPortForwardTitle:       "Forward a local port to %s",
+	KeyPortForwardLocalPort:   "Local port",
+	KeyPortForwardEndpoint:    "Endpoint (host:port, as seen from the bastion)",
+	KeyPortForwardBastionHost: "Bastion ([user@]host[:port])",
+	KeyPortForwardBadPort:     "Enter the local port as a number from 1 to 65535",
+	KeyPortForwardBadBastion:  "Enter the bastion as [user@]host[:port]",
+	KeyPortForwardPortBusy:    "Local port %d is not free: %v",
+	KeyPortForwardNoSSH:       "ssh was not found in PATH; install OpenSSH to forward ports",
+	KeyPortForwardStarted:     "Forwarding %s; J shows the tunnel, x there closes it",
+	KeyJobTunnel:              "Tunnel %s",
+
+	// What's New
+	KeyPageChangelog:     "What's New",
+	KeyChangelogSince:    "What's new in alidash %s since %s (a: all releases)",
+	KeyChangelogAll:      "What's new in each release, up to alidash %s",
+	KeyMenuChangelog:     "(W) What's New",
+	KeyMenuChangelogDesc: "New pages and key bindings of each release",
+
+	// Recent Resources
+	KeyPageRecent:         "Recent Resources",
+	KeyMenuRecent:         "(H) Recent",
+	KeyMenuRecentDesc:     "Reopen resources whose details you viewed",
+	KeyRecentPick:         "Jump to a recent resource",
+	KeyRecentEmpty:        "No resources viewed yet. Detail pages you open are listed here.",
+	KeyRecentSwitchFailed: "Failed to switch to profile %s, region %s: %v",
+	KeyRecentGone:         "%s %s no longer exists in %s/%s",
+
+	// Usage Statistics
+	KeyPageUsage:     "Usage Stats",
+	KeyUsageTitle:    "Your usage since %s, kept on this machine only",
+	KeyMenuUsage:     "(U) Usage Stats",
+	KeyMenuUsageDesc: "Pages and actions you use most, never uploaded",
+
+	// OSS copy and move
+	KeyOSSCopy:               "Copy to another bucket - %s",
+	KeyOSSMove:               "Move to another bucket - %s",
+	KeyOSSCopyBucket:         "Destination bucket",
+	KeyOSSCopyPrefix:         "Destination folder",
+	KeyOSSCopyWhatKeys:       "%d object(s) from oss://%s/%s",
+	KeyOSSCopyWhatFolder:     "every object under oss://%s/%s",
+	KeyOSSCopyBucketRequired: "Enter the destination bucket",
+	KeyJobOSSCopy:            "Copy %s to oss://%s/%s",
+	KeyJobOSSMove:            "Move %s to oss://%s/%s",
+	KeyOSSCopied:             "Copied %d object(s), %s, to oss://%s/%s",
+	KeyOSSMoved:              "Moved %d object(s), %s, to oss://%s/%s",
+	KeyOSSCopyCrossRegion:    "The buckets are in different regions, so objects were downloaded and uploaded again",
+	KeyOSSCopyFailed:         "%d object(s) failed:",
+	KeyOSSCopyStopped:        "Stopped after copying %d object(s), %s, to oss://%s/%s; %d not copied",
+	KeyOSSMoveStopped:        "Stopped after moving %d object(s), %s, to oss://%s/%s; %d not moved are still in the source",
+
+	// DNS import
+	KeyPageDNSImport:         "DNS Import",
+	KeyDNSImport:             "Import DNS changes from CSV (domain, rr, type, value, ttl, action)",
+	KeyDNSImportPath:         "CSV file",
+	KeyDNSImportTitle:        "DNS Import - %s: %d to apply, %d unchanged, %d invalid",
+	KeyDNSImportAppliedTitle: "DNS Import - %s: %d applied, %d failed, %d unchanged",
+	KeyDNSImportInvalid:      "%d row(s) are invalid; fix the file and import it again before applying",
+	KeyDNSImportNothing:      "There are no changes to apply",
+	KeyDNSImportConfirm:      "Apply %d DNS change(s) from %s?",
+	KeyDNSImportDone:         "Applied %d DNS change(s)",
+	KeyDNSImportFailed:       "Applied %d DNS change(s), %d failed; see the Status column",
+	KeyDNSImportCancelled:    "Stopped after applying %d DNS change(s), %d failed; %d not applied are still Ready",
+	KeyJobDNSImportPlan:      "Check DNS changes from %s",
+	KeyJobDNSImportApply:     "Apply DNS changes from %s",
+
+	// SLB backends
+	KeySLBBackendNoneMarked:   "Mark ECS instances with Space in the ECS list first",
+	KeySLBBackendNoSLB:        "There are no SLB instances in this region",
+	KeySLBBackendPickSLB:      "Add %d ECS instance(s) to SLB",
+	KeySLBBackendPickGroup:    "VServer group on %s",
+	KeySLBBackendNewGroup:     "+ New VServer group",
+	KeySLBBackendAddTitle:     "Add %d ECS instance(s) to %s",
+	KeySLBBackendCreateTitle:  "New VServer group on %s with %d ECS instance(s)",
+	KeySLBBackendGroupName:    "Group name",
+	KeySLBBackendPort:         "Backend port",
+	KeySLBBackendWeight:       "Weight",
+	KeySLBBackendNameRequired: "Enter a name for the new VServer group",
+	KeySLBBackendBadPort:      "Invalid backend port: %s (1-65535)",
+	KeySLBBackendBadWeight:    "Invalid weight: %s (0-100)",
+	KeySLBBackendsAdded:       "Added %d backend server(s) to %s on %s",
+	KeySLBBackendGroupCreated: "Created %[2]s on %[3]s with %[1]d backend server(s)",
+
+	// SLB certificates
+	KeySLBCertHTTPSOnly:    "Only HTTPS listeners have a server certificate",
+	KeySLBCertPick:         "Certificate for HTTPS listener %d on %s",
+	KeySLBCertUploadOption: "+ Upload a new certificate",
+	KeySLBCertCurrent:      "(current)",
+	KeySLBCertExpires:      "expires %s (%d days)",
+	KeySLBCertExpiresSoon:  "! expires %s (%d days)",
+	KeySLBCertExpired:      "! expired %s",
+	KeySLBCertUploadTitle:  "Upload a certificate for HTTPS listener %d on %s",
+	KeySLBCertName:         "Certificate name",
+	KeySLBCertNameHint:     "domain-expiry by default",
+	KeySLBCertCertificate:  "Certificate (PEM chain)",
+	KeySLBCertPrivateKey:   "Private key (PEM)",
+	KeySLBCertPEMHint:      "file path, or paste the PEM",
+	KeySLBCertBindConfirm:  "Bind HTTPS listener %d on %s to %s (%s, %s)?\n\nCurrent certificate: %s",
+	KeySLBCertBound:        "HTTPS listener %d on %s now serves %s",
+
+	// Read-only mode
+	KeyHeaderReadOnly:  "Read-only",
+	KeyReadOnlyRefused: "Read-only mode: changes to cloud resources are disabled",
+
+	// Prefetching
+	KeyPrefetchStale:         "Cached %s ago, refreshing…",
+	KeyPrefetchRefreshFailed: "Cached %s ago, refresh failed: %v",
+
+	// Cache status
+	KeyCacheAge: "cached %s ago",
+
+	// ECS paging
+	KeyECSLoadProgress:        "Loaded %d / %d…",
+	KeyECSLoadProgressUnknown: "Loaded %d…",
+
+	// Resource finder progress
+	KeyJobFind:               "Find %s",
+	KeyFinderSearching:       "Searching for %s…",
+	KeyFinderProgressPages:   "%d/%d pages",
+	KeyFinderProgressDomains: "%d/%d domains",
+	KeyFinderCancelHint:      "esc: cancel search",
+
+	// API retries
+	KeyActionRetrying: "Retrying (%d/%d)...",
+
+	// API trace
+	KeyPageAPITrace:      "API Trace",
+	KeyAPITraceTitle:     "The last %d API calls, newest first (up to %d kept)",
+	KeyColTraceTime:      "Time",
+	KeyColTraceAction:    "Action",
+	KeyColTraceHost:      "Endpoint",
+	KeyColTraceLatency:   "Latency",
+	KeyColTraceAttempts:  "Tries",
+	KeyColTraceRequestID: "Request ID",
+	KeyColTraceError:     "Error",
+
+	// Permissions
+	KeyNoPermission:    "no permission",
+	KeyFinderDenied:    "No permission, skipped: %s",
+	KeyNoPermissionFor: "No permission for %s",
+
+	// Help overlay
+	KeyHelpTitle:      "Keys: %s",
+	KeyHelpNavigation: "Navigation",
+	KeyHelpSearch:     "Search",
+	KeyHelpGoTo:       "Go to",
+	KeyHelpGeneral:    "General",
+	KeyHelpPage:       "This page",
+	KeyHelpTable:      "Tables",
+	KeyHelpViewer:     "Viewer",
+	KeyHelpHint:       "/: Search keys | j/k: Scroll | esc/?: Close",
+	KeyHelpNoMatch:    "No keys match %q",
+
+	// Split view
+	KeySplitPreview: "Preview",
+	KeySplitNothing: "Nothing selected",
+
+	// Tabs
+	KeyHelpTabs: "Tabs",
+
+	// Remaining literals
+	KeyColHealthCheck:  "Health Check",
+	KeyColScheduler:    "Scheduler",
+	KeyColCertificate:  "Certificate",
+	KeyDiskLocalSSD:    "Local SSD",
+	KeyTableRowsOf:     "%s (%d of %d rows)",
+	KeyTableFilterInfo: " Filter: %s (%d of %d rows) ",
+	KeySearchInfo:      " Search: %s (%d/%d) ",
+	KeyViewportHelp:    "q/Esc: back | yy: copy | e: edit | v: pager | /: search | n/N: next/prev",
+	KeyOSSObjectsTitle: "Objects in %s (Page %d)",
+	KeyOSSPage:         "Page %d",
+	KeyOSSPrevPage:     "[ Prev",
+	KeyOSSNextPage:     "] Next",
+	KeyOSSFirstPage:    "0 First",
+	KeyProfileSwitched: "Switched to profile: %s (region: %s)",
+	KeyUnknownPage:     "Unknown page",
+
+	// Timestamps
+	KeyTimeAgo:     "%s ago",
+	KeyTimeIn:      "in %s",
+	KeyTimeJustNow: "just now",
+	KeyTimeMinutes: "%dm",
+	KeyTimeHours:   "%dh",
+	KeyTimeDays:    "%dd",
+	KeyTimeMonths:  "%dmo",
+	KeyTimeYears:   "%dy",
+
+	// Table selection
+	KeyTableSelectedTitle: "%s - %d selected",
+	KeyTableSelectionInfo: "%d selected · yy copies them",
+	KeyTableRangeInfo:     "%d selected · move to extend the range, V to end it",
+
+	// ECS batch copy
+	KeyECSCopyTitle:      "Copy %d instances as",
+	KeyECSCopyIDs:        "Instance IDs, one per line",
+	KeyECSCopyPrivateIPs: "Private IPs, one per line",
+	KeyECSCopyPublicIPs:  "Public IPs, one per line",
+	KeyECSCopyNone:       "None of the instances has one",
+
+	// Column chooser
+	KeyColumnsTitle:      "Columns: %s",
+	KeyColumnsHint:       "space show/hide · J/K move · r defaults · enter save · esc cancel",
+	KeyColumnsSaveFailed: "Failed to save the columns: %v",
+
+	// Finder match reasons
+	KeyFinderMatchedBy:    "matched by %s",
+	KeyFinderMatchIP:      "IP",
+	KeyFinderMatchAddress: "address",
+	KeyFinderMatchID:      "ID",
+	KeyFinderMatchName:    "name",
+	KeyFinderMatchTag:     "tag",
+
+	// DNS propagation check
+	KeyDNSPropagationTitle:    "Propagation of %s %s",
+	KeyDNSPropagationExpected: "Configured: %s",
+	KeyDNSPropagationMatch:    "up to date",
+	KeyDNSPropagationStale:    "stale: %s, cached for up to %ds more",
+	KeyDNSPropagationMissing:  "no record",
+	KeyDNSPropagationFailed:   "failed: %v",
+	KeyDNSPropagationAll:      "All resolvers return the configured value",
+	KeyDNSPropagationSome:     "%d of %d resolvers do not return the configured value yet",
+	KeyDNSPropagationPaused:   "The record is paused, so resolvers should no longer return it",
+	KeyDNSPropagationLine:     "The record is for line %s: resolvers elsewhere may get another record",
+
 	// Common
-	KeyActionCopied:  "Copied to clipboard!",
-	KeyActionLoading: "Loading...",
-	KeyNoData:        "N/A",
+	KeyActionCopied:        "Copied to clipboard!",
+	KeyActionOpenedBrowser: "Opened in the browser",
+	KeyActionLoading:       "Loading...",
+	KeyNoData:              "N/A",
 }
 
 // Chinese translations
@@ -713,6 +2015,7 @@ var zhCN = map[string]string{
 	// Header
 	KeyHeaderProfile: "配置",
 	KeyHeaderRegion:  "地域",
+	KeyHeaderRole:    "角色",
 
 	// Modal
 	KeyModalInfo:          "信息",
@@ -725,10 +2028,12 @@ var zhCN = map[string]string{
 	KeyModalSelectRegion:  "选择地域",
 	KeyModalLoading:       "正在加载有资源的地域...",
 	KeyModalResourceFind:  "资源查找",
-	KeyModalInputPrompt:   "请输入 IP 地址或域名:",
-	KeyModalInputExample:  "例如: 192.168.1.1 或 example.com",
+	KeyModalInputPrompt:   "请输入 IP、域名、资源 ID、名称或 tag=键:值:",
+	KeyModalInputExample:  "例如: 192.168.1.1、example.com、i-bp1abc2def3ghi4jkl 或 tag=env:prod",
 	KeyModalHistory:       "历史",
 	KeyModalCurrent:       "当前",
+	KeyModalNextField:     "切换字段",
+	KeyModalSubmit:        "提交",
 
 	// Common columns
 	KeyColInstanceID:   "实例 ID",
@@ -739,6 +2044,8 @@ var zhCN = map[string]string{
 	KeyColPrivateIP:    "私网 IP",
 	KeyColPublicIP:     "公网 IP",
 	KeyColExpired:      "到期时间",
+	KeyColImageID:      "镜像 ID",
+	KeyColOSName:       "操作系统",
 	KeyColType:         "类型",
 	KeyColDescription:  "描述",
 	KeyColCreatedAt:    "创建时间",
@@ -825,6 +2132,8 @@ var zhCN = map[string]string{
 	KeyFinderRDS:          "RDS 实例",
 	KeyFinderRedis:        "Redis 实例",
 	KeyFinderRocketMQ:     "RocketMQ 实例",
+	KeyFinderEIP:          "弹性公网 IP",
+	KeyFinderNAT:          "NAT 网关",
 
 	// ENI Types
 	KeyENIPrimary:   "主网卡",
@@ -852,6 +2161,7 @@ var zhCN = map[string]string{
 	KeySectionOtherInfo:      "其他信息",
 	KeySectionUsageOverview:  "使用率概览",
 	KeySectionStorageOverview: "存储概览",
+	KeySectionZoomHint:       "z: 显示全部分区",
 
 	// ECS Detail labels
 	KeyLabelInstanceID:      "实例 ID",
@@ -927,10 +2237,653 @@ var zhCN = map[string]string{
 	KeyCountENI:   "%d 个",
 	KeyCountDisks: "%d 个",
 
+	// DNS record management
+	KeyDNSAddRecord:     "添加解析记录",
+	KeyDNSEditRecord:    "修改解析记录",
+	KeyDNSConfirmDelete: "确认删除记录 %s (%s → %s)?",
+	KeyDNSRecordAdded:   "记录 %s 已添加",
+	KeyDNSRecordUpdated: "记录 %s 已修改",
+	KeyDNSRecordDeleted: "记录 %s 已删除",
+	KeyDNSRecordEnabled: "记录 %s 已启用",
+	KeyDNSRecordPaused:  "记录 %s 已暂停",
+	KeyDNSInvalidTTL:    "TTL 无效: %s",
+	KeyDNSFieldRequired: "主机记录、记录类型和记录值不能为空",
+	KeyLabelPriority:    "MX 优先级",
+
+	// OSS download
+	KeyOSSDownload:     "下载对象",
+	KeyOSSDownloadPath: "保存到",
+	KeyOSSDownloaded:   "已下载 %s 到 %s",
+
+	// OSS preview
+	KeyPageOSSPreview:      "OSS 对象预览",
+	KeyOSSPreviewTruncated: "仅显示前 %s",
+	KeyOSSPreviewBinary:    "%s 不是文本内容，仅支持预览文本对象",
+
+	// All regions mode
+	KeyAllRegions:        "全部地域",
+	KeyAllRegionsEnabled: "已切换为全部地域，列表将汇总所有有资源的地域",
+	KeyAllRegionsPartial: "部分地域加载失败：\n%s",
+
+	// Background jobs
+	KeyPageJobs:           "后台任务",
+	KeyColJob:             "任务",
+	KeyColProgress:        "进度",
+	KeyColElapsed:         "耗时",
+	KeyColJobError:        "错误",
+	KeyJobStatusRunning:   "运行中",
+	KeyJobStatusDone:      "已完成",
+	KeyJobStatusFailed:    "失败",
+	KeyJobStatusCancelled: "已取消",
+	KeyJobsRunning:        "%d 个任务运行中",
+	KeyJobCancelled:       "已取消：%s",
+	KeyJobDownload:        "下载 oss://%s/%s",
+	KeyJobFetchAllRegions: "从全部地域获取 %s",
+
+	// RAM
+	KeyMenuRAM:             "(a) RAM 访问控制",
+	KeyMenuRAMDesc:         "审计 RAM 用户、角色和权限策略",
+	KeyPageRAMUsers:        "RAM 用户",
+	KeyPageRAMRoles:        "RAM 角色",
+	KeyPageRAMPolicies:     "RAM 权限策略",
+	KeyPageRAMUserPolicies: "RAM 用户权限策略",
+	KeyPageRAMDetail:       "RAM 详情",
+
+	// ECS metrics
+	KeyPageECSMetrics:       "ECS 监控指标",
+	KeyMetricsNoData:        "该时间段内无数据",
+	KeyMetricsAgentRequired: "内存指标需要实例安装云监控插件",
+
+	// ECS spot and GPU instances
+	KeyColSpot:               "抢占式",
+	KeyColGPU:                "GPU",
+	KeySectionSpot:           "抢占式实例",
+	KeySectionGPU:            "GPU",
+	KeyLabelSpotStrategy:     "竞价策略",
+	KeyLabelSpotPriceLimit:   "价格上限",
+	KeyLabelSpotDuration:     "保护期",
+	KeyLabelSpotInterruption: "中断模式",
+	KeyLabelSpotStatus:       "中断状态",
+	KeyLabelGPUSpec:          "GPU 类型",
+	KeyLabelGPUAmount:        "GPU 数量",
+	KeySpotActive:            "正常",
+	KeySpotReclaiming:        "回收中",
+	KeySpotAsPriceGo:         "跟随市场价",
+	KeySpotWithPriceLimit:    "设置上限价格",
+	KeySpotNoProtection:      "无保护期",
+	KeyFilterSpotOnly:        "仅抢占式",
+	KeyFilterGPUOnly:         "仅 GPU",
+
+	// RDS metrics
+	KeyPageRDSMetrics: "RDS 监控指标",
+
+	// ECS right-sizing
+	KeySizingTitle:       "规格建议",
+	KeySizingKeep:        "当前规格与负载匹配",
+	KeySizingDownsize:    "可考虑降配至 %s",
+	KeySizingUpsize:      "可考虑升配至 %s",
+	KeySizingNoSmaller:   "%s 规格族中没有更小的规格",
+	KeySizingNoLarger:    "%s 规格族中没有更大的规格",
+	KeySizingNoHistory:   "没有可用于规格建议的 CPU 历史数据",
+	KeySizingUnavailable: "无法生成规格建议",
+	KeySizingLoading:     "正在分析 CPU 历史数据...",
+
+	// Log Service
+	KeyMenuSLS:               "(l) 日志服务",
+	KeyMenuSLSDesc:           "排查 Logtail 采集配置、机器组和 ECS 覆盖情况",
+	KeyPageSLSProjects:       "日志服务 Project",
+	KeyPageSLSLogtailConfigs: "Logtail 采集配置",
+	KeyPageSLSMachineGroups:  "机器组",
+	KeyPageSLSMachines:       "机器组机器",
+	KeyPageSLSCoverage:       "Logtail ECS 覆盖",
+	KeyPageSLSDetail:         "日志服务详情",
+
+	// Security group rule management
+	KeySGAddRule:           "添加安全组规则",
+	KeySGEditRule:          "修改安全组规则",
+	KeySGConfirmRevoke:     "确认删除%s规则 %s %s %s (%s)?",
+	KeySGRuleAdded:         "规则 %s %s 已添加到 %s",
+	KeySGRuleUpdated:       "%[2]s 的规则 %[1]s 已修改",
+	KeySGRuleRevoked:       "规则 %s %s 已从 %s 删除",
+	KeySGFieldRequired:     "方向、协议和 CIDR 不能为空",
+	KeySGInvalidDirection:  "方向必须是 ingress 或 egress: %s",
+	KeySGInvalidProtocol:   "协议必须是 TCP、UDP、ICMP、GRE 或 ALL: %s",
+	KeySGInvalidPortRange:  "端口范围无效: %s (格式为 起始/结束, 如 22/22)",
+	KeySGInvalidCIDR:       "CIDR 或 IP 无效: %s",
+	KeySGInvalidPolicy:     "授权策略必须是 accept 或 drop: %s",
+	KeySGInvalidPriority:   "优先级必须在 1 到 100 之间: %s",
+	KeySGDirectionFixed:    "已有规则的方向不能修改",
+	KeySGGroupRuleReadOnly: "引用安全组或前缀列表的规则只能删除",
+	KeyLabelCIDR:           "CIDR",
+
+	// Cloud Config
+	KeyMenuConfig:        "(c) 配置审计",
+	KeyMenuConfigDesc:    "查看合规规则及不合规资源",
+	KeyPageConfigRules:   "配置审计规则",
+	KeyPageConfigResults: "不合规资源",
+	KeyPageConfigDetail:  "配置审计详情",
+
+	// Resource tags
+	KeyMenuTags:         "(t) 标签",
+	KeyMenuTagsDesc:     "浏览标签键值及打了标签的资源",
+	KeyPageTagBrowser:   "资源标签",
+	KeyPageTagResources: "标签资源",
+	KeyPageTagDetail:    "标签资源详情",
+	KeyTagFilter:        "按标签过滤",
+	KeyTagFilterLabel:   "标签 (key=value，留空清除)",
+	KeyTagFilterInvalid: "无效的标签过滤 %q，应为 key=value",
+
+	// Billing
+	KeyMenuBilling:           "($) 费用",
+	KeyMenuBillingDesc:       "余额、本月各产品消费及昨日消费",
+	KeyPageBilling:           "费用",
+	KeyPageBillingDetail:     "费用详情",
+	KeyBillingLoading:        "正在加载费用…",
+	KeyBillingUnavailable:    "费用不可用",
+	KeyBillingCycle:          "账期",
+	KeyBillingMonthToDate:    "本月累计",
+	KeyBillingYesterday:      "昨日",
+	KeyBillingBalance:        "余额",
+	KeyBillingBeforeDiscount: "优惠前",
+	KeyBillingCost:           "费用",
+
+	// Dangling DNS records
+	KeyPageDNSDangling:    "悬空 DNS 记录",
+	KeyJobScanDanglingDNS: "扫描悬空 DNS 记录",
+
+	// Instance RAM role
+	KeyPageRAMRolePolicies: "RAM 角色权限策略",
+	KeyLabelRAMRole:        "RAM 角色",
+	KeyRAMRoleNone:         "无",
+	KeyRAMRoleLoading:      "加载中…",
+	KeyRAMRoleUnavailable:  "角色不可用",
+
+	// NAT gateways
+	KeyMenuNAT:            "(n) NAT 网关",
+	KeyMenuNATDesc:        "NAT 网关及其 SNAT、DNAT 条目",
+	KeyPageNATList:        "NAT 网关",
+	KeyPageNATDetail:      "NAT 网关详情",
+	KeyPageSNATEntries:    "SNAT 条目",
+	KeyPageDNATEntries:    "DNAT 条目",
+	KeyDNATTargetNotFound: "%[2]s 中没有私网 IP 为 %[1]s 的 ECS 实例",
+
+	// Finder EIP and NAT columns
+	KeyColAllocationID: "实例 ID",
+	KeyColBandwidth:    "带宽",
+	KeyColBoundTo:      "绑定资源",
+	KeyColNATGatewayID: "NAT 网关 ID",
+	KeyColVPC:          "专有网络",
+
+	// OSS object versions
+	KeyPageOSSVersions:    "OSS 对象历史版本",
+	KeyOSSDeleteMarker:    "删除标记",
+	KeyOSSVersionCurrent:  "当前版本",
+	KeyOSSConfirmRestore:  "将 oss://%[1]s/%[2]s 恢复为 %[4]s 的版本 %[3]s？当前内容会保留为历史版本。",
+	KeyOSSVersionRestored: "已将 %s 恢复为版本 %s",
+	KeyJobDownloadVersion: "下载 oss://%s/%s（版本 %s）",
+
+	// ALB and NLB
+	KeyMenuALB:             "(p) 应用型负载均衡 ALB",
+	KeyMenuALBDesc:         "应用型负载均衡及其监听、转发规则和服务器组",
+	KeyMenuNLB:             "(w) 网络型负载均衡 NLB",
+	KeyMenuNLBDesc:         "网络型负载均衡及其监听和服务器组",
+	KeyPageALBList:         "应用型负载均衡",
+	KeyPageALBDetail:       "ALB 详情",
+	KeyPageALBListeners:    "ALB 监听",
+	KeyPageALBRules:        "ALB 转发规则",
+	KeyPageALBRuleDetail:   "ALB 转发规则详情",
+	KeyPageALBServerGroups: "ALB 服务器组",
+	KeyPageNLBList:         "网络型负载均衡",
+	KeyPageNLBDetail:       "NLB 详情",
+	KeyPageNLBListeners:    "NLB 监听",
+	KeyPageNLBServerGroups: "NLB 服务器组",
+	KeyPageLBServers:       "后端服务器",
+
+	// OSS bucket detail
+	KeyPageOSSBucketDetail: "OSS Bucket 详情",
+
+	// ACK
+	KeyMenuACK:                 "(K) ACK 集群",
+	KeyMenuACKDesc:             "Kubernetes 集群及其节点池和节点",
+	KeyPageACKClusters:         "ACK 集群",
+	KeyPageACKClusterDetail:    "ACK 集群详情",
+	KeyPageACKNodePools:        "ACK 节点池",
+	KeyPageACKNodes:            "ACK 节点",
+	KeyACKNodeInstanceNotFound: "%[2]s 中未找到 ECS 实例 %[1]s",
+
+	// OSS object scans
+	KeyPageOSSScan:       "OSS 对象扫描",
+	KeyOSSPrefixSearch:   "前缀搜索 - oss://%s/",
+	KeyOSSScanPrefix:     "对象前缀",
+	KeyJobScanOSSObjects: "扫描 %s 中的对象",
+
+	// OSS archive restore
+	KeyOSSRestoreArchive:     "解冻 - oss://%s/%s（%s）",
+	KeyOSSRestoreDays:        "解冻副本保留天数（1-%d）",
+	KeyOSSRestoreTier:        "解冻优先级（%s）",
+	KeyOSSRestoreInvalidDays: "天数必须是 1 到 %d 之间的数字",
+	KeyOSSRestoreInvalidTier: "解冻优先级必须是 %s 之一",
+	KeyOSSRestoreStarted:     "正在解冻 %s，Restore 列显示 Restored 后即可下载",
+	KeyOSSRestoreRequired:    "%[1]s 为 %[2]s 存储类型（%[3]s），请先按 r 解冻后再下载",
+
+	// ACR
+	KeyMenuACR:             "(C) 容器镜像服务 ACR",
+	KeyMenuACRDesc:         "ACR 企业版命名空间、镜像仓库和镜像版本",
+	KeyPageACRInstances:    "ACR 实例",
+	KeyPageACRNamespaces:   "ACR 命名空间",
+	KeyPageACRRepositories: "ACR 镜像仓库",
+	KeyPageACRTags:         "ACR 镜像版本",
+	KeyPageACRTagDetail:    "ACR 镜像版本详情",
+
+	// FC
+	KeyMenuFC:               "(u) 函数计算 FC",
+	KeyMenuFCDesc:           "FC 服务和函数，以及近 24 小时调用与错误次数",
+	KeyPageFCServices:       "FC 服务",
+	KeyPageFCFunctions:      "FC 函数",
+	KeyPageFCFunctionDetail: "FC 函数详情",
+
+	// SLB detail
+	KeySectionNetwork:        "网络",
+	KeySectionListeners:      "监听",
+	KeySectionBilling:        "计费",
+	KeyLabelIPVersion:        "IP 版本",
+	KeyLabelMasterZone:       "主可用区",
+	KeyLabelSlaveZone:        "备可用区",
+	KeyLabelSpecCharge:       "实例计费方式",
+	KeyLabelDeleteProtection: "删除保护",
+	KeyLabelModProtection:    "配置修改保护",
+	KeyLabelDefaultServers:   "默认服务器组",
+	KeySLBNoListeners:        "无监听",
+	KeyDetailUnavailable:     "无法获取",
+	KeyPageSLBJSONDetail:     "SLB JSON 详情",
+
+	// MongoDB
+	KeyMenuMongoDB:           "(M) MongoDB 数据库",
+	KeyMenuMongoDBDesc:       "查看 MongoDB 副本集和分片集群的连接地址及账号",
+	KeyPageMongoDBList:       "MongoDB 实例",
+	KeyPageMongoDBDetail:     "MongoDB 详情",
+	KeyPageMongoDBJSONDetail: "MongoDB JSON 详情",
+	KeyPageMongoDBAccounts:   "MongoDB 账号",
+	KeySectionConnections:    "连接地址",
+	KeySectionReplicaSet:     "副本集",
+	KeySectionMongos:         "Mongos 节点",
+	KeySectionShards:         "Shard 节点",
+	KeySectionConfigServers:  "ConfigServer 节点",
+	KeyLabelArchitecture:     "架构",
+	KeyLabelEngineVersion:    "引擎版本",
+	KeyLabelStorageEngine:    "存储引擎",
+	KeyLabelStorage:          "存储空间",
+	KeyLabelMaxConnections:   "最大连接数",
+	KeyLabelMaintenance:      "可维护时间段 (UTC)",
+	KeyMongoDBReplicaSet:     "副本集",
+	KeyMongoDBSharded:        "分片集群",
+	KeyMongoDBNoNodes:        "无节点",
+
+	// Instance details
+	KeyPageRDSJSONDetail:      "RDS JSON 详情",
+	KeyPageRedisJSONDetail:    "Redis JSON 详情",
+	KeyPageRocketMQJSONDetail: "RocketMQ JSON 详情",
+	KeySectionSpec:            "规格",
+	KeySectionEndpoints:       "连接地址",
+	KeySectionWhitelist:       "白名单",
+	KeySectionMaintenance:     "运维",
+	KeyLabelEdition:           "系列",
+	KeyLabelMaxIOPS:           "最大 IOPS",
+	KeyLabelMinorUpgrade:      "小版本升级",
+	KeyLabelKernelVersion:     "内核版本",
+	KeyLabelQPS:               "QPS",
+	KeyLabelShards:            "分片数",
+	KeyLabelMaxTPS:            "TPS 上限",
+	KeyLabelTopicCapacity:     "Topic 数上限",
+	KeyLabelIndependentNaming: "独立命名空间",
+	KeyLabelReleaseTime:       "释放时间",
+
+	// Maintenance windows
+	KeyMaintenanceWindowTitle:   "%s 的可维护时间段（UTC，括号内为本地时间）",
+	KeyMaintenanceWindowChanged: "%s 的可维护时间段已修改为 %s",
+
+	// Elasticsearch
+	KeyMenuElasticsearch:           "(e) Elasticsearch 实例",
+	KeyMenuElasticsearchDesc:       "查看 Elasticsearch 集群的节点规格及 Elasticsearch 和 Kibana 访问地址",
+	KeyPageElasticsearchList:       "Elasticsearch 实例",
+	KeyPageElasticsearchDetail:     "Elasticsearch 详情",
+	KeyPageElasticsearchJSONDetail: "Elasticsearch JSON 详情",
+	KeyLabelDataNodes:              "数据节点",
+	KeyLabelMasterNodes:            "专有主节点",
+	KeyLabelKibanaNodes:            "Kibana 节点",
+
+	// Zone capacity
+	KeyPageZoneCapacity:  "可用区库存",
+	KeyCapacityAvailable: "有库存",
+	KeyCapacityLow:       "库存紧张",
+	KeyCapacitySoldOut:   "售罄",
+
+	// Kafka
+	KeyMenuKafka:             "(A) Kafka 实例",
+	KeyMenuKafkaDesc:         "查看 Kafka 实例、Topic 分区及消费组堆积",
+	KeyPageKafkaList:         "Kafka 实例",
+	KeyPageKafkaDetail:       "Kafka 详情",
+	KeyPageKafkaJSONDetail:   "Kafka JSON 详情",
+	KeyPageKafkaTopics:       "Kafka Topic",
+	KeyPageKafkaGroups:       "Kafka 消费组",
+	KeyLabelTrafficSpec:      "流量规格",
+	KeyLabelMessageRetention: "消息保留时长",
+	KeyLabelPartitions:       "分区数",
+	KeyLabelConsumerGroups:   "消费组数",
+
+	// Finder across profiles
+	KeyFinderProfilesTotal:   "共找到 %d 个匹配资源 (%d 个 Profile)",
+	KeyFinderProfilesNoMatch: "无匹配: %s",
+	KeyFinderProfileFailed:   "查找失败",
+	KeyColErrorMessage:       "错误",
+
+	// RocketMQ lag and messages
+	KeyPageRocketMQLag:      "RocketMQ 消费堆积",
+	KeyPageRocketMQMessages: "RocketMQ 消息",
+
+	// RocketMQ 5.x
+	KeyLabelAPIVersion: "API 版本",
+
+	// Empty list state
+	KeyEmptyStateTitle:       "%[2]s 中没有 %[1]s",
+	KeyEmptyStateCounting:    "正在检查其他地域...",
+	KeyEmptyStateCountFailed: "无法检查其他地域: %v",
+	KeyEmptyStateNoOthers:    "其他地域也没有",
+	KeyEmptyStateOthers:      "%d 个其他地域有此资源:",
+	KeyEmptyStateJump:        "按 enter 切换到 %s",
+	KeyEmptyStateCached:      "数量来自资源中心地域缓存,可能是几天前的数据",
+
+	// Redis parameters and performance
+	KeyPageRedisParameters: "Redis 参数",
+	KeyPageRedisMetrics:    "Redis 性能",
+
+	// Recycle bin
+	KeyMenuRecycleBin:          "(x) 回收站",
+	KeyMenuRecycleBinDesc:      "已过期、已锁定和即将释放的 ECS 与 RDS 实例",
+	KeyPageRecycleBin:          "回收站",
+	KeyPageOSSDeleted:          "已删除对象",
+	KeyRecyclePartial:          "无法列出回收站中的 %s 实例：%v",
+	KeyRecycleConfirmCancel:    "取消 ECS 实例 %s（%s）在 %s 的定时释放？",
+	KeyRecycleReleaseCancelled: "已取消 %s 的定时释放",
+	KeyRecycleRenewInConsole:   "%s 实例 %s：%s。请在控制台续费以保留该实例；续费会产生费用，因此不在此处操作",
+	KeyOSSDeletedTruncated:     "（已截断）",
+	KeyOSSConfirmUndelete:      "删除 oss://%s/%s 的删除标记并恢复其上一个版本？",
+	KeyOSSUndeleted:            "已恢复 %s",
+
+	// Redis backups
+	KeyPageRedisBackups: "Redis 备份",
+
+	// RDS backups
+	KeyPageRDSBackups: "RDS 备份",
+	KeyPageRDSBinlogs: "RDS Binlog",
+
+	// Connection test
+	KeyConnTestTitle:         "测试连接 %s",
+	KeyConnTestAddress:       "地址 (host:port)",
+	KeyConnTestUsername:      "用户名 (可选)",
+	KeyConnTestPassword:      "密码 (可选，用于验证登录)",
+	KeyConnTestNoAddress:     "请输入 host:port 格式的地址",
+	KeyConnTestConnected:     "已连接 %s，耗时 %s",
+	KeyConnTestServer:        "服务器版本: %s",
+	KeyConnTestLoggedIn:      "登录成功: 凭据有效",
+	KeyConnTestNotLoggedIn:   "未验证凭据",
+	KeyConnTestConnectFailed: "无法连接 %s: %v\n\n这是网络问题: 请检查 IP 白名单和安全组，以及本机能否访问该地址 (内网地址仅能在 VPC 内访问)",
+	KeyConnTestAuthFailed:    "已连接 %s，耗时 %s，但登录失败: %v\n\n网络正常: 请检查用户名和密码",
+
+	// RDS slow log
+	KeyPageRDSSlowLog:       "RDS 慢查询",
+	KeyRDSSlowLogRangeTitle: "%s 的慢查询",
+	KeyRDSSlowLogRangeDays:  "最近 %d 天",
+	KeyRDSSlowLogRangeToday: "今天",
+
+	// Quick connect
+	KeyConnectTitle:     "使用 %[2]s 客户端连接 %[1]s",
+	KeyConnectAccount:   "账号",
+	KeyConnectNoAccount: "请输入要连接的账号",
+	KeyConnectNoClient:  "未找到 %s: 请安装它，或在 ~/.aliyun/config.json 的 connect_commands 中设置 %s 的命令",
+
+	// Latency probe
+	KeyProbeTitle:   "延迟探测 %s",
+	KeyProbeHost:    "主机",
+	KeyProbePort:    "TCP 端口 (可选)",
+	KeyProbeNoHost:  "请输入要探测的主机",
+	KeyProbeBadPort: "TCP 端口应为 1 到 65535 的数字，留空则只 ping",
+	KeyProbeSummary: "%s %s: %d/%d 次响应，丢包 %.0f%%",
+	KeyProbeStats:   "最小/平均/最大: %s / %s / %s",
+	KeyProbeFailed:  "%s %s 无法执行: %v",
+	KeyProbeNoReply: "无响应: 本机无法访问该地址，或被安全组/防火墙拦截",
+
+	// SSH port forward
+	KeyPortForwardBastion:     "选择访问 %s 的跳板机",
+	KeyPortForwardNoBastion:   "当前地域没有可用作跳板机的运行中 ECS 实例",
+	KeyPortForwardTitle:       "转发本地端口到 %s",
+	KeyPortForwardLocalPort:   "本地端口",
+	KeyPortForwardEndpoint:    "目标地址 (host:port，跳板机可访问)",
+	KeyPortForwardBastionHost: "跳板机 ([user@]host[:port])",
+	KeyPortForwardBadPort:     "本地端口应为 1 到 65535 的数字",
+	KeyPortForwardBadBastion:  "请输入 [user@]host[:port] 格式的跳板机",
+	KeyPortForwardPortBusy:    "本地端口 %d 不可用: %v",
+	KeyPortForwardNoSSH:       "未在 PATH 中找到 ssh，请安装 OpenSSH 后再转发端口",
+	KeyPortForwardStarted:     "正在转发 %s；按 J 查看隧道，在其中按 x 关闭",
+	KeyJobTunnel:              "隧道 %s",
+
+	// What's New
+	KeyPageChangelog:     "新功能",
+	KeyChangelogSince:    "alidash %s 自 %s 以来的新功能 (a: 全部版本)",
+	KeyChangelogAll:      "各版本新功能，当前 alidash %s",
+	KeyMenuChangelog:     "(W) 新功能",
+	KeyMenuChangelogDesc: "各版本新增的页面和快捷键",
+
+	// Recent Resources
+	KeyPageRecent:         "最近查看",
+	KeyMenuRecent:         "(H) 最近查看",
+	KeyMenuRecentDesc:     "重新打开查看过详情的资源",
+	KeyRecentPick:         "跳转到最近查看的资源",
+	KeyRecentEmpty:        "还没有查看过资源，打开的详情页会列在这里。",
+	KeyRecentSwitchFailed: "切换到配置 %s、地域 %s 失败: %v",
+	KeyRecentGone:         "%s %s 在 %s/%s 中已不存在",
+
+	// Usage Statistics
+	KeyPageUsage:     "使用统计",
+	KeyUsageTitle:    "自 %s 以来的使用情况，仅保存在本机",
+	KeyMenuUsage:     "(U) 使用统计",
+	KeyMenuUsageDesc: "最常用的页面和操作，从不上传",
+
+	// OSS copy and move
+	KeyOSSCopy:               "复制到其他存储桶 - %s",
+	KeyOSSMove:               "移动到其他存储桶 - %s",
+	KeyOSSCopyBucket:         "目标存储桶",
+	KeyOSSCopyPrefix:         "目标目录",
+	KeyOSSCopyWhatKeys:       "%d 个对象（oss://%s/%s）",
+	KeyOSSCopyWhatFolder:     "oss://%s/%s 下的所有对象",
+	KeyOSSCopyBucketRequired: "请输入目标存储桶",
+	KeyJobOSSCopy:            "复制 %s 到 oss://%s/%s",
+	KeyJobOSSMove:            "移动 %s 到 oss://%s/%s",
+	KeyOSSCopied:             "已复制 %d 个对象（%s）到 oss://%s/%s",
+	KeyOSSMoved:              "已移动 %d 个对象（%s）到 oss://%s/%s",
+	KeyOSSCopyCrossRegion:    "两个存储桶位于不同地域，对象经本机下载后重新上传",
+	KeyOSSCopyFailed:         "%d 个对象失败：",
+	KeyOSSCopyStopped:        "已停止：已复制 %d 个对象（%s）到 oss://%s/%s，%d 个未复制",
+	KeyOSSMoveStopped:        "已停止：已移动 %d 个对象（%s）到 oss://%s/%s，%d 个未移动，仍在源位置",
+
+	// DNS import
+	KeyPageDNSImport:         "DNS 导入",
+	KeyDNSImport:             "从 CSV 导入 DNS 变更（domain, rr, type, value, ttl, action）",
+	KeyDNSImportPath:         "CSV 文件",
+	KeyDNSImportTitle:        "DNS 导入 - %s：%d 项待应用，%d 项无变化，%d 项无效",
+	KeyDNSImportAppliedTitle: "DNS 导入 - %s：%d 项已应用，%d 项失败，%d 项无变化",
+	KeyDNSImportInvalid:      "%d 行无效，请修正文件并重新导入后再应用",
+	KeyDNSImportNothing:      "没有需要应用的变更",
+	KeyDNSImportConfirm:      "应用 %[2]s 中的 %[1]d 项 DNS 变更？",
+	KeyDNSImportDone:         "已应用 %d 项 DNS 变更",
+	KeyDNSImportFailed:       "已应用 %d 项 DNS 变更，%d 项失败，详见 Status 列",
+	KeyDNSImportCancelled:    "已停止：已应用 %d 项 DNS 变更，%d 项失败，%d 项未应用仍为 Ready",
+	KeyJobDNSImportPlan:      "检查 %s 中的 DNS 变更",
+	KeyJobDNSImportApply:     "应用 %s 中的 DNS 变更",
+
+	// SLB backends
+	KeySLBBackendNoneMarked:   "请先在 ECS 列表中用空格标记实例",
+	KeySLBBackendNoSLB:        "当前地域没有 SLB 实例",
+	KeySLBBackendPickSLB:      "将 %d 个 ECS 实例添加到 SLB",
+	KeySLBBackendPickGroup:    "%s 的虚拟服务器组",
+	KeySLBBackendNewGroup:     "+ 新建虚拟服务器组",
+	KeySLBBackendAddTitle:     "将 %d 个 ECS 实例添加到 %s",
+	KeySLBBackendCreateTitle:  "在 %s 上新建虚拟服务器组，包含 %d 个 ECS 实例",
+	KeySLBBackendGroupName:    "组名称",
+	KeySLBBackendPort:         "后端端口",
+	KeySLBBackendWeight:       "权重",
+	KeySLBBackendNameRequired: "请输入新虚拟服务器组的名称",
+	KeySLBBackendBadPort:      "无效的后端端口：%s（1-65535）",
+	KeySLBBackendBadWeight:    "无效的权重：%s（0-100）",
+	KeySLBBackendsAdded:       "已将 %d 个后端服务器添加到 %s（%s）",
+	KeySLBBackendGroupCreated: "已在 %[3]s 上创建 %[2]s，包含 %[1]d 个后端服务器",
+
+	// SLB certificates
+	KeySLBCertHTTPSOnly:    "只有 HTTPS 监听有服务器证书",
+	KeySLBCertPick:         "HTTPS 监听 %d（%s）的证书",
+	KeySLBCertUploadOption: "+ 上传新证书",
+	KeySLBCertCurrent:      "（当前）",
+	KeySLBCertExpires:      "%s 到期（%d 天）",
+	KeySLBCertExpiresSoon:  "! %s 到期（%d 天）",
+	KeySLBCertExpired:      "! 已于 %s 过期",
+	KeySLBCertUploadTitle:  "为 HTTPS 监听 %d（%s）上传证书",
+	KeySLBCertName:         "证书名称",
+	KeySLBCertNameHint:     "默认为 域名-到期日",
+	KeySLBCertCertificate:  "证书（PEM 证书链）",
+	KeySLBCertPrivateKey:   "私钥（PEM）",
+	KeySLBCertPEMHint:      "文件路径，或粘贴 PEM 内容",
+	KeySLBCertBindConfirm:  "将 HTTPS 监听 %d（%s）绑定到 %s（%s，%s）？\n\n当前证书：%s",
+	KeySLBCertBound:        "HTTPS 监听 %d（%s）已使用证书 %s",
+
+	// Read-only mode
+	KeyHeaderReadOnly:  "只读",
+	KeyReadOnlyRefused: "只读模式：已禁止修改云资源",
+
+	// Prefetching
+	KeyPrefetchStale:         "%s 前的缓存，刷新中…",
+	KeyPrefetchRefreshFailed: "%s 前的缓存，刷新失败：%v",
+
+	// Cache status
+	KeyCacheAge: "%s 前缓存",
+
+	// ECS paging
+	KeyECSLoadProgress:        "已加载 %d / %d…",
+	KeyECSLoadProgressUnknown: "已加载 %d…",
+
+	// Resource finder progress
+	KeyJobFind:               "查找 %s",
+	KeyFinderSearching:       "正在查找 %s…",
+	KeyFinderProgressPages:   "%d/%d 页",
+	KeyFinderProgressDomains: "%d/%d 个域名",
+	KeyFinderCancelHint:      "esc: 取消查找",
+
+	// API retries
+	KeyActionRetrying: "重试中 (%d/%d)...",
+
+	// API trace
+	KeyPageAPITrace:      "API 调用记录",
+	KeyAPITraceTitle:     "最近 %d 次 API 调用，最新的在前（最多保留 %d 次）",
+	KeyColTraceTime:      "时间",
+	KeyColTraceAction:    "操作",
+	KeyColTraceHost:      "端点",
+	KeyColTraceLatency:   "耗时",
+	KeyColTraceAttempts:  "次数",
+	KeyColTraceRequestID: "请求 ID",
+	KeyColTraceError:     "错误",
+
+	// Permissions
+	KeyNoPermission:    "无权限",
+	KeyFinderDenied:    "无权限，已跳过：%s",
+	KeyNoPermissionFor: "无 %s 的访问权限",
+
+	// Help overlay
+	KeyHelpTitle:      "快捷键：%s",
+	KeyHelpNavigation: "导航",
+	KeyHelpSearch:     "搜索",
+	KeyHelpGoTo:       "跳转",
+	KeyHelpGeneral:    "通用",
+	KeyHelpPage:       "当前页面",
+	KeyHelpTable:      "表格",
+	KeyHelpViewer:     "查看器",
+	KeyHelpHint:       "/: 搜索快捷键 | j/k: 滚动 | esc/?: 关闭",
+	KeyHelpNoMatch:    "没有匹配 %q 的快捷键",
+
+	// Split view
+	KeySplitPreview: "预览",
+	KeySplitNothing: "未选中任何行",
+
+	// Tabs
+	KeyHelpTabs: "标签页",
+
+	// Remaining literals
+	KeyColHealthCheck:  "健康检查",
+	KeyColScheduler:    "调度算法",
+	KeyColCertificate:  "证书",
+	KeyDiskLocalSSD:    "本地 SSD 盘",
+	KeyTableRowsOf:     "%s（%d / %d 行）",
+	KeyTableFilterInfo: " 筛选：%s（%d / %d 行） ",
+	KeySearchInfo:      " 搜索：%s (%d/%d) ",
+	KeyViewportHelp:    "q/Esc: 返回 | yy: 复制 | e: 编辑 | v: 分页查看 | /: 搜索 | n/N: 下一个/上一个",
+	KeyOSSObjectsTitle: "%s 中的对象（第 %d 页）",
+	KeyOSSPage:         "第 %d 页",
+	KeyOSSPrevPage:     "[ 上一页",
+	KeyOSSNextPage:     "] 下一页",
+	KeyOSSFirstPage:    "0 首页",
+	KeyProfileSwitched: "已切换到配置：%s（地域：%s）",
+	KeyUnknownPage:     "未知页面",
+
+	// Timestamps
+	KeyTimeAgo:     "%s前",
+	KeyTimeIn:      "%s后",
+	KeyTimeJustNow: "刚刚",
+	KeyTimeMinutes: "%d分钟",
+	KeyTimeHours:   "%d小时",
+	KeyTimeDays:    "%d天",
+	KeyTimeMonths:  "%d个月",
+	KeyTimeYears:   "%d年",
+
+	// Table selection
+	KeyTableSelectedTitle: "%s - 已选 %d 行",
+	KeyTableSelectionInfo: "已选 %d 行 · yy 复制所选",
+	KeyTableRangeInfo:     "已选 %d 行 · 移动光标扩展范围，V 结束",
+
+	// ECS batch copy
+	KeyECSCopyTitle:      "复制 %d 个实例的",
+	KeyECSCopyIDs:        "实例 ID，每行一个",
+	KeyECSCopyPrivateIPs: "私网 IP，每行一个",
+	KeyECSCopyPublicIPs:  "公网 IP，每行一个",
+	KeyECSCopyNone:       "所选实例均无此项",
+
+	// Column chooser
+	KeyColumnsTitle:      "列：%s",
+	KeyColumnsHint:       "空格 显示/隐藏 · J/K 移动 · r 恢复默认 · 回车 保存 · esc 取消",
+	KeyColumnsSaveFailed: "保存列设置失败：%v",
+
+	// Finder match reasons
+	KeyFinderMatchedBy:    "按%s匹配",
+	KeyFinderMatchIP:      "IP",
+	KeyFinderMatchAddress: "地址",
+	KeyFinderMatchID:      "ID",
+	KeyFinderMatchName:    "名称",
+	KeyFinderMatchTag:     "标签",
+
+	// DNS propagation check
+	KeyDNSPropagationTitle:    "%s %s 的解析生效情况",
+	KeyDNSPropagationExpected: "配置值: %s",
+	KeyDNSPropagationMatch:    "已生效",
+	KeyDNSPropagationStale:    "未生效: %s，缓存最多还有 %d 秒",
+	KeyDNSPropagationMissing:  "无记录",
+	KeyDNSPropagationFailed:   "查询失败: %v",
+	KeyDNSPropagationAll:      "所有解析器均返回配置值",
+	KeyDNSPropagationSome:     "%d/%d 个解析器尚未返回配置值",
+	KeyDNSPropagationPaused:   "该记录已暂停，解析器不应再返回它",
+	KeyDNSPropagationLine:     "该记录的线路为 %s，其他网络的解析器可能得到其他记录",
+
 	// Common
-	KeyActionCopied:  "已复制到剪贴板!",
-	KeyActionLoading: "加载中...",
-	KeyNoData:        "无",
+	KeyActionCopied:        "已复制到剪贴板!",
+	KeyActionOpenedBrowser: "已在浏览器中打开",
+	KeyActionLoading:       "加载中...",
+	KeyNoData:              "无",
 }
 
 // currentLocale caches the current locale
@@ -955,14 +2908,14 @@ func T(key string) string {
 // GetLocale returns the current locale
 func GetLocale() string {
 	if currentLocale == "" {
-		currentLocale = config.GetLocale()
+		currentLocale = configLocale()
 	}
 	return currentLocale
 }
 
 // RefreshLocale forces a refresh of the cached locale
 func RefreshLocale() {
-	currentLocale = config.GetLocale()
+	currentLocale = configLocale()
 }
 
 // SetLocale sets the locale (for testing purposes)
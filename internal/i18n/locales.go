@@ -0,0 +1,91 @@
+package i18n
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"aliyun-tui-viewer/internal/config"
+	"aliyun-tui-viewer/internal/logging"
+)
+
+// loadLocales loads the locale file the config file names once, before the
+// locale is first looked up
+var loadLocales sync.Once
+
+// LoadLocaleFile adds the translations of a JSON or YAML file, by locale
+// such as "ja_JP" and then by key, to the built-in ones. Those of a built-in
+// locale override its own; keys a locale lacks fall back to English
+func LoadLocaleFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading locale file: %w", err)
+	}
+
+	var locales map[string]map[string]string
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &locales)
+	default:
+		err = json.Unmarshal(data, &locales)
+	}
+	if err != nil {
+		return fmt.Errorf("parsing locale file %s: %w", path, err)
+	}
+
+	for locale, keys := range locales {
+		if translations[locale] == nil {
+			translations[locale] = make(map[string]string, len(keys))
+		}
+		for key, value := range keys {
+			translations[locale][key] = value
+		}
+	}
+	return nil
+}
+
+// configLocale returns the locale of the config file or the environment,
+// after loading the locale file: a "locale" only that file has is used as
+// written
+func configLocale() string {
+	loadLocales.Do(func() {
+		if path := config.GetLocaleFile(); path != "" {
+			if err := LoadLocaleFile(path); err != nil {
+				logging.Debug("locale file not loaded", "error", err)
+			}
+		}
+	})
+	if setting := config.GetLocaleSetting(); translations[setting] != nil {
+		return setting
+	}
+	return config.GetLocale()
+}
+
+// Locales returns the locales there are translations for, sorted
+func Locales() []string {
+	locales := make([]string, 0, len(translations))
+	for locale := range translations {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// NextLocale returns the locale after the current one in Locales, wrapping
+// around, to switch through the languages in turn
+func NextLocale() string {
+	locales := Locales()
+	current := GetLocale()
+	for i, locale := range locales {
+		if locale == current {
+			return locales[(i+1)%len(locales)]
+		}
+	}
+	return locales[0]
+}
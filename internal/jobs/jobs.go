@@ -0,0 +1,217 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Status describes where a job is in its lifecycle
+type Status int
+
+const (
+	StatusRunning Status = iota
+	StatusDone
+	StatusFailed
+	StatusCancelled
+)
+
+// String returns the string representation of Status
+func (s Status) String() string {
+	switch s {
+	case StatusRunning:
+		return "Running"
+	case StatusDone:
+		return "Done"
+	case StatusFailed:
+		return "Failed"
+	case StatusCancelled:
+		return "Cancelled"
+	default:
+		return "Unknown"
+	}
+}
+
+// Unit tells how a job's progress counters should be displayed
+type Unit int
+
+const (
+	UnitItems Unit = iota // Done/Total count items, e.g. regions
+	UnitBytes             // Done/Total count bytes
+)
+
+// Reporter is called by a running job to publish its progress
+type Reporter func(done, total int64)
+
+// Job is a snapshot of a background job
+type Job struct {
+	ID       int
+	Title    string
+	Status   Status
+	Unit     Unit
+	Done     int64
+	Total    int64 // 0 when unknown
+	Err      error
+	Started  time.Time
+	Finished time.Time
+}
+
+// Elapsed returns how long the job ran, or has run so far
+func (j Job) Elapsed() time.Duration {
+	if j.Finished.IsZero() {
+		return time.Since(j.Started)
+	}
+	return j.Finished.Sub(j.Started)
+}
+
+// Percent returns the completion percentage, or -1 when the total is unknown
+func (j Job) Percent() int {
+	if j.Total <= 0 {
+		return -1
+	}
+	return int(j.Done * 100 / j.Total)
+}
+
+type entry struct {
+	job    Job
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// Manager keeps track of background jobs. It is safe for concurrent use
+type Manager struct {
+	mu     sync.Mutex
+	nextID int
+	jobs   []*entry
+}
+
+// NewManager creates an empty job manager
+func NewManager() *Manager {
+	return &Manager{}
+}
+
+// Start registers a running job. The returned context is cancelled when the
+// job is cancelled, and report updates its progress
+func (m *Manager) Start(title string, unit Unit) (int, context.Context, Reporter) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nextID++
+	e := &entry{
+		job: Job{
+			ID:      m.nextID,
+			Title:   title,
+			Status:  StatusRunning,
+			Unit:    unit,
+			Started: time.Now(),
+		},
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	m.jobs = append(m.jobs, e)
+
+	report := func(done, total int64) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		e.job.Done = done
+		e.job.Total = total
+	}
+	return e.job.ID, ctx, report
+}
+
+// Finish records the outcome of a job. A job whose context was cancelled is
+// marked cancelled regardless of err
+func (m *Manager) Finish(id int, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e := m.find(id)
+	if e == nil || e.job.Status != StatusRunning {
+		return
+	}
+
+	switch {
+	case e.ctx.Err() != nil || errors.Is(err, context.Canceled):
+		e.job.Status = StatusCancelled
+	case err != nil:
+		e.job.Status = StatusFailed
+		e.job.Err = err
+	default:
+		e.job.Status = StatusDone
+		if e.job.Total > 0 {
+			e.job.Done = e.job.Total
+		}
+	}
+	e.job.Finished = time.Now()
+	e.cancel()
+}
+
+// Cancel asks a running job to stop. It reports whether the job was running
+func (m *Manager) Cancel(id int) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e := m.find(id)
+	if e == nil || e.job.Status != StatusRunning {
+		return false
+	}
+	e.cancel()
+	return true
+}
+
+// Get returns a snapshot of a single job
+func (m *Manager) Get(id int) (Job, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if e := m.find(id); e != nil {
+		return e.job, true
+	}
+	return Job{}, false
+}
+
+// Jobs returns snapshots of all jobs, newest first
+func (m *Manager) Jobs() []Job {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	jobs := make([]Job, len(m.jobs))
+	for i, e := range m.jobs {
+		jobs[len(m.jobs)-1-i] = e.job
+	}
+	return jobs
+}
+
+// Running returns snapshots of the jobs still running, oldest first
+func (m *Manager) Running() []Job {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var jobs []Job
+	for _, e := range m.jobs {
+		if e.job.Status == StatusRunning {
+			jobs = append(jobs, e.job)
+		}
+	}
+	return jobs
+}
+
+// ClearFinished forgets every job that is no longer running
+func (m *Manager) ClearFinished() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	running := m.jobs[:0]
+	for _, e := range m.jobs {
+		if e.job.Status == StatusRunning {
+			running = append(running, e)
+		}
+	}
+	m.jobs = running
+}
+
+// find returns the entry for id; callers must hold mu
+func (m *Manager) find(id int) *entry {
+	for _, e := range m.jobs {
+		if e.job.ID == id {
+			return e
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+// Package logging records the API calls made: the last ones in memory for
+// the API trace page and, with ALIDASH_DEBUG=1, every one as a JSON line in
+// a debug log
+package logging
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// EnvDebug is the environment variable that turns the debug log on
+const EnvDebug = "ALIDASH_DEBUG"
+
+// TraceSize is how many of the last API calls are kept for the trace page
+const TraceSize = 100
+
+// Call is an API call, with its retries
+type Call struct {
+	Seq       int           `json:"seq"` // Order of the call in the session, from 1
+	Time      time.Time     `json:"time"`
+	Profile   string        `json:"profile"`
+	Region    string        `json:"region"`
+	Host      string        `json:"host"`
+	Action    string        `json:"action"` // RPC action, or method and path of REST calls
+	Latency   time.Duration `json:"latency"`
+	Attempts  int           `json:"attempts"`
+	Status    int           `json:"status"` // HTTP status, 0 without a response
+	RequestID string        `json:"request_id,omitempty"`
+	Err       string        `json:"error,omitempty"` // Error code and message, "" on success
+}
+
+var (
+	mu     sync.Mutex
+	seq    int
+	trace  []Call       // The last TraceSize calls, oldest first
+	logger *slog.Logger // nil unless the debug log is open
+)
+
+// LogFilePath returns the path to the debug log
+func LogFilePath() string {
+	usr, err := user.Current()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(usr.HomeDir, ".aliyun", "alidash_debug.log")
+}
+
+// Open starts the debug log when ALIDASH_DEBUG=1, appending to the log file.
+// The returned function closes it
+func Open() (func(), error) {
+	if os.Getenv(EnvDebug) != "1" {
+		return func() {}, nil
+	}
+	path := LogFilePath()
+	if path == "" {
+		return nil, fmt.Errorf("cannot determine debug log path")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	// The log names resources and request IDs, so only the user reads it
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("opening debug log: %w", err)
+	}
+
+	mu.Lock()
+	logger = slog.New(slog.NewJSONHandler(file, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	mu.Unlock()
+	return func() {
+		mu.Lock()
+		logger = nil
+		mu.Unlock()
+		file.Close()
+	}, nil
+}
+
+// Debug writes a message with key-value attributes to the debug log, if it
+// is open
+func Debug(msg string, args ...any) {
+	mu.Lock()
+	l := logger
+	mu.Unlock()
+	if l != nil {
+		l.Debug(msg, args...)
+	}
+}
+
+// Record adds a finished call to the trace and the debug log
+func Record(call Call) {
+	mu.Lock()
+	seq++
+	call.Seq = seq
+	trace = append(trace, call)
+	if len(trace) > TraceSize {
+		trace = trace[len(trace)-TraceSize:]
+	}
+	l := logger
+	mu.Unlock()
+
+	if l == nil {
+		return
+	}
+	attrs := []any{
+		"profile", call.Profile,
+		"region", call.Region,
+		"host", call.Host,
+		"action", call.Action,
+		"latency_ms", call.Latency.Milliseconds(),
+		"attempts", call.Attempts,
+		"status", call.Status,
+		"request_id", call.RequestID,
+	}
+	if call.Err != "" {
+		l.Warn("api call", append(attrs, "error", call.Err)...)
+		return
+	}
+	l.Info("api call", attrs...)
+}
+
+// Recent returns the last calls, newest first
+func Recent() []Call {
+	mu.Lock()
+	defer mu.Unlock()
+	calls := make([]Call, len(trace))
+	for i, call := range trace {
+		calls[len(trace)-1-i] = call
+	}
+	return calls
+}
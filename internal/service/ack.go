@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cs"
+
+	"aliyun-tui-viewer/internal/cache"
+)
+
+// ackPageSize is the page size used with the ACK list calls
+const ackPageSize = 50
+
+// ACKCluster is a Container Service for Kubernetes cluster. The SDK does not
+// model the ACK responses, so they are decoded from the returned JSON
+type ACKCluster struct {
+	ClusterID          string   `json:"cluster_id"`
+	Name               string   `json:"name"`
+	ClusterType        string   `json:"cluster_type"` // ManagedKubernetes, Kubernetes, ExternalKubernetes
+	ClusterSpec        string   `json:"cluster_spec"` // ack.pro.small, ack.standard
+	Profile            string   `json:"profile"`      // Default, Edge, Serverless, Lingjun
+	State              string   `json:"state"`
+	CurrentVersion     string   `json:"current_version"`
+	InitVersion        string   `json:"init_version"`
+	RegionID           string   `json:"region_id"`
+	ZoneID             string   `json:"zone_id"`
+	VpcID              string   `json:"vpc_id"`
+	VSwitchID          string   `json:"vswitch_id"`
+	SecurityGroupID    string   `json:"security_group_id"`
+	ContainerCIDR      string   `json:"container_cidr"`
+	ServiceCIDR        string   `json:"service_cidr"`
+	ProxyMode          string   `json:"proxy_mode"`
+	IPStack            string   `json:"ip_stack"`
+	Size               int      `json:"size"` // Number of nodes
+	Created            string   `json:"created"`
+	Updated            string   `json:"updated"`
+	ResourceGroupID    string   `json:"resource_group_id"`
+	DeletionProtection bool     `json:"deletion_protection"`
+	Tags               []ACKTag `json:"tags"`
+	// MasterURL is a JSON document with the API server endpoints
+	MasterURL string `json:"master_url"`
+}
+
+// ACKTag is a tag of an ACK cluster or a node label
+type ACKTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ACKNodePool is a node pool of an ACK cluster
+type ACKNodePool struct {
+	Info struct {
+		NodePoolID string `json:"nodepool_id"`
+		Name       string `json:"name"`
+		Type       string `json:"type"` // ess, edge, lingjun
+		IsDefault  bool   `json:"is_default"`
+		Created    string `json:"created"`
+	} `json:"nodepool_info"`
+	Status struct {
+		State        string `json:"state"`
+		TotalNodes   int    `json:"total_nodes"`
+		HealthyNodes int    `json:"healthy_nodes"`
+		ReadyNodes   int    `json:"ready_nodes"`
+		FailedNodes  int    `json:"failed_nodes"`
+	} `json:"status"`
+	ScalingGroup struct {
+		InstanceTypes      []string `json:"instance_types"`
+		VSwitchIDs         []string `json:"vswitch_ids"`
+		DesiredSize        int      `json:"desired_size"`
+		ImageID            string   `json:"image_id"`
+		InstanceChargeType string   `json:"instance_charge_type"`
+		SpotStrategy       string   `json:"spot_strategy"`
+		SystemDiskCategory string   `json:"system_disk_category"`
+		SystemDiskSize     int      `json:"system_disk_size"`
+		SecurityGroupIDs   []string `json:"security_group_ids"`
+	} `json:"scaling_group"`
+	AutoScaling struct {
+		Enable       bool `json:"enable"`
+		MinInstances int  `json:"min_instances"`
+		MaxInstances int  `json:"max_instances"`
+	} `json:"auto_scaling"`
+	KubernetesConfig struct {
+		Runtime        string   `json:"runtime"`
+		RuntimeVersion string   `json:"runtime_version"`
+		Labels         []ACKTag `json:"labels"`
+	} `json:"kubernetes_config"`
+}
+
+// ACKClusterDetail is a cluster with its node pools
+type ACKClusterDetail struct {
+	Cluster   ACKCluster
+	NodePools []ACKNodePool
+}
+
+// ACKService handles Container Service for Kubernetes operations
+type ACKService struct {
+	client   *cs.Client
+	regionID string
+
+	cached
+}
+
+// NewACKService creates a new ACK service listing the clusters of a region
+func NewACKService(client *cs.Client, regionID string) *ACKService {
+	return &ACKService{client: client, regionID: regionID}
+}
+
+// FetchClusters retrieves the clusters of the region using pagination
+func (s *ACKService) FetchClusters(ctx context.Context) ([]ACKCluster, error) {
+	return cache.Fetch(ctx, s.cache, CacheACKClusters, s.fetchClusters)
+}
+
+// fetchClusters calls the API for FetchClusters
+func (s *ACKService) fetchClusters(ctx context.Context) ([]ACKCluster, error) {
+	var allClusters []ACKCluster
+	pageNumber := 1
+
+	for {
+		request := cs.CreateDescribeClustersV1Request()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(ackPageSize)
+		request.QueryParams["region_id"] = s.regionID
+
+		response, err := s.client.DescribeClustersV1(request)
+		if err != nil {
+			return nil, fmt.Errorf("describing ACK clusters (page %d): %w", pageNumber, err)
+		}
+
+		var page struct {
+			Clusters []ACKCluster `json:"clusters"`
+			PageInfo struct {
+				TotalCount int `json:"total_count"`
+			} `json:"page_info"`
+		}
+		if err := json.Unmarshal(response.GetHttpContentBytes(), &page); err != nil {
+			return nil, fmt.Errorf("decoding ACK clusters: %w", err)
+		}
+
+		allClusters = append(allClusters, page.Clusters...)
+		if len(page.Clusters) < ackPageSize || len(allClusters) >= page.PageInfo.TotalCount {
+			break
+		}
+		pageNumber++
+	}
+
+	return allClusters, nil
+}
+
+// FetchClusterDetail retrieves a cluster with its node pools
+func (s *ACKService) FetchClusterDetail(ctx context.Context, clusterID string) (*ACKClusterDetail, error) {
+	request := cs.CreateDescribeClusterDetailRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.ClusterId = clusterID
+
+	response, err := s.client.DescribeClusterDetail(request)
+	if err != nil {
+		return nil, fmt.Errorf("describing ACK cluster %s: %w", clusterID, err)
+	}
+
+	detail := &ACKClusterDetail{}
+	if err := json.Unmarshal(response.GetHttpContentBytes(), &detail.Cluster); err != nil {
+		return nil, fmt.Errorf("decoding ACK cluster %s: %w", clusterID, err)
+	}
+
+	detail.NodePools, err = s.FetchNodePools(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return detail, nil
+}
+
+// FetchNodePools retrieves the node pools of a cluster
+func (s *ACKService) FetchNodePools(ctx context.Context, clusterID string) ([]ACKNodePool, error) {
+	request := cs.CreateDescribeClusterNodePoolsRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.ClusterId = clusterID
+
+	response, err := s.client.DescribeClusterNodePools(request)
+	if err != nil {
+		return nil, fmt.Errorf("describing node pools of ACK cluster %s: %w", clusterID, err)
+	}
+
+	var result struct {
+		NodePools []ACKNodePool `json:"nodepools"`
+	}
+	if err := json.Unmarshal(response.GetHttpContentBytes(), &result); err != nil {
+		return nil, fmt.Errorf("decoding node pools of ACK cluster %s: %w", clusterID, err)
+	}
+	return result.NodePools, nil
+}
+
+// FetchNodes retrieves the nodes of a cluster's node pool using pagination
+func (s *ACKService) FetchNodes(ctx context.Context, clusterID, nodePoolID string) ([]cs.Node, error) {
+	var allNodes []cs.Node
+	pageNumber := 1
+
+	for {
+		request := cs.CreateDescribeClusterNodesRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.ClusterId = clusterID
+		request.NodepoolId = nodePoolID
+		request.PageNumber = strconv.Itoa(pageNumber)
+		request.PageSize = strconv.Itoa(ackPageSize)
+
+		response, err := s.client.DescribeClusterNodes(request)
+		if err != nil {
+			return nil, fmt.Errorf("describing nodes of node pool %s (page %d): %w", nodePoolID, pageNumber, err)
+		}
+
+		allNodes = append(allNodes, response.Nodes...)
+		if len(response.Nodes) < ackPageSize || len(allNodes) >= response.Page.TotalCount {
+			break
+		}
+		pageNumber++
+	}
+
+	return allNodes, nil
+}
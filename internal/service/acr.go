@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr_ee"
+
+	"aliyun-tui-viewer/internal/cache"
+)
+
+const (
+	// acrPageSize is the page size used with the ACR list calls
+	acrPageSize = 100
+	// acrMaxTags caps the tags loaded for a repository; the newest are kept
+	acrMaxTags = 1000
+)
+
+// ACRRepository is a repository of an ACR Enterprise Edition instance
+type ACRRepository struct {
+	RepoID       string
+	Name         string
+	Namespace    string
+	InstanceID   string
+	RepoType     string // PUBLIC or PRIVATE
+	Status       string
+	BuildType    string
+	Summary      string
+	CreateTime   time.Time
+	ModifiedTime time.Time
+}
+
+// ACRTag is an image tag of an ACR repository
+type ACRTag struct {
+	Tag      string
+	Digest   string
+	ImageID  string
+	Status   string
+	Size     int64 // Bytes
+	Created  time.Time
+	Updated  time.Time // When the tag was last pushed
+	RepoName string
+}
+
+// ACRService handles Container Registry (ACR) Enterprise Edition operations
+type ACRService struct {
+	client *cr_ee.Client
+
+	cached
+}
+
+// NewACRService creates a new ACR service
+func NewACRService(client *cr_ee.Client) *ACRService {
+	return &ACRService{client: client}
+}
+
+// FetchInstances retrieves the ACR Enterprise Edition instances of the region
+// using pagination
+func (s *ACRService) FetchInstances(ctx context.Context) ([]cr_ee.InstancesItem, error) {
+	return cache.Fetch(ctx, s.cache, CacheACRInstances, s.fetchInstances)
+}
+
+// fetchInstances calls the API for FetchInstances
+func (s *ACRService) fetchInstances(ctx context.Context) ([]cr_ee.InstancesItem, error) {
+	var all []cr_ee.InstancesItem
+	pageNumber := 1
+
+	for {
+		request := cr_ee.CreateListInstanceRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.PageNo = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(acrPageSize)
+
+		response, err := s.client.ListInstance(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing ACR instances (page %d): %w", pageNumber, err)
+		}
+		if !response.ListInstanceIsSuccess {
+			return nil, fmt.Errorf("listing ACR instances: %s", response.Code)
+		}
+
+		all = append(all, response.Instances...)
+		if len(response.Instances) < acrPageSize || len(all) >= response.TotalCount {
+			break
+		}
+		pageNumber++
+	}
+
+	return all, nil
+}
+
+// FetchNamespaces retrieves the namespaces of an instance using pagination
+func (s *ACRService) FetchNamespaces(ctx context.Context, instanceID string) ([]cr_ee.NamespacesItem, error) {
+	var all []cr_ee.NamespacesItem
+	pageNumber := 1
+
+	for {
+		request := cr_ee.CreateListNamespaceRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.InstanceId = instanceID
+		request.PageNo = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(acrPageSize)
+
+		response, err := s.client.ListNamespace(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing namespaces of ACR instance %s (page %d): %w", instanceID, pageNumber, err)
+		}
+		if !response.ListNamespaceIsSuccess {
+			return nil, fmt.Errorf("listing namespaces of ACR instance %s: %s", instanceID, response.Code)
+		}
+
+		all = append(all, response.Namespaces...)
+		if len(response.Namespaces) < acrPageSize || len(all) >= atoiOrZero(response.TotalCount) {
+			break
+		}
+		pageNumber++
+	}
+
+	return all, nil
+}
+
+// FetchRepositories retrieves the repositories of a namespace using
+// pagination, most recently modified first
+func (s *ACRService) FetchRepositories(ctx context.Context, instanceID, namespace string) ([]ACRRepository, error) {
+	var all []ACRRepository
+	pageNumber := 1
+
+	for {
+		request := cr_ee.CreateListRepositoryRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.InstanceId = instanceID
+		request.RepoNamespaceName = namespace
+		request.PageNo = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(acrPageSize)
+
+		response, err := s.client.ListRepository(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing repositories of ACR namespace %s (page %d): %w", namespace, pageNumber, err)
+		}
+		if !response.ListRepositoryIsSuccess {
+			return nil, fmt.Errorf("listing repositories of ACR namespace %s: %s", namespace, response.Code)
+		}
+
+		for _, repo := range response.Repositories {
+			all = append(all, ACRRepository{
+				RepoID:       repo.RepoId,
+				Name:         repo.RepoName,
+				Namespace:    repo.RepoNamespaceName,
+				InstanceID:   repo.InstanceId,
+				RepoType:     repo.RepoType,
+				Status:       repo.RepoStatus,
+				BuildType:    repo.RepoBuildType,
+				Summary:      repo.Summary,
+				CreateTime:   time.UnixMilli(repo.CreateTime),
+				ModifiedTime: time.UnixMilli(repo.ModifiedTime),
+			})
+		}
+		if len(response.Repositories) < acrPageSize || len(all) >= atoiOrZero(response.TotalCount) {
+			break
+		}
+		pageNumber++
+	}
+
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].ModifiedTime.After(all[j].ModifiedTime)
+	})
+	return all, nil
+}
+
+// FetchTags retrieves the image tags of a repository, most recently pushed
+// first. ListRepoTag lists the newest tags first, so stopping at acrMaxTags
+// keeps the recent ones
+func (s *ACRService) FetchTags(ctx context.Context, repo ACRRepository) ([]ACRTag, error) {
+	var all []ACRTag
+	pageNumber := 1
+
+	for {
+		request := cr_ee.CreateListRepoTagRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.InstanceId = repo.InstanceID
+		request.RepoId = repo.RepoID
+		request.PageNo = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(acrPageSize)
+
+		response, err := s.client.ListRepoTag(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing tags of ACR repository %s/%s (page %d): %w", repo.Namespace, repo.Name, pageNumber, err)
+		}
+		if !response.ListRepoTagIsSuccess {
+			return nil, fmt.Errorf("listing tags of ACR repository %s/%s: %s", repo.Namespace, repo.Name, response.Code)
+		}
+
+		for _, image := range response.Images {
+			all = append(all, ACRTag{
+				Tag:      image.Tag,
+				Digest:   image.Digest,
+				ImageID:  image.ImageId,
+				Status:   image.Status,
+				Size:     image.ImageSize,
+				Created:  parseACRTime(image.ImageCreate),
+				Updated:  parseACRTime(image.ImageUpdate),
+				RepoName: repo.Name,
+			})
+		}
+		if len(response.Images) < acrPageSize || len(all) >= atoiOrZero(response.TotalCount) || len(all) >= acrMaxTags {
+			break
+		}
+		pageNumber++
+	}
+
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].Updated.After(all[j].Updated)
+	})
+	return all, nil
+}
+
+// parseACRTime parses an ACR timestamp, given in milliseconds since the epoch
+func parseACRTime(value string) time.Time {
+	ms, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || ms <= 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
+// atoiOrZero parses a count the API returns as a string, 0 when it is not a
+// number
+func atoiOrZero(value string) int {
+	n, _ := strconv.Atoi(value)
+	return n
+}
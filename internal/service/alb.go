@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alb"
+
+	"aliyun-tui-viewer/internal/cache"
+)
+
+// lbPageSize is the page size used with the ALB and NLB list calls
+const lbPageSize = 100
+
+// LBServer is a backend server of an ALB or NLB server group
+type LBServer struct {
+	ServerID      string
+	ServerType    string // Ecs, Eni, Eci, Ip or Fc
+	ServerIP      string
+	Port          int
+	Weight        int
+	Status        string
+	Description   string
+	ZoneID        string // NLB only
+	ServerGroupID string
+}
+
+// ALBService handles Application Load Balancer operations
+type ALBService struct {
+	client *alb.Client
+
+	cached
+}
+
+// NewALBService creates a new ALB service
+func NewALBService(client *alb.Client) *ALBService {
+	return &ALBService{client: client}
+}
+
+// FetchLoadBalancers retrieves all ALB instances of the region using pagination
+func (s *ALBService) FetchLoadBalancers(ctx context.Context) ([]alb.LoadBalancer, error) {
+	return cache.Fetch(ctx, s.cache, CacheALBLoadBalancers, s.fetchLoadBalancers)
+}
+
+// fetchLoadBalancers calls the API for FetchLoadBalancers
+func (s *ALBService) fetchLoadBalancers(ctx context.Context) ([]alb.LoadBalancer, error) {
+	var all []alb.LoadBalancer
+	nextToken := ""
+
+	for {
+		request := alb.CreateListLoadBalancersRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.MaxResults = requests.NewInteger(lbPageSize)
+		request.NextToken = nextToken
+
+		response, err := s.client.ListLoadBalancers(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing ALB instances: %w", err)
+		}
+
+		all = append(all, response.LoadBalancers...)
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+
+	return all, nil
+}
+
+// FetchListeners retrieves the listeners of an ALB instance
+func (s *ALBService) FetchListeners(ctx context.Context, loadBalancerID string) ([]alb.Listener, error) {
+	var all []alb.Listener
+	nextToken := ""
+
+	for {
+		request := alb.CreateListListenersRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.LoadBalancerIds = &[]string{loadBalancerID}
+		request.MaxResults = requests.NewInteger(lbPageSize)
+		request.NextToken = nextToken
+
+		response, err := s.client.ListListeners(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing listeners of ALB %s: %w", loadBalancerID, err)
+		}
+
+		all = append(all, response.Listeners...)
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+
+	return all, nil
+}
+
+// FetchRules retrieves the forwarding rules of an ALB listener, ordered by
+// priority as they are evaluated
+func (s *ALBService) FetchRules(ctx context.Context, listenerID string) ([]alb.Rule, error) {
+	var all []alb.Rule
+	nextToken := ""
+
+	for {
+		request := alb.CreateListRulesRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.ListenerIds = &[]string{listenerID}
+		request.MaxResults = requests.NewInteger(lbPageSize)
+		request.NextToken = nextToken
+
+		response, err := s.client.ListRules(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing rules of ALB listener %s: %w", listenerID, err)
+		}
+
+		all = append(all, response.Rules...)
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+
+	slices.SortStableFunc(all, func(a, b alb.Rule) int { return a.Priority - b.Priority })
+	return all, nil
+}
+
+// FetchServerGroups retrieves the server groups used by an ALB instance.
+// Server groups are not owned by a load balancer, so every group of the
+// region is listed and those related to loadBalancerID are kept
+func (s *ALBService) FetchServerGroups(ctx context.Context, loadBalancerID string) ([]alb.ServerGroup, error) {
+	var matched []alb.ServerGroup
+	nextToken := ""
+
+	for {
+		request := alb.CreateListServerGroupsRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.ShowRelationEnabled = requests.NewBoolean(true)
+		request.MaxResults = requests.NewInteger(lbPageSize)
+		request.NextToken = nextToken
+
+		response, err := s.client.ListServerGroups(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing ALB server groups: %w", err)
+		}
+
+		for _, group := range response.ServerGroups {
+			if slices.Contains(group.RelatedLoadBalancerIds, loadBalancerID) {
+				matched = append(matched, group)
+			}
+		}
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+
+	return matched, nil
+}
+
+// FetchServerGroupServers retrieves the backend servers of an ALB server group
+func (s *ALBService) FetchServerGroupServers(ctx context.Context, serverGroupID string) ([]LBServer, error) {
+	var all []LBServer
+	nextToken := ""
+
+	for {
+		request := alb.CreateListServerGroupServersRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.ServerGroupId = serverGroupID
+		request.MaxResults = requests.NewInteger(lbPageSize)
+		request.NextToken = nextToken
+
+		response, err := s.client.ListServerGroupServers(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing servers of ALB server group %s: %w", serverGroupID, err)
+		}
+
+		for _, server := range response.Servers {
+			all = append(all, LBServer{
+				ServerID:      server.ServerId,
+				ServerType:    server.ServerType,
+				ServerIP:      server.ServerIp,
+				Port:          server.Port,
+				Weight:        server.Weight,
+				Status:        server.Status,
+				Description:   server.Description,
+				ServerGroupID: server.ServerGroupId,
+			})
+		}
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+
+	return all, nil
+}
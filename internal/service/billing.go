@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/bssopenapi"
+)
+
+// billingPageSize is the page size used with the billing list calls
+const billingPageSize = 300
+
+// ProductCost is the month-to-date spend of one product
+type ProductCost struct {
+	ProductCode string
+	ProductName string
+	GrossAmount float64 // Before discounts
+	Amount      float64 // Pre-tax amount payable
+}
+
+// BillingOverview summarizes the account's spend and balance
+type BillingOverview struct {
+	BillingCycle    string // Current month, e.g. 2026-10
+	Currency        string
+	AvailableAmount string // Remaining balance, formatted by the API, e.g. "1,024.00"
+	CreditAmount    string
+	MonthToDate     float64
+	Yesterday       float64
+	Products        []ProductCost // Sorted by amount, highest first
+}
+
+// InstanceCost is the month-to-date spend of one instance
+type InstanceCost struct {
+	InstanceID   string
+	BillingCycle string
+	Currency     string
+	Amount       float64
+	GrossAmount  float64
+	Subscription string // Subscription or PayAsYouGo, of the last bill item
+}
+
+// BillingService handles billing (BSS OpenAPI) operations
+type BillingService struct {
+	client *bssopenapi.Client
+}
+
+// NewBillingService creates a new billing service
+func NewBillingService(client *bssopenapi.Client) *BillingService {
+	return &BillingService{client: client}
+}
+
+// billingCycle returns the billing cycle containing t, e.g. 2026-10
+func billingCycle(t time.Time) string {
+	return t.Format("2006-01")
+}
+
+// FetchOverview retrieves the balance, the current month's spend per product
+// and yesterday's spend, as of now
+func (s *BillingService) FetchOverview(ctx context.Context, now time.Time) (*BillingOverview, error) {
+	overview := &BillingOverview{BillingCycle: billingCycle(now)}
+
+	balance := bssopenapi.CreateQueryAccountBalanceRequest()
+	balance.Scheme = "https"
+	bind(ctx, balance)
+	balanceResp, err := s.client.QueryAccountBalance(balance)
+	if err != nil {
+		return nil, fmt.Errorf("querying account balance: %w", err)
+	}
+	overview.Currency = balanceResp.Data.Currency
+	overview.AvailableAmount = balanceResp.Data.AvailableAmount
+	overview.CreditAmount = balanceResp.Data.CreditAmount
+
+	bills := bssopenapi.CreateQueryBillOverviewRequest()
+	bills.Scheme = "https"
+	bind(ctx, bills)
+	bills.BillingCycle = overview.BillingCycle
+	billsResp, err := s.client.QueryBillOverview(bills)
+	if err != nil {
+		return nil, fmt.Errorf("querying bill overview of %s: %w", overview.BillingCycle, err)
+	}
+
+	// Items are split by subscription type, so add them up per product
+	byProduct := make(map[string]*ProductCost)
+	for _, item := range billsResp.Data.Items.Item {
+		cost, ok := byProduct[item.ProductCode]
+		if !ok {
+			cost = &ProductCost{ProductCode: item.ProductCode, ProductName: item.ProductName}
+			byProduct[item.ProductCode] = cost
+		}
+		cost.GrossAmount += item.PretaxGrossAmount
+		cost.Amount += item.PretaxAmount
+		overview.MonthToDate += item.PretaxAmount
+	}
+	for _, cost := range byProduct {
+		overview.Products = append(overview.Products, *cost)
+	}
+	sort.Slice(overview.Products, func(i, j int) bool {
+		return overview.Products[i].Amount > overview.Products[j].Amount
+	})
+
+	overview.Yesterday, err = s.fetchDailySpend(ctx, now.AddDate(0, 0, -1))
+	if err != nil {
+		return nil, err
+	}
+	return overview, nil
+}
+
+// fetchDailySpend retrieves the total pre-tax spend of one day
+func (s *BillingService) fetchDailySpend(ctx context.Context, day time.Time) (float64, error) {
+	var total float64
+	pageNum := 1
+
+	for {
+		request := bssopenapi.CreateQueryAccountBillRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.BillingCycle = billingCycle(day)
+		request.BillingDate = day.Format("2006-01-02")
+		request.Granularity = "DAILY"
+		request.PageNum = requests.NewInteger(pageNum)
+		request.PageSize = requests.NewInteger(billingPageSize)
+
+		response, err := s.client.QueryAccountBill(request)
+		if err != nil {
+			return 0, fmt.Errorf("querying bill of %s (page %d): %w", request.BillingDate, pageNum, err)
+		}
+
+		items := response.Data.Items.Item
+		for _, item := range items {
+			total += item.PretaxAmount
+		}
+		if len(items) < billingPageSize || pageNum*billingPageSize >= response.Data.TotalCount {
+			break
+		}
+		pageNum++
+	}
+
+	return total, nil
+}
+
+// FetchInstanceCost retrieves the month-to-date spend of an instance of any
+// product, as of now
+func (s *BillingService) FetchInstanceCost(ctx context.Context, instanceID string, now time.Time) (*InstanceCost, error) {
+	cost := &InstanceCost{InstanceID: instanceID, BillingCycle: billingCycle(now)}
+	nextToken := ""
+
+	for {
+		request := bssopenapi.CreateDescribeInstanceBillRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.BillingCycle = cost.BillingCycle
+		request.InstanceID = instanceID
+		request.MaxResults = requests.NewInteger(billingPageSize)
+		request.NextToken = nextToken
+
+		response, err := s.client.DescribeInstanceBill(request)
+		if err != nil {
+			return nil, fmt.Errorf("describing bill of instance %s: %w", instanceID, err)
+		}
+
+		for _, item := range response.Data.Items {
+			cost.Amount += item.PretaxAmount
+			cost.GrossAmount += item.PretaxGrossAmount
+			cost.Currency = item.Currency
+			cost.Subscription = item.SubscriptionType
+		}
+		if response.Data.NextToken == "" {
+			break
+		}
+		nextToken = response.Data.NextToken
+	}
+
+	return cost, nil
+}
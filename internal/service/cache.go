@@ -0,0 +1,47 @@
+package service
+
+import "aliyun-tui-viewer/internal/cache"
+
+// Cache resources of the list fetches, as the mode line looks them up
+const (
+	CacheECSInstances      = "ecs.instances"
+	CacheSecurityGroups    = "ecs.security_groups"
+	CacheDNSDomains        = "dns.domains"
+	CacheSLBInstances      = "slb.instances"
+	CacheALBLoadBalancers  = "alb.load_balancers"
+	CacheNLBLoadBalancers  = "nlb.load_balancers"
+	CacheOSSBuckets        = "oss.buckets"
+	CacheRDSInstances      = "rds.instances"
+	CacheRDSDetailed       = "rds.detailed_instances"
+	CacheRedisInstances    = "redis.instances"
+	CacheMongoDBInstances  = "mongodb.instances"
+	CacheESInstances       = "elasticsearch.instances"
+	CacheKafkaInstances    = "kafka.instances"
+	CacheRocketMQInstances = "rocketmq.instances"
+	CacheRAMUsers          = "ram.users"
+	CacheRAMRoles          = "ram.roles"
+	CacheRAMPolicies       = "ram.policies"
+	CacheSLSProjects       = "sls.projects"
+	CacheConfigRules       = "config.rules"
+	CacheNATGateways       = "nat.gateways"
+	CacheACKClusters       = "ack.clusters"
+	CacheACRInstances      = "acr.instances"
+	CacheFCServices        = "fc.services"
+	CacheTags              = "tag.tags"
+)
+
+// Cacheable is a service whose list fetches can be cached
+type Cacheable interface {
+	SetCache(scope *cache.Scope)
+}
+
+// cached gives a service the cache scope its list fetches go through. Without
+// one they always call the API
+type cached struct {
+	cache *cache.Scope
+}
+
+// SetCache makes the list fetches reuse results from scope
+func (c *cached) SetCache(scope *cache.Scope) {
+	c.cache = scope
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+)
+
+// Stock categories DescribeAvailableResource reports for an instance type
+const (
+	StockAvailable = "WithStock"
+	StockLow       = "ClosedWithStock" // Still sold, but about to run out
+	StockSoldOut   = "WithoutStock"
+)
+
+// ZoneFamilyCapacity is the stock of the instance types of a family in a zone
+type ZoneFamilyCapacity struct {
+	ZoneId    string
+	Family    string   // e.g. ecs.g7
+	Available []string // Types with stock
+	Low       []string // Types about to run out
+	SoldOut   []string
+}
+
+// CanCreate reports whether any type of the family can be created in the zone
+func (c ZoneFamilyCapacity) CanCreate() bool {
+	return len(c.Available) > 0 || len(c.Low) > 0
+}
+
+// instanceTypeFamily returns the family of an instance type, e.g. ecs.g7 for
+// ecs.g7.large
+func instanceTypeFamily(instanceType string) string {
+	if i := strings.LastIndex(instanceType, "."); i > 0 {
+		return instanceType[:i]
+	}
+	return instanceType
+}
+
+// FetchZoneCapacity retrieves which pay-as-you-go instance types can be
+// created in each zone of the region, grouped by family and sorted by zone
+// and family
+func (s *ECSService) FetchZoneCapacity(ctx context.Context) ([]ZoneFamilyCapacity, error) {
+	request := ecs.CreateDescribeAvailableResourceRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.DestinationResource = "InstanceType"
+	request.InstanceChargeType = "PostPaid"
+	request.IoOptimized = "optimized"
+
+	response, err := s.client.DescribeAvailableResource(request)
+	if err != nil {
+		return nil, fmt.Errorf("describing available resources: %w", err)
+	}
+
+	var capacity []ZoneFamilyCapacity
+	for _, zone := range response.AvailableZones.AvailableZone {
+		families := make(map[string]*ZoneFamilyCapacity)
+		for _, resource := range zone.AvailableResources.AvailableResource {
+			if resource.Type != "InstanceType" {
+				continue
+			}
+			for _, supported := range resource.SupportedResources.SupportedResource {
+				family := instanceTypeFamily(supported.Value)
+				entry, ok := families[family]
+				if !ok {
+					entry = &ZoneFamilyCapacity{ZoneId: zone.ZoneId, Family: family}
+					families[family] = entry
+				}
+				switch supported.StatusCategory {
+				case StockAvailable:
+					entry.Available = append(entry.Available, supported.Value)
+				case StockLow:
+					entry.Low = append(entry.Low, supported.Value)
+				default:
+					entry.SoldOut = append(entry.SoldOut, supported.Value)
+				}
+			}
+		}
+		for _, entry := range families {
+			capacity = append(capacity, *entry)
+		}
+	}
+
+	sort.Slice(capacity, func(i, j int) bool {
+		if capacity[i].ZoneId != capacity[j].ZoneId {
+			return capacity[i].ZoneId < capacity[j].ZoneId
+		}
+		return capacity[i].Family < capacity[j].Family
+	})
+	return capacity, nil
+}
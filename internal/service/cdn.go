@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cdn"
+)
+
+// CDNService handles CDN operations
+type CDNService struct {
+	client *cdn.Client
+}
+
+// NewCDNService creates a new CDN service
+func NewCDNService(client *cdn.Client) *CDNService {
+	return &CDNService{client: client}
+}
+
+// FetchDomains retrieves all accelerated domains of the account using pagination
+func (s *CDNService) FetchDomains(ctx context.Context) ([]cdn.PageData, error) {
+	var allDomains []cdn.PageData
+	pageNumber := 1
+	pageSize := 500
+
+	for {
+		request := cdn.CreateDescribeUserDomainsRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(pageSize)
+
+		response, err := s.client.DescribeUserDomains(request)
+		if err != nil {
+			return nil, fmt.Errorf("describing CDN domains (page %d): %w", pageNumber, err)
+		}
+
+		allDomains = append(allDomains, response.Domains.PageData...)
+		if len(response.Domains.PageData) < pageSize || int64(len(allDomains)) >= response.TotalCount {
+			break
+		}
+		pageNumber++
+	}
+
+	return allDomains, nil
+}
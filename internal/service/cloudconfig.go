@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	cloudconfig "github.com/aliyun/alibaba-cloud-sdk-go/services/config"
+
+	"aliyun-tui-viewer/internal/cache"
+)
+
+// cloudConfigPageSize is the largest page the Cloud Config list APIs accept
+const cloudConfigPageSize = 100
+
+// Compliance types reported by Cloud Config rules and evaluation results
+const (
+	ComplianceCompliant    = "COMPLIANT"
+	ComplianceNonCompliant = "NON_COMPLIANT"
+)
+
+// CloudConfigService handles Cloud Config (compliance rule) operations
+type CloudConfigService struct {
+	client *cloudconfig.Client
+
+	cached
+}
+
+// NewCloudConfigService creates a new Cloud Config service
+func NewCloudConfigService(client *cloudconfig.Client) *CloudConfigService {
+	return &CloudConfigService{client: client}
+}
+
+// FetchRules retrieves all Cloud Config rules of the account with their
+// compliance summary, non-compliant rules first
+func (s *CloudConfigService) FetchRules(ctx context.Context) ([]cloudconfig.ConfigRule, error) {
+	return cache.Fetch(ctx, s.cache, CacheConfigRules, s.fetchRules)
+}
+
+// fetchRules calls the API for FetchRules
+func (s *CloudConfigService) fetchRules(ctx context.Context) ([]cloudconfig.ConfigRule, error) {
+	var allRules []cloudconfig.ConfigRule
+	pageNumber := 1
+
+	for {
+		request := cloudconfig.CreateListConfigRulesRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(cloudConfigPageSize)
+
+		response, err := s.client.ListConfigRules(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing Cloud Config rules (page %d): %w", pageNumber, err)
+		}
+
+		rules := response.ConfigRules.ConfigRuleList
+		allRules = append(allRules, rules...)
+		if len(rules) < cloudConfigPageSize || int64(len(allRules)) >= response.ConfigRules.TotalCount {
+			break
+		}
+		pageNumber++
+	}
+
+	sort.SliceStable(allRules, func(i, j int) bool {
+		iBad := allRules[i].Compliance.ComplianceType == ComplianceNonCompliant
+		jBad := allRules[j].Compliance.ComplianceType == ComplianceNonCompliant
+		if iBad != jBad {
+			return iBad
+		}
+		return iBad && allRules[i].Compliance.Count > allRules[j].Compliance.Count
+	})
+	return allRules, nil
+}
+
+// FetchNonCompliantResources retrieves the resources a rule evaluated as
+// non-compliant, using NextToken pagination
+func (s *CloudConfigService) FetchNonCompliantResources(ctx context.Context, ruleID string) ([]cloudconfig.EvaluationResult, error) {
+	var allResults []cloudconfig.EvaluationResult
+	nextToken := ""
+
+	for {
+		request := cloudconfig.CreateListConfigRuleEvaluationResultsRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.ConfigRuleId = ruleID
+		request.ComplianceType = ComplianceNonCompliant
+		request.MaxResults = requests.NewInteger(cloudConfigPageSize)
+		request.NextToken = nextToken
+
+		response, err := s.client.ListConfigRuleEvaluationResults(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing evaluation results of Cloud Config rule %s: %w", ruleID, err)
+		}
+
+		allResults = append(allResults, response.EvaluationResults.EvaluationResultList...)
+		if response.EvaluationResults.NextToken == "" {
+			break
+		}
+		nextToken = response.EvaluationResults.NextToken
+	}
+
+	return allResults, nil
+}
@@ -0,0 +1,398 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// Protocols a connection test can speak after the TCP connect
+const (
+	ConnProtocolTCP   = "tcp"   // Connect only
+	ConnProtocolMySQL = "mysql" // MySQL and MariaDB handshake and login
+	ConnProtocolRedis = "redis" // Redis AUTH
+)
+
+// ConnTestTimeout bounds each step of a connection test
+const ConnTestTimeout = 5 * time.Second
+
+// Steps of a connection test, reported as the step that failed
+const (
+	ConnStageConnect = "connect"
+	ConnStageAuth    = "auth"
+)
+
+// ConnTestTarget is the endpoint to test. Credentials are optional: without a
+// password only the TCP connect (and for MySQL the server greeting) is
+// checked
+type ConnTestTarget struct {
+	Address  string // host:port
+	Protocol string
+	Username string
+	Password string
+}
+
+// ConnTestResult is the outcome of a connection test. Stage is empty when
+// every step passed, otherwise it names the step Err comes from, which tells
+// network problems (connect) from credential problems (auth)
+type ConnTestResult struct {
+	Address       string
+	Latency       time.Duration // Time to establish the TCP connection
+	Server        string        // Server version from the greeting, when the protocol has one
+	Authenticated bool
+	Stage         string
+	Err           error
+}
+
+// ConnProtocolForEngine returns the protocol to test an RDS engine with
+func ConnProtocolForEngine(engine string) string {
+	switch strings.ToLower(engine) {
+	case "mysql", "mariadb":
+		return ConnProtocolMySQL
+	default:
+		return ConnProtocolTCP
+	}
+}
+
+// DefaultPortForEngine returns the port RDS instances of an engine listen on
+// unless it was changed
+func DefaultPortForEngine(engine string) int {
+	switch strings.ToLower(engine) {
+	case "postgresql":
+		return 5432
+	case "sqlserver":
+		return 1433
+	default:
+		return 3306
+	}
+}
+
+// TestConnection connects to the target from the local machine and, if a
+// password is given, logs in with it
+func TestConnection(target ConnTestTarget) ConnTestResult {
+	result := ConnTestResult{Address: target.Address}
+
+	start := time.Now()
+	conn, err := net.DialTimeout("tcp", target.Address, ConnTestTimeout)
+	if err != nil {
+		result.Stage, result.Err = ConnStageConnect, err
+		return result
+	}
+	defer conn.Close()
+	result.Latency = time.Since(start)
+
+	if err := conn.SetDeadline(time.Now().Add(ConnTestTimeout)); err != nil {
+		result.Stage, result.Err = ConnStageConnect, err
+		return result
+	}
+
+	switch target.Protocol {
+	case ConnProtocolMySQL:
+		result.Server, result.Stage, err = mysqlLogin(conn, target.Username, target.Password)
+	case ConnProtocolRedis:
+		if target.Password != "" {
+			result.Stage = ConnStageAuth
+			err = redisAuth(conn, target.Username, target.Password)
+		}
+	}
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.Stage = ""
+	result.Authenticated = target.Password != "" && target.Protocol != ConnProtocolTCP
+	return result
+}
+
+// redisAuth sends AUTH, with the account name for ACL users
+func redisAuth(conn net.Conn, username, password string) error {
+	args := []string{"AUTH", password}
+	if username != "" {
+		args = []string{"AUTH", username, password}
+	}
+	var cmd strings.Builder
+	fmt.Fprintf(&cmd, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(&cmd, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	if _, err := io.WriteString(conn, cmd.String()); err != nil {
+		return err
+	}
+
+	reply, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("reading AUTH reply: %w", err)
+	}
+	reply = strings.TrimSpace(reply)
+	if strings.HasPrefix(reply, "-") {
+		return errors.New(strings.TrimPrefix(reply, "-"))
+	}
+	return nil
+}
+
+// MySQL protocol constants used by the login
+const (
+	mysqlClientLongPassword     = 0x00000001
+	mysqlClientProtocol41       = 0x00000200
+	mysqlClientTransactions     = 0x00002000
+	mysqlClientSecureConnection = 0x00008000
+	mysqlClientPluginAuth       = 0x00080000
+	mysqlCharsetUTF8            = 33
+	mysqlMaxPacket              = 1 << 24
+
+	mysqlNativePassword = "mysql_native_password"
+	mysqlCachingSHA2    = "caching_sha2_password"
+)
+
+// mysqlConn reads and writes MySQL packets, tracking their sequence number
+type mysqlConn struct {
+	conn io.ReadWriter
+	seq  byte
+}
+
+func (c *mysqlConn) readPacket() ([]byte, error) {
+	var header [4]byte
+	if _, err := io.ReadFull(c.conn, header[:]); err != nil {
+		return nil, err
+	}
+	length := int(header[0]) | int(header[1])<<8 | int(header[2])<<16
+	c.seq = header[3] + 1
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.conn, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+func (c *mysqlConn) writePacket(payload []byte) error {
+	header := []byte{byte(len(payload)), byte(len(payload) >> 8), byte(len(payload) >> 16), c.seq}
+	c.seq++
+	_, err := c.conn.Write(append(header, payload...))
+	return err
+}
+
+// mysqlError turns an ERR packet into an error, e.g. "1045 (28000): Access
+// denied for user ..."
+func mysqlError(payload []byte) error {
+	if len(payload) < 3 {
+		return errors.New("malformed MySQL error packet")
+	}
+	code := binary.LittleEndian.Uint16(payload[1:3])
+	message := payload[3:]
+	if len(message) >= 6 && message[0] == '#' {
+		return fmt.Errorf("%d (%s): %s", code, message[1:6], message[6:])
+	}
+	return fmt.Errorf("%d: %s", code, message)
+}
+
+// mysqlLogin reads the server greeting and, if a password is given, logs in.
+// It returns the server version and the stage of any error: a server that
+// refuses the client before the login, e.g. for its host, fails the connect
+func mysqlLogin(conn net.Conn, username, password string) (string, string, error) {
+	c := &mysqlConn{conn: conn}
+	greeting, err := c.readPacket()
+	if err != nil {
+		return "", ConnStageConnect, fmt.Errorf("reading MySQL greeting: %w", err)
+	}
+	if len(greeting) > 0 && greeting[0] == 0xff {
+		return "", ConnStageConnect, mysqlError(greeting)
+	}
+	version, scramble, plugin, err := parseMySQLGreeting(greeting)
+	if err != nil {
+		return "", ConnStageConnect, err
+	}
+	if password == "" {
+		return version, "", nil
+	}
+
+	if plugin != mysqlCachingSHA2 {
+		plugin = mysqlNativePassword
+	}
+	if err := c.writePacket(mysqlHandshakeResponse(username, plugin, mysqlScramble(plugin, password, scramble))); err != nil {
+		return version, ConnStageAuth, err
+	}
+	return version, ConnStageAuth, mysqlAuthResult(c, password, plugin, scramble)
+}
+
+// parseMySQLGreeting reads the server version, the scramble and the default
+// authentication plugin from a protocol 10 greeting
+func parseMySQLGreeting(p []byte) (version string, scramble []byte, plugin string, err error) {
+	malformed := errors.New("not a MySQL server greeting")
+	if len(p) < 1 || p[0] != 10 {
+		return "", nil, "", malformed
+	}
+	end := bytes.IndexByte(p[1:], 0)
+	if end < 0 {
+		return "", nil, "", malformed
+	}
+	version = string(p[1 : 1+end])
+	pos := 1 + end + 1 + 4 // version, NUL, connection ID
+	if len(p) < pos+8+1+2+1+2+2+1+10 {
+		return "", nil, "", malformed
+	}
+	scramble = append(scramble, p[pos:pos+8]...)
+	pos += 8 + 1 + 2 + 1 + 2 + 2 // scramble, filler, capabilities, charset, status, capabilities
+	authLen := int(p[pos])
+	pos += 1 + 10
+	rest := authLen - 8
+	if rest < 13 {
+		rest = 13
+	}
+	if len(p) >= pos+rest {
+		scramble = append(scramble, bytes.TrimRight(p[pos:pos+rest], "\x00")...)
+		pos += rest
+		if end := bytes.IndexByte(p[pos:], 0); end >= 0 {
+			plugin = string(p[pos : pos+end])
+		} else {
+			plugin = string(p[pos:])
+		}
+	}
+	return version, scramble, plugin, nil
+}
+
+// mysqlHandshakeResponse builds the login packet of the 4.1 protocol
+func mysqlHandshakeResponse(username, plugin string, auth []byte) []byte {
+	flags := uint32(mysqlClientLongPassword | mysqlClientProtocol41 | mysqlClientTransactions |
+		mysqlClientSecureConnection | mysqlClientPluginAuth)
+	p := binary.LittleEndian.AppendUint32(nil, flags)
+	p = binary.LittleEndian.AppendUint32(p, mysqlMaxPacket)
+	p = append(p, mysqlCharsetUTF8)
+	p = append(p, make([]byte, 23)...)
+	p = append(p, username...)
+	p = append(p, 0, byte(len(auth)))
+	p = append(p, auth...)
+	p = append(p, plugin...)
+	return append(p, 0)
+}
+
+// mysqlAuthResult reads the server's answer to the login, following a switch
+// to another plugin and the caching_sha2_password exchanges
+func mysqlAuthResult(c *mysqlConn, password, plugin string, scramble []byte) error {
+	for {
+		p, err := c.readPacket()
+		if err != nil {
+			return fmt.Errorf("reading MySQL login reply: %w", err)
+		}
+		if len(p) == 0 {
+			return errors.New("empty MySQL login reply")
+		}
+		switch p[0] {
+		case 0x00: // OK
+			return nil
+
+		case 0xff: // ERR
+			return mysqlError(p)
+
+		case 0xfe: // Switch to the plugin named in the packet
+			end := bytes.IndexByte(p[1:], 0)
+			if end < 0 {
+				return errors.New("malformed MySQL auth switch request")
+			}
+			plugin = string(p[1 : 1+end])
+			scramble = bytes.TrimRight(p[2+end:], "\x00")
+			if plugin != mysqlNativePassword && plugin != mysqlCachingSHA2 {
+				return fmt.Errorf("unsupported MySQL authentication plugin %s", plugin)
+			}
+			if err := c.writePacket(mysqlScramble(plugin, password, scramble)); err != nil {
+				return err
+			}
+
+		case 0x01: // caching_sha2_password: fast login done, or full login needed
+			if len(p) < 2 || plugin != mysqlCachingSHA2 {
+				return errors.New("unexpected MySQL login reply")
+			}
+			switch p[1] {
+			case 3:
+				// The OK packet follows
+			case 4:
+				// The password must be sent in full; without TLS it is
+				// encrypted with the server's RSA key, which is asked for
+				if err := c.writePacket([]byte{2}); err != nil {
+					return err
+				}
+				keyPacket, err := c.readPacket()
+				if err != nil {
+					return fmt.Errorf("reading MySQL public key: %w", err)
+				}
+				if len(keyPacket) > 0 && keyPacket[0] == 0xff {
+					return mysqlError(keyPacket)
+				}
+				encrypted, err := mysqlEncryptPassword(password, scramble, bytes.TrimPrefix(keyPacket, []byte{1}))
+				if err != nil {
+					return err
+				}
+				if err := c.writePacket(encrypted); err != nil {
+					return err
+				}
+			default:
+				return errors.New("unexpected MySQL login reply")
+			}
+
+		default:
+			return errors.New("unexpected MySQL login reply")
+		}
+	}
+}
+
+// mysqlScramble hashes the password with the server's scramble as the plugin
+// expects it
+func mysqlScramble(plugin, password string, scramble []byte) []byte {
+	if password == "" {
+		return nil
+	}
+	if plugin == mysqlCachingSHA2 {
+		// SHA256(password) XOR SHA256(SHA256(SHA256(password)), scramble)
+		h1 := sha256.Sum256([]byte(password))
+		h2 := sha256.Sum256(h1[:])
+		h3 := sha256.Sum256(append(h2[:], scramble...))
+		for i := range h1 {
+			h1[i] ^= h3[i]
+		}
+		return h1[:]
+	}
+	// SHA1(password) XOR SHA1(scramble, SHA1(SHA1(password)))
+	h1 := sha1.Sum([]byte(password))
+	h2 := sha1.Sum(h1[:])
+	h3 := sha1.Sum(append(append([]byte{}, scramble...), h2[:]...))
+	for i := range h1 {
+		h1[i] ^= h3[i]
+	}
+	return h1[:]
+}
+
+// mysqlEncryptPassword encrypts the NUL-terminated password, XORed with the
+// scramble, with the server's PEM encoded RSA public key
+func mysqlEncryptPassword(password string, scramble, pemKey []byte) ([]byte, error) {
+	block, _ := pem.Decode(pemKey)
+	if block == nil {
+		return nil, errors.New("MySQL server sent no RSA public key")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing MySQL public key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("MySQL public key is not an RSA key")
+	}
+	if len(scramble) == 0 {
+		return nil, errors.New("MySQL server sent no scramble")
+	}
+	plain := append([]byte(password), 0)
+	for i := range plain {
+		plain[i] ^= scramble[i%len(scramble)]
+	}
+	return rsa.EncryptOAEP(sha1.New(), rand.Reader, rsaKey, plain, nil)
+}
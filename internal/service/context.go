@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+
+	"aliyun-tui-viewer/internal/client"
+)
+
+// bind ties an API request to ctx, so cancelling ctx, as leaving the page
+// that asked for it does, aborts the call
+func bind(ctx context.Context, request requests.AcsRequest) {
+	client.BindContext(ctx, request)
+}
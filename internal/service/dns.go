@@ -1,15 +1,20 @@
 package service
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+
+	"aliyun-tui-viewer/internal/cache"
 )
 
 // DNSService handles DNS operations
 type DNSService struct {
 	client *alidns.Client
+
+	cached
 }
 
 // NewDNSService creates a new DNS service
@@ -18,7 +23,12 @@ func NewDNSService(client *alidns.Client) *DNSService {
 }
 
 // FetchDomains retrieves all DNS domains using pagination
-func (s *DNSService) FetchDomains() ([]alidns.DomainInDescribeDomains, error) {
+func (s *DNSService) FetchDomains(ctx context.Context) ([]alidns.DomainInDescribeDomains, error) {
+	return cache.Fetch(ctx, s.cache, CacheDNSDomains, s.fetchDomains)
+}
+
+// fetchDomains calls the API for FetchDomains
+func (s *DNSService) fetchDomains(ctx context.Context) ([]alidns.DomainInDescribeDomains, error) {
 	var allDomains []alidns.DomainInDescribeDomains
 	pageNumber := int64(1) // SDK uses int64 for PageNumber in response, so keep consistent
 	pageSize := int64(100)
@@ -26,6 +36,7 @@ func (s *DNSService) FetchDomains() ([]alidns.DomainInDescribeDomains, error) {
 	for {
 		request := alidns.CreateDescribeDomainsRequest()
 		request.Scheme = "https"
+		bind(ctx, request)
 		request.PageNumber = requests.NewInteger(int(pageNumber)) // CreateDescribeDomainsRequest uses requests.Integer
 		request.PageSize = requests.NewInteger(int(pageSize))
 
@@ -52,7 +63,7 @@ func (s *DNSService) FetchDomains() ([]alidns.DomainInDescribeDomains, error) {
 }
 
 // FetchDomainRecords retrieves DNS records for a specific domain using pagination
-func (s *DNSService) FetchDomainRecords(domainName string) ([]alidns.Record, error) {
+func (s *DNSService) FetchDomainRecords(ctx context.Context, domainName string) ([]alidns.Record, error) {
 	var allRecords []alidns.Record
 	pageNumber := int64(1)
 	pageSize := int64(100)
@@ -60,6 +71,7 @@ func (s *DNSService) FetchDomainRecords(domainName string) ([]alidns.Record, err
 	for {
 		request := alidns.CreateDescribeDomainRecordsRequest()
 		request.Scheme = "https"
+		bind(ctx, request)
 		request.DomainName = domainName
 		request.PageNumber = requests.NewInteger(int(pageNumber))
 		request.PageSize = requests.NewInteger(int(pageSize))
@@ -83,3 +95,83 @@ func (s *DNSService) FetchDomainRecords(domainName string) ([]alidns.Record, err
 	}
 	return allRecords, nil
 }
+
+// DomainRecordInput holds the editable fields of a DNS record
+type DomainRecordInput struct {
+	RR       string
+	Type     string
+	Value    string
+	TTL      int64 // 0 keeps the server default (600)
+	Line     string
+	Priority int64 // Only used by MX records
+}
+
+// AddDomainRecord creates a new record under the given domain and returns its ID
+func (s *DNSService) AddDomainRecord(domainName string, input DomainRecordInput) (string, error) {
+	request := alidns.CreateAddDomainRecordRequest()
+	request.Scheme = "https"
+	request.DomainName = domainName
+	request.RR = input.RR
+	request.Type = input.Type
+	request.Value = input.Value
+	request.Line = input.Line
+	if input.TTL > 0 {
+		request.TTL = requests.NewInteger(int(input.TTL))
+	}
+	if input.Priority > 0 {
+		request.Priority = requests.NewInteger(int(input.Priority))
+	}
+
+	response, err := s.client.AddDomainRecord(request)
+	if err != nil {
+		return "", fmt.Errorf("adding DNS record %s.%s: %w", input.RR, domainName, err)
+	}
+	return response.RecordId, nil
+}
+
+// UpdateDomainRecord replaces the RR, type, value and TTL of an existing record
+func (s *DNSService) UpdateDomainRecord(recordID string, input DomainRecordInput) error {
+	request := alidns.CreateUpdateDomainRecordRequest()
+	request.Scheme = "https"
+	request.RecordId = recordID
+	request.RR = input.RR
+	request.Type = input.Type
+	request.Value = input.Value
+	request.Line = input.Line
+	if input.TTL > 0 {
+		request.TTL = requests.NewInteger(int(input.TTL))
+	}
+	if input.Priority > 0 {
+		request.Priority = requests.NewInteger(int(input.Priority))
+	}
+
+	if _, err := s.client.UpdateDomainRecord(request); err != nil {
+		return fmt.Errorf("updating DNS record %s: %w", recordID, err)
+	}
+	return nil
+}
+
+// DeleteDomainRecord removes a record
+func (s *DNSService) DeleteDomainRecord(recordID string) error {
+	request := alidns.CreateDeleteDomainRecordRequest()
+	request.Scheme = "https"
+	request.RecordId = recordID
+
+	if _, err := s.client.DeleteDomainRecord(request); err != nil {
+		return fmt.Errorf("deleting DNS record %s: %w", recordID, err)
+	}
+	return nil
+}
+
+// SetDomainRecordStatus pauses or resumes a record, status is "Enable" or "Disable"
+func (s *DNSService) SetDomainRecordStatus(recordID, status string) error {
+	request := alidns.CreateSetDomainRecordStatusRequest()
+	request.Scheme = "https"
+	request.RecordId = recordID
+	request.Status = status
+
+	if _, err := s.client.SetDomainRecordStatus(request); err != nil {
+		return fmt.Errorf("setting DNS record %s status to %s: %w", recordID, status, err)
+	}
+	return nil
+}
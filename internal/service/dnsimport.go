@@ -0,0 +1,475 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+)
+
+// DNS change actions of an import file
+const (
+	DNSChangeAdd    = "add"
+	DNSChangeUpdate = "update"
+	DNSChangeDelete = "delete"
+)
+
+// dnsImportColumns are the columns of an import file, in the order used when
+// the file has no header row
+var dnsImportColumns = []string{"domain", "rr", "type", "value", "ttl", "action"}
+
+// dnsRecordTypes are the record types an import may change
+var dnsRecordTypes = map[string]bool{
+	"A": true, "AAAA": true, "CNAME": true, "MX": true, "TXT": true, "NS": true,
+	"SRV": true, "CAA": true, "REDIRECT_URL": true, "FORWARD_URL": true,
+}
+
+// DNSChange is a row of an import file
+type DNSChange struct {
+	Line   int // Line in the file, for reporting
+	Domain string
+	RR     string
+	Type   string
+	Value  string // Optional for a delete, which then needs a unique RR and type
+	TTL    int64  // 0 keeps the server default on add and the current TTL on update
+	Action string
+}
+
+// Name returns the full name of the record changed, e.g. "www.example.com"
+func (c DNSChange) Name() string {
+	if c.RR == "@" {
+		return c.Domain
+	}
+	return c.RR + "." + c.Domain
+}
+
+// DNSChangeStatus says whether a planned change can be, or was, applied
+type DNSChangeStatus int
+
+const (
+	DNSChangeReady     DNSChangeStatus = iota // Will change the record
+	DNSChangeUnchanged                        // The record is already as asked
+	DNSChangeInvalid                          // The row is wrong or matches no single record
+	DNSChangeApplied                          // Applied successfully
+	DNSChangeFailed                           // The API refused the change
+)
+
+// String returns the status name
+func (s DNSChangeStatus) String() string {
+	switch s {
+	case DNSChangeReady:
+		return "Ready"
+	case DNSChangeUnchanged:
+		return "Unchanged"
+	case DNSChangeInvalid:
+		return "Invalid"
+	case DNSChangeApplied:
+		return "Applied"
+	case DNSChangeFailed:
+		return "Failed"
+	default:
+		return "Unknown"
+	}
+}
+
+// DNSPlannedChange is a change checked against the records of its domain
+type DNSPlannedChange struct {
+	DNSChange
+	Existing *alidns.Record // The record updated or deleted
+	Status   DNSChangeStatus
+	Reason   string // Why the change is unchanged, invalid or failed
+}
+
+// Before describes the record as it is, "" when it is added
+func (p DNSPlannedChange) Before() string {
+	if p.Existing == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s (TTL %d)", p.Existing.Value, p.Existing.TTL)
+}
+
+// After describes the record as the change leaves it, "" when it is deleted
+func (p DNSPlannedChange) After() string {
+	if p.Action == DNSChangeDelete {
+		return ""
+	}
+	ttl := p.TTL
+	if ttl == 0 && p.Existing != nil {
+		ttl = p.Existing.TTL
+	}
+	if ttl == 0 {
+		return p.Value
+	}
+	return fmt.Sprintf("%s (TTL %d)", p.Value, ttl)
+}
+
+// DNSImportPlan is the dry run of an import file
+type DNSImportPlan struct {
+	Source  string // File the changes were read from
+	Changes []DNSPlannedChange
+}
+
+// Count returns how many changes have a status
+func (p *DNSImportPlan) Count(status DNSChangeStatus) int {
+	n := 0
+	for _, c := range p.Changes {
+		if c.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// ParseDNSChanges reads the rows of a CSV import file: domain, RR, type,
+// value, TTL and action (add, update or delete). A first row naming these
+// columns may give them in any order. Rows with missing or wrong fields are
+// kept, with what is wrong in the returned map by line; the error is for a
+// file that is not valid CSV
+func ParseDNSChanges(r io.Reader) ([]DNSChange, map[int]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	columns := make(map[string]int)
+	for i, name := range dnsImportColumns {
+		columns[name] = i
+	}
+
+	var changes []DNSChange
+	invalid := make(map[int]string)
+	first := true
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading DNS changes: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		if first {
+			first = false
+			if header, ok := dnsImportHeader(row); ok {
+				columns = header
+				continue
+			}
+		}
+
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		change := DNSChange{
+			Line:   line,
+			Domain: strings.TrimSuffix(strings.ToLower(field("domain")), "."),
+			RR:     field("rr"),
+			Type:   strings.ToUpper(field("type")),
+			Value:  field("value"),
+			Action: strings.ToLower(field("action")),
+		}
+		if ttl := field("ttl"); ttl != "" {
+			n, err := strconv.ParseInt(ttl, 10, 64)
+			if err != nil || n <= 0 {
+				invalid[line] = fmt.Sprintf("invalid TTL %q", ttl)
+			}
+			change.TTL = n
+		}
+		if _, bad := invalid[line]; !bad {
+			if err := change.validate(); err != nil {
+				invalid[line] = err.Error()
+			}
+		}
+		changes = append(changes, change)
+	}
+	return changes, invalid, nil
+}
+
+// ReadDNSChangesFile reads an import file as ParseDNSChanges does, expanding
+// a leading ~ in its path
+func ReadDNSChangesFile(path string) ([]DNSChange, map[int]string, error) {
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, nil, fmt.Errorf("resolving home directory: %w", err)
+		}
+		path = filepath.Join(home, strings.TrimPrefix(path, "~"))
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening DNS changes: %w", err)
+	}
+	defer f.Close()
+	return ParseDNSChanges(f)
+}
+
+// dnsImportHeader maps the column names of a header row to their positions
+func dnsImportHeader(row []string) (map[string]int, bool) {
+	columns := make(map[string]int)
+	for i, name := range row {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"domain", "rr", "type", "action"} {
+		if _, ok := columns[name]; !ok {
+			return nil, false
+		}
+	}
+	return columns, true
+}
+
+// validate checks the fields a change needs
+func (c DNSChange) validate() error {
+	switch {
+	case c.Domain == "":
+		return fmt.Errorf("no domain")
+	case c.RR == "":
+		return fmt.Errorf("no RR, use @ for the domain itself")
+	case !dnsRecordTypes[c.Type]:
+		return fmt.Errorf("unknown record type %q", c.Type)
+	}
+
+	switch c.Action {
+	case DNSChangeAdd, DNSChangeUpdate:
+		if c.Value == "" {
+			return fmt.Errorf("no value to %s", c.Action)
+		}
+	case DNSChangeDelete:
+		if c.Value == "" {
+			return nil
+		}
+	default:
+		return fmt.Errorf("unknown action %q, expected add, update or delete", c.Action)
+	}
+
+	ip := net.ParseIP(c.Value)
+	switch {
+	case c.Type == "A" && (ip == nil || ip.To4() == nil):
+		return fmt.Errorf("%q is not an IPv4 address", c.Value)
+	case c.Type == "AAAA" && (ip == nil || ip.To4() != nil):
+		return fmt.Errorf("%q is not an IPv6 address", c.Value)
+	}
+	return nil
+}
+
+// PlanDNSChanges checks each change against the current records of its
+// domain, fetched once per domain: an add of a record that exists, an update
+// to the current value or a delete of a missing record is unchanged, and an
+// update or delete must match exactly one record. Rows are planned against
+// the records as they are, so rows changing the same record are invalid.
+// invalid holds the reasons of rows already found invalid, by line.
+// onProgress, if not nil, is called with the domains done so far and the
+// total
+func (s *DNSService) PlanDNSChanges(ctx context.Context, source string, changes []DNSChange, invalid map[int]string, onProgress func(done, total int64)) (*DNSImportPlan, error) {
+	var domains []string
+	seen := make(map[string]bool)
+	for _, c := range changes {
+		if _, bad := invalid[c.Line]; !bad && !seen[c.Domain] {
+			seen[c.Domain] = true
+			domains = append(domains, c.Domain)
+		}
+	}
+
+	records := make(map[string][]alidns.Record)
+	fetchErrs := make(map[string]error)
+	for i, domain := range domains {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if onProgress != nil {
+			onProgress(int64(i), int64(len(domains)))
+		}
+		recs, err := s.FetchDomainRecords(ctx, domain)
+		if err != nil {
+			fetchErrs[domain] = err
+			continue
+		}
+		records[domain] = recs
+	}
+	if onProgress != nil {
+		onProgress(int64(len(domains)), int64(len(domains)))
+	}
+
+	duplicates := duplicateDNSChanges(changes, invalid)
+	plan := &DNSImportPlan{Source: source, Changes: make([]DNSPlannedChange, len(changes))}
+	for i, c := range changes {
+		p := DNSPlannedChange{DNSChange: c}
+		switch {
+		case invalid[c.Line] != "":
+			p.Status, p.Reason = DNSChangeInvalid, invalid[c.Line]
+		case duplicates[c.Line] != "":
+			p.Status, p.Reason = DNSChangeInvalid, duplicates[c.Line]
+		case fetchErrs[c.Domain] != nil:
+			p.Status, p.Reason = DNSChangeInvalid, fetchErrs[c.Domain].Error()
+		default:
+			p = planDNSChange(p, records[c.Domain])
+		}
+		plan.Changes[i] = p
+	}
+	return plan, nil
+}
+
+// duplicateDNSChanges returns, by line, why rows change a record another row
+// changes too, e.g. an add then an update of it. Each would be planned
+// against the record as it is, not as the other leaves it. Rows of one RR
+// and type with different values are apart, unless one is an update or a
+// delete without a value, which concern every record of that RR and type
+func duplicateDNSChanges(changes []DNSChange, invalid map[int]string) map[int]string {
+	groups := make(map[string][]DNSChange)
+	for _, c := range changes {
+		if _, bad := invalid[c.Line]; bad {
+			continue
+		}
+		key := strings.ToLower(c.Domain + "\x00" + c.RR + "\x00" + c.Type)
+		groups[key] = append(groups[key], c)
+	}
+
+	duplicates := make(map[int]string)
+	for _, group := range groups {
+		for i, a := range group {
+			for j, b := range group {
+				if i != j && dnsChangesOverlap(a, b) {
+					duplicates[a.Line] = fmt.Sprintf("line %d also changes %s record %s, give each record one row", b.Line, a.Type, a.Name())
+					break
+				}
+			}
+		}
+	}
+	return duplicates
+}
+
+// dnsChangesOverlap reports whether two changes of one RR and type may
+// change the same record
+func dnsChangesOverlap(a, b DNSChange) bool {
+	for _, c := range []DNSChange{a, b} {
+		if c.Action == DNSChangeUpdate || c.Value == "" {
+			return true
+		}
+	}
+	return dnsValueEqual(a.Value, b.Value)
+}
+
+// planDNSChange checks a change against the records of its domain
+func planDNSChange(p DNSPlannedChange, records []alidns.Record) DNSPlannedChange {
+	var matches []alidns.Record
+	for _, r := range records {
+		if !strings.EqualFold(r.RR, p.RR) || !strings.EqualFold(r.Type, p.Type) {
+			continue
+		}
+		// An add or a delete with a value is about that value only; several
+		// records of one name and type are common, e.g. round-robin A records
+		if p.Action != DNSChangeUpdate && p.Value != "" && !dnsValueEqual(r.Value, p.Value) {
+			continue
+		}
+		matches = append(matches, r)
+	}
+
+	switch p.Action {
+	case DNSChangeAdd:
+		if len(matches) > 0 {
+			p.Status, p.Reason = DNSChangeUnchanged, "the record already exists"
+		}
+	case DNSChangeUpdate:
+		switch len(matches) {
+		case 0:
+			p.Status, p.Reason = DNSChangeInvalid, fmt.Sprintf("no %s record %s to update", p.Type, p.Name())
+		case 1:
+			p.Existing = &matches[0]
+			if dnsValueEqual(p.Existing.Value, p.Value) && (p.TTL == 0 || p.TTL == p.Existing.TTL) {
+				p.Status, p.Reason = DNSChangeUnchanged, "the record already has this value"
+			}
+		default:
+			p.Status, p.Reason = DNSChangeInvalid, fmt.Sprintf("%d %s records %s, delete and add them instead", len(matches), p.Type, p.Name())
+		}
+	case DNSChangeDelete:
+		switch len(matches) {
+		case 0:
+			p.Status, p.Reason = DNSChangeUnchanged, "the record does not exist"
+		case 1:
+			p.Existing = &matches[0]
+		default:
+			p.Status, p.Reason = DNSChangeInvalid, fmt.Sprintf("%d %s records %s, give the value to delete", len(matches), p.Type, p.Name())
+		}
+	}
+	return p
+}
+
+// dnsValueEqual compares record values the way DNS does, ignoring case and
+// a trailing dot
+func dnsValueEqual(a, b string) bool {
+	return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+}
+
+// ApplyDNSChange makes a ready change, unless ctx is already cancelled. The
+// call itself is not bound to ctx: a write aborted midway may or may not
+// have been made. An update keeps the line and MX priority of the record it
+// replaces
+func (s *DNSService) ApplyDNSChange(ctx context.Context, p DNSPlannedChange) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	input := DomainRecordInput{RR: p.RR, Type: p.Type, Value: p.Value, TTL: p.TTL}
+	switch p.Action {
+	case DNSChangeAdd:
+		_, err := s.AddDomainRecord(p.Domain, input)
+		return err
+	case DNSChangeUpdate:
+		input.Line = p.Existing.Line
+		input.Priority = p.Existing.Priority
+		if input.TTL == 0 {
+			input.TTL = p.Existing.TTL
+		}
+		return s.UpdateDomainRecord(p.Existing.RecordId, input)
+	case DNSChangeDelete:
+		return s.DeleteDomainRecord(p.Existing.RecordId)
+	}
+	return fmt.Errorf("unknown action %q", p.Action)
+}
+
+// ApplyDNSImport applies the ready changes of a plan in file order, going on
+// past failures, and returns the plan with each of them applied or failed.
+// When ctx is cancelled it stops and returns the plan so far, the changes not
+// made yet still ready, with the error. onProgress, if not nil, is called
+// with the changes done so far and the total
+func (s *DNSService) ApplyDNSImport(ctx context.Context, plan *DNSImportPlan, onProgress func(done, total int64)) (*DNSImportPlan, error) {
+	result := &DNSImportPlan{Source: plan.Source, Changes: append([]DNSPlannedChange(nil), plan.Changes...)}
+	total := int64(plan.Count(DNSChangeReady))
+	done := int64(0)
+	for i := range result.Changes {
+		p := &result.Changes[i]
+		if p.Status != DNSChangeReady {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		if onProgress != nil {
+			onProgress(done, total)
+		}
+
+		if err := s.ApplyDNSChange(ctx, *p); err != nil {
+			if ctx.Err() != nil {
+				return result, err
+			}
+			p.Status, p.Reason = DNSChangeFailed, err.Error()
+		} else {
+			p.Status = DNSChangeApplied
+		}
+		done++
+	}
+	if onProgress != nil {
+		onProgress(total, total)
+	}
+	return result, nil
+}
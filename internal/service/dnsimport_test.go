@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseDNSChanges(t *testing.T) {
+	input := strings.Join([]string{
+		"# migration of example.com",
+		"Example.COM., www, a, 10.0.0.1, 600, ADD",
+		"example.com, api, CNAME, api.example.net, , update",
+		"example.com, old, A, , , delete",
+		"example.com, bad, A, not-an-ip, , add",
+		"example.com, v6, AAAA, 10.0.0.2, , add",
+		"example.com, ttl, A, 10.0.0.3, -5, add",
+		"example.com, www, A, 10.0.0.4, , replace",
+		"example.com, www, PTR, 10.0.0.5, , add",
+		", www, A, 10.0.0.6, , add",
+		"example.com, , A, 10.0.0.7, , add",
+		"example.com, www, CNAME, , , update",
+	}, "\n")
+
+	changes, invalid, err := ParseDNSChanges(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseDNSChanges: %v", err)
+	}
+	if len(changes) != 11 {
+		t.Fatalf("got %d changes, want 11", len(changes))
+	}
+
+	first := changes[0]
+	want := DNSChange{Line: 2, Domain: "example.com", RR: "www", Type: "A", Value: "10.0.0.1", TTL: 600, Action: DNSChangeAdd}
+	if first != want {
+		t.Errorf("first change = %+v, want %+v", first, want)
+	}
+	if changes[1].TTL != 0 || changes[1].Action != DNSChangeUpdate {
+		t.Errorf("second change = %+v, want an update keeping the TTL", changes[1])
+	}
+
+	valid := map[int]bool{2: true, 3: true, 4: true}
+	for _, c := range changes {
+		_, bad := invalid[c.Line]
+		if bad == valid[c.Line] {
+			t.Errorf("line %d (%s %s %s): invalid = %v (%q), want %v", c.Line, c.Action, c.RR, c.Value, bad, invalid[c.Line], !valid[c.Line])
+		}
+	}
+	if reason := invalid[7]; !strings.Contains(reason, "TTL") {
+		t.Errorf("line 7 reason = %q, want an invalid TTL", reason)
+	}
+}
+
+func TestParseDNSChangesHeader(t *testing.T) {
+	input := "action,type,rr,domain,value\ndelete,TXT,_acme,example.com,\"v=1, k=2\"\n"
+	changes, invalid, err := ParseDNSChanges(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseDNSChanges: %v", err)
+	}
+	if len(invalid) != 0 {
+		t.Errorf("invalid = %v, want none", invalid)
+	}
+	want := DNSChange{Line: 2, Domain: "example.com", RR: "_acme", Type: "TXT", Value: "v=1, k=2", Action: DNSChangeDelete}
+	if len(changes) != 1 || changes[0] != want {
+		t.Errorf("changes = %+v, want [%+v]", changes, want)
+	}
+}
+
+func TestParseDNSChangesMalformed(t *testing.T) {
+	_, _, err := ParseDNSChanges(strings.NewReader("example.com,www,A,\"10.0.0.1,600,add\n"))
+	if err == nil {
+		t.Fatal("ParseDNSChanges accepted an unterminated quote")
+	}
+	if errors.Unwrap(err) == nil {
+		t.Errorf("error %v does not wrap the CSV error", err)
+	}
+}
+
+func TestApplyDNSImportCancelled(t *testing.T) {
+	plan := &DNSImportPlan{Source: "changes.csv", Changes: []DNSPlannedChange{
+		{DNSChange: DNSChange{Line: 1, Domain: "example.com", RR: "www", Type: "A", Value: "10.0.0.1", Action: DNSChangeAdd}, Status: DNSChangeReady},
+		{DNSChange: DNSChange{Line: 2, Domain: "example.com", RR: "api", Type: "A", Value: "10.0.0.2", Action: DNSChangeAdd}, Status: DNSChangeUnchanged},
+	}}
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	result, err := (&DNSService{}).ApplyDNSImport(ctx, plan, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if result == nil || result.Count(DNSChangeReady) != 1 || result.Count(DNSChangeUnchanged) != 1 {
+		t.Fatalf("result = %+v, want the plan with its changes untouched", result)
+	}
+}
+
+func TestDuplicateDNSChanges(t *testing.T) {
+	change := func(line int, rr, typ, value, action string) DNSChange {
+		return DNSChange{Line: line, Domain: "example.com", RR: rr, Type: typ, Value: value, Action: action}
+	}
+	tests := []struct {
+		name    string
+		changes []DNSChange
+		invalid map[int]string
+		want    []int // Lines flagged
+	}{
+		{name: "add then update", changes: []DNSChange{
+			change(1, "www", "A", "10.0.0.1", DNSChangeAdd),
+			change(2, "www", "A", "10.0.0.2", DNSChangeUpdate),
+		}, want: []int{1, 2}},
+		{name: "same add twice", changes: []DNSChange{
+			change(1, "www", "A", "10.0.0.1", DNSChangeAdd),
+			change(2, "WWW", "a", "10.0.0.1", DNSChangeAdd),
+		}, want: []int{1, 2}},
+		{name: "delete without value and add", changes: []DNSChange{
+			change(1, "old", "A", "", DNSChangeDelete),
+			change(2, "old", "A", "10.0.0.3", DNSChangeAdd),
+		}, want: []int{1, 2}},
+		{name: "round-robin adds", changes: []DNSChange{
+			change(1, "www", "A", "10.0.0.1", DNSChangeAdd),
+			change(2, "www", "A", "10.0.0.2", DNSChangeAdd),
+			change(3, "www", "A", "10.0.0.3", DNSChangeDelete),
+		}},
+		{name: "other type or name", changes: []DNSChange{
+			change(1, "www", "A", "10.0.0.1", DNSChangeUpdate),
+			change(2, "www", "AAAA", "2001:db8::1", DNSChangeUpdate),
+			change(3, "api", "A", "10.0.0.1", DNSChangeUpdate),
+		}},
+		{name: "already invalid row", changes: []DNSChange{
+			change(1, "www", "A", "10.0.0.1", DNSChangeAdd),
+			change(2, "www", "A", "10.0.0.2", DNSChangeUpdate),
+		}, invalid: map[int]string{2: "bad TTL"}},
+	}
+	for _, tt := range tests {
+		got := duplicateDNSChanges(tt.changes, tt.invalid)
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: flagged %v, want lines %v", tt.name, got, tt.want)
+			continue
+		}
+		for _, line := range tt.want {
+			if got[line] == "" {
+				t.Errorf("%s: line %d not flagged, got %v", tt.name, line, got)
+			}
+		}
+	}
+
+	got := duplicateDNSChanges([]DNSChange{
+		change(4, "www", "A", "10.0.0.1", DNSChangeAdd),
+		change(7, "www", "A", "10.0.0.2", DNSChangeUpdate),
+	}, nil)
+	if want := "line 7 also changes A record www.example.com, give each record one row"; got[4] != want {
+		t.Errorf("reason = %q, want %q", got[4], want)
+	}
+}
@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/netip"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// PropagationResolver is a public DNS resolver a record is checked against
+type PropagationResolver struct {
+	Name    string
+	Address string // Queried on port 53
+}
+
+// PropagationResolvers are the resolvers CheckDNSPropagation asks, in the
+// order of the results
+var PropagationResolvers = []PropagationResolver{
+	{Name: "AliDNS", Address: "223.5.5.5"},
+	{Name: "Google", Address: "8.8.8.8"},
+	{Name: "Cloudflare", Address: "1.1.1.1"},
+}
+
+// PropagationTimeout bounds the query to each resolver
+const PropagationTimeout = 3 * time.Second
+
+// propagationUDPSize is the EDNS0 payload size asked for, which keeps most
+// TXT answers from being truncated
+const propagationUDPSize = 1232
+
+// States of a resolver's answer
+const (
+	PropagationMatch   = "match"   // The answer holds the configured value
+	PropagationStale   = "stale"   // The answer holds other values only
+	PropagationMissing = "missing" // No such name, or no record of the type
+	PropagationFailed  = "failed"  // The resolver could not be asked, see Err
+)
+
+// propagationTypes are the record types that can be checked
+var propagationTypes = map[string]dnsmessage.Type{
+	"A":     dnsmessage.TypeA,
+	"AAAA":  dnsmessage.TypeAAAA,
+	"CNAME": dnsmessage.TypeCNAME,
+	"MX":    dnsmessage.TypeMX,
+	"NS":    dnsmessage.TypeNS,
+	"TXT":   dnsmessage.TypeTXT,
+	"SRV":   dnsmessage.TypeSRV,
+}
+
+// PropagationAnswer is what one resolver answered
+type PropagationAnswer struct {
+	Resolver PropagationResolver
+	State    string
+	Values   []string // Records of the checked type, normalized like the configured value
+	TTL      uint32   // Lowest TTL left of the records, how long a stale answer may still be served
+	Latency  time.Duration
+	Err      error
+}
+
+// PropagationResult is the answer of every resolver for a record
+type PropagationResult struct {
+	Name     string // Full name, e.g. www.example.com
+	Type     string
+	Expected string // The configured value, normalized for comparison
+	Answers  []PropagationAnswer
+	Err      error // Set when the record cannot be checked at all
+}
+
+// Propagated reports whether every resolver answers with the configured value
+func (r PropagationResult) Propagated() bool {
+	if r.Err != nil {
+		return false
+	}
+	for _, a := range r.Answers {
+		if a.State != PropagationMatch {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckDNSPropagation asks every resolver of PropagationResolvers for the
+// records of recordType at name at the same time, and compares the answers
+// with value, the record as configured
+func CheckDNSPropagation(ctx context.Context, name, recordType, value string) PropagationResult {
+	recordType = strings.ToUpper(recordType)
+	result := PropagationResult{
+		Name:     strings.TrimSuffix(name, "."),
+		Type:     recordType,
+		Expected: normalizeRecordValue(recordType, value),
+	}
+	qtype, ok := propagationTypes[recordType]
+	if !ok {
+		result.Err = fmt.Errorf("%s records cannot be checked", recordType)
+		return result
+	}
+	qname, err := dnsmessage.NewName(result.Name + ".")
+	if err != nil {
+		result.Err = fmt.Errorf("invalid name %s: %w", result.Name, err)
+		return result
+	}
+
+	result.Answers = make([]PropagationAnswer, len(PropagationResolvers))
+	var wg sync.WaitGroup
+	for i, resolver := range PropagationResolvers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result.Answers[i] = askResolver(ctx, resolver, qname, qtype, recordType, result.Expected)
+		}()
+	}
+	wg.Wait()
+	return result
+}
+
+// askResolver queries one resolver and compares its answer with expected
+func askResolver(ctx context.Context, resolver PropagationResolver, qname dnsmessage.Name, qtype dnsmessage.Type, recordType, expected string) PropagationAnswer {
+	ctx, cancel := context.WithTimeout(ctx, PropagationTimeout)
+	defer cancel()
+
+	answer := PropagationAnswer{Resolver: resolver}
+	start := time.Now()
+	msg, err := queryResolver(ctx, resolver.Address, qname, qtype)
+	answer.Latency = time.Since(start)
+	if err != nil {
+		answer.State, answer.Err = PropagationFailed, err
+		return answer
+	}
+	switch msg.RCode {
+	case dnsmessage.RCodeSuccess, dnsmessage.RCodeNameError:
+	default:
+		answer.State = PropagationFailed
+		answer.Err = fmt.Errorf("%s answered %s", resolver.Address, msg.RCode)
+		return answer
+	}
+
+	for _, rr := range msg.Answers {
+		if rr.Header.Type != qtype {
+			continue // e.g. the CNAME an A record query went through
+		}
+		value := recordValue(rr.Body)
+		if value == "" {
+			continue
+		}
+		answer.Values = append(answer.Values, normalizeRecordValue(recordType, value))
+		if answer.TTL == 0 || rr.Header.TTL < answer.TTL {
+			answer.TTL = rr.Header.TTL
+		}
+	}
+	switch {
+	case slices.Contains(answer.Values, expected):
+		answer.State = PropagationMatch
+	case len(answer.Values) > 0:
+		answer.State = PropagationStale
+	default:
+		answer.State = PropagationMissing
+	}
+	return answer
+}
+
+// queryResolver sends a recursive query over UDP, and again over TCP when the
+// answer is truncated
+func queryResolver(ctx context.Context, server string, qname dnsmessage.Name, qtype dnsmessage.Type) (*dnsmessage.Message, error) {
+	id := uint16(rand.Uint32())
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: id, RecursionDesired: true})
+	b.EnableCompression()
+	if err := b.StartQuestions(); err != nil {
+		return nil, err
+	}
+	if err := b.Question(dnsmessage.Question{Name: qname, Type: qtype, Class: dnsmessage.ClassINET}); err != nil {
+		return nil, err
+	}
+	if err := b.StartAdditionals(); err != nil {
+		return nil, err
+	}
+	var opt dnsmessage.ResourceHeader
+	if err := opt.SetEDNS0(propagationUDPSize, dnsmessage.RCodeSuccess, false); err != nil {
+		return nil, err
+	}
+	if err := b.OPTResource(opt, dnsmessage.OPTResource{}); err != nil {
+		return nil, err
+	}
+	query, err := b.Finish()
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := exchangeDNS(ctx, "udp", server, id, query)
+	if err == nil && msg.Truncated {
+		msg, err = exchangeDNS(ctx, "tcp", server, id, query)
+	}
+	return msg, err
+}
+
+// exchangeDNS sends query to server and reads the answer to it. Over TCP
+// both are prefixed with their length
+func exchangeDNS(ctx context.Context, network, server string, id uint16, query []byte) (*dnsmessage.Message, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, network, net.JoinHostPort(server, "53"))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	var buf []byte
+	if network == "tcp" {
+		if _, err := conn.Write(append(binary.BigEndian.AppendUint16(nil, uint16(len(query))), query...)); err != nil {
+			return nil, err
+		}
+		var size [2]byte
+		if _, err := io.ReadFull(conn, size[:]); err != nil {
+			return nil, err
+		}
+		buf = make([]byte, binary.BigEndian.Uint16(size[:]))
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			return nil, err
+		}
+	} else {
+		if _, err := conn.Write(query); err != nil {
+			return nil, err
+		}
+		buf = make([]byte, 65535)
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		buf = buf[:n]
+	}
+
+	var msg dnsmessage.Message
+	if err := msg.Unpack(buf); err != nil {
+		return nil, err
+	}
+	if msg.ID != id || !msg.Response {
+		return nil, errors.New("answer does not match the query")
+	}
+	return &msg, nil
+}
+
+// recordValue returns the value of an answer record as AliDNS shows it, e.g.
+// the target of a CNAME record or "priority weight port target" for SRV
+func recordValue(body dnsmessage.ResourceBody) string {
+	switch r := body.(type) {
+	case *dnsmessage.AResource:
+		return netip.AddrFrom4(r.A).String()
+	case *dnsmessage.AAAAResource:
+		return netip.AddrFrom16(r.AAAA).String()
+	case *dnsmessage.CNAMEResource:
+		return r.CNAME.String()
+	case *dnsmessage.MXResource:
+		return r.MX.String()
+	case *dnsmessage.NSResource:
+		return r.NS.String()
+	case *dnsmessage.TXTResource:
+		return strings.Join(r.TXT, "")
+	case *dnsmessage.SRVResource:
+		return fmt.Sprintf("%d %d %d %s", r.Priority, r.Weight, r.Port, r.Target.String())
+	}
+	return ""
+}
+
+// normalizeRecordValue puts a record value in the form answers are compared
+// in: addresses in their canonical form, host names in lower case without
+// the final dot, and TXT values without surrounding quotes
+func normalizeRecordValue(recordType, value string) string {
+	value = strings.TrimSpace(value)
+	switch recordType {
+	case "A", "AAAA":
+		if addr, err := netip.ParseAddr(value); err == nil {
+			return addr.String()
+		}
+	case "CNAME", "MX", "NS":
+		return strings.TrimSuffix(strings.ToLower(value), ".")
+	case "TXT":
+		if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+			return value[1 : len(value)-1]
+		}
+	case "SRV":
+		fields := strings.Fields(value)
+		if len(fields) > 0 {
+			last := len(fields) - 1
+			fields[last] = strings.TrimSuffix(strings.ToLower(fields[last]), ".")
+		}
+		return strings.Join(fields, " ")
+	}
+	return value
+}
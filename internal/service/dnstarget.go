@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cdn"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+// Kinds of account resources DNS records are matched against
+const (
+	DNSTargetECS = "ECS"
+	DNSTargetEIP = "EIP"
+	DNSTargetSLB = "SLB"
+	DNSTargetOSS = "OSS"
+	DNSTargetCDN = "CDN"
+)
+
+// DNSTarget is an account resource a DNS record can point at
+type DNSTarget struct {
+	Kind      string
+	ID        string
+	Name      string
+	Status    string
+	Region    string
+	Addresses []string // IPs and host names the resource answers on
+}
+
+// Label describes the target, e.g. "ECS web-1 (Running)"
+func (t DNSTarget) Label() string {
+	name := t.Name
+	if name == "" {
+		name = t.ID
+	}
+	if t.Status == "" {
+		return fmt.Sprintf("%s %s", t.Kind, name)
+	}
+	return fmt.Sprintf("%s %s (%s)", t.Kind, name, t.Status)
+}
+
+// DNSTargetState tells how the target of a record was resolved
+type DNSTargetState int
+
+const (
+	// DNSTargetNotApplicable is used for records other than A and CNAME
+	DNSTargetNotApplicable DNSTargetState = iota
+	// DNSTargetFound means the target is a known account resource
+	DNSTargetFound
+	// DNSTargetMissing means an A record IP, or a CNAME to an Alibaba Cloud
+	// host, matches no account resource: the record is likely dangling
+	DNSTargetMissing
+	// DNSTargetExternal means a CNAME points outside Alibaba Cloud
+	DNSTargetExternal
+	// DNSTargetUnknown means no resource matched but some resources could
+	// not be listed, so the record may still be in use
+	DNSTargetUnknown
+)
+
+// DNSTargetMatch is the result of matching a record against the index
+type DNSTargetMatch struct {
+	State  DNSTargetState
+	Target DNSTarget // Set when State is DNSTargetFound
+}
+
+// DNSTargetIndex finds the account resource answering on an IP or host name
+type DNSTargetIndex struct {
+	byAddress map[string]DNSTarget
+	complete  bool
+}
+
+// NewDNSTargetIndex indexes targets by their addresses. complete is false when
+// some regions or products could not be listed
+func NewDNSTargetIndex(targets []DNSTarget, complete bool) *DNSTargetIndex {
+	index := &DNSTargetIndex{byAddress: make(map[string]DNSTarget), complete: complete}
+	for _, target := range targets {
+		for _, address := range target.Addresses {
+			if address = normalizeDNSAddress(address); address != "" {
+				index.byAddress[address] = target
+			}
+		}
+	}
+	return index
+}
+
+// Complete reports whether every region and product was listed
+func (x *DNSTargetIndex) Complete() bool {
+	return x != nil && x.complete
+}
+
+// Match resolves the target of an A or CNAME record
+func (x *DNSTargetIndex) Match(record alidns.Record) DNSTargetMatch {
+	recordType := strings.ToUpper(record.Type)
+	if x == nil || (recordType != "A" && recordType != "CNAME") {
+		return DNSTargetMatch{State: DNSTargetNotApplicable}
+	}
+
+	value := normalizeDNSAddress(record.Value)
+	if target, ok := x.byAddress[value]; ok {
+		return DNSTargetMatch{State: DNSTargetFound, Target: target}
+	}
+	if recordType == "CNAME" && !isAliyunHost(value) {
+		return DNSTargetMatch{State: DNSTargetExternal}
+	}
+	if !x.complete {
+		return DNSTargetMatch{State: DNSTargetUnknown}
+	}
+	return DNSTargetMatch{State: DNSTargetMissing}
+}
+
+// DanglingDNSRecord is an A or CNAME record whose target matches no account
+// resource
+type DanglingDNSRecord struct {
+	Domain string
+	Record alidns.Record
+	State  DNSTargetState // DNSTargetMissing, or DNSTargetUnknown when the index is incomplete
+}
+
+// Dangling returns the A records pointing at public IPs and the CNAME records
+// pointing at Alibaba Cloud hosts that match no account resource. A records
+// to private IPs are skipped as they cannot be taken over
+func (x *DNSTargetIndex) Dangling(domain string, records []alidns.Record) []DanglingDNSRecord {
+	var dangling []DanglingDNSRecord
+	for _, record := range records {
+		match := x.Match(record)
+		if match.State != DNSTargetMissing && match.State != DNSTargetUnknown {
+			continue
+		}
+		if strings.EqualFold(record.Type, "A") && !isPublicIP(record.Value) {
+			continue
+		}
+		dangling = append(dangling, DanglingDNSRecord{Domain: domain, Record: record, State: match.State})
+	}
+	return dangling
+}
+
+// isPublicIP reports whether address is a globally routable IP
+func isPublicIP(address string) bool {
+	ip := net.ParseIP(strings.TrimSpace(address))
+	return ip != nil && ip.IsGlobalUnicast() && !ip.IsPrivate() &&
+		!carrierGradeNAT.Contains(ip)
+}
+
+// carrierGradeNAT is the shared address space of RFC 6598, used by some
+// Alibaba Cloud internal endpoints
+var carrierGradeNAT = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// normalizeDNSAddress lowercases a host name and drops its trailing dot
+func normalizeDNSAddress(address string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(address)), ".")
+}
+
+// isAliyunHost reports whether host is served by Alibaba Cloud, e.g. an OSS,
+// SLB or CDN (kunlun) host name
+func isAliyunHost(host string) bool {
+	return strings.HasSuffix(host, ".aliyuncs.com") ||
+		strings.Contains(host, ".kunlun") ||
+		strings.Contains(host, "alikunlun.")
+}
+
+// ECSDNSTargets returns the public, elastic and private IPs of instances
+func ECSDNSTargets(instances []ecs.Instance) []DNSTarget {
+	targets := make([]DNSTarget, 0, len(instances))
+	for _, inst := range instances {
+		addresses := append([]string{}, inst.PublicIpAddress.IpAddress...)
+		if inst.EipAddress.IpAddress != "" {
+			addresses = append(addresses, inst.EipAddress.IpAddress)
+		}
+		addresses = append(addresses, instancePrivateIPs(inst)...)
+		targets = append(targets, DNSTarget{
+			Kind:      DNSTargetECS,
+			ID:        inst.InstanceId,
+			Name:      inst.InstanceName,
+			Status:    inst.Status,
+			Region:    inst.RegionId,
+			Addresses: addresses,
+		})
+	}
+	return targets
+}
+
+// EIPDNSTargets returns the addresses of elastic IPs. Unbound EIPs are still
+// owned by the account, so records pointing at them are not dangling
+func EIPDNSTargets(eips []vpc.EipAddress) []DNSTarget {
+	targets := make([]DNSTarget, 0, len(eips))
+	for _, eip := range eips {
+		targets = append(targets, DNSTarget{
+			Kind:      DNSTargetEIP,
+			ID:        eip.AllocationId,
+			Name:      eip.Name,
+			Status:    eip.Status,
+			Region:    eip.RegionId,
+			Addresses: []string{eip.IpAddress},
+		})
+	}
+	return targets
+}
+
+// SLBDNSTargets returns the service addresses of load balancers
+func SLBDNSTargets(lbs []slb.LoadBalancer) []DNSTarget {
+	targets := make([]DNSTarget, 0, len(lbs))
+	for _, lb := range lbs {
+		targets = append(targets, DNSTarget{
+			Kind:      DNSTargetSLB,
+			ID:        lb.LoadBalancerId,
+			Name:      lb.LoadBalancerName,
+			Status:    lb.LoadBalancerStatus,
+			Region:    lb.RegionId,
+			Addresses: []string{lb.Address},
+		})
+	}
+	return targets
+}
+
+// OSSDNSTargets returns the public and internal endpoints of buckets
+func OSSDNSTargets(buckets []oss.BucketProperties) []DNSTarget {
+	targets := make([]DNSTarget, 0, len(buckets))
+	for _, bucket := range buckets {
+		targets = append(targets, DNSTarget{
+			Kind:   DNSTargetOSS,
+			ID:     bucket.Name,
+			Region: bucket.Region,
+			Addresses: []string{
+				fmt.Sprintf("%s.%s.aliyuncs.com", bucket.Name, bucket.Location),
+				fmt.Sprintf("%s.%s-internal.aliyuncs.com", bucket.Name, bucket.Location),
+			},
+		})
+	}
+	return targets
+}
+
+// CDNDNSTargets returns the CNAME host names of accelerated domains
+func CDNDNSTargets(domains []cdn.PageData) []DNSTarget {
+	targets := make([]DNSTarget, 0, len(domains))
+	for _, domain := range domains {
+		targets = append(targets, DNSTarget{
+			Kind:      DNSTargetCDN,
+			ID:        domain.DomainName,
+			Status:    domain.DomainStatus,
+			Addresses: []string{domain.Cname},
+		})
+	}
+	return targets
+}
+
+// DNSTargetService lists the account resources DNS records are matched against
+type DNSTargetService struct {
+	ecs *ECSService
+	eip *EIPService
+	slb *SLBService
+	oss *OSSService
+	cdn *CDNService
+}
+
+// NewDNSTargetService creates a new DNS target service
+func NewDNSTargetService(ecsSvc *ECSService, eipSvc *EIPService, slbSvc *SLBService, ossSvc *OSSService, cdnSvc *CDNService) *DNSTargetService {
+	return &DNSTargetService{ecs: ecsSvc, eip: eipSvc, slb: slbSvc, oss: ossSvc, cdn: cdnSvc}
+}
+
+// FetchRegionTargets retrieves the ECS instances, elastic IPs and load
+// balancers of the service's region
+func (s *DNSTargetService) FetchRegionTargets(ctx context.Context) ([]DNSTarget, error) {
+	instances, err := s.ecs.FetchInstances(ctx)
+	if err != nil {
+		return nil, err
+	}
+	eips, err := s.eip.FetchEIPs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lbs, err := s.slb.FetchInstances(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targets := append(ECSDNSTargets(instances), EIPDNSTargets(eips)...)
+	return append(targets, SLBDNSTargets(lbs)...), nil
+}
+
+// FetchGlobalTargets retrieves the account's OSS buckets and CDN domains.
+// Products that cannot be listed are reported in the error while the others
+// are still returned
+func (s *DNSTargetService) FetchGlobalTargets(ctx context.Context) ([]DNSTarget, error) {
+	var targets []DNSTarget
+	var errs []error
+
+	if buckets, err := s.oss.FetchBuckets(ctx); err != nil {
+		errs = append(errs, err)
+	} else {
+		targets = append(targets, OSSDNSTargets(buckets)...)
+	}
+
+	if domains, err := s.cdn.FetchDomains(ctx); err != nil {
+		errs = append(errs, err)
+	} else {
+		targets = append(targets, CDNDNSTargets(domains)...)
+	}
+
+	return targets, errors.Join(errs...)
+}
@@ -1,61 +1,187 @@
 package service
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+
+	"aliyun-tui-viewer/internal/cache"
 )
 
 // ECSService handles ECS operations
 type ECSService struct {
 	client *ecs.Client
+
+	cached
 }
 
+// vncConsoleURL is the web page of the ECS management terminal
+const vncConsoleURL = "https://g.alicdn.com/aliyun/ecs-console-vnc2/0.0.8/index.html"
+
 // NewECSService creates a new ECS service
 func NewECSService(client *ecs.Client) *ECSService {
 	return &ECSService{client: client}
 }
 
 // FetchInstances retrieves all ECS instances using pagination
-func (s *ECSService) FetchInstances() ([]ecs.Instance, error) {
-	var allInstances []ecs.Instance
-	pageNumber := 1
-	pageSize := 100 // 使用最大页面大小以减少请求次数
+func (s *ECSService) FetchInstances(ctx context.Context) ([]ecs.Instance, error) {
+	return cache.Fetch(ctx, s.cache, CacheECSInstances, s.fetchInstances)
+}
 
-	for {
-		request := ecs.CreateDescribeInstancesRequest()
-		request.Scheme = "https"
-		request.PageNumber = requests.NewInteger(pageNumber)
-		request.PageSize = requests.NewInteger(pageSize)
+// fetchInstances calls the API for FetchInstances
+func (s *ECSService) fetchInstances(ctx context.Context) ([]ecs.Instance, error) {
+	return s.fetchInstancesWhere(ctx, nil)
+}
 
-		response, err := s.client.DescribeInstances(request)
+// fetchInstancesWhere retrieves every page of the instances filter selects
+// by setting request parameters, all of them when it is nil
+func (s *ECSService) fetchInstancesWhere(ctx context.Context, filter func(*ecs.DescribeInstancesRequest)) ([]ecs.Instance, error) {
+	var allInstances []ecs.Instance
+	nextToken := ""
+	for {
+		page, err := s.fetchInstancesPage(ctx, nextToken, filter)
 		if err != nil {
-			return nil, fmt.Errorf("describing ECS instances (page %d): %w", pageNumber, err)
+			return nil, err
+		}
+		allInstances = append(allInstances, page.Instances...)
+		if page.NextToken == "" {
+			return allInstances, nil
 		}
+		nextToken = page.NextToken
+	}
+}
 
-		// 添加当前页的实例到总列表
-		allInstances = append(allInstances, response.Instances.Instance...)
+// ecsInstancesPageSize is the most instances DescribeInstances returns at once
+const ecsInstancesPageSize = 100
 
-		// 检查是否还有更多页面
-		// 如果当前页的实例数量小于页面大小，说明这是最后一页
-		if len(response.Instances.Instance) < pageSize {
-			break
-		}
+// ECSInstancesPage is one page of ECS instances
+type ECSInstancesPage struct {
+	Instances  []ecs.Instance
+	NextToken  string // Fetches the next page; "" on the last one
+	TotalCount int    // Instances in the region, 0 when the API leaves it out
+}
 
-		// 也可以通过TotalCount来判断是否获取完所有数据
-		if len(allInstances) >= response.TotalCount {
-			break
+// FetchInstancesPage retrieves the page of ECS instances nextToken points
+// to, the first one when it is ""
+func (s *ECSService) FetchInstancesPage(ctx context.Context, nextToken string) (*ECSInstancesPage, error) {
+	return s.fetchInstancesPage(ctx, nextToken, nil)
+}
+
+// fetchInstancesPage retrieves a page of the instances filter selects
+func (s *ECSService) fetchInstancesPage(ctx context.Context, nextToken string, filter func(*ecs.DescribeInstancesRequest)) (*ECSInstancesPage, error) {
+	request := ecs.CreateDescribeInstancesRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.MaxResults = requests.NewInteger(ecsInstancesPageSize)
+	request.NextToken = nextToken
+	if filter != nil {
+		filter(request)
+	}
+
+	response, err := s.client.DescribeInstances(request)
+	if err != nil {
+		return nil, fmt.Errorf("describing ECS instances: %w", err)
+	}
+	return &ECSInstancesPage{
+		Instances:  response.Instances.Instance,
+		NextToken:  response.NextToken,
+		TotalCount: response.TotalCount,
+	}, nil
+}
+
+// ECSIPFilterLimit is the most addresses one DescribeInstances IP filter or
+// ID list takes
+const ECSIPFilterLimit = 100
+
+// FetchInstancesByIPs retrieves the instances having one of ips as private,
+// public or classic network address, filtered by the API. ips are complete
+// addresses, at most ECSIPFilterLimit of them
+func (s *ECSService) FetchInstancesByIPs(ctx context.Context, ips []string) ([]ecs.Instance, error) {
+	data, err := json.Marshal(ips)
+	if err != nil {
+		return nil, err
+	}
+	list := string(data)
+	filters := []func(*ecs.DescribeInstancesRequest){
+		func(r *ecs.DescribeInstancesRequest) { r.PrivateIpAddresses = list },
+		func(r *ecs.DescribeInstancesRequest) { r.PublicIpAddresses = list },
+		func(r *ecs.DescribeInstancesRequest) { r.InnerIpAddresses = list },
+	}
+
+	var instances []ecs.Instance
+	seen := make(map[string]bool)
+	for _, filter := range filters {
+		found, err := s.fetchInstancesWhere(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		for _, inst := range found {
+			if !seen[inst.InstanceId] {
+				seen[inst.InstanceId] = true
+				instances = append(instances, inst)
+			}
 		}
+	}
+	return instances, nil
+}
 
-		pageNumber++
+// FetchInstancesByIDs retrieves the instances with the IDs, at most
+// ECSIPFilterLimit of them
+func (s *ECSService) FetchInstancesByIDs(ctx context.Context, ids []string) ([]ecs.Instance, error) {
+	if len(ids) == 0 {
+		return nil, nil
 	}
+	data, err := json.Marshal(ids)
+	if err != nil {
+		return nil, err
+	}
+	return s.fetchInstancesWhere(ctx, func(r *ecs.DescribeInstancesRequest) { r.InstanceIds = string(data) })
+}
 
-	return allInstances, nil
+// CachedInstances returns the ECS instances while they are cached, so a
+// caller loading them page by page can skip the API
+func (s *ECSService) CachedInstances() ([]ecs.Instance, bool) {
+	return cache.Lookup[[]ecs.Instance](s.cache, CacheECSInstances)
+}
+
+// CacheInstances caches the ECS instances a caller loaded page by page, as
+// FetchInstances would have
+func (s *ECSService) CacheInstances(instances []ecs.Instance) {
+	s.cache.Put(CacheECSInstances, instances)
+}
+
+// InstanceAddress returns the address to reach an instance at: its public
+// IP or EIP, else its private IP for when this machine is inside the VPC
+func InstanceAddress(inst ecs.Instance) string {
+	switch {
+	case len(inst.PublicIpAddress.IpAddress) > 0:
+		return inst.PublicIpAddress.IpAddress[0]
+	case inst.EipAddress.IpAddress != "":
+		return inst.EipAddress.IpAddress
+	case len(inst.VpcAttributes.PrivateIpAddress.IpAddress) > 0:
+		return inst.VpcAttributes.PrivateIpAddress.IpAddress[0]
+	case len(inst.InnerIpAddress.IpAddress) > 0:
+		return inst.InnerIpAddress.IpAddress[0]
+	}
+	return ""
 }
 
 // FetchSecurityGroups retrieves all security groups using pagination
-func (s *ECSService) FetchSecurityGroups() ([]ecs.SecurityGroup, error) {
+func (s *ECSService) FetchSecurityGroups(ctx context.Context) ([]ecs.SecurityGroup, error) {
+	return cache.Fetch(ctx, s.cache, CacheSecurityGroups, s.fetchSecurityGroups)
+}
+
+// fetchSecurityGroups calls the API for FetchSecurityGroups
+func (s *ECSService) fetchSecurityGroups(ctx context.Context) ([]ecs.SecurityGroup, error) {
 	var allSecurityGroups []ecs.SecurityGroup
 	pageNumber := 1
 	pageSize := 100 // 使用最大页面大小以减少请求次数
@@ -63,6 +189,7 @@ func (s *ECSService) FetchSecurityGroups() ([]ecs.SecurityGroup, error) {
 	for {
 		request := ecs.CreateDescribeSecurityGroupsRequest()
 		request.Scheme = "https"
+		bind(ctx, request)
 		request.PageNumber = requests.NewInteger(pageNumber)
 		request.PageSize = requests.NewInteger(pageSize)
 
@@ -92,9 +219,10 @@ func (s *ECSService) FetchSecurityGroups() ([]ecs.SecurityGroup, error) {
 }
 
 // FetchSecurityGroupRules retrieves security group rules for a specific security group
-func (s *ECSService) FetchSecurityGroupRules(securityGroupId string) (*ecs.DescribeSecurityGroupAttributeResponse, error) {
+func (s *ECSService) FetchSecurityGroupRules(ctx context.Context, securityGroupId string) (*ecs.DescribeSecurityGroupAttributeResponse, error) {
 	request := ecs.CreateDescribeSecurityGroupAttributeRequest()
 	request.Scheme = "https"
+	bind(ctx, request)
 	request.SecurityGroupId = securityGroupId
 
 	response, err := s.client.DescribeSecurityGroupAttribute(request)
@@ -105,8 +233,113 @@ func (s *ECSService) FetchSecurityGroupRules(securityGroupId string) (*ecs.Descr
 	return response, nil
 }
 
+// SecurityGroupRuleInput holds the editable fields of a security group rule
+type SecurityGroupRuleInput struct {
+	Direction   string // "ingress" or "egress"
+	IpProtocol  string // TCP, UDP, ICMP, GRE or ALL
+	PortRange   string // e.g. "22/22", "-1/-1" for ICMP and ALL
+	CidrIp      string // Source CIDR of an ingress rule, destination of an egress rule
+	Policy      string // "accept" or "drop"
+	Priority    string // 1-100, empty keeps the default (1)
+	Description string
+}
+
+// AuthorizeSecurityGroup adds an ingress or egress rule to a security group
+func (s *ECSService) AuthorizeSecurityGroup(securityGroupId string, input SecurityGroupRuleInput) error {
+	var err error
+	if input.Direction == "egress" {
+		request := ecs.CreateAuthorizeSecurityGroupEgressRequest()
+		request.Scheme = "https"
+		request.SecurityGroupId = securityGroupId
+		request.IpProtocol = input.IpProtocol
+		request.PortRange = input.PortRange
+		request.DestCidrIp = input.CidrIp
+		request.Policy = input.Policy
+		request.Priority = input.Priority
+		request.Description = input.Description
+		_, err = s.client.AuthorizeSecurityGroupEgress(request)
+	} else {
+		request := ecs.CreateAuthorizeSecurityGroupRequest()
+		request.Scheme = "https"
+		request.SecurityGroupId = securityGroupId
+		request.IpProtocol = input.IpProtocol
+		request.PortRange = input.PortRange
+		request.SourceCidrIp = input.CidrIp
+		request.Policy = input.Policy
+		request.Priority = input.Priority
+		request.Description = input.Description
+		_, err = s.client.AuthorizeSecurityGroup(request)
+	}
+
+	if err != nil {
+		return fmt.Errorf("adding %s rule %s %s to security group %s: %w", input.Direction, input.IpProtocol, input.PortRange, securityGroupId, err)
+	}
+	return nil
+}
+
+// ModifySecurityGroupRule replaces the fields of an existing rule. The
+// direction of a rule cannot be changed
+func (s *ECSService) ModifySecurityGroupRule(securityGroupId, ruleID string, input SecurityGroupRuleInput) error {
+	var err error
+	if input.Direction == "egress" {
+		request := ecs.CreateModifySecurityGroupEgressRuleRequest()
+		request.Scheme = "https"
+		request.SecurityGroupId = securityGroupId
+		request.SecurityGroupRuleId = ruleID
+		request.IpProtocol = input.IpProtocol
+		request.PortRange = input.PortRange
+		request.DestCidrIp = input.CidrIp
+		request.Policy = input.Policy
+		request.Priority = input.Priority
+		request.Description = input.Description
+		_, err = s.client.ModifySecurityGroupEgressRule(request)
+	} else {
+		request := ecs.CreateModifySecurityGroupRuleRequest()
+		request.Scheme = "https"
+		request.SecurityGroupId = securityGroupId
+		request.SecurityGroupRuleId = ruleID
+		request.IpProtocol = input.IpProtocol
+		request.PortRange = input.PortRange
+		request.SourceCidrIp = input.CidrIp
+		request.Policy = input.Policy
+		request.Priority = input.Priority
+		request.Description = input.Description
+		_, err = s.client.ModifySecurityGroupRule(request)
+	}
+
+	if err != nil {
+		return fmt.Errorf("modifying rule %s of security group %s: %w", ruleID, securityGroupId, err)
+	}
+	return nil
+}
+
+// RevokeSecurityGroup removes a rule from a security group by its rule ID
+func (s *ECSService) RevokeSecurityGroup(securityGroupId string, rule ecs.Permission) error {
+	ruleIDs := []string{rule.SecurityGroupRuleId}
+
+	var err error
+	if rule.Direction == "egress" {
+		request := ecs.CreateRevokeSecurityGroupEgressRequest()
+		request.Scheme = "https"
+		request.SecurityGroupId = securityGroupId
+		request.SecurityGroupRuleId = &ruleIDs
+		_, err = s.client.RevokeSecurityGroupEgress(request)
+	} else {
+		request := ecs.CreateRevokeSecurityGroupRequest()
+		request.Scheme = "https"
+		request.SecurityGroupId = securityGroupId
+		request.SecurityGroupRuleId = &ruleIDs
+		_, err = s.client.RevokeSecurityGroup(request)
+	}
+
+	if err != nil {
+		return fmt.Errorf("revoking rule %s of security group %s: %w", rule.SecurityGroupRuleId, securityGroupId, err)
+	}
+	return nil
+}
+
 // FetchInstancesBySecurityGroup retrieves ECS instances that use a specific security group
-func (s *ECSService) FetchInstancesBySecurityGroup(securityGroupId string) ([]ecs.Instance, error) {
+func (s *ECSService) FetchInstancesBySecurityGroup(ctx context.Context, securityGroupId string) ([]ecs.Instance, error) {
 	var allInstances []ecs.Instance
 	pageNumber := 1
 	pageSize := 100
@@ -114,6 +347,7 @@ func (s *ECSService) FetchInstancesBySecurityGroup(securityGroupId string) ([]ec
 	for {
 		request := ecs.CreateDescribeInstancesRequest()
 		request.Scheme = "https"
+		bind(ctx, request)
 		request.PageNumber = requests.NewInteger(pageNumber)
 		request.PageSize = requests.NewInteger(pageSize)
 		request.SecurityGroupId = securityGroupId
@@ -142,7 +376,7 @@ func (s *ECSService) FetchInstancesBySecurityGroup(securityGroupId string) ([]ec
 }
 
 // FetchNetworkInterfaces retrieves all network interfaces for a specific ECS instance
-func (s *ECSService) FetchNetworkInterfaces(instanceId string) ([]ecs.NetworkInterfaceSet, error) {
+func (s *ECSService) FetchNetworkInterfaces(ctx context.Context, instanceId string) ([]ecs.NetworkInterfaceSet, error) {
 	var allENIs []ecs.NetworkInterfaceSet
 	pageNumber := 1
 	pageSize := 100
@@ -150,6 +384,7 @@ func (s *ECSService) FetchNetworkInterfaces(instanceId string) ([]ecs.NetworkInt
 	for {
 		request := ecs.CreateDescribeNetworkInterfacesRequest()
 		request.Scheme = "https"
+		bind(ctx, request)
 		request.InstanceId = instanceId
 		request.PageNumber = requests.NewInteger(pageNumber)
 		request.PageSize = requests.NewInteger(pageSize)
@@ -175,8 +410,99 @@ func (s *ECSService) FetchNetworkInterfaces(instanceId string) ([]ecs.NetworkInt
 	return allENIs, nil
 }
 
+const (
+	// eniPageSize is how many network interfaces a region-wide
+	// DescribeNetworkInterfaces call returns
+	eniPageSize = 100
+	// eniConcurrency bounds the pages of network interfaces fetched at once
+	eniConcurrency = 8
+	// eniIPFilterLimit is the most private IPs one DescribeNetworkInterfaces
+	// call filters by
+	eniIPFilterLimit = 100
+)
+
+// FetchAllNetworkInterfaces retrieves the network interfaces of every
+// instance in the region, fetching the pages after the first concurrently.
+// With privateIPs the API returns only the interfaces holding one of them;
+// more than it filters by at once fetch them all. onPage, when set, is
+// called with the pages fetched so far; cancelling ctx skips the pages not
+// started yet
+func (s *ECSService) FetchAllNetworkInterfaces(ctx context.Context, privateIPs []string, onPage func(done, total int)) ([]ecs.NetworkInterfaceSet, error) {
+	if len(privateIPs) > eniIPFilterLimit {
+		privateIPs = nil
+	}
+
+	first, err := s.fetchNetworkInterfacesPage(ctx, privateIPs, 1)
+	if err != nil {
+		return nil, err
+	}
+	pageCount := (first.TotalCount + eniPageSize - 1) / eniPageSize
+	if pageCount <= 1 {
+		return first.NetworkInterfaceSets.NetworkInterfaceSet, nil
+	}
+
+	var wg sync.WaitGroup
+	var fetched atomic.Int32
+	sem := make(chan struct{}, eniConcurrency)
+	pages := make([][]ecs.NetworkInterfaceSet, pageCount)
+	errs := make([]error, pageCount)
+	pages[0] = first.NetworkInterfaceSets.NetworkInterfaceSet
+	fetched.Add(1)
+	if onPage != nil {
+		onPage(1, pageCount)
+	}
+
+	for i := 1; i < pageCount; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			if errs[i] = ctx.Err(); errs[i] != nil {
+				return
+			}
+
+			// Each goroutine only writes its own elements
+			response, err := s.fetchNetworkInterfacesPage(ctx, privateIPs, i+1)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			pages[i] = response.NetworkInterfaceSets.NetworkInterfaceSet
+			if done := fetched.Add(1); onPage != nil {
+				onPage(int(done), pageCount)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return slices.Concat(pages...), nil
+}
+
+// fetchNetworkInterfacesPage retrieves a page of the region's network
+// interfaces, those holding one of privateIPs when set
+func (s *ECSService) fetchNetworkInterfacesPage(ctx context.Context, privateIPs []string, pageNumber int) (*ecs.DescribeNetworkInterfacesResponse, error) {
+	request := ecs.CreateDescribeNetworkInterfacesRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.PageNumber = requests.NewInteger(pageNumber)
+	request.PageSize = requests.NewInteger(eniPageSize)
+	if len(privateIPs) > 0 {
+		request.PrivateIpAddress = &privateIPs
+	}
+
+	response, err := s.client.DescribeNetworkInterfaces(request)
+	if err != nil {
+		return nil, fmt.Errorf("describing network interfaces (page %d): %w", pageNumber, err)
+	}
+	return response, nil
+}
+
 // FetchDisks retrieves all disks for a specific ECS instance
-func (s *ECSService) FetchDisks(instanceId string) ([]ecs.Disk, error) {
+func (s *ECSService) FetchDisks(ctx context.Context, instanceId string) ([]ecs.Disk, error) {
 	var allDisks []ecs.Disk
 	pageNumber := 1
 	pageSize := 100
@@ -184,6 +510,7 @@ func (s *ECSService) FetchDisks(instanceId string) ([]ecs.Disk, error) {
 	for {
 		request := ecs.CreateDescribeDisksRequest()
 		request.Scheme = "https"
+		bind(ctx, request)
 		request.InstanceId = instanceId
 		request.PageNumber = requests.NewInteger(pageNumber)
 		request.PageSize = requests.NewInteger(pageSize)
@@ -210,10 +537,11 @@ func (s *ECSService) FetchDisks(instanceId string) ([]ecs.Disk, error) {
 }
 
 // FetchSecurityGroupsByInstance retrieves security groups for a specific ECS instance
-func (s *ECSService) FetchSecurityGroupsByInstance(instanceId string) ([]ecs.SecurityGroup, error) {
+func (s *ECSService) FetchSecurityGroupsByInstance(ctx context.Context, instanceId string) ([]ecs.SecurityGroup, error) {
 	// 首先获取实例详情以获取安全组ID列表
 	request := ecs.CreateDescribeInstancesRequest()
 	request.Scheme = "https"
+	bind(ctx, request)
 	request.InstanceIds = fmt.Sprintf("[\"%s\"]", instanceId)
 
 	response, err := s.client.DescribeInstances(request)
@@ -237,6 +565,7 @@ func (s *ECSService) FetchSecurityGroupsByInstance(instanceId string) ([]ecs.Sec
 	for _, sgId := range securityGroupIds {
 		sgRequest := ecs.CreateDescribeSecurityGroupsRequest()
 		sgRequest.Scheme = "https"
+		bind(ctx, sgRequest)
 		sgRequest.SecurityGroupIds = fmt.Sprintf("[\"%s\"]", sgId)
 
 		sgResponse, err := s.client.DescribeSecurityGroups(sgRequest)
@@ -251,3 +580,91 @@ func (s *ECSService) FetchSecurityGroupsByInstance(instanceId string) ([]ecs.Sec
 
 	return securityGroups, nil
 }
+
+// CreateVncUrl returns the management terminal (VNC) console URL of an
+// instance. The URL embeds a one-time session that expires within seconds,
+// so it must be opened right away and cannot be reused. regionID may be
+// empty to use the client's region
+func (s *ECSService) CreateVncUrl(instanceID, regionID string, isWindows bool) (string, error) {
+	request := ecs.CreateDescribeInstanceVncUrlRequest()
+	request.Scheme = "https"
+	request.InstanceId = instanceID
+	if regionID != "" {
+		request.RegionId = regionID
+	}
+
+	response, err := s.client.DescribeInstanceVncUrl(request)
+	if err != nil {
+		return "", fmt.Errorf("describing VNC URL of instance %s: %w", instanceID, err)
+	}
+
+	query := url.Values{}
+	query.Set("vncUrl", response.VncUrl)
+	query.Set("instanceId", instanceID)
+	query.Set("isWindows", strconv.FormatBool(isWindows))
+	return vncConsoleURL + "?" + query.Encode(), nil
+}
+
+// FetchInstanceRAMRole returns the name of the RAM role attached to an
+// instance, or "" if it has none. regionID may be empty to use the client's
+// region
+func (s *ECSService) FetchInstanceRAMRole(ctx context.Context, instanceID, regionID string) (string, error) {
+	request := ecs.CreateDescribeInstanceRamRoleRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.InstanceIds = fmt.Sprintf(`["%s"]`, instanceID)
+	if regionID != "" {
+		request.RegionId = regionID
+	}
+
+	response, err := s.client.DescribeInstanceRamRole(request)
+	if err != nil {
+		return "", fmt.Errorf("describing RAM role of instance %s: %w", instanceID, err)
+	}
+
+	for _, set := range response.InstanceRamRoleSets.InstanceRamRoleSet {
+		if set.InstanceId == instanceID {
+			return set.RamRoleName, nil
+		}
+	}
+	return "", nil
+}
+
+// FetchInstanceByPrivateIP returns the instance of a VPC with a private IP,
+// or nil if there is none
+func (s *ECSService) FetchInstanceByPrivateIP(ctx context.Context, vpcID, ip string) (*ecs.Instance, error) {
+	request := ecs.CreateDescribeInstancesRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.VpcId = vpcID
+	request.PrivateIpAddresses = fmt.Sprintf(`["%s"]`, ip)
+
+	response, err := s.client.DescribeInstances(request)
+	if err != nil {
+		return nil, fmt.Errorf("describing ECS instance with private IP %s: %w", ip, err)
+	}
+
+	if len(response.Instances.Instance) == 0 {
+		return nil, nil
+	}
+	return &response.Instances.Instance[0], nil
+}
+
+// FetchInstance returns an instance of a region by ID, or nil if there is none
+func (s *ECSService) FetchInstance(ctx context.Context, instanceID, regionID string) (*ecs.Instance, error) {
+	request := ecs.CreateDescribeInstancesRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.RegionId = regionID
+	request.InstanceIds = fmt.Sprintf(`["%s"]`, instanceID)
+
+	response, err := s.client.DescribeInstances(request)
+	if err != nil {
+		return nil, fmt.Errorf("describing ECS instance %s: %w", instanceID, err)
+	}
+
+	if len(response.Instances.Instance) == 0 {
+		return nil, nil
+	}
+	return &response.Instances.Instance[0], nil
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+)
+
+// EIPService handles elastic IP address operations
+type EIPService struct {
+	client *vpc.Client
+}
+
+// NewEIPService creates a new EIP service
+func NewEIPService(client *vpc.Client) *EIPService {
+	return &EIPService{client: client}
+}
+
+// FetchEIPs retrieves all elastic IP addresses of the region, bound or not,
+// using pagination
+func (s *EIPService) FetchEIPs(ctx context.Context) ([]vpc.EipAddress, error) {
+	var allEIPs []vpc.EipAddress
+	pageNumber := 1
+	pageSize := 100
+
+	for {
+		request := vpc.CreateDescribeEipAddressesRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(pageSize)
+
+		response, err := s.client.DescribeEipAddresses(request)
+		if err != nil {
+			return nil, fmt.Errorf("describing EIP addresses (page %d): %w", pageNumber, err)
+		}
+
+		allEIPs = append(allEIPs, response.EipAddresses.EipAddress...)
+		if len(response.EipAddresses.EipAddress) < pageSize || len(allEIPs) >= response.TotalCount {
+			break
+		}
+		pageNumber++
+	}
+
+	return allEIPs, nil
+}
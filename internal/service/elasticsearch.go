@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/elasticsearch"
+
+	"aliyun-tui-viewer/internal/cache"
+)
+
+// elasticsearchPageSize is the largest page ListInstance accepts
+const elasticsearchPageSize = 100
+
+// ElasticsearchInstance is an Elasticsearch cluster as DescribeInstance
+// reports it, which unlike ListInstance includes the endpoints
+type ElasticsearchInstance struct {
+	elasticsearch.ResultInDescribeInstance
+	RegionId string `json:"regionId"`
+}
+
+// Endpoint returns the private endpoint of the cluster, e.g.
+// "http://es-cn-xxx.elasticsearch.aliyuncs.com:9200"
+func (i ElasticsearchInstance) Endpoint() string {
+	return elasticsearchURL(i.Protocol, i.Domain, i.Port)
+}
+
+// PublicEndpoint returns the public endpoint, empty unless public access is
+// enabled
+func (i ElasticsearchInstance) PublicEndpoint() string {
+	if !i.EnablePublic {
+		return ""
+	}
+	return elasticsearchURL(i.Protocol, i.PublicDomain, i.PublicPort)
+}
+
+// KibanaEndpoint returns the Kibana endpoint, which Alibaba Cloud serves over
+// HTTPS, empty when the cluster has no Kibana
+func (i ElasticsearchInstance) KibanaEndpoint() string {
+	if !i.HaveKibana {
+		return ""
+	}
+	return elasticsearchURL("HTTPS", i.KibanaDomain, i.KibanaPort)
+}
+
+// elasticsearchURL formats an endpoint, empty when there is no domain
+func elasticsearchURL(protocol, domain string, port int) string {
+	if domain == "" {
+		return ""
+	}
+	scheme := "http"
+	if protocol == "HTTPS" {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s:%d", scheme, domain, port)
+}
+
+// ElasticsearchService handles Alibaba Cloud Elasticsearch operations
+type ElasticsearchService struct {
+	client   *elasticsearch.Client
+	regionID string
+
+	cached
+}
+
+// NewElasticsearchService creates a new ElasticsearchService. The region is
+// recorded on the instances, which do not report it
+func NewElasticsearchService(client *elasticsearch.Client, regionID string) *ElasticsearchService {
+	return &ElasticsearchService{client: client, regionID: regionID}
+}
+
+// FetchInstances retrieves all Elasticsearch clusters of the region. They are
+// listed with pagination and then described in parallel for their endpoints;
+// a cluster that cannot be described keeps the attributes of the list
+func (s *ElasticsearchService) FetchInstances(ctx context.Context) ([]ElasticsearchInstance, error) {
+	return cache.Fetch(ctx, s.cache, CacheESInstances, s.fetchInstances)
+}
+
+// fetchInstances calls the API for FetchInstances
+func (s *ElasticsearchService) fetchInstances(ctx context.Context) ([]ElasticsearchInstance, error) {
+	listed, err := s.listInstances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var wg sync.WaitGroup
+	instances := make([]ElasticsearchInstance, len(listed))
+	for i, inst := range listed {
+		instances[i] = s.fromListed(inst)
+
+		wg.Add(1)
+		go func(idx int, instanceID string) {
+			defer wg.Done()
+			if described, err := s.FetchInstance(ctx, instanceID); err == nil {
+				instances[idx] = *described
+			}
+		}(i, inst.InstanceId)
+	}
+	wg.Wait()
+	return instances, nil
+}
+
+// listInstances lists the clusters of the region using pagination
+func (s *ElasticsearchService) listInstances(ctx context.Context) ([]elasticsearch.Instance, error) {
+	var all []elasticsearch.Instance
+	page := 1
+
+	for {
+		request := elasticsearch.CreateListInstanceRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.Page = requests.NewInteger(page)
+		request.Size = requests.NewInteger(elasticsearchPageSize)
+
+		response, err := s.client.ListInstance(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing Elasticsearch instances (page %d): %w", page, err)
+		}
+
+		all = append(all, response.Result...)
+		if len(response.Result) < elasticsearchPageSize || len(all) >= response.Headers.XTotalCount {
+			break
+		}
+		page++
+	}
+	return all, nil
+}
+
+// FetchInstance describes a cluster
+func (s *ElasticsearchService) FetchInstance(ctx context.Context, instanceID string) (*ElasticsearchInstance, error) {
+	request := elasticsearch.CreateDescribeInstanceRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.InstanceId = instanceID
+
+	response, err := s.client.DescribeInstance(request)
+	if err != nil {
+		return nil, fmt.Errorf("describing Elasticsearch instance %s: %w", instanceID, err)
+	}
+	return &ElasticsearchInstance{ResultInDescribeInstance: response.Result, RegionId: s.regionID}, nil
+}
+
+// fromListed converts a listed cluster, for when it cannot be described
+func (s *ElasticsearchService) fromListed(inst elasticsearch.Instance) ElasticsearchInstance {
+	return ElasticsearchInstance{
+		ResultInDescribeInstance: elasticsearch.ResultInDescribeInstance{
+			InstanceId:      inst.InstanceId,
+			Description:     inst.Description,
+			Status:          inst.Status,
+			EsVersion:       inst.EsVersion,
+			NodeAmount:      inst.NodeAmount,
+			PaymentType:     inst.PaymentType,
+			CreatedAt:       inst.CreatedAt,
+			UpdatedAt:       inst.UpdatedAt,
+			Endtime:         inst.EndTime,
+			ResourceGroupId: inst.ResourceGroupId,
+			DedicateMaster:  inst.DedicateMaster,
+			ArchType:        inst.ArchType,
+			NetworkConfig:   inst.NetworkConfig,
+			NodeSpec: elasticsearch.NodeSpecInDescribeInstance{
+				Spec:     inst.NodeSpec.Spec,
+				Disk:     inst.NodeSpec.Disk,
+				DiskType: inst.NodeSpec.DiskType,
+			},
+			MasterConfiguration: inst.MasterConfiguration,
+			KibanaConfiguration: inst.KibanaConfiguration,
+			Tags:                inst.Tags,
+		},
+		RegionId: s.regionID,
+	}
+}
@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cms"
+
+	"aliyun-tui-viewer/internal/cache"
+	"aliyun-tui-viewer/internal/client"
+)
+
+const (
+	// fcPageSize is the largest page the FC list calls accept
+	fcPageSize = 100
+	// fcMetricNamespace is the CloudMonitor namespace of Function Compute
+	fcMetricNamespace = "acs_fc"
+	// FCStatsWindow is how far back invocation statistics are summed
+	FCStatsWindow = 24 * time.Hour
+)
+
+// FCServiceInfo is a Function Compute service, which groups functions
+// sharing a role, log and network configuration
+type FCServiceInfo struct {
+	ServiceName      string                 `json:"serviceName"`
+	ServiceID        string                 `json:"serviceId"`
+	Description      string                 `json:"description"`
+	Role             string                 `json:"role"`
+	InternetAccess   bool                   `json:"internetAccess"`
+	LogConfig        FCLogConfig            `json:"logConfig"`
+	VpcConfig        FCVpcConfig            `json:"vpcConfig"`
+	TracingConfig    map[string]interface{} `json:"tracingConfig,omitempty"`
+	CreatedTime      string                 `json:"createdTime"`
+	LastModifiedTime string                 `json:"lastModifiedTime"`
+}
+
+// FCLogConfig is where a service ships function logs
+type FCLogConfig struct {
+	Project  string `json:"project"`
+	Logstore string `json:"logstore"`
+}
+
+// FCVpcConfig is the VPC a service's functions run in
+type FCVpcConfig struct {
+	VpcID           string   `json:"vpcId"`
+	VSwitchIDs      []string `json:"vSwitchIds"`
+	SecurityGroupID string   `json:"securityGroupId"`
+}
+
+// FCFunction is a function of a Function Compute service
+type FCFunction struct {
+	FunctionName          string                 `json:"functionName"`
+	FunctionID            string                 `json:"functionId"`
+	Description           string                 `json:"description"`
+	Runtime               string                 `json:"runtime"`
+	Handler               string                 `json:"handler"`
+	MemorySize            int                    `json:"memorySize"` // MB
+	Timeout               int                    `json:"timeout"`    // Seconds
+	InstanceConcurrency   int                    `json:"instanceConcurrency"`
+	InstanceType          string                 `json:"instanceType"`
+	CodeSize              int64                  `json:"codeSize"` // Bytes
+	CodeChecksum          string                 `json:"codeChecksum"`
+	EnvironmentVariables  map[string]string      `json:"environmentVariables"`
+	Layers                []string               `json:"layers"`
+	CustomContainerConfig map[string]interface{} `json:"customContainerConfig,omitempty"`
+	CreatedTime           string                 `json:"createdTime"`
+	LastModifiedTime      string                 `json:"lastModifiedTime"`
+}
+
+// EnvNames returns the names of the function's environment variables, sorted
+func (f FCFunction) EnvNames() []string {
+	names := make([]string, 0, len(f.EnvironmentVariables))
+	for name := range f.EnvironmentVariables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FCInvocationStats are the invocation counts of a function over
+// FCStatsWindow
+type FCInvocationStats struct {
+	Invocations    int64
+	FunctionErrors int64 // Errors raised by the function code
+	ServerErrors   int64 // Errors of the Function Compute system
+}
+
+// Errors returns the total error count
+func (s FCInvocationStats) Errors() int64 {
+	return s.FunctionErrors + s.ServerErrors
+}
+
+// FCService handles Function Compute operations
+type FCService struct {
+	client *client.FCClient
+
+	cached
+}
+
+// NewFCService creates a new Function Compute service
+func NewFCService(client *client.FCClient) *FCService {
+	return &FCService{client: client}
+}
+
+// FetchServices retrieves all Function Compute services of the region
+func (s *FCService) FetchServices(ctx context.Context) ([]FCServiceInfo, error) {
+	return cache.Fetch(ctx, s.cache, CacheFCServices, s.fetchServices)
+}
+
+// fetchServices calls the API for FetchServices
+func (s *FCService) fetchServices(ctx context.Context) ([]FCServiceInfo, error) {
+	var all []FCServiceInfo
+	nextToken := ""
+	for {
+		var response struct {
+			Services  []FCServiceInfo `json:"services"`
+			NextToken string          `json:"nextToken"`
+		}
+		if err := s.client.Get(ctx, "/services", fcPageQuery(nextToken), &response); err != nil {
+			return nil, fmt.Errorf("listing FC services: %w", err)
+		}
+		all = append(all, response.Services...)
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+
+	sort.SliceStable(all, func(i, j int) bool { return all[i].ServiceName < all[j].ServiceName })
+	return all, nil
+}
+
+// FetchFunctions retrieves the functions of a service
+func (s *FCService) FetchFunctions(ctx context.Context, serviceName string) ([]FCFunction, error) {
+	var all []FCFunction
+	nextToken := ""
+	path := "/services/" + url.PathEscape(serviceName) + "/functions"
+	for {
+		var response struct {
+			Functions []FCFunction `json:"functions"`
+			NextToken string       `json:"nextToken"`
+		}
+		if err := s.client.Get(ctx, path, fcPageQuery(nextToken), &response); err != nil {
+			return nil, fmt.Errorf("listing functions of FC service %s: %w", serviceName, err)
+		}
+		all = append(all, response.Functions...)
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+
+	sort.SliceStable(all, func(i, j int) bool { return all[i].FunctionName < all[j].FunctionName })
+	return all, nil
+}
+
+// AccountID returns the account whose functions are listed, as CloudMonitor
+// dimensions need it
+func (s *FCService) AccountID(ctx context.Context) (string, error) {
+	return s.client.AccountID(ctx)
+}
+
+// Region returns the region functions are listed in
+func (s *FCService) Region() string {
+	return s.client.Region()
+}
+
+// fcPageQuery returns the query of an FC list request
+func fcPageQuery(nextToken string) url.Values {
+	query := url.Values{"limit": {strconv.Itoa(fcPageSize)}}
+	if nextToken != "" {
+		query.Set("nextToken", nextToken)
+	}
+	return query
+}
+
+// FetchFCInvocationStats sums the invocation and error counts of every
+// function of a service over the last FCStatsWindow, keyed by function name.
+// One request per metric covers the whole service, as data points carry the
+// function name
+func (s *MonitorService) FetchFCInvocationStats(ctx context.Context, accountID, region, serviceName string) (map[string]FCInvocationStats, error) {
+	end := time.Now()
+	start := end.Add(-FCStatsWindow)
+	dimensions, err := json.Marshal([]map[string]string{{"userId": accountID, "region": region, "serviceName": serviceName}})
+	if err != nil {
+		return nil, fmt.Errorf("encoding metric dimensions: %w", err)
+	}
+
+	metrics := []struct {
+		name  string
+		apply func(stats *FCInvocationStats, value int64)
+	}{
+		{"FunctionTotalInvocations", func(stats *FCInvocationStats, value int64) { stats.Invocations = value }},
+		{"FunctionFunctionErrors", func(stats *FCInvocationStats, value int64) { stats.FunctionErrors = value }},
+		{"FunctionServerErrors", func(stats *FCInvocationStats, value int64) { stats.ServerErrors = value }},
+	}
+
+	stats := make(map[string]FCInvocationStats)
+	for _, metric := range metrics {
+		sums, err := s.fetchFunctionMetricSums(ctx, metric.name, string(dimensions), start, end)
+		if err != nil {
+			return nil, fmt.Errorf("fetching invocation stats of FC service %s: %w", serviceName, err)
+		}
+		for function, sum := range sums {
+			entry := stats[function]
+			metric.apply(&entry, int64(sum))
+			stats[function] = entry
+		}
+	}
+	return stats, nil
+}
+
+// fetchFunctionMetricSums sums a Function Compute metric over the window per
+// function, following NextToken pages
+func (s *MonitorService) fetchFunctionMetricSums(ctx context.Context, metricName, dimensions string, start, end time.Time) (map[string]float64, error) {
+	sums := make(map[string]float64)
+	nextToken := ""
+
+	for {
+		request := cms.CreateDescribeMetricListRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.Namespace = fcMetricNamespace
+		request.MetricName = metricName
+		request.Dimensions = dimensions
+		request.Period = strconv.Itoa(int(time.Hour.Seconds()))
+		request.Length = "1440" // Points per page
+		request.StartTime = strconv.FormatInt(start.UnixMilli(), 10)
+		request.EndTime = strconv.FormatInt(end.UnixMilli(), 10)
+		request.NextToken = nextToken
+
+		response, err := s.client.DescribeMetricList(request)
+		if err != nil {
+			return nil, fmt.Errorf("describing metric %s: %w", metricName, err)
+		}
+		if !response.Success {
+			return nil, fmt.Errorf("describing metric %s: %s (%s)", metricName, response.Message, response.Code)
+		}
+
+		if response.Datapoints != "" {
+			// Count metrics report the period total as Sum or Value
+			var raw []struct {
+				FunctionName string   `json:"functionName"`
+				Sum          *float64 `json:"Sum"`
+				Value        *float64 `json:"Value"`
+			}
+			if err := json.Unmarshal([]byte(response.Datapoints), &raw); err != nil {
+				return nil, fmt.Errorf("parsing metric %s: %w", metricName, err)
+			}
+			for _, p := range raw {
+				switch {
+				case p.Sum != nil:
+					sums[p.FunctionName] += *p.Sum
+				case p.Value != nil:
+					sums[p.FunctionName] += *p.Value
+				}
+			}
+		}
+
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+	return sums, nil
+}
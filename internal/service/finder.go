@@ -1,8 +1,11 @@
 package service
 
 import (
+	"context"
 	"net"
 	"regexp"
+	"slices"
+	"sort"
 	"strings"
 	"sync"
 
@@ -10,6 +13,7 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 )
 
 // FinderService provides resource finding functionality
@@ -20,6 +24,9 @@ type FinderService struct {
 	rdsService      *RDSService
 	redisService    *RedisService
 	rocketMQService *RocketMQService
+	eipService      *EIPService
+	natService      *NATService
+	permissions     *Permissions
 }
 
 // NewFinderService creates a new finder service
@@ -30,6 +37,8 @@ func NewFinderService(
 	rds *RDSService,
 	redis *RedisService,
 	rocketMQ *RocketMQService,
+	eip *EIPService,
+	nat *NATService,
 ) *FinderService {
 	return &FinderService{
 		ecsService:      ecs,
@@ -38,9 +47,17 @@ func NewFinderService(
 		rdsService:      rds,
 		redisService:    redis,
 		rocketMQService: rocketMQ,
+		eipService:      eip,
+		natService:      nat,
 	}
 }
 
+// SetPermissions makes the finder skip the products p has no permission for,
+// and record those it finds denied
+func (s *FinderService) SetPermissions(p *Permissions) {
+	s.permissions = p
+}
+
 // FindResult contains all matching resources
 type FindResult struct {
 	Query             string
@@ -52,6 +69,23 @@ type FindResult struct {
 	RDSInstances      []RDSInstanceDetail // Changed to detailed instances
 	RedisInstances    []r_kvstore.KVStoreInstance
 	RocketMQInstances []RocketMQInstance
+	EIPs              []vpc.EipAddress
+	NATGateways       []vpc.NatGateway
+	Denied            []string // Products skipped or refused for lack of permission, sorted
+
+	// Why the resources of each section with matches were found, e.g.
+	// FindMatchName, by section name: "ECS", "ENI", "SLB", "DNS", "RDS",
+	// "Redis", "RocketMQ", "EIP" or "NAT"
+	Reasons map[string]string
+}
+
+// ProfileFindResult is the result of a finder query in one of the configured
+// profiles, searched in the profile's own region
+type ProfileFindResult struct {
+	Profile  string
+	RegionID string
+	Result   *FindResult
+	Err      error // Set when the profile could not be loaded or searched
 }
 
 // DNSRecordMatch contains a matched DNS record with its domain
@@ -84,24 +118,28 @@ func IsDomain(input string) bool {
 // ResolveToIPs resolves the input to IP addresses
 // If input is an IP, returns it directly
 // If input is a domain, tries to resolve via Aliyun DNS first, then system DNS
-func (s *FinderService) ResolveToIPs(input string) ([]string, string, error) {
+// Otherwise (a resource ID, name or tag query) returns no IPs
+func (s *FinderService) ResolveToIPs(ctx context.Context, input string) ([]string, string, error) {
 	input = strings.TrimSpace(input)
 
 	// If already an IP, return it
 	if IsIP(input) {
 		return []string{input}, input, nil
 	}
+	if !ParseFindQuery(input).resolvable() {
+		return nil, input, nil
+	}
 
 	// Try to resolve via Aliyun DNS records first
 	if s.dnsService != nil {
-		ips, err := s.resolveViaAliyunDNS(input)
+		ips, err := s.resolveViaAliyunDNS(ctx, input)
 		if err == nil && len(ips) > 0 {
 			return ips, input, nil
 		}
 	}
 
 	// Fall back to system DNS
-	addrs, err := net.LookupHost(input)
+	addrs, err := net.DefaultResolver.LookupHost(ctx, input)
 	if err != nil {
 		// Return empty IPs but keep the domain for DNS record search
 		return nil, input, nil
@@ -111,13 +149,13 @@ func (s *FinderService) ResolveToIPs(input string) ([]string, string, error) {
 }
 
 // resolveViaAliyunDNS looks up the domain in Aliyun DNS records
-func (s *FinderService) resolveViaAliyunDNS(domain string) ([]string, error) {
+func (s *FinderService) resolveViaAliyunDNS(ctx context.Context, domain string) ([]string, error) {
 	if s.dnsService == nil {
 		return nil, nil
 	}
 
 	// Get all domains
-	domains, err := s.dnsService.FetchDomains()
+	domains, err := s.dnsService.FetchDomains(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -128,7 +166,7 @@ func (s *FinderService) resolveViaAliyunDNS(domain string) ([]string, error) {
 	for _, d := range domains {
 		// Check if the input domain is under this DNS domain
 		if strings.HasSuffix(domain, d.DomainName) || domain == d.DomainName {
-			records, err := s.dnsService.FetchDomainRecords(d.DomainName)
+			records, err := s.dnsService.FetchDomainRecords(ctx, d.DomainName)
 			if err != nil {
 				continue
 			}
@@ -151,146 +189,326 @@ func (s *FinderService) resolveViaAliyunDNS(domain string) ([]string, error) {
 	return ips, nil
 }
 
-// FindResources searches for resources matching the given IPs and domain
-func (s *FinderService) FindResources(ips []string, domain string) (*FindResult, error) {
+// FindResources searches for resources matching the given IPs and domain.
+// A domain that is a resource ID, part of a name or a tag query finds the
+// resources with that ID, name or tag
+func (s *FinderService) FindResources(ctx context.Context, ips []string, domain string) (*FindResult, error) {
+	return s.FindResourcesWithProgress(ctx, ips, domain, nil)
+}
+
+// FindResourcesWithProgress searches for resources matching the given IPs
+// and domain, recording how far each section got in progress, which may be
+// nil. Cancelling ctx returns ctx's error at once and stops the sections
+// that fetch page by page
+func (s *FinderService) FindResourcesWithProgress(ctx context.Context, ips []string, domain string, progress *FindProgress) (*FindResult, error) {
 	result := &FindResult{
 		Query:       domain,
 		ResolvedIPs: ips,
+		Reasons:     make(map[string]string),
+	}
+	q := ParseFindQuery(domain)
+	if q.Kind == FindQueryTag {
+		// Tags are not part of addresses or DNS records
+		domain = ""
+	}
+	// Connection addresses and records match a domain, or one of its IPs
+	addressReason := FindMatchAddress
+	if q.Kind == FindQueryIP {
+		addressReason = FindMatchIP
 	}
 
 	var wg sync.WaitGroup
 	var mu sync.Mutex
 
+	deny := func(product string) {
+		mu.Lock()
+		defer mu.Unlock()
+		if !slices.Contains(result.Denied, product) {
+			result.Denied = append(result.Denied, product)
+		}
+	}
+	// skip reports whether a section is left out as the profile has no
+	// permission for its product
+	skip := func(section, product string) bool {
+		if !s.permissions.Denied(product) {
+			return false
+		}
+		progress.deny(section)
+		deny(product)
+		return true
+	}
+	// found records why the resources of a section matched, if any did. The
+	// caller holds mu
+	found := func(section string, count int, reason string) {
+		if count > 0 {
+			result.Reasons[section] = reason
+		}
+	}
+	// failed finishes a section and reports whether it failed, remembering
+	// products the profile turns out to have no permission for
+	failed := func(step int, product string, err error) bool {
+		progress.finish(step, err)
+		if IsPermissionDenied(err) {
+			s.permissions.Deny(product)
+			deny(product)
+		}
+		return err != nil
+	}
+
 	// Search ECS instances
-	if s.ecsService != nil {
+	if s.ecsService != nil && !skip("ECS", ProductECS) {
 		wg.Add(1)
+		step := progress.start("ECS", "")
 		go func() {
 			defer wg.Done()
-			instances, err := s.ecsService.FetchInstances()
-			if err != nil {
+			instances, err := s.findECSInstances(ctx, ips, q)
+			if failed(step, ProductECS, err) {
 				return
 			}
-			matched := s.matchECSInstances(instances, ips)
+			matched := s.matchECSInstances(instances, ips, q)
 			mu.Lock()
 			result.ECSInstances = matched
+			found("ECS", len(matched), q.reason(FindMatchIP))
 			mu.Unlock()
 		}()
 	}
 
 	// Search ENIs
-	if s.ecsService != nil {
+	if s.ecsService != nil && !skip("ENI", ProductECS) {
 		wg.Add(1)
+		step := progress.start("ENI", FindUnitPages)
 		go func() {
 			defer wg.Done()
-			enis, err := s.fetchAllENIs()
-			if err != nil {
+			enis, err := s.fetchAllENIs(ctx, ips, func(done, total int) { progress.advance(step, done, total) })
+			if failed(step, ProductECS, err) {
 				return
 			}
-			matched := s.matchENIs(enis, ips)
+			matched := s.matchENIs(enis, ips, q)
 			mu.Lock()
 			result.ENIs = matched
+			found("ENI", len(matched), q.reason(FindMatchIP))
 			mu.Unlock()
 		}()
 	}
 
 	// Search SLB instances
-	if s.slbService != nil {
+	if s.slbService != nil && !skip("SLB", ProductSLB) {
 		wg.Add(1)
+		step := progress.start("SLB", "")
 		go func() {
 			defer wg.Done()
-			lbs, err := s.slbService.FetchInstances()
-			if err != nil {
+			lbs, err := s.findSLBInstances(ctx, ips)
+			if failed(step, ProductSLB, err) {
 				return
 			}
-			matched := s.matchSLBInstances(lbs, ips)
+			matched := s.matchSLBInstances(lbs, ips, q)
 			mu.Lock()
 			result.SLBInstances = matched
+			found("SLB", len(matched), q.reason(FindMatchIP))
 			mu.Unlock()
 		}()
 	}
 
-	// Search DNS records
-	if s.dnsService != nil {
+	// Search DNS records, which carry no tags
+	if s.dnsService != nil && q.Kind != FindQueryTag && !skip("DNS", ProductDNS) {
 		wg.Add(1)
+		step := progress.start("DNS", FindUnitDomains)
 		go func() {
 			defer wg.Done()
-			matched, _ := s.matchDNSRecords(ips, domain)
+			matched, err := s.matchDNSRecords(ctx, ips, domain, func(done, total int) { progress.advance(step, done, total) })
+			failed(step, ProductDNS, err)
 			mu.Lock()
 			result.DNSRecords = matched
+			found("DNS", len(matched), addressReason)
 			mu.Unlock()
 		}()
 	}
 
-	// Search RDS instances (with network info for public address matching)
-	if s.rdsService != nil {
+	// Search RDS instances (with network info for public address matching).
+	// The list has no tags
+	if s.rdsService != nil && q.Kind != FindQueryTag && !skip("RDS", ProductRDS) {
 		wg.Add(1)
+		step := progress.start("RDS", "")
 		go func() {
 			defer wg.Done()
-			instances, err := s.rdsService.FetchDetailedInstances()
-			if err != nil {
+			instances, err := s.rdsService.FetchDetailedInstances(ctx)
+			if failed(step, ProductRDS, err) {
 				return
 			}
-			matched := s.matchRDSDetailedInstances(instances, ips, domain)
+			matched := s.matchRDSDetailedInstances(instances, ips, domain, q)
 			mu.Lock()
 			result.RDSInstances = matched
+			found("RDS", len(matched), q.reason(addressReason))
 			mu.Unlock()
 		}()
 	}
 
 	// Search Redis instances
-	if s.redisService != nil {
+	if s.redisService != nil && !skip("Redis", ProductRedis) {
 		wg.Add(1)
+		step := progress.start("Redis", "")
 		go func() {
 			defer wg.Done()
-			instances, err := s.redisService.FetchInstances()
-			if err != nil {
+			instances, err := s.redisService.FetchInstances(ctx)
+			if failed(step, ProductRedis, err) {
 				return
 			}
-			matched := s.matchRedisInstances(instances, ips, domain)
+			matched := s.matchRedisInstances(instances, ips, domain, q)
 			mu.Lock()
 			result.RedisInstances = matched
+			found("Redis", len(matched), q.reason(addressReason))
 			mu.Unlock()
 		}()
 	}
 
-	// Search RocketMQ instances
-	if s.rocketMQService != nil {
+	// Search RocketMQ instances, which carry no tags in the list
+	if s.rocketMQService != nil && q.Kind != FindQueryTag && !skip("RocketMQ", ProductRocketMQ) {
 		wg.Add(1)
+		step := progress.start("RocketMQ", "")
 		go func() {
 			defer wg.Done()
-			instances, err := s.rocketMQService.FetchInstances()
-			if err != nil {
+			instances, err := s.rocketMQService.FetchInstances(ctx)
+			if failed(step, ProductRocketMQ, err) {
 				return
 			}
-			matched := s.matchRocketMQInstances(instances, ips, domain)
+			matched := s.matchRocketMQInstances(instances, ips, domain, q)
 			mu.Lock()
 			result.RocketMQInstances = matched
+			found("RocketMQ", len(matched), q.reason(FindMatchName))
+			mu.Unlock()
+		}()
+	}
+
+	// Search elastic IPs, which also covers addresses bound to NAT gateways and SLBs
+	if s.eipService != nil && !skip("EIP", ProductVPC) {
+		wg.Add(1)
+		step := progress.start("EIP", "")
+		go func() {
+			defer wg.Done()
+			eips, err := s.eipService.FetchEIPs(ctx)
+			if failed(step, ProductVPC, err) {
+				return
+			}
+			matched := s.matchEIPs(eips, ips, q)
+			mu.Lock()
+			result.EIPs = matched
+			found("EIP", len(matched), q.reason(FindMatchIP))
 			mu.Unlock()
 		}()
 	}
 
-	wg.Wait()
+	// Search NAT gateways
+	if s.natService != nil && !skip("NAT", ProductVPC) {
+		wg.Add(1)
+		step := progress.start("NAT", "")
+		go func() {
+			defer wg.Done()
+			gateways, err := s.natService.FetchNATGateways(ctx)
+			if failed(step, ProductVPC, err) {
+				return
+			}
+			matched := s.matchNATGateways(gateways, ips, q)
+			mu.Lock()
+			result.NATGateways = matched
+			found("NAT", len(matched), q.reason(FindMatchIP))
+			mu.Unlock()
+		}()
+	}
+
+	// The sections still running finish in the background when cancelled
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	sort.Strings(result.Denied)
 	return result, nil
 }
 
-// fetchAllENIs fetches all ENIs (this is a simplified version)
-func (s *FinderService) fetchAllENIs() ([]ecs.NetworkInterfaceSet, error) {
-	// Note: This requires iterating through all instances or using DescribeNetworkInterfaces API
-	// For simplicity, we'll fetch instances first and then their ENIs
-	instances, err := s.ecsService.FetchInstances()
+// finderSLBAddressLimit is the most IPs the finder looks SLB instances up by
+// one at a time; more list them all
+const finderSLBAddressLimit = 10
+
+// filterableIPs reports whether an API can filter by ips: there are at most
+// limit of them and all are complete addresses
+func filterableIPs(ips []string, limit int) bool {
+	return len(ips) > 0 && len(ips) <= limit &&
+		!slices.ContainsFunc(ips, func(ip string) bool { return net.ParseIP(ip) == nil })
+}
+
+// findECSInstances fetches the instances that may hold one of the IPs. The
+// API filters by complete addresses, and instances bound to a matching EIP
+// are fetched by ID, as is the instance an ID query names; IDs of other
+// resources fetch none. Other queries fetch every instance for
+// matchECSInstances to narrow down
+func (s *FinderService) findECSInstances(ctx context.Context, ips []string, q FindQuery) ([]ecs.Instance, error) {
+	if q.Kind == FindQueryID {
+		if !strings.HasPrefix(strings.ToLower(q.Text), "i-") {
+			return nil, nil
+		}
+		return s.ecsService.FetchInstancesByIDs(ctx, []string{q.Text})
+	}
+	if !filterableIPs(ips, ECSIPFilterLimit) {
+		return s.ecsService.FetchInstances(ctx)
+	}
+	instances, err := s.ecsService.FetchInstancesByIPs(ctx, ips)
 	if err != nil {
 		return nil, err
 	}
+	if s.eipService == nil {
+		return instances, nil
+	}
 
-	var allENIs []ecs.NetworkInterfaceSet
-	for _, inst := range instances {
-		enis, err := s.ecsService.FetchNetworkInterfaces(inst.InstanceId)
+	eips, err := s.eipService.FetchEIPs(ctx)
+	if err != nil {
+		return instances, nil // The address filters found the rest
+	}
+	var ids []string
+	for _, eip := range s.matchEIPs(eips, ips, FindQuery{}) {
+		if eip.InstanceType == "EcsInstance" && eip.InstanceId != "" &&
+			!slices.ContainsFunc(instances, func(inst ecs.Instance) bool { return inst.InstanceId == eip.InstanceId }) {
+			ids = append(ids, eip.InstanceId)
+		}
+	}
+	bound, err := s.ecsService.FetchInstancesByIDs(ctx, ids[:min(len(ids), ECSIPFilterLimit)])
+	if err != nil {
+		return instances, nil
+	}
+	return append(instances, bound...), nil
+}
+
+// findSLBInstances fetches the SLB instances that may serve at one of the
+// IPs, looked up by address when there are few complete ones, otherwise every
+// instance for matchSLBInstances to narrow down
+func (s *FinderService) findSLBInstances(ctx context.Context, ips []string) ([]slb.LoadBalancer, error) {
+	if !filterableIPs(ips, finderSLBAddressLimit) {
+		return s.slbService.FetchInstances(ctx)
+	}
+	var lbs []slb.LoadBalancer
+	for _, ip := range ips {
+		found, err := s.slbService.FetchInstancesByAddress(ctx, ip)
 		if err != nil {
-			continue
+			return nil, err
 		}
-		allENIs = append(allENIs, enis...)
+		lbs = append(lbs, found...)
+	}
+	return lbs, nil
+}
+
+// fetchAllENIs fetches the region's ENIs that may hold one of the IPs. When
+// they are all complete IP addresses the API filters by them; otherwise
+// every ENI is fetched for matchENIs to narrow down
+func (s *FinderService) fetchAllENIs(ctx context.Context, ips []string, onPage func(done, total int)) ([]ecs.NetworkInterfaceSet, error) {
+	var filter []string
+	if filterableIPs(ips, eniIPFilterLimit) {
+		filter = ips
 	}
-	return allENIs, nil
+	return s.ecsService.FetchAllNetworkInterfaces(ctx, filter, onPage)
 }
 
 // containsAny checks if target contains any of the search strings (case-insensitive)
@@ -304,14 +522,15 @@ func containsAny(target string, searches []string) bool {
 	return false
 }
 
-// matchECSInstances finds ECS instances matching the given IPs (using contains matching)
-func (s *FinderService) matchECSInstances(instances []ecs.Instance, ips []string) []ecs.Instance {
-	if len(ips) == 0 {
-		return nil
-	}
-
+// matchECSInstances finds ECS instances matching the given IPs (using contains matching),
+// or the ID, name or tag of q
+func (s *FinderService) matchECSInstances(instances []ecs.Instance, ips []string, q FindQuery) []ecs.Instance {
 	var matched []ecs.Instance
 	for _, inst := range instances {
+		if q.matches(inst.InstanceId, inst.InstanceName, tagsOf(inst.Tags.Tag, func(t ecs.Tag) (string, string) { return t.TagKey, t.TagValue })) {
+			matched = append(matched, inst)
+			continue
+		}
 		// Check public IP
 		for _, pip := range inst.PublicIpAddress.IpAddress {
 			if containsAny(pip, ips) {
@@ -343,14 +562,16 @@ func (s *FinderService) matchECSInstances(instances []ecs.Instance, ips []string
 	return matched
 }
 
-// matchENIs finds ENIs matching the given IPs (using contains matching)
-func (s *FinderService) matchENIs(enis []ecs.NetworkInterfaceSet, ips []string) []ecs.NetworkInterfaceSet {
-	if len(ips) == 0 {
-		return nil
-	}
-
+// matchENIs finds ENIs matching the given IPs (using contains matching), or
+// the ID, name or tag of q. An instance ID finds the ENIs attached to it
+func (s *FinderService) matchENIs(enis []ecs.NetworkInterfaceSet, ips []string, q FindQuery) []ecs.NetworkInterfaceSet {
 	var matched []ecs.NetworkInterfaceSet
 	for _, eni := range enis {
+		tags := tagsOf(eni.Tags.Tag, func(t ecs.Tag) (string, string) { return t.TagKey, t.TagValue })
+		if q.matches(eni.NetworkInterfaceId, eni.NetworkInterfaceName, tags) || q.matches(eni.InstanceId, "", nil) {
+			matched = append(matched, eni)
+			continue
+		}
 		// Check primary private IP
 		if containsAny(eni.PrivateIpAddress, ips) {
 			matched = append(matched, eni)
@@ -367,15 +588,13 @@ func (s *FinderService) matchENIs(enis []ecs.NetworkInterfaceSet, ips []string)
 	return matched
 }
 
-// matchSLBInstances finds SLB instances matching the given IPs (using contains matching)
-func (s *FinderService) matchSLBInstances(lbs []slb.LoadBalancer, ips []string) []slb.LoadBalancer {
-	if len(ips) == 0 {
-		return nil
-	}
-
+// matchSLBInstances finds SLB instances matching the given IPs (using contains matching),
+// or the ID, name or tag of q
+func (s *FinderService) matchSLBInstances(lbs []slb.LoadBalancer, ips []string, q FindQuery) []slb.LoadBalancer {
 	var matched []slb.LoadBalancer
 	for _, lb := range lbs {
-		if containsAny(lb.Address, ips) {
+		tags := tagsOf(lb.Tags.Tag, func(t slb.Tag) (string, string) { return t.TagKey, t.TagValue })
+		if containsAny(lb.Address, ips) || q.matches(lb.LoadBalancerId, lb.LoadBalancerName, tags) {
 			matched = append(matched, lb)
 		}
 	}
@@ -383,15 +602,19 @@ func (s *FinderService) matchSLBInstances(lbs []slb.LoadBalancer, ips []string)
 }
 
 // matchDNSRecords finds DNS records matching the given IPs or domain (using contains matching)
-func (s *FinderService) matchDNSRecords(ips []string, domain string) ([]DNSRecordMatch, error) {
-	domains, err := s.dnsService.FetchDomains()
+func (s *FinderService) matchDNSRecords(ctx context.Context, ips []string, domain string, onDomain func(done, total int)) ([]DNSRecordMatch, error) {
+	domains, err := s.dnsService.FetchDomains(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	var matched []DNSRecordMatch
-	for _, d := range domains {
-		records, err := s.dnsService.FetchDomainRecords(d.DomainName)
+	for i, d := range domains {
+		if err := ctx.Err(); err != nil {
+			return matched, err
+		}
+		onDomain(i, len(domains))
+		records, err := s.dnsService.FetchDomainRecords(ctx, d.DomainName)
 		if err != nil {
 			continue
 		}
@@ -429,10 +652,15 @@ func (s *FinderService) matchDNSRecords(ips []string, domain string) ([]DNSRecor
 	return matched, nil
 }
 
-// matchRDSDetailedInstances finds RDS instances matching the given IPs or domain (using contains matching)
-func (s *FinderService) matchRDSDetailedInstances(instances []RDSInstanceDetail, ips []string, domain string) []RDSInstanceDetail {
+// matchRDSDetailedInstances finds RDS instances matching the given IPs or domain (using contains matching),
+// or the ID or name (description) of q
+func (s *FinderService) matchRDSDetailedInstances(instances []RDSInstanceDetail, ips []string, domain string, q FindQuery) []RDSInstanceDetail {
 	var matched []RDSInstanceDetail
 	for _, inst := range instances {
+		if q.matches(inst.Instance.DBInstanceId, inst.Instance.DBInstanceDescription, nil) {
+			matched = append(matched, inst)
+			continue
+		}
 		// Check internal connection string
 		if domain != "" && strings.Contains(strings.ToLower(inst.InternalConnectionStr), strings.ToLower(domain)) {
 			matched = append(matched, inst)
@@ -461,10 +689,16 @@ func (s *FinderService) matchRDSDetailedInstances(instances []RDSInstanceDetail,
 	return matched
 }
 
-// matchRedisInstances finds Redis instances matching the given IPs or domain (using contains matching)
-func (s *FinderService) matchRedisInstances(instances []r_kvstore.KVStoreInstance, ips []string, domain string) []r_kvstore.KVStoreInstance {
+// matchRedisInstances finds Redis instances matching the given IPs or domain (using contains matching),
+// or the ID, name or tag of q
+func (s *FinderService) matchRedisInstances(instances []r_kvstore.KVStoreInstance, ips []string, domain string, q FindQuery) []r_kvstore.KVStoreInstance {
 	var matched []r_kvstore.KVStoreInstance
 	for _, inst := range instances {
+		tags := tagsOf(inst.Tags.Tag, func(t r_kvstore.Tag) (string, string) { return t.Key, t.Value })
+		if q.matches(inst.InstanceId, inst.InstanceName, tags) {
+			matched = append(matched, inst)
+			continue
+		}
 		// Check connection domain contains the domain
 		if domain != "" && strings.Contains(strings.ToLower(inst.ConnectionDomain), strings.ToLower(domain)) {
 			matched = append(matched, inst)
@@ -478,10 +712,10 @@ func (s *FinderService) matchRedisInstances(instances []r_kvstore.KVStoreInstanc
 	return matched
 }
 
-// matchRocketMQInstances finds RocketMQ instances matching the given IPs or domain
+// matchRocketMQInstances finds RocketMQ instances matching the given IPs or domain, or the ID of q
 // Note: RocketMQ instances don't have direct IP/endpoint info in the basic list API
 // This is a placeholder that matches by instance name containing the domain
-func (s *FinderService) matchRocketMQInstances(instances []RocketMQInstance, ips []string, domain string) []RocketMQInstance {
+func (s *FinderService) matchRocketMQInstances(instances []RocketMQInstance, ips []string, domain string, q FindQuery) []RocketMQInstance {
 	if domain == "" {
 		return nil
 	}
@@ -489,13 +723,51 @@ func (s *FinderService) matchRocketMQInstances(instances []RocketMQInstance, ips
 	var matched []RocketMQInstance
 	for _, inst := range instances {
 		// Check if instance name contains the domain
-		if strings.Contains(strings.ToLower(inst.InstanceName), strings.ToLower(domain)) {
+		if strings.Contains(strings.ToLower(inst.InstanceName), strings.ToLower(domain)) || q.matches(inst.InstanceId, "", nil) {
 			matched = append(matched, inst)
 		}
 	}
 	return matched
 }
 
+// matchEIPs finds elastic IPs matching the given IPs (using contains matching),
+// or the ID, name or tag of q. An instance ID finds the EIP bound to it
+func (s *FinderService) matchEIPs(eips []vpc.EipAddress, ips []string, q FindQuery) []vpc.EipAddress {
+	var matched []vpc.EipAddress
+	for _, eip := range eips {
+		tags := tagsOf(eip.Tags.Tag, vpcTag)
+		if containsAny(eip.IpAddress, ips) || containsAny(eip.PrivateIpAddress, ips) ||
+			q.matches(eip.AllocationId, eip.Name, tags) || q.matches(eip.InstanceId, "", nil) {
+			matched = append(matched, eip)
+		}
+	}
+	return matched
+}
+
+// matchNATGateways finds NAT gateways whose public or private addresses match
+// the given IPs (using contains matching), or the ID, name or tag of q
+func (s *FinderService) matchNATGateways(gateways []vpc.NatGateway, ips []string, q FindQuery) []vpc.NatGateway {
+	var matched []vpc.NatGateway
+	for _, gw := range gateways {
+		tags := tagsOf(gw.Tags.Tag, vpcTag)
+		if q.matches(gw.NatGatewayId, gw.Name, tags) {
+			matched = append(matched, gw)
+			continue
+		}
+		if containsAny(gw.NatGatewayPrivateInfo.PrivateIpAddress, ips) {
+			matched = append(matched, gw)
+			continue
+		}
+		for _, ip := range gw.IpLists.IpList {
+			if containsAny(ip.IpAddress, ips) || containsAny(ip.PrivateIpAddress, ips) {
+				matched = append(matched, gw)
+				break
+			}
+		}
+	}
+	return matched
+}
+
 // HasResults checks if the find result has any matches
 func (r *FindResult) HasResults() bool {
 	return len(r.ECSInstances) > 0 ||
@@ -504,7 +776,9 @@ func (r *FindResult) HasResults() bool {
 		len(r.DNSRecords) > 0 ||
 		len(r.RDSInstances) > 0 ||
 		len(r.RedisInstances) > 0 ||
-		len(r.RocketMQInstances) > 0
+		len(r.RocketMQInstances) > 0 ||
+		len(r.EIPs) > 0 ||
+		len(r.NATGateways) > 0
 }
 
 // TotalCount returns the total number of matched resources
@@ -515,5 +789,7 @@ func (r *FindResult) TotalCount() int {
 		len(r.DNSRecords) +
 		len(r.RDSInstances) +
 		len(r.RedisInstances) +
-		len(r.RocketMQInstances)
+		len(r.RocketMQInstances) +
+		len(r.EIPs) +
+		len(r.NATGateways)
 }
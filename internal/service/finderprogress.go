@@ -0,0 +1,111 @@
+package service
+
+import "sync"
+
+// Units the sections of a resource search count their progress in
+const (
+	FindUnitPages   = "pages"
+	FindUnitDomains = "domains"
+)
+
+// FindStep is how far one section of a resource search got
+type FindStep struct {
+	Section  string // e.g. "ECS"
+	Unit     string // What Done and Total count, a FindUnit; "" for a section of a single list call
+	Done     int
+	Total    int
+	Finished bool
+	Err      error
+	Denied   bool // The profile has no permission for the section's product
+}
+
+// FindProgress records the progress of the sections of a resource search,
+// which update it concurrently. A nil FindProgress records nothing
+type FindProgress struct {
+	mu       sync.Mutex
+	steps    []FindStep
+	onChange func(finished, sections int)
+}
+
+// NewFindProgress creates an empty progress record
+func NewFindProgress() *FindProgress {
+	return &FindProgress{}
+}
+
+// Notify makes the record call onChange with the number of sections finished
+// whenever one finishes
+func (p *FindProgress) Notify(onChange func(finished, sections int)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.onChange = onChange
+}
+
+// Steps returns the sections in the order they started
+func (p *FindProgress) Steps() []FindStep {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]FindStep(nil), p.steps...)
+}
+
+// start adds a section and returns its index
+func (p *FindProgress) start(section, unit string) int {
+	if p == nil {
+		return 0
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.steps = append(p.steps, FindStep{Section: section, Unit: unit})
+	return len(p.steps) - 1
+}
+
+// advance records how much of a section is done
+func (p *FindProgress) advance(step, done, total int) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.steps[step].Done, p.steps[step].Total = done, total
+}
+
+// finish marks a section done, failed when err is set
+func (p *FindProgress) finish(step int, err error) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	s := &p.steps[step]
+	s.Finished, s.Err = true, err
+	s.Denied = IsPermissionDenied(err)
+	if err == nil && s.Total > 0 {
+		s.Done = s.Total
+	}
+	p.finished()
+}
+
+// deny adds a section left out for lack of permission, as finished
+func (p *FindProgress) deny(section string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	p.steps = append(p.steps, FindStep{Section: section, Finished: true, Denied: true})
+	p.finished()
+}
+
+// finished reports to onChange that a section finished, unlocking p
+func (p *FindProgress) finished() {
+	finished := 0
+	for _, s := range p.steps {
+		if s.Finished {
+			finished++
+		}
+	}
+	sections := len(p.steps)
+	onChange := p.onChange
+	p.mu.Unlock()
+
+	if onChange != nil {
+		onChange(finished, sections)
+	}
+}
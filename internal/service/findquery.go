@@ -0,0 +1,123 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+)
+
+// Kinds of finder queries
+const (
+	FindQueryIP     = "ip"     // e.g. 10.0.0.1, or part of one such as 10.0.0.
+	FindQueryDomain = "domain" // e.g. api.example.com, resolved to IPs
+	FindQueryID     = "id"     // e.g. i-bp1abc..., lb-bp1abc..., rm-bp1abc..., r-bp1abc...
+	FindQueryTag    = "tag"    // tag=key:value, or tag=key for any value
+	FindQueryName   = "name"   // Anything else: part of a resource name
+)
+
+// Why the finder matched the resources of a section, by FindResult.Reasons
+const (
+	FindMatchIP      = "ip"      // An address is, or contains, the query or an IP it resolves to
+	FindMatchAddress = "address" // A connection address or DNS record contains the query
+	FindMatchID      = "id"
+	FindMatchName    = "name"
+	FindMatchTag     = "tag"
+)
+
+// findQueryTagPrefix starts a tag query
+const findQueryTagPrefix = "tag="
+
+// resourceIDPattern matches the IDs of the resources the finder searches:
+// ECS instances, SLB instances, RDS instances, Redis instances, ENIs, elastic
+// IPs and NAT gateways
+var resourceIDPattern = regexp.MustCompile(`^(i|lb|rm|r|eni|eip|ngw)-[a-z0-9]{8,}$`)
+
+// FindQuery is a finder query as understood by the finder
+type FindQuery struct {
+	Text     string
+	Kind     string
+	TagKey   string
+	TagValue string // Empty for any value
+}
+
+// ParseFindQuery works out what kind of query text is
+func ParseFindQuery(text string) FindQuery {
+	text = strings.TrimSpace(text)
+	q := FindQuery{Text: text}
+	switch {
+	case IsIP(text):
+		q.Kind = FindQueryIP
+	case len(text) > len(findQueryTagPrefix) && strings.EqualFold(text[:len(findQueryTagPrefix)], findQueryTagPrefix):
+		q.Kind = FindQueryTag
+		key, value, _ := strings.Cut(text[len(findQueryTagPrefix):], ":")
+		q.TagKey, q.TagValue = strings.TrimSpace(key), strings.TrimSpace(value)
+	case resourceIDPattern.MatchString(strings.ToLower(text)):
+		q.Kind = FindQueryID
+	case IsDomain(text):
+		q.Kind = FindQueryDomain
+	default:
+		q.Kind = FindQueryName
+	}
+	return q
+}
+
+// resolvable reports whether the query may resolve to IPs. Only domains do:
+// a name looked up could resolve through search domains or /etc/hosts to an
+// unrelated IP
+func (q FindQuery) resolvable() bool {
+	return q.Kind == FindQueryDomain
+}
+
+// matches reports whether a resource with id, name and tags is found by an
+// ID, name or tag query. IP and domain queries match addresses instead
+func (q FindQuery) matches(id, name string, tags map[string]string) bool {
+	switch q.Kind {
+	case FindQueryID:
+		return id != "" && strings.EqualFold(id, q.Text)
+	case FindQueryName:
+		return name != "" && strings.Contains(strings.ToLower(name), strings.ToLower(q.Text))
+	case FindQueryTag:
+		value, ok := tags[q.TagKey]
+		return ok && (q.TagValue == "" || value == q.TagValue)
+	}
+	return false
+}
+
+// reason returns why the resources of a section were matched: by their IDs,
+// names or tags for such queries, else as the section matches IPs and
+// domains, e.g. FindMatchAddress for connection addresses
+func (q FindQuery) reason(byAddress string) string {
+	switch q.Kind {
+	case FindQueryID:
+		return FindMatchID
+	case FindQueryName:
+		return FindMatchName
+	case FindQueryTag:
+		return FindMatchTag
+	}
+	return byAddress
+}
+
+// tagsOf returns the tags of a resource by key, pair returning the key and
+// value of each
+func tagsOf[T any](tags []T, pair func(T) (string, string)) map[string]string {
+	if len(tags) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		key, value := pair(t)
+		m[key] = value
+	}
+	return m
+}
+
+// vpcTag returns the key and value of a VPC tag, which DescribeEipAddresses
+// returns as Key and Value and DescribeNatGateways as TagKey and TagValue
+func vpcTag(t vpc.Tag) (string, string) {
+	if t.Key != "" {
+		return t.Key, t.Value
+	}
+	return t.TagKey, t.TagValue
+}
@@ -0,0 +1,78 @@
+package service
+
+import "testing"
+
+func TestParseFindQuery(t *testing.T) {
+	tests := []struct {
+		text     string
+		kind     string
+		tagKey   string
+		tagValue string
+	}{
+		{text: "10.0.0.1", kind: FindQueryIP},
+		{text: "10.0.", kind: FindQueryIP},
+		{text: "api.example.com", kind: FindQueryDomain},
+		{text: " api.example.com ", kind: FindQueryDomain},
+		{text: "i-bp1abc2def3ghi4jkl", kind: FindQueryID},
+		{text: "I-BP1ABC2DEF3GHI4JKL", kind: FindQueryID},
+		{text: "lb-2zeabcdefgh", kind: FindQueryID},
+		{text: "rm-bp1abcdefgh", kind: FindQueryID},
+		{text: "r-bp1abcdefgh", kind: FindQueryID},
+		{text: "eni-bp1abcdefgh", kind: FindQueryID},
+		{text: "i-short", kind: FindQueryName},
+		{text: "tag=env:prod", kind: FindQueryTag, tagKey: "env", tagValue: "prod"},
+		{text: "TAG=env", kind: FindQueryTag, tagKey: "env"},
+		{text: "tag= team : web ", kind: FindQueryTag, tagKey: "team", tagValue: "web"},
+		{text: "tag=", kind: FindQueryName},
+		{text: "nginx", kind: FindQueryName},
+		{text: "web-01", kind: FindQueryName},
+	}
+	for _, tt := range tests {
+		q := ParseFindQuery(tt.text)
+		if q.Kind != tt.kind || q.TagKey != tt.tagKey || q.TagValue != tt.tagValue {
+			t.Errorf("ParseFindQuery(%q) = %s %q:%q, want %s %q:%q", tt.text, q.Kind, q.TagKey, q.TagValue, tt.kind, tt.tagKey, tt.tagValue)
+		}
+	}
+}
+
+func TestFindQueryResolvable(t *testing.T) {
+	tests := map[string]bool{
+		"api.example.com":      true,
+		"10.0.0.1":             false, // Already an IP
+		"nginx":                false,
+		"i-bp1abc2def3ghi4jkl": false,
+		"tag=env:prod":         false,
+	}
+	for text, want := range tests {
+		if got := ParseFindQuery(text).resolvable(); got != want {
+			t.Errorf("ParseFindQuery(%q).resolvable() = %v, want %v", text, got, want)
+		}
+	}
+}
+
+func TestFindQueryMatches(t *testing.T) {
+	tags := map[string]string{"env": "prod", "team": "web"}
+	tests := []struct {
+		query string
+		id    string
+		name  string
+		want  bool
+	}{
+		{query: "i-bp1abc2def3ghi4jkl", id: "i-bp1abc2def3ghi4jkl", want: true},
+		{query: "I-BP1ABC2DEF3GHI4JKL", id: "i-bp1abc2def3ghi4jkl", want: true},
+		{query: "i-bp1abc2def3ghi4jkl", id: "i-bp1zzz2def3ghi4jkl", want: false},
+		{query: "WEB", name: "prod-web-01", want: true},
+		{query: "db", name: "prod-web-01", want: false},
+		{query: "db", name: "", want: false},
+		{query: "tag=env:prod", want: true},
+		{query: "tag=env", want: true},
+		{query: "tag=env:dev", want: false},
+		{query: "tag=owner", want: false},
+		{query: "10.0.0.1", id: "10.0.0.1", name: "10.0.0.1", want: false}, // IPs match addresses only
+	}
+	for _, tt := range tests {
+		if got := ParseFindQuery(tt.query).matches(tt.id, tt.name, tags); got != tt.want {
+			t.Errorf("%q matches(%q, %q) = %v, want %v", tt.query, tt.id, tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
+
+	"aliyun-tui-viewer/internal/cache"
+)
+
+// kafkaPageSize is the largest page the AliKafka list APIs accept
+const kafkaPageSize = 100
+
+// kafkaProgressConcurrency bounds the per-group consumer progress lookups
+const kafkaProgressConcurrency = 8
+
+// KafkaService handles ApsaraMQ for Kafka (AliKafka) operations
+type KafkaService struct {
+	client *alikafka.Client
+
+	cached
+}
+
+// KafkaConsumerGroup contains a consumer group with the accumulated lag of
+// its subscriptions
+type KafkaConsumerGroup struct {
+	alikafka.ConsumerVO
+	TotalLag      int64    // Messages not consumed yet, over all subscribed topics
+	LastTimestamp int64    // Unix milliseconds of the last consumed message
+	Topics        []string // Subscribed topics
+	LagErr        error    `json:"-"` // Set when the consumer progress could not be read
+}
+
+// NewKafkaService creates a new KafkaService
+func NewKafkaService(client *alikafka.Client) *KafkaService {
+	return &KafkaService{client: client}
+}
+
+// kafkaError turns a response the API marked as failed into an error
+func kafkaError(success bool, code int, message string) error {
+	if success {
+		return nil
+	}
+	return fmt.Errorf("code %d: %s", code, message)
+}
+
+// FetchInstances retrieves all Kafka instances of the region
+func (s *KafkaService) FetchInstances(ctx context.Context) ([]alikafka.InstanceVO, error) {
+	return cache.Fetch(ctx, s.cache, CacheKafkaInstances, s.fetchInstances)
+}
+
+// fetchInstances calls the API for FetchInstances
+func (s *KafkaService) fetchInstances(ctx context.Context) ([]alikafka.InstanceVO, error) {
+	request := alikafka.CreateGetInstanceListRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+
+	response, err := s.client.GetInstanceList(request)
+	if err == nil {
+		err = kafkaError(response.Success, response.Code, response.Message)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing Kafka instances: %w", err)
+	}
+	return response.InstanceList.InstanceVO, nil
+}
+
+// FetchTopics retrieves all topics of a Kafka instance using pagination
+func (s *KafkaService) FetchTopics(ctx context.Context, instanceId string) ([]alikafka.TopicVO, error) {
+	var allTopics []alikafka.TopicVO
+	page := 1
+
+	for {
+		request := alikafka.CreateGetTopicListRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.InstanceId = instanceId
+		request.CurrentPage = fmt.Sprintf("%d", page)
+		request.PageSize = fmt.Sprintf("%d", kafkaPageSize)
+
+		response, err := s.client.GetTopicList(request)
+		if err == nil {
+			err = kafkaError(response.Success, response.Code, response.Message)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listing topics for Kafka instance %s: %w", instanceId, err)
+		}
+
+		topics := response.TopicList.TopicVO
+		allTopics = append(allTopics, topics...)
+		if len(topics) < kafkaPageSize || len(allTopics) >= response.Total {
+			break
+		}
+		page++
+	}
+
+	return allTopics, nil
+}
+
+// FetchConsumerGroups retrieves all consumer groups of a Kafka instance with
+// their lag. Groups whose progress cannot be read are still returned, with
+// LagErr set
+func (s *KafkaService) FetchConsumerGroups(ctx context.Context, instanceId string) ([]KafkaConsumerGroup, error) {
+	consumers, err := s.fetchConsumers(ctx, instanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, kafkaProgressConcurrency)
+	groups := make([]KafkaConsumerGroup, len(consumers))
+
+	for i, consumer := range consumers {
+		groups[i] = KafkaConsumerGroup{ConsumerVO: consumer}
+
+		wg.Add(1)
+		go func(group *KafkaConsumerGroup) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			// Each goroutine only writes its own element
+			progress, err := s.FetchConsumerProgress(ctx, instanceId, group.ConsumerId)
+			if err != nil {
+				group.LagErr = err
+				return
+			}
+			group.TotalLag = progress.TotalDiff
+			group.LastTimestamp = progress.LastTimestamp
+			for _, topic := range progress.TopicList.TopicListItem {
+				group.Topics = append(group.Topics, topic.Topic)
+			}
+		}(&groups[i])
+	}
+
+	wg.Wait()
+	return groups, nil
+}
+
+// fetchConsumers lists the consumer groups of a Kafka instance using pagination
+func (s *KafkaService) fetchConsumers(ctx context.Context, instanceId string) ([]alikafka.ConsumerVO, error) {
+	var allConsumers []alikafka.ConsumerVO
+	page := 1
+
+	for {
+		request := alikafka.CreateGetConsumerListRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.InstanceId = instanceId
+		request.CurrentPage = requests.NewInteger(page)
+		request.PageSize = requests.NewInteger(kafkaPageSize)
+
+		response, err := s.client.GetConsumerList(request)
+		if err == nil {
+			err = kafkaError(response.Success, response.Code, response.Message)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listing consumer groups for Kafka instance %s: %w", instanceId, err)
+		}
+
+		consumers := response.ConsumerList.ConsumerVO
+		allConsumers = append(allConsumers, consumers...)
+		if len(consumers) < kafkaPageSize || int64(len(allConsumers)) >= response.Total {
+			break
+		}
+		page++
+	}
+
+	return allConsumers, nil
+}
+
+// FetchConsumerProgress retrieves the consumption progress of a consumer
+// group, with the lag of every subscribed topic
+func (s *KafkaService) FetchConsumerProgress(ctx context.Context, instanceId, consumerId string) (*alikafka.ConsumerProgress, error) {
+	request := alikafka.CreateGetConsumerProgressRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.InstanceId = instanceId
+	request.ConsumerId = consumerId
+
+	response, err := s.client.GetConsumerProgress(request)
+	if err == nil {
+		err = kafkaError(response.Success, response.Code, response.Message)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting progress of consumer group %s: %w", consumerId, err)
+	}
+	return &response.ConsumerProgress, nil
+}
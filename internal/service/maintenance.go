@@ -0,0 +1,62 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// maintenanceTimePattern matches a time of day as the maintenance APIs take
+// it, e.g. "02:00Z"
+var maintenanceTimePattern = regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\dZ$`)
+
+// MaintenanceWindow is the daily maintenance window of an RDS, Redis or
+// MongoDB instance. Start and End are UTC times formatted as "02:00Z"; a
+// window ending at or before its start ends the next day
+type MaintenanceWindow struct {
+	Start string
+	End   string
+}
+
+// String formats the window as RDS reports it, e.g. "02:00Z-03:00Z"
+func (w MaintenanceWindow) String() string {
+	return w.Start + "-" + w.End
+}
+
+// Local formats the window in the local time zone, e.g. "10:00-11:00 CST"
+func (w MaintenanceWindow) Local() string {
+	start, err1 := time.Parse("15:04Z", w.Start)
+	end, err2 := time.Parse("15:04Z", w.End)
+	if err1 != nil || err2 != nil {
+		return w.String()
+	}
+	// Take today's offset, the windows recur daily
+	now := time.Now().UTC()
+	at := func(t time.Time) time.Time {
+		return time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), 0, 0, time.UTC).Local()
+	}
+	return fmt.Sprintf("%s-%s %s", at(start).Format("15:04"), at(end).Format("15:04"), at(start).Format("MST"))
+}
+
+// ParseMaintenanceWindow parses a window formatted as "02:00Z-03:00Z"
+func ParseMaintenanceWindow(s string) (MaintenanceWindow, error) {
+	start, end, ok := strings.Cut(strings.TrimSpace(s), "-")
+	if !ok || !maintenanceTimePattern.MatchString(start) || !maintenanceTimePattern.MatchString(end) {
+		return MaintenanceWindow{}, fmt.Errorf("invalid maintenance window %q, expected e.g. 02:00Z-03:00Z", s)
+	}
+	return MaintenanceWindow{Start: start, End: end}, nil
+}
+
+// MaintenanceWindows returns the one-hour windows starting at every full
+// hour, the choices the consoles offer
+func MaintenanceWindows() []MaintenanceWindow {
+	windows := make([]MaintenanceWindow, 24)
+	for hour := range windows {
+		windows[hour] = MaintenanceWindow{
+			Start: fmt.Sprintf("%02d:00Z", hour),
+			End:   fmt.Sprintf("%02d:00Z", (hour+1)%24),
+		}
+	}
+	return windows
+}
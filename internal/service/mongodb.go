@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/dds"
+
+	"aliyun-tui-viewer/internal/cache"
+)
+
+const (
+	// mongoDBPageSize is the largest page DescribeDBInstances accepts
+	mongoDBPageSize = 100
+	// MongoDBTypeReplicaSet and MongoDBTypeSharding are the instance
+	// architectures, as DBInstanceType reports them
+	MongoDBTypeReplicaSet = "replicate"
+	MongoDBTypeSharding   = "sharding"
+)
+
+// MongoDBConnection is a connection string of a MongoDB instance on one
+// network, with the password masked
+type MongoDBConnection struct {
+	Network string // VPC, Classic or Public
+	URI     string
+}
+
+// MongoDBTopology is the layout of a MongoDB instance: the replica set
+// members of a replica set instance, or the mongos, shard and config server
+// nodes of a sharded cluster, with the connection strings to use
+type MongoDBTopology struct {
+	Instance    dds.DBInstance   // From DescribeDBInstanceAttribute
+	Members     []dds.ReplicaSet // Replica set members, empty for sharded clusters
+	Connections []MongoDBConnection
+}
+
+// MongoDBService handles ApsaraDB for MongoDB (dds) operations
+type MongoDBService struct {
+	client *dds.Client
+
+	cached
+}
+
+// NewMongoDBService creates a new MongoDBService
+func NewMongoDBService(client *dds.Client) *MongoDBService {
+	return &MongoDBService{client: client}
+}
+
+// FetchInstances fetches all replica set and sharded MongoDB instances.
+// DescribeDBInstances lists one architecture per call, replica sets by
+// default
+func (s *MongoDBService) FetchInstances(ctx context.Context) ([]dds.DBInstance, error) {
+	return cache.Fetch(ctx, s.cache, CacheMongoDBInstances, s.fetchInstances)
+}
+
+// fetchInstances calls the API for FetchInstances
+func (s *MongoDBService) fetchInstances(ctx context.Context) ([]dds.DBInstance, error) {
+	var all []dds.DBInstance
+	for _, instanceType := range []string{MongoDBTypeReplicaSet, MongoDBTypeSharding} {
+		instances, err := s.fetchInstancesOfType(ctx, instanceType)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, instances...)
+	}
+	return all, nil
+}
+
+// fetchInstancesOfType fetches the instances of one architecture using
+// pagination
+func (s *MongoDBService) fetchInstancesOfType(ctx context.Context, instanceType string) ([]dds.DBInstance, error) {
+	var all []dds.DBInstance
+	pageNumber := 1
+
+	for {
+		request := dds.CreateDescribeDBInstancesRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.DBInstanceType = instanceType
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(mongoDBPageSize)
+
+		response, err := s.client.DescribeDBInstances(request)
+		if err != nil {
+			return nil, fmt.Errorf("describing %s MongoDB instances (page %d): %w", instanceType, pageNumber, err)
+		}
+
+		all = append(all, response.DBInstances.DBInstance...)
+		if len(response.DBInstances.DBInstance) < mongoDBPageSize || len(all) >= response.TotalCount {
+			break
+		}
+		pageNumber++
+	}
+	return all, nil
+}
+
+// FetchTopology fetches the full attributes of an instance and, for replica
+// sets, the role of every member, and builds the connection strings
+func (s *MongoDBService) FetchTopology(ctx context.Context, instanceID string) (*MongoDBTopology, error) {
+	request := dds.CreateDescribeDBInstanceAttributeRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.DBInstanceId = instanceID
+
+	response, err := s.client.DescribeDBInstanceAttribute(request)
+	if err != nil {
+		return nil, fmt.Errorf("describing MongoDB instance %s: %w", instanceID, err)
+	}
+	if len(response.DBInstances.DBInstance) == 0 {
+		return nil, fmt.Errorf("MongoDB instance %s not found", instanceID)
+	}
+
+	topology := &MongoDBTopology{Instance: response.DBInstances.DBInstance[0]}
+	if topology.Instance.DBInstanceType == MongoDBTypeSharding {
+		topology.Connections = mongosConnections(topology.Instance)
+		return topology, nil
+	}
+
+	roleRequest := dds.CreateDescribeReplicaSetRoleRequest()
+	roleRequest.Scheme = "https"
+	bind(ctx, roleRequest)
+	roleRequest.DBInstanceId = instanceID
+
+	roles, err := s.client.DescribeReplicaSetRole(roleRequest)
+	if err != nil {
+		return nil, fmt.Errorf("describing replica set roles of MongoDB instance %s: %w", instanceID, err)
+	}
+	topology.Members = roles.ReplicaSets.ReplicaSet
+	topology.Connections = replicaSetConnections(topology.Instance.ReplicaSetName, topology.Members)
+	return topology, nil
+}
+
+// FetchAccounts fetches all accounts for a specific MongoDB instance
+func (s *MongoDBService) FetchAccounts(ctx context.Context, instanceID string) ([]dds.Account, error) {
+	request := dds.CreateDescribeAccountsRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.DBInstanceId = instanceID
+
+	response, err := s.client.DescribeAccounts(request)
+	if err != nil {
+		return nil, fmt.Errorf("fetching MongoDB accounts for instance %s: %w", instanceID, err)
+	}
+	return response.Accounts.Account, nil
+}
+
+// ModifyMaintenanceWindow changes the daily maintenance window of an instance
+func (s *MongoDBService) ModifyMaintenanceWindow(instanceID string, window MaintenanceWindow) error {
+	request := dds.CreateModifyDBInstanceMaintainTimeRequest()
+	request.Scheme = "https"
+	request.DBInstanceId = instanceID
+	request.MaintainStartTime = window.Start
+	request.MaintainEndTime = window.End
+
+	if _, err := s.client.ModifyDBInstanceMaintainTime(request); err != nil {
+		return fmt.Errorf("modifying maintenance window of MongoDB instance %s: %w", instanceID, err)
+	}
+	return nil
+}
+
+// replicaSetConnections builds one connection string per network from the
+// primary and secondary members, which drivers need together to follow a
+// failover. Hidden and read-only members are left out, as the console does
+func replicaSetConnections(replicaSetName string, members []dds.ReplicaSet) []MongoDBConnection {
+	var networks []string
+	hosts := make(map[string][]string)
+	for _, member := range members {
+		if member.ReplicaSetRole != "Primary" && member.ReplicaSetRole != "Secondary" {
+			continue
+		}
+		network := mongoDBNetwork(member.NetworkType, member.ConnectionType)
+		if _, ok := hosts[network]; !ok {
+			networks = append(networks, network)
+		}
+		hosts[network] = append(hosts[network], member.ConnectionDomain+":"+member.ConnectionPort)
+	}
+
+	options := ""
+	if replicaSetName != "" {
+		options = "?replicaSet=" + replicaSetName
+	}
+	connections := make([]MongoDBConnection, len(networks))
+	for i, network := range networks {
+		connections[i] = MongoDBConnection{Network: network, URI: mongoDBURI(hosts[network], options)}
+	}
+	return connections
+}
+
+// mongosConnections builds the connection string of a sharded cluster, which
+// lists its mongos nodes
+func mongosConnections(instance dds.DBInstance) []MongoDBConnection {
+	var hosts []string
+	for _, mongos := range instance.MongosList.MongosAttribute {
+		if mongos.ConnectSting != "" {
+			hosts = append(hosts, fmt.Sprintf("%s:%d", mongos.ConnectSting, mongos.Port))
+		}
+	}
+	if len(hosts) == 0 {
+		return nil
+	}
+	return []MongoDBConnection{{Network: mongoDBNetwork(instance.NetworkType, ""), URI: mongoDBURI(hosts, "")}}
+}
+
+// mongoDBNetwork names the network of an endpoint, Public for internet
+// endpoints
+func mongoDBNetwork(networkType, connectionType string) string {
+	if strings.EqualFold(connectionType, "Public") || strings.EqualFold(networkType, "Public") {
+		return "Public"
+	}
+	if networkType == "" {
+		return "VPC"
+	}
+	return networkType
+}
+
+// mongoDBURI formats a connection string for the root account, with the
+// password masked and admin as the authentication database
+func mongoDBURI(hosts []string, options string) string {
+	return "mongodb://root:****@" + strings.Join(hosts, ",") + "/admin" + options
+}
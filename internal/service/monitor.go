@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cms"
+)
+
+// CloudMonitor namespaces of instance metrics
+const (
+	ecsMetricNamespace = "acs_ecs_dashboard"
+	rdsMetricNamespace = "acs_rds_dashboard"
+)
+
+// MetricPeriod is the aggregation period of fetched data points
+const MetricPeriod = time.Minute
+
+// Units of metric values
+const (
+	UnitPercent     = "%"
+	UnitBitsPerSec  = "bit/s"
+	UnitBytesPerSec = "Byte/s"
+)
+
+// MetricPoint is one aggregated data point
+type MetricPoint struct {
+	Time  time.Time
+	Value float64 // Average over the period
+}
+
+// MetricSeries is the data of one metric over a time window
+type MetricSeries struct {
+	Metric string // CloudMonitor metric name, e.g. CPUUtilization
+	Label  string
+	Unit   string
+	Points []MetricPoint
+	Err    error `json:"-"` // Set when this metric could not be fetched
+}
+
+// metricDef describes a metric shown in a metrics view
+type metricDef struct {
+	name  string
+	label string
+	unit  string
+}
+
+// ecsMetrics are the ECS metrics fetched, in display order. Memory metrics
+// are only reported by instances running the CloudMonitor agent
+var ecsMetrics = []metricDef{
+	{"CPUUtilization", "CPU Utilization", UnitPercent},
+	{"memory_usedutilization", "Memory Usage", UnitPercent},
+	{"InternetInRate", "Internet In", UnitBitsPerSec},
+	{"InternetOutRate", "Internet Out", UnitBitsPerSec},
+	{"IntranetInRate", "Intranet In", UnitBitsPerSec},
+	{"IntranetOutRate", "Intranet Out", UnitBitsPerSec},
+	{"DiskReadBPS", "Disk Read", UnitBytesPerSec},
+	{"DiskWriteBPS", "Disk Write", UnitBytesPerSec},
+}
+
+// rdsMetrics are the RDS metrics fetched, in display order. They are reported
+// as a share of the instance class limits, so they apply to every engine
+var rdsMetrics = []metricDef{
+	{"CpuUsage", "CPU Usage", UnitPercent},
+	{"MemoryUsage", "Memory Usage", UnitPercent},
+	{"ConnectionUsage", "Connection Usage", UnitPercent},
+	{"IOPSUsage", "IOPS Usage", UnitPercent},
+	{"DiskUsage", "Disk Usage", UnitPercent},
+}
+
+// MonitorService handles CloudMonitor (CMS) operations
+type MonitorService struct {
+	client *cms.Client
+}
+
+// NewMonitorService creates a new monitor service
+func NewMonitorService(client *cms.Client) *MonitorService {
+	return &MonitorService{client: client}
+}
+
+// FetchECSMetrics retrieves the ECS metrics of an instance over the last window
+func (s *MonitorService) FetchECSMetrics(ctx context.Context, instanceID string, window time.Duration) ([]MetricSeries, error) {
+	return s.fetchInstanceMetrics(ctx, ecsMetricNamespace, ecsMetrics, instanceID, window)
+}
+
+// FetchRDSMetrics retrieves the RDS metrics of an instance over the last window
+func (s *MonitorService) FetchRDSMetrics(ctx context.Context, instanceID string, window time.Duration) ([]MetricSeries, error) {
+	return s.fetchInstanceMetrics(ctx, rdsMetricNamespace, rdsMetrics, instanceID, window)
+}
+
+// fetchInstanceMetrics retrieves metrics of an instance over the last window.
+// Metrics are fetched concurrently; a metric that fails only sets its
+// series' Err, and an error is returned only when every metric failed
+func (s *MonitorService) fetchInstanceMetrics(ctx context.Context, namespace string, metrics []metricDef, instanceID string, window time.Duration) ([]MetricSeries, error) {
+	end := time.Now()
+	start := end.Add(-window)
+	dimensions, err := json.Marshal([]map[string]string{{"instanceId": instanceID}})
+	if err != nil {
+		return nil, fmt.Errorf("encoding metric dimensions: %w", err)
+	}
+
+	var wg sync.WaitGroup
+	series := make([]MetricSeries, len(metrics))
+	for i, metric := range metrics {
+		series[i] = MetricSeries{Metric: metric.name, Label: metric.label, Unit: metric.unit}
+
+		wg.Add(1)
+		go func(ms *MetricSeries) {
+			defer wg.Done()
+			// Each goroutine only writes its own element
+			ms.Points, ms.Err = s.fetchMetric(ctx, namespace, ms.Metric, string(dimensions), MetricPeriod, start, end)
+		}(&series[i])
+	}
+	wg.Wait()
+
+	for _, ms := range series {
+		if ms.Err == nil {
+			return series, nil
+		}
+	}
+	return nil, fmt.Errorf("fetching metrics for instance %s: %w", instanceID, series[0].Err)
+}
+
+// fetchMetric retrieves the data points of one metric aggregated over period,
+// following NextToken pages
+func (s *MonitorService) fetchMetric(ctx context.Context, namespace, metricName, dimensions string, period time.Duration, start, end time.Time) ([]MetricPoint, error) {
+	var points []MetricPoint
+	nextToken := ""
+
+	for {
+		request := cms.CreateDescribeMetricListRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.Namespace = namespace
+		request.MetricName = metricName
+		request.Dimensions = dimensions
+		request.Period = strconv.Itoa(int(period.Seconds()))
+		request.Length = "1440" // Points per page
+		request.StartTime = strconv.FormatInt(start.UnixMilli(), 10)
+		request.EndTime = strconv.FormatInt(end.UnixMilli(), 10)
+		request.NextToken = nextToken
+
+		response, err := s.client.DescribeMetricList(request)
+		if err != nil {
+			return nil, fmt.Errorf("describing metric %s: %w", metricName, err)
+		}
+		if !response.Success {
+			return nil, fmt.Errorf("describing metric %s: %s (%s)", metricName, response.Message, response.Code)
+		}
+
+		page, err := parseDatapoints(response.Datapoints)
+		if err != nil {
+			return nil, fmt.Errorf("parsing metric %s: %w", metricName, err)
+		}
+		points = append(points, page...)
+
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+
+	sort.Slice(points, func(i, j int) bool { return points[i].Time.Before(points[j].Time) })
+	return points, nil
+}
+
+// parseDatapoints decodes the JSON-encoded Datapoints field of DescribeMetricList
+func parseDatapoints(datapoints string) ([]MetricPoint, error) {
+	if datapoints == "" {
+		return nil, nil
+	}
+
+	var raw []struct {
+		Timestamp int64   `json:"timestamp"`
+		Average   float64 `json:"Average"`
+	}
+	if err := json.Unmarshal([]byte(datapoints), &raw); err != nil {
+		return nil, err
+	}
+
+	points := make([]MetricPoint, len(raw))
+	for i, p := range raw {
+		points[i] = MetricPoint{Time: time.UnixMilli(p.Timestamp), Value: p.Average}
+	}
+	return points, nil
+}
+
+// Values returns the point values in time order
+func (s MetricSeries) Values() []float64 {
+	values := make([]float64, len(s.Points))
+	for i, p := range s.Points {
+		values[i] = p.Value
+	}
+	return values
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+
+	"aliyun-tui-viewer/internal/cache"
+)
+
+// natPageSize is the page size used with the NAT Gateway list calls
+const natPageSize = 50
+
+// NATService handles NAT Gateway operations
+type NATService struct {
+	client *vpc.Client
+
+	cached
+}
+
+// NewNATService creates a new NAT service
+func NewNATService(client *vpc.Client) *NATService {
+	return &NATService{client: client}
+}
+
+// FetchNATGateways retrieves all NAT gateways of the region using pagination
+func (s *NATService) FetchNATGateways(ctx context.Context) ([]vpc.NatGateway, error) {
+	return cache.Fetch(ctx, s.cache, CacheNATGateways, s.fetchNATGateways)
+}
+
+// fetchNATGateways calls the API for FetchNATGateways
+func (s *NATService) fetchNATGateways(ctx context.Context) ([]vpc.NatGateway, error) {
+	var allGateways []vpc.NatGateway
+	pageNumber := 1
+
+	for {
+		request := vpc.CreateDescribeNatGatewaysRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(natPageSize)
+
+		response, err := s.client.DescribeNatGateways(request)
+		if err != nil {
+			return nil, fmt.Errorf("describing NAT gateways (page %d): %w", pageNumber, err)
+		}
+
+		allGateways = append(allGateways, response.NatGateways.NatGateway...)
+		if len(response.NatGateways.NatGateway) < natPageSize || len(allGateways) >= response.TotalCount {
+			break
+		}
+		pageNumber++
+	}
+
+	return allGateways, nil
+}
+
+// FetchSNATEntries retrieves the entries of a SNAT table using pagination
+func (s *NATService) FetchSNATEntries(ctx context.Context, snatTableID string) ([]vpc.SnatTableEntry, error) {
+	var allEntries []vpc.SnatTableEntry
+	pageNumber := 1
+
+	for {
+		request := vpc.CreateDescribeSnatTableEntriesRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.SnatTableId = snatTableID
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(natPageSize)
+
+		response, err := s.client.DescribeSnatTableEntries(request)
+		if err != nil {
+			return nil, fmt.Errorf("describing SNAT entries of %s (page %d): %w", snatTableID, pageNumber, err)
+		}
+
+		allEntries = append(allEntries, response.SnatTableEntries.SnatTableEntry...)
+		if len(response.SnatTableEntries.SnatTableEntry) < natPageSize || len(allEntries) >= response.TotalCount {
+			break
+		}
+		pageNumber++
+	}
+
+	return allEntries, nil
+}
+
+// FetchDNATEntries retrieves the entries of a DNAT (forward) table using
+// pagination
+func (s *NATService) FetchDNATEntries(ctx context.Context, forwardTableID string) ([]vpc.ForwardTableEntry, error) {
+	var allEntries []vpc.ForwardTableEntry
+	pageNumber := 1
+
+	for {
+		request := vpc.CreateDescribeForwardTableEntriesRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.ForwardTableId = forwardTableID
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(natPageSize)
+
+		response, err := s.client.DescribeForwardTableEntries(request)
+		if err != nil {
+			return nil, fmt.Errorf("describing DNAT entries of %s (page %d): %w", forwardTableID, pageNumber, err)
+		}
+
+		allEntries = append(allEntries, response.ForwardTableEntries.ForwardTableEntry...)
+		if len(response.ForwardTableEntries.ForwardTableEntry) < natPageSize || len(allEntries) >= response.TotalCount {
+			break
+		}
+		pageNumber++
+	}
+
+	return allEntries, nil
+}
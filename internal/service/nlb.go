@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/nlb"
+
+	"aliyun-tui-viewer/internal/cache"
+)
+
+// NLBService handles Network Load Balancer operations
+type NLBService struct {
+	client *nlb.Client
+
+	cached
+}
+
+// NewNLBService creates a new NLB service
+func NewNLBService(client *nlb.Client) *NLBService {
+	return &NLBService{client: client}
+}
+
+// FetchLoadBalancers retrieves all NLB instances of the region using pagination
+func (s *NLBService) FetchLoadBalancers(ctx context.Context) ([]nlb.LoadbalancerInfo, error) {
+	return cache.Fetch(ctx, s.cache, CacheNLBLoadBalancers, s.fetchLoadBalancers)
+}
+
+// fetchLoadBalancers calls the API for FetchLoadBalancers
+func (s *NLBService) fetchLoadBalancers(ctx context.Context) ([]nlb.LoadbalancerInfo, error) {
+	var all []nlb.LoadbalancerInfo
+	nextToken := ""
+
+	for {
+		request := nlb.CreateListLoadBalancersRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.MaxResults = requests.NewInteger(lbPageSize)
+		request.NextToken = nextToken
+
+		response, err := s.client.ListLoadBalancers(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing NLB instances: %w", err)
+		}
+
+		all = append(all, response.LoadBalancers...)
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+
+	return all, nil
+}
+
+// FetchListeners retrieves the listeners of an NLB instance
+func (s *NLBService) FetchListeners(ctx context.Context, loadBalancerID string) ([]nlb.ListenerInfo, error) {
+	var all []nlb.ListenerInfo
+	nextToken := ""
+
+	for {
+		request := nlb.CreateListListenersRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.LoadBalancerIds = &[]string{loadBalancerID}
+		request.MaxResults = requests.NewInteger(lbPageSize)
+		request.NextToken = nextToken
+
+		response, err := s.client.ListListeners(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing listeners of NLB %s: %w", loadBalancerID, err)
+		}
+
+		all = append(all, response.Listeners...)
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+
+	return all, nil
+}
+
+// FetchServerGroups retrieves the server groups used by an NLB instance,
+// listing every group of the region and keeping those related to loadBalancerID
+func (s *NLBService) FetchServerGroups(ctx context.Context, loadBalancerID string) ([]nlb.ServerGroup, error) {
+	var matched []nlb.ServerGroup
+	nextToken := ""
+
+	for {
+		request := nlb.CreateListServerGroupsRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.MaxResults = requests.NewInteger(lbPageSize)
+		request.NextToken = nextToken
+
+		response, err := s.client.ListServerGroups(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing NLB server groups: %w", err)
+		}
+
+		for _, group := range response.ServerGroups {
+			if slices.Contains(group.RelatedLoadBalancerIds, loadBalancerID) {
+				matched = append(matched, group)
+			}
+		}
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+
+	return matched, nil
+}
+
+// FetchServerGroupServers retrieves the backend servers of an NLB server group
+func (s *NLBService) FetchServerGroupServers(ctx context.Context, serverGroupID string) ([]LBServer, error) {
+	var all []LBServer
+	nextToken := ""
+
+	for {
+		request := nlb.CreateListServerGroupServersRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.ServerGroupId = serverGroupID
+		request.MaxResults = requests.NewInteger(lbPageSize)
+		request.NextToken = nextToken
+
+		response, err := s.client.ListServerGroupServers(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing servers of NLB server group %s: %w", serverGroupID, err)
+		}
+
+		for _, server := range response.Servers {
+			all = append(all, LBServer{
+				ServerID:      server.ServerId,
+				ServerType:    server.ServerType,
+				ServerIP:      server.ServerIp,
+				Port:          server.Port,
+				Weight:        server.Weight,
+				Status:        server.Status,
+				Description:   server.Description,
+				ZoneID:        server.ZoneId,
+				ServerGroupID: server.ServerGroupId,
+			})
+		}
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+
+	return all, nil
+}
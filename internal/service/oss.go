@@ -1,10 +1,19 @@
 package service
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+
+	"aliyun-tui-viewer/internal/cache"
 )
 
 // OSSService handles OSS operations
@@ -13,6 +22,9 @@ type OSSService struct {
 	accessKeyID     string
 	accessKeySecret string
 	defaultEndpoint string
+	clientOptions   []oss.ClientOption // Applied to clients created for other endpoints
+
+	cached
 }
 
 // NewOSSService creates a new OSS service
@@ -23,21 +35,28 @@ func NewOSSService(client *oss.Client) *OSSService {
 }
 
 // NewOSSServiceWithCredentials creates a new OSS service with credentials for cross-region access
-func NewOSSServiceWithCredentials(client *oss.Client, accessKeyID, accessKeySecret, defaultEndpoint string) *OSSService {
+func NewOSSServiceWithCredentials(client *oss.Client, accessKeyID, accessKeySecret, defaultEndpoint string, options ...oss.ClientOption) *OSSService {
 	return &OSSService{
 		client:          client,
 		accessKeyID:     accessKeyID,
 		accessKeySecret: accessKeySecret,
 		defaultEndpoint: defaultEndpoint,
+		clientOptions:   options,
 	}
 }
 
 // FetchBuckets retrieves all OSS buckets using pagination
-func (s *OSSService) FetchBuckets() ([]oss.BucketProperties, error) {
+func (s *OSSService) FetchBuckets(ctx context.Context) ([]oss.BucketProperties, error) {
+	return cache.Fetch(ctx, s.cache, CacheOSSBuckets, s.fetchBuckets)
+}
+
+// fetchBuckets calls the API for FetchBuckets
+func (s *OSSService) fetchBuckets(ctx context.Context) ([]oss.BucketProperties, error) {
 	var allBuckets []oss.BucketProperties
 	marker := ""
 	for {
 		options := []oss.Option{
+			oss.WithContext(ctx),
 			oss.MaxKeys(100),
 			oss.Marker(marker),
 		}
@@ -70,11 +89,11 @@ func (s *OSSService) getClientForBucket(bucketName string) (*oss.Client, error)
 		if strings.Contains(err.Error(), "AccessDenied") && strings.Contains(err.Error(), "endpoint") {
 			// Extract region from bucket location if available
 			// For buckets in different regions, we need to create a new client
-			if s.accessKeyID != "" && s.accessKeySecret != "" {
+			if (s.accessKeyID != "" && s.accessKeySecret != "") || len(s.clientOptions) > 0 {
 				// Try common endpoints based on bucket name patterns or error messages
 				endpoints := s.guessEndpointsFromError(err.Error(), bucketName)
 				for _, endpoint := range endpoints {
-					newClient, clientErr := oss.New(endpoint, s.accessKeyID, s.accessKeySecret)
+					newClient, clientErr := oss.New(endpoint, s.accessKeyID, s.accessKeySecret, s.clientOptions...)
 					if clientErr != nil {
 						continue
 					}
@@ -134,6 +153,8 @@ func (s *OSSService) guessEndpointsFromError(errorMsg, bucketName string) []stri
 // ObjectListResult holds the result of a paginated object list query
 type ObjectListResult struct {
 	Objects     []oss.ObjectProperties
+	Prefixes    []string // Common prefixes (folders) when listed with a delimiter
+	Prefix      string
 	NextMarker  string
 	PrevMarker  string
 	IsTruncated bool
@@ -142,7 +163,9 @@ type ObjectListResult struct {
 }
 
 // FetchObjects retrieves objects from a specific bucket with pagination
-func (s *OSSService) FetchObjects(bucketName string, marker string, pageSize int) (*ObjectListResult, error) {
+// Objects are limited to those under prefix; with a non-empty delimiter, keys
+// sharing the next path segment are rolled up into Prefixes
+func (s *OSSService) FetchObjects(ctx context.Context, bucketName, prefix, delimiter, marker string, pageSize int) (*ObjectListResult, error) {
 	// Get the appropriate client for this bucket
 	client, err := s.getClientForBucket(bucketName)
 	if err != nil {
@@ -155,11 +178,18 @@ func (s *OSSService) FetchObjects(bucketName string, marker string, pageSize int
 	}
 
 	options := []oss.Option{
+		oss.WithContext(ctx),
 		oss.MaxKeys(pageSize),
 	}
 	if marker != "" {
 		options = append(options, oss.Marker(marker))
 	}
+	if prefix != "" {
+		options = append(options, oss.Prefix(prefix))
+	}
+	if delimiter != "" {
+		options = append(options, oss.Delimiter(delimiter))
+	}
 
 	result, err := bucket.ListObjects(options...)
 	if err != nil {
@@ -168,9 +198,289 @@ func (s *OSSService) FetchObjects(bucketName string, marker string, pageSize int
 
 	return &ObjectListResult{
 		Objects:     result.Objects,
+		Prefixes:    result.CommonPrefixes,
+		Prefix:      prefix,
 		NextMarker:  result.NextMarker,
 		PrevMarker:  marker, // Store the current marker as previous for backward navigation
 		IsTruncated: result.IsTruncated,
 		HasPrevious: marker != "", // If we have a marker, we can go back
 	}, nil
 }
+
+// progressFunc adapts a callback to oss.ProgressListener
+type progressFunc func(consumed, total int64)
+
+func (f progressFunc) ProgressChanged(event *oss.ProgressEvent) {
+	f(event.ConsumedBytes, event.TotalBytes)
+}
+
+// ResolveDownloadPath expands ~ in localPath and, if it names a directory,
+// appends the base name of the object key
+func ResolveDownloadPath(objectKey, localPath string) (string, error) {
+	if localPath == "~" || strings.HasPrefix(localPath, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("resolving home directory: %w", err)
+		}
+		localPath = filepath.Join(home, strings.TrimPrefix(localPath, "~"))
+	}
+	if strings.HasSuffix(localPath, string(os.PathSeparator)) {
+		return filepath.Join(localPath, path.Base(objectKey)), nil
+	}
+	if info, err := os.Stat(localPath); err == nil && info.IsDir() {
+		return filepath.Join(localPath, path.Base(objectKey)), nil
+	}
+	return localPath, nil
+}
+
+// DownloadObject downloads an object to localPath and returns the file path written.
+// A non-empty versionID downloads that version of a versioned object instead of
+// the current one. onProgress, if not nil, is called with the bytes downloaded
+// so far and the object size
+func (s *OSSService) DownloadObject(ctx context.Context, bucketName, objectKey, versionID, localPath string, onProgress func(consumed, total int64)) (string, error) {
+	target, err := ResolveDownloadPath(objectKey, localPath)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return "", fmt.Errorf("creating directory for %s: %w", target, err)
+	}
+
+	client, err := s.getClientForBucket(bucketName)
+	if err != nil {
+		return "", err
+	}
+
+	bucket, err := client.Bucket(bucketName)
+	if err != nil {
+		return "", fmt.Errorf("getting bucket %s: %w", bucketName, err)
+	}
+
+	options := []oss.Option{oss.WithContext(ctx)}
+	if versionID != "" {
+		options = append(options, oss.VersionId(versionID))
+	}
+	if onProgress != nil {
+		options = append(options, oss.Progress(progressFunc(onProgress)))
+	}
+
+	if err := bucket.GetObjectToFile(objectKey, target, options...); err != nil {
+		return "", fmt.Errorf("downloading oss://%s/%s to %s: %w", bucketName, objectKey, target, err)
+	}
+	return target, nil
+}
+
+// ObjectVersion is one version of an object in a versioned bucket, either
+// stored data or a delete marker
+type ObjectVersion struct {
+	Key          string
+	VersionID    string
+	IsLatest     bool
+	DeleteMarker bool
+	LastModified time.Time
+	Size         int64
+	ETag         string
+	StorageClass string
+}
+
+// FetchObjectVersions lists every version of objectKey, newest first. Buckets
+// without versioning return the current object as the single "null" version
+func (s *OSSService) FetchObjectVersions(ctx context.Context, bucketName, objectKey string) ([]ObjectVersion, error) {
+	client, err := s.getClientForBucket(bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket, err := client.Bucket(bucketName)
+	if err != nil {
+		return nil, fmt.Errorf("getting bucket %s: %w", bucketName, err)
+	}
+
+	var versions []ObjectVersion
+	keyMarker, versionIDMarker := "", ""
+	for {
+		options := []oss.Option{oss.WithContext(ctx), oss.Prefix(objectKey), oss.MaxKeys(100)}
+		if keyMarker != "" {
+			options = append(options, oss.KeyMarker(keyMarker), oss.VersionIdMarker(versionIDMarker))
+		}
+
+		result, err := bucket.ListObjectVersions(options...)
+		if err != nil {
+			return nil, fmt.Errorf("listing versions of oss://%s/%s: %w", bucketName, objectKey, err)
+		}
+
+		// The prefix also matches longer keys, keep the exact key only
+		for _, v := range result.ObjectVersions {
+			if v.Key == objectKey {
+				versions = append(versions, ObjectVersion{
+					Key:          v.Key,
+					VersionID:    v.VersionId,
+					IsLatest:     v.IsLatest,
+					LastModified: v.LastModified,
+					Size:         v.Size,
+					ETag:         v.ETag,
+					StorageClass: v.StorageClass,
+				})
+			}
+		}
+		for _, d := range result.ObjectDeleteMarkers {
+			if d.Key == objectKey {
+				versions = append(versions, ObjectVersion{
+					Key:          d.Key,
+					VersionID:    d.VersionId,
+					IsLatest:     d.IsLatest,
+					DeleteMarker: true,
+					LastModified: d.LastModified,
+				})
+			}
+		}
+
+		// Keys are listed in order, so nothing of objectKey follows a larger key
+		if !result.IsTruncated || result.NextKeyMarker > objectKey {
+			break
+		}
+		keyMarker, versionIDMarker = result.NextKeyMarker, result.NextVersionIdMarker
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].LastModified.After(versions[j].LastModified)
+	})
+	return versions, nil
+}
+
+// RestoreObjectVersion makes a previous version the current one by copying it
+// over the object, so the version history is kept. The copy is limited to
+// objects of at most 1 GB
+func (s *OSSService) RestoreObjectVersion(bucketName, objectKey, versionID string) error {
+	client, err := s.getClientForBucket(bucketName)
+	if err != nil {
+		return err
+	}
+
+	bucket, err := client.Bucket(bucketName)
+	if err != nil {
+		return fmt.Errorf("getting bucket %s: %w", bucketName, err)
+	}
+
+	if _, err := bucket.CopyObject(objectKey, objectKey, oss.VersionId(versionID)); err != nil {
+		return fmt.Errorf("restoring oss://%s/%s to version %s: %w", bucketName, objectKey, versionID, err)
+	}
+	return nil
+}
+
+// maxDeletedObjects caps the number of deleted objects FetchDeletedObjects
+// collects from folders with a long deletion history
+const maxDeletedObjects = 1000
+
+// FetchDeletedObjects lists the objects under prefix whose current version is
+// a delete marker, most recently deleted first. Removing the marker restores
+// the previous version. Only versioned buckets keep deleted objects; the list
+// stops at maxDeletedObjects, with truncated set
+func (s *OSSService) FetchDeletedObjects(ctx context.Context, bucketName, prefix string) (deleted []ObjectVersion, truncated bool, err error) {
+	client, err := s.getClientForBucket(bucketName)
+	if err != nil {
+		return nil, false, err
+	}
+
+	bucket, err := client.Bucket(bucketName)
+	if err != nil {
+		return nil, false, fmt.Errorf("getting bucket %s: %w", bucketName, err)
+	}
+
+	keyMarker, versionIDMarker := "", ""
+	for {
+		options := []oss.Option{oss.WithContext(ctx), oss.Prefix(prefix), oss.MaxKeys(1000)}
+		if keyMarker != "" {
+			options = append(options, oss.KeyMarker(keyMarker), oss.VersionIdMarker(versionIDMarker))
+		}
+
+		result, err := bucket.ListObjectVersions(options...)
+		if err != nil {
+			return nil, false, fmt.Errorf("listing versions under oss://%s/%s: %w", bucketName, prefix, err)
+		}
+
+		for _, d := range result.ObjectDeleteMarkers {
+			if d.IsLatest {
+				deleted = append(deleted, ObjectVersion{
+					Key:          d.Key,
+					VersionID:    d.VersionId,
+					IsLatest:     true,
+					DeleteMarker: true,
+					LastModified: d.LastModified,
+				})
+			}
+		}
+
+		if len(deleted) >= maxDeletedObjects {
+			deleted, truncated = deleted[:maxDeletedObjects], true
+			break
+		}
+		if !result.IsTruncated {
+			break
+		}
+		keyMarker, versionIDMarker = result.NextKeyMarker, result.NextVersionIdMarker
+	}
+
+	sort.SliceStable(deleted, func(i, j int) bool {
+		return deleted[i].LastModified.After(deleted[j].LastModified)
+	})
+	return deleted, truncated, nil
+}
+
+// RemoveDeleteMarker deletes the delete marker of a deleted object, which
+// makes its previous version the current one again
+func (s *OSSService) RemoveDeleteMarker(bucketName, objectKey, markerVersionID string) error {
+	client, err := s.getClientForBucket(bucketName)
+	if err != nil {
+		return err
+	}
+
+	bucket, err := client.Bucket(bucketName)
+	if err != nil {
+		return fmt.Errorf("getting bucket %s: %w", bucketName, err)
+	}
+
+	if err := bucket.DeleteObject(objectKey, oss.VersionId(markerVersionID)); err != nil {
+		return fmt.Errorf("removing delete marker %s of oss://%s/%s: %w", markerVersionID, bucketName, objectKey, err)
+	}
+	return nil
+}
+
+// ObjectContent holds the leading bytes of an object fetched for preview
+type ObjectContent struct {
+	Key       string
+	Data      []byte
+	Truncated bool // The object is larger than the bytes fetched
+}
+
+// FetchObjectContent reads at most maxBytes from the start of an object
+func (s *OSSService) FetchObjectContent(ctx context.Context, bucketName, objectKey string, maxBytes int64) (*ObjectContent, error) {
+	client, err := s.getClientForBucket(bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket, err := client.Bucket(bucketName)
+	if err != nil {
+		return nil, fmt.Errorf("getting bucket %s: %w", bucketName, err)
+	}
+
+	// Request one byte past the limit so truncation can be detected
+	body, err := bucket.GetObject(objectKey, oss.WithContext(ctx), oss.Range(0, maxBytes))
+	if err != nil {
+		return nil, fmt.Errorf("reading oss://%s/%s: %w", bucketName, objectKey, err)
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(body, maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("reading oss://%s/%s: %w", bucketName, objectKey, err)
+	}
+
+	content := &ObjectContent{Key: objectKey, Data: data}
+	if int64(len(data)) > maxBytes {
+		content.Data = data[:maxBytes]
+		content.Truncated = true
+	}
+	return content, nil
+}
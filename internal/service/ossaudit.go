@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+// BucketAudit gathers the security and data-protection settings of a bucket:
+// access, versioning, encryption, lifecycle, inventory and replication
+type BucketAudit struct {
+	Name             string
+	Location         string
+	CreationDate     time.Time
+	StorageClass     string
+	RedundancyType   string
+	ExtranetEndpoint string
+	IntranetEndpoint string
+	ACL              string
+	Versioning       string
+	Encryption       BucketEncryption
+	Lifecycle        []BucketLifecycleRule
+	Inventories      []BucketInventory
+	Replication      []BucketReplicationRule
+	// Unavailable lists the settings that could not be read, such as when
+	// the caller lacks the permission, with the reason
+	Unavailable map[string]string `json:",omitempty"`
+}
+
+// BucketEncryption is the default server-side encryption of a bucket
+type BucketEncryption struct {
+	Algorithm         string // None, AES256, KMS or SM4
+	KMSMasterKeyID    string `json:",omitempty"` // Empty with KMS means the OSS-managed key
+	KMSDataEncryption string `json:",omitempty"`
+}
+
+// BucketLifecycleRule summarizes a lifecycle rule
+type BucketLifecycleRule struct {
+	ID      string
+	Prefix  string
+	Status  string
+	Actions []string
+}
+
+// BucketInventory summarizes an inventory configuration
+type BucketInventory struct {
+	ID                     string
+	Enabled                bool
+	Prefix                 string `json:",omitempty"`
+	Frequency              string
+	Format                 string
+	Destination            string
+	IncludedObjectVersions string
+}
+
+// BucketReplicationRule summarizes a cross-region or same-region
+// replication rule
+type BucketReplicationRule struct {
+	ID                          string
+	Status                      string
+	Action                      string
+	Prefixes                    []string `json:",omitempty"`
+	Destination                 string
+	TransferType                string `json:",omitempty"`
+	HistoricalObjectReplication string
+	RTC                         string `json:",omitempty"`
+	ReplicaKMSKeyID             string `json:",omitempty"`
+}
+
+// FetchBucketAudit reads the settings of a bucket. Only failing to read the
+// bucket info is an error; the other settings are recorded in Unavailable
+// when they cannot be read
+func (s *OSSService) FetchBucketAudit(ctx context.Context, bucketName string) (*BucketAudit, error) {
+	client, err := s.getClientForBucket(bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := client.GetBucketInfo(bucketName, oss.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("getting info of bucket %s: %w", bucketName, err)
+	}
+	bi := info.BucketInfo
+	audit := &BucketAudit{
+		Name:             bi.Name,
+		Location:         bi.Location,
+		CreationDate:     bi.CreationDate,
+		StorageClass:     bi.StorageClass,
+		RedundancyType:   bi.RedundancyType,
+		ExtranetEndpoint: bi.ExtranetEndpoint,
+		IntranetEndpoint: bi.IntranetEndpoint,
+		ACL:              bi.ACL,
+		Versioning:       bi.Versioning,
+		Encryption:       BucketEncryption{Algorithm: "None"},
+		Unavailable:      map[string]string{},
+	}
+	if audit.Versioning == "" {
+		audit.Versioning = "Disabled"
+	}
+
+	encryption, err := client.GetBucketEncryption(bucketName, oss.WithContext(ctx))
+	switch {
+	case err == nil:
+		audit.Encryption = BucketEncryption{
+			Algorithm:         encryption.SSEDefault.SSEAlgorithm,
+			KMSMasterKeyID:    encryption.SSEDefault.KMSMasterKeyID,
+			KMSDataEncryption: encryption.SSEDefault.KMSDataEncryption,
+		}
+	case !isOSSNotFound(err):
+		audit.Unavailable["Encryption"] = ossErrorReason(err)
+	}
+
+	lifecycle, err := client.GetBucketLifecycle(bucketName, oss.WithContext(ctx))
+	switch {
+	case err == nil:
+		for _, rule := range lifecycle.Rules {
+			audit.Lifecycle = append(audit.Lifecycle, summarizeLifecycleRule(rule))
+		}
+	case !isOSSNotFound(err):
+		audit.Unavailable["Lifecycle"] = ossErrorReason(err)
+	}
+
+	if inventories, err := fetchBucketInventories(ctx, client, bucketName); err == nil {
+		audit.Inventories = inventories
+	} else if !isOSSNotFound(err) {
+		audit.Unavailable["Inventories"] = ossErrorReason(err)
+	}
+
+	// The SDK returns the replication configuration as raw XML
+	replicationXML, err := client.GetBucketReplication(bucketName, oss.WithContext(ctx))
+	if err == nil {
+		var replication oss.GetBucketReplicationResult
+		if err := xml.Unmarshal([]byte(replicationXML), &replication); err != nil {
+			audit.Unavailable["Replication"] = err.Error()
+		}
+		for _, rule := range replication.Rule {
+			audit.Replication = append(audit.Replication, summarizeReplicationRule(rule))
+		}
+	} else if !isOSSNotFound(err) {
+		audit.Unavailable["Replication"] = ossErrorReason(err)
+	}
+
+	return audit, nil
+}
+
+// fetchBucketInventories lists every inventory configuration of a bucket
+func fetchBucketInventories(ctx context.Context, client *oss.Client, bucketName string) ([]BucketInventory, error) {
+	var inventories []BucketInventory
+	token := ""
+	for {
+		result, err := client.ListBucketInventory(bucketName, token, oss.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		for _, inv := range result.InventoryConfiguration {
+			dest := inv.OSSBucketDestination
+			inventories = append(inventories, BucketInventory{
+				ID:                     inv.Id,
+				Enabled:                inv.IsEnabled != nil && *inv.IsEnabled,
+				Prefix:                 inv.Prefix,
+				Frequency:              inv.Frequency,
+				Format:                 dest.Format,
+				Destination:            fmt.Sprintf("oss://%s/%s", strings.TrimPrefix(dest.Bucket, "acs:oss:::"), dest.Prefix),
+				IncludedObjectVersions: inv.IncludedObjectVersions,
+			})
+		}
+		if result.IsTruncated == nil || !*result.IsTruncated || result.NextContinuationToken == "" {
+			return inventories, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+// summarizeLifecycleRule describes the actions of a lifecycle rule in words
+func summarizeLifecycleRule(rule oss.LifecycleRule) BucketLifecycleRule {
+	summary := BucketLifecycleRule{ID: rule.ID, Prefix: rule.Prefix, Status: rule.Status}
+	if summary.Prefix == "" {
+		summary.Prefix = "(whole bucket)"
+	}
+
+	if exp := rule.Expiration; exp != nil {
+		switch {
+		case exp.Days > 0:
+			summary.Actions = append(summary.Actions, fmt.Sprintf("Expire objects %d days after last modified", exp.Days))
+		case exp.CreatedBeforeDate != "":
+			summary.Actions = append(summary.Actions, "Expire objects created before "+exp.CreatedBeforeDate)
+		case exp.ExpiredObjectDeleteMarker != nil && *exp.ExpiredObjectDeleteMarker:
+			summary.Actions = append(summary.Actions, "Remove expired delete markers")
+		}
+	}
+	for _, t := range rule.Transitions {
+		basis := "last modified"
+		if t.IsAccessTime != nil && *t.IsAccessTime {
+			basis = "last access"
+		}
+		summary.Actions = append(summary.Actions, fmt.Sprintf("Transition to %s %d days after %s", t.StorageClass, t.Days, basis))
+	}
+	if abort := rule.AbortMultipartUpload; abort != nil && abort.Days > 0 {
+		summary.Actions = append(summary.Actions, fmt.Sprintf("Abort multipart uploads after %d days", abort.Days))
+	}
+	if nv := rule.NonVersionExpiration; nv != nil {
+		summary.Actions = append(summary.Actions, fmt.Sprintf("Delete previous versions %d days after they become noncurrent", nv.NoncurrentDays))
+	}
+	for _, t := range rule.NonVersionTransitions {
+		summary.Actions = append(summary.Actions, fmt.Sprintf("Transition previous versions to %s %d days after they become noncurrent", t.StorageClass, t.NoncurrentDays))
+	}
+	return summary
+}
+
+// summarizeReplicationRule flattens a replication rule
+func summarizeReplicationRule(rule oss.ReplicationRule) BucketReplicationRule {
+	summary := BucketReplicationRule{
+		ID:                          rule.ID,
+		Status:                      rule.Status,
+		Action:                      rule.Action,
+		HistoricalObjectReplication: rule.HistoricalObjectReplication,
+	}
+	if rule.PrefixSet != nil {
+		for _, prefix := range rule.PrefixSet.Prefix {
+			if prefix != nil {
+				summary.Prefixes = append(summary.Prefixes, *prefix)
+			}
+		}
+	}
+	if dest := rule.Destination; dest != nil {
+		summary.Destination = fmt.Sprintf("%s (%s)", dest.Bucket, dest.Location)
+		summary.TransferType = dest.TransferType
+	}
+	if rule.RTC != nil {
+		summary.RTC = *rule.RTC
+	}
+	if rule.EncryptionConfiguration != nil {
+		summary.ReplicaKMSKeyID = *rule.EncryptionConfiguration
+	}
+	return summary
+}
+
+// isOSSNotFound reports whether an OSS error means the requested
+// configuration is not set on the bucket
+func isOSSNotFound(err error) bool {
+	var serviceErr oss.ServiceError
+	return errors.As(err, &serviceErr) && serviceErr.StatusCode == http.StatusNotFound
+}
+
+// ossErrorReason returns the short reason of an OSS error, such as its
+// error code for service errors
+func ossErrorReason(err error) string {
+	var serviceErr oss.ServiceError
+	if errors.As(err, &serviceErr) {
+		return fmt.Sprintf("%s: %s", serviceErr.Code, serviceErr.Message)
+	}
+	return err.Error()
+}
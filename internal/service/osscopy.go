@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+const (
+	// ossCopyObjectLimit is the largest object a single CopyObject or
+	// PutObject copies; bigger ones are copied in parts
+	ossCopyObjectLimit = 1 << 30
+	// ossCopyPartSize is the part size of multipart copies
+	ossCopyPartSize = 100 << 20
+	// ossMaxParts is how many parts a multipart upload holds at most
+	ossMaxParts = 10000
+)
+
+// ObjectCopy copies or moves objects between buckets. Keys are copied as
+// named; with Folder set, every object under it is copied instead. Each
+// destination key is DestPrefix followed by the source key relative to
+// BasePrefix, so a folder keeps its layout below the destination
+type ObjectCopy struct {
+	SourceBucket string
+	Keys         []string
+	Folder       string
+	BasePrefix   string
+	DestBucket   string
+	DestPrefix   string
+	Move         bool // Delete each source object once copied
+}
+
+// DestKey returns the destination key of a source key
+func (c ObjectCopy) DestKey(key string) string {
+	return c.DestPrefix + strings.TrimPrefix(key, c.BasePrefix)
+}
+
+// ObjectCopyResult sums up a copy, finished or stopped
+type ObjectCopyResult struct {
+	Total       int // Objects to copy
+	Copied      int
+	Bytes       int64
+	CrossRegion bool             // Objects were streamed through this machine
+	Failed      map[string]error // Errors keyed by source key
+}
+
+// CopyObjects copies the objects of c, server-side with CopyObject when both
+// buckets share an endpoint and by streaming each object from one region to
+// the other when they don't. A failed object is recorded and the copy goes
+// on; a move keeps the source of a failed object. A cancelled copy returns
+// the objects done so far with the error of ctx, the one it was copying
+// recorded as failed. onProgress, if not nil, is called with the objects
+// done so far and the total
+func (s *OSSService) CopyObjects(ctx context.Context, c ObjectCopy, onProgress func(done, total int64)) (*ObjectCopyResult, error) {
+	if c.SourceBucket == c.DestBucket && c.DestPrefix == c.BasePrefix {
+		return nil, fmt.Errorf("oss://%s/%s is both the source and the destination", c.SourceBucket, c.BasePrefix)
+	}
+
+	srcClient, err := s.getClientForBucket(c.SourceBucket)
+	if err != nil {
+		return nil, err
+	}
+	src, err := srcClient.Bucket(c.SourceBucket)
+	if err != nil {
+		return nil, fmt.Errorf("getting bucket %s: %w", c.SourceBucket, err)
+	}
+	dstClient, err := s.getClientForBucket(c.DestBucket)
+	if err != nil {
+		return nil, err
+	}
+	dst, err := dstClient.Bucket(c.DestBucket)
+	if err != nil {
+		return nil, fmt.Errorf("getting bucket %s: %w", c.DestBucket, err)
+	}
+
+	// Sizes pick the copy method; keys named without a listing are looked up
+	objects := make([]oss.ObjectProperties, 0, len(c.Keys))
+	if c.Folder != "" {
+		scan, err := s.ScanObjects(ctx, c.SourceBucket, c.Folder, DefaultOSSScanWorkers, 0, nil)
+		if err != nil {
+			return nil, err
+		}
+		objects = scan.Objects
+	} else {
+		for _, key := range c.Keys {
+			objects = append(objects, oss.ObjectProperties{Key: key, Size: -1})
+		}
+	}
+
+	result := &ObjectCopyResult{
+		Total:       len(objects),
+		CrossRegion: srcClient.Config.Endpoint != dstClient.Config.Endpoint,
+		Failed:      make(map[string]error),
+	}
+	total := int64(len(objects))
+	for i, obj := range objects {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		if onProgress != nil {
+			onProgress(int64(i), total)
+		}
+
+		size, err := copyObject(ctx, src, dst, obj, c.DestKey(obj.Key), result.CrossRegion)
+		if err == nil && c.Move {
+			if delErr := src.DeleteObject(obj.Key, oss.WithContext(ctx)); delErr != nil {
+				err = fmt.Errorf("copied, but deleting the source: %w", delErr)
+			}
+		}
+		if err != nil {
+			result.Failed[obj.Key] = err
+			if ctx.Err() != nil {
+				return result, ctx.Err()
+			}
+			continue
+		}
+		result.Copied++
+		result.Bytes += size
+	}
+	if onProgress != nil {
+		onProgress(total, total)
+	}
+	return result, nil
+}
+
+// copyObject copies one object and returns its size. A size below 0 is
+// looked up first
+func copyObject(ctx context.Context, src, dst *oss.Bucket, obj oss.ObjectProperties, destKey string, crossRegion bool) (int64, error) {
+	if crossRegion {
+		return streamObject(ctx, src, dst, obj.Key, destKey)
+	}
+
+	size := obj.Size
+	if size < 0 {
+		meta, err := src.GetObjectMeta(obj.Key, oss.WithContext(ctx))
+		if err != nil {
+			return 0, err
+		}
+		size, _ = strconv.ParseInt(meta.Get("Content-Length"), 10, 64)
+	}
+
+	if size > ossCopyObjectLimit {
+		return size, dst.CopyFile(src.BucketName, obj.Key, destKey, ossCopyPartSize, oss.WithContext(ctx))
+	}
+	_, err := dst.CopyObjectFrom(src.BucketName, obj.Key, destKey, oss.WithContext(ctx))
+	return size, err
+}
+
+// streamObject copies an object to a bucket in another region, which
+// CopyObject cannot reach, by uploading it as it is downloaded. Objects over
+// ossCopyObjectLimit are uploaded in parts, PutObject taking 5 GB at most.
+// The content type and user metadata are carried over
+func streamObject(ctx context.Context, src, dst *oss.Bucket, key, destKey string) (int64, error) {
+	got, err := src.DoGetObject(&oss.GetObjectRequest{ObjectKey: key}, []oss.Option{oss.WithContext(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	defer got.Response.Close()
+
+	header := got.Response.Headers
+	options := []oss.Option{oss.WithContext(ctx)}
+	if contentType := header.Get("Content-Type"); contentType != "" {
+		options = append(options, oss.ContentType(contentType))
+	}
+	for name := range header {
+		if strings.HasPrefix(strings.ToLower(name), "x-oss-meta-") {
+			options = append(options, oss.Meta(name[len("x-oss-meta-"):], header.Get(name)))
+		}
+	}
+
+	size, _ := strconv.ParseInt(header.Get("Content-Length"), 10, 64)
+	if size > ossCopyObjectLimit {
+		return size, uploadParts(ctx, dst, destKey, got.Response.Body, size, options)
+	}
+	return size, dst.PutObject(destKey, got.Response.Body, options...)
+}
+
+// uploadParts uploads size bytes of body to key as a multipart upload, with
+// parts of ossCopyPartSize or, for objects too big for ossMaxParts of them,
+// larger. A failed upload is aborted so its parts are not billed
+func uploadParts(ctx context.Context, dst *oss.Bucket, key string, body io.Reader, size int64, options []oss.Option) error {
+	partSize := max(int64(ossCopyPartSize), (size+ossMaxParts-1)/ossMaxParts)
+	imur, err := dst.InitiateMultipartUpload(key, options...)
+	if err != nil {
+		return err
+	}
+
+	var parts []oss.UploadPart
+	for offset := int64(0); offset < size; offset += partSize {
+		n := min(partSize, size-offset)
+		part, err := dst.UploadPart(imur, io.LimitReader(body, n), n, len(parts)+1, oss.WithContext(ctx))
+		if err != nil {
+			dst.AbortMultipartUpload(imur)
+			return fmt.Errorf("uploading part %d: %w", len(parts)+1, err)
+		}
+		parts = append(parts, part)
+	}
+	if _, err := dst.CompleteMultipartUpload(imur, parts, oss.WithContext(ctx)); err != nil {
+		dst.AbortMultipartUpload(imur)
+		return err
+	}
+	return nil
+}
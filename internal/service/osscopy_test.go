@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+func TestCopyObjectsCancelledMove(t *testing.T) {
+	var mu sync.Mutex
+	var copied, deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		key := strings.TrimPrefix(r.URL.Path, "/bucket/")
+		switch {
+		case r.Method == http.MethodGet && key == "":
+			w.Write([]byte(`<ListBucketResult><Name>bucket</Name></ListBucketResult>`))
+		case r.Method == http.MethodHead:
+			w.Header().Set("Content-Length", "10")
+		case r.Method == http.MethodPut && r.Header.Get("X-Oss-Copy-Source") != "":
+			copied = append(copied, key)
+			w.Write([]byte(`<CopyObjectResult><ETag>"etag"</ETag></CopyObjectResult>`))
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, key)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client, err := oss.New(server.URL, "ak", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewOSSService(client)
+
+	// Cancelled once the first object has moved, as the progress reports it
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	onProgress := func(done, total int64) {
+		if done == 1 {
+			cancel()
+		}
+	}
+
+	c := ObjectCopy{SourceBucket: "bucket", Keys: []string{"a", "b", "c"}, DestBucket: "bucket", DestPrefix: "moved/", Move: true}
+	result, err := svc.CopyObjects(ctx, c, onProgress)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if result == nil {
+		t.Fatal("result = nil, want the objects moved before the cancel")
+	}
+	if result.Total != 3 || result.Copied != 1 || result.Bytes != 10 {
+		t.Errorf("result = %d of %d copied, %d bytes, want 1 of 3, 10 bytes", result.Copied, result.Total, result.Bytes)
+	}
+	if _, ok := result.Failed["b"]; !ok || len(result.Failed) != 1 {
+		t.Errorf("failed = %v, want only b", result.Failed)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if strings.Join(copied, ",") != "moved/a" || strings.Join(deleted, ",") != "a" {
+		t.Errorf("copied %v and deleted %v, want moved/a and a", copied, deleted)
+	}
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+// ArchiveRestoreOptions describes how an archive storage class can be
+// restored: the priorities it accepts, fastest first, and for how many days
+// the restored copy can be kept
+type ArchiveRestoreOptions struct {
+	Tiers       []string // Empty when the class takes no tier
+	DefaultTier string
+	MaxDays     int
+}
+
+// archiveRestoreOptions holds the restore options of the storage classes
+// whose objects must be restored before they can be read
+var archiveRestoreOptions = map[string]ArchiveRestoreOptions{
+	string(oss.StorageArchive):         {MaxDays: 7},
+	string(oss.StorageColdArchive):     {Tiers: []string{"Expedited", "Standard", "Bulk"}, DefaultTier: "Standard", MaxDays: 365},
+	string(oss.StorageDeepColdArchive): {Tiers: []string{"Expedited", "Standard"}, DefaultTier: "Standard", MaxDays: 365},
+}
+
+// ArchiveRestoreOptionsFor returns the restore options of a storage class,
+// and false when objects of the class can be read without a restore
+func ArchiveRestoreOptionsFor(storageClass string) (ArchiveRestoreOptions, bool) {
+	options, ok := archiveRestoreOptions[storageClass]
+	return options, ok
+}
+
+// ObjectRestoreState tells whether an archived object can be read
+type ObjectRestoreState int
+
+const (
+	RestoreNotNeeded ObjectRestoreState = iota // Not an archive storage class
+	RestoreFrozen                              // Archived, no restored copy
+	RestoreOngoing                             // A restore is in progress
+	RestoreDone                                // A restored copy can be read until it expires
+)
+
+// ObjectRestoreStatus is the restore state of an object with the expiry of
+// its restored copy
+type ObjectRestoreStatus struct {
+	State  ObjectRestoreState
+	Expiry time.Time // Set for RestoreDone
+}
+
+// CanRead reports whether the object content can be downloaded
+func (s ObjectRestoreStatus) CanRead() bool {
+	return s.State == RestoreNotNeeded || s.State == RestoreDone
+}
+
+// String describes the status for display, "" when no restore is needed
+func (s ObjectRestoreStatus) String() string {
+	switch s.State {
+	case RestoreFrozen:
+		return "Frozen"
+	case RestoreOngoing:
+		return "Restoring"
+	case RestoreDone:
+		if s.Expiry.IsZero() {
+			return "Restored"
+		}
+		return "Restored until " + s.Expiry.Local().Format("2006-01-02 15:04")
+	default:
+		return ""
+	}
+}
+
+// restoreInfoPattern matches the RestoreInfo of a listed object, e.g.
+// ongoing-request="false", expiry-date="Sun, 16 Apr 2023 08:12:33 GMT"
+var restoreInfoPattern = regexp.MustCompile(`ongoing-request="(true|false)"(?:,\s*expiry-date="([^"]+)")?`)
+
+// RestoreStatusOf returns the restore status of a listed object from its
+// storage class and RestoreInfo
+func RestoreStatusOf(obj oss.ObjectProperties) ObjectRestoreStatus {
+	if _, ok := archiveRestoreOptions[obj.StorageClass]; !ok {
+		return ObjectRestoreStatus{State: RestoreNotNeeded}
+	}
+	match := restoreInfoPattern.FindStringSubmatch(obj.RestoreInfo)
+	switch {
+	case match == nil:
+		return ObjectRestoreStatus{State: RestoreFrozen}
+	case match[1] == "true":
+		return ObjectRestoreStatus{State: RestoreOngoing}
+	}
+	status := ObjectRestoreStatus{State: RestoreDone}
+	if expiry, err := time.Parse(time.RFC1123, match[2]); err == nil {
+		status.Expiry = expiry
+	}
+	return status
+}
+
+// RestoreArchiveObject starts restoring an Archive, ColdArchive or
+// DeepColdArchive object so it can be read for days days. tier selects how
+// fast a cold archive object is restored and must be empty for Archive
+func (s *OSSService) RestoreArchiveObject(bucketName, objectKey, storageClass string, days int, tier string) error {
+	options, ok := archiveRestoreOptions[storageClass]
+	if !ok {
+		return fmt.Errorf("oss://%s/%s is in the %s storage class and needs no restore", bucketName, objectKey, storageClass)
+	}
+	if days < 1 || days > options.MaxDays {
+		return fmt.Errorf("restore days must be from 1 to %d for %s objects", options.MaxDays, storageClass)
+	}
+	if tier != "" && !slices.Contains(options.Tiers, tier) {
+		return fmt.Errorf("%s objects cannot be restored with the %s tier", storageClass, tier)
+	}
+
+	client, err := s.getClientForBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	bucket, err := client.Bucket(bucketName)
+	if err != nil {
+		return fmt.Errorf("getting bucket %s: %w", bucketName, err)
+	}
+
+	// RestoreObjectDetail always sends a tier, which Archive objects reject
+	config, err := xml.Marshal(oss.RestoreConfiguration{Days: int32(days), Tier: tier})
+	if err != nil {
+		return fmt.Errorf("encoding restore request: %w", err)
+	}
+	if err := bucket.RestoreObjectXML(objectKey, string(config)); err != nil {
+		return fmt.Errorf("restoring oss://%s/%s: %w", bucketName, objectKey, err)
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+const (
+	// ossScanPageSize is the largest page ListObjectsV2 accepts
+	ossScanPageSize = 1000
+	// ossScanSplitDepth is how many folder levels below the scanned prefix are
+	// split into separate partitions; deeper folders are listed flat by the
+	// partition that contains them, which needs far fewer requests than
+	// walking every folder
+	ossScanSplitDepth = 2
+	// DefaultOSSScanWorkers is the number of partitions listed concurrently
+	DefaultOSSScanWorkers = 8
+	// DefaultOSSScanLimit caps the number of objects a scan keeps in memory
+	DefaultOSSScanLimit = 200000
+	// ossScanDelimiter separates folder levels in object keys
+	ossScanDelimiter = "/"
+)
+
+// ObjectScanResult is every object found under a prefix by ScanObjects,
+// sorted by key
+type ObjectScanResult struct {
+	Prefix     string
+	Objects    []oss.ObjectProperties
+	Partitions int  // Number of prefixes listed
+	Truncated  bool // The scan stopped at the object limit
+}
+
+// scanPartition is a prefix to list. Split partitions are listed with the
+// delimiter so their folders become partitions of their own
+type scanPartition struct {
+	prefix string
+	depth  int
+}
+
+// ScanObjects lists every object under prefix, recursively. The key space is
+// partitioned by folder: the first ossScanSplitDepth folder levels are
+// discovered with delimited listings, and each partition is paginated with
+// ListObjectsV2 on a pool of workers goroutines. The scan stops early with
+// Truncated set, keeping limit objects, once there are more than limit.
+// onProgress, if not nil, is called with the number of objects found so far
+func (s *OSSService) ScanObjects(ctx context.Context, bucketName, prefix string, workers, limit int, onProgress func(found int64)) (*ObjectScanResult, error) {
+	client, err := s.getClientForBucket(bucketName)
+	if err != nil {
+		return nil, err
+	}
+	bucket, err := client.Bucket(bucketName)
+	if err != nil {
+		return nil, fmt.Errorf("getting bucket %s: %w", bucketName, err)
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	scanCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg         sync.WaitGroup
+		mu         sync.Mutex
+		objects    []oss.ObjectProperties
+		partitions int
+		truncated  bool
+		firstErr   error
+		sem        = make(chan struct{}, workers)
+	)
+
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr = err
+		}
+		cancel()
+	}
+
+	// collect merges a page of a partition into the result, and reports
+	// whether the scan should go on. A bucket of exactly limit objects is not
+	// truncated: only an object past the limit stops the scan
+	collect := func(page []oss.ObjectProperties) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		if limit > 0 && len(objects)+len(page) > limit {
+			page = page[:limit-len(objects)]
+			truncated = true
+			cancel()
+		}
+		objects = append(objects, page...)
+		if onProgress != nil {
+			onProgress(int64(len(objects)))
+		}
+		return !truncated
+	}
+
+	var scan func(p scanPartition)
+	scan = func(p scanPartition) {
+		defer wg.Done()
+		select {
+		case sem <- struct{}{}:
+		case <-scanCtx.Done():
+			return
+		}
+		defer func() { <-sem }()
+
+		mu.Lock()
+		partitions++
+		mu.Unlock()
+
+		split := p.depth < ossScanSplitDepth
+		token := ""
+		for {
+			if scanCtx.Err() != nil {
+				return
+			}
+			options := []oss.Option{oss.MaxKeys(ossScanPageSize), oss.WithContext(scanCtx)}
+			if p.prefix != "" {
+				options = append(options, oss.Prefix(p.prefix))
+			}
+			if split {
+				options = append(options, oss.Delimiter(ossScanDelimiter))
+			}
+			if token != "" {
+				options = append(options, oss.ContinuationToken(token))
+			}
+
+			result, err := bucket.ListObjectsV2(options...)
+			if err != nil {
+				if scanCtx.Err() == nil {
+					fail(fmt.Errorf("listing objects in bucket %s (prefix: %s): %w", bucketName, p.prefix, err))
+				}
+				return
+			}
+
+			for _, folder := range result.CommonPrefixes {
+				wg.Add(1)
+				go scan(scanPartition{prefix: folder, depth: p.depth + 1})
+			}
+			if !collect(result.Objects) {
+				return
+			}
+			if !result.IsTruncated || result.NextContinuationToken == "" {
+				return
+			}
+			token = result.NextContinuationToken
+		}
+	}
+
+	wg.Add(1)
+	go scan(scanPartition{prefix: prefix})
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].Key < objects[j].Key
+	})
+	return &ObjectScanResult{
+		Prefix:     prefix,
+		Objects:    objects,
+		Partitions: partitions,
+		Truncated:  truncated,
+	}, nil
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/alibabacloud-go/tea/tea"
+	sdkerrors "github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+
+	"aliyun-tui-viewer/internal/client"
+)
+
+// Products the permissions of a profile are tracked by, each covering the
+// APIs of one Aliyun product
+const (
+	ProductECS           = "ECS"
+	ProductVPC           = "VPC"
+	ProductDNS           = "DNS"
+	ProductSLB           = "SLB"
+	ProductALB           = "ALB"
+	ProductNLB           = "NLB"
+	ProductACK           = "ACK"
+	ProductACR           = "ACR"
+	ProductFC            = "FC"
+	ProductOSS           = "OSS"
+	ProductRDS           = "RDS"
+	ProductRedis         = "Redis"
+	ProductMongoDB       = "MongoDB"
+	ProductElasticsearch = "Elasticsearch"
+	ProductKafka         = "Kafka"
+	ProductRocketMQ      = "RocketMQ"
+	ProductRAM           = "RAM"
+	ProductSLS           = "SLS"
+	ProductConfig        = "Config"
+	ProductTags          = "Tags"
+	ProductBilling       = "Billing"
+)
+
+// deniedCodes are the prefixes of error codes with which Aliyun refuses a call
+// the caller has no permission for
+var deniedCodes = []string{"Forbidden", "NoPermission", "AccessDenied", "NotAuthorized"}
+
+// IsPermissionDenied reports whether err means the profile is not allowed to
+// make the call, whichever SDK returned it
+func IsPermissionDenied(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var serverErr *sdkerrors.ServerError
+	if errors.As(err, &serverErr) {
+		return serverErr.HttpStatus() == http.StatusForbidden || isDeniedCode(serverErr.ErrorCode())
+	}
+	var sdkErr *tea.SDKError
+	if errors.As(err, &sdkErr) {
+		return tea.IntValue(sdkErr.StatusCode) == http.StatusForbidden || isDeniedCode(tea.StringValue(sdkErr.Code))
+	}
+	var ossErr oss.ServiceError
+	if errors.As(err, &ossErr) {
+		return ossErr.StatusCode == http.StatusForbidden || isDeniedCode(ossErr.Code)
+	}
+	var slsErr *client.SLSError
+	if errors.As(err, &slsErr) {
+		return slsErr.Status == http.StatusForbidden || isDeniedCode(slsErr.Code)
+	}
+	var fcErr *client.FCError
+	if errors.As(err, &fcErr) {
+		return fcErr.Status == http.StatusForbidden || isDeniedCode(fcErr.Code)
+	}
+	return false
+}
+
+// isDeniedCode reports whether an error code refuses a call for lack of
+// permission
+func isDeniedCode(code string) bool {
+	for _, prefix := range deniedCodes {
+		if strings.HasPrefix(code, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Permissions records the products a profile turned out to have no
+// permission for, so they can be marked and skipped. It is safe for
+// concurrent use; a nil Permissions records nothing
+type Permissions struct {
+	mu     sync.Mutex
+	denied map[string]bool // By product, e.g. "RocketMQ"
+}
+
+// Deny records that the profile has no permission for product. It reports
+// whether that is news
+func (p *Permissions) Deny(product string) bool {
+	if p == nil {
+		return false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.denied[product] {
+		return false
+	}
+	if p.denied == nil {
+		p.denied = make(map[string]bool)
+	}
+	p.denied[product] = true
+	return true
+}
+
+// Denied reports whether the profile has no permission for product
+func (p *Permissions) Denied(product string) bool {
+	if p == nil {
+		return false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.denied[product]
+}
+
+// Products returns the denied products, sorted
+func (p *Permissions) Products() []string {
+	if p == nil {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	products := make([]string, 0, len(p.denied))
+	for product := range p.denied {
+		products = append(products, product)
+	}
+	sort.Strings(products)
+	return products
+}
+
+var (
+	permissionsMu sync.Mutex
+	permissions   = make(map[string]*Permissions) // By profile
+)
+
+// PermissionsOf returns the permissions found for a profile during the
+// session, shared by all its regions
+func PermissionsOf(profile string) *Permissions {
+	permissionsMu.Lock()
+	defer permissionsMu.Unlock()
+	p, ok := permissions[profile]
+	if !ok {
+		p = &Permissions{}
+		permissions[profile] = p
+	}
+	return p
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strconv"
+	"time"
+)
+
+// Probe kinds
+const (
+	ProbeICMP = "ICMP"
+	ProbeTCP  = "TCP"
+)
+
+// ProbeCount is how many echo requests or connects a probe sends
+const ProbeCount = 10
+
+// probeInterval is the pause between TCP connects, and between pings where
+// the system ping allows it unprivileged
+const probeInterval = 200 * time.Millisecond
+
+// ProbeResult holds the round trip times of a probe. Lost counts the requests
+// that got no answer in time. Err is set when the probe could not run at all,
+// or why the connects failed when none of them got through
+type ProbeResult struct {
+	Kind    string
+	Target  string
+	Samples []time.Duration
+	Lost    int
+	Err     error
+}
+
+// Sent returns the number of requests of the probe
+func (r ProbeResult) Sent() int {
+	return len(r.Samples) + r.Lost
+}
+
+// Stats returns the fastest, average and slowest round trip time
+func (r ProbeResult) Stats() (fastest, mean, slowest time.Duration) {
+	if len(r.Samples) == 0 {
+		return 0, 0, 0
+	}
+	fastest, slowest = r.Samples[0], r.Samples[0]
+	var total time.Duration
+	for _, s := range r.Samples {
+		total += s
+		fastest = min(fastest, s)
+		slowest = max(slowest, s)
+	}
+	return fastest, total / time.Duration(len(r.Samples)), slowest
+}
+
+// LossPercent returns the share of requests that got no answer
+func (r ProbeResult) LossPercent() float64 {
+	if r.Sent() == 0 {
+		return 0
+	}
+	return float64(r.Lost) / float64(r.Sent()) * 100
+}
+
+// ProbeTCPPort connects to host:port count times, measuring the time each
+// connect takes
+func ProbeTCPPort(host string, port, count int) ProbeResult {
+	address := net.JoinHostPort(host, strconv.Itoa(port))
+	result := ProbeResult{Kind: ProbeTCP, Target: address}
+	var lastErr error
+	for i := 0; i < count; i++ {
+		if i > 0 {
+			time.Sleep(probeInterval)
+		}
+		start := time.Now()
+		conn, err := net.DialTimeout("tcp", address, time.Second)
+		if err != nil {
+			result.Lost++
+			lastErr = err
+			continue
+		}
+		result.Samples = append(result.Samples, time.Since(start))
+		conn.Close()
+	}
+	if len(result.Samples) == 0 {
+		result.Err = lastErr
+	}
+	return result
+}
+
+// pingTimeRegexp matches the round trip time of a reply line, e.g.
+// "time=12.3 ms" or, on Windows, "time<1ms"
+var pingTimeRegexp = regexp.MustCompile(`time[=<]\s*([0-9.]+)\s*ms`)
+
+// ProbeICMPHost sends count echo requests with the system ping command, as
+// raw ICMP sockets need privileges
+func ProbeICMPHost(host string, count int) ProbeResult {
+	result := ProbeResult{Kind: ProbeICMP, Target: host}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(count)*time.Second+5*time.Second)
+	defer cancel()
+	output, err := pingCommand(ctx, host, count).Output()
+	if err != nil && len(output) == 0 {
+		result.Err = fmt.Errorf("running ping: %w", err)
+		return result
+	}
+
+	for _, match := range pingTimeRegexp.FindAllSubmatch(output, -1) {
+		if ms, err := strconv.ParseFloat(string(match[1]), 64); err == nil {
+			result.Samples = append(result.Samples, time.Duration(ms*float64(time.Millisecond)))
+		}
+	}
+	result.Lost = count - len(result.Samples)
+	if result.Lost < 0 {
+		result.Lost = 0
+	}
+	return result
+}
+
+// pingCommand builds the ping invocation of the platform
+func pingCommand(ctx context.Context, host string, count int) *exec.Cmd {
+	n := strconv.Itoa(count)
+	switch runtime.GOOS {
+	case "windows":
+		return exec.CommandContext(ctx, "ping", "-n", n, "-w", "1000", host)
+	case "darwin":
+		return exec.CommandContext(ctx, "ping", "-c", n, "-i", "0.2", "-W", "1000", host)
+	default:
+		return exec.CommandContext(ctx, "ping", "-c", n, "-i", "0.2", "-W", "1", host)
+	}
+}
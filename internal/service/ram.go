@@ -0,0 +1,304 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	sdkerrors "github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
+
+	"aliyun-tui-viewer/internal/cache"
+)
+
+// ramPageSize is the largest MaxItems the RAM list APIs accept
+const ramPageSize = 1000
+
+// ramUserDetailConcurrency bounds the per-user access key and MFA lookups
+const ramUserDetailConcurrency = 8
+
+// ramTimeLayout is the format of RAM CreateDate/LastLoginDate fields
+const ramTimeLayout = "2006-01-02T15:04:05Z"
+
+// RAMService handles RAM (identity) operations
+type RAMService struct {
+	client *ram.Client
+
+	cached
+}
+
+// RAMUserDetail contains a RAM user with its access keys and MFA device
+type RAMUserDetail struct {
+	User       ram.User
+	AccessKeys []ram.AccessKey
+	MFADevice  *ram.MFADevice // nil when no MFA device is bound
+	KeysErr    error          `json:"-"` // Set when the access keys could not be listed
+	MFAErr     error          `json:"-"` // Set when the MFA device could not be read
+}
+
+// RAMPolicyDetail contains a policy with the document of its default version
+type RAMPolicyDetail struct {
+	Policy         ram.Policy
+	VersionID      string
+	PolicyDocument json.RawMessage
+}
+
+// NewRAMService creates a new RAM service
+func NewRAMService(client *ram.Client) *RAMService {
+	return &RAMService{client: client}
+}
+
+// FetchUsers retrieves all RAM users using marker pagination
+func (s *RAMService) FetchUsers(ctx context.Context) ([]ram.User, error) {
+	var allUsers []ram.User
+	marker := ""
+
+	for {
+		request := ram.CreateListUsersRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.MaxItems = requests.NewInteger(ramPageSize)
+		request.Marker = marker
+
+		response, err := s.client.ListUsers(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing RAM users: %w", err)
+		}
+
+		allUsers = append(allUsers, response.Users.User...)
+		if !response.IsTruncated || response.Marker == "" {
+			break
+		}
+		marker = response.Marker
+	}
+
+	return allUsers, nil
+}
+
+// FetchDetailedUsers retrieves all RAM users with their access keys and MFA
+// devices. Users whose keys or MFA device cannot be read are still returned,
+// with KeysErr or MFAErr set
+func (s *RAMService) FetchDetailedUsers(ctx context.Context) ([]RAMUserDetail, error) {
+	return cache.Fetch(ctx, s.cache, CacheRAMUsers, s.fetchDetailedUsers)
+}
+
+// fetchDetailedUsers calls the API for FetchDetailedUsers
+func (s *RAMService) fetchDetailedUsers(ctx context.Context) ([]RAMUserDetail, error) {
+	users, err := s.FetchUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, ramUserDetailConcurrency)
+	details := make([]RAMUserDetail, len(users))
+
+	for i, user := range users {
+		details[i] = RAMUserDetail{User: user}
+
+		wg.Add(1)
+		go func(detail *RAMUserDetail) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			// Each goroutine only writes its own element
+			detail.AccessKeys, detail.KeysErr = s.FetchAccessKeys(ctx, detail.User.UserName)
+			detail.MFADevice, detail.MFAErr = s.FetchMFADevice(ctx, detail.User.UserName)
+		}(&details[i])
+	}
+
+	wg.Wait()
+	return details, nil
+}
+
+// FetchAccessKeys retrieves the access keys of a RAM user
+func (s *RAMService) FetchAccessKeys(ctx context.Context, userName string) ([]ram.AccessKey, error) {
+	request := ram.CreateListAccessKeysRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.UserName = userName
+
+	response, err := s.client.ListAccessKeys(request)
+	if err != nil {
+		return nil, fmt.Errorf("listing access keys for user %s: %w", userName, err)
+	}
+
+	return response.AccessKeys.AccessKey, nil
+}
+
+// FetchMFADevice retrieves the MFA device bound to a RAM user, or nil when
+// the user has none
+func (s *RAMService) FetchMFADevice(ctx context.Context, userName string) (*ram.MFADevice, error) {
+	request := ram.CreateGetUserMFAInfoRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.UserName = userName
+
+	response, err := s.client.GetUserMFAInfo(request)
+	if err != nil {
+		// Users without a device are reported as EntityNotExist.User.MFADevice
+		var serverErr *sdkerrors.ServerError
+		if errors.As(err, &serverErr) && strings.HasPrefix(serverErr.ErrorCode(), "EntityNotExist") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("getting MFA info for user %s: %w", userName, err)
+	}
+
+	return &response.MFADevice, nil
+}
+
+// FetchRoles retrieves all RAM roles using marker pagination
+func (s *RAMService) FetchRoles(ctx context.Context) ([]ram.Role, error) {
+	return cache.Fetch(ctx, s.cache, CacheRAMRoles, s.fetchRoles)
+}
+
+// fetchRoles calls the API for FetchRoles
+func (s *RAMService) fetchRoles(ctx context.Context) ([]ram.Role, error) {
+	var allRoles []ram.Role
+	marker := ""
+
+	for {
+		request := ram.CreateListRolesRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.MaxItems = requests.NewInteger(ramPageSize)
+		request.Marker = marker
+
+		response, err := s.client.ListRoles(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing RAM roles: %w", err)
+		}
+
+		allRoles = append(allRoles, response.Roles.Role...)
+		if !response.IsTruncated || response.Marker == "" {
+			break
+		}
+		marker = response.Marker
+	}
+
+	return allRoles, nil
+}
+
+// FetchPolicies retrieves all system and custom RAM policies using marker pagination
+func (s *RAMService) FetchPolicies(ctx context.Context) ([]ram.Policy, error) {
+	return cache.Fetch(ctx, s.cache, CacheRAMPolicies, s.fetchPolicies)
+}
+
+// fetchPolicies calls the API for FetchPolicies
+func (s *RAMService) fetchPolicies(ctx context.Context) ([]ram.Policy, error) {
+	var allPolicies []ram.Policy
+	marker := ""
+
+	for {
+		request := ram.CreateListPoliciesRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.MaxItems = requests.NewInteger(ramPageSize)
+		request.Marker = marker
+
+		response, err := s.client.ListPolicies(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing RAM policies: %w", err)
+		}
+
+		allPolicies = append(allPolicies, response.Policies.Policy...)
+		if !response.IsTruncated || response.Marker == "" {
+			break
+		}
+		marker = response.Marker
+	}
+
+	return allPolicies, nil
+}
+
+// FetchUserPolicies retrieves the policies attached directly to a RAM user
+func (s *RAMService) FetchUserPolicies(ctx context.Context, userName string) ([]ram.Policy, error) {
+	request := ram.CreateListPoliciesForUserRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.UserName = userName
+
+	response, err := s.client.ListPoliciesForUser(request)
+	if err != nil {
+		return nil, fmt.Errorf("listing policies for user %s: %w", userName, err)
+	}
+
+	return response.Policies.Policy, nil
+}
+
+// FetchRolePolicies retrieves the policies attached to a RAM role
+func (s *RAMService) FetchRolePolicies(ctx context.Context, roleName string) ([]ram.Policy, error) {
+	request := ram.CreateListPoliciesForRoleRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.RoleName = roleName
+
+	response, err := s.client.ListPoliciesForRole(request)
+	if err != nil {
+		return nil, fmt.Errorf("listing policies for role %s: %w", roleName, err)
+	}
+
+	return response.Policies.Policy, nil
+}
+
+// FetchPolicyDetail retrieves a policy with the document of its default version
+func (s *RAMService) FetchPolicyDetail(ctx context.Context, policyName, policyType string) (*RAMPolicyDetail, error) {
+	request := ram.CreateGetPolicyRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.PolicyName = policyName
+	request.PolicyType = policyType
+
+	response, err := s.client.GetPolicy(request)
+	if err != nil {
+		return nil, fmt.Errorf("getting policy %s: %w", policyName, err)
+	}
+
+	detail := &RAMPolicyDetail{
+		Policy:    response.Policy,
+		VersionID: response.DefaultPolicyVersion.VersionId,
+	}
+	// Keep the document as JSON so the detail view pretty-prints it
+	if document := response.DefaultPolicyVersion.PolicyDocument; json.Valid([]byte(document)) {
+		detail.PolicyDocument = json.RawMessage(document)
+	} else if document != "" {
+		quoted, _ := json.Marshal(document)
+		detail.PolicyDocument = quoted
+	}
+	return detail, nil
+}
+
+// ActiveKeyCount returns the number of active access keys
+func (d RAMUserDetail) ActiveKeyCount() int {
+	count := 0
+	for _, key := range d.AccessKeys {
+		if key.Status == "Active" {
+			count++
+		}
+	}
+	return count
+}
+
+// OldestActiveKeyAge returns the age of the oldest active access key. ok is
+// false when the user has no active key with a parseable creation date
+func (d RAMUserDetail) OldestActiveKeyAge(now time.Time) (age time.Duration, ok bool) {
+	for _, key := range d.AccessKeys {
+		if key.Status != "Active" {
+			continue
+		}
+		created, err := time.Parse(ramTimeLayout, key.CreateDate)
+		if err != nil {
+			continue
+		}
+		if keyAge := now.Sub(created); !ok || keyAge > age {
+			age, ok = keyAge, true
+		}
+	}
+	return age, ok
+}
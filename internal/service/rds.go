@@ -1,16 +1,23 @@
 package service
 
 import (
+	"context"
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+
+	"aliyun-tui-viewer/internal/cache"
 )
 
 // RDSService handles RDS operations
 type RDSService struct {
 	client *rds.Client
+
+	cached
 }
 
 // RDSInstanceDetail contains RDS instance with network info
@@ -22,13 +29,26 @@ type RDSInstanceDetail struct {
 	PublicIP              string // 外网IP
 }
 
+// RDSInstanceAttributes contains the attributes of an RDS instance shown in
+// its detail: the full attribute set, its endpoints and IP whitelists
+type RDSInstanceAttributes struct {
+	Attribute  rds.DBInstanceAttribute
+	NetInfo    []rds.DBInstanceNetInfo
+	Whitelists []rds.DBInstanceIPArray
+}
+
 // NewRDSService creates a new RDS service
 func NewRDSService(client *rds.Client) *RDSService {
 	return &RDSService{client: client}
 }
 
 // FetchInstances retrieves all RDS instances using pagination
-func (s *RDSService) FetchInstances() ([]rds.DBInstance, error) {
+func (s *RDSService) FetchInstances(ctx context.Context) ([]rds.DBInstance, error) {
+	return cache.Fetch(ctx, s.cache, CacheRDSInstances, s.fetchInstances)
+}
+
+// fetchInstances calls the API for FetchInstances
+func (s *RDSService) fetchInstances(ctx context.Context) ([]rds.DBInstance, error) {
 	var allInstances []rds.DBInstance
 	pageNumber := 1
 	pageSize := 100 // 使用最大页面大小以减少请求次数
@@ -36,6 +56,7 @@ func (s *RDSService) FetchInstances() ([]rds.DBInstance, error) {
 	for {
 		request := rds.CreateDescribeDBInstancesRequest()
 		request.Scheme = "https"
+		bind(ctx, request)
 		request.PageNumber = requests.NewInteger(pageNumber)
 		request.PageSize = requests.NewInteger(pageSize)
 
@@ -65,9 +86,10 @@ func (s *RDSService) FetchInstances() ([]rds.DBInstance, error) {
 }
 
 // FetchDatabases retrieves all databases for a specific RDS instance
-func (s *RDSService) FetchDatabases(dbInstanceId string) ([]rds.Database, error) {
+func (s *RDSService) FetchDatabases(ctx context.Context, dbInstanceId string) ([]rds.Database, error) {
 	request := rds.CreateDescribeDatabasesRequest()
 	request.Scheme = "https"
+	bind(ctx, request)
 	request.DBInstanceId = dbInstanceId
 
 	response, err := s.client.DescribeDatabases(request)
@@ -79,9 +101,10 @@ func (s *RDSService) FetchDatabases(dbInstanceId string) ([]rds.Database, error)
 }
 
 // FetchAccounts retrieves all accounts for a specific RDS instance
-func (s *RDSService) FetchAccounts(dbInstanceId string) ([]rds.DBInstanceAccount, error) {
+func (s *RDSService) FetchAccounts(ctx context.Context, dbInstanceId string) ([]rds.DBInstanceAccount, error) {
 	request := rds.CreateDescribeAccountsRequest()
 	request.Scheme = "https"
+	bind(ctx, request)
 	request.DBInstanceId = dbInstanceId
 
 	response, err := s.client.DescribeAccounts(request)
@@ -93,9 +116,10 @@ func (s *RDSService) FetchAccounts(dbInstanceId string) ([]rds.DBInstanceAccount
 }
 
 // FetchInstanceNetInfo retrieves network info for a specific RDS instance
-func (s *RDSService) FetchInstanceNetInfo(dbInstanceId string) ([]rds.DBInstanceNetInfo, error) {
+func (s *RDSService) FetchInstanceNetInfo(ctx context.Context, dbInstanceId string) ([]rds.DBInstanceNetInfo, error) {
 	request := rds.CreateDescribeDBInstanceNetInfoRequest()
 	request.Scheme = "https"
+	bind(ctx, request)
 	request.DBInstanceId = dbInstanceId
 
 	response, err := s.client.DescribeDBInstanceNetInfo(request)
@@ -107,9 +131,14 @@ func (s *RDSService) FetchInstanceNetInfo(dbInstanceId string) ([]rds.DBInstance
 }
 
 // FetchDetailedInstances retrieves all RDS instances with their network info
-func (s *RDSService) FetchDetailedInstances() ([]RDSInstanceDetail, error) {
+func (s *RDSService) FetchDetailedInstances(ctx context.Context) ([]RDSInstanceDetail, error) {
+	return cache.Fetch(ctx, s.cache, CacheRDSDetailed, s.fetchDetailedInstances)
+}
+
+// fetchDetailedInstances calls the API for FetchDetailedInstances
+func (s *RDSService) fetchDetailedInstances(ctx context.Context) ([]RDSInstanceDetail, error) {
 	// First fetch all instances
-	instances, err := s.FetchInstances()
+	instances, err := s.FetchInstances(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +158,7 @@ func (s *RDSService) FetchDetailedInstances() ([]RDSInstanceDetail, error) {
 		go func(idx int, instanceId string) {
 			defer wg.Done()
 
-			netInfos, err := s.FetchInstanceNetInfo(instanceId)
+			netInfos, err := s.FetchInstanceNetInfo(ctx, instanceId)
 			if err != nil {
 				return // Skip if error
 			}
@@ -155,3 +184,256 @@ func (s *RDSService) FetchDetailedInstances() ([]RDSInstanceDetail, error) {
 	wg.Wait()
 	return detailedInstances, nil
 }
+
+// FetchInstanceAttributes retrieves the attributes, endpoints and IP
+// whitelists of an RDS instance
+func (s *RDSService) FetchInstanceAttributes(ctx context.Context, dbInstanceId string) (*RDSInstanceAttributes, error) {
+	request := rds.CreateDescribeDBInstanceAttributeRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.DBInstanceId = dbInstanceId
+
+	response, err := s.client.DescribeDBInstanceAttribute(request)
+	if err != nil {
+		return nil, fmt.Errorf("describing attributes of instance %s: %w", dbInstanceId, err)
+	}
+	if len(response.Items.DBInstanceAttribute) == 0 {
+		return nil, fmt.Errorf("RDS instance %s not found", dbInstanceId)
+	}
+	attributes := &RDSInstanceAttributes{Attribute: response.Items.DBInstanceAttribute[0]}
+
+	if attributes.NetInfo, err = s.FetchInstanceNetInfo(ctx, dbInstanceId); err != nil {
+		return nil, err
+	}
+
+	ipRequest := rds.CreateDescribeDBInstanceIPArrayListRequest()
+	ipRequest.Scheme = "https"
+	bind(ctx, ipRequest)
+	ipRequest.DBInstanceId = dbInstanceId
+
+	ipResponse, err := s.client.DescribeDBInstanceIPArrayList(ipRequest)
+	if err != nil {
+		return nil, fmt.Errorf("describing IP whitelists of instance %s: %w", dbInstanceId, err)
+	}
+	attributes.Whitelists = ipResponse.Items.DBInstanceIPArray
+	return attributes, nil
+}
+
+// ModifyMaintenanceWindow changes the daily maintenance window of an instance
+func (s *RDSService) ModifyMaintenanceWindow(dbInstanceId string, window MaintenanceWindow) error {
+	request := rds.CreateModifyDBInstanceMaintainTimeRequest()
+	request.Scheme = "https"
+	request.DBInstanceId = dbInstanceId
+	request.MaintainTime = window.String()
+
+	if _, err := s.client.ModifyDBInstanceMaintainTime(request); err != nil {
+		return fmt.Errorf("modifying maintenance window of RDS instance %s: %w", dbInstanceId, err)
+	}
+	return nil
+}
+
+// RDSBackupWindow is how far back the backups and binlogs pages look: backup
+// sets and binlogs are kept for 7 days unless the retention was extended
+const RDSBackupWindow = 7 * 24 * time.Hour
+
+// Time formats of DescribeBackups and DescribeBinlogFiles, in UTC
+const (
+	rdsBackupLayout = "2006-01-02T15:04Z"
+	rdsBinlogLayout = "2006-01-02T15:04:05Z"
+)
+
+// FetchBackups retrieves the backup sets of an instance started within the
+// last window, newest first
+func (s *RDSService) FetchBackups(ctx context.Context, dbInstanceId string, window time.Duration) ([]rds.Backup, error) {
+	end := time.Now().UTC()
+	start := end.Add(-window)
+
+	var backups []rds.Backup
+	pageNumber := 1
+	pageSize := 100
+
+	for {
+		request := rds.CreateDescribeBackupsRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.DBInstanceId = dbInstanceId
+		request.StartTime = start.Format(rdsBackupLayout)
+		request.EndTime = end.Format(rdsBackupLayout)
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(pageSize)
+
+		response, err := s.client.DescribeBackups(request)
+		if err != nil {
+			return nil, fmt.Errorf("describing backups of RDS instance %s (page %d): %w", dbInstanceId, pageNumber, err)
+		}
+		backups = append(backups, response.Items.Backup...)
+		if len(response.Items.Backup) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backups[i].BackupStartTime > backups[j].BackupStartTime
+	})
+	return backups, nil
+}
+
+// FetchBinlogs retrieves the binlog files of an instance written within the
+// last window, newest first. High-availability instances list the files of
+// each node, told apart by HostInstanceID
+func (s *RDSService) FetchBinlogs(ctx context.Context, dbInstanceId string, window time.Duration) ([]rds.BinLogFile, error) {
+	end := time.Now().UTC()
+	start := end.Add(-window)
+
+	var files []rds.BinLogFile
+	pageNumber := 1
+	pageSize := 100
+
+	for {
+		request := rds.CreateDescribeBinlogFilesRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.DBInstanceId = dbInstanceId
+		request.StartTime = start.Format(rdsBinlogLayout)
+		request.EndTime = end.Format(rdsBinlogLayout)
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(pageSize)
+
+		response, err := s.client.DescribeBinlogFiles(request)
+		if err != nil {
+			return nil, fmt.Errorf("describing binlog files of RDS instance %s (page %d): %w", dbInstanceId, pageNumber, err)
+		}
+		files = append(files, response.Items.BinLogFile...)
+		if len(response.Items.BinLogFile) < pageSize || len(files) >= response.TotalRecordCount {
+			break
+		}
+		pageNumber++
+	}
+
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].LogBeginTime > files[j].LogBeginTime
+	})
+	return files, nil
+}
+
+// RDSSlowLogRanges are the date ranges in days the slow log page offers. The
+// slow log statistics are kept for 30 days
+var RDSSlowLogRanges = []int{1, 7, 15, 30}
+
+// RDSSlowLogDefaultDays is the date range the slow log page opens with
+const RDSSlowLogDefaultDays = 7
+
+// rdsSlowLogLayout is the date format DescribeSlowLogs accepts, in UTC
+const rdsSlowLogLayout = "2006-01-02Z"
+
+// SlowQuerySummary aggregates the daily slow log statistics of one SQL
+// template over a date range
+type SlowQuerySummary struct {
+	SQLHash      string
+	SQLText      string
+	DBName       string
+	Executions   int64
+	TotalTime    time.Duration
+	MaxTime      time.Duration
+	RowsExamined int64
+	RowsReturned int64
+	Reports      []rds.SQLSlowLog // Daily statistics the summary adds up
+}
+
+// AvgTime returns the average execution time of the template
+func (s SlowQuerySummary) AvgTime() time.Duration {
+	if s.Executions == 0 {
+		return 0
+	}
+	return s.TotalTime / time.Duration(s.Executions)
+}
+
+// AvgRowsExamined returns the average number of rows an execution examined
+func (s SlowQuerySummary) AvgRowsExamined() int64 {
+	if s.Executions == 0 {
+		return 0
+	}
+	return s.RowsExamined / s.Executions
+}
+
+// FetchSlowLogSummary retrieves the slow log statistics of the last days,
+// today included, and sums them up per SQL template, most executed first
+func (s *RDSService) FetchSlowLogSummary(ctx context.Context, dbInstanceId string, days int) ([]SlowQuerySummary, error) {
+	end := time.Now().UTC()
+	start := end.AddDate(0, 0, -(days - 1))
+
+	var logs []rds.SQLSlowLog
+	pageNumber := 1
+	pageSize := 100
+
+	for {
+		request := rds.CreateDescribeSlowLogsRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.DBInstanceId = dbInstanceId
+		request.StartTime = start.Format(rdsSlowLogLayout)
+		request.EndTime = end.Format(rdsSlowLogLayout)
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(pageSize)
+
+		response, err := s.client.DescribeSlowLogs(request)
+		if err != nil {
+			return nil, fmt.Errorf("describing slow logs of RDS instance %s (page %d): %w", dbInstanceId, pageNumber, err)
+		}
+		logs = append(logs, response.Items.SQLSlowLog...)
+		if len(response.Items.SQLSlowLog) < pageSize || len(logs) >= response.TotalRecordCount {
+			break
+		}
+		pageNumber++
+	}
+
+	return summarizeSlowLogs(logs), nil
+}
+
+// summarizeSlowLogs merges the daily statistics by SQL template. MySQL reports
+// total times in seconds and SQL Server in milliseconds
+func summarizeSlowLogs(logs []rds.SQLSlowLog) []SlowQuerySummary {
+	index := make(map[string]int)
+	var summaries []SlowQuerySummary
+
+	for _, l := range logs {
+		key := l.SQLHASH
+		if key == "" {
+			key = l.DBName + "\x00" + l.SQLText
+		}
+		i, ok := index[key]
+		if !ok {
+			i = len(summaries)
+			index[key] = i
+			summaries = append(summaries, SlowQuerySummary{SQLHash: l.SQLHASH, SQLText: l.SQLText, DBName: l.DBName})
+		}
+		sum := &summaries[i]
+
+		var total, peak time.Duration
+		if l.MySQLTotalExecutionCounts == 0 && l.SQLServerTotalExecutionCounts > 0 {
+			sum.Executions += l.SQLServerTotalExecutionCounts
+			total = time.Duration(l.SQLServerTotalExecutionTimes) * time.Millisecond
+		} else {
+			sum.Executions += l.MySQLTotalExecutionCounts
+			total = time.Duration(l.MySQLTotalExecutionTimes) * time.Second
+		}
+		if l.MaxExecutionTimeMS > 0 {
+			peak = time.Duration(l.MaxExecutionTimeMS) * time.Millisecond
+		} else {
+			peak = time.Duration(l.MaxExecutionTime) * time.Second
+		}
+		sum.TotalTime += total
+		if peak > sum.MaxTime {
+			sum.MaxTime = peak
+		}
+		sum.RowsExamined += l.ParseTotalRowCounts
+		sum.RowsReturned += l.ReturnTotalRowCounts
+		sum.Reports = append(sum.Reports, l)
+	}
+
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return summaries[i].Executions > summaries[j].Executions
+	})
+	return summaries
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+)
+
+// Products the recycle bin lists
+const (
+	RecycledProductECS = "ECS"
+	RecycledProductRDS = "RDS"
+)
+
+// Why a resource is in the recycle bin
+const (
+	RecycleReasonExpired   = "Expired"
+	RecycleReasonOverdue   = "Overdue payment"
+	RecycleReasonRecycling = "Recycling"
+	RecycleReasonScheduled = "Scheduled release"
+)
+
+// RecycledResource is a resource that expired, was locked for an overdue
+// payment, or is scheduled to be released, and will be released unless it
+// is renewed or the release is cancelled
+type RecycledResource struct {
+	Product string
+	ID      string
+	Name    string
+	Status  string
+	Reason  string
+	Time    string // When it expired or will be released
+	// Cancellable reports that the release is scheduled and can be
+	// cancelled; expired resources have to be renewed in the console
+	Cancellable bool
+	Raw         interface{} // The instance as the list API reported it
+}
+
+// FetchRecycledInstances returns the ECS instances that expired, are locked
+// or are scheduled to be released
+func (s *ECSService) FetchRecycledInstances(ctx context.Context) ([]RecycledResource, error) {
+	instances, err := s.FetchInstances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var recycled []RecycledResource
+	for _, inst := range instances {
+		reason := ""
+		for _, lock := range inst.OperationLocks.LockReason {
+			switch lock.LockReason {
+			case "financial":
+				reason = RecycleReasonOverdue
+			case "recycling":
+				reason = RecycleReasonRecycling
+			}
+		}
+		if reason == "" && inst.Status == "Expired" {
+			reason = RecycleReasonExpired
+		}
+
+		resource := RecycledResource{
+			Product: RecycledProductECS,
+			ID:      inst.InstanceId,
+			Name:    inst.InstanceName,
+			Status:  inst.Status,
+			Reason:  reason,
+			Time:    inst.ExpiredTime,
+			Raw:     inst,
+		}
+		switch {
+		case reason != "":
+		case inst.AutoReleaseTime != "":
+			resource.Reason = RecycleReasonScheduled
+			resource.Time = inst.AutoReleaseTime
+			resource.Cancellable = true
+		default:
+			continue
+		}
+		recycled = append(recycled, resource)
+	}
+	return recycled, nil
+}
+
+// CancelAutoRelease cancels the scheduled release of an ECS instance
+func (s *ECSService) CancelAutoRelease(instanceId string) error {
+	request := ecs.CreateModifyInstanceAutoReleaseTimeRequest()
+	request.Scheme = "https"
+	request.InstanceId = instanceId
+	// An empty release time cancels the scheduled release
+	request.AutoReleaseTime = ""
+
+	if _, err := s.client.ModifyInstanceAutoReleaseTime(request); err != nil {
+		return fmt.Errorf("cancelling release of instance %s: %w", instanceId, err)
+	}
+	return nil
+}
+
+// FetchRecycledInstances returns the expired RDS instances, which are locked
+// until they are renewed and then released
+func (s *RDSService) FetchRecycledInstances(ctx context.Context) ([]RecycledResource, error) {
+	var instances []rds.DBInstance
+	pageNumber := 1
+	pageSize := 100
+
+	for {
+		request := rds.CreateDescribeDBInstancesRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.Expired = "True"
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(pageSize)
+
+		response, err := s.client.DescribeDBInstances(request)
+		if err != nil {
+			return nil, fmt.Errorf("describing expired RDS instances (page %d): %w", pageNumber, err)
+		}
+		instances = append(instances, response.Items.DBInstance...)
+		if len(response.Items.DBInstance) < pageSize || len(instances) >= response.TotalRecordCount {
+			break
+		}
+		pageNumber++
+	}
+
+	recycled := make([]RecycledResource, len(instances))
+	for i, inst := range instances {
+		reason := RecycleReasonExpired
+		if inst.LockReason != "" {
+			reason = fmt.Sprintf("%s (%s)", RecycleReasonExpired, inst.LockReason)
+		}
+		recycled[i] = RecycledResource{
+			Product: RecycledProductRDS,
+			ID:      inst.DBInstanceId,
+			Name:    inst.DBInstanceDescription,
+			Status:  inst.DBInstanceStatus,
+			Reason:  reason,
+			Time:    inst.ExpireTime,
+			Raw:     inst,
+		}
+	}
+	return recycled, nil
+}
+
+// SortRecycledResources orders the recycle bin by time, soonest first and
+// resources without a time last, then by product and ID
+func SortRecycledResources(resources []RecycledResource) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		a, b := resources[i], resources[j]
+		if (a.Time == "") != (b.Time == "") {
+			return b.Time == ""
+		}
+		if a.Time != b.Time {
+			return a.Time < b.Time
+		}
+		if a.Product != b.Product {
+			return a.Product < b.Product
+		}
+		return a.ID < b.ID
+	})
+}
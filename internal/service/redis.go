@@ -1,14 +1,71 @@
 package service
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
+
+	"aliyun-tui-viewer/internal/cache"
 )
 
 // RedisService handles r-kvstore related operations
 type RedisService struct {
 	client *r_kvstore.Client
+
+	cached
+}
+
+// RedisInstanceAttributes contains the attributes of a Redis instance shown
+// in its detail: the full attribute set, its endpoints and IP whitelists
+type RedisInstanceAttributes struct {
+	Attribute  r_kvstore.DBInstanceAttribute
+	NetInfo    []r_kvstore.InstanceNetInfo
+	Whitelists []r_kvstore.SecurityIpGroup
+}
+
+// RedisParameter is a configuration parameter of a Redis instance with its
+// running value
+type RedisParameter struct {
+	Name         string
+	Value        string
+	Modifiable   bool
+	ForceRestart bool   // Changing it restarts the instance
+	CheckingCode string // Accepted values, e.g. [0-10000] or [yes|no]
+	Description  string
+}
+
+// Monitor keys of DescribeHistoryMonitorValues
+const (
+	redisMemoryUsage     = "memoryUsage"
+	redisUsedConnection  = "UsedConnection"
+	redisConnectionUsage = "connectionUsage"
+	redisUsedQPS         = "UsedQPS"
+	redisHit             = "hit"
+	redisMiss            = "miss"
+)
+
+// redisHitRate is the Metric of the hit rate series, derived from the hits
+// and misses rather than reported
+const redisHitRate = "hitRate"
+
+// redisMonitorLayout is how DescribeHistoryMonitorValues formats times, in UTC
+const redisMonitorLayout = "2006-01-02T15:04:05Z"
+
+// redisMetrics are the Redis metrics shown on the performance page, in
+// display order, as the console's overview shows them
+var redisMetrics = []metricDef{
+	{redisMemoryUsage, "Memory Usage", UnitPercent},
+	{redisUsedConnection, "Connections", "connections"},
+	{redisConnectionUsage, "Connection Usage", UnitPercent},
+	{redisUsedQPS, "QPS", "ops/s"},
+	{redisHitRate, "Hit Rate", UnitPercent},
 }
 
 // NewRedisService creates a new RedisService
@@ -17,9 +74,15 @@ func NewRedisService(client *r_kvstore.Client) *RedisService {
 }
 
 // FetchInstances fetches all Redis instances
-func (s *RedisService) FetchInstances() ([]r_kvstore.KVStoreInstance, error) {
+func (s *RedisService) FetchInstances(ctx context.Context) ([]r_kvstore.KVStoreInstance, error) {
+	return cache.Fetch(ctx, s.cache, CacheRedisInstances, s.fetchInstances)
+}
+
+// fetchInstances calls the API for FetchInstances
+func (s *RedisService) fetchInstances(ctx context.Context) ([]r_kvstore.KVStoreInstance, error) {
 	request := r_kvstore.CreateDescribeInstancesRequest()
 	request.Scheme = "https"
+	bind(ctx, request)
 	// Set PageSize to a large number to fetch all instances in one go,
 	// as pagination might be complex to implement quickly for this new feature.
 	// Max PageSize is typically 50 or 100. Let's use 100.
@@ -33,9 +96,10 @@ func (s *RedisService) FetchInstances() ([]r_kvstore.KVStoreInstance, error) {
 }
 
 // FetchAccounts fetches all accounts for a specific Redis instance
-func (s *RedisService) FetchAccounts(instanceID string) ([]r_kvstore.Account, error) {
+func (s *RedisService) FetchAccounts(ctx context.Context, instanceID string) ([]r_kvstore.Account, error) {
 	request := r_kvstore.CreateDescribeAccountsRequest()
 	request.Scheme = "https"
+	bind(ctx, request)
 	request.InstanceId = instanceID
 
 	response, err := s.client.DescribeAccounts(request)
@@ -45,5 +109,231 @@ func (s *RedisService) FetchAccounts(instanceID string) ([]r_kvstore.Account, er
 	return response.Accounts.Account, nil
 }
 
-// TODO: Add methods for fetching Redis instance details if needed, similar to ECS/RDS.
-// For now, the instance list provides most common details.
+// FetchInstanceAttributes fetches the attributes, endpoints and IP whitelists
+// of a Redis instance
+func (s *RedisService) FetchInstanceAttributes(ctx context.Context, instanceID string) (*RedisInstanceAttributes, error) {
+	request := r_kvstore.CreateDescribeInstanceAttributeRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.InstanceId = instanceID
+
+	response, err := s.client.DescribeInstanceAttribute(request)
+	if err != nil {
+		return nil, fmt.Errorf("fetching redis attributes for instance %s: %w", instanceID, err)
+	}
+	if len(response.Instances.DBInstanceAttribute) == 0 {
+		return nil, fmt.Errorf("redis instance %s not found", instanceID)
+	}
+	attributes := &RedisInstanceAttributes{Attribute: response.Instances.DBInstanceAttribute[0]}
+
+	netRequest := r_kvstore.CreateDescribeDBInstanceNetInfoRequest()
+	netRequest.Scheme = "https"
+	bind(ctx, netRequest)
+	netRequest.InstanceId = instanceID
+
+	netResponse, err := s.client.DescribeDBInstanceNetInfo(netRequest)
+	if err != nil {
+		return nil, fmt.Errorf("fetching redis endpoints for instance %s: %w", instanceID, err)
+	}
+	attributes.NetInfo = netResponse.NetInfoItems.InstanceNetInfo
+
+	ipRequest := r_kvstore.CreateDescribeSecurityIpsRequest()
+	ipRequest.Scheme = "https"
+	bind(ctx, ipRequest)
+	ipRequest.InstanceId = instanceID
+
+	ipResponse, err := s.client.DescribeSecurityIps(ipRequest)
+	if err != nil {
+		return nil, fmt.Errorf("fetching redis whitelists for instance %s: %w", instanceID, err)
+	}
+	attributes.Whitelists = ipResponse.SecurityIpGroups.SecurityIpGroup
+	return attributes, nil
+}
+
+// ModifyMaintenanceWindow changes the daily maintenance window of an instance
+func (s *RedisService) ModifyMaintenanceWindow(instanceID string, window MaintenanceWindow) error {
+	request := r_kvstore.CreateModifyInstanceMaintainTimeRequest()
+	request.Scheme = "https"
+	request.InstanceId = instanceID
+	request.MaintainStartTime = window.Start
+	request.MaintainEndTime = window.End
+
+	if _, err := s.client.ModifyInstanceMaintainTime(request); err != nil {
+		return fmt.Errorf("modifying maintenance window of Redis instance %s: %w", instanceID, err)
+	}
+	return nil
+}
+
+// FetchParameters retrieves the configuration parameters of an instance,
+// sorted by name. The running parameters carry the current values; the
+// configurable ones say which can be changed
+func (s *RedisService) FetchParameters(ctx context.Context, instanceID string) ([]RedisParameter, error) {
+	request := r_kvstore.CreateDescribeParametersRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.DBInstanceId = instanceID
+
+	response, err := s.client.DescribeParameters(request)
+	if err != nil {
+		return nil, fmt.Errorf("fetching redis parameters for instance %s: %w", instanceID, err)
+	}
+
+	byName := make(map[string]*RedisParameter)
+	var params []*RedisParameter
+	for _, p := range response.RunningParameters.Parameter {
+		param := &RedisParameter{
+			Name:         p.ParameterName,
+			Value:        p.ParameterValue,
+			Modifiable:   p.ModifiableStatus == "true",
+			ForceRestart: p.ForceRestart == "true",
+			CheckingCode: p.CheckingCode,
+			Description:  p.ParameterDescription,
+		}
+		byName[p.ParameterName] = param
+		params = append(params, param)
+	}
+	for _, p := range response.ConfigParameters.Parameter {
+		if param, ok := byName[p.ParameterName]; ok {
+			param.Modifiable = p.ModifiableStatus
+			param.ForceRestart = p.ForceRestart
+			if param.CheckingCode == "" {
+				param.CheckingCode = p.CheckingCode
+			}
+			continue
+		}
+		params = append(params, &RedisParameter{
+			Name:         p.ParameterName,
+			Value:        p.ParameterValue,
+			Modifiable:   p.ModifiableStatus,
+			ForceRestart: p.ForceRestart,
+			CheckingCode: p.CheckingCode,
+			Description:  p.ParameterDescription,
+		})
+	}
+
+	result := make([]RedisParameter, len(params))
+	for i, p := range params {
+		result[i] = *p
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+// FetchMetrics retrieves the performance metrics of an instance over the
+// last window at one-minute intervals: memory usage, connections, QPS and the
+// hit rate, which is computed from the hits and misses of each interval
+func (s *RedisService) FetchMetrics(ctx context.Context, instanceID string, window time.Duration) ([]MetricSeries, error) {
+	end := time.Now().UTC()
+	start := end.Add(-window)
+
+	request := r_kvstore.CreateDescribeHistoryMonitorValuesRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.InstanceId = instanceID
+	request.StartTime = start.Format(redisMonitorLayout)
+	request.EndTime = end.Format(redisMonitorLayout)
+	request.IntervalForHistory = "01m"
+	request.MonitorKeys = strings.Join([]string{
+		redisMemoryUsage, redisUsedConnection, redisConnectionUsage, redisUsedQPS, redisHit, redisMiss,
+	}, ",")
+
+	response, err := s.client.DescribeHistoryMonitorValues(request)
+	if err != nil {
+		return nil, fmt.Errorf("fetching redis metrics for instance %s: %w", instanceID, err)
+	}
+
+	// MonitorHistory maps each time to the values of the keys, as strings
+	var history map[string]map[string]string
+	if response.MonitorHistory != "" {
+		if err := json.Unmarshal([]byte(response.MonitorHistory), &history); err != nil {
+			return nil, fmt.Errorf("parsing redis metrics for instance %s: %w", instanceID, err)
+		}
+	}
+
+	times := make([]time.Time, 0, len(history))
+	values := make(map[time.Time]map[string]string, len(history))
+	for stamp, keys := range history {
+		t, err := time.Parse(redisMonitorLayout, stamp)
+		if err != nil {
+			continue
+		}
+		times = append(times, t.Local())
+		values[t.Local()] = keys
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+	series := make([]MetricSeries, len(redisMetrics))
+	for i, metric := range redisMetrics {
+		series[i] = MetricSeries{Metric: metric.name, Label: metric.label, Unit: metric.unit}
+		for _, t := range times {
+			value, ok := redisMetricValue(values[t], metric.name)
+			if ok {
+				series[i].Points = append(series[i].Points, MetricPoint{Time: t, Value: value})
+			}
+		}
+	}
+	return series, nil
+}
+
+// redisMetricValue reads a metric from the values of one interval. The hit
+// rate is the share of hits in the lookups, unknown when there were none
+func redisMetricValue(keys map[string]string, metric string) (float64, bool) {
+	number := func(key string) (float64, bool) {
+		v, err := strconv.ParseFloat(keys[key], 64)
+		return v, err == nil
+	}
+	if metric != redisHitRate {
+		return number(metric)
+	}
+
+	hits, okHits := number(redisHit)
+	misses, okMisses := number(redisMiss)
+	if !okHits || !okMisses || hits+misses == 0 {
+		return 0, false
+	}
+	return hits / (hits + misses) * 100, true
+}
+
+// RedisBackupWindow is how far back the backups page looks: backups are kept
+// for 7 days unless the retention period was extended
+const RedisBackupWindow = 7 * 24 * time.Hour
+
+// redisBackupLayout is the time format DescribeBackups accepts, in UTC
+const redisBackupLayout = "2006-01-02T15:04Z"
+
+// FetchBackups retrieves the backup sets of an instance started within the
+// last window, newest first
+func (s *RedisService) FetchBackups(ctx context.Context, instanceID string, window time.Duration) ([]r_kvstore.Backup, error) {
+	end := time.Now().UTC()
+	start := end.Add(-window)
+
+	var backups []r_kvstore.Backup
+	pageNumber := 1
+	pageSize := 100
+
+	for {
+		request := r_kvstore.CreateDescribeBackupsRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.InstanceId = instanceID
+		request.StartTime = start.Format(redisBackupLayout)
+		request.EndTime = end.Format(redisBackupLayout)
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(pageSize)
+
+		response, err := s.client.DescribeBackups(request)
+		if err != nil {
+			return nil, fmt.Errorf("fetching redis backups for instance %s (page %d): %w", instanceID, pageNumber, err)
+		}
+		backups = append(backups, response.Backups.Backup...)
+		if len(response.Backups.Backup) < pageSize || len(backups) >= response.TotalCount {
+			break
+		}
+		pageNumber++
+	}
+
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backups[i].BackupStartTime > backups[j].BackupStartTime
+	})
+	return backups, nil
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -12,6 +13,7 @@ import (
 	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
 	resourcecenter "github.com/alibabacloud-go/resourcecenter-20221201/client"
 	"github.com/alibabacloud-go/tea/tea"
+	credential "github.com/aliyun/credentials-go/credentials"
 
 	"aliyun-tui-viewer/internal/i18n"
 )
@@ -21,6 +23,10 @@ type RegionCache struct {
 	Profile   string    `json:"profile"`
 	Regions   []string  `json:"regions"`
 	UpdatedAt time.Time `json:"updated_at"`
+
+	// Counts holds the number of resources per region and resource type,
+	// e.g. Counts["cn-hangzhou"]["ACS::ECS::Instance"]
+	Counts map[string]map[string]int `json:"counts,omitempty"`
 }
 
 // RegionCacheFile represents the cache file structure
@@ -30,67 +36,105 @@ type RegionCacheFile struct {
 
 // RegionService provides region-related operations
 type RegionService struct {
-	accessKeyID     string
-	accessKeySecret string
-	profile         string
-	cacheExpiry     time.Duration
+	credential  credential.Credential
+	profile     string
+	cacheExpiry time.Duration
 }
 
 // NewRegionService creates a new RegionService
-func NewRegionService(accessKeyID, accessKeySecret, profile string) *RegionService {
+func NewRegionService(credential credential.Credential, profile string) *RegionService {
 	return &RegionService{
-		accessKeyID:     accessKeyID,
-		accessKeySecret: accessKeySecret,
-		profile:         profile,
-		cacheExpiry:     7 * 24 * time.Hour, // 7 days
+		credential:  credential,
+		profile:     profile,
+		cacheExpiry: 7 * 24 * time.Hour, // 7 days
 	}
 }
 
 // GetRegionsWithResources returns the list of regions where the account has resources
-func (s *RegionService) GetRegionsWithResources() ([]string, error) {
+func (s *RegionService) GetRegionsWithResources(ctx context.Context) ([]string, error) {
 	// Check cache first
 	if cached := s.loadCache(); cached != nil && !s.isCacheExpired(cached) {
 		return cached.Regions, nil
 	}
 
 	// Fetch from API
-	regions, err := s.fetchRegionsFromAPI()
+	regions, counts, err := s.fetchRegionsFromAPI(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Save to cache
-	s.saveCache(regions)
+	s.saveCache(regions, counts)
 
 	return regions, nil
 }
 
 // ForceRefresh forces a refresh of the region list, bypassing cache
-func (s *RegionService) ForceRefresh() ([]string, error) {
-	regions, err := s.fetchRegionsFromAPI()
+func (s *RegionService) ForceRefresh(ctx context.Context) ([]string, error) {
+	regions, counts, err := s.fetchRegionsFromAPI(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	s.saveCache(regions)
+	s.saveCache(regions, counts)
 	return regions, nil
 }
 
-// fetchRegionsFromAPI fetches regions from Aliyun Resource Center API
-func (s *RegionService) fetchRegionsFromAPI() ([]string, error) {
+// GetResourceCounts returns how many resources of a Resource Center type
+// (e.g. ACS::ECS::Instance) each region holds, leaving out regions without
+// any. The counts share the region cache, so they can be a few days old
+func (s *RegionService) GetResourceCounts(ctx context.Context, resourceType string) (map[string]int, error) {
+	cached := s.loadCache()
+	if cached == nil || s.isCacheExpired(cached) || cached.Counts == nil {
+		// Caches written before counts were recorded have none
+		regions, counts, err := s.fetchRegionsFromAPI(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.saveCache(regions, counts)
+		cached = &RegionCache{Regions: regions, Counts: counts}
+	}
+
+	result := make(map[string]int)
+	for region, types := range cached.Counts {
+		if n := types[resourceType]; n > 0 {
+			result[region] = n
+		}
+	}
+	return result, nil
+}
+
+// RefreshResourceCounts scans the account's resources again, updating the
+// cache, and returns the number of each resource type in region
+func (s *RegionService) RefreshResourceCounts(ctx context.Context, region string) (map[string]int, error) {
+	regions, counts, err := s.fetchRegionsFromAPI(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.saveCache(regions, counts)
+
+	result := make(map[string]int, len(counts[region]))
+	for resourceType, n := range counts[region] {
+		result[resourceType] = n
+	}
+	return result, nil
+}
+
+// fetchRegionsFromAPI fetches regions from Aliyun Resource Center API, with
+// the number of resources of each type in every region
+func (s *RegionService) fetchRegionsFromAPI(ctx context.Context) ([]string, map[string]map[string]int, error) {
 	// Create Resource Center client
 	config := &openapi.Config{
-		AccessKeyId:     tea.String(s.accessKeyID),
-		AccessKeySecret: tea.String(s.accessKeySecret),
-		Endpoint:        tea.String("resourcecenter.aliyuncs.com"),
+		Credential: s.credential,
+		Endpoint:   tea.String("resourcecenter.aliyuncs.com"),
 	}
 
 	client, err := resourcecenter.NewClient(config)
 	if err != nil {
-		return nil, fmt.Errorf("creating resource center client: %w", err)
+		return nil, nil, fmt.Errorf("creating resource center client: %w", err)
 	}
 
-	regionSet := make(map[string]bool)
+	counts := make(map[string]map[string]int)
 	var nextToken *string
 
 	for {
@@ -101,7 +145,7 @@ func (s *RegionService) fetchRegionsFromAPI() ([]string, error) {
 
 		response, err := client.SearchResources(request)
 		if err != nil {
-			return nil, fmt.Errorf("searching resources: %w", err)
+			return nil, nil, fmt.Errorf("searching resources: %w", err)
 		}
 
 		if response.Body == nil || response.Body.Resources == nil {
@@ -110,7 +154,11 @@ func (s *RegionService) fetchRegionsFromAPI() ([]string, error) {
 
 		for _, resource := range response.Body.Resources {
 			if resource.RegionId != nil && *resource.RegionId != "" {
-				regionSet[*resource.RegionId] = true
+				region := *resource.RegionId
+				if counts[region] == nil {
+					counts[region] = make(map[string]int)
+				}
+				counts[region][tea.StringValue(resource.ResourceType)]++
 			}
 		}
 
@@ -122,13 +170,13 @@ func (s *RegionService) fetchRegionsFromAPI() ([]string, error) {
 	}
 
 	// Convert set to sorted slice
-	regions := make([]string, 0, len(regionSet))
-	for region := range regionSet {
+	regions := make([]string, 0, len(counts))
+	for region := range counts {
 		regions = append(regions, region)
 	}
 	sort.Strings(regions)
 
-	return regions, nil
+	return regions, counts, nil
 }
 
 // getCachePath returns the path to the cache file
@@ -165,7 +213,7 @@ func (s *RegionService) loadCache() *RegionCache {
 }
 
 // saveCache saves the regions to cache for the current profile
-func (s *RegionService) saveCache(regions []string) error {
+func (s *RegionService) saveCache(regions []string, counts map[string]map[string]int) error {
 	cachePath, err := s.getCachePath()
 	if err != nil {
 		return err
@@ -188,6 +236,7 @@ func (s *RegionService) saveCache(regions []string) error {
 		Profile:   s.profile,
 		Regions:   regions,
 		UpdatedAt: time.Now(),
+		Counts:    counts,
 	}
 
 	// Write cache file
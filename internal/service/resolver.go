@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/resourcemanager"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+)
+
+// ResourceKind identifies the kind of resource an ID refers to
+type ResourceKind string
+
+// Kinds of referenced resources the resolver can name
+const (
+	KindSecurityGroup ResourceKind = "security-group"
+	KindVPC           ResourceKind = "vpc"
+	KindVSwitch       ResourceKind = "vswitch"
+	KindImage         ResourceKind = "image"
+	KindResourceGroup ResourceKind = "resource-group"
+)
+
+// Batch sizes accepted by the describe APIs for ID lists
+const (
+	resolveSecurityGroupBatch = 100
+	resolveImageBatch         = 100
+	resolveVPCBatch           = 20
+	resolveResourceGroupBatch = 100
+)
+
+// NameRefs collects referenced IDs by kind
+type NameRefs map[ResourceKind][]string
+
+// Add records ids of the given kind, skipping empty and "-" placeholders
+func (r NameRefs) Add(kind ResourceKind, ids ...string) {
+	for _, id := range ids {
+		if id != "" && id != "-" {
+			r[kind] = append(r[kind], id)
+		}
+	}
+}
+
+// NameResolver translates resource IDs into their names. Names are fetched
+// lazily in batches and cached for the lifetime of the resolver; IDs that
+// could not be found are cached too so they are not requested again
+type NameResolver struct {
+	ecs *ecs.Client
+	vpc *vpc.Client
+	rm  *resourcemanager.Client
+
+	mu    sync.RWMutex
+	names map[ResourceKind]map[string]string
+}
+
+// NewNameResolver creates a new name resolver
+func NewNameResolver(ecsClient *ecs.Client, vpcClient *vpc.Client, rmClient *resourcemanager.Client) *NameResolver {
+	return &NameResolver{
+		ecs:   ecsClient,
+		vpc:   vpcClient,
+		rm:    rmClient,
+		names: make(map[ResourceKind]map[string]string),
+	}
+}
+
+// Name returns the cached name of a resource. ok is false when the name is
+// unknown, has not been fetched yet, or the resource has no name
+func (r *NameResolver) Name(kind ResourceKind, id string) (name string, ok bool) {
+	if r == nil {
+		return "", false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	name = r.names[kind][id]
+	return name, name != ""
+}
+
+// Label returns "name (id)" when the name is known and id otherwise
+func (r *NameResolver) Label(kind ResourceKind, id string) string {
+	if name, ok := r.Name(kind, id); ok && name != id {
+		return fmt.Sprintf("%s (%s)", name, id)
+	}
+	return id
+}
+
+// Missing returns the referenced IDs that have not been looked up yet
+func (r *NameResolver) Missing(refs NameRefs) NameRefs {
+	missing := make(NameRefs)
+	if r == nil {
+		return missing
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for kind, ids := range refs {
+		seen := make(map[string]bool)
+		for _, id := range ids {
+			if _, cached := r.names[kind][id]; cached || seen[id] {
+				continue
+			}
+			seen[id] = true
+			missing[kind] = append(missing[kind], id)
+		}
+	}
+	return missing
+}
+
+// Resolve fetches the names of referenced IDs that are not cached yet. Kinds
+// that fail are reported in the returned error; the others are still cached
+func (r *NameResolver) Resolve(ctx context.Context, refs NameRefs) error {
+	var errs []string
+	for kind, ids := range r.Missing(refs) {
+		found, err := r.fetch(ctx, kind, ids)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", kind, err))
+			continue
+		}
+		r.store(kind, ids, found)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("resolving names: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// store caches the names found for ids, remembering the ones that were not found
+func (r *NameResolver) store(kind ResourceKind, ids []string, found map[string]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.names[kind] == nil {
+		r.names[kind] = make(map[string]string)
+	}
+	for _, id := range ids {
+		r.names[kind][id] = found[id]
+	}
+}
+
+// fetch looks up the names of ids of one kind
+func (r *NameResolver) fetch(ctx context.Context, kind ResourceKind, ids []string) (map[string]string, error) {
+	switch kind {
+	case KindSecurityGroup:
+		return r.fetchSecurityGroups(ctx, ids)
+	case KindVPC:
+		return r.fetchVPCs(ctx, ids)
+	case KindVSwitch:
+		return r.fetchVSwitches(ctx, ids)
+	case KindImage:
+		return r.fetchImages(ctx, ids)
+	case KindResourceGroup:
+		return r.fetchResourceGroups(ctx, ids)
+	default:
+		return nil, fmt.Errorf("unsupported resource kind")
+	}
+}
+
+// fetchSecurityGroups looks up security group names in batches
+func (r *NameResolver) fetchSecurityGroups(ctx context.Context, ids []string) (map[string]string, error) {
+	found := make(map[string]string)
+	for _, batch := range chunkIDs(ids, resolveSecurityGroupBatch) {
+		idsJSON, err := json.Marshal(batch)
+		if err != nil {
+			return nil, fmt.Errorf("encoding security group IDs: %w", err)
+		}
+
+		request := ecs.CreateDescribeSecurityGroupsRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.SecurityGroupIds = string(idsJSON)
+		request.PageSize = requests.NewInteger(resolveSecurityGroupBatch)
+
+		response, err := r.ecs.DescribeSecurityGroups(request)
+		if err != nil {
+			return nil, fmt.Errorf("describing security groups: %w", err)
+		}
+		for _, sg := range response.SecurityGroups.SecurityGroup {
+			found[sg.SecurityGroupId] = sg.SecurityGroupName
+		}
+	}
+	return found, nil
+}
+
+// fetchVPCs looks up VPC names in batches
+func (r *NameResolver) fetchVPCs(ctx context.Context, ids []string) (map[string]string, error) {
+	found := make(map[string]string)
+	for _, batch := range chunkIDs(ids, resolveVPCBatch) {
+		request := vpc.CreateDescribeVpcsRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.VpcId = strings.Join(batch, ",")
+		request.PageSize = requests.NewInteger(50)
+
+		response, err := r.vpc.DescribeVpcs(request)
+		if err != nil {
+			return nil, fmt.Errorf("describing VPCs: %w", err)
+		}
+		for _, v := range response.Vpcs.Vpc {
+			found[v.VpcId] = v.VpcName
+		}
+	}
+	return found, nil
+}
+
+// fetchVSwitches looks up vSwitch names one at a time, as DescribeVSwitches
+// only filters by a single vSwitch ID
+func (r *NameResolver) fetchVSwitches(ctx context.Context, ids []string) (map[string]string, error) {
+	found := make(map[string]string)
+	for _, id := range ids {
+		request := vpc.CreateDescribeVSwitchesRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.VSwitchId = id
+
+		response, err := r.vpc.DescribeVSwitches(request)
+		if err != nil {
+			return nil, fmt.Errorf("describing vSwitch %s: %w", id, err)
+		}
+		for _, vsw := range response.VSwitches.VSwitch {
+			found[vsw.VSwitchId] = vsw.VSwitchName
+		}
+	}
+	return found, nil
+}
+
+// fetchImages looks up image names in batches, including deprecated images
+// that running instances may still use
+func (r *NameResolver) fetchImages(ctx context.Context, ids []string) (map[string]string, error) {
+	found := make(map[string]string)
+	for _, batch := range chunkIDs(ids, resolveImageBatch) {
+		request := ecs.CreateDescribeImagesRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.ImageId = strings.Join(batch, ",")
+		request.ShowExpired = requests.NewBoolean(true)
+		request.PageSize = requests.NewInteger(resolveImageBatch)
+
+		response, err := r.ecs.DescribeImages(request)
+		if err != nil {
+			return nil, fmt.Errorf("describing images: %w", err)
+		}
+		for _, image := range response.Images.Image {
+			found[image.ImageId] = image.ImageName
+		}
+	}
+	return found, nil
+}
+
+// fetchResourceGroups looks up resource group display names in batches
+func (r *NameResolver) fetchResourceGroups(ctx context.Context, ids []string) (map[string]string, error) {
+	found := make(map[string]string)
+	for _, batch := range chunkIDs(ids, resolveResourceGroupBatch) {
+		request := resourcemanager.CreateListResourceGroupsRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.ResourceGroupIds = &batch
+		request.PageSize = requests.NewInteger(resolveResourceGroupBatch)
+
+		response, err := r.rm.ListResourceGroups(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing resource groups: %w", err)
+		}
+		for _, group := range response.ResourceGroups.ResourceGroup {
+			name := group.DisplayName
+			if name == "" {
+				name = group.Name
+			}
+			found[group.Id] = name
+		}
+	}
+	return found, nil
+}
+
+// chunkIDs splits ids into batches of at most size
+func chunkIDs(ids []string, size int) [][]string {
+	var batches [][]string
+	for len(ids) > size {
+		batches = append(batches, ids[:size])
+		ids = ids[size:]
+	}
+	if len(ids) > 0 {
+		batches = append(batches, ids)
+	}
+	return batches
+}
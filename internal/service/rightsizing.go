@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+)
+
+// RightsizingWindow is the CloudMonitor history used for sizing recommendations
+const RightsizingWindow = 14 * 24 * time.Hour
+
+// rightsizingPeriod is the aggregation period of the sizing history
+const rightsizingPeriod = time.Hour
+
+// Utilization thresholds, as the p95 of hourly averages
+const (
+	downsizeBelow   = 20.0 // Suggest a smaller type when CPU p95 stays below this
+	upsizeAbove     = 80.0 // Suggest a larger type when CPU p95 exceeds this
+	targetCPUUsage  = 60.0 // CPU p95 aimed at on the suggested type
+	targetMemoryUse = 80.0 // Memory p95 aimed at on the suggested type
+)
+
+// Sizing actions of a recommendation
+const (
+	SizingKeep     = "keep"
+	SizingDownsize = "downsize"
+	SizingUpsize   = "upsize"
+)
+
+// ECSUtilization is the p95 utilization of an instance over a window
+type ECSUtilization struct {
+	Window    time.Duration
+	CPUP95    float64
+	MemoryP95 float64
+	HasMemory bool // Memory is only reported by the CloudMonitor agent
+	Samples   int  // Hourly CPU data points the percentiles are computed from
+}
+
+// SizingRecommendation is a right-sizing hint for an ECS instance
+type SizingRecommendation struct {
+	Utilization ECSUtilization
+	Action      string
+	Suggested   *ecs.InstanceType // nil when no type of the family fits better
+}
+
+// FetchECSUtilization retrieves the p95 CPU and memory utilization of an
+// instance from hourly averages over the last window
+func (s *MonitorService) FetchECSUtilization(ctx context.Context, instanceID string, window time.Duration) (ECSUtilization, error) {
+	end := time.Now()
+	start := end.Add(-window)
+	dimensions, err := json.Marshal([]map[string]string{{"instanceId": instanceID}})
+	if err != nil {
+		return ECSUtilization{}, fmt.Errorf("encoding metric dimensions: %w", err)
+	}
+
+	cpu, err := s.fetchMetric(ctx, ecsMetricNamespace, "CPUUtilization", string(dimensions), rightsizingPeriod, start, end)
+	if err != nil {
+		return ECSUtilization{}, err
+	}
+	usage := ECSUtilization{Window: window, Samples: len(cpu)}
+	if len(cpu) == 0 {
+		return usage, nil
+	}
+	usage.CPUP95 = percentile(MetricSeries{Points: cpu}.Values(), 95)
+
+	// Missing memory data only makes the recommendation CPU-based
+	if memory, err := s.fetchMetric(ctx, ecsMetricNamespace, "memory_usedutilization", string(dimensions), rightsizingPeriod, start, end); err == nil && len(memory) > 0 {
+		usage.MemoryP95 = percentile(MetricSeries{Points: memory}.Values(), 95)
+		usage.HasMemory = true
+	}
+	return usage, nil
+}
+
+// FetchInstanceTypes retrieves the instance types of a family, e.g. ecs.g7
+func (s *ECSService) FetchInstanceTypes(ctx context.Context, family string) ([]ecs.InstanceType, error) {
+	var allTypes []ecs.InstanceType
+	nextToken := ""
+
+	for {
+		request := ecs.CreateDescribeInstanceTypesRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.InstanceTypeFamily = family
+		request.MaxResults = requests.NewInteger(100)
+		request.NextToken = nextToken
+
+		response, err := s.client.DescribeInstanceTypes(request)
+		if err != nil {
+			return nil, fmt.Errorf("describing instance types of family %s: %w", family, err)
+		}
+		allTypes = append(allTypes, response.InstanceTypes.InstanceType...)
+
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+
+	return allTypes, nil
+}
+
+// RecommendInstanceType suggests a type of the instance's own family sized
+// for its utilization: the smallest type keeping CPU p95 near targetCPUUsage
+// and, when memory is reported, memory p95 below targetMemoryUse
+func RecommendInstanceType(inst ecs.Instance, usage ECSUtilization, types []ecs.InstanceType) SizingRecommendation {
+	rec := SizingRecommendation{Utilization: usage, Action: SizingKeep}
+	if usage.Samples == 0 || inst.Cpu == 0 {
+		return rec
+	}
+	switch {
+	case usage.CPUP95 < downsizeBelow:
+		rec.Action = SizingDownsize
+	case usage.CPUP95 > upsizeAbove:
+		rec.Action = SizingUpsize
+	default:
+		return rec
+	}
+
+	neededCPU := float64(inst.Cpu) * usage.CPUP95 / targetCPUUsage
+	neededMemory := 0.0 // GiB
+	if usage.HasMemory {
+		neededMemory = float64(inst.Memory) / 1024 * usage.MemoryP95 / targetMemoryUse
+	}
+
+	// Smallest fitting type first
+	sort.Slice(types, func(i, j int) bool {
+		if types[i].CpuCoreCount != types[j].CpuCoreCount {
+			return types[i].CpuCoreCount < types[j].CpuCoreCount
+		}
+		return types[i].MemorySize < types[j].MemorySize
+	})
+	for i, t := range types {
+		if float64(t.CpuCoreCount) < neededCPU || t.MemorySize < neededMemory {
+			continue
+		}
+		if t.InstanceTypeId == inst.InstanceType {
+			rec.Action = SizingKeep // e.g. low CPU but memory-bound
+			break
+		}
+		isSmaller := t.CpuCoreCount < inst.Cpu
+		if isSmaller == (rec.Action == SizingDownsize) {
+			rec.Suggested = &types[i]
+		}
+		break
+	}
+	return rec
+}
+
+// percentile returns the p-th percentile of values by nearest rank
+func percentile(values []float64, p float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(rank-1, 0)]
+}
@@ -1,20 +1,41 @@
 package service
 
 import (
+	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	ons20190214 "github.com/alibabacloud-go/ons-20190214/v3/client"
 	"github.com/alibabacloud-go/tea/tea"
+
+	"aliyun-tui-viewer/internal/cache"
+	"aliyun-tui-viewer/internal/client"
+)
+
+// rocketMQLagConcurrency bounds the per-group accumulation lookups
+const rocketMQLagConcurrency = 8
+
+// rocketMQMessagePageSize is the largest page OnsMessagePageQueryByTopic
+// returns, and rocketMQMessageLimit the most messages fetched for browsing
+const (
+	rocketMQMessagePageSize = 50
+	rocketMQMessageLimit    = 200
 )
 
-// RocketMQService handles RocketMQ operations
+// RocketMQService handles RocketMQ operations. 4.x instances are served by
+// the ONS API and 5.x instances by the RocketMQ 5.x API; the instance ID tells
+// which one an instance belongs to
 type RocketMQService struct {
-	client *ons20190214.Client
+	client   *ons20190214.Client
+	v5Client *client.RocketMQ5Client
+
+	cached
 }
 
 // NewRocketMQService creates a new RocketMQService
-func NewRocketMQService(client *ons20190214.Client) *RocketMQService {
-	return &RocketMQService{client: client}
+func NewRocketMQService(client *ons20190214.Client, v5Client *client.RocketMQ5Client) *RocketMQService {
+	return &RocketMQService{client: client, v5Client: v5Client}
 }
 
 // RocketMQInstance represents a RocketMQ instance
@@ -28,6 +49,28 @@ type RocketMQInstance struct {
 	ReleaseTime    int64  `json:"releaseTime"`
 	Remark         string `json:"remark"`
 	ServiceVersion int32  `json:"serviceVersion"`
+	APIVersion     string `json:"apiVersion"`        // RocketMQAPIVersion4 or RocketMQAPIVersion5
+	Edition        string `json:"edition,omitempty"` // Set for 5.x instances, whose edition has no InstanceType code
+}
+
+// RocketMQInstanceInfo is the base information of a RocketMQ instance: its
+// endpoints and the limits of its edition
+type RocketMQInstanceInfo struct {
+	InstanceId                 string `json:"instanceId"`
+	InstanceName               string `json:"instanceName"`
+	InstanceType               int32  `json:"instanceType"`
+	InstanceStatus             int32  `json:"instanceStatus"`
+	CreateTime                 string `json:"createTime"`
+	ReleaseTime                int64  `json:"releaseTime"` // Unix milliseconds
+	Remark                     string `json:"remark"`
+	MaxTps                     int64  `json:"maxTps"`
+	TopicCapacity              int32  `json:"topicCapacity"`
+	IndependentNaming          bool   `json:"independentNaming"` // Topics and groups have their own namespace
+	TcpEndpoint                string `json:"tcpEndpoint"`
+	TcpInternetEndpoint        string `json:"tcpInternetEndpoint"`
+	HttpInternalEndpoint       string `json:"httpInternalEndpoint"`
+	HttpInternetEndpoint       string `json:"httpInternetEndpoint"`
+	HttpInternetSecureEndpoint string `json:"httpInternetSecureEndpoint"`
 }
 
 // RocketMQTopic represents a RocketMQ topic
@@ -40,6 +83,10 @@ type RocketMQTopic struct {
 	Remark      string `json:"remark"`
 	Status      int32  `json:"status"`
 	Perm        int32  `json:"perm"`
+
+	TotalDiff     int64              `json:"totalDiff"`               // Messages not consumed yet, over all subscribed groups
+	Subscriptions []RocketMQTopicLag `json:"subscriptions,omitempty"` // Accumulation of each subscribed group
+	LagErr        error              `json:"-"`                       // Set when the groups' accumulation could not be read
 }
 
 // RocketMQGroup represents a RocketMQ consumer group
@@ -50,12 +97,81 @@ type RocketMQGroup struct {
 	CreateTime int64  `json:"createTime"`
 	UpdateTime int64  `json:"updateTime"`
 	Remark     string `json:"remark"`
+	APIVersion string `json:"apiVersion"`
+
+	Lag    *RocketMQGroupLag `json:"lag,omitempty"`
+	LagErr error             `json:"-"` // Set when the accumulation could not be read
+}
+
+// RocketMQGroupLag is the message accumulation of a consumer group
+type RocketMQGroupLag struct {
+	Online        bool               `json:"online"`        // Not reported for 5.x groups
+	TotalDiff     int64              `json:"totalDiff"`     // Messages not consumed yet, over all subscribed topics
+	DelayTime     int64              `json:"delayTime"`     // Milliseconds the group is behind
+	ConsumeTps    float32            `json:"consumeTps"`    // Messages consumed per second, not reported for 5.x groups
+	LastTimestamp int64              `json:"lastTimestamp"` // Unix milliseconds of the last consumed message
+	Topics        []RocketMQTopicLag `json:"topics"`
+}
+
+// RocketMQTopicLag is the accumulation of a consumer group on one of the
+// topics it subscribes to
+type RocketMQTopicLag struct {
+	GroupId       string `json:"groupId"`
+	Topic         string `json:"topic"`
+	TotalDiff     int64  `json:"totalDiff"`
+	DelayTime     int64  `json:"delayTime"`     // Milliseconds
+	LastTimestamp int64  `json:"lastTimestamp"` // Unix milliseconds
 }
 
-// FetchInstances retrieves all RocketMQ instances
-func (s *RocketMQService) FetchInstances() ([]RocketMQInstance, error) {
+// RocketMQMessage is a message stored in a topic
+type RocketMQMessage struct {
+	MsgId          string            `json:"msgId"`
+	Topic          string            `json:"topic"`
+	Tag            string            `json:"tag"`
+	Keys           string            `json:"keys"`
+	BornHost       string            `json:"bornHost"`
+	BornTimestamp  int64             `json:"bornTimestamp"` // Unix milliseconds
+	StoreHost      string            `json:"storeHost"`
+	StoreTimestamp int64             `json:"storeTimestamp"` // Unix milliseconds
+	StoreSize      int32             `json:"storeSize"`      // Bytes
+	ReconsumeTimes int32             `json:"reconsumeTimes"`
+	Properties     map[string]string `json:"properties"`
+}
+
+// FetchInstances retrieves all RocketMQ instances, 4.x and 5.x. An account
+// that does not use one of the generations may be refused by its API, so the
+// error of one is only returned when the other fails too
+func (s *RocketMQService) FetchInstances(ctx context.Context) ([]RocketMQInstance, error) {
+	return cache.Fetch(ctx, s.cache, CacheRocketMQInstances, s.fetchInstances)
+}
+
+// fetchInstances calls the API for FetchInstances
+func (s *RocketMQService) fetchInstances(ctx context.Context) ([]RocketMQInstance, error) {
+	var v5Instances []RocketMQInstance
+	var v5Err error
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		v5Instances, v5Err = s.fetchInstances5(ctx)
+	}()
+
+	instances, err := s.fetchInstances4(ctx)
+	<-done
+	if err != nil && v5Err != nil {
+		return nil, err
+	}
+	return append(instances, v5Instances...), nil
+}
+
+// fetchInstances4 retrieves the RocketMQ 4.x instances
+func (s *RocketMQService) fetchInstances4(ctx context.Context) ([]RocketMQInstance, error) {
 	request := &ons20190214.OnsInstanceInServiceListRequest{}
 
+	// The ONS client takes no context, so a cancelled ctx only stops calls
+	// that have not gone out yet
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	response, err := s.client.OnsInstanceInServiceList(request)
 	if err != nil {
 		return nil, fmt.Errorf("fetching RocketMQ instances: %w", err)
@@ -74,6 +190,7 @@ func (s *RocketMQService) FetchInstances() ([]RocketMQInstance, error) {
 				ReleaseTime:    tea.Int64Value(inst.ReleaseTime),
 				Remark:         "", // Field not available in API response
 				ServiceVersion: 0,  // Field not available in API response
+				APIVersion:     RocketMQAPIVersion4,
 			}
 			instances = append(instances, instance)
 		}
@@ -83,11 +200,18 @@ func (s *RocketMQService) FetchInstances() ([]RocketMQInstance, error) {
 }
 
 // FetchTopics retrieves all topics for a specific RocketMQ instance
-func (s *RocketMQService) FetchTopics(instanceId string) ([]RocketMQTopic, error) {
+func (s *RocketMQService) FetchTopics(ctx context.Context, instanceId string) ([]RocketMQTopic, error) {
+	if IsRocketMQ5Instance(instanceId) {
+		return s.fetchTopics5(ctx, instanceId)
+	}
+
 	request := &ons20190214.OnsTopicListRequest{
 		InstanceId: tea.String(instanceId),
 	}
 
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	response, err := s.client.OnsTopicList(request)
 	if err != nil {
 		return nil, fmt.Errorf("fetching topics for instance %s: %w", instanceId, err)
@@ -114,11 +238,18 @@ func (s *RocketMQService) FetchTopics(instanceId string) ([]RocketMQTopic, error
 }
 
 // FetchGroups retrieves all consumer groups for a specific RocketMQ instance
-func (s *RocketMQService) FetchGroups(instanceId string) ([]RocketMQGroup, error) {
+func (s *RocketMQService) FetchGroups(ctx context.Context, instanceId string) ([]RocketMQGroup, error) {
+	if IsRocketMQ5Instance(instanceId) {
+		return s.fetchGroups5(ctx, instanceId)
+	}
+
 	request := &ons20190214.OnsGroupListRequest{
 		InstanceId: tea.String(instanceId),
 	}
 
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	response, err := s.client.OnsGroupList(request)
 	if err != nil {
 		return nil, fmt.Errorf("fetching groups for instance %s: %w", instanceId, err)
@@ -134,6 +265,7 @@ func (s *RocketMQService) FetchGroups(instanceId string) ([]RocketMQGroup, error
 				CreateTime: tea.Int64Value(group.CreateTime),
 				UpdateTime: tea.Int64Value(group.UpdateTime),
 				Remark:     tea.StringValue(group.Remark),
+				APIVersion: RocketMQAPIVersion4,
 			}
 			groups = append(groups, groupInfo)
 		}
@@ -141,3 +273,229 @@ func (s *RocketMQService) FetchGroups(instanceId string) ([]RocketMQGroup, error
 
 	return groups, nil
 }
+
+// FetchInstanceInfo retrieves the base information of a RocketMQ instance
+func (s *RocketMQService) FetchInstanceInfo(ctx context.Context, instanceId string) (*RocketMQInstanceInfo, error) {
+	if IsRocketMQ5Instance(instanceId) {
+		return s.fetchInstanceInfo5(ctx, instanceId)
+	}
+
+	request := &ons20190214.OnsInstanceBaseInfoRequest{
+		InstanceId: tea.String(instanceId),
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	response, err := s.client.OnsInstanceBaseInfo(request)
+	if err != nil {
+		return nil, fmt.Errorf("fetching base info for instance %s: %w", instanceId, err)
+	}
+	if response.Body == nil || response.Body.InstanceBaseInfo == nil {
+		return nil, fmt.Errorf("RocketMQ instance %s not found", instanceId)
+	}
+
+	base := response.Body.InstanceBaseInfo
+	info := &RocketMQInstanceInfo{
+		InstanceId:        tea.StringValue(base.InstanceId),
+		InstanceName:      tea.StringValue(base.InstanceName),
+		InstanceType:      tea.Int32Value(base.InstanceType),
+		InstanceStatus:    tea.Int32Value(base.InstanceStatus),
+		CreateTime:        tea.StringValue(base.CreateTime),
+		ReleaseTime:       tea.Int64Value(base.ReleaseTime),
+		Remark:            tea.StringValue(base.Remark),
+		MaxTps:            tea.Int64Value(base.MaxTps),
+		TopicCapacity:     tea.Int32Value(base.TopicCapacity),
+		IndependentNaming: tea.BoolValue(base.IndependentNaming),
+	}
+	if endpoints := base.Endpoints; endpoints != nil {
+		info.TcpEndpoint = tea.StringValue(endpoints.TcpEndpoint)
+		info.TcpInternetEndpoint = tea.StringValue(endpoints.TcpInternetEndpoint)
+		info.HttpInternalEndpoint = tea.StringValue(endpoints.HttpInternalEndpoint)
+		info.HttpInternetEndpoint = tea.StringValue(endpoints.HttpInternetEndpoint)
+		info.HttpInternetSecureEndpoint = tea.StringValue(endpoints.HttpInternetSecureEndpoint)
+	}
+	return info, nil
+}
+
+// FetchGroupLag retrieves the message accumulation of a consumer group, with
+// the accumulation of each subscribed topic
+func (s *RocketMQService) FetchGroupLag(ctx context.Context, instanceId, groupId string) (*RocketMQGroupLag, error) {
+	if IsRocketMQ5Instance(instanceId) {
+		return s.fetchGroupLag5(ctx, instanceId, groupId)
+	}
+
+	request := &ons20190214.OnsConsumerAccumulateRequest{
+		InstanceId: tea.String(instanceId),
+		GroupId:    tea.String(groupId),
+		Detail:     tea.Bool(true),
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	response, err := s.client.OnsConsumerAccumulate(request)
+	if err != nil {
+		return nil, fmt.Errorf("fetching accumulation of group %s: %w", groupId, err)
+	}
+	if response.Body == nil || response.Body.Data == nil {
+		return nil, fmt.Errorf("no accumulation returned for group %s", groupId)
+	}
+
+	data := response.Body.Data
+	lag := &RocketMQGroupLag{
+		Online:        tea.BoolValue(data.Online),
+		TotalDiff:     tea.Int64Value(data.TotalDiff),
+		DelayTime:     tea.Int64Value(data.DelayTime),
+		ConsumeTps:    tea.Float32Value(data.ConsumeTps),
+		LastTimestamp: tea.Int64Value(data.LastTimestamp),
+	}
+	if data.DetailInTopicList != nil {
+		for _, detail := range data.DetailInTopicList.DetailInTopicDo {
+			lag.Topics = append(lag.Topics, RocketMQTopicLag{
+				GroupId:       groupId,
+				Topic:         tea.StringValue(detail.Topic),
+				TotalDiff:     tea.Int64Value(detail.TotalDiff),
+				DelayTime:     tea.Int64Value(detail.DelayTime),
+				LastTimestamp: tea.Int64Value(detail.LastTimestamp),
+			})
+		}
+	}
+	return lag, nil
+}
+
+// FetchGroupsWithLag retrieves the consumer groups of an instance with their
+// accumulation. Groups whose accumulation cannot be read are still returned,
+// with LagErr set
+func (s *RocketMQService) FetchGroupsWithLag(ctx context.Context, instanceId string) ([]RocketMQGroup, error) {
+	groups, err := s.FetchGroups(ctx, instanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, rocketMQLagConcurrency)
+	for i := range groups {
+		wg.Add(1)
+		go func(group *RocketMQGroup) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			// Each goroutine only writes its own element
+			group.Lag, group.LagErr = s.FetchGroupLag(ctx, instanceId, group.GroupId)
+		}(&groups[i])
+	}
+
+	wg.Wait()
+	return groups, nil
+}
+
+// FetchTopicsWithLag retrieves the topics of an instance with the
+// accumulation of the groups subscribed to them. Groups whose accumulation
+// cannot be read are left out; when the groups cannot be listed, every topic
+// has LagErr set
+func (s *RocketMQService) FetchTopicsWithLag(ctx context.Context, instanceId string) ([]RocketMQTopic, error) {
+	topics, err := s.FetchTopics(ctx, instanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := s.FetchGroupsWithLag(ctx, instanceId)
+	if err != nil {
+		for i := range topics {
+			topics[i].LagErr = err
+		}
+		return topics, nil
+	}
+
+	byTopic := make(map[string][]RocketMQTopicLag)
+	for _, group := range groups {
+		if group.Lag == nil {
+			continue
+		}
+		for _, lag := range group.Lag.Topics {
+			byTopic[lag.Topic] = append(byTopic[lag.Topic], lag)
+		}
+	}
+	for i := range topics {
+		topics[i].Subscriptions = byTopic[topics[i].Topic]
+		for _, lag := range topics[i].Subscriptions {
+			topics[i].TotalDiff += lag.TotalDiff
+		}
+	}
+	return topics, nil
+}
+
+// FetchMessages retrieves the messages stored in a topic during the given
+// period up to now, at most rocketMQMessageLimit of them. Only 4.x instances
+// can be queried
+func (s *RocketMQService) FetchMessages(ctx context.Context, instanceId, topic string, period time.Duration) ([]RocketMQMessage, error) {
+	if IsRocketMQ5Instance(instanceId) {
+		return nil, fmt.Errorf("browsing messages is not supported for RocketMQ 5.x instance %s", instanceId)
+	}
+
+	now := time.Now()
+	var messages []RocketMQMessage
+	var taskId *string
+
+	for page := int32(1); len(messages) < rocketMQMessageLimit; page++ {
+		request := &ons20190214.OnsMessagePageQueryByTopicRequest{
+			InstanceId:  tea.String(instanceId),
+			Topic:       tea.String(topic),
+			BeginTime:   tea.Int64(now.Add(-period).UnixMilli()),
+			EndTime:     tea.Int64(now.UnixMilli()),
+			CurrentPage: tea.Int32(page),
+			PageSize:    tea.Int32(rocketMQMessagePageSize),
+			TaskId:      taskId, // Later pages continue the query task of the first
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		response, err := s.client.OnsMessagePageQueryByTopic(request)
+		if err != nil {
+			return nil, fmt.Errorf("querying messages of topic %s: %w", topic, err)
+		}
+		if response.Body == nil || response.Body.MsgFoundDo == nil {
+			break
+		}
+
+		found := response.Body.MsgFoundDo
+		taskId = found.TaskId
+		if found.MsgFoundList != nil {
+			for _, msg := range found.MsgFoundList.OnsRestMessageDo {
+				messages = append(messages, rocketMQMessage(msg))
+			}
+		}
+		if int64(page) >= tea.Int64Value(found.MaxPageCount) {
+			break
+		}
+	}
+
+	return messages, nil
+}
+
+// rocketMQMessage converts a queried message, taking the tag and keys out of
+// its properties
+func rocketMQMessage(msg *ons20190214.OnsMessagePageQueryByTopicResponseBodyMsgFoundDoMsgFoundListOnsRestMessageDo) RocketMQMessage {
+	message := RocketMQMessage{
+		MsgId:          tea.StringValue(msg.MsgId),
+		Topic:          tea.StringValue(msg.Topic),
+		BornHost:       tea.StringValue(msg.BornHost),
+		BornTimestamp:  tea.Int64Value(msg.BornTimestamp),
+		StoreHost:      tea.StringValue(msg.StoreHost),
+		StoreTimestamp: tea.Int64Value(msg.StoreTimestamp),
+		StoreSize:      tea.Int32Value(msg.StoreSize),
+		ReconsumeTimes: tea.Int32Value(msg.ReconsumeTimes),
+		Properties:     make(map[string]string),
+	}
+	if msg.PropertyList != nil {
+		for _, prop := range msg.PropertyList.MessageProperty {
+			message.Properties[tea.StringValue(prop.Name)] = tea.StringValue(prop.Value)
+		}
+	}
+	message.Tag = message.Properties["TAGS"]
+	message.Keys = message.Properties["KEYS"]
+	return message
+}
@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// API generations a RocketMQ instance belongs to, shown in the API Version
+// column
+const (
+	RocketMQAPIVersion4 = "4.x"
+	RocketMQAPIVersion5 = "5.x"
+)
+
+// rocketMQ5PageSize is the largest page the RocketMQ 5.x list APIs accept
+const rocketMQ5PageSize = 100
+
+// rocketMQ5TimeLayout is how the RocketMQ 5.x API formats times
+const rocketMQ5TimeLayout = "2006-01-02 15:04:05"
+
+// IsRocketMQ5Instance reports whether an instance ID belongs to a RocketMQ
+// 5.x instance: 5.x IDs look like rmq-cn-xxx, 4.x IDs like MQ_INST_xxx
+func IsRocketMQ5Instance(instanceId string) bool {
+	return strings.HasPrefix(instanceId, "rmq-")
+}
+
+// rocketMQ5Page is the paginated data of the RocketMQ 5.x list APIs
+type rocketMQ5Page[T any] struct {
+	PageNumber int `json:"pageNumber"`
+	PageSize   int `json:"pageSize"`
+	TotalCount int `json:"totalCount"`
+	List       []T `json:"list"`
+}
+
+// rocketMQ5Instance is an instance as ListInstances and GetInstance report it
+type rocketMQ5Instance struct {
+	InstanceId    string `json:"instanceId"`
+	InstanceName  string `json:"instanceName"`
+	SeriesCode    string `json:"seriesCode"`    // standard, professional or ultimate
+	SubSeriesCode string `json:"subSeriesCode"` // cluster_ha, single_node or serverless
+	Status        string `json:"status"`
+	RegionId      string `json:"regionId"`
+	CreateTime    string `json:"createTime"`
+	ReleaseTime   string `json:"releaseTime"`
+	Remark        string `json:"remark"`
+	NetworkInfo   struct {
+		Endpoints []struct {
+			EndpointType string `json:"endpointType"` // TCP_VPC or TCP_INTERNET
+			EndpointUrl  string `json:"endpointUrl"`
+		} `json:"endpoints"`
+	} `json:"networkInfo"`
+	InstanceQuotas []struct {
+		QuotaName  string  `json:"quotaName"`
+		TotalCount float64 `json:"totalCount"`
+	} `json:"instanceQuotas"`
+}
+
+// rocketMQ5Topic is a topic as ListTopics reports it
+type rocketMQ5Topic struct {
+	TopicName   string `json:"topicName"`
+	MessageType string `json:"messageType"` // NORMAL, FIFO, DELAY or TRANSACTION
+	InstanceId  string `json:"instanceId"`
+	Remark      string `json:"remark"`
+	CreateTime  string `json:"createTime"`
+	UpdateTime  string `json:"updateTime"`
+}
+
+// rocketMQ5Group is a consumer group as ListConsumerGroups reports it
+type rocketMQ5Group struct {
+	ConsumerGroupId string `json:"consumerGroupId"`
+	InstanceId      string `json:"instanceId"`
+	Remark          string `json:"remark"`
+	CreateTime      string `json:"createTime"`
+	UpdateTime      string `json:"updateTime"`
+}
+
+// rocketMQ5Lag is the accumulation GetConsumerGroupLag reports
+type rocketMQ5Lag struct {
+	ReadyCount           int64 `json:"readyCount"`           // Messages ready to be consumed
+	InflightCount        int64 `json:"inflightCount"`        // Messages delivered but not acknowledged
+	DeliveryDuration     int64 `json:"deliveryDuration"`     // Seconds the oldest ready message has waited
+	LastConsumeTimestamp int64 `json:"lastConsumeTimestamp"` // Unix milliseconds
+}
+
+// rocketMQ5Time parses a RocketMQ 5.x time into Unix milliseconds, 0 when
+// it is empty or malformed
+func rocketMQ5Time(value string) int64 {
+	t, err := time.ParseInLocation(rocketMQ5TimeLayout, value, time.Local)
+	if err != nil {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
+// rocketMQ5Status converts a 5.x status to the 4.x status code the pages name
+func rocketMQ5Status(status string) int32 {
+	switch status {
+	case "RUNNING":
+		return 5
+	case "PREPARING", "CREATING":
+		return 0
+	case "EXPIRED":
+		return 6
+	case "RELEASED", "DELETING":
+		return 7
+	default:
+		return -1
+	}
+}
+
+// rocketMQ5MessageType converts a 5.x message type to the 4.x type code
+func rocketMQ5MessageType(messageType string) int32 {
+	switch messageType {
+	case "TRANSACTION":
+		return 2
+	case "DELAY":
+		return 4
+	case "FIFO":
+		return 5
+	default:
+		return 0
+	}
+}
+
+// rocketMQ5Edition names the edition of a 5.x instance, e.g. "Serverless Standard"
+func rocketMQ5Edition(inst rocketMQ5Instance) string {
+	series := inst.SeriesCode
+	if series != "" {
+		series = strings.ToUpper(series[:1]) + series[1:]
+	}
+	if inst.SubSeriesCode == "serverless" {
+		return strings.TrimSpace("Serverless " + series)
+	}
+	return series
+}
+
+// rocketMQ5List fetches every page of a RocketMQ 5.x list API
+func rocketMQ5List[T any](ctx context.Context, s *RocketMQService, action, pathname string) ([]T, error) {
+	var all []T
+	for page := 1; ; page++ {
+		query := url.Values{}
+		query.Set("pageNumber", fmt.Sprintf("%d", page))
+		query.Set("pageSize", fmt.Sprintf("%d", rocketMQ5PageSize))
+
+		var data rocketMQ5Page[T]
+		if err := s.v5Client.Get(ctx, action, pathname, query, &data); err != nil {
+			return nil, err
+		}
+		all = append(all, data.List...)
+		if len(data.List) < rocketMQ5PageSize || len(all) >= data.TotalCount {
+			return all, nil
+		}
+	}
+}
+
+// fetchInstances5 retrieves the RocketMQ 5.x instances
+func (s *RocketMQService) fetchInstances5(ctx context.Context) ([]RocketMQInstance, error) {
+	listed, err := rocketMQ5List[rocketMQ5Instance](ctx, s, "ListInstances", "/instances")
+	if err != nil {
+		return nil, fmt.Errorf("fetching RocketMQ 5.x instances: %w", err)
+	}
+
+	instances := make([]RocketMQInstance, len(listed))
+	for i, inst := range listed {
+		instances[i] = RocketMQInstance{
+			InstanceId:     inst.InstanceId,
+			InstanceName:   inst.InstanceName,
+			InstanceStatus: rocketMQ5Status(inst.Status),
+			RegionId:       inst.RegionId,
+			CreateTime:     rocketMQ5Time(inst.CreateTime),
+			ReleaseTime:    rocketMQ5Time(inst.ReleaseTime),
+			Remark:         inst.Remark,
+			APIVersion:     RocketMQAPIVersion5,
+			Edition:        rocketMQ5Edition(inst),
+		}
+	}
+	return instances, nil
+}
+
+// fetchInstanceInfo5 retrieves the endpoints and topic quota of a RocketMQ
+// 5.x instance
+func (s *RocketMQService) fetchInstanceInfo5(ctx context.Context, instanceId string) (*RocketMQInstanceInfo, error) {
+	var inst rocketMQ5Instance
+	if err := s.v5Client.Get(ctx, "GetInstance", "/instances/"+url.PathEscape(instanceId), nil, &inst); err != nil {
+		return nil, fmt.Errorf("fetching base info for instance %s: %w", instanceId, err)
+	}
+
+	info := &RocketMQInstanceInfo{
+		InstanceId:        inst.InstanceId,
+		InstanceName:      inst.InstanceName,
+		InstanceStatus:    rocketMQ5Status(inst.Status),
+		CreateTime:        inst.CreateTime,
+		ReleaseTime:       rocketMQ5Time(inst.ReleaseTime),
+		Remark:            inst.Remark,
+		IndependentNaming: true, // 5.x topics and groups always belong to their instance
+	}
+	for _, endpoint := range inst.NetworkInfo.Endpoints {
+		switch endpoint.EndpointType {
+		case "TCP_VPC":
+			info.TcpEndpoint = endpoint.EndpointUrl
+		case "TCP_INTERNET":
+			info.TcpInternetEndpoint = endpoint.EndpointUrl
+		}
+	}
+	for _, quota := range inst.InstanceQuotas {
+		if quota.QuotaName == "TOPIC_COUNT" {
+			info.TopicCapacity = int32(quota.TotalCount)
+		}
+	}
+	return info, nil
+}
+
+// fetchTopics5 retrieves the topics of a RocketMQ 5.x instance
+func (s *RocketMQService) fetchTopics5(ctx context.Context, instanceId string) ([]RocketMQTopic, error) {
+	listed, err := rocketMQ5List[rocketMQ5Topic](ctx, s, "ListTopics", "/instances/"+url.PathEscape(instanceId)+"/topics")
+	if err != nil {
+		return nil, fmt.Errorf("fetching topics for instance %s: %w", instanceId, err)
+	}
+
+	topics := make([]RocketMQTopic, len(listed))
+	for i, topic := range listed {
+		topics[i] = RocketMQTopic{
+			Topic:       topic.TopicName,
+			MessageType: rocketMQ5MessageType(topic.MessageType),
+			InstanceId:  topic.InstanceId,
+			CreateTime:  rocketMQ5Time(topic.CreateTime),
+			UpdateTime:  rocketMQ5Time(topic.UpdateTime),
+			Remark:      topic.Remark,
+		}
+	}
+	return topics, nil
+}
+
+// fetchGroups5 retrieves the consumer groups of a RocketMQ 5.x instance
+func (s *RocketMQService) fetchGroups5(ctx context.Context, instanceId string) ([]RocketMQGroup, error) {
+	listed, err := rocketMQ5List[rocketMQ5Group](ctx, s, "ListConsumerGroups", "/instances/"+url.PathEscape(instanceId)+"/consumerGroups")
+	if err != nil {
+		return nil, fmt.Errorf("fetching groups for instance %s: %w", instanceId, err)
+	}
+
+	groups := make([]RocketMQGroup, len(listed))
+	for i, group := range listed {
+		groups[i] = RocketMQGroup{
+			GroupId:    group.ConsumerGroupId,
+			InstanceId: group.InstanceId,
+			CreateTime: rocketMQ5Time(group.CreateTime),
+			UpdateTime: rocketMQ5Time(group.UpdateTime),
+			Remark:     group.Remark,
+			APIVersion: RocketMQAPIVersion5,
+		}
+	}
+	return groups, nil
+}
+
+// fetchGroupLag5 retrieves the accumulation of a RocketMQ 5.x consumer group.
+// The lag counts the ready and the delivered but unacknowledged messages
+func (s *RocketMQService) fetchGroupLag5(ctx context.Context, instanceId, groupId string) (*RocketMQGroupLag, error) {
+	var data struct {
+		TotalLag    rocketMQ5Lag            `json:"totalLag"`
+		TopicLagMap map[string]rocketMQ5Lag `json:"topicLagMap"`
+	}
+	pathname := "/instances/" + url.PathEscape(instanceId) + "/consumerGroups/" + url.PathEscape(groupId) + "/lag"
+	if err := s.v5Client.Get(ctx, "GetConsumerGroupLag", pathname, nil, &data); err != nil {
+		return nil, fmt.Errorf("fetching accumulation of group %s: %w", groupId, err)
+	}
+
+	lag := &RocketMQGroupLag{
+		TotalDiff:     data.TotalLag.ReadyCount + data.TotalLag.InflightCount,
+		DelayTime:     data.TotalLag.DeliveryDuration * 1000,
+		LastTimestamp: data.TotalLag.LastConsumeTimestamp,
+	}
+	for topic, topicLag := range data.TopicLagMap {
+		lag.Topics = append(lag.Topics, RocketMQTopicLag{
+			GroupId:       groupId,
+			Topic:         topic,
+			TotalDiff:     topicLag.ReadyCount + topicLag.InflightCount,
+			DelayTime:     topicLag.DeliveryDuration * 1000,
+			LastTimestamp: topicLag.LastConsumeTimestamp,
+		})
+	}
+	return lag, nil
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+)
+
+// SecurityGroupRuleMatches reports whether a rule covers query: an IP address
+// in the rule's source or destination CIDR block, e.g. 10.2.3.4 for
+// 10.0.0.0/8, a CIDR block inside it, or a port in its port range, e.g. 8080
+// for 8000/9000. Rules of all protocols cover every port
+func SecurityGroupRuleMatches(rule ecs.Permission, query string) bool {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return false
+	}
+
+	if port, err := strconv.Atoi(query); err == nil {
+		return rulePortMatches(rule, port)
+	}
+
+	var prefix netip.Prefix
+	if addr, err := netip.ParseAddr(query); err == nil {
+		prefix = netip.PrefixFrom(addr, addr.BitLen())
+	} else if prefix, err = netip.ParsePrefix(query); err != nil {
+		return false
+	}
+	for _, cidr := range []string{rule.SourceCidrIp, rule.DestCidrIp, rule.Ipv6SourceCidrIp, rule.Ipv6DestCidrIp} {
+		if block, ok := parseRuleCidr(cidr); ok && block.Bits() <= prefix.Bits() && block.Contains(prefix.Addr()) {
+			return true
+		}
+	}
+	return false
+}
+
+// rulePortMatches reports whether port is in the port range of a TCP, UDP or
+// all-protocol rule. The range is "from/to", "-1/-1" for any port
+func rulePortMatches(rule ecs.Permission, port int) bool {
+	if port < 0 || port > 65535 {
+		return false
+	}
+	protocol := strings.ToUpper(rule.IpProtocol)
+	if protocol == "ALL" {
+		return true
+	}
+	if protocol != "TCP" && protocol != "UDP" {
+		return false
+	}
+	from, to, ok := strings.Cut(rule.PortRange, "/")
+	if !ok {
+		return false
+	}
+	low, err := strconv.Atoi(from)
+	if err != nil {
+		return false
+	}
+	high, err := strconv.Atoi(to)
+	if err != nil {
+		return false
+	}
+	if low == -1 && high == -1 {
+		return true
+	}
+	return port >= low && port <= high
+}
+
+// parseRuleCidr parses the CIDR block of a rule, which may be a single
+// address
+func parseRuleCidr(cidr string) (netip.Prefix, bool) {
+	cidr = strings.TrimSpace(cidr)
+	if cidr == "" {
+		return netip.Prefix{}, false
+	}
+	if addr, err := netip.ParseAddr(cidr); err == nil {
+		return netip.PrefixFrom(addr, addr.BitLen()), true
+	}
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, false
+	}
+	return prefix.Masked(), true
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+)
+
+func TestSecurityGroupRuleMatches(t *testing.T) {
+	private := ecs.Permission{IpProtocol: "TCP", PortRange: "22/22", SourceCidrIp: "10.0.0.0/8"}
+	anyPort := ecs.Permission{IpProtocol: "TCP", PortRange: "-1/-1", SourceCidrIp: "0.0.0.0/0"}
+	http := ecs.Permission{IpProtocol: "tcp", PortRange: "80/80", SourceCidrIp: "192.168.1.10"}
+	web := ecs.Permission{IpProtocol: "UDP", PortRange: "8000/9000", DestCidrIp: "172.16.0.0/12"}
+	icmp := ecs.Permission{IpProtocol: "ICMP", PortRange: "-1/-1", SourceCidrIp: "10.1.0.0/16"}
+	all := ecs.Permission{IpProtocol: "ALL", PortRange: "-1/-1", Ipv6SourceCidrIp: "2001:db8::/32"}
+
+	tests := []struct {
+		name  string
+		rule  ecs.Permission
+		query string
+		want  bool
+	}{
+		{name: "address in block", rule: private, query: "10.2.3.4", want: true},
+		{name: "address outside block", rule: private, query: "11.2.3.4", want: false},
+		{name: "block inside block", rule: private, query: "10.2.0.0/16", want: true},
+		{name: "block wider than block", rule: private, query: "10.0.0.0/7", want: false},
+		{name: "unmasked block inside block", rule: private, query: "10.2.3.4/24", want: true},
+		{name: "single address rule", rule: http, query: "192.168.1.10", want: true},
+		{name: "block around single address rule", rule: http, query: "192.168.1.0/24", want: false},
+		{name: "destination block", rule: web, query: "172.20.1.1", want: true},
+		{name: "IPv6 block", rule: all, query: "2001:db8::1", want: true},
+		{name: "IPv4 against IPv6 block", rule: all, query: "10.0.0.1", want: false},
+		{name: "port in single port range", rule: http, query: "80", want: true},
+		{name: "port outside single port range", rule: http, query: "81", want: false},
+		{name: "any port range", rule: anyPort, query: "65535", want: true},
+		{name: "port in UDP range", rule: web, query: "8080", want: true},
+		{name: "port above UDP range", rule: web, query: "9001", want: false},
+		{name: "port out of bounds", rule: anyPort, query: "70000", want: false},
+		{name: "ICMP has no ports", rule: icmp, query: "22", want: false},
+		{name: "ICMP block", rule: icmp, query: "10.1.2.3", want: true},
+		{name: "all protocols cover every port", rule: all, query: "3306", want: true},
+		{name: "empty query", rule: anyPort, query: " ", want: false},
+		{name: "not an address or port", rule: anyPort, query: "web", want: false},
+	}
+	for _, tt := range tests {
+		if got := SecurityGroupRuleMatches(tt.rule, tt.query); got != tt.want {
+			t.Errorf("%s: SecurityGroupRuleMatches(%q) = %v, want %v", tt.name, tt.query, got, tt.want)
+		}
+	}
+}
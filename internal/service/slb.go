@@ -1,16 +1,21 @@
 package service
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+
+	"aliyun-tui-viewer/internal/cache"
 )
 
 // SLBService handles SLB operations
 type SLBService struct {
 	client *slb.Client
+
+	cached
 }
 
 // NewSLBService creates a new SLB service
@@ -19,7 +24,12 @@ func NewSLBService(client *slb.Client) *SLBService {
 }
 
 // FetchInstances retrieves all SLB instances using pagination
-func (s *SLBService) FetchInstances() ([]slb.LoadBalancer, error) {
+func (s *SLBService) FetchInstances(ctx context.Context) ([]slb.LoadBalancer, error) {
+	return cache.Fetch(ctx, s.cache, CacheSLBInstances, s.fetchInstances)
+}
+
+// fetchInstances calls the API for FetchInstances
+func (s *SLBService) fetchInstances(ctx context.Context) ([]slb.LoadBalancer, error) {
 	var allLoadBalancers []slb.LoadBalancer
 	pageNumber := int64(1)
 	pageSize := int64(100)
@@ -27,6 +37,7 @@ func (s *SLBService) FetchInstances() ([]slb.LoadBalancer, error) {
 	for {
 		request := slb.CreateDescribeLoadBalancersRequest()
 		request.Scheme = "https"
+		bind(ctx, request)
 		request.PageNumber = requests.NewInteger(int(pageNumber))
 		request.PageSize = requests.NewInteger(int(pageSize))
 
@@ -50,22 +61,40 @@ func (s *SLBService) FetchInstances() ([]slb.LoadBalancer, error) {
 	return allLoadBalancers, nil
 }
 
+// FetchInstancesByAddress retrieves the SLB instances serving at an IP
+// address, filtered by the API
+func (s *SLBService) FetchInstancesByAddress(ctx context.Context, address string) ([]slb.LoadBalancer, error) {
+	request := slb.CreateDescribeLoadBalancersRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+	request.Address = address
+	request.PageSize = requests.NewInteger(100)
+
+	response, err := s.client.DescribeLoadBalancers(request)
+	if err != nil {
+		return nil, fmt.Errorf("describing SLB instances at %s: %w", address, err)
+	}
+	return response.LoadBalancers.LoadBalancer, nil
+}
+
 // ListenerDetail contains detailed information about a listener
 type ListenerDetail struct {
-	Protocol         string
-	Port             int
-	BackendPort      int
-	Status           string
-	HealthCheck      string
-	Scheduler        string
-	VServerGroupId   string
-	VServerGroupName string
+	Protocol            string
+	Port                int
+	BackendPort         int
+	Status              string
+	HealthCheck         string
+	Scheduler           string
+	VServerGroupId      string
+	VServerGroupName    string
+	ServerCertificateId string // HTTPS listeners only
 }
 
 // FetchListeners retrieves all listeners for a specific SLB instance
-func (s *SLBService) FetchListeners(loadBalancerId string) (*slb.DescribeLoadBalancerAttributeResponse, error) {
+func (s *SLBService) FetchListeners(ctx context.Context, loadBalancerId string) (*slb.DescribeLoadBalancerAttributeResponse, error) {
 	request := slb.CreateDescribeLoadBalancerAttributeRequest()
 	request.Scheme = "https"
+	bind(ctx, request)
 	request.LoadBalancerId = loadBalancerId
 
 	response, err := s.client.DescribeLoadBalancerAttribute(request)
@@ -77,9 +106,9 @@ func (s *SLBService) FetchListeners(loadBalancerId string) (*slb.DescribeLoadBal
 }
 
 // FetchDetailedListeners retrieves detailed information for all listeners of an SLB instance
-func (s *SLBService) FetchDetailedListeners(loadBalancerId string) ([]ListenerDetail, error) {
+func (s *SLBService) FetchDetailedListeners(ctx context.Context, loadBalancerId string) ([]ListenerDetail, error) {
 	// First get the basic listener info
-	basicResponse, err := s.FetchListeners(loadBalancerId)
+	basicResponse, err := s.FetchListeners(ctx, loadBalancerId)
 	if err != nil {
 		return nil, err
 	}
@@ -89,25 +118,25 @@ func (s *SLBService) FetchDetailedListeners(loadBalancerId string) ([]ListenerDe
 	// For each listener port, try to get detailed information
 	for _, port := range basicResponse.ListenerPorts.ListenerPort {
 		// Try HTTP listener first
-		if httpDetail := s.fetchHTTPListenerDetail(loadBalancerId, port); httpDetail != nil {
+		if httpDetail := s.fetchHTTPListenerDetail(ctx, loadBalancerId, port); httpDetail != nil {
 			detailedListeners = append(detailedListeners, *httpDetail)
 			continue
 		}
 
 		// Try HTTPS listener
-		if httpsDetail := s.fetchHTTPSListenerDetail(loadBalancerId, port); httpsDetail != nil {
+		if httpsDetail := s.fetchHTTPSListenerDetail(ctx, loadBalancerId, port); httpsDetail != nil {
 			detailedListeners = append(detailedListeners, *httpsDetail)
 			continue
 		}
 
 		// Try TCP listener
-		if tcpDetail := s.fetchTCPListenerDetail(loadBalancerId, port); tcpDetail != nil {
+		if tcpDetail := s.fetchTCPListenerDetail(ctx, loadBalancerId, port); tcpDetail != nil {
 			detailedListeners = append(detailedListeners, *tcpDetail)
 			continue
 		}
 
 		// Try UDP listener
-		if udpDetail := s.fetchUDPListenerDetail(loadBalancerId, port); udpDetail != nil {
+		if udpDetail := s.fetchUDPListenerDetail(ctx, loadBalancerId, port); udpDetail != nil {
 			detailedListeners = append(detailedListeners, *udpDetail)
 			continue
 		}
@@ -127,9 +156,10 @@ func (s *SLBService) FetchDetailedListeners(loadBalancerId string) ([]ListenerDe
 }
 
 // fetchHTTPListenerDetail tries to fetch HTTP listener details
-func (s *SLBService) fetchHTTPListenerDetail(loadBalancerId string, port int) *ListenerDetail {
+func (s *SLBService) fetchHTTPListenerDetail(ctx context.Context, loadBalancerId string, port int) *ListenerDetail {
 	request := slb.CreateDescribeLoadBalancerHTTPListenerAttributeRequest()
 	request.Scheme = "https"
+	bind(ctx, request)
 	request.LoadBalancerId = loadBalancerId
 	request.ListenerPort = requests.NewInteger(port)
 
@@ -141,7 +171,7 @@ func (s *SLBService) fetchHTTPListenerDetail(loadBalancerId string, port int) *L
 	// Get VServer group name if available
 	vsgName := ""
 	if response.VServerGroupId != "" {
-		if vsg, err := s.getVServerGroupName(response.VServerGroupId); err == nil {
+		if vsg, err := s.getVServerGroupName(ctx, response.VServerGroupId); err == nil {
 			vsgName = vsg
 		}
 	}
@@ -159,9 +189,10 @@ func (s *SLBService) fetchHTTPListenerDetail(loadBalancerId string, port int) *L
 }
 
 // fetchHTTPSListenerDetail tries to fetch HTTPS listener details
-func (s *SLBService) fetchHTTPSListenerDetail(loadBalancerId string, port int) *ListenerDetail {
+func (s *SLBService) fetchHTTPSListenerDetail(ctx context.Context, loadBalancerId string, port int) *ListenerDetail {
 	request := slb.CreateDescribeLoadBalancerHTTPSListenerAttributeRequest()
 	request.Scheme = "https"
+	bind(ctx, request)
 	request.LoadBalancerId = loadBalancerId
 	request.ListenerPort = requests.NewInteger(port)
 
@@ -173,27 +204,29 @@ func (s *SLBService) fetchHTTPSListenerDetail(loadBalancerId string, port int) *
 	// Get VServer group name if available
 	vsgName := ""
 	if response.VServerGroupId != "" {
-		if vsg, err := s.getVServerGroupName(response.VServerGroupId); err == nil {
+		if vsg, err := s.getVServerGroupName(ctx, response.VServerGroupId); err == nil {
 			vsgName = vsg
 		}
 	}
 
 	return &ListenerDetail{
-		Protocol:         "HTTPS",
-		Port:             port,
-		BackendPort:      response.BackendServerPort,
-		Status:           response.Status,
-		HealthCheck:      response.HealthCheck,
-		Scheduler:        response.Scheduler,
-		VServerGroupId:   response.VServerGroupId,
-		VServerGroupName: vsgName,
+		Protocol:            "HTTPS",
+		Port:                port,
+		BackendPort:         response.BackendServerPort,
+		Status:              response.Status,
+		HealthCheck:         response.HealthCheck,
+		Scheduler:           response.Scheduler,
+		VServerGroupId:      response.VServerGroupId,
+		VServerGroupName:    vsgName,
+		ServerCertificateId: response.ServerCertificateId,
 	}
 }
 
 // fetchTCPListenerDetail tries to fetch TCP listener details
-func (s *SLBService) fetchTCPListenerDetail(loadBalancerId string, port int) *ListenerDetail {
+func (s *SLBService) fetchTCPListenerDetail(ctx context.Context, loadBalancerId string, port int) *ListenerDetail {
 	request := slb.CreateDescribeLoadBalancerTCPListenerAttributeRequest()
 	request.Scheme = "https"
+	bind(ctx, request)
 	request.LoadBalancerId = loadBalancerId
 	request.ListenerPort = requests.NewInteger(port)
 
@@ -205,7 +238,7 @@ func (s *SLBService) fetchTCPListenerDetail(loadBalancerId string, port int) *Li
 	// Get VServer group name if available
 	vsgName := ""
 	if response.VServerGroupId != "" {
-		if vsg, err := s.getVServerGroupName(response.VServerGroupId); err == nil {
+		if vsg, err := s.getVServerGroupName(ctx, response.VServerGroupId); err == nil {
 			vsgName = vsg
 		}
 	}
@@ -223,9 +256,10 @@ func (s *SLBService) fetchTCPListenerDetail(loadBalancerId string, port int) *Li
 }
 
 // fetchUDPListenerDetail tries to fetch UDP listener details
-func (s *SLBService) fetchUDPListenerDetail(loadBalancerId string, port int) *ListenerDetail {
+func (s *SLBService) fetchUDPListenerDetail(ctx context.Context, loadBalancerId string, port int) *ListenerDetail {
 	request := slb.CreateDescribeLoadBalancerUDPListenerAttributeRequest()
 	request.Scheme = "https"
+	bind(ctx, request)
 	request.LoadBalancerId = loadBalancerId
 	request.ListenerPort = requests.NewInteger(port)
 
@@ -237,7 +271,7 @@ func (s *SLBService) fetchUDPListenerDetail(loadBalancerId string, port int) *Li
 	// Get VServer group name if available
 	vsgName := ""
 	if response.VServerGroupId != "" {
-		if vsg, err := s.getVServerGroupName(response.VServerGroupId); err == nil {
+		if vsg, err := s.getVServerGroupName(ctx, response.VServerGroupId); err == nil {
 			vsgName = vsg
 		}
 	}
@@ -255,9 +289,10 @@ func (s *SLBService) fetchUDPListenerDetail(loadBalancerId string, port int) *Li
 }
 
 // getVServerGroupName retrieves the name of a virtual server group
-func (s *SLBService) getVServerGroupName(vServerGroupId string) (string, error) {
+func (s *SLBService) getVServerGroupName(ctx context.Context, vServerGroupId string) (string, error) {
 	request := slb.CreateDescribeVServerGroupAttributeRequest()
 	request.Scheme = "https"
+	bind(ctx, request)
 	request.VServerGroupId = vServerGroupId
 
 	response, err := s.client.DescribeVServerGroupAttribute(request)
@@ -278,9 +313,10 @@ type VServerGroupDetail struct {
 }
 
 // FetchVServerGroups retrieves all virtual server groups for a specific SLB instance
-func (s *SLBService) FetchVServerGroups(loadBalancerId string) ([]slb.VServerGroup, error) {
+func (s *SLBService) FetchVServerGroups(ctx context.Context, loadBalancerId string) ([]slb.VServerGroup, error) {
 	request := slb.CreateDescribeVServerGroupsRequest()
 	request.Scheme = "https"
+	bind(ctx, request)
 	request.LoadBalancerId = loadBalancerId
 
 	response, err := s.client.DescribeVServerGroups(request)
@@ -292,15 +328,15 @@ func (s *SLBService) FetchVServerGroups(loadBalancerId string) ([]slb.VServerGro
 }
 
 // FetchDetailedVServerGroups retrieves detailed information for all virtual server groups
-func (s *SLBService) FetchDetailedVServerGroups(loadBalancerId string) ([]VServerGroupDetail, error) {
+func (s *SLBService) FetchDetailedVServerGroups(ctx context.Context, loadBalancerId string) ([]VServerGroupDetail, error) {
 	// Get basic VServer groups
-	vServerGroups, err := s.FetchVServerGroups(loadBalancerId)
+	vServerGroups, err := s.FetchVServerGroups(ctx, loadBalancerId)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get detailed listeners to find associations
-	listeners, err := s.FetchDetailedListeners(loadBalancerId)
+	listeners, err := s.FetchDetailedListeners(ctx, loadBalancerId)
 	if err != nil {
 		return nil, err
 	}
@@ -311,7 +347,7 @@ func (s *SLBService) FetchDetailedVServerGroups(loadBalancerId string) ([]VServe
 	// Fetch forwarding rules for each HTTP/HTTPS listener
 	for _, listener := range listeners {
 		if listener.Protocol == "HTTP" || listener.Protocol == "HTTPS" {
-			rules, err := s.FetchForwardingRules(loadBalancerId, listener.Port, listener.Protocol)
+			rules, err := s.FetchForwardingRules(ctx, loadBalancerId, listener.Port, listener.Protocol)
 			if err != nil {
 				continue // Skip if we can't fetch rules
 			}
@@ -327,7 +363,7 @@ func (s *SLBService) FetchDetailedVServerGroups(loadBalancerId string) ([]VServe
 
 	for _, vsg := range vServerGroups {
 		// Get backend server count
-		backendServers, err := s.FetchVServerGroupBackendServers(vsg.VServerGroupId)
+		backendServers, err := s.FetchVServerGroupBackendServers(ctx, vsg.VServerGroupId)
 		if err != nil {
 			// If we can't get backend servers, set count to 0
 			backendServers = []slb.BackendServerInDescribeVServerGroupAttribute{}
@@ -369,9 +405,10 @@ type BackendServerDetail struct {
 }
 
 // FetchVServerGroupBackendServers retrieves backend servers for a specific virtual server group
-func (s *SLBService) FetchVServerGroupBackendServers(vServerGroupId string) ([]slb.BackendServerInDescribeVServerGroupAttribute, error) {
+func (s *SLBService) FetchVServerGroupBackendServers(ctx context.Context, vServerGroupId string) ([]slb.BackendServerInDescribeVServerGroupAttribute, error) {
 	request := slb.CreateDescribeVServerGroupAttributeRequest()
 	request.Scheme = "https"
+	bind(ctx, request)
 	request.VServerGroupId = vServerGroupId
 
 	response, err := s.client.DescribeVServerGroupAttribute(request)
@@ -383,9 +420,9 @@ func (s *SLBService) FetchVServerGroupBackendServers(vServerGroupId string) ([]s
 }
 
 // FetchDetailedBackendServers retrieves detailed information for backend servers including ECS details
-func (s *SLBService) FetchDetailedBackendServers(vServerGroupId string, ecsClient *ecs.Client) ([]BackendServerDetail, error) {
+func (s *SLBService) FetchDetailedBackendServers(ctx context.Context, vServerGroupId string, ecsClient *ecs.Client) ([]BackendServerDetail, error) {
 	// Get basic backend servers
-	backendServers, err := s.FetchVServerGroupBackendServers(vServerGroupId)
+	backendServers, err := s.FetchVServerGroupBackendServers(ctx, vServerGroupId)
 	if err != nil {
 		return nil, err
 	}
@@ -406,7 +443,7 @@ func (s *SLBService) FetchDetailedBackendServers(vServerGroupId string, ecsClien
 
 		// Try to get ECS instance details if ecsClient is provided
 		if ecsClient != nil {
-			if ecsInstanceDetail := s.getECSInstanceDetail(server.ServerId, ecsClient); ecsInstanceDetail != nil {
+			if ecsInstanceDetail := s.getECSInstanceDetail(ctx, server.ServerId, ecsClient); ecsInstanceDetail != nil {
 				detail.InstanceName = ecsInstanceDetail.InstanceName
 				detail.PrivateIpAddress = ecsInstanceDetail.PrivateIpAddress
 				detail.PublicIpAddress = ecsInstanceDetail.PublicIpAddress
@@ -437,7 +474,7 @@ type ForwardingRuleDetail struct {
 }
 
 // FetchForwardingRules retrieves all forwarding rules for a specific listener
-func (s *SLBService) FetchForwardingRules(loadBalancerId string, listenerPort int, listenerProtocol string) ([]ForwardingRuleDetail, error) {
+func (s *SLBService) FetchForwardingRules(ctx context.Context, loadBalancerId string, listenerPort int, listenerProtocol string) ([]ForwardingRuleDetail, error) {
 	// Forwarding rules only apply to HTTP/HTTPS listeners
 	if listenerProtocol != "HTTP" && listenerProtocol != "HTTPS" {
 		return nil, nil
@@ -445,6 +482,7 @@ func (s *SLBService) FetchForwardingRules(loadBalancerId string, listenerPort in
 
 	request := slb.CreateDescribeRulesRequest()
 	request.Scheme = "https"
+	bind(ctx, request)
 	request.LoadBalancerId = loadBalancerId
 	request.ListenerPort = requests.NewInteger(listenerPort)
 	request.ListenerProtocol = listenerProtocol
@@ -459,7 +497,7 @@ func (s *SLBService) FetchForwardingRules(loadBalancerId string, listenerPort in
 		// Get VServer group name if available
 		vsgName := ""
 		if rule.VServerGroupId != "" {
-			if name, err := s.getVServerGroupName(rule.VServerGroupId); err == nil {
+			if name, err := s.getVServerGroupName(ctx, rule.VServerGroupId); err == nil {
 				vsgName = name
 			}
 		}
@@ -490,9 +528,10 @@ type DefaultServerDetail struct {
 }
 
 // FetchDefaultBackendServers retrieves the default backend servers for an SLB instance
-func (s *SLBService) FetchDefaultBackendServers(loadBalancerId string, ecsClient *ecs.Client) ([]DefaultServerDetail, error) {
+func (s *SLBService) FetchDefaultBackendServers(ctx context.Context, loadBalancerId string, ecsClient *ecs.Client) ([]DefaultServerDetail, error) {
 	request := slb.CreateDescribeLoadBalancerAttributeRequest()
 	request.Scheme = "https"
+	bind(ctx, request)
 	request.LoadBalancerId = loadBalancerId
 
 	response, err := s.client.DescribeLoadBalancerAttribute(request)
@@ -515,7 +554,7 @@ func (s *SLBService) FetchDefaultBackendServers(loadBalancerId string, ecsClient
 
 		// Get ECS instance details
 		if ecsClient != nil {
-			if ecsDetail := s.getECSInstanceDetailFull(server.ServerId, ecsClient); ecsDetail != nil {
+			if ecsDetail := s.getECSInstanceDetailFull(ctx, server.ServerId, ecsClient); ecsDetail != nil {
 				detail.InstanceName = ecsDetail.InstanceName
 				detail.Zone = ecsDetail.Zone
 				detail.VpcId = ecsDetail.VpcId
@@ -542,13 +581,14 @@ type ECSInstanceDetailFull struct {
 }
 
 // getECSInstanceDetailFull retrieves full ECS instance details
-func (s *SLBService) getECSInstanceDetailFull(instanceId string, ecsClient *ecs.Client) *ECSInstanceDetailFull {
+func (s *SLBService) getECSInstanceDetailFull(ctx context.Context, instanceId string, ecsClient *ecs.Client) *ECSInstanceDetailFull {
 	if ecsClient == nil {
 		return nil
 	}
 
 	request := ecs.CreateDescribeInstancesRequest()
 	request.Scheme = "https"
+	bind(ctx, request)
 	request.InstanceIds = fmt.Sprintf("[\"%s\"]", instanceId)
 
 	response, err := ecsClient.DescribeInstances(request)
@@ -585,13 +625,14 @@ func (s *SLBService) getECSInstanceDetailFull(instanceId string, ecsClient *ecs.
 }
 
 // getECSInstanceDetail retrieves ECS instance details for a given instance ID
-func (s *SLBService) getECSInstanceDetail(instanceId string, ecsClient *ecs.Client) *ECSInstanceDetail {
+func (s *SLBService) getECSInstanceDetail(ctx context.Context, instanceId string, ecsClient *ecs.Client) *ECSInstanceDetail {
 	if ecsClient == nil {
 		return nil
 	}
 
 	request := ecs.CreateDescribeInstancesRequest()
 	request.Scheme = "https"
+	bind(ctx, request)
 	request.InstanceIds = fmt.Sprintf("[\"%s\"]", instanceId)
 
 	response, err := ecsClient.DescribeInstances(request)
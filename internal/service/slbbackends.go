@@ -0,0 +1,86 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+)
+
+// slbBackendBatch is the most backend servers one SLB call takes
+const slbBackendBatch = 20
+
+// VServerBackend is an ECS instance to register in a VServer group
+type VServerBackend struct {
+	ServerID string
+	Port     int
+	Weight   int
+}
+
+// backendServersJSON encodes backends as the BackendServers parameter
+func backendServersJSON(backends []VServerBackend) (string, error) {
+	type backendServer struct {
+		ServerId string
+		Port     int
+		Weight   int
+		Type     string
+	}
+	servers := make([]backendServer, len(backends))
+	for i, b := range backends {
+		servers[i] = backendServer{ServerId: b.ServerID, Port: b.Port, Weight: b.Weight, Type: "ecs"}
+	}
+	data, err := json.Marshal(servers)
+	return string(data), err
+}
+
+// CreateVServerGroup creates a VServer group on an SLB instance with backends
+// registered, and returns its ID. Backends beyond what one call takes are
+// added to the new group in further calls
+func (s *SLBService) CreateVServerGroup(loadBalancerId, name string, backends []VServerBackend) (string, error) {
+	first := backends
+	if len(first) > slbBackendBatch {
+		first = first[:slbBackendBatch]
+	}
+	servers, err := backendServersJSON(first)
+	if err != nil {
+		return "", err
+	}
+
+	request := slb.CreateCreateVServerGroupRequest()
+	request.Scheme = "https"
+	request.LoadBalancerId = loadBalancerId
+	request.VServerGroupName = name
+	request.BackendServers = servers
+
+	response, err := s.client.CreateVServerGroup(request)
+	if err != nil {
+		return "", fmt.Errorf("creating virtual server group %s on SLB %s: %w", name, loadBalancerId, err)
+	}
+
+	if err := s.AddVServerGroupBackendServers(response.VServerGroupId, backends[len(first):]); err != nil {
+		return response.VServerGroupId, err
+	}
+	return response.VServerGroupId, nil
+}
+
+// AddVServerGroupBackendServers registers backends in a VServer group, in
+// batches of what one call takes
+func (s *SLBService) AddVServerGroupBackendServers(vServerGroupId string, backends []VServerBackend) error {
+	for start := 0; start < len(backends); start += slbBackendBatch {
+		end := min(start+slbBackendBatch, len(backends))
+		servers, err := backendServersJSON(backends[start:end])
+		if err != nil {
+			return err
+		}
+
+		request := slb.CreateAddVServerGroupBackendServersRequest()
+		request.Scheme = "https"
+		request.VServerGroupId = vServerGroupId
+		request.BackendServers = servers
+
+		if _, err := s.client.AddVServerGroupBackendServers(request); err != nil {
+			return fmt.Errorf("adding backend servers to virtual server group %s: %w", vServerGroupId, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+)
+
+// pemBlockPattern matches a PEM block whose line breaks may have been turned
+// into spaces, as pasting into a single-line input does
+var pemBlockPattern = regexp.MustCompile(`-----BEGIN ([A-Z0-9 ]+)-----([A-Za-z0-9+/=\s]*?)-----END ([A-Z0-9 ]+)-----`)
+
+// CertificateBundle is a PEM certificate chain and its private key, checked
+// to belong together
+type CertificateBundle struct {
+	Certificate string
+	PrivateKey  string
+	Leaf        *x509.Certificate // The server certificate, first in the chain
+}
+
+// ReadPEMInput returns the PEM content of a form value: the value itself when
+// it holds a pasted PEM block, otherwise the file it names, with a leading ~
+// expanded
+func ReadPEMInput(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if strings.Contains(value, "-----BEGIN ") {
+		return normalizePEM(value), nil
+	}
+
+	path := value
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("resolving home directory: %w", err)
+		}
+		path = filepath.Join(home, strings.TrimPrefix(path, "~"))
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading %s: %w", value, err)
+	}
+	return string(data), nil
+}
+
+// normalizePEM rebuilds the PEM blocks of s with the base64 body wrapped at
+// 64 columns, restoring blocks whose line breaks were lost
+func normalizePEM(s string) string {
+	var b strings.Builder
+	for _, m := range pemBlockPattern.FindAllStringSubmatch(s, -1) {
+		body := strings.Join(strings.Fields(m[2]), "")
+		b.WriteString("-----BEGIN " + m[1] + "-----\n")
+		for len(body) > 64 {
+			b.WriteString(body[:64] + "\n")
+			body = body[64:]
+		}
+		if body != "" {
+			b.WriteString(body + "\n")
+		}
+		b.WriteString("-----END " + m[3] + "-----\n")
+	}
+	return b.String()
+}
+
+// LoadCertificateBundle checks that a PEM certificate chain and private key
+// form a key pair, and parses the server certificate
+func LoadCertificateBundle(certPEM, keyPEM string) (*CertificateBundle, error) {
+	pair, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+	if err != nil {
+		return nil, fmt.Errorf("checking certificate and private key: %w", err)
+	}
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificate: %w", err)
+	}
+	if block, _ := pem.Decode([]byte(keyPEM)); block == nil {
+		return nil, fmt.Errorf("private key is not PEM encoded")
+	}
+	return &CertificateBundle{Certificate: certPEM, PrivateKey: keyPEM, Leaf: leaf}, nil
+}
+
+// CertificateExpiry returns when an SLB server certificate expires, zero
+// when unknown
+func CertificateExpiry(cert slb.ServerCertificate) time.Time {
+	if cert.ExpireTimeStamp > 0 {
+		return time.UnixMilli(cert.ExpireTimeStamp)
+	}
+	t, _ := time.Parse(time.RFC3339, cert.ExpireTime)
+	return t
+}
+
+// FetchServerCertificates retrieves the server certificates uploaded to SLB
+// in the region
+func (s *SLBService) FetchServerCertificates(ctx context.Context) ([]slb.ServerCertificate, error) {
+	request := slb.CreateDescribeServerCertificatesRequest()
+	request.Scheme = "https"
+	bind(ctx, request)
+
+	response, err := s.client.DescribeServerCertificates(request)
+	if err != nil {
+		return nil, fmt.Errorf("describing server certificates: %w", err)
+	}
+	return response.ServerCertificates.ServerCertificate, nil
+}
+
+// UploadServerCertificate uploads a certificate and its private key as an
+// SLB server certificate, and returns its ID
+func (s *SLBService) UploadServerCertificate(name string, bundle *CertificateBundle) (string, error) {
+	request := slb.CreateUploadServerCertificateRequest()
+	request.Scheme = "https"
+	request.ServerCertificateName = name
+	request.ServerCertificate = bundle.Certificate
+	request.PrivateKey = bundle.PrivateKey
+
+	response, err := s.client.UploadServerCertificate(request)
+	if err != nil {
+		return "", fmt.Errorf("uploading server certificate %s: %w", name, err)
+	}
+	return response.ServerCertificateId, nil
+}
+
+// SetListenerServerCertificate makes an HTTPS listener serve another server
+// certificate, leaving its other settings as they are
+func (s *SLBService) SetListenerServerCertificate(loadBalancerId string, port int, certificateId string) error {
+	request := slb.CreateSetLoadBalancerHTTPSListenerAttributeRequest()
+	request.Scheme = "https"
+	request.LoadBalancerId = loadBalancerId
+	request.ListenerPort = requests.NewInteger(port)
+	request.ServerCertificateId = certificateId
+
+	if _, err := s.client.SetLoadBalancerHTTPSListenerAttribute(request); err != nil {
+		return fmt.Errorf("setting certificate of HTTPS listener %d on SLB %s: %w", port, loadBalancerId, err)
+	}
+	return nil
+}
@@ -0,0 +1,371 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+
+	"aliyun-tui-viewer/internal/cache"
+	"aliyun-tui-viewer/internal/client"
+)
+
+// slsPageSize is the page size of Log Service list requests
+const slsPageSize = 500
+
+// slsConcurrency bounds the concurrent per-config and per-group requests
+const slsConcurrency = 8
+
+// LogtailHeartbeatTimeout is how old a machine's last heartbeat may be
+// before its Logtail is considered to have stopped reporting
+const LogtailHeartbeatTimeout = 5 * time.Minute
+
+// SLSProject is a Log Service project
+type SLSProject struct {
+	ProjectName    string `json:"projectName"`
+	Description    string `json:"description"`
+	Region         string `json:"region"`
+	Status         string `json:"status"`
+	Owner          string `json:"owner"`
+	CreateTime     string `json:"createTime"`
+	LastModifyTime string `json:"lastModifyTime"`
+}
+
+// SLSLogtailConfig is a Logtail collection config
+type SLSLogtailConfig struct {
+	ConfigName     string                 `json:"configName"`
+	InputType      string                 `json:"inputType"`
+	InputDetail    map[string]interface{} `json:"inputDetail"`
+	OutputType     string                 `json:"outputType"`
+	OutputDetail   SLSLogtailOutput       `json:"outputDetail"`
+	LogSample      string                 `json:"logSample"`
+	CreateTime     int64                  `json:"createTime"`
+	LastModifyTime int64                  `json:"lastModifyTime"`
+	MachineGroups  []string               `json:"machineGroups"` // Groups the config is applied to
+}
+
+// SLSLogtailOutput is where a Logtail config ships logs to
+type SLSLogtailOutput struct {
+	LogstoreName string `json:"logstoreName"`
+	Endpoint     string `json:"endpoint"`
+	Region       string `json:"region"`
+}
+
+// SLSMachineGroup is a Logtail machine group
+type SLSMachineGroup struct {
+	GroupName           string            `json:"groupName"`
+	GroupType           string            `json:"groupType"`
+	MachineIdentifyType string            `json:"machineIdentifyType"` // "ip" or "userdefined"
+	GroupAttribute      SLSGroupAttribute `json:"groupAttribute"`
+	MachineList         []string          `json:"machineList"` // IPs or custom identifiers
+	CreateTime          int64             `json:"createTime"`
+	LastModifyTime      int64             `json:"lastModifyTime"`
+	Configs             []string          `json:"configs"`  // Logtail configs applied to the group
+	Machines            []SLSMachine      `json:"machines"` // Heartbeating machines, plus listed IPs without one
+}
+
+// SLSGroupAttribute holds optional machine group attributes
+type SLSGroupAttribute struct {
+	ExternalName string `json:"externalName"`
+	GroupTopic   string `json:"groupTopic"`
+}
+
+// SLSMachine is a machine of a machine group
+type SLSMachine struct {
+	IP                string `json:"ip"`
+	UniqueID          string `json:"machine-uniqueid"`
+	UserDefinedID     string `json:"userdefined-id"`
+	LastHeartbeatTime int64  `json:"lastHeartbeatTime"` // Unix seconds, 0 when it never reported
+	InstanceID        string `json:"instanceId"`        // ECS instance with this private IP
+	InstanceName      string `json:"instanceName"`
+}
+
+// HeartbeatOK reports whether the machine's Logtail reported recently
+func (m SLSMachine) HeartbeatOK() bool {
+	return m.LastHeartbeatTime > 0 && time.Since(time.Unix(m.LastHeartbeatTime, 0)) < LogtailHeartbeatTimeout
+}
+
+// HeartbeatCount returns how many machines of the group report heartbeats
+func (g SLSMachineGroup) HeartbeatCount() int {
+	count := 0
+	for _, machine := range g.Machines {
+		if machine.HeartbeatOK() {
+			count++
+		}
+	}
+	return count
+}
+
+// LogtailCoverage is how Logtail covers one ECS instance
+type LogtailCoverage struct {
+	Instance      ecs.Instance
+	PrivateIP     string
+	MachineGroups []string
+	Configs       []string
+	Heartbeat     *SLSMachine // nil when no group has a machine with this IP
+}
+
+// LogtailOverview is the Logtail setup of a project
+type LogtailOverview struct {
+	Project  string
+	Configs  []SLSLogtailConfig
+	Groups   []SLSMachineGroup
+	Coverage []LogtailCoverage // ECS instances of the region
+}
+
+// SLSService handles Log Service operations
+type SLSService struct {
+	client *client.SLSClient
+
+	cached
+}
+
+// NewSLSService creates a new Log Service service
+func NewSLSService(client *client.SLSClient) *SLSService {
+	return &SLSService{client: client}
+}
+
+// FetchProjects retrieves all Log Service projects of the region
+func (s *SLSService) FetchProjects(ctx context.Context) ([]SLSProject, error) {
+	return cache.Fetch(ctx, s.cache, CacheSLSProjects, s.fetchProjects)
+}
+
+// fetchProjects calls the API for FetchProjects
+func (s *SLSService) fetchProjects(ctx context.Context) ([]SLSProject, error) {
+	var allProjects []SLSProject
+	for offset := 0; ; offset += slsPageSize {
+		var response struct {
+			Projects []SLSProject `json:"projects"`
+			Total    int          `json:"total"`
+		}
+		if err := s.client.Get(ctx, "", "/", pageQuery(offset), &response); err != nil {
+			return nil, fmt.Errorf("listing SLS projects: %w", err)
+		}
+		allProjects = append(allProjects, response.Projects...)
+		if len(response.Projects) < slsPageSize || len(allProjects) >= response.Total {
+			break
+		}
+	}
+	return allProjects, nil
+}
+
+// FetchLogtailOverview retrieves the Logtail configs and machine groups of a
+// project and matches them against the region's ECS instances by private IP
+func (s *SLSService) FetchLogtailOverview(ctx context.Context, project string, instances []ecs.Instance) (LogtailOverview, error) {
+	overview := LogtailOverview{Project: project}
+
+	var configsErr, groupsErr error
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		overview.Configs, configsErr = s.fetchLogtailConfigs(ctx, project)
+	}()
+	go func() {
+		defer wg.Done()
+		overview.Groups, groupsErr = s.fetchMachineGroups(ctx, project)
+	}()
+	wg.Wait()
+	if configsErr != nil {
+		return overview, configsErr
+	}
+	if groupsErr != nil {
+		return overview, groupsErr
+	}
+
+	linkLogtail(&overview, instances)
+	return overview, nil
+}
+
+// fetchLogtailConfigs retrieves every Logtail config of a project
+func (s *SLSService) fetchLogtailConfigs(ctx context.Context, project string) ([]SLSLogtailConfig, error) {
+	names, err := s.listNames(ctx, project, "/logtailconfigs", "configs")
+	if err != nil {
+		return nil, fmt.Errorf("listing Logtail configs of %s: %w", project, err)
+	}
+
+	configs := make([]SLSLogtailConfig, len(names))
+	err = forEachConcurrently(len(names), func(i int) error {
+		if err := s.client.Get(ctx, project, "/logtailconfigs/"+url.PathEscape(names[i]), nil, &configs[i]); err != nil {
+			return fmt.Errorf("getting Logtail config %s: %w", names[i], err)
+		}
+		return nil
+	})
+	return configs, err
+}
+
+// fetchMachineGroups retrieves every machine group of a project with its
+// applied configs and heartbeating machines
+func (s *SLSService) fetchMachineGroups(ctx context.Context, project string) ([]SLSMachineGroup, error) {
+	names, err := s.listNames(ctx, project, "/machinegroups", "machinegroups")
+	if err != nil {
+		return nil, fmt.Errorf("listing machine groups of %s: %w", project, err)
+	}
+
+	groups := make([]SLSMachineGroup, len(names))
+	err = forEachConcurrently(len(names), func(i int) error {
+		path := "/machinegroups/" + url.PathEscape(names[i])
+		group := &groups[i]
+		if err := s.client.Get(ctx, project, path, nil, group); err != nil {
+			return fmt.Errorf("getting machine group %s: %w", names[i], err)
+		}
+
+		var applied struct {
+			Configs []string `json:"configs"`
+		}
+		if err := s.client.Get(ctx, project, path+"/configs", nil, &applied); err != nil {
+			return fmt.Errorf("listing configs of machine group %s: %w", names[i], err)
+		}
+		group.Configs = applied.Configs
+
+		for offset := 0; ; offset += slsPageSize {
+			var machines struct {
+				Machines []SLSMachine `json:"machines"`
+				Total    int          `json:"total"`
+			}
+			if err := s.client.Get(ctx, project, path+"/machines", pageQuery(offset), &machines); err != nil {
+				return fmt.Errorf("listing machines of machine group %s: %w", names[i], err)
+			}
+			group.Machines = append(group.Machines, machines.Machines...)
+			if len(machines.Machines) < slsPageSize || len(group.Machines) >= machines.Total {
+				break
+			}
+		}
+		return nil
+	})
+	return groups, err
+}
+
+// listNames pages through a list endpoint returning names under field
+func (s *SLSService) listNames(ctx context.Context, project, path, field string) ([]string, error) {
+	var allNames []string
+	for offset := 0; ; offset += slsPageSize {
+		var response map[string]interface{}
+		if err := s.client.Get(ctx, project, path, pageQuery(offset), &response); err != nil {
+			return nil, err
+		}
+		items, _ := response[field].([]interface{})
+		for _, item := range items {
+			if name, ok := item.(string); ok {
+				allNames = append(allNames, name)
+			}
+		}
+		total, _ := response["total"].(float64)
+		if len(items) < slsPageSize || len(allNames) >= int(total) {
+			break
+		}
+	}
+	return allNames, nil
+}
+
+// linkLogtail fills the configs' machine groups, adds listed IPs without a
+// heartbeat to their groups, maps machines to ECS instances and builds the
+// per-instance coverage
+func linkLogtail(overview *LogtailOverview, instances []ecs.Instance) {
+	byIP := make(map[string]ecs.Instance)
+	for _, inst := range instances {
+		for _, ip := range instancePrivateIPs(inst) {
+			byIP[ip] = inst
+		}
+	}
+
+	groupsByConfig := make(map[string][]string)
+	for gi := range overview.Groups {
+		group := &overview.Groups[gi]
+		for _, config := range group.Configs {
+			groupsByConfig[config] = append(groupsByConfig[config], group.GroupName)
+		}
+
+		if group.MachineIdentifyType == "ip" {
+			reporting := make(map[string]bool)
+			for _, machine := range group.Machines {
+				reporting[machine.IP] = true
+			}
+			for _, ip := range group.MachineList {
+				if !reporting[ip] {
+					group.Machines = append(group.Machines, SLSMachine{IP: ip})
+				}
+			}
+		}
+
+		for mi := range group.Machines {
+			machine := &group.Machines[mi]
+			if inst, ok := byIP[machine.IP]; ok {
+				machine.InstanceID, machine.InstanceName = inst.InstanceId, inst.InstanceName
+			}
+		}
+	}
+	for ci := range overview.Configs {
+		overview.Configs[ci].MachineGroups = groupsByConfig[overview.Configs[ci].ConfigName]
+	}
+
+	overview.Coverage = make([]LogtailCoverage, 0, len(instances))
+	for _, inst := range instances {
+		coverage := LogtailCoverage{Instance: inst}
+		if ips := instancePrivateIPs(inst); len(ips) > 0 {
+			coverage.PrivateIP = ips[0]
+		}
+		configs := make(map[string]bool)
+		for gi, group := range overview.Groups {
+			for mi, machine := range group.Machines {
+				if machine.InstanceID != inst.InstanceId {
+					continue
+				}
+				coverage.MachineGroups = append(coverage.MachineGroups, group.GroupName)
+				for _, config := range group.Configs {
+					configs[config] = true
+				}
+				if coverage.Heartbeat == nil || machine.LastHeartbeatTime > coverage.Heartbeat.LastHeartbeatTime {
+					coverage.Heartbeat = &overview.Groups[gi].Machines[mi]
+				}
+				break
+			}
+		}
+		for config := range configs {
+			coverage.Configs = append(coverage.Configs, config)
+		}
+		sort.Strings(coverage.Configs)
+		overview.Coverage = append(overview.Coverage, coverage)
+	}
+}
+
+// instancePrivateIPs returns the private IPs of a VPC or classic instance
+func instancePrivateIPs(inst ecs.Instance) []string {
+	if ips := inst.VpcAttributes.PrivateIpAddress.IpAddress; len(ips) > 0 {
+		return ips
+	}
+	return inst.InnerIpAddress.IpAddress
+}
+
+// pageQuery returns the offset/size query of a Log Service list request
+func pageQuery(offset int) url.Values {
+	return url.Values{"offset": {strconv.Itoa(offset)}, "size": {strconv.Itoa(slsPageSize)}}
+}
+
+// forEachConcurrently calls fn for 0..n-1 with at most slsConcurrency calls
+// in flight and returns the first error
+func forEachConcurrently(n int, fn func(i int) error) error {
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+	sem := make(chan struct{}, slsConcurrency)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := fn(i); err != nil {
+				once.Do(func() { firstErr = err })
+			}
+		}(i)
+	}
+	wg.Wait()
+	return firstErr
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/tag"
+
+	"aliyun-tui-viewer/internal/cache"
+)
+
+// tagPageSize is the page size used with the Tag API list calls
+const tagPageSize = 100
+
+// Products whose resources the tag browser lists
+const (
+	TagProductECS   = "ECS"
+	TagProductRDS   = "RDS"
+	TagProductSLB   = "SLB"
+	TagProductRedis = "Redis"
+)
+
+// taggedProduct maps a product to its Tag API resource type and to the
+// service and resource type found in its ARNs
+type taggedProduct struct {
+	Product      string
+	ResourceType string // e.g. ALIYUN::ECS::INSTANCE
+	ARNService   string // e.g. ecs in arn:acs:ecs:cn-hangzhou:123:instance/i-xxx
+	ARNType      string
+}
+
+var taggedProducts = []taggedProduct{
+	{Product: TagProductECS, ResourceType: "ALIYUN::ECS::INSTANCE", ARNService: "ecs", ARNType: "instance"},
+	{Product: TagProductRDS, ResourceType: "ALIYUN::RDS::DBINSTANCE", ARNService: "rds", ARNType: "dbinstance"},
+	{Product: TagProductSLB, ResourceType: "ALIYUN::SLB::INSTANCE", ARNService: "slb", ARNType: "instance"},
+	{Product: TagProductRedis, ResourceType: "ALIYUN::KVSTORE::INSTANCE", ARNService: "kvstore", ARNType: "instance"},
+}
+
+// TagPair is a tag key and value with the products using it
+type TagPair struct {
+	Key      string
+	Value    string
+	Products []string
+}
+
+// String formats the tag as key=value
+func (p TagPair) String() string {
+	return p.Key + "=" + p.Value
+}
+
+// TaggedResource is a resource carrying a tag
+type TaggedResource struct {
+	Product string
+	ID      string
+	Region  string
+	ARN     string
+	Tags    []tag.Tag
+}
+
+// TagService handles tag operations through the Tag API
+type TagService struct {
+	client *tag.Client
+
+	cached
+}
+
+// NewTagService creates a new tag service
+func NewTagService(client *tag.Client) *TagService {
+	return &TagService{client: client}
+}
+
+// FetchTags retrieves the custom tag keys and values used by ECS, RDS, SLB
+// and Redis resources, sorted by key and value
+func (s *TagService) FetchTags(ctx context.Context) ([]TagPair, error) {
+	return cache.Fetch(ctx, s.cache, CacheTags, s.fetchTags)
+}
+
+// fetchTags calls the API for FetchTags
+func (s *TagService) fetchTags(ctx context.Context) ([]TagPair, error) {
+	type keyValue struct{ key, value string }
+	products := make(map[keyValue][]string)
+	for _, p := range taggedProducts {
+		keys, err := s.fetchTagKeys(ctx, p.ResourceType)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			values, err := s.fetchTagValues(ctx, p.ResourceType, key)
+			if err != nil {
+				return nil, err
+			}
+			for _, value := range values {
+				kv := keyValue{key, value}
+				products[kv] = append(products[kv], p.Product)
+			}
+		}
+	}
+
+	pairs := make([]TagPair, 0, len(products))
+	for kv, used := range products {
+		pairs = append(pairs, TagPair{Key: kv.key, Value: kv.value, Products: used})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].Key != pairs[j].Key {
+			return pairs[i].Key < pairs[j].Key
+		}
+		return pairs[i].Value < pairs[j].Value
+	})
+	return pairs, nil
+}
+
+// fetchTagKeys retrieves the custom tag keys of a resource type using
+// NextToken pagination
+func (s *TagService) fetchTagKeys(ctx context.Context, resourceType string) ([]string, error) {
+	var keys []string
+	nextToken := ""
+
+	for {
+		request := tag.CreateListTagKeysRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.QueryType = "TAG"
+		request.Category = "Custom"
+		request.ResourceType = resourceType
+		request.PageSize = requests.NewInteger(tagPageSize)
+		request.NextToken = nextToken
+
+		response, err := s.client.ListTagKeys(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing tag keys of %s: %w", resourceType, err)
+		}
+
+		for _, key := range response.Keys.Key {
+			keys = append(keys, key.Key)
+		}
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+
+	return keys, nil
+}
+
+// fetchTagValues retrieves the values of a tag key for a resource type using
+// NextToken pagination
+func (s *TagService) fetchTagValues(ctx context.Context, resourceType, key string) ([]string, error) {
+	var values []string
+	nextToken := ""
+
+	for {
+		request := tag.CreateListTagValuesRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.QueryType = "TAG"
+		request.ResourceType = resourceType
+		request.Key = key
+		request.PageSize = requests.NewInteger(tagPageSize)
+		request.NextToken = nextToken
+
+		response, err := s.client.ListTagValues(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing values of tag %s on %s: %w", key, resourceType, err)
+		}
+
+		values = append(values, response.Values.Value...)
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+
+	return values, nil
+}
+
+// FetchTaggedResources retrieves the ECS, RDS, SLB and Redis resources
+// carrying the tag key=value
+func (s *TagService) FetchTaggedResources(ctx context.Context, key, value string) ([]TaggedResource, error) {
+	tags, err := json.Marshal(map[string]string{key: value})
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []TaggedResource
+	nextToken := ""
+
+	for {
+		request := tag.CreateListTagResourcesRequest()
+		request.Scheme = "https"
+		bind(ctx, request)
+		request.Tags = string(tags)
+		request.PageSize = requests.NewInteger(tagPageSize)
+		request.NextToken = nextToken
+
+		response, err := s.client.ListTagResources(request)
+		if err != nil {
+			return nil, fmt.Errorf("listing resources tagged %s=%s: %w", key, value, err)
+		}
+
+		for _, r := range response.TagResources {
+			product, region, ok := parseTaggedARN(r.ResourceARN)
+			if !ok {
+				continue
+			}
+			resources = append(resources, TaggedResource{
+				Product: product,
+				ID:      r.ResourceId,
+				Region:  region,
+				ARN:     r.ResourceARN,
+				Tags:    r.Tags,
+			})
+		}
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+
+	return resources, nil
+}
+
+// parseTaggedARN returns the product and region of an ARN such as
+// arn:acs:ecs:cn-hangzhou:123:instance/i-xxx. ok is false for products the
+// tag browser does not list
+func parseTaggedARN(arn string) (product, region string, ok bool) {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" {
+		return "", "", false
+	}
+	resourceType, _, _ := strings.Cut(parts[5], "/")
+	for _, p := range taggedProducts {
+		if strings.EqualFold(parts[2], p.ARNService) && strings.EqualFold(resourceType, p.ARNType) {
+			return p.Product, parts[3], true
+		}
+	}
+	return "", "", false
+}
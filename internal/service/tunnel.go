@@ -0,0 +1,55 @@
+package service
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+)
+
+// TunnelSpec describes a local port forwarded to an endpoint through an SSH
+// bastion, which reaches the endpoint from inside the VPC
+type TunnelSpec struct {
+	LocalPort   int
+	Remote      string // host:port of the endpoint, as seen from the bastion
+	Bastion     string // [user@]host of the bastion
+	BastionPort int    // SSH port of the bastion, 0 for the default
+}
+
+// LocalAddress returns the address clients connect to for the tunnel
+func (t TunnelSpec) LocalAddress() string {
+	return net.JoinHostPort("127.0.0.1", strconv.Itoa(t.LocalPort))
+}
+
+// String describes the tunnel, e.g. for its job title
+func (t TunnelSpec) String() string {
+	return fmt.Sprintf("%s → %s via %s", t.LocalAddress(), t.Remote, t.Bastion)
+}
+
+// SSHArgs returns the ssh arguments that hold the tunnel open without running
+// a remote command. ssh runs without a terminal, so it must not prompt: keys
+// come from the agent or ssh config, and the host key of a new bastion is
+// accepted on first use
+func (t TunnelSpec) SSHArgs() []string {
+	args := []string{
+		"-N",
+		"-L", t.LocalAddress() + ":" + t.Remote,
+		"-o", "ExitOnForwardFailure=yes",
+		"-o", "BatchMode=yes",
+		"-o", "StrictHostKeyChecking=accept-new",
+		"-o", "ServerAliveInterval=30",
+	}
+	if t.BastionPort > 0 {
+		args = append(args, "-p", strconv.Itoa(t.BastionPort))
+	}
+	return append(args, t.Bastion)
+}
+
+// CheckLocalPort reports an error when a local port can't be forwarded from,
+// usually because another program listens on it
+func CheckLocalPort(port int) error {
+	l, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+	if err != nil {
+		return err
+	}
+	return l.Close()
+}
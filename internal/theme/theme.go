@@ -0,0 +1,180 @@
+package theme
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"aliyun-tui-viewer/internal/config"
+)
+
+// Theme names
+const (
+	Dark         = "dark"
+	Light        = "light"
+	HighContrast = "high-contrast"
+)
+
+// Theme is the set of colors the interface is drawn with, by the role they
+// play rather than by hue
+type Theme struct {
+	Name string
+
+	// Primary colors
+	Primary   lipgloss.Color // Titles, focused borders, selected rows
+	Secondary lipgloss.Color // Keys, subtitles and values of note
+	Accent    lipgloss.Color // Table headers, labels and shortcuts
+
+	// Status colors
+	Success lipgloss.Color
+	Warning lipgloss.Color
+	Error   lipgloss.Color
+	Info    lipgloss.Color
+
+	// Neutral colors
+	Text       lipgloss.Color
+	SubtleText lipgloss.Color
+	MutedText  lipgloss.Color
+	Border     lipgloss.Color
+	Bar        lipgloss.Color // Background of the header, mode line, search bar and modals
+
+	// Special colors
+	Selected     lipgloss.Color // Background of buttons and focused fields
+	OnPrimary    lipgloss.Color // Text on the primary color
+	SearchMatch  lipgloss.Color // Background of search matches
+	CurrentMatch lipgloss.Color // Background of the current search match
+	OnMatch      lipgloss.Color // Text on the current search match
+	Overlay      lipgloss.Color // Background behind modals
+}
+
+// themes are the built-in themes by name
+var themes = map[string]Theme{
+	Dark: {
+		Name:         Dark,
+		Primary:      "#7C3AED", // Purple
+		Secondary:    "#06B6D4", // Cyan
+		Accent:       "#F59E0B", // Amber
+		Success:      "#10B981", // Green
+		Warning:      "#F59E0B", // Amber
+		Error:        "#EF4444", // Red
+		Info:         "#3B82F6", // Blue
+		Text:         "#E5E7EB", // Light gray
+		SubtleText:   "#9CA3AF", // Gray
+		MutedText:    "#6B7280", // Dark gray
+		Border:       "#374151", // Dark border
+		Bar:          "#1F2937",
+		Selected:     "#374151",
+		OnPrimary:    "#FFFFFF",
+		SearchMatch:  "#854D0E", // Dark yellow
+		CurrentMatch: "#CA8A04", // Bright yellow
+		OnMatch:      "#000000",
+		Overlay:      "#000000",
+	},
+	Light: {
+		Name:         Light,
+		Primary:      "#6D28D9", // Deep purple
+		Secondary:    "#0E7490", // Deep cyan
+		Accent:       "#B45309", // Deep amber
+		Success:      "#047857", // Deep green
+		Warning:      "#B45309", // Deep amber
+		Error:        "#B91C1C", // Deep red
+		Info:         "#1D4ED8", // Deep blue
+		Text:         "#111827", // Near black
+		SubtleText:   "#4B5563", // Dark gray
+		MutedText:    "#6B7280", // Gray
+		Border:       "#D1D5DB", // Light border
+		Bar:          "#E5E7EB",
+		Selected:     "#DDD6FE",
+		OnPrimary:    "#FFFFFF",
+		SearchMatch:  "#FDE68A", // Light yellow
+		CurrentMatch: "#F59E0B", // Amber
+		OnMatch:      "#000000",
+		Overlay:      "#F3F4F6",
+	},
+	HighContrast: {
+		Name:         HighContrast,
+		Primary:      "#C084FC", // Light purple
+		Secondary:    "#22D3EE", // Bright cyan
+		Accent:       "#FACC15", // Bright yellow
+		Success:      "#4ADE80", // Bright green
+		Warning:      "#FACC15", // Bright yellow
+		Error:        "#F87171", // Bright red
+		Info:         "#60A5FA", // Bright blue
+		Text:         "#FFFFFF",
+		SubtleText:   "#E5E7EB",
+		MutedText:    "#D1D5DB",
+		Border:       "#FFFFFF",
+		Bar:          "#000000",
+		Selected:     "#4B5563",
+		OnPrimary:    "#000000",
+		SearchMatch:  "#A16207", // Dark yellow
+		CurrentMatch: "#FFFFFF",
+		OnMatch:      "#000000",
+		Overlay:      "#000000",
+	},
+}
+
+// hexPattern matches the colors an override may set, as #RGB or #RRGGBB
+var hexPattern = regexp.MustCompile(`^#([0-9A-Fa-f]{3}|[0-9A-Fa-f]{6})$`)
+
+// current caches the theme in use
+var current *Theme
+
+// Current returns the theme in use, the one of the config file "theme" field
+// with its "theme_colors" overrides applied
+func Current() Theme {
+	if current == nil {
+		t := Load(config.GetTheme())
+		current = &t
+	}
+	return *current
+}
+
+// Set sets the theme in use (for testing purposes)
+func Set(t Theme) {
+	current = &t
+}
+
+// Load returns the theme called name, dark when there is no such theme, with
+// colors overriding its roles. Overrides of unknown roles or that are no hex
+// colors are ignored
+func Load(name string, colors map[string]string) Theme {
+	t, ok := themes[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		t = themes[Dark]
+	}
+	roles := t.roles()
+	for role, color := range colors {
+		color = strings.TrimSpace(color)
+		if c, ok := roles[strings.ToLower(role)]; ok && hexPattern.MatchString(color) {
+			*c = lipgloss.Color(color)
+		}
+	}
+	return t
+}
+
+// roles returns the colors of t by the names "theme_colors" overrides them
+// with
+func (t *Theme) roles() map[string]*lipgloss.Color {
+	return map[string]*lipgloss.Color{
+		"primary":       &t.Primary,
+		"secondary":     &t.Secondary,
+		"accent":        &t.Accent,
+		"success":       &t.Success,
+		"warning":       &t.Warning,
+		"error":         &t.Error,
+		"info":          &t.Info,
+		"text":          &t.Text,
+		"subtle_text":   &t.SubtleText,
+		"muted_text":    &t.MutedText,
+		"border":        &t.Border,
+		"bar":           &t.Bar,
+		"selected":      &t.Selected,
+		"on_primary":    &t.OnPrimary,
+		"search_match":  &t.SearchMatch,
+		"current_match": &t.CurrentMatch,
+		"on_match":      &t.OnMatch,
+		"overlay":       &t.Overlay,
+	}
+}
@@ -0,0 +1,424 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/dds"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+
+	"aliyun-tui-viewer/internal/cache"
+	"aliyun-tui-viewer/internal/client"
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/jobs"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/pages"
+)
+
+// allRegionsConcurrency caps the number of regions fetched at the same time
+const allRegionsConcurrency = 8
+
+// AllRegionsLoadedMsg wraps a list load aggregated across regions
+type AllRegionsLoadedMsg struct {
+	Loaded tea.Msg
+	Failed map[string]error // Fetch errors keyed by region ID
+}
+
+// buildServices creates the service set for a set of clients
+func buildServices(clients *client.AliyunClients) *Services {
+	cfg := clients.GetConfig()
+	services := &Services{
+		ECS:      service.NewECSService(clients.ECS),
+		EIP:      service.NewEIPService(clients.VPC),
+		NAT:      service.NewNATService(clients.VPC),
+		DNS:      service.NewDNSService(clients.DNS),
+		SLB:      service.NewSLBService(clients.SLB),
+		ALB:      service.NewALBService(clients.ALB),
+		NLB:      service.NewNLBService(clients.NLB),
+		ACK:      service.NewACKService(clients.CS, cfg.RegionID),
+		ACR:      service.NewACRService(clients.CR),
+		FC:       service.NewFCService(clients.FC),
+		RDS:      service.NewRDSService(clients.RDS),
+		OSS:      service.NewOSSServiceWithCredentials(clients.OSS, cfg.AccessKeyID, cfg.AccessKeySecret, cfg.OssEndpoint, client.OSSCredentialsOption(cfg.Credentials)),
+		Redis:    service.NewRedisService(clients.Redis),
+		MongoDB:  service.NewMongoDBService(clients.MongoDB),
+		ES:       service.NewElasticsearchService(clients.ES, cfg.RegionID),
+		Kafka:    service.NewKafkaService(clients.Kafka),
+		RocketMQ: service.NewRocketMQService(clients.RocketMQ, clients.RocketMQ5),
+		RAM:      service.NewRAMService(clients.RAM),
+		Monitor:  service.NewMonitorService(clients.CMS),
+		SLS:      service.NewSLSService(clients.SLS),
+		Config:   service.NewCloudConfigService(clients.CloudConfig),
+		Tags:     service.NewTagService(clients.Tag),
+		Billing:  service.NewBillingService(clients.BSS),
+		Names:    service.NewNameResolver(clients.ECS, clients.VPC, clients.ResourceManager),
+	}
+	services.DNSTargets = service.NewDNSTargetService(services.ECS, services.EIP, services.SLB, services.OSS, service.NewCDNService(clients.CDN))
+
+	// The list fetches reuse results of this profile and region for a while
+	services.Cache = cache.Default.Scope(cfg.Profile, cfg.RegionID)
+	for _, svc := range []service.Cacheable{
+		services.ECS, services.NAT, services.DNS, services.SLB, services.ALB, services.NLB,
+		services.ACK, services.ACR, services.FC, services.RDS, services.OSS, services.Redis,
+		services.MongoDB, services.ES, services.Kafka, services.RocketMQ, services.RAM,
+		services.SLS, services.Config, services.Tags,
+	} {
+		svc.SetCache(services.Cache)
+	}
+	services.Permissions = service.PermissionsOf(cfg.Profile)
+	return services
+}
+
+// fanOutRegions calls fetch concurrently for every region with resources and
+// concatenates the results. Regions that fail are returned in failed; err is
+// only set when no region could be fetched at all, or ctx was cancelled.
+func fanOutRegions[T any](ctx context.Context, report jobs.Reporter, regionSvc *service.RegionService, clients *client.AliyunClients, fetch func(context.Context, *Services) ([]T, error)) (results []T, failed map[string]error, err error) {
+	regions, err := regionSvc.GetRegionsWithResources(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing regions with resources: %w", err)
+	}
+
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, allRegionsConcurrency)
+	)
+	failed = make(map[string]error)
+	perRegion := make(map[string][]T, len(regions))
+	total := int64(len(regions))
+	report(0, total)
+
+	for _, region := range regions {
+		wg.Add(1)
+		go func(region string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			if ctx.Err() != nil {
+				return
+			}
+
+			var items []T
+			regionClients, err := clients.UpdateRegion(region)
+			if err == nil {
+				items, err = fetch(ctx, buildServices(regionClients))
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failed[region] = err
+			} else {
+				perRegion[region] = items
+			}
+			report(int64(len(perRegion)+len(failed)), total)
+		}(region)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if len(perRegion) == 0 && len(failed) > 0 {
+		return nil, nil, fmt.Errorf("fetching from all regions: %w", failed[regions[0]])
+	}
+
+	// Keep a stable order: regions sorted, items in API order within a region
+	for _, region := range regions {
+		results = append(results, perRegion[region]...)
+	}
+	return results, failed, nil
+}
+
+// allRegionsJob runs an aggregated fetch as a job and wraps its result for the app
+func allRegionsJob[T any](regionSvc *service.RegionService, clients *client.AliyunClients, fetch func(context.Context, *Services) ([]T, error), loaded func([]T) tea.Msg) jobRunner {
+	return func(ctx context.Context, report jobs.Reporter) (tea.Msg, error) {
+		results, failed, err := fanOutRegions(ctx, report, regionSvc, clients, fetch)
+		if err != nil {
+			return ErrorMsg{Err: err}, err
+		}
+		return AllRegionsLoadedMsg{Loaded: loaded(results), Failed: failed}, nil
+	}
+}
+
+// LoadECSInstancesAllRegions returns a job loading ECS instances from every region with resources
+func LoadECSInstancesAllRegions(regionSvc *service.RegionService, clients *client.AliyunClients) jobRunner {
+	return allRegionsJob(regionSvc, clients,
+		func(ctx context.Context, s *Services) ([]ecs.Instance, error) { return s.ECS.FetchInstances(ctx) },
+		func(instances []ecs.Instance) tea.Msg { return ECSInstancesLoadedMsg{Instances: instances} })
+}
+
+// LoadSLBInstancesAllRegions returns a job loading SLB instances from every region with resources
+func LoadSLBInstancesAllRegions(regionSvc *service.RegionService, clients *client.AliyunClients) jobRunner {
+	return allRegionsJob(regionSvc, clients,
+		func(ctx context.Context, s *Services) ([]slb.LoadBalancer, error) { return s.SLB.FetchInstances(ctx) },
+		func(lbs []slb.LoadBalancer) tea.Msg { return SLBInstancesLoadedMsg{LoadBalancers: lbs} })
+}
+
+// LoadRDSDetailedInstancesAllRegions returns a job loading RDS instances with network info from every region with resources
+func LoadRDSDetailedInstancesAllRegions(regionSvc *service.RegionService, clients *client.AliyunClients) jobRunner {
+	return allRegionsJob(regionSvc, clients,
+		func(ctx context.Context, s *Services) ([]service.RDSInstanceDetail, error) {
+			return s.RDS.FetchDetailedInstances(ctx)
+		},
+		func(instances []service.RDSInstanceDetail) tea.Msg {
+			return RDSDetailedInstancesLoadedMsg{Instances: instances}
+		})
+}
+
+// LoadRedisInstancesAllRegions returns a job loading Redis instances from every region with resources
+func LoadRedisInstancesAllRegions(regionSvc *service.RegionService, clients *client.AliyunClients) jobRunner {
+	return allRegionsJob(regionSvc, clients,
+		func(ctx context.Context, s *Services) ([]r_kvstore.KVStoreInstance, error) {
+			return s.Redis.FetchInstances(ctx)
+		},
+		func(instances []r_kvstore.KVStoreInstance) tea.Msg {
+			return RedisInstancesLoadedMsg{Instances: instances}
+		})
+}
+
+// LoadMongoDBInstancesAllRegions returns a job loading MongoDB instances from every region with resources
+func LoadMongoDBInstancesAllRegions(regionSvc *service.RegionService, clients *client.AliyunClients) jobRunner {
+	return allRegionsJob(regionSvc, clients,
+		func(ctx context.Context, s *Services) ([]dds.DBInstance, error) { return s.MongoDB.FetchInstances(ctx) },
+		func(instances []dds.DBInstance) tea.Msg {
+			return MongoDBInstancesLoadedMsg{Instances: instances}
+		})
+}
+
+// LoadElasticsearchInstancesAllRegions returns a job loading Elasticsearch instances from every region with resources
+func LoadElasticsearchInstancesAllRegions(regionSvc *service.RegionService, clients *client.AliyunClients) jobRunner {
+	return allRegionsJob(regionSvc, clients,
+		func(ctx context.Context, s *Services) ([]service.ElasticsearchInstance, error) {
+			return s.ES.FetchInstances(ctx)
+		},
+		func(instances []service.ElasticsearchInstance) tea.Msg {
+			return ElasticsearchInstancesLoadedMsg{Instances: instances}
+		})
+}
+
+// LoadKafkaInstancesAllRegions returns a job loading Kafka instances from every region with resources
+func LoadKafkaInstancesAllRegions(regionSvc *service.RegionService, clients *client.AliyunClients) jobRunner {
+	return allRegionsJob(regionSvc, clients,
+		func(ctx context.Context, s *Services) ([]alikafka.InstanceVO, error) {
+			return s.Kafka.FetchInstances(ctx)
+		},
+		func(instances []alikafka.InstanceVO) tea.Msg {
+			return KafkaInstancesLoadedMsg{Instances: instances}
+		})
+}
+
+// LoadRocketMQInstancesAllRegions returns a job loading RocketMQ instances from every region with resources
+func LoadRocketMQInstancesAllRegions(regionSvc *service.RegionService, clients *client.AliyunClients) jobRunner {
+	return allRegionsJob(regionSvc, clients,
+		func(ctx context.Context, s *Services) ([]service.RocketMQInstance, error) {
+			return s.RocketMQ.FetchInstances(ctx)
+		},
+		func(instances []service.RocketMQInstance) tea.Msg {
+			return RocketMQInstancesLoadedMsg{Instances: instances}
+		})
+}
+
+// LoadDNSTargets creates a command indexing the resources DNS records can
+// point at: ECS instances, elastic IPs and load balancers of every region
+// with resources, OSS buckets and CDN domains. Failures only mark the index
+// incomplete, so unmatched records are shown as unknown rather than dangling
+func LoadDNSTargets(ctx context.Context, regionSvc *service.RegionService, clients *client.AliyunClients, svc *service.DNSTargetService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		noProgress := func(done, total int64) {}
+		return DNSTargetsLoadedMsg{Index: buildDNSTargetIndex(ctx, noProgress, regionSvc, clients, svc)}
+	})
+}
+
+// buildDNSTargetIndex indexes the resources of every region with resources
+// and the account's global resources
+func buildDNSTargetIndex(ctx context.Context, report jobs.Reporter, regionSvc *service.RegionService, clients *client.AliyunClients, svc *service.DNSTargetService) *service.DNSTargetIndex {
+	targets, failed, err := fanOutRegions(ctx, report, regionSvc, clients,
+		func(ctx context.Context, s *Services) ([]service.DNSTarget, error) {
+			return s.DNSTargets.FetchRegionTargets(ctx)
+		})
+	complete := err == nil && len(failed) == 0
+	if err != nil {
+		// Fall back to the current region when regions cannot be listed
+		targets, _ = svc.FetchRegionTargets(ctx)
+	}
+
+	global, err := svc.FetchGlobalTargets(ctx)
+	complete = complete && err == nil
+	return service.NewDNSTargetIndex(append(targets, global...), complete)
+}
+
+// ScanDanglingDNS returns a job matching the A and CNAME records of every
+// domain against the account's resources. Progress counts the domains scanned
+func ScanDanglingDNS(regionSvc *service.RegionService, clients *client.AliyunClients, dns *service.DNSService, targets *service.DNSTargetService) jobRunner {
+	return func(ctx context.Context, report jobs.Reporter) (tea.Msg, error) {
+		noProgress := func(done, total int64) {}
+		index := buildDNSTargetIndex(ctx, noProgress, regionSvc, clients, targets)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		domains, err := dns.FetchDomains(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}, err
+		}
+
+		var dangling []service.DanglingDNSRecord
+		for i, domain := range domains {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			records, err := dns.FetchDomainRecords(ctx, domain.DomainName)
+			if err != nil {
+				return ErrorMsg{Err: err}, err
+			}
+			dangling = append(dangling, index.Dangling(domain.DomainName, records)...)
+			report(int64(i+1), int64(len(domains)))
+		}
+		return DanglingDNSLoadedMsg{Records: dangling, Complete: index.Complete()}, nil
+	}
+}
+
+// LoadTagFilter creates a command building a tag filter for a list page from
+// the resources carrying key=value, in every region with resources when
+// allRegions is set
+func LoadTagFilter(ctx context.Context, regionSvc *service.RegionService, clients *client.AliyunClients, svc *service.TagService, page PageType, key, value string, allRegions bool) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		var resources []service.TaggedResource
+		var err error
+		if allRegions {
+			noProgress := func(done, total int64) {}
+			resources, _, err = fanOutRegions(ctx, noProgress, regionSvc, clients,
+				func(ctx context.Context, s *Services) ([]service.TaggedResource, error) {
+					return s.Tags.FetchTaggedResources(ctx, key, value)
+				})
+		} else {
+			resources, err = svc.FetchTaggedResources(ctx, key, value)
+		}
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+
+		ids := make(map[string]bool, len(resources))
+		for _, r := range resources {
+			ids[r.ID] = true
+		}
+		return TagFilterLoadedMsg{Page: page, Filter: &pages.TagFilter{Key: key, Value: value, ResourceIDs: ids}}
+	})
+}
+
+// handleAllRegionsLoaded applies the wrapped load and reports regions that failed
+func (m Model) handleAllRegionsLoaded(msg AllRegionsLoadedMsg) (tea.Model, tea.Cmd) {
+	model, cmd := m.update(msg.Loaded)
+	next, ok := model.(Model)
+	if !ok || len(msg.Failed) == 0 {
+		return model, cmd
+	}
+
+	regions := make([]string, 0, len(msg.Failed))
+	for region := range msg.Failed {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	lines := make([]string, len(regions))
+	for i, region := range regions {
+		lines[i] = fmt.Sprintf("%s: %v", region, msg.Failed[region])
+	}
+	next.modal = components.NewErrorModal(fmt.Sprintf(i18n.T(i18n.KeyAllRegionsPartial), strings.Join(lines, "\n")))
+	return next, cmd
+}
+
+// enterAllRegions switches list pages to aggregate every region with resources.
+// Clients stay on the profile's region until a resource in another region is opened
+func (m Model) enterAllRegions() (tea.Model, tea.Cmd) {
+	m = m.clearCachedData()
+	m.allRegions = true
+	m = m.scopeServices(m.region)
+
+	label := i18n.T(i18n.KeyAllRegions)
+	m.header = m.header.SetRegion(label).SetTitle(i18n.T(i18n.KeyAppTitle))
+	m.modeLine = m.modeLine.SetRegion(label)
+
+	m.currentPage = PageMenu
+	m.leaveAllPages()
+	m.previousPages = []PageType{}
+	m.hasAlternate = false
+
+	m.modal = components.NewSuccessModal(i18n.T(i18n.KeyAllRegionsEnabled))
+	return m, nil
+}
+
+// regionSelection returns the entry to mark as current in the region modal
+func (m Model) regionSelection() string {
+	if m.allRegions {
+		return components.AllRegionsID
+	}
+	return m.region
+}
+
+// navigationRegion returns the region sub-pages should query when leaving the
+// current page in All Regions mode: the selected row's region on aggregated
+// lists, the profile's region from the menu, or "" to keep the current scope
+func (m Model) navigationRegion() string {
+	switch m.currentPage {
+	case PageMenu:
+		return m.region
+	case PageECSList:
+		if inst := m.ecsListPage.SelectedInstance(); inst != nil {
+			return inst.RegionId
+		}
+	case PageSLBList:
+		if lb := m.slbListPage.SelectedLoadBalancer(); lb != nil {
+			return lb.RegionId
+		}
+	case PageRDSList:
+		if inst := m.rdsListPage.SelectedInstance(); inst != nil {
+			return inst.RegionId
+		}
+	case PageRedisList:
+		if inst := m.redisListPage.SelectedInstance(); inst != nil {
+			return inst.RegionId
+		}
+	case PageMongoDBList:
+		if inst := m.mongoListPage.SelectedInstance(); inst != nil {
+			return inst.RegionId
+		}
+	case PageElasticsearchList:
+		if inst := m.esListPage.SelectedInstance(); inst != nil {
+			return inst.RegionId
+		}
+	case PageKafkaList:
+		if inst := m.kafkaListPage.SelectedInstance(); inst != nil {
+			return inst.RegionId
+		}
+	case PageRocketMQList:
+		if inst := m.rocketmqListPage.SelectedInstance(); inst != nil {
+			return inst.RegionId
+		}
+	}
+	return ""
+}
+
+// scopeServices points clients and services at region, if it differs from the current scope
+func (m Model) scopeServices(region string) Model {
+	if region == "" || region == m.clients.GetConfig().RegionID {
+		return m
+	}
+	newClients, err := m.clients.UpdateRegion(region)
+	if err != nil {
+		return m
+	}
+	m.clients = newClients
+	m.services = buildServices(newClients)
+	return m
+}
@@ -0,0 +1,39 @@
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/logging"
+)
+
+// traceTickInterval is how often the API trace page shows the calls made
+// since
+const traceTickInterval = time.Second
+
+// traceTickMsg refreshes the API trace page
+type traceTickMsg struct{}
+
+// scheduleTraceTick starts the API trace page's ticker unless it is already
+// running
+func (m Model) scheduleTraceTick() (Model, tea.Cmd) {
+	if m.traceTicking {
+		return m, nil
+	}
+	m.traceTicking = true
+	return m, tea.Tick(traceTickInterval, func(time.Time) tea.Msg {
+		return traceTickMsg{}
+	})
+}
+
+// handleTraceTick refreshes the calls and keeps ticking while the API trace
+// page shows
+func (m Model) handleTraceTick() (Model, tea.Cmd) {
+	m.traceTicking = false
+	if m.currentPage != PageAPITrace {
+		return m, nil
+	}
+	m.apiTracePage = m.apiTracePage.SetData(logging.Recent())
+	return m.scheduleTraceTick()
+}
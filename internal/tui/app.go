@@ -2,15 +2,27 @@ package tui
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+	cloudconfig "github.com/aliyun/alibaba-cloud-sdk-go/services/config"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr_ee"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/nlb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+
+	"aliyun-tui-viewer/internal/cache"
 	"aliyun-tui-viewer/internal/client"
 	"aliyun-tui-viewer/internal/config"
 	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/jobs"
+	"aliyun-tui-viewer/internal/logging"
 	"aliyun-tui-viewer/internal/service"
 	"aliyun-tui-viewer/internal/tui/components"
 	"aliyun-tui-viewer/internal/tui/pages"
@@ -21,6 +33,8 @@ type Model struct {
 	// Current state
 	currentPage   PageType
 	previousPages []PageType // Navigation stack for back navigation
+	alternatePage PageType   // Previously viewed page for ctrl+^ toggle
+	hasAlternate  bool
 
 	// Profile
 	profile  string
@@ -36,58 +50,233 @@ type Model struct {
 	clients  *client.AliyunClients
 
 	// Page models
-	menuPage           pages.MenuModel
-	ecsListPage        pages.ECSListModel
-	ecsDetailPage      pages.ECSDetailModel // Formatted detail view
-	ecsJSONDetailPage  pages.DetailModel    // JSON detail view
-	ecsDiskPage        pages.ECSDiskModel   // Disk/storage page
-	ecsENIPage         pages.ECSENIModel    // Network interfaces page
-	sgListPage         pages.SecurityGroupsModel
-	sgRulesPage        pages.SecurityGroupRulesModel
-	sgInstancesPage    pages.ECSListModel
-	instSGPage         pages.SecurityGroupsModel
-	dnsDomainsPage     pages.DNSDomainsModel
-	dnsRecordsPage     pages.DNSRecordsModel
-	slbListPage             pages.SLBListModel
-	slbDetailPage           pages.DetailModel
-	slbListenersPage        pages.SLBListenersModel
-	slbVServerPage          pages.SLBVServerGroupsModel
-	slbBackendPage          pages.SLBBackendServersModel
-	slbForwardingRulesPage  pages.SLBForwardingRulesModel
-	slbDefaultServersPage   pages.SLBDefaultServersModel
-	ossBucketsPage     pages.OSSBucketsModel
-	ossObjectsPage     pages.OSSObjectsModel
-	ossDetailPage      pages.DetailModel
-	rdsListPage        pages.RDSListModel
-	rdsDetailPage      pages.DetailModel
-	rdsDatabasesPage   pages.RDSDatabasesModel
-	rdsAccountsPage    pages.RDSAccountsModel
-	redisListPage      pages.RedisListModel
-	redisDetailPage    pages.DetailModel
-	redisAccountsPage  pages.RedisAccountsModel
-	rocketmqListPage   pages.RocketMQListModel
-	rocketmqDetailPage pages.DetailModel
-	rocketmqTopicsPage pages.RocketMQTopicsModel
-	rocketmqGroupsPage pages.RocketMQGroupsModel
-	finderPage         pages.FinderModel
+	menuPage               pages.MenuModel
+	ecsListPage            pages.ECSListModel
+	ecsDetailPage          pages.ECSDetailModel // Formatted detail view
+	ecsJSONDetailPage      pages.DetailModel    // JSON detail view
+	ecsDiskPage            pages.ECSDiskModel   // Disk/storage page
+	ecsENIPage             pages.ECSENIModel    // Network interfaces page
+	sgListPage             pages.SecurityGroupsModel
+	sgRulesPage            pages.SecurityGroupRulesModel
+	sgInstancesPage        pages.ECSListModel
+	instSGPage             pages.SecurityGroupsModel
+	dnsDomainsPage         pages.DNSDomainsModel
+	dnsRecordsPage         pages.DNSRecordsModel
+	slbListPage            pages.SLBListModel
+	slbDetailPage          pages.SLBDetailModel // Formatted detail view
+	slbJSONDetailPage      pages.DetailModel    // JSON detail view
+	slbListenersPage       pages.SLBListenersModel
+	slbVServerPage         pages.SLBVServerGroupsModel
+	slbBackendPage         pages.SLBBackendServersModel
+	slbForwardingRulesPage pages.SLBForwardingRulesModel
+	slbDefaultServersPage  pages.SLBDefaultServersModel
+	ossBucketsPage         pages.OSSBucketsModel
+	ossObjectsPage         pages.OSSObjectsModel
+	ossDetailPage          pages.DetailModel
+	ossPreviewPage         pages.OSSObjectPreviewModel
+	rdsListPage            pages.RDSListModel
+	rdsDetailPage          pages.RDSDetailModel
+	rdsDatabasesPage       pages.RDSDatabasesModel
+	rdsAccountsPage        pages.RDSAccountsModel
+	redisListPage          pages.RedisListModel
+	redisDetailPage        pages.RedisDetailModel
+	redisAccountsPage      pages.RedisAccountsModel
+	rocketmqListPage       pages.RocketMQListModel
+	rocketmqDetailPage     pages.RocketMQDetailModel
+	rocketmqTopicsPage     pages.RocketMQTopicsModel
+	rocketmqGroupsPage     pages.RocketMQGroupsModel
+	finderPage             pages.FinderModel
+	jobsPage               pages.JobsModel
+	ramUsersPage           pages.RAMUsersModel
+	ramRolesPage           pages.RAMRolesModel
+	ramPoliciesPage        pages.RAMPoliciesModel
+	ramUserPoliciesPage    pages.RAMPoliciesModel
+	ramDetailPage          pages.DetailModel
+	ecsMetricsPage         pages.MetricsModel
+	rdsMetricsPage         pages.MetricsModel
+	slsProjectsPage        pages.SLSProjectsModel
+	slsConfigsPage         pages.SLSLogtailConfigsModel
+	slsGroupsPage          pages.SLSMachineGroupsModel
+	slsMachinesPage        pages.SLSMachinesModel
+	slsCoveragePage        pages.SLSCoverageModel
+	slsDetailPage          pages.DetailModel
+	configRulesPage        pages.ConfigRulesModel
+	configResultsPage      pages.ConfigResultsModel
+	configDetailPage       pages.DetailModel
+	tagBrowserPage         pages.TagBrowserModel
+	tagResourcesPage       pages.TagResourcesModel
+	tagDetailPage          pages.DetailModel
+	billingPage            pages.BillingModel
+	billingDetailPage      pages.DetailModel
+	dnsDanglingPage        pages.DNSDanglingModel
+	ramRolePoliciesPage    pages.RAMPoliciesModel
+	natListPage            pages.NATListModel
+	natDetailPage          pages.DetailModel
+	snatEntriesPage        pages.SNATEntriesModel
+	dnatEntriesPage        pages.DNATEntriesModel
+	ossVersionsPage        pages.OSSObjectVersionsModel
+	albListPage            pages.ALBListModel
+	albDetailPage          pages.DetailModel
+	albListenersPage       pages.ALBListenersModel
+	albRulesPage           pages.ALBRulesModel
+	albRuleDetailPage      pages.DetailModel
+	albServerGroupsPage    pages.ALBServerGroupsModel
+	nlbListPage            pages.NLBListModel
+	nlbDetailPage          pages.DetailModel
+	nlbListenersPage       pages.NLBListenersModel
+	nlbServerGroupsPage    pages.NLBServerGroupsModel
+	lbServersPage          pages.LBServersModel
+	ossBucketDetailPage    pages.DetailModel
+	ackClustersPage        pages.ACKClustersModel
+	ackClusterDetailPage   pages.DetailModel
+	ackNodePoolsPage       pages.ACKNodePoolsModel
+	ackNodesPage           pages.ACKNodesModel
+	ossScanPage            pages.OSSObjectScanModel
+	acrInstancesPage       pages.ACRInstancesModel
+	acrNamespacesPage      pages.ACRNamespacesModel
+	acrReposPage           pages.ACRRepositoriesModel
+	acrTagsPage            pages.ACRTagsModel
+	acrTagDetailPage       pages.DetailModel
+	fcServicesPage         pages.FCServicesModel
+	fcFunctionsPage        pages.FCFunctionsModel
+	fcDetailPage           pages.DetailModel
+	mongoListPage          pages.MongoDBListModel
+	mongoDetailPage        pages.MongoDBDetailModel
+	mongoJSONPage          pages.DetailModel
+	mongoAccountsPage      pages.MongoDBAccountsModel
+	rdsJSONPage            pages.DetailModel
+	redisJSONPage          pages.DetailModel
+	rocketmqJSONPage       pages.DetailModel
+	esListPage             pages.ElasticsearchListModel
+	esDetailPage           pages.ElasticsearchDetailModel
+	esJSONPage             pages.DetailModel
+	zoneCapacityPage       pages.ZoneCapacityModel
+	kafkaListPage          pages.KafkaListModel
+	kafkaDetailPage        pages.KafkaDetailModel
+	kafkaJSONPage          pages.DetailModel
+	kafkaTopicsPage        pages.KafkaTopicsModel
+	kafkaGroupsPage        pages.KafkaGroupsModel
+	rocketmqLagPage        pages.RocketMQLagModel
+	rocketmqMessagesPage   pages.RocketMQMessagesModel
+	redisParamsPage        pages.RedisParametersModel
+	redisMetricsPage       pages.MetricsModel
+	recycleBinPage         pages.RecycleBinModel
+	ossDeletedPage         pages.OSSDeletedObjectsModel
+	redisBackupsPage       pages.RedisBackupsModel
+	rdsBackupsPage         pages.RDSBackupsModel
+	rdsBinlogsPage         pages.RDSBinlogsModel
+	rdsSlowLogPage         pages.RDSSlowLogModel
+	changelogPage          pages.ChangelogModel
+	recentPage             pages.RecentModel
+	usagePage              pages.UsageModel
+	dnsImportPage          pages.DNSImportModel
+	apiTracePage           pages.APITraceModel
 
 	// Services for finder
 	finderService *service.FinderService
 
+	// Resources DNS records are matched against, built once per DNS visit
+	dnsTargets        *service.DNSTargetIndex
+	dnsTargetsLoading bool
+
 	// Input history for finder
 	inputHistory *config.InputHistory
 
+	// Resources whose detail pages were opened, newest first
+	recent *config.RecentHistory
+
+	// Pages opened and actions taken, counted locally
+	usage *config.UsageStats
+
+	// Background jobs (downloads, multi-region fetches, ...)
+	jobManager  *jobs.Manager
+	jobsTicking bool
+	loadJob     int // Job feeding the current page's loading state, 0 if none
+
+	loadingTicking bool // Whether the loading view redraws to show retries
+	traceTicking   bool // Whether the API trace page refreshes its calls
+
+	// SSH port forwards, held open by jobs
+	tunnels *tunnelSet
+
+	// What's New page opened on startup after an upgrade
+	showWhatsNew  bool
+	whatsNewSince string
+
 	// Shared components
 	header   components.HeaderModel
 	modeLine components.ModeLineModel
 	search   components.SearchModel
 	modal    components.ModalModel
+	help     components.HelpModel          // The ? overlay
+	columns  components.ColumnChooserModel // The c overlay of table pages
+
+	// Columns picked with the column chooser, by page name
+	columnLayouts map[string]config.ColumnLayout
 
 	// UI state
 	width, height int
 	loading       bool
 	err           error
 
+	// All Regions mode aggregates list pages across regions
+	allRegions bool
+
+	// Read-only mode refuses changes to cloud resources; the flag forces it
+	// for every profile
+	readOnly     bool
+	readOnlyFlag bool
+
+	// List page the current region has no resources for, if any
+	emptyState *emptyState
+
+	// Resource finder query being searched, if any
+	finderSearch *finderSearch
+
+	// Profile and region the menu counts are of, and the counts each region's
+	// changes are shown against, by profile and region
+	menuCountsKey      string
+	menuCountBaselines map[string]map[string]int
+
+	// Profile and products the menu marks as without permission
+	deniedKey string
+
+	// The | split view previews the highlighted row of list pages, from the
+	// row and width the preview was built for
+	split        bool
+	preview      pages.DetailModel
+	previewData  interface{}
+	previewWidth int
+
+	// Open tabs, each a model with its own pages and navigation stack, and
+	// the index of this one, whose entry is stale until it is left. Empty
+	// while a single tab is open
+	tabs []Model
+	tab  int
+
+	// List pages fetched in the background, and the one shown from the cache
+	// until it is fetched again
+	prefetch *prefetchCache
+	staleKey string
+
+	// Contexts of the pages' API calls, cancelled as the pages are left
+	loads *pageLoads
+
+	// Latest page by page load of ECS instances, and whether one is under way
+	ecsStream int64
+	ecsPaging bool
+
+	// Terminal bell after long loads
+	bellMode    string
+	focused     bool
+	loadStarted time.Time
+
+	// Pages of the startup workspace still waiting to be opened
+	workspacePages []PageType
+
+	// Detail page of a resumed session, opened after its workspace pages
+	resumeResource *config.RecentResource
+
 	// Yank tracker for double-y
 	yankLastTime time.Time
 	yankCount    int
@@ -97,18 +286,56 @@ type Model struct {
 	keys   KeyMap
 }
 
+// Options configures how the application starts
+type Options struct {
+	Workspace string // Name of a workspace from the config file to open
+	Profile   string // Profile to use instead of the current one
+	Region    string // Region to use instead of the profile's region_id
+	Resume    bool   // Restore the session saved on the last quit
+	ReadOnly  bool   // Disable changes to cloud resources whatever the profile says
+}
+
 // New creates a new application model
-func New() (*Model, error) {
-	// Load configuration
-	cfg, err := config.LoadAliyunConfig()
-	if err != nil {
-		return nil, fmt.Errorf("loading config: %w", err)
-	}
+func New(opts Options) (*Model, error) {
+	var cfg *config.Config
+	var currentProfile string
+	var workspacePages []PageType
+	var resumeResource *config.RecentResource
+	var err error
+
+	if opts.Workspace != "" {
+		// Load the workspace's profile and region without switching the current profile
+		cfg, workspacePages, err = loadWorkspace(opts.Workspace, opts.Profile, opts.Region)
+		if err != nil {
+			return nil, err
+		}
+		currentProfile = cfg.Profile
+	} else if opts.Resume {
+		// Restore the last session's profile, region and pages
+		cfg, workspacePages, resumeResource, err = loadSession(opts.Profile, opts.Region)
+		if err != nil {
+			return nil, fmt.Errorf("resuming session: %w", err)
+		}
+		currentProfile = cfg.Profile
+	} else if opts.Profile != "" || opts.Region != "" {
+		// Load the requested profile and region without switching the current profile
+		cfg, err = config.LoadProfileConfig(opts.Profile, opts.Region)
+		if err != nil {
+			return nil, fmt.Errorf("loading config: %w", err)
+		}
+		currentProfile = cfg.Profile
+	} else {
+		// Load configuration
+		cfg, err = config.LoadAliyunConfig()
+		if err != nil {
+			return nil, fmt.Errorf("loading config: %w", err)
+		}
 
-	// Get current profile name
-	currentProfile, err := config.GetCurrentProfileName()
-	if err != nil {
-		return nil, fmt.Errorf("getting current profile: %w", err)
+		// Get current profile name
+		currentProfile, err = config.GetCurrentProfileName()
+		if err != nil {
+			return nil, fmt.Errorf("getting current profile: %w", err)
+		}
 	}
 
 	// Get all profiles
@@ -118,44 +345,26 @@ func New() (*Model, error) {
 	}
 
 	// Create clients
-	clientConfig := &client.Config{
-		AccessKeyID:     cfg.AccessKeyID,
-		AccessKeySecret: cfg.AccessKeySecret,
-		RegionID:        cfg.RegionID,
-		OssEndpoint:     cfg.OssEndpoint,
-	}
-
-	clients, err := client.NewAliyunClients(clientConfig)
+	clients, err := client.NewAliyunClients(client.NewProfileConfig(cfg))
 	if err != nil {
 		return nil, fmt.Errorf("creating clients: %w", err)
 	}
 
-	// Create services
-	services := &Services{
-		ECS:      service.NewECSService(clients.ECS),
-		DNS:      service.NewDNSService(clients.DNS),
-		SLB:      service.NewSLBService(clients.SLB),
-		RDS:      service.NewRDSService(clients.RDS),
-		OSS:      service.NewOSSServiceWithCredentials(clients.OSS, cfg.AccessKeyID, cfg.AccessKeySecret, cfg.OssEndpoint),
-		Redis:    service.NewRedisService(clients.Redis),
-		RocketMQ: service.NewRocketMQService(clients.RocketMQ),
-	}
+	// Create services, their list fetches reusing results for cache_ttl
+	cache.Default.SetTTL(config.GetCacheTTL())
+	services := buildServices(clients)
 
 	// Create finder service
-	finderService := service.NewFinderService(
-		services.ECS,
-		services.DNS,
-		services.SLB,
-		services.RDS,
-		services.Redis,
-		services.RocketMQ,
-	)
+	finderService := newFinderService(services)
 
 	// Load input history
 	inputHistory := config.LoadInputHistory()
 
+	// Load usage statistics
+	usage := config.LoadUsageStats()
+
 	// Create region service
-	regionService := service.NewRegionService(cfg.AccessKeyID, cfg.AccessKeySecret, currentProfile)
+	regionService := service.NewRegionService(client.TeaCredential(clients.GetConfig().Credentials), currentProfile)
 
 	m := &Model{
 		currentPage:   PageMenu,
@@ -168,32 +377,164 @@ func New() (*Model, error) {
 		clients:       clients,
 		finderService: finderService,
 		inputHistory:  inputHistory,
-		styles:        GlobalStyles,
+		recent:        config.LoadRecentHistory(),
+		usage:         usage,
+		styles:        GlobalStyles(),
 		keys:          GlobalKeyMap,
+		jobManager:    jobs.NewManager(),
+		tunnels:       newTunnelSet(),
+		bellMode:      config.GetBellMode(),
+		focused:       true,
+
+		menuCountBaselines: make(map[string]map[string]int),
+		prefetch:           newPrefetchCache(),
+		loads:              newPageLoads(),
+		readOnlyFlag:       opts.ReadOnly,
+
+		workspacePages: workspacePages,
+		resumeResource: resumeResource,
+		columnLayouts:  config.LoadColumnLayouts(),
 	}
 
 	// Initialize page models
 	m.menuPage = pages.NewMenuModel()
-	m.header = components.NewHeaderModel(i18n.T(i18n.KeyAppTitle), currentProfile, cfg.RegionID)
+	if config.GetMenuOrder() == config.MenuOrderUsage {
+		m.menuPage = m.menuPage.OrderByUsage(usage.Pages)
+	}
+	m.header = components.NewHeaderModel(i18n.T(i18n.KeyAppTitle), currentProfile, cfg.RegionID).SetRole(cfg.AssumedRole())
 	m.modeLine = components.NewModeLineModel(currentProfile, cfg.RegionID, PageMenu)
+	*m = m.applyReadOnly(cfg)
 	m.search = components.NewSearchModel()
 	m.modal = components.NewModalModel()
 
+	// A workspace or resumed session opens its own pages, so What's New waits for a plain start
+	if len(workspacePages) == 0 && resumeResource == nil {
+		m.whatsNewSince, m.showWhatsNew = whatsNewSince(len(inputHistory.Items) > 0)
+	}
+
 	return m, nil
 }
 
 // Init implements tea.Model
 func (m Model) Init() tea.Cmd {
-	return tea.Batch(
-		tea.EnterAltScreen,
-		m.menuPage.Init(),
-	)
+	cmds := []tea.Cmd{tea.EnterAltScreen, m.menuPage.Init()}
+	if m.showWhatsNew {
+		cmds = append(cmds, openWhatsNew(m.whatsNewSince))
+	}
+	return tea.Batch(cmds...)
 }
 
+// Close tears down the port forwards still open and saves the usage
+// statistics. Call it once the program has exited, as the ssh processes
+// would otherwise outlive the TUI
+func (m Model) Close() {
+	m.tunnels.closeAll()
+	m.loads.close()
+	_ = m.usage.Save() // Ignore save errors
+}
+
+// bellThreshold is how long a load must take before it rings the bell on completion
+const bellThreshold = 3 * time.Second
+
 // Update implements tea.Model
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg.(type) {
+	case tea.FocusMsg:
+		m.focused = true
+		return m, nil
+	case tea.BlurMsg:
+		m.focused = false
+		return m, nil
+	}
+
+	wasLoading := m.loading
+	model, cmd := m.update(msg)
+	next, ok := model.(Model)
+	if !ok {
+		return model, cmd
+	}
+
+	// Open the startup workspace one page at a time, as each page finishes loading
+	if len(next.workspacePages) > 0 {
+		var openCmd tea.Cmd
+		next, openCmd = next.openNextWorkspacePage()
+		cmd = tea.Batch(cmd, openCmd)
+	} else if next.resumeResource != nil {
+		var openCmd tea.Cmd
+		next, openCmd = next.openResumedResource()
+		cmd = tea.Batch(cmd, openCmd)
+	}
+
+	// Replay requests that failed on expired credentials after refreshing them
+	cmd = withCredentialRefresh(next.credentials(), cmd)
+
+	// Count the menu's services whenever it shows another profile or region's
+	var countCmd tea.Cmd
+	next, countCmd = next.refreshMenuCounts()
+	cmd = tea.Batch(cmd, countCmd)
+
+	// Mark the menu's services the profile turned out to have no permission for
+	next = next.refreshDeniedMarks()
+
+	// Show the columns picked for the page, also once it is recreated
+	next = next.restoreColumnLayout()
+
+	// Keep the split view's preview on the highlighted row
+	next = next.refreshPreview()
+
+	// Name the tabs after their pages
+	next = next.refreshTabs()
+
+	// Fetch the most opened list pages in the background while the menu shows
+	cmd = tea.Batch(cmd, next.startPrefetch())
+
+	// Show how old the page's cached data is
+	next.modeLine = next.modeLine.SetCacheStatus(next.cacheStatus())
+
+	// Track load duration to ring the bell when a long load finishes
+	switch {
+	case !wasLoading && next.loading:
+		next.loadStarted = time.Now()
+		var tick tea.Cmd
+		next, tick = next.scheduleLoadingTick()
+		cmd = tea.Batch(cmd, tick)
+	case wasLoading && !next.loading && next.shouldRingBell():
+		cmd = tea.Batch(cmd, RingBell())
+	}
+	return next, cmd
+}
+
+// shouldRingBell reports whether the load that just finished warrants a bell
+func (m Model) shouldRingBell() bool {
+	if m.loadStarted.IsZero() {
+		return false
+	}
+	return m.shouldRingBellAfter(time.Since(m.loadStarted))
+}
+
+// shouldRingBellAfter reports whether work that took elapsed warrants a bell
+func (m Model) shouldRingBellAfter(elapsed time.Duration) bool {
+	if elapsed < bellThreshold {
+		return false
+	}
+	switch m.bellMode {
+	case config.BellAlways:
+		return true
+	case config.BellUnfocused:
+		return !m.focused
+	default:
+		return false
+	}
+}
+
+// update handles a message and returns the updated model
+func (m Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
+	if m.readOnly && isMutatingRequest(msg) {
+		return m.refuseInReadOnly()
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		// Handle modal first if active
@@ -206,6 +547,20 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Batch(cmds...)
 		}
 
+		// The help overlay takes the keys while it shows
+		if m.help.Visible {
+			var cmd tea.Cmd
+			m.help, cmd = m.help.Update(msg)
+			return m, cmd
+		}
+
+		// So does the column chooser
+		if m.columns.Visible {
+			var cmd tea.Cmd
+			m.columns, cmd = m.columns.Update(msg)
+			return m, cmd
+		}
+
 		// Handle search input if active
 		if m.search.Active {
 			var cmd tea.Cmd
@@ -222,12 +577,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Q (capital) always quits
 			return m, tea.Quit
 
-		case key.Matches(msg, m.keys.Profile):
+		case key.Matches(msg, m.keys.Profile) && m.currentPage != PageRedisList:
+			// Except on the Redis list, where P opens the parameters
+			m.usage.RecordAction("profile.switch")
 			m.modal = components.NewProfileSelectionModal(m.profiles, m.profile)
 			return m, nil
 
 		case key.Matches(msg, m.keys.FindResource):
 			// Open resource finder input dialog with history
+			m.usage.RecordAction("finder.find")
 			m.modal = components.NewInputModalWithHistory(
 				i18n.T(i18n.KeyModalResourceFind),
 				i18n.T(i18n.KeyModalInputPrompt),
@@ -236,11 +594,41 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			)
 			return m, nil
 
+		case key.Matches(msg, m.keys.Jobs):
+			if m.currentPage != PageJobs {
+				return m.navigateTo(PageJobs, nil)
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.APITrace):
+			if m.currentPage != PageAPITrace {
+				m.usage.RecordAction("trace.open")
+				return m.navigateTo(PageAPITrace, nil)
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.Help):
+			m.usage.RecordAction("help.open")
+			return m.openHelp()
+
+		case key.Matches(msg, m.keys.Recent):
+			m.usage.RecordAction("recent.jump")
+			return m.openRecentPicker()
+
 		case key.Matches(msg, m.keys.Region):
 			// Show loading modal and start async region loading
-			m.modal = components.NewRegionSelectionModal(m.region)
+			m.usage.RecordAction("region.switch")
+			m.modal = components.NewRegionSelectionModal(m.regionSelection())
 			return m, m.loadRegions()
 
+		case m.showsEmptyState() && key.Matches(msg, m.keys.Enter):
+			// enter on an empty list jumps to the region with the most resources
+			return m.jumpToPopulousRegion()
+
+		case m.searchingFinder() && key.Matches(msg, m.keys.Back):
+			// esc while the finder searches cancels the search
+			return m.cancelFinderSearch()
+
 		case key.Matches(msg, m.keys.Back):
 			// q/esc goes back, but not on menu page (menu uses Q to quit)
 			if m.currentPage != PageMenu {
@@ -248,13 +636,62 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			// On menu page, esc does nothing, q is handled by menu shortcuts
 
+		case key.Matches(msg, m.keys.Refresh):
+			// F5 drops the cached results and reloads the list page
+			m.usage.RecordAction("page.refresh")
+			return m.refreshPage()
+
+		case key.Matches(msg, m.keys.TogglePage):
+			// ctrl+^ flips between the current and the previously viewed page
+			m.usage.RecordAction("page.toggle")
+			return m.toggleAlternatePage()
+
+		case key.Matches(msg, m.keys.SplitView):
+			// | shows the highlighted row next to the list pages
+			if _, ok := m.previewSelection(); ok {
+				m.usage.RecordAction("split.toggle")
+				return m.toggleSplit(), nil
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.NewTab):
+			// ctrl+t opens a tab on the main menu
+			m.usage.RecordAction("tab.new")
+			return m.newTab(), nil
+
+		case key.Matches(msg, m.keys.CloseTab):
+			return m.closeTab(), nil
+
+		case key.Matches(msg, m.keys.NextTab):
+			return m.switchTab((m.tab + 1) % m.tabCount()), nil
+
+		case key.Matches(msg, m.keys.PrevTab):
+			return m.switchTab((m.tab + m.tabCount() - 1) % m.tabCount()), nil
+
+		case key.Matches(msg, m.keys.GoToTab):
+			// alt+1 to alt+9 switch to the tab of that number
+			return m.goToTab(msg.String()), nil
+
+		case key.Matches(msg, m.keys.Language):
+			m.usage.RecordAction("language.switch")
+			return m.switchLanguage(), nil
+
 		case key.Matches(msg, m.keys.Search):
 			// Don't activate search on menu page
 			if m.currentPage != PageMenu {
+				m.usage.RecordAction("table.search")
 				m.search = m.search.Activate()
 				return m, m.search.Focus()
 			}
 
+		case key.Matches(msg, m.keys.Filter):
+			// f edits the row filter on table pages
+			if m.isFilterablePage() {
+				m.usage.RecordAction("table.filter")
+				m.search = m.search.ActivateFilter()
+				return m, m.search.Focus()
+			}
+
 		case key.Matches(msg, m.keys.SearchNext):
 			// n for next search match
 			if m.search.Query() != "" {
@@ -268,6 +705,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+	case tea.MouseMsg:
+		return m.handleMouse(msg)
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
@@ -280,6 +720,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.header = m.header.SetWidth(m.width)
 		m.menuPage = m.menuPage.SetSize(m.width, contentHeight)
 		m.modeLine = m.modeLine.SetWidth(m.width)
+		m.help = m.help.SetSize(m.width, m.height)
 
 		// Update current page size
 		m = m.updateCurrentPageSize(contentHeight)
@@ -287,7 +728,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case ErrorMsg:
 		m.err = msg.Err
 		m.loading = false
-		m.modal = components.NewErrorModal(msg.Err.Error())
+		m.finderSearch = nil
+		// Once a product is known to be denied, its errors only show on the mode line
+		var known bool
+		if m, known = m.notePermissionError(msg.Err); !known {
+			m.modal = components.NewErrorModal(msg.Err.Error())
+		}
 
 	case ModalDismissedMsg:
 		m.modal = m.modal.Hide()
@@ -307,14 +753,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		// Recreate clients with new credentials
-		clientConfig := &client.Config{
-			AccessKeyID:     cfg.AccessKeyID,
-			AccessKeySecret: cfg.AccessKeySecret,
-			RegionID:        cfg.RegionID,
-			OssEndpoint:     cfg.OssEndpoint,
-		}
-
-		newClients, err := client.NewAliyunClients(clientConfig)
+		newClients, err := client.NewAliyunClients(client.NewProfileConfig(cfg))
 		if err != nil {
 			m.modal = components.NewErrorModal(fmt.Sprintf("Failed to create clients: %v", err))
 			return m, nil
@@ -322,19 +761,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Update clients and recreate services
 		m.clients = newClients
-		m.services = &Services{
-			ECS:      service.NewECSService(newClients.ECS),
-			DNS:      service.NewDNSService(newClients.DNS),
-			SLB:      service.NewSLBService(newClients.SLB),
-			RDS:      service.NewRDSService(newClients.RDS),
-			OSS:      service.NewOSSServiceWithCredentials(newClients.OSS, cfg.AccessKeyID, cfg.AccessKeySecret, cfg.OssEndpoint),
-			Redis:    service.NewRedisService(newClients.Redis),
-			RocketMQ: service.NewRocketMQService(newClients.RocketMQ),
-		}
-		m.finderService = service.NewFinderService(
-			m.services.ECS, m.services.DNS, m.services.SLB,
-			m.services.RDS, m.services.Redis, m.services.RocketMQ,
-		)
+		m.services = buildServices(newClients)
+		m.finderService = newFinderService(m.services)
 
 		// Clear cached data first
 		m = m.clearCachedData()
@@ -342,18 +770,22 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Update profile, region and mode line AFTER clearing cache
 		m.profile = msg.Profile
 		m.region = cfg.RegionID // Reset to profile's default region
-		m.header = m.header.SetProfile(msg.Profile).SetRegion(cfg.RegionID).SetTitle(i18n.T(i18n.KeyAppTitle))
+		m.allRegions = false
+		m.header = m.header.SetProfile(msg.Profile).SetRole(cfg.AssumedRole()).SetRegion(cfg.RegionID).SetTitle(i18n.T(i18n.KeyAppTitle))
 		m.modeLine = m.modeLine.SetProfile(msg.Profile).SetRegion(cfg.RegionID)
+		m = m.applyReadOnly(cfg)
 
 		// Update region service for new profile (cache is per-profile)
-		m.regionService = service.NewRegionService(cfg.AccessKeyID, cfg.AccessKeySecret, msg.Profile)
+		m.regionService = service.NewRegionService(client.TeaCredential(newClients.GetConfig().Credentials), msg.Profile)
 
 		// Set page state
 		m.currentPage = PageMenu
+		m.leaveAllPages()
 		m.previousPages = []PageType{}
+		m.hasAlternate = false
 
 		// Show success message
-		m.modal = components.NewSuccessModal(fmt.Sprintf("Switched to profile: %s (region: %s)", msg.Profile, cfg.RegionID))
+		m.modal = components.NewSuccessModal(fmt.Sprintf(i18n.T(i18n.KeyProfileSwitched), msg.Profile, cfg.RegionID))
 		return m, nil
 
 	case ProfileSwitchedMsg:
@@ -364,63 +796,66 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.header = m.header.SetProfile(msg.ProfileName).SetTitle(i18n.T(i18n.KeyAppTitle))
 		m.modeLine = m.modeLine.SetProfile(msg.ProfileName)
 		m.currentPage = PageMenu
+		m.leaveAllPages()
 		m.previousPages = []PageType{}
+		m.hasAlternate = false
 
 	case RegionsLoadedMsg:
 		// Update modal with loaded regions
 		m.regions = msg.Regions
-		m.modal = m.modal.SetRegions(msg.Regions, m.region)
+		m.modal = m.modal.SetRegions(msg.Regions, m.regionSelection())
 		return m, nil
 
 	case components.RegionSelectedMsg:
 		// Region switching - called when user selects a region from modal
-		if msg.Region == m.region {
+		if msg.Region == components.AllRegionsID {
+			return m.enterAllRegions()
+		}
+		if msg.Region == m.region && !m.allRegions {
 			// Same region, just dismiss modal
 			m.modal = m.modal.Hide()
 			return m, nil
 		}
 
-		// Recreate clients with new region using UpdateRegion
-		newClients, err := m.clients.UpdateRegion(msg.Region)
+		next, err := m.switchRegion(msg.Region)
 		if err != nil {
 			m.modal = components.NewErrorModal(fmt.Sprintf("Failed to create clients: %v", err))
 			return m, nil
 		}
 
-		// Get config for OSS service
-		clientCfg := newClients.GetConfig()
+		// Show success message
+		next.modal = components.NewSuccessModal(fmt.Sprintf("Switched to region: %s", msg.Region))
+		return next, nil
 
-		// Clear cached data first
-		m = m.clearCachedData()
+	case RegionCountsLoadedMsg:
+		return m.handleRegionCountsLoaded(msg)
 
-		// Update region AFTER clearing cache
-		m.region = msg.Region
-		m.header = m.header.SetRegion(msg.Region).SetTitle(i18n.T(i18n.KeyAppTitle))
-		m.modeLine = m.modeLine.SetRegion(msg.Region)
+	case MenuCountsLoadedMsg:
+		return m.handleMenuCountsLoaded(msg)
 
-		// Update clients and recreate services
-		m.clients = newClients
-		m.services = &Services{
-			ECS:      service.NewECSService(newClients.ECS),
-			DNS:      service.NewDNSService(newClients.DNS),
-			SLB:      service.NewSLBService(newClients.SLB),
-			RDS:      service.NewRDSService(newClients.RDS),
-			OSS:      service.NewOSSServiceWithCredentials(newClients.OSS, clientCfg.AccessKeyID, clientCfg.AccessKeySecret, clientCfg.OssEndpoint),
-			Redis:    service.NewRedisService(newClients.Redis),
-			RocketMQ: service.NewRocketMQService(newClients.RocketMQ),
-		}
-		m.finderService = service.NewFinderService(
-			m.services.ECS, m.services.DNS, m.services.SLB,
-			m.services.RDS, m.services.Redis, m.services.RocketMQ,
-		)
+	case PagePrefetchedMsg:
+		return m.handlePagePrefetched(msg)
 
-		// Set page state
-		m.currentPage = PageMenu
-		m.previousPages = []PageType{}
+	case AllRegionsLoadedMsg:
+		return m.handleAllRegionsLoaded(msg)
 
-		// Show success message
-		m.modal = components.NewSuccessModal(fmt.Sprintf("Switched to region: %s", msg.Region))
-		return m, nil
+	case JobFinishedMsg:
+		return m.handleJobFinished(msg)
+
+	case jobsTickMsg:
+		return m.handleJobsTick()
+
+	case loadingTickMsg:
+		return m.handleLoadingTick()
+
+	case traceTickMsg:
+		return m.handleTraceTick()
+
+	case pages.JobCancelRequestMsg:
+		return m.handleJobCancelRequest(msg)
+
+	case pages.JobsClearRequestMsg:
+		return m.handleJobsClearRequest()
 
 	case NavigateMsg:
 		return m.navigateTo(msg.Page, msg.Data)
@@ -433,6 +868,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.search = m.search.Deactivate()
 		return m.handleSearchQuery(msg.Query)
 
+	case components.FilterExecuteMsg:
+		m.search = m.search.Deactivate()
+		return m.handleFilterQuery(msg.Query)
+
 	case components.SearchCancelMsg:
 		m.search = m.search.Deactivate()
 		return m, nil
@@ -456,38 +895,186 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		_ = m.inputHistory.Save() // Ignore save errors
 
 		// Start resource finding
-		m.loading = true
-		return m, FindResources(m.finderService, msg.Value)
+		return m.startFinderSearch(msg.Value)
+
+	// Handle confirm and form modals
+	case components.ConfirmedMsg:
+		switch msg.ID {
+		case actionDNSDeleteRecord:
+			if record, ok := msg.Data.(alidns.Record); ok {
+				m.loading = true
+				return m, DeleteDNSRecord(m.services.DNS, record)
+			}
+		case actionDNSImportApply:
+			if plan, ok := msg.Data.(*service.DNSImportPlan); ok {
+				return m.startDNSImportApply(plan)
+			}
+		case actionSLBCertBind:
+			if bind, ok := msg.Data.(slbCertBind); ok {
+				m.loading = true
+				return m, BindSLBCertificate(m.services.SLB, bind.request.LoadBalancerID, bind.request.Listener.Port, bind.certificate.ServerCertificateId)
+			}
+		case actionSGRevokeRule:
+			if edit, ok := msg.Data.(sgRuleEdit); ok {
+				m.loading = true
+				return m, RevokeSecurityGroupRule(m.services.ECS, edit.SecurityGroupId, edit.Rule)
+			}
+		case actionOSSRestoreVersion:
+			if req, ok := msg.Data.(pages.OSSRestoreVersionRequestMsg); ok {
+				m.loading = true
+				return m, RestoreOSSObjectVersion(m.services.OSS, req.BucketName, req.Version)
+			}
+		case actionOSSUndelete:
+			if req, ok := msg.Data.(pages.OSSUndeleteRequestMsg); ok {
+				m.loading = true
+				return m, UndeleteOSSObject(m.services.OSS, req.BucketName, req.Marker)
+			}
+		case actionRecycleCancelRelease:
+			if resource, ok := msg.Data.(service.RecycledResource); ok {
+				m.loading = true
+				return m, CancelECSAutoRelease(m.services.ECS, resource.ID)
+			}
+		}
+		return m, nil
+
+	case components.FormSubmittedMsg:
+		m.usage.RecordAction(msg.ID)
+		switch msg.ID {
+		case actionDNSAddRecord, actionDNSEditRecord:
+			return m.handleDNSFormSubmitted(msg)
+		case actionDNSImport:
+			return m.handleDNSImportSubmitted(msg)
+		case actionSGAddRule, actionSGEditRule:
+			return m.handleSGFormSubmitted(msg)
+		case actionOSSDownload:
+			return m.handleOSSDownloadSubmitted(msg)
+		case actionOSSPrefixSearch:
+			return m.handleOSSPrefixSearchSubmitted(msg)
+		case actionOSSRestoreArchive:
+			return m.handleOSSArchiveRestoreSubmitted(msg)
+		case actionOSSCopy:
+			return m.handleOSSCopySubmitted(msg)
+		case actionSLBBackendAdd:
+			return m.handleSLBBackendAddSubmitted(msg)
+		case actionSLBCertUpload:
+			return m.handleSLBCertUploadSubmitted(msg)
+		case actionTagFilter:
+			return m.handleTagFilterSubmitted(msg)
+		case actionConnTest:
+			return m.handleConnTestSubmitted(msg)
+		case actionConnect:
+			return m.handleConnectSubmitted(msg)
+		case actionProbe:
+			return m.handleProbeSubmitted(msg)
+		case actionPortForward:
+			return m.handlePortForwardSubmitted(msg)
+		}
+		return m, nil
+
+	case components.OptionSelectedMsg:
+		m.usage.RecordAction(msg.ID)
+		switch msg.ID {
+		case actionMaintenanceWindow:
+			return m.handleMaintenanceWindowSelected(msg)
+		case actionRDSSlowLogRange:
+			return m.handleRDSSlowLogRangeSelected(msg)
+		case actionPortForwardBastion:
+			return m.handleBastionSelected(msg)
+		case actionRecentOpen:
+			return m.handleRecentSelected(msg)
+		case actionSLBBackendLB:
+			return m.handleSLBBackendLBSelected(msg)
+		case actionSLBBackendGroup:
+			return m.handleSLBBackendGroupSelected(msg)
+		case actionSLBCertPick:
+			return m.handleSLBCertPicked(msg)
+		case actionECSCopy:
+			return m.handleECSCopySelected(msg)
+		}
+		return m, nil
 
 	// Handle resource finder results
 	case FindResourceResultMsg:
 		m.loading = false
+		m.finderSearch = nil
 		m.finderPage = pages.NewFinderModel(msg.Result)
 		m.finderPage = m.finderPage.SetSize(m.width, m.height-1)
 		var cmd tea.Cmd
 		m, cmd = m.navigateTo(PageResourceFinder, nil)
 		return m, cmd
 
+	case pages.FinderAllProfilesMsg:
+		m.loading = true
+		return m, FindResourcesInProfiles(m.loadCtx(), m.profiles, msg.Query)
+
+	case FindProfilesResultMsg:
+		m.loading = false
+		m.finderPage = pages.NewProfilesFinderModel(msg.Query, msg.Results, m.profile)
+		m.finderPage = m.finderPage.SetSize(m.width, m.height-1)
+		if m.currentPage == PageResourceFinder {
+			// Replace the single-profile results in place, q still goes back
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m, cmd = m.navigateTo(PageResourceFinder, nil)
+		return m, cmd
+
 	// Handle data loaded messages
+	case ECSInstancesBatchMsg:
+		// The pages of a load a newer one superseded are dropped, which ends it
+		if msg.Stream >= m.ecsStream {
+			m.ecsStream = msg.Stream
+			m.ecsPaging = true
+			m.loading = false
+			m.ecsListPage = m.ecsListPage.SetData(msg.Instances)
+			m.ecsListPage = m.ecsListPage.SetSize(m.width, m.height-1)
+			if m.currentPage == PageECSList {
+				m.modeLine = m.modeLine.SetPageInfo(ecsLoadProgress(len(msg.Instances), msg.Total))
+			}
+			cmds = append(cmds, msg.next)
+		}
+
 	case ECSInstancesLoadedMsg:
 		m.loading = false
 		m.ecsListPage = m.ecsListPage.SetData(msg.Instances)
 		m.ecsListPage = m.ecsListPage.SetSize(m.width, m.height-1)
+		if m.ecsPaging && m.currentPage == PageECSList {
+			m.modeLine = m.modeLine.SetPageInfo("")
+		}
+		m.ecsPaging = false
+		var cmd tea.Cmd
+		m, cmd = m.checkEmptyList(PageECSList, len(msg.Instances))
+		cmds = append(cmds, cmd)
 
 	case SecurityGroupsLoadedMsg:
 		m.loading = false
 		if m.currentPage == PageSecurityGroups {
 			m.sgListPage = m.sgListPage.SetData(msg.SecurityGroups)
+			m.sgListPage = m.sgListPage.SetNames(m.services.Names)
 			m.sgListPage = m.sgListPage.SetSize(m.width, m.height-1)
+			cmds = append(cmds, ResolveNames(m.loadCtx(), m.services.Names, m.sgListPage.NameRefs()))
 		} else if m.currentPage == PageInstanceSecurityGroups {
 			m.instSGPage = m.instSGPage.SetData(msg.SecurityGroups)
+			m.instSGPage = m.instSGPage.SetNames(m.services.Names)
 			m.instSGPage = m.instSGPage.SetSize(m.width, m.height-1)
+			cmds = append(cmds, ResolveNames(m.loadCtx(), m.services.Names, m.instSGPage.NameRefs()))
 		}
 
 	case SecurityGroupRulesLoadedMsg:
 		m.loading = false
 		m.sgRulesPage = m.sgRulesPage.SetData(msg.Response)
+		m.sgRulesPage = m.sgRulesPage.SetNames(m.services.Names)
 		m.sgRulesPage = m.sgRulesPage.SetSize(m.width, m.height-1)
+		cmds = append(cmds, ResolveNames(m.loadCtx(), m.services.Names, m.sgRulesPage.NameRefs()))
+
+	case pages.SecurityGroupRuleActionMsg:
+		return m.handleSGRuleAction(msg)
+
+	case SecurityGroupRuleChangedMsg:
+		// Show the result and reload the rules so the table reflects the change
+		m.modal = components.NewSuccessModal(msg.Message)
+		m.invalidatePage(PageSecurityGroups)
+		return m, LoadSecurityGroupRules(m.loadCtx(), m.services.ECS, msg.SecurityGroupId)
 
 	case SecurityGroupInstancesLoadedMsg:
 		m.loading = false
@@ -498,8 +1085,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case InstanceSecurityGroupsLoadedMsg:
 		m.loading = false
 		m.instSGPage = m.instSGPage.SetData(msg.SecurityGroups)
+		m.instSGPage = m.instSGPage.SetNames(m.services.Names)
 		m.instSGPage = m.instSGPage.SetTitle(fmt.Sprintf("Security Groups for Instance: %s", msg.InstanceId))
 		m.instSGPage = m.instSGPage.SetSize(m.width, m.height-1)
+		cmds = append(cmds, ResolveNames(m.loadCtx(), m.services.Names, m.instSGPage.NameRefs()))
 
 	case ECSDisksLoadedMsg:
 		m.loading = false
@@ -510,6 +1099,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case ECSNetworkInterfacesLoadedMsg:
 		m.loading = false
 		m.ecsENIPage = m.ecsENIPage.SetData(msg.NetworkInterfaces)
+		m.ecsENIPage = m.ecsENIPage.SetNames(m.services.Names)
+		cmds = append(cmds, ResolveNames(m.loadCtx(), m.services.Names, m.ecsENIPage.NameRefs()))
 		m.ecsENIPage = m.ecsENIPage.SetTitle(fmt.Sprintf("%s - %s: %s", i18n.T(i18n.KeyPageECSENIs), i18n.T(i18n.KeyColInstanceID), msg.InstanceId))
 		m.ecsENIPage = m.ecsENIPage.SetSize(m.width, m.height-1)
 
@@ -523,10 +1114,46 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.dnsRecordsPage = m.dnsRecordsPage.SetData(msg.Records, msg.DomainName)
 		m.dnsRecordsPage = m.dnsRecordsPage.SetSize(m.width, m.height-1)
 
+	case DanglingDNSLoadedMsg:
+		m.loading = false
+		m.dnsDanglingPage = m.dnsDanglingPage.SetData(msg.Records, msg.Complete)
+		m.dnsDanglingPage = m.dnsDanglingPage.SetSize(m.width, m.height-1)
+
+	case DNSTargetsLoadedMsg:
+		m.dnsTargets = msg.Index
+		m.dnsTargetsLoading = false
+		m.dnsRecordsPage = m.dnsRecordsPage.SetTargets(m.dnsTargets)
+
+	case pages.DNSRecordActionMsg:
+		return m.handleDNSRecordAction(msg)
+
+	case pages.DNSImportRequestMsg:
+		return m.handleDNSImportRequest()
+
+	case DNSImportPlannedMsg:
+		m.loading = false
+		m.dnsImportPage = m.dnsImportPage.SetData(msg.Plan)
+		m.dnsImportPage = m.dnsImportPage.SetSize(m.width, m.height-1)
+
+	case pages.DNSImportApplyRequestMsg:
+		return m.handleDNSImportApplyRequest(msg)
+
+	case DNSImportAppliedMsg:
+		return m.handleDNSImportApplied(msg)
+
+	case DNSRecordChangedMsg:
+		// Show the result and reload the records so the table reflects the change
+		m.modal = components.NewSuccessModal(msg.Message)
+		m.invalidatePage(PageDNSDomains)
+		return m, LoadDNSRecords(m.loadCtx(), m.services.DNS, msg.DomainName)
+
 	case SLBInstancesLoadedMsg:
 		m.loading = false
 		m.slbListPage = m.slbListPage.SetData(msg.LoadBalancers)
 		m.slbListPage = m.slbListPage.SetSize(m.width, m.height-1)
+		var cmd tea.Cmd
+		m, cmd = m.checkEmptyList(PageSLBList, len(msg.LoadBalancers))
+		cmds = append(cmds, cmd)
 
 	case SLBListenersLoadedMsg:
 		m.loading = false
@@ -551,37 +1178,139 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case SLBDefaultServersLoadedMsg:
 		m.loading = false
 		m.slbDefaultServersPage = m.slbDefaultServersPage.SetData(msg.Servers, msg.LoadBalancerId)
+		m.slbDefaultServersPage = m.slbDefaultServersPage.SetNames(m.services.Names)
 		m.slbDefaultServersPage = m.slbDefaultServersPage.SetSize(m.width, m.height-1)
+		cmds = append(cmds, ResolveNames(m.loadCtx(), m.services.Names, m.slbDefaultServersPage.NameRefs()))
+
+	case NamesResolvedMsg:
+		m = m.applyResolvedNames()
 
 	case OSSBucketsLoadedMsg:
 		m.loading = false
 		m.ossBucketsPage = m.ossBucketsPage.SetData(msg.Buckets)
 		m.ossBucketsPage = m.ossBucketsPage.SetSize(m.width, m.height-1)
 
+	case OSSBucketAuditLoadedMsg:
+		m.loading = false
+		m.ossBucketDetailPage = pages.NewDetailModel(fmt.Sprintf("OSS Bucket Detail: %s", msg.Audit.Name), msg.Audit)
+		m.ossBucketDetailPage = m.ossBucketDetailPage.SetSize(m.width, m.height-1)
+
 	case OSSObjectsLoadedMsg:
 		m.loading = false
 		m.ossObjectsPage = m.ossObjectsPage.SetData(msg.Result, msg.BucketName, msg.Page)
 		m.ossObjectsPage = m.ossObjectsPage.SetSize(m.width, m.height-1)
+		m.header = m.header.SetTitle(m.getPageTitle(PageOSSObjects))
 
 	// Handle pagination messages from pages package
 	case pages.OSSObjectsLoadedMsg:
 		m.loading = false
 		m.ossObjectsPage = m.ossObjectsPage.SetData(msg.Result, msg.BucketName, msg.Page)
 		m.ossObjectsPage = m.ossObjectsPage.SetSize(m.width, m.height-1)
+		m.header = m.header.SetTitle(m.getPageTitle(PageOSSObjects))
+
+	case OSSObjectPreviewLoadedMsg:
+		m.loading = false
+		m.ossPreviewPage = m.ossPreviewPage.SetData(msg.Content)
+		m.ossPreviewPage = m.ossPreviewPage.SetSize(m.width, m.height-1)
 
 	case pages.OSSErrorMsg:
 		m.loading = false
 		m.modal = components.NewErrorModal(msg.Err.Error())
 
+	case pages.OSSDownloadRequestMsg:
+		return m.handleOSSDownloadRequest(msg)
+
+	case pages.OSSScanRequestMsg:
+		return m.handleOSSScanRequest(msg)
+
+	case pages.OSSArchiveRestoreRequestMsg:
+		return m.handleOSSArchiveRestoreRequest(msg)
+
+	case OSSArchiveRestoreStartedMsg:
+		return m.handleOSSArchiveRestoreStarted(msg)
+
+	case OSSObjectsScannedMsg:
+		m.loading = false
+		if m.ossScanPage.Scan() == msg.Scan {
+			m.ossScanPage = m.ossScanPage.SetData(msg.Result)
+			m.ossScanPage = m.ossScanPage.SetSize(m.width, m.height-1)
+		}
+
+	case OSSDownloadDoneMsg:
+		return m.handleOSSDownloadDone(msg)
+
+	case pages.OSSCopyRequestMsg:
+		return m.handleOSSCopyRequest(msg)
+
+	case OSSObjectsCopiedMsg:
+		return m.handleOSSObjectsCopied(msg)
+
+	case pages.ECSCopyRequestMsg:
+		return m.handleECSCopyRequest(msg)
+
+	case pages.SLBBackendAddRequestMsg:
+		return m.handleSLBBackendAddRequest(msg)
+
+	case SLBBackendTargetsLoadedMsg:
+		return m.handleSLBBackendTargetsLoaded(msg)
+
+	case SLBBackendGroupsLoadedMsg:
+		return m.handleSLBBackendGroupsLoaded(msg)
+
+	case SLBBackendsAddedMsg:
+		return m.handleSLBBackendsAdded(msg)
+
+	case pages.SLBCertRotateRequestMsg:
+		return m.handleSLBCertRotateRequest(msg)
+
+	case SLBCertificatesLoadedMsg:
+		return m.handleSLBCertificatesLoaded(msg)
+
+	case SLBCertUploadedMsg:
+		return m.handleSLBCertUploaded(msg)
+
+	case SLBCertBoundMsg:
+		return m.handleSLBCertBound(msg)
+
+	case OSSObjectVersionsLoadedMsg:
+		m.loading = false
+		m.ossVersionsPage = m.ossVersionsPage.SetData(msg.Versions)
+		m.ossVersionsPage = m.ossVersionsPage.SetSize(m.width, m.height-1)
+
+	case pages.OSSRestoreVersionRequestMsg:
+		return m.handleOSSRestoreVersionRequest(msg)
+
+	case OSSVersionRestoredMsg:
+		m.loading = false
+		return m.handleOSSVersionRestored(msg)
+
+	case OSSDeletedObjectsLoadedMsg:
+		m.loading = false
+		m.ossDeletedPage = m.ossDeletedPage.SetData(msg.Markers, msg.Truncated)
+		m.ossDeletedPage = m.ossDeletedPage.SetSize(m.width, m.height-1)
+
+	case pages.OSSUndeleteRequestMsg:
+		return m.handleOSSUndeleteRequest(msg)
+
+	case OSSUndeletedMsg:
+		m.loading = false
+		return m.handleOSSUndeleted(msg)
+
 	case RDSInstancesLoadedMsg:
 		m.loading = false
 		m.rdsListPage = m.rdsListPage.SetData(msg.Instances)
 		m.rdsListPage = m.rdsListPage.SetSize(m.width, m.height-1)
+		var cmd tea.Cmd
+		m, cmd = m.checkEmptyList(PageRDSList, len(msg.Instances))
+		cmds = append(cmds, cmd)
 
 	case RDSDetailedInstancesLoadedMsg:
 		m.loading = false
 		m.rdsListPage = m.rdsListPage.SetDetailedData(msg.Instances)
 		m.rdsListPage = m.rdsListPage.SetSize(m.width, m.height-1)
+		var cmd tea.Cmd
+		m, cmd = m.checkEmptyList(PageRDSList, len(msg.Instances))
+		cmds = append(cmds, cmd)
 
 	case RDSDatabasesLoadedMsg:
 		m.loading = false
@@ -597,50 +1326,513 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.loading = false
 		m.redisListPage = m.redisListPage.SetData(msg.Instances)
 		m.redisListPage = m.redisListPage.SetSize(m.width, m.height-1)
+		var cmd tea.Cmd
+		m, cmd = m.checkEmptyList(PageRedisList, len(msg.Instances))
+		cmds = append(cmds, cmd)
 
 	case RedisAccountsLoadedMsg:
 		m.loading = false
 		m.redisAccountsPage = m.redisAccountsPage.SetData(msg.Accounts, msg.InstanceId)
 		m.redisAccountsPage = m.redisAccountsPage.SetSize(m.width, m.height-1)
 
-	case RocketMQInstancesLoadedMsg:
+	case RedisParametersLoadedMsg:
 		m.loading = false
-		m.rocketmqListPage = m.rocketmqListPage.SetData(msg.Instances)
-		m.rocketmqListPage = m.rocketmqListPage.SetSize(m.width, m.height-1)
+		m.redisParamsPage = m.redisParamsPage.SetData(msg.Parameters, msg.InstanceId)
+		m.redisParamsPage = m.redisParamsPage.SetSize(m.width, m.height-1)
 
-	case RocketMQTopicsLoadedMsg:
+	case RDSSlowLogsLoadedMsg:
 		m.loading = false
-		m.rocketmqTopicsPage = m.rocketmqTopicsPage.SetData(msg.Topics, msg.InstanceId)
-		m.rocketmqTopicsPage = m.rocketmqTopicsPage.SetSize(m.width, m.height-1)
+		if m.rdsSlowLogPage.InstanceID() == msg.InstanceId && m.rdsSlowLogPage.Days() == msg.Days {
+			m.rdsSlowLogPage = m.rdsSlowLogPage.SetData(msg.Summaries)
+			m.rdsSlowLogPage = m.rdsSlowLogPage.SetSize(m.width, m.height-1)
+		}
 
-	case RocketMQGroupsLoadedMsg:
+	case pages.RDSSlowLogRangeRequestMsg:
+		return m.handleRDSSlowLogRangeRequest(msg)
+
+	case RDSBackupsLoadedMsg:
 		m.loading = false
-		m.rocketmqGroupsPage = m.rocketmqGroupsPage.SetData(msg.Groups, msg.InstanceId)
-		m.rocketmqGroupsPage = m.rocketmqGroupsPage.SetSize(m.width, m.height-1)
+		m.rdsBackupsPage = m.rdsBackupsPage.SetData(msg.Backups, msg.InstanceId)
+		m.rdsBackupsPage = m.rdsBackupsPage.SetSize(m.width, m.height-1)
 
-	// Handle component messages (from table and viewport)
-	case components.CopyDataMsg:
-		return m, CopyToClipboard(msg.Data)
+	case RDSBinlogsLoadedMsg:
+		m.loading = false
+		m.rdsBinlogsPage = m.rdsBinlogsPage.SetData(msg.Files, msg.InstanceId)
+		m.rdsBinlogsPage = m.rdsBinlogsPage.SetSize(m.width, m.height-1)
 
-	case components.OpenEditorMsg:
-		return m, OpenInEditor(msg.Data)
+	case RedisBackupsLoadedMsg:
+		m.loading = false
+		m.redisBackupsPage = m.redisBackupsPage.SetData(msg.Backups, msg.InstanceId)
+		m.redisBackupsPage = m.redisBackupsPage.SetSize(m.width, m.height-1)
 
-	case components.OpenPagerMsg:
-		return m, OpenInPager(msg.Data)
+	case MongoDBInstancesLoadedMsg:
+		m.loading = false
+		m.mongoListPage = m.mongoListPage.SetData(msg.Instances)
+		m.mongoListPage = m.mongoListPage.SetSize(m.width, m.height-1)
+		var cmd tea.Cmd
+		m, cmd = m.checkEmptyList(PageMongoDBList, len(msg.Instances))
+		cmds = append(cmds, cmd)
 
-	// Handle copy messages
-	case CopiedMsg:
-		m.modal = components.NewInfoModal(i18n.T(i18n.KeyActionCopied))
-	}
+	case ZoneCapacityLoadedMsg:
+		m.loading = false
+		m.zoneCapacityPage = m.zoneCapacityPage.SetData(msg.Capacity)
+		m.zoneCapacityPage = m.zoneCapacityPage.SetSize(m.width, m.height-1)
 
-	// Forward non-key messages to modal if visible (for list filtering to work)
-	if m.modal.Visible {
+	case KafkaInstancesLoadedMsg:
+		m.loading = false
+		m.kafkaListPage = m.kafkaListPage.SetData(msg.Instances)
+		m.kafkaListPage = m.kafkaListPage.SetSize(m.width, m.height-1)
 		var cmd tea.Cmd
-		m.modal, cmd = m.modal.Update(msg)
-		if cmd != nil {
-			cmds = append(cmds, cmd)
-		}
-	}
+		m, cmd = m.checkEmptyList(PageKafkaList, len(msg.Instances))
+		cmds = append(cmds, cmd)
+
+	case KafkaTopicsLoadedMsg:
+		m.loading = false
+		m.kafkaTopicsPage = m.kafkaTopicsPage.SetData(msg.Topics, msg.InstanceId)
+		m.kafkaTopicsPage = m.kafkaTopicsPage.SetSize(m.width, m.height-1)
+
+	case KafkaGroupsLoadedMsg:
+		m.loading = false
+		m.kafkaGroupsPage = m.kafkaGroupsPage.SetData(msg.Groups, msg.InstanceId)
+		m.kafkaGroupsPage = m.kafkaGroupsPage.SetSize(m.width, m.height-1)
+
+	case ElasticsearchInstancesLoadedMsg:
+		m.loading = false
+		m.esListPage = m.esListPage.SetData(msg.Instances)
+		m.esListPage = m.esListPage.SetSize(m.width, m.height-1)
+		var cmd tea.Cmd
+		m, cmd = m.checkEmptyList(PageElasticsearchList, len(msg.Instances))
+		cmds = append(cmds, cmd)
+
+	case RDSAttributesLoadedMsg:
+		if m.rdsDetailPage.InstanceID() == msg.InstanceID {
+			m.rdsDetailPage = m.rdsDetailPage.SetAttributes(msg.Attributes, msg.Err)
+		}
+
+	case RedisAttributesLoadedMsg:
+		if m.redisDetailPage.InstanceID() == msg.InstanceID {
+			m.redisDetailPage = m.redisDetailPage.SetAttributes(msg.Attributes, msg.Err)
+		}
+
+	case RocketMQInfoLoadedMsg:
+		if m.rocketmqDetailPage.InstanceID() == msg.InstanceID {
+			m.rocketmqDetailPage = m.rocketmqDetailPage.SetInfo(msg.Info, msg.Err)
+		}
+
+	case pages.MaintenanceWindowRequestMsg:
+		return m.handleMaintenanceWindowRequest(msg)
+
+	case pages.ConnTestRequestMsg:
+		return m.handleConnTestRequest(msg)
+
+	case ConnTestedMsg:
+		return m.handleConnTested(msg)
+
+	case pages.ConnectRequestMsg:
+		return m.handleConnectRequest(msg)
+
+	case pages.ProbeRequestMsg:
+		return m.handleProbeRequest(msg)
+
+	case pages.PortForwardRequestMsg:
+		return m.handlePortForwardRequest(msg)
+
+	case BastionsLoadedMsg:
+		return m.handleBastionsLoaded(msg)
+
+	case pages.RecentOpenRequestMsg:
+		return m.handleRecentOpenRequest(msg)
+
+	case RecentResourceLoadedMsg:
+		return m.handleRecentResourceLoaded(msg)
+
+	case ProbedMsg:
+		return m.handleProbed(msg)
+
+	case pages.DNSPropagationCheckRequestMsg:
+		return m.handleDNSPropagationCheckRequest(msg)
+
+	case DNSPropagationCheckedMsg:
+		return m.handleDNSPropagationChecked(msg)
+
+	case MaintenanceWindowChangedMsg:
+		return m.handleMaintenanceWindowChanged(msg)
+
+	case MongoDBTopologyLoadedMsg:
+		if m.mongoDetailPage.InstanceID() == msg.InstanceID {
+			m.mongoDetailPage = m.mongoDetailPage.SetTopology(msg.Topology, msg.Err)
+		}
+
+	case MongoDBAccountsLoadedMsg:
+		m.loading = false
+		m.mongoAccountsPage = m.mongoAccountsPage.SetData(msg.Accounts, msg.InstanceId)
+		m.mongoAccountsPage = m.mongoAccountsPage.SetSize(m.width, m.height-1)
+
+	case RocketMQInstancesLoadedMsg:
+		m.loading = false
+		m.rocketmqListPage = m.rocketmqListPage.SetData(msg.Instances)
+		m.rocketmqListPage = m.rocketmqListPage.SetSize(m.width, m.height-1)
+		var cmd tea.Cmd
+		m, cmd = m.checkEmptyList(PageRocketMQList, len(msg.Instances))
+		cmds = append(cmds, cmd)
+
+	case RocketMQTopicsLoadedMsg:
+		m.loading = false
+		m.rocketmqTopicsPage = m.rocketmqTopicsPage.SetData(msg.Topics, msg.InstanceId)
+		m.rocketmqTopicsPage = m.rocketmqTopicsPage.SetSize(m.width, m.height-1)
+
+	case RocketMQGroupsLoadedMsg:
+		m.loading = false
+		m.rocketmqGroupsPage = m.rocketmqGroupsPage.SetData(msg.Groups, msg.InstanceId)
+		m.rocketmqGroupsPage = m.rocketmqGroupsPage.SetSize(m.width, m.height-1)
+
+	case RocketMQMessagesLoadedMsg:
+		m.loading = false
+		m.rocketmqMessagesPage = m.rocketmqMessagesPage.SetData(msg.Messages, msg.Topic)
+		m.rocketmqMessagesPage = m.rocketmqMessagesPage.SetSize(m.width, m.height-1)
+
+	case ECSMetricsLoadedMsg:
+		m.loading = false
+		if m.ecsMetricsPage.InstanceID() == msg.InstanceID {
+			m.ecsMetricsPage = m.ecsMetricsPage.SetData(msg.Series)
+		}
+
+	case ECSSizingLoadedMsg:
+		if m.ecsMetricsPage.InstanceID() == msg.InstanceID {
+			m.ecsMetricsPage = m.ecsMetricsPage.SetSizing(msg.Recommendation, msg.Family, msg.Err)
+		}
+
+	case RDSMetricsLoadedMsg:
+		m.loading = false
+		if m.rdsMetricsPage.InstanceID() == msg.InstanceID {
+			m.rdsMetricsPage = m.rdsMetricsPage.SetData(msg.Series)
+		}
+
+	case RedisMetricsLoadedMsg:
+		m.loading = false
+		if m.redisMetricsPage.InstanceID() == msg.InstanceID {
+			m.redisMetricsPage = m.redisMetricsPage.SetData(msg.Series)
+		}
+
+	case pages.ECSConsoleRequestMsg:
+		return m, LoadECSConsoleURL(m.services.ECS, msg)
+
+	case ECSConsoleURLLoadedMsg:
+		// The URL holds a short-lived session, so it is used right away
+		if msg.Open {
+			return m, OpenInBrowser(msg.URL)
+		}
+		return m, CopyToClipboard(components.TextContent(msg.URL))
+
+	case pages.MetricsRefreshRequestMsg:
+		switch m.currentPage {
+		case PageRDSMetrics:
+			return m, LoadRDSMetrics(m.loadCtx(), m.services.Monitor, msg.InstanceID)
+		case PageRedisMetrics:
+			return m, LoadRedisMetrics(m.loadCtx(), m.services.Redis, msg.InstanceID)
+		}
+		return m, LoadECSMetrics(m.loadCtx(), m.services.Monitor, msg.InstanceID)
+
+	case RAMUsersLoadedMsg:
+		m.loading = false
+		m.ramUsersPage = m.ramUsersPage.SetData(msg.Users)
+		m.ramUsersPage = m.ramUsersPage.SetSize(m.width, m.height-1)
+
+	case RAMRolesLoadedMsg:
+		m.loading = false
+		m.ramRolesPage = m.ramRolesPage.SetData(msg.Roles)
+		m.ramRolesPage = m.ramRolesPage.SetSize(m.width, m.height-1)
+
+	case RAMPoliciesLoadedMsg:
+		m.loading = false
+		m.ramPoliciesPage = m.ramPoliciesPage.SetData(msg.Policies)
+		m.ramPoliciesPage = m.ramPoliciesPage.SetSize(m.width, m.height-1)
+
+	case RAMUserPoliciesLoadedMsg:
+		m.loading = false
+		m.ramUserPoliciesPage = m.ramUserPoliciesPage.SetUserData(msg.Policies, msg.UserName)
+		m.ramUserPoliciesPage = m.ramUserPoliciesPage.SetSize(m.width, m.height-1)
+
+	case RAMRolePoliciesLoadedMsg:
+		m.loading = false
+		m.ramRolePoliciesPage = m.ramRolePoliciesPage.SetRoleData(msg.Policies, msg.RoleName)
+		m.ramRolePoliciesPage = m.ramRolePoliciesPage.SetSize(m.width, m.height-1)
+
+	case RAMPolicyDetailLoadedMsg:
+		m.loading = false
+		m.ramDetailPage = pages.NewDetailModel(fmt.Sprintf("RAM Policy: %s", msg.Detail.Policy.PolicyName), msg.Detail)
+		m.ramDetailPage = m.ramDetailPage.SetSize(m.width, m.height-1)
+
+	case SLSProjectsLoadedMsg:
+		m.loading = false
+		m.slsProjectsPage = m.slsProjectsPage.SetData(msg.Projects)
+		m.slsProjectsPage = m.slsProjectsPage.SetSize(m.width, m.height-1)
+
+	case SLSLogtailLoadedMsg:
+		// One load backs the configs, machine groups and coverage pages
+		m.loading = false
+		overview := msg.Overview
+		if m.slsConfigsPage.Project() == overview.Project {
+			m.slsConfigsPage = m.slsConfigsPage.SetData(overview.Configs)
+			m.slsConfigsPage = m.slsConfigsPage.SetSize(m.width, m.height-1)
+		}
+		if m.slsGroupsPage.Project() == overview.Project {
+			m.slsGroupsPage = m.slsGroupsPage.SetData(overview.Groups)
+			m.slsGroupsPage = m.slsGroupsPage.SetSize(m.width, m.height-1)
+		}
+		if m.slsCoveragePage.Project() == overview.Project {
+			m.slsCoveragePage = m.slsCoveragePage.SetData(overview.Coverage)
+			m.slsCoveragePage = m.slsCoveragePage.SetSize(m.width, m.height-1)
+		}
+
+	case ConfigRulesLoadedMsg:
+		m.loading = false
+		m.configRulesPage = m.configRulesPage.SetData(msg.Rules)
+		m.configRulesPage = m.configRulesPage.SetSize(m.width, m.height-1)
+
+	case ConfigResultsLoadedMsg:
+		m.loading = false
+		if m.configResultsPage.RuleID() == msg.RuleID {
+			m.configResultsPage = m.configResultsPage.SetData(msg.Results)
+			m.configResultsPage = m.configResultsPage.SetSize(m.width, m.height-1)
+		}
+
+	case TagsLoadedMsg:
+		m.loading = false
+		m.tagBrowserPage = m.tagBrowserPage.SetData(msg.Tags)
+		m.tagBrowserPage = m.tagBrowserPage.SetSize(m.width, m.height-1)
+
+	case TaggedResourcesLoadedMsg:
+		m.loading = false
+		if m.tagResourcesPage.Tag().String() == msg.Tag.String() {
+			m.tagResourcesPage = m.tagResourcesPage.SetData(msg.Resources)
+			m.tagResourcesPage = m.tagResourcesPage.SetSize(m.width, m.height-1)
+		}
+
+	case ALBLoadBalancersLoadedMsg:
+		m.loading = false
+		m.albListPage = m.albListPage.SetData(msg.LoadBalancers)
+		m.albListPage = m.albListPage.SetSize(m.width, m.height-1)
+		var cmd tea.Cmd
+		m, cmd = m.checkEmptyList(PageALBList, len(msg.LoadBalancers))
+		cmds = append(cmds, cmd)
+
+	case ALBListenersLoadedMsg:
+		m.loading = false
+		m.albListenersPage = m.albListenersPage.SetData(msg.Listeners, msg.LoadBalancerID)
+		m.albListenersPage = m.albListenersPage.SetSize(m.width, m.height-1)
+
+	case ALBRulesLoadedMsg:
+		m.loading = false
+		m.albRulesPage = m.albRulesPage.SetData(msg.Rules, msg.ListenerID)
+		m.albRulesPage = m.albRulesPage.SetSize(m.width, m.height-1)
+
+	case ALBServerGroupsLoadedMsg:
+		m.loading = false
+		m.albServerGroupsPage = m.albServerGroupsPage.SetData(msg.ServerGroups, msg.LoadBalancerID)
+		m.albServerGroupsPage = m.albServerGroupsPage.SetSize(m.width, m.height-1)
+
+	case NLBLoadBalancersLoadedMsg:
+		m.loading = false
+		m.nlbListPage = m.nlbListPage.SetData(msg.LoadBalancers)
+		m.nlbListPage = m.nlbListPage.SetSize(m.width, m.height-1)
+		var cmd tea.Cmd
+		m, cmd = m.checkEmptyList(PageNLBList, len(msg.LoadBalancers))
+		cmds = append(cmds, cmd)
+
+	case NLBListenersLoadedMsg:
+		m.loading = false
+		m.nlbListenersPage = m.nlbListenersPage.SetData(msg.Listeners, msg.LoadBalancerID)
+		m.nlbListenersPage = m.nlbListenersPage.SetSize(m.width, m.height-1)
+
+	case NLBServerGroupsLoadedMsg:
+		m.loading = false
+		m.nlbServerGroupsPage = m.nlbServerGroupsPage.SetData(msg.ServerGroups, msg.LoadBalancerID)
+		m.nlbServerGroupsPage = m.nlbServerGroupsPage.SetSize(m.width, m.height-1)
+
+	case LBServersLoadedMsg:
+		m.loading = false
+		if m.lbServersPage.ServerGroup().ServerGroupID == msg.ServerGroupID {
+			m.lbServersPage = m.lbServersPage.SetData(msg.Servers)
+			m.lbServersPage = m.lbServersPage.SetSize(m.width, m.height-1)
+		}
+
+	case ACKClustersLoadedMsg:
+		m.loading = false
+		m.ackClustersPage = m.ackClustersPage.SetData(msg.Clusters)
+		m.ackClustersPage = m.ackClustersPage.SetSize(m.width, m.height-1)
+
+	case ACKClusterDetailLoadedMsg:
+		m.loading = false
+		m.ackClusterDetailPage = pages.NewDetailModel(fmt.Sprintf("ACK Cluster Detail: %s", msg.Detail.Cluster.Name), msg.Detail)
+		m.ackClusterDetailPage = m.ackClusterDetailPage.SetSize(m.width, m.height-1)
+
+	case ACKNodePoolsLoadedMsg:
+		m.loading = false
+		if m.ackNodePoolsPage.ClusterID() == msg.ClusterID {
+			m.ackNodePoolsPage = m.ackNodePoolsPage.SetData(msg.NodePools)
+			m.ackNodePoolsPage = m.ackNodePoolsPage.SetSize(m.width, m.height-1)
+		}
+
+	case ACKNodesLoadedMsg:
+		m.loading = false
+		if m.ackNodesPage.NodePool().NodePoolID == msg.NodePoolID {
+			m.ackNodesPage = m.ackNodesPage.SetData(msg.Nodes)
+			m.ackNodesPage = m.ackNodesPage.SetSize(m.width, m.height-1)
+		}
+
+	case pages.ACKNodeInstanceRequestMsg:
+		m.loading = true
+		return m, OpenACKNodeInstance(m.loadCtx(), m.services.ECS, msg.InstanceID, msg.RegionID)
+
+	case ACRInstancesLoadedMsg:
+		m.loading = false
+		m.acrInstancesPage = m.acrInstancesPage.SetData(msg.Instances)
+		m.acrInstancesPage = m.acrInstancesPage.SetSize(m.width, m.height-1)
+
+	case ACRNamespacesLoadedMsg:
+		m.loading = false
+		if m.acrNamespacesPage.InstanceID() == msg.InstanceID {
+			m.acrNamespacesPage = m.acrNamespacesPage.SetData(msg.Namespaces)
+			m.acrNamespacesPage = m.acrNamespacesPage.SetSize(m.width, m.height-1)
+		}
+
+	case ACRRepositoriesLoadedMsg:
+		m.loading = false
+		if m.acrReposPage.Namespace() == msg.Namespace {
+			m.acrReposPage = m.acrReposPage.SetData(msg.Repositories)
+			m.acrReposPage = m.acrReposPage.SetSize(m.width, m.height-1)
+		}
+
+	case ACRTagsLoadedMsg:
+		m.loading = false
+		if m.acrTagsPage.Repository().RepoID == msg.RepoID {
+			m.acrTagsPage = m.acrTagsPage.SetData(msg.Tags)
+			m.acrTagsPage = m.acrTagsPage.SetSize(m.width, m.height-1)
+		}
+
+	case FCServicesLoadedMsg:
+		m.loading = false
+		m.fcServicesPage = m.fcServicesPage.SetData(msg.Services)
+		m.fcServicesPage = m.fcServicesPage.SetSize(m.width, m.height-1)
+
+	case FCFunctionsLoadedMsg:
+		m.loading = false
+		if m.fcFunctionsPage.ServiceName() == msg.ServiceName {
+			m.fcFunctionsPage = m.fcFunctionsPage.SetData(msg.Functions)
+			m.fcFunctionsPage = m.fcFunctionsPage.SetSize(m.width, m.height-1)
+			return m, LoadFCFunctionStats(m.loadCtx(), m.services.FC, m.services.Monitor, msg.ServiceName)
+		}
+
+	case FCFunctionStatsLoadedMsg:
+		if m.fcFunctionsPage.ServiceName() == msg.ServiceName {
+			m.fcFunctionsPage = m.fcFunctionsPage.SetStats(msg.Stats, msg.Err)
+		}
+
+	case NATGatewaysLoadedMsg:
+		m.loading = false
+		m.natListPage = m.natListPage.SetData(msg.Gateways)
+		m.natListPage = m.natListPage.SetSize(m.width, m.height-1)
+
+	case SNATEntriesLoadedMsg:
+		m.loading = false
+		m.snatEntriesPage = m.snatEntriesPage.SetData(msg.Entries, msg.GatewayID)
+		m.snatEntriesPage = m.snatEntriesPage.SetSize(m.width, m.height-1)
+
+	case DNATEntriesLoadedMsg:
+		m.loading = false
+		m.dnatEntriesPage = m.dnatEntriesPage.SetData(msg.Entries, msg.Gateway)
+		m.dnatEntriesPage = m.dnatEntriesPage.SetSize(m.width, m.height-1)
+
+	case pages.DNATTargetRequestMsg:
+		m.loading = true
+		return m, OpenDNATTarget(m.loadCtx(), m.services.ECS, msg.VpcID, msg.InternalIP)
+
+	case BillingLoadedMsg:
+		m.loading = false
+		m.billingPage = m.billingPage.SetData(msg.Overview)
+		m.billingPage = m.billingPage.SetSize(m.width, m.height-1)
+
+	case RecycleBinLoadedMsg:
+		m.loading = false
+		m.recycleBinPage = m.recycleBinPage.SetData(msg.Resources)
+		m.recycleBinPage = m.recycleBinPage.SetSize(m.width, m.height-1)
+		if msg.Err != nil {
+			m.modal = components.NewErrorModal(msg.Err.Error())
+		}
+
+	case pages.RecycleRestoreRequestMsg:
+		return m.handleRecycleRestoreRequest(msg)
+
+	case ECSReleaseCancelledMsg:
+		m.loading = false
+		return m.handleECSReleaseCancelled(msg)
+
+	case InstanceCostLoadedMsg:
+		if m.ecsDetailPage.InstanceID() == msg.InstanceID {
+			m.ecsDetailPage = m.ecsDetailPage.SetCost(msg.Cost, msg.Err)
+		}
+
+	case SLBAttributeLoadedMsg:
+		if m.slbDetailPage.LoadBalancerID() == msg.LoadBalancerID {
+			m.slbDetailPage = m.slbDetailPage.SetAttribute(msg.Attribute, msg.Err)
+		}
+
+	case InstanceRAMRoleLoadedMsg:
+		if m.ecsDetailPage.InstanceID() == msg.InstanceID {
+			m.ecsDetailPage = m.ecsDetailPage.SetRAMRole(msg.RoleName, msg.Err)
+		}
+
+	case pages.TagFilterRequestMsg:
+		return m.handleTagFilterRequest(msg)
+
+	case TagFilterLoadedMsg:
+		m.loading = false
+		return m.setTagFilter(msg.Page, msg.Filter), nil
+
+	case pages.OpenTaggedListMsg:
+		return m.openTaggedList(msg)
+
+	// Handle component messages (from table and viewport)
+	case components.CopyDataMsg:
+		return m, CopyToClipboard(msg.Data)
+
+	case components.OpenEditorMsg:
+		return m, OpenInEditor(msg.Data)
+
+	case components.OpenPagerMsg:
+		return m, OpenInPager(msg.Data)
+
+	case components.ColumnChooserRequestMsg:
+		m.columns = components.NewColumnChooserModel(m.getPageTitle(m.currentPage), msg)
+		return m, nil
+
+	case components.ColumnsChosenMsg:
+		return m.handleColumnsChosen(msg)
+
+	// Handle copy messages
+	case CopiedMsg:
+		m.modal = components.NewInfoModal(i18n.T(i18n.KeyActionCopied))
+
+	case BrowserOpenedMsg:
+		m.modal = components.NewInfoModal(i18n.T(i18n.KeyActionOpenedBrowser))
+	}
+
+	// Forward non-key messages to modal if visible (for list filtering to work)
+	if m.modal.Visible {
+		var cmd tea.Cmd
+		m.modal, cmd = m.modal.Update(msg)
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+	if m.help.Visible {
+		var cmd tea.Cmd
+		m.help, cmd = m.help.Update(msg)
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
 
 	// Update current page
 	var cmd tea.Cmd
@@ -706,6 +1898,8 @@ func (m Model) View() string {
 		content = m.ossObjectsPage.View()
 	case PageOSSObjectDetail:
 		content = m.ossDetailPage.View()
+	case PageOSSObjectPreview:
+		content = m.ossPreviewPage.View()
 	case PageRDSList:
 		content = m.rdsListPage.View()
 	case PageRDSDetail:
@@ -730,13 +1924,198 @@ func (m Model) View() string {
 		content = m.rocketmqGroupsPage.View()
 	case PageResourceFinder:
 		content = m.finderPage.View()
+	case PageJobs:
+		content = m.jobsPage.View()
+	case PageRAMUsers:
+		content = m.ramUsersPage.View()
+	case PageRAMRoles:
+		content = m.ramRolesPage.View()
+	case PageRAMPolicies:
+		content = m.ramPoliciesPage.View()
+	case PageRAMUserPolicies:
+		content = m.ramUserPoliciesPage.View()
+	case PageRAMDetail:
+		content = m.ramDetailPage.View()
+	case PageECSMetrics:
+		content = m.ecsMetricsPage.View()
+	case PageRDSMetrics:
+		content = m.rdsMetricsPage.View()
+	case PageSLSProjects:
+		content = m.slsProjectsPage.View()
+	case PageSLSLogtailConfigs:
+		content = m.slsConfigsPage.View()
+	case PageSLSMachineGroups:
+		content = m.slsGroupsPage.View()
+	case PageSLSMachines:
+		content = m.slsMachinesPage.View()
+	case PageSLSCoverage:
+		content = m.slsCoveragePage.View()
+	case PageSLSDetail:
+		content = m.slsDetailPage.View()
+	case PageConfigRules:
+		content = m.configRulesPage.View()
+	case PageConfigResults:
+		content = m.configResultsPage.View()
+	case PageConfigDetail:
+		content = m.configDetailPage.View()
+	case PageTagBrowser:
+		content = m.tagBrowserPage.View()
+	case PageTagResources:
+		content = m.tagResourcesPage.View()
+	case PageTagDetail:
+		content = m.tagDetailPage.View()
+	case PageBilling:
+		content = m.billingPage.View()
+	case PageBillingDetail:
+		content = m.billingDetailPage.View()
+	case PageDNSDangling:
+		content = m.dnsDanglingPage.View()
+	case PageRAMRolePolicies:
+		content = m.ramRolePoliciesPage.View()
+	case PageNATList:
+		content = m.natListPage.View()
+	case PageNATDetail:
+		content = m.natDetailPage.View()
+	case PageSNATEntries:
+		content = m.snatEntriesPage.View()
+	case PageDNATEntries:
+		content = m.dnatEntriesPage.View()
+	case PageOSSObjectVersions:
+		content = m.ossVersionsPage.View()
+	case PageALBList:
+		content = m.albListPage.View()
+	case PageALBDetail:
+		content = m.albDetailPage.View()
+	case PageALBListeners:
+		content = m.albListenersPage.View()
+	case PageALBRules:
+		content = m.albRulesPage.View()
+	case PageALBRuleDetail:
+		content = m.albRuleDetailPage.View()
+	case PageALBServerGroups:
+		content = m.albServerGroupsPage.View()
+	case PageNLBList:
+		content = m.nlbListPage.View()
+	case PageNLBDetail:
+		content = m.nlbDetailPage.View()
+	case PageNLBListeners:
+		content = m.nlbListenersPage.View()
+	case PageNLBServerGroups:
+		content = m.nlbServerGroupsPage.View()
+	case PageLBServers:
+		content = m.lbServersPage.View()
+	case PageOSSBucketDetail:
+		content = m.ossBucketDetailPage.View()
+	case PageACKClusters:
+		content = m.ackClustersPage.View()
+	case PageACKClusterDetail:
+		content = m.ackClusterDetailPage.View()
+	case PageACKNodePools:
+		content = m.ackNodePoolsPage.View()
+	case PageACKNodes:
+		content = m.ackNodesPage.View()
+	case PageOSSObjectScan:
+		content = m.ossScanPage.View()
+	case PageACRInstances:
+		content = m.acrInstancesPage.View()
+	case PageACRNamespaces:
+		content = m.acrNamespacesPage.View()
+	case PageACRRepositories:
+		content = m.acrReposPage.View()
+	case PageACRTags:
+		content = m.acrTagsPage.View()
+	case PageACRTagDetail:
+		content = m.acrTagDetailPage.View()
+	case PageFCServices:
+		content = m.fcServicesPage.View()
+	case PageFCFunctions:
+		content = m.fcFunctionsPage.View()
+	case PageFCFunctionDetail:
+		content = m.fcDetailPage.View()
+	case PageSLBJSONDetail:
+		content = m.slbJSONDetailPage.View()
+	case PageMongoDBList:
+		content = m.mongoListPage.View()
+	case PageMongoDBDetail:
+		content = m.mongoDetailPage.View()
+	case PageMongoDBJSONDetail:
+		content = m.mongoJSONPage.View()
+	case PageMongoDBAccounts:
+		content = m.mongoAccountsPage.View()
+	case PageRDSJSONDetail:
+		content = m.rdsJSONPage.View()
+	case PageRedisJSONDetail:
+		content = m.redisJSONPage.View()
+	case PageRocketMQJSONDetail:
+		content = m.rocketmqJSONPage.View()
+	case PageElasticsearchList:
+		content = m.esListPage.View()
+	case PageElasticsearchDetail:
+		content = m.esDetailPage.View()
+	case PageElasticsearchJSONDetail:
+		content = m.esJSONPage.View()
+	case PageZoneCapacity:
+		content = m.zoneCapacityPage.View()
+	case PageKafkaList:
+		content = m.kafkaListPage.View()
+	case PageKafkaDetail:
+		content = m.kafkaDetailPage.View()
+	case PageKafkaJSONDetail:
+		content = m.kafkaJSONPage.View()
+	case PageKafkaTopics:
+		content = m.kafkaTopicsPage.View()
+	case PageKafkaGroups:
+		content = m.kafkaGroupsPage.View()
+	case PageRocketMQLag:
+		content = m.rocketmqLagPage.View()
+	case PageRocketMQMessages:
+		content = m.rocketmqMessagesPage.View()
+	case PageRedisParameters:
+		content = m.redisParamsPage.View()
+	case PageRedisMetrics:
+		content = m.redisMetricsPage.View()
+	case PageRecycleBin:
+		content = m.recycleBinPage.View()
+	case PageOSSDeletedObjects:
+		content = m.ossDeletedPage.View()
+	case PageRedisBackups:
+		content = m.redisBackupsPage.View()
+	case PageRDSBackups:
+		content = m.rdsBackupsPage.View()
+	case PageRDSBinlogs:
+		content = m.rdsBinlogsPage.View()
+	case PageRDSSlowLog:
+		content = m.rdsSlowLogPage.View()
+	case PageChangelog:
+		content = m.changelogPage.View()
+	case PageRecent:
+		content = m.recentPage.View()
+	case PageUsage:
+		content = m.usagePage.View()
+	case PageDNSImport:
+		content = m.dnsImportPage.View()
+	case PageAPITrace:
+		content = m.apiTracePage.View()
 	default:
-		content = "Unknown page"
+		content = i18n.T(i18n.KeyUnknownPage)
+	}
+
+	// The split view shows the highlighted row next to the list
+	if m.splitShown() {
+		content = m.splitView(content)
+	}
+
+	// Explain an empty list rather than showing a bare table
+	if m.showsEmptyState() {
+		content = m.emptyStateView()
 	}
 
 	// Show loading spinner
 	if m.loading {
-		content = Center(i18n.T(i18n.KeyActionLoading), m.width, m.height-2)
+		content = Center(loadingText(), m.width, m.height-2)
+		if m.finderSearch != nil {
+			content = m.finderSearchView()
+		}
 	}
 
 	// Build the full view: header + content + modeline
@@ -758,6 +2137,16 @@ func (m Model) View() string {
 		)
 	}
 
+	// The help overlay covers the whole screen
+	if m.help.Visible {
+		view = m.help.View()
+	}
+
+	// The column chooser shows over the page
+	if m.columns.Visible {
+		view = Center(m.columns.View(), m.width, m.height)
+	}
+
 	// Overlay modal if visible
 	if m.modal.Visible {
 		modalView := m.modal.View()
@@ -769,10 +2158,19 @@ func (m Model) View() string {
 
 // navigateTo handles navigation to a specific page
 func (m Model) navigateTo(page PageType, data interface{}) (Model, tea.Cmd) {
+	// In All Regions mode, sub-pages query the region of the resource they came from
+	if m.allRegions {
+		m = m.scopeServices(m.navigationRegion())
+	}
+
 	// Push current page to stack
 	m.previousPages = append(m.previousPages, m.currentPage)
+	m.alternatePage = m.currentPage
+	m.hasAlternate = true
 	m.currentPage = page
 	m.loading = true
+	m.loadJob = 0
+	m.loads.leave(len(m.previousPages)) // Loads of the page start afresh
 
 	// Update mode line and header
 	m.modeLine = m.modeLine.SetPage(page)
@@ -782,16 +2180,26 @@ func (m Model) navigateTo(page PageType, data interface{}) (Model, tea.Cmd) {
 
 	switch page {
 	case PageECSList:
-		m.ecsListPage = pages.NewECSListModel()
-		cmd = LoadECSInstances(m.services.ECS)
+		m.ecsListPage = pages.NewECSListModel().SetShowRegion(m.allRegions)
+		if m.allRegions {
+			title := fmt.Sprintf(i18n.T(i18n.KeyJobFetchAllRegions), m.getPageTitle(page))
+			m, cmd = m.startLoadJob(title, jobs.UnitItems, LoadECSInstancesAllRegions(m.regionService, m.clients))
+		} else {
+			m, cmd = m.loadOrServePrefetched(PageECSList, LoadECSInstances(m.loadCtx(), m.services.ECS))
+		}
 
 	case PageECSDetail:
 		// Try to get ecs.Instance for formatted detail view using the pages package function
 		// This ensures type assertion happens in the same package where ecs.Instance is defined
 		if detailModel, ok := pages.NewECSDetailModelFromInterface(data); ok {
-			m.ecsDetailPage = detailModel
+			m.ecsDetailPage = detailModel.SetNames(m.services.Names)
 			m.ecsDetailPage = m.ecsDetailPage.SetSize(m.width, m.height-1)
 			m.loading = false
+			cmd = tea.Batch(
+				ResolveNames(m.loadCtx(), m.services.Names, m.ecsDetailPage.NameRefs()),
+				LoadInstanceCost(m.loadCtx(), m.services.Billing, m.ecsDetailPage.InstanceID()),
+				LoadInstanceRAMRole(m.loadCtx(), m.services.ECS, m.ecsDetailPage.InstanceID(), m.ecsDetailPage.RegionID()),
+			)
 		} else {
 			// Fallback: if type assertion fails, navigate to JSON detail instead
 			m.ecsJSONDetailPage = pages.NewDetailModel("ECS JSON Detail", data)
@@ -808,176 +2216,743 @@ func (m Model) navigateTo(page PageType, data interface{}) (Model, tea.Cmd) {
 	case PageECSDisks:
 		if instanceId, ok := data.(string); ok {
 			m.ecsDiskPage = pages.NewECSDiskModel(instanceId)
-			cmd = LoadECSDisks(m.services.ECS, instanceId)
+			cmd = LoadECSDisks(m.loadCtx(), m.services.ECS, instanceId)
 		}
 
 	case PageECSNetworkInterfaces:
 		if instanceId, ok := data.(string); ok {
 			m.ecsENIPage = pages.NewECSENIModel(instanceId)
-			cmd = LoadECSNetworkInterfaces(m.services.ECS, instanceId)
+			cmd = LoadECSNetworkInterfaces(m.loadCtx(), m.services.ECS, instanceId)
 		}
 
 	case PageSecurityGroups:
 		m.sgListPage = pages.NewSecurityGroupsModel()
-		cmd = LoadSecurityGroups(m.services.ECS)
+		cmd = LoadSecurityGroups(m.loadCtx(), m.services.ECS)
+
+	case PageSecurityGroupRules:
+		if sgId, ok := data.(string); ok {
+			m.sgRulesPage = pages.NewSecurityGroupRulesModel(sgId)
+			cmd = LoadSecurityGroupRules(m.loadCtx(), m.services.ECS, sgId)
+		}
+
+	case PageSecurityGroupInstances:
+		if sgId, ok := data.(string); ok {
+			m.sgInstancesPage = pages.NewECSListModel()
+			cmd = LoadSecurityGroupInstances(m.loadCtx(), m.services.ECS, sgId)
+		}
+
+	case PageInstanceSecurityGroups:
+		if instId, ok := data.(string); ok {
+			m.instSGPage = pages.NewSecurityGroupsModel()
+			cmd = LoadInstanceSecurityGroups(m.loadCtx(), m.services.ECS, instId)
+		}
+
+	case PageDNSDomains:
+		m.dnsDomainsPage = pages.NewDNSDomainsModel()
+		cmd = LoadDNSDomains(m.loadCtx(), m.services.DNS)
+		if !m.dnsTargetsLoading {
+			m.dnsTargets = nil // Re-index resources on the next records page
+		}
+
+	case PageDNSRecords:
+		if domain, ok := data.(string); ok {
+			m.dnsRecordsPage = pages.NewDNSRecordsModel().SetTargets(m.dnsTargets)
+			cmd = LoadDNSRecords(m.loadCtx(), m.services.DNS, domain)
+			if m.dnsTargets == nil && !m.dnsTargetsLoading {
+				m.dnsTargetsLoading = true
+				cmd = tea.Batch(cmd, LoadDNSTargets(m.loadCtx(), m.regionService, m.clients, m.services.DNSTargets))
+			}
+		}
+
+	case PageSLBList:
+		m.slbListPage = pages.NewSLBListModel().SetShowRegion(m.allRegions)
+		if m.allRegions {
+			title := fmt.Sprintf(i18n.T(i18n.KeyJobFetchAllRegions), m.getPageTitle(page))
+			m, cmd = m.startLoadJob(title, jobs.UnitItems, LoadSLBInstancesAllRegions(m.regionService, m.clients))
+		} else {
+			m, cmd = m.loadOrServePrefetched(PageSLBList, LoadSLBInstances(m.loadCtx(), m.services.SLB))
+		}
+
+	case PageSLBDetail:
+		if detailModel, ok := pages.NewSLBDetailModelFromInterface(data); ok {
+			m.slbDetailPage = detailModel.SetNames(m.services.Names)
+			m.slbDetailPage = m.slbDetailPage.SetSize(m.width, m.height-1)
+			m.loading = false
+			cmd = tea.Batch(
+				ResolveNames(m.loadCtx(), m.services.Names, m.slbDetailPage.NameRefs()),
+				LoadSLBAttribute(m.loadCtx(), m.services.SLB, m.slbDetailPage.LoadBalancerID()),
+			)
+		} else {
+			// Other SLB resources have no formatted view
+			m.slbJSONDetailPage = pages.NewDetailModel("SLB JSON Detail", data)
+			m.slbJSONDetailPage = m.slbJSONDetailPage.SetSize(m.width, m.height-1)
+			m.currentPage = PageSLBJSONDetail
+			m.loading = false
+		}
+
+	case PageSLBListeners:
+		if lbId, ok := data.(string); ok {
+			m.slbListenersPage = pages.NewSLBListenersModel()
+			cmd = LoadSLBListeners(m.loadCtx(), m.services.SLB, lbId)
+		}
+
+	case PageSLBVServerGroups:
+		if lbId, ok := data.(string); ok {
+			m.slbVServerPage = pages.NewSLBVServerGroupsModel()
+			cmd = LoadSLBVServerGroups(m.loadCtx(), m.services.SLB, lbId)
+		}
+
+	case PageSLBBackendServers:
+		if vsgId, ok := data.(string); ok {
+			m.slbBackendPage = pages.NewSLBBackendServersModel()
+			cmd = LoadSLBBackendServers(m.loadCtx(), m.services.SLB, vsgId, m.clients.ECS)
+		}
+
+	case PageSLBForwardingRules:
+		if navData, ok := data.(pages.ListenerNavData); ok {
+			m.slbForwardingRulesPage = pages.NewSLBForwardingRulesModel()
+			cmd = LoadSLBForwardingRules(m.loadCtx(), m.services.SLB, navData.LoadBalancerId, navData.ListenerPort, navData.ListenerProtocol)
+		}
+
+	case PageSLBDefaultServers:
+		if lbId, ok := data.(string); ok {
+			m.slbDefaultServersPage = pages.NewSLBDefaultServersModel()
+			cmd = LoadSLBDefaultServers(m.loadCtx(), m.services.SLB, lbId, m.clients.ECS)
+		}
+
+	case PageOSSBuckets:
+		m.ossBucketsPage = pages.NewOSSBucketsModel()
+		cmd = LoadOSSBuckets(m.loadCtx(), m.services.OSS)
+
+	case PageOSSObjects:
+		if bucket, ok := data.(string); ok {
+			m.ossObjectsPage = pages.NewOSSObjectsModel(m.loadCtx(), m.services.OSS, bucket)
+			m.header = m.header.SetTitle(m.getPageTitle(page))
+			cmd = LoadOSSObjects(m.loadCtx(), m.services.OSS, bucket, "", "", 20, 1)
+		}
+
+	case PageOSSObjectDetail:
+		if obj, ok := data.(interface{}); ok {
+			m.ossDetailPage = pages.NewDetailModel("OSS Object Detail", obj)
+			m.ossDetailPage = m.ossDetailPage.SetSize(m.width, m.height-1)
+			m.loading = false
+		}
+
+	case PageOSSObjectPreview:
+		if navData, ok := data.(pages.OSSObjectNavData); ok {
+			m.ossPreviewPage = pages.NewOSSObjectPreviewModel(navData.BucketName, navData.Object)
+			cmd = LoadOSSObjectPreview(m.loadCtx(), m.services.OSS, navData.BucketName, navData.Object.Key, pages.OSSPreviewMaxBytes)
+		}
+
+	case PageRDSList:
+		m.rdsListPage = pages.NewRDSListModel().SetShowRegion(m.allRegions)
+		if m.allRegions {
+			title := fmt.Sprintf(i18n.T(i18n.KeyJobFetchAllRegions), m.getPageTitle(page))
+			m, cmd = m.startLoadJob(title, jobs.UnitItems, LoadRDSDetailedInstancesAllRegions(m.regionService, m.clients))
+		} else {
+			m, cmd = m.loadOrServePrefetched(PageRDSList, LoadRDSDetailedInstances(m.loadCtx(), m.services.RDS))
+		}
+
+	case PageRDSDetail:
+		if detailModel, ok := pages.NewRDSDetailModelFromInterface(data); ok {
+			m.rdsDetailPage = detailModel.SetNames(m.services.Names)
+			m.rdsDetailPage = m.rdsDetailPage.SetSize(m.width, m.height-1)
+			m.loading = false
+			cmd = tea.Batch(
+				ResolveNames(m.loadCtx(), m.services.Names, m.rdsDetailPage.NameRefs()),
+				LoadRDSAttributes(m.loadCtx(), m.services.RDS, m.rdsDetailPage.InstanceID()),
+			)
+		} else {
+			m.rdsJSONPage = pages.NewDetailModel("RDS JSON Detail", data)
+			m.rdsJSONPage = m.rdsJSONPage.SetSize(m.width, m.height-1)
+			m.currentPage = PageRDSJSONDetail
+			m.loading = false
+		}
+
+	case PageRDSDatabases:
+		if instId, ok := data.(string); ok {
+			m.rdsDatabasesPage = pages.NewRDSDatabasesModel()
+			cmd = LoadRDSDatabases(m.loadCtx(), m.services.RDS, instId)
+		}
+
+	case PageRDSAccounts:
+		if instId, ok := data.(string); ok {
+			m.rdsAccountsPage = pages.NewRDSAccountsModel()
+			cmd = LoadRDSAccounts(m.loadCtx(), m.services.RDS, instId)
+		}
+
+	case PageRedisList:
+		m.redisListPage = pages.NewRedisListModel().SetShowRegion(m.allRegions)
+		if m.allRegions {
+			title := fmt.Sprintf(i18n.T(i18n.KeyJobFetchAllRegions), m.getPageTitle(page))
+			m, cmd = m.startLoadJob(title, jobs.UnitItems, LoadRedisInstancesAllRegions(m.regionService, m.clients))
+		} else {
+			m, cmd = m.loadOrServePrefetched(PageRedisList, LoadRedisInstances(m.loadCtx(), m.services.Redis))
+		}
+
+	case PageRedisDetail:
+		if detailModel, ok := pages.NewRedisDetailModelFromInterface(data); ok {
+			m.redisDetailPage = detailModel.SetNames(m.services.Names)
+			m.redisDetailPage = m.redisDetailPage.SetSize(m.width, m.height-1)
+			m.loading = false
+			cmd = tea.Batch(
+				ResolveNames(m.loadCtx(), m.services.Names, m.redisDetailPage.NameRefs()),
+				LoadRedisAttributes(m.loadCtx(), m.services.Redis, m.redisDetailPage.InstanceID()),
+			)
+		} else {
+			m.redisJSONPage = pages.NewDetailModel("Redis JSON Detail", data)
+			m.redisJSONPage = m.redisJSONPage.SetSize(m.width, m.height-1)
+			m.currentPage = PageRedisJSONDetail
+			m.loading = false
+		}
+
+	case PageRedisAccounts:
+		if instId, ok := data.(string); ok {
+			m.redisAccountsPage = pages.NewRedisAccountsModel()
+			cmd = LoadRedisAccounts(m.loadCtx(), m.services.Redis, instId)
+		}
+
+	case PageRocketMQList:
+		m.rocketmqListPage = pages.NewRocketMQListModel()
+		if m.allRegions {
+			title := fmt.Sprintf(i18n.T(i18n.KeyJobFetchAllRegions), m.getPageTitle(page))
+			m, cmd = m.startLoadJob(title, jobs.UnitItems, LoadRocketMQInstancesAllRegions(m.regionService, m.clients))
+		} else {
+			cmd = LoadRocketMQInstances(m.loadCtx(), m.services.RocketMQ)
+		}
+
+	case PageRocketMQDetail:
+		if detailModel, ok := pages.NewRocketMQDetailModelFromInterface(data); ok {
+			m.rocketmqDetailPage = detailModel.SetSize(m.width, m.height-1)
+			m.loading = false
+			cmd = LoadRocketMQInfo(m.loadCtx(), m.services.RocketMQ, m.rocketmqDetailPage.InstanceID())
+		} else {
+			m.rocketmqJSONPage = pages.NewDetailModel("RocketMQ JSON Detail", data)
+			m.rocketmqJSONPage = m.rocketmqJSONPage.SetSize(m.width, m.height-1)
+			m.currentPage = PageRocketMQJSONDetail
+			m.loading = false
+		}
+
+	case PageRocketMQTopics:
+		if instId, ok := data.(string); ok {
+			m.rocketmqTopicsPage = pages.NewRocketMQTopicsModel()
+			cmd = LoadRocketMQTopics(m.loadCtx(), m.services.RocketMQ, instId)
+		}
+
+	case PageRocketMQGroups:
+		if instId, ok := data.(string); ok {
+			m.rocketmqGroupsPage = pages.NewRocketMQGroupsModel()
+			cmd = LoadRocketMQGroups(m.loadCtx(), m.services.RocketMQ, instId)
+		}
+
+	case PageResourceFinder:
+		// Finder page is already set up via FindResourceResultMsg
+		m.loading = false
+
+	case PageJobs:
+		m.jobsPage = pages.NewJobsModel().SetData(m.jobManager.Jobs())
+		m.jobsPage = m.jobsPage.SetSize(m.width, m.height-1)
+		m.loading = false
+
+	case PageRAMUsers:
+		m.ramUsersPage = pages.NewRAMUsersModel()
+		cmd = LoadRAMUsers(m.loadCtx(), m.services.RAM)
+
+	case PageRAMRoles:
+		m.ramRolesPage = pages.NewRAMRolesModel()
+		cmd = LoadRAMRoles(m.loadCtx(), m.services.RAM)
+
+	case PageRAMPolicies:
+		m.ramPoliciesPage = pages.NewRAMPoliciesModel()
+		cmd = LoadRAMPolicies(m.loadCtx(), m.services.RAM)
+
+	case PageRAMUserPolicies:
+		if userName, ok := data.(string); ok {
+			m.ramUserPoliciesPage = pages.NewRAMUserPoliciesModel()
+			cmd = LoadRAMUserPolicies(m.loadCtx(), m.services.RAM, userName)
+		}
+
+	case PageRAMDetail:
+		if ref, ok := data.(pages.RAMPolicyRef); ok {
+			// Policy documents are not part of the list, load the default version
+			cmd = LoadRAMPolicyDetail(m.loadCtx(), m.services.RAM, ref.Name, ref.Type)
+		} else {
+			m.ramDetailPage = pages.NewDetailModel("RAM Detail", data)
+			m.ramDetailPage = m.ramDetailPage.SetSize(m.width, m.height-1)
+			m.loading = false
+		}
+
+	case PageECSMetrics:
+		if metricsModel, ok := pages.NewECSMetricsModelFromInterface(data); ok {
+			m.ecsMetricsPage = metricsModel.SetSize(m.width, m.height-1)
+			cmd = tea.Batch(
+				LoadECSMetrics(m.loadCtx(), m.services.Monitor, metricsModel.InstanceID()),
+				LoadECSSizing(m.loadCtx(), m.services.Monitor, m.services.ECS, data.(ecs.Instance)),
+			)
+		}
+
+	case PageRDSMetrics:
+		if metricsModel, ok := pages.NewRDSMetricsModelFromInterface(data); ok {
+			m.rdsMetricsPage = metricsModel.SetSize(m.width, m.height-1)
+			cmd = LoadRDSMetrics(m.loadCtx(), m.services.Monitor, metricsModel.InstanceID())
+		}
+
+	case PageSLSProjects:
+		m.slsProjectsPage = pages.NewSLSProjectsModel()
+		cmd = LoadSLSProjects(m.loadCtx(), m.services.SLS)
+
+	case PageSLSLogtailConfigs:
+		if project, ok := data.(string); ok {
+			m.slsConfigsPage = pages.NewSLSLogtailConfigsModel(project)
+			cmd = LoadSLSLogtail(m.loadCtx(), m.services.SLS, m.services.ECS, project)
+		}
+
+	case PageSLSMachineGroups:
+		if project, ok := data.(string); ok {
+			m.slsGroupsPage = pages.NewSLSMachineGroupsModel(project)
+			cmd = LoadSLSLogtail(m.loadCtx(), m.services.SLS, m.services.ECS, project)
+		}
+
+	case PageSLSMachines:
+		if group, ok := data.(service.SLSMachineGroup); ok {
+			m.slsMachinesPage = pages.NewSLSMachinesModel(group)
+			m.slsMachinesPage = m.slsMachinesPage.SetSize(m.width, m.height-1)
+			m.loading = false
+		}
+
+	case PageSLSCoverage:
+		if project, ok := data.(string); ok {
+			m.slsCoveragePage = pages.NewSLSCoverageModel(project)
+			cmd = LoadSLSLogtail(m.loadCtx(), m.services.SLS, m.services.ECS, project)
+		}
+
+	case PageSLSDetail:
+		m.slsDetailPage = pages.NewDetailModel("Log Service Detail", data)
+		m.slsDetailPage = m.slsDetailPage.SetSize(m.width, m.height-1)
+		m.loading = false
+
+	case PageConfigRules:
+		m.configRulesPage = pages.NewConfigRulesModel()
+		cmd = LoadConfigRules(m.loadCtx(), m.services.Config)
+
+	case PageConfigResults:
+		if rule, ok := data.(cloudconfig.ConfigRule); ok {
+			m.configResultsPage = pages.NewConfigResultsModel(rule)
+			cmd = LoadConfigResults(m.loadCtx(), m.services.Config, rule.ConfigRuleId)
+		}
+
+	case PageConfigDetail:
+		m.configDetailPage = pages.NewDetailModel("Cloud Config Detail", data)
+		m.configDetailPage = m.configDetailPage.SetSize(m.width, m.height-1)
+		m.loading = false
+
+	case PageTagBrowser:
+		m.tagBrowserPage = pages.NewTagBrowserModel()
+		cmd = LoadTags(m.loadCtx(), m.services.Tags)
+
+	case PageTagResources:
+		if pair, ok := data.(service.TagPair); ok {
+			m.tagResourcesPage = pages.NewTagResourcesModel(pair)
+			cmd = LoadTaggedResources(m.loadCtx(), m.services.Tags, pair)
+		}
+
+	case PageTagDetail:
+		m.tagDetailPage = pages.NewDetailModel("Tagged Resource Detail", data)
+		m.tagDetailPage = m.tagDetailPage.SetSize(m.width, m.height-1)
+		m.loading = false
+
+	case PageBilling:
+		m.billingPage = pages.NewBillingModel()
+		cmd = LoadBilling(m.loadCtx(), m.services.Billing)
+
+	case PageBillingDetail:
+		m.billingDetailPage = pages.NewDetailModel("Billing Detail", data)
+		m.billingDetailPage = m.billingDetailPage.SetSize(m.width, m.height-1)
+		m.loading = false
+
+	case PageDNSDangling:
+		m.dnsDanglingPage = pages.NewDNSDanglingModel()
+		m, cmd = m.startLoadJob(i18n.T(i18n.KeyJobScanDanglingDNS), jobs.UnitItems,
+			ScanDanglingDNS(m.regionService, m.clients, m.services.DNS, m.services.DNSTargets))
+
+	case PageRAMRolePolicies:
+		if roleName, ok := data.(string); ok {
+			m.ramRolePoliciesPage = pages.NewRAMRolePoliciesModel()
+			cmd = LoadRAMRolePolicies(m.loadCtx(), m.services.RAM, roleName)
+		}
+
+	case PageNATList:
+		m.natListPage = pages.NewNATListModel()
+		cmd = LoadNATGateways(m.loadCtx(), m.services.NAT)
+
+	case PageNATDetail:
+		m.natDetailPage = pages.NewDetailModel("NAT Gateway Detail", data)
+		m.natDetailPage = m.natDetailPage.SetSize(m.width, m.height-1)
+		m.loading = false
+
+	case PageSNATEntries:
+		if gateway, ok := data.(vpc.NatGateway); ok {
+			m.snatEntriesPage = pages.NewSNATEntriesModel()
+			cmd = LoadSNATEntries(m.loadCtx(), m.services.NAT, gateway)
+		}
+
+	case PageDNATEntries:
+		if gateway, ok := data.(vpc.NatGateway); ok {
+			m.dnatEntriesPage = pages.NewDNATEntriesModel()
+			cmd = LoadDNATEntries(m.loadCtx(), m.services.NAT, gateway)
+		}
+
+	case PageOSSObjectVersions:
+		if navData, ok := data.(pages.OSSObjectNavData); ok {
+			m.ossVersionsPage = pages.NewOSSObjectVersionsModel(navData.BucketName, navData.Object.Key)
+			cmd = LoadOSSObjectVersions(m.loadCtx(), m.services.OSS, navData.BucketName, navData.Object.Key)
+		}
+
+	case PageALBList:
+		m.albListPage = pages.NewALBListModel()
+		cmd = LoadALBLoadBalancers(m.loadCtx(), m.services.ALB)
+
+	case PageALBDetail:
+		m.albDetailPage = pages.NewDetailModel("ALB Detail", data)
+		m.albDetailPage = m.albDetailPage.SetSize(m.width, m.height-1)
+		m.loading = false
+
+	case PageALBListeners:
+		if lb, ok := data.(alb.LoadBalancer); ok {
+			m.albListenersPage = pages.NewALBListenersModel()
+			cmd = LoadALBListeners(m.loadCtx(), m.services.ALB, lb.LoadBalancerId)
+		}
+
+	case PageALBRules:
+		if listener, ok := data.(alb.Listener); ok {
+			m.albRulesPage = pages.NewALBRulesModel()
+			cmd = LoadALBRules(m.loadCtx(), m.services.ALB, listener.ListenerId)
+		}
+
+	case PageALBRuleDetail:
+		m.albRuleDetailPage = pages.NewDetailModel("ALB Rule Detail", data)
+		m.albRuleDetailPage = m.albRuleDetailPage.SetSize(m.width, m.height-1)
+		m.loading = false
+
+	case PageALBServerGroups:
+		if lb, ok := data.(alb.LoadBalancer); ok {
+			m.albServerGroupsPage = pages.NewALBServerGroupsModel()
+			cmd = LoadALBServerGroups(m.loadCtx(), m.services.ALB, lb.LoadBalancerId)
+		}
+
+	case PageNLBList:
+		m.nlbListPage = pages.NewNLBListModel()
+		cmd = LoadNLBLoadBalancers(m.loadCtx(), m.services.NLB)
+
+	case PageNLBDetail:
+		m.nlbDetailPage = pages.NewDetailModel("NLB Detail", data)
+		m.nlbDetailPage = m.nlbDetailPage.SetSize(m.width, m.height-1)
+		m.loading = false
+
+	case PageNLBListeners:
+		if lb, ok := data.(nlb.LoadbalancerInfo); ok {
+			m.nlbListenersPage = pages.NewNLBListenersModel()
+			cmd = LoadNLBListeners(m.loadCtx(), m.services.NLB, lb.LoadBalancerId)
+		}
+
+	case PageNLBServerGroups:
+		if lb, ok := data.(nlb.LoadbalancerInfo); ok {
+			m.nlbServerGroupsPage = pages.NewNLBServerGroupsModel()
+			cmd = LoadNLBServerGroups(m.loadCtx(), m.services.NLB, lb.LoadBalancerId)
+		}
+
+	case PageLBServers:
+		if group, ok := data.(pages.LBServerGroupNavData); ok {
+			m.lbServersPage = pages.NewLBServersModel(group)
+			cmd = LoadLBServers(m.loadCtx(), m.services, group)
+		}
+
+	case PageOSSBucketDetail:
+		if bucketName, ok := data.(string); ok {
+			m.ossBucketDetailPage = pages.NewDetailModel("OSS Bucket Detail", nil)
+			cmd = LoadOSSBucketAudit(m.loadCtx(), m.services.OSS, bucketName)
+		}
+
+	case PageACKClusters:
+		m.ackClustersPage = pages.NewACKClustersModel()
+		cmd = LoadACKClusters(m.loadCtx(), m.services.ACK)
+
+	case PageACKClusterDetail:
+		if cluster, ok := data.(service.ACKCluster); ok {
+			m.ackClusterDetailPage = pages.NewDetailModel("ACK Cluster Detail", nil)
+			cmd = LoadACKClusterDetail(m.loadCtx(), m.services.ACK, cluster.ClusterID)
+		}
 
-	case PageSecurityGroupRules:
-		if sgId, ok := data.(string); ok {
-			m.sgRulesPage = pages.NewSecurityGroupRulesModel(sgId)
-			cmd = LoadSecurityGroupRules(m.services.ECS, sgId)
+	case PageACKNodePools:
+		if cluster, ok := data.(service.ACKCluster); ok {
+			m.ackNodePoolsPage = pages.NewACKNodePoolsModel(cluster)
+			cmd = LoadACKNodePools(m.loadCtx(), m.services.ACK, cluster.ClusterID)
 		}
 
-	case PageSecurityGroupInstances:
-		if sgId, ok := data.(string); ok {
-			m.sgInstancesPage = pages.NewECSListModel()
-			cmd = LoadSecurityGroupInstances(m.services.ECS, sgId)
+	case PageACKNodes:
+		if nodePool, ok := data.(pages.ACKNodePoolNavData); ok {
+			m.ackNodesPage = pages.NewACKNodesModel(nodePool)
+			cmd = LoadACKNodes(m.loadCtx(), m.services.ACK, nodePool)
 		}
 
-	case PageInstanceSecurityGroups:
-		if instId, ok := data.(string); ok {
-			m.instSGPage = pages.NewSecurityGroupsModel()
-			cmd = LoadInstanceSecurityGroups(m.services.ECS, instId)
+	case PageOSSObjectScan:
+		if scan, ok := data.(pages.OSSScanNavData); ok {
+			m.ossScanPage = pages.NewOSSObjectScanModel(scan)
+			m, cmd = m.startLoadJob(fmt.Sprintf(i18n.T(i18n.KeyJobScanOSSObjects), scan.Location()), jobs.UnitItems,
+				ScanOSSObjects(m.services.OSS, scan))
 		}
 
-	case PageDNSDomains:
-		m.dnsDomainsPage = pages.NewDNSDomainsModel()
-		cmd = LoadDNSDomains(m.services.DNS)
+	case PageACRInstances:
+		m.acrInstancesPage = pages.NewACRInstancesModel()
+		cmd = LoadACRInstances(m.loadCtx(), m.services.ACR)
 
-	case PageDNSRecords:
-		if domain, ok := data.(string); ok {
-			m.dnsRecordsPage = pages.NewDNSRecordsModel()
-			cmd = LoadDNSRecords(m.services.DNS, domain)
+	case PageACRNamespaces:
+		if instance, ok := data.(cr_ee.InstancesItem); ok {
+			m.acrNamespacesPage = pages.NewACRNamespacesModel(instance)
+			cmd = LoadACRNamespaces(m.loadCtx(), m.services.ACR, instance.InstanceId)
 		}
 
-	case PageSLBList:
-		m.slbListPage = pages.NewSLBListModel()
-		cmd = LoadSLBInstances(m.services.SLB)
+	case PageACRRepositories:
+		if namespace, ok := data.(pages.ACRNamespaceNavData); ok {
+			m.acrReposPage = pages.NewACRRepositoriesModel(namespace)
+			cmd = LoadACRRepositories(m.loadCtx(), m.services.ACR, namespace)
+		}
 
-	case PageSLBDetail:
-		if lb, ok := data.(interface{}); ok {
-			m.slbDetailPage = pages.NewDetailModel("SLB Detail", lb)
-			m.slbDetailPage = m.slbDetailPage.SetSize(m.width, m.height-1)
+	case PageACRTags:
+		if repo, ok := data.(service.ACRRepository); ok {
+			m.acrTagsPage = pages.NewACRTagsModel(repo)
+			cmd = LoadACRTags(m.loadCtx(), m.services.ACR, repo)
+		}
+
+	case PageACRTagDetail:
+		if tag, ok := data.(service.ACRTag); ok {
+			m.acrTagDetailPage = pages.NewDetailModel(fmt.Sprintf("ACR Image Tag Detail: %s:%s", tag.RepoName, tag.Tag), tag)
+			m.acrTagDetailPage = m.acrTagDetailPage.SetSize(m.width, m.height-1)
 			m.loading = false
 		}
 
-	case PageSLBListeners:
-		if lbId, ok := data.(string); ok {
-			m.slbListenersPage = pages.NewSLBListenersModel()
-			cmd = LoadSLBListeners(m.services.SLB, lbId)
+	case PageFCServices:
+		m.fcServicesPage = pages.NewFCServicesModel()
+		cmd = LoadFCServices(m.loadCtx(), m.services.FC)
+
+	case PageFCFunctions:
+		if svc, ok := data.(service.FCServiceInfo); ok {
+			m.fcFunctionsPage = pages.NewFCFunctionsModel(svc)
+			cmd = LoadFCFunctions(m.loadCtx(), m.services.FC, svc.ServiceName)
 		}
 
-	case PageSLBVServerGroups:
-		if lbId, ok := data.(string); ok {
-			m.slbVServerPage = pages.NewSLBVServerGroupsModel()
-			cmd = LoadSLBVServerGroups(m.services.SLB, lbId)
+	case PageFCFunctionDetail:
+		if fn, ok := data.(service.FCFunction); ok {
+			m.fcDetailPage = pages.NewDetailModel(fmt.Sprintf("FC Function Detail: %s", fn.FunctionName), fn)
+			m.fcDetailPage = m.fcDetailPage.SetSize(m.width, m.height-1)
+			m.loading = false
 		}
 
-	case PageSLBBackendServers:
-		if vsgId, ok := data.(string); ok {
-			m.slbBackendPage = pages.NewSLBBackendServersModel()
-			cmd = LoadSLBBackendServers(m.services.SLB, vsgId, m.clients.ECS)
+	case PageSLBJSONDetail:
+		m.slbJSONDetailPage = pages.NewDetailModel("SLB JSON Detail", data)
+		m.slbJSONDetailPage = m.slbJSONDetailPage.SetSize(m.width, m.height-1)
+		m.loading = false
+
+	case PageMongoDBList:
+		m.mongoListPage = pages.NewMongoDBListModel().SetShowRegion(m.allRegions)
+		if m.allRegions {
+			title := fmt.Sprintf(i18n.T(i18n.KeyJobFetchAllRegions), m.getPageTitle(page))
+			m, cmd = m.startLoadJob(title, jobs.UnitItems, LoadMongoDBInstancesAllRegions(m.regionService, m.clients))
+		} else {
+			cmd = LoadMongoDBInstances(m.loadCtx(), m.services.MongoDB)
 		}
 
-	case PageSLBForwardingRules:
-		if navData, ok := data.(pages.ListenerNavData); ok {
-			m.slbForwardingRulesPage = pages.NewSLBForwardingRulesModel()
-			cmd = LoadSLBForwardingRules(m.services.SLB, navData.LoadBalancerId, navData.ListenerPort, navData.ListenerProtocol)
+	case PageMongoDBDetail:
+		if detailModel, ok := pages.NewMongoDBDetailModelFromInterface(data); ok {
+			m.mongoDetailPage = detailModel.SetNames(m.services.Names)
+			m.mongoDetailPage = m.mongoDetailPage.SetSize(m.width, m.height-1)
+			m.loading = false
+			cmd = tea.Batch(
+				ResolveNames(m.loadCtx(), m.services.Names, m.mongoDetailPage.NameRefs()),
+				LoadMongoDBTopology(m.loadCtx(), m.services.MongoDB, m.mongoDetailPage.InstanceID()),
+			)
 		}
 
-	case PageSLBDefaultServers:
-		if lbId, ok := data.(string); ok {
-			m.slbDefaultServersPage = pages.NewSLBDefaultServersModel()
-			cmd = LoadSLBDefaultServers(m.services.SLB, lbId, m.clients.ECS)
+	case PageMongoDBJSONDetail:
+		m.mongoJSONPage = pages.NewDetailModel("MongoDB JSON Detail", data)
+		m.mongoJSONPage = m.mongoJSONPage.SetSize(m.width, m.height-1)
+		m.loading = false
+
+	case PageMongoDBAccounts:
+		if instId, ok := data.(string); ok {
+			m.mongoAccountsPage = pages.NewMongoDBAccountsModel()
+			cmd = LoadMongoDBAccounts(m.loadCtx(), m.services.MongoDB, instId)
 		}
 
-	case PageOSSBuckets:
-		m.ossBucketsPage = pages.NewOSSBucketsModel()
-		cmd = LoadOSSBuckets(m.services.OSS)
+	case PageRDSJSONDetail:
+		m.rdsJSONPage = pages.NewDetailModel("RDS JSON Detail", data)
+		m.rdsJSONPage = m.rdsJSONPage.SetSize(m.width, m.height-1)
+		m.loading = false
 
-	case PageOSSObjects:
-		if bucket, ok := data.(string); ok {
-			m.ossObjectsPage = pages.NewOSSObjectsModel(m.services.OSS, bucket)
-			cmd = LoadOSSObjects(m.services.OSS, bucket, "", 20, 1)
+	case PageRedisJSONDetail:
+		m.redisJSONPage = pages.NewDetailModel("Redis JSON Detail", data)
+		m.redisJSONPage = m.redisJSONPage.SetSize(m.width, m.height-1)
+		m.loading = false
+
+	case PageRocketMQJSONDetail:
+		m.rocketmqJSONPage = pages.NewDetailModel("RocketMQ JSON Detail", data)
+		m.rocketmqJSONPage = m.rocketmqJSONPage.SetSize(m.width, m.height-1)
+		m.loading = false
+
+	case PageElasticsearchList:
+		m.esListPage = pages.NewElasticsearchListModel().SetShowRegion(m.allRegions)
+		if m.allRegions {
+			title := fmt.Sprintf(i18n.T(i18n.KeyJobFetchAllRegions), m.getPageTitle(page))
+			m, cmd = m.startLoadJob(title, jobs.UnitItems, LoadElasticsearchInstancesAllRegions(m.regionService, m.clients))
+		} else {
+			cmd = LoadElasticsearchInstances(m.loadCtx(), m.services.ES)
 		}
 
-	case PageOSSObjectDetail:
-		if obj, ok := data.(interface{}); ok {
-			m.ossDetailPage = pages.NewDetailModel("OSS Object Detail", obj)
-			m.ossDetailPage = m.ossDetailPage.SetSize(m.width, m.height-1)
+	case PageElasticsearchDetail:
+		if detailModel, ok := pages.NewElasticsearchDetailModelFromInterface(data); ok {
+			m.esDetailPage = detailModel.SetNames(m.services.Names)
+			m.esDetailPage = m.esDetailPage.SetSize(m.width, m.height-1)
 			m.loading = false
+			cmd = ResolveNames(m.loadCtx(), m.services.Names, m.esDetailPage.NameRefs())
 		}
 
-	case PageRDSList:
-		m.rdsListPage = pages.NewRDSListModel()
-		cmd = LoadRDSDetailedInstances(m.services.RDS)
+	case PageElasticsearchJSONDetail:
+		m.esJSONPage = pages.NewDetailModel("Elasticsearch JSON Detail", data)
+		m.esJSONPage = m.esJSONPage.SetSize(m.width, m.height-1)
+		m.loading = false
 
-	case PageRDSDetail:
-		if inst, ok := data.(interface{}); ok {
-			m.rdsDetailPage = pages.NewDetailModel("RDS Detail", inst)
-			m.rdsDetailPage = m.rdsDetailPage.SetSize(m.width, m.height-1)
+	case PageZoneCapacity:
+		m.zoneCapacityPage = pages.NewZoneCapacityModel()
+		cmd = LoadZoneCapacity(m.loadCtx(), m.services.ECS)
+
+	case PageKafkaList:
+		m.kafkaListPage = pages.NewKafkaListModel().SetShowRegion(m.allRegions)
+		if m.allRegions {
+			title := fmt.Sprintf(i18n.T(i18n.KeyJobFetchAllRegions), m.getPageTitle(page))
+			m, cmd = m.startLoadJob(title, jobs.UnitItems, LoadKafkaInstancesAllRegions(m.regionService, m.clients))
+		} else {
+			cmd = LoadKafkaInstances(m.loadCtx(), m.services.Kafka)
+		}
+
+	case PageKafkaDetail:
+		if detailModel, ok := pages.NewKafkaDetailModelFromInterface(data); ok {
+			m.kafkaDetailPage = detailModel.SetNames(m.services.Names)
+			m.kafkaDetailPage = m.kafkaDetailPage.SetSize(m.width, m.height-1)
 			m.loading = false
+			cmd = ResolveNames(m.loadCtx(), m.services.Names, m.kafkaDetailPage.NameRefs())
 		}
 
-	case PageRDSDatabases:
+	case PageKafkaJSONDetail:
+		m.kafkaJSONPage = pages.NewDetailModel("Kafka JSON Detail", data)
+		m.kafkaJSONPage = m.kafkaJSONPage.SetSize(m.width, m.height-1)
+		m.loading = false
+
+	case PageKafkaTopics:
 		if instId, ok := data.(string); ok {
-			m.rdsDatabasesPage = pages.NewRDSDatabasesModel()
-			cmd = LoadRDSDatabases(m.services.RDS, instId)
+			m.kafkaTopicsPage = pages.NewKafkaTopicsModel()
+			cmd = LoadKafkaTopics(m.loadCtx(), m.services.Kafka, instId)
 		}
 
-	case PageRDSAccounts:
+	case PageKafkaGroups:
 		if instId, ok := data.(string); ok {
-			m.rdsAccountsPage = pages.NewRDSAccountsModel()
-			cmd = LoadRDSAccounts(m.services.RDS, instId)
+			m.kafkaGroupsPage = pages.NewKafkaGroupsModel()
+			cmd = LoadKafkaGroups(m.loadCtx(), m.services.Kafka, instId)
 		}
 
-	case PageRedisList:
-		m.redisListPage = pages.NewRedisListModel()
-		cmd = LoadRedisInstances(m.services.Redis)
-
-	case PageRedisDetail:
-		if inst, ok := data.(interface{}); ok {
-			m.redisDetailPage = pages.NewDetailModel("Redis Detail", inst)
-			m.redisDetailPage = m.redisDetailPage.SetSize(m.width, m.height-1)
+	case PageRocketMQLag:
+		if lagData, ok := data.(pages.RocketMQLagData); ok {
+			m.rocketmqLagPage = pages.NewRocketMQLagModel().SetData(lagData)
+			m.rocketmqLagPage = m.rocketmqLagPage.SetSize(m.width, m.height-1)
 			m.loading = false
 		}
 
-	case PageRedisAccounts:
-		if instId, ok := data.(string); ok {
-			m.redisAccountsPage = pages.NewRedisAccountsModel()
-			cmd = LoadRedisAccounts(m.services.Redis, instId)
+	case PageRocketMQMessages:
+		if ref, ok := data.(pages.RocketMQTopicRef); ok {
+			m.rocketmqMessagesPage = pages.NewRocketMQMessagesModel()
+			cmd = LoadRocketMQMessages(m.loadCtx(), m.services.RocketMQ, ref.InstanceId, ref.Topic)
 		}
 
-	case PageRocketMQList:
-		m.rocketmqListPage = pages.NewRocketMQListModel()
-		cmd = LoadRocketMQInstances(m.services.RocketMQ)
+	case PageRedisParameters:
+		if instanceId, ok := data.(string); ok {
+			m.redisParamsPage = pages.NewRedisParametersModel()
+			cmd = LoadRedisParameters(m.loadCtx(), m.services.Redis, instanceId)
+		}
 
-	case PageRocketMQDetail:
-		if inst, ok := data.(interface{}); ok {
-			m.rocketmqDetailPage = pages.NewDetailModel("RocketMQ Detail", inst)
-			m.rocketmqDetailPage = m.rocketmqDetailPage.SetSize(m.width, m.height-1)
-			m.loading = false
+	case PageRedisMetrics:
+		if metricsModel, ok := pages.NewRedisMetricsModelFromInterface(data); ok {
+			m.redisMetricsPage = metricsModel.SetSize(m.width, m.height-1)
+			cmd = LoadRedisMetrics(m.loadCtx(), m.services.Redis, metricsModel.InstanceID())
 		}
 
-	case PageRocketMQTopics:
-		if instId, ok := data.(string); ok {
-			m.rocketmqTopicsPage = pages.NewRocketMQTopicsModel()
-			cmd = LoadRocketMQTopics(m.services.RocketMQ, instId)
+	case PageRecycleBin:
+		m.recycleBinPage = pages.NewRecycleBinModel()
+		cmd = LoadRecycleBin(m.loadCtx(), m.services.ECS, m.services.RDS)
+
+	case PageOSSDeletedObjects:
+		if navData, ok := data.(pages.OSSDeletedNavData); ok {
+			m.ossDeletedPage = pages.NewOSSDeletedObjectsModel(navData.BucketName, navData.Prefix)
+			cmd = LoadOSSDeletedObjects(m.loadCtx(), m.services.OSS, navData.BucketName, navData.Prefix)
 		}
 
-	case PageRocketMQGroups:
-		if instId, ok := data.(string); ok {
-			m.rocketmqGroupsPage = pages.NewRocketMQGroupsModel()
-			cmd = LoadRocketMQGroups(m.services.RocketMQ, instId)
+	case PageRedisBackups:
+		if instanceId, ok := data.(string); ok {
+			m.redisBackupsPage = pages.NewRedisBackupsModel()
+			cmd = LoadRedisBackups(m.loadCtx(), m.services.Redis, instanceId)
 		}
 
-	case PageResourceFinder:
-		// Finder page is already set up via FindResourceResultMsg
+	case PageRDSBackups:
+		if instanceId, ok := data.(string); ok {
+			m.rdsBackupsPage = pages.NewRDSBackupsModel()
+			cmd = LoadRDSBackups(m.loadCtx(), m.services.RDS, instanceId)
+		}
+
+	case PageRDSBinlogs:
+		if instanceId, ok := data.(string); ok {
+			m.rdsBinlogsPage = pages.NewRDSBinlogsModel()
+			cmd = LoadRDSBinlogs(m.loadCtx(), m.services.RDS, instanceId)
+		}
+
+	case PageRDSSlowLog:
+		if navData, ok := data.(pages.RDSSlowLogNavData); ok {
+			m.rdsSlowLogPage = pages.NewRDSSlowLogModel(navData.InstanceID, navData.Days)
+			cmd = LoadRDSSlowLogs(m.loadCtx(), m.services.RDS, navData.InstanceID, navData.Days)
+		}
+
+	case PageChangelog:
+		since, _ := data.(string)
+		m.changelogPage = pages.NewChangelogModel(since)
+		m.changelogPage = m.changelogPage.SetSize(m.width, m.height-1)
+		m.loading = false
+
+	case PageRecent:
+		m.recentPage = pages.NewRecentModel().SetData(m.recent.Items)
+		m.recentPage = m.recentPage.SetSize(m.width, m.height-1)
+		m.loading = false
+
+	case PageUsage:
+		m.usagePage = pages.NewUsageModel().SetData(m.usage)
+		m.usagePage = m.usagePage.SetSize(m.width, m.height-1)
+		m.loading = false
+
+	case PageDNSImport:
+		m.dnsImportPage = pages.NewDNSImportModel()
+		if path, ok := data.(string); ok {
+			m, cmd = m.startLoadJob(fmt.Sprintf(i18n.T(i18n.KeyJobDNSImportPlan), path), jobs.UnitItems,
+				PlanDNSImport(m.services.DNS, path))
+		}
+
+	case PageAPITrace:
+		m.apiTracePage = pages.NewAPITraceModel().SetData(logging.Recent())
+		m.apiTracePage = m.apiTracePage.SetSize(m.width, m.height-1)
 		m.loading = false
+		m, cmd = m.scheduleTraceTick()
 
 	default:
 		m.loading = false
 	}
 
+	m = m.recordRecent(m.currentPage, data)
+	m.usage.RecordPage(m.currentPage.String())
 	return m, cmd
 }
 
@@ -991,12 +2966,59 @@ func (m Model) navigateBack() (Model, tea.Cmd) {
 	lastIdx := len(m.previousPages) - 1
 	prevPage := m.previousPages[lastIdx]
 	m.previousPages = m.previousPages[:lastIdx]
+	m.alternatePage = m.currentPage
+	m.hasAlternate = true
 	m.currentPage = prevPage
 
+	// The page left stops loading: its API calls are cancelled and whatever
+	// they return is dropped
+	m.loads.leave(lastIdx + 1)
+	if m.loadJob != 0 {
+		m.jobManager.Cancel(m.loadJob)
+		m.loadJob = 0
+		m = m.refreshJobs()
+	}
+	m.loading = false
+	m.finderSearch = nil
+
 	// Update mode line and header
 	m.modeLine = m.modeLine.SetPage(prevPage)
 	m.header = m.header.SetTitle(m.getPageTitle(prevPage))
 
+	// The page may have been left in the split view's left pane
+	m = m.fitSplit()
+
+	return m, nil
+}
+
+// toggleAlternatePage switches to the previously viewed page without
+// reloading it, so both pages keep their cursor, search and scroll state
+func (m Model) toggleAlternatePage() (Model, tea.Cmd) {
+	if !m.hasAlternate || m.loading || m.alternatePage == m.currentPage {
+		return m, nil
+	}
+
+	target := m.alternatePage
+
+	// Keep the back stack consistent: toggling to the page on top of the
+	// stack is the same as going back, otherwise it behaves like a forward jump
+	if n := len(m.previousPages); n > 0 && m.previousPages[n-1] == target {
+		m.previousPages = m.previousPages[:n-1]
+		m.loads.leave(n)
+	} else {
+		m.previousPages = append(m.previousPages, m.currentPage)
+	}
+
+	m.alternatePage = m.currentPage
+	m.currentPage = target
+
+	// Update mode line and header
+	m.modeLine = m.modeLine.SetPage(target)
+	m.header = m.header.SetTitle(m.getPageTitle(target))
+
+	// The page may have been left in the split view's left pane
+	m = m.fitSplit()
+
 	return m, nil
 }
 
@@ -1044,9 +3066,11 @@ func (m Model) getPageTitle(page PageType) string {
 	case PageOSSBuckets:
 		return i18n.T(i18n.KeyPageOSSBuckets)
 	case PageOSSObjects:
-		return i18n.T(i18n.KeyPageOSSObjects)
+		return fmt.Sprintf("%s: %s", i18n.T(i18n.KeyPageOSSObjects), m.ossObjectsPage.Breadcrumb())
 	case PageOSSObjectDetail:
 		return i18n.T(i18n.KeyPageOSSDetail)
+	case PageOSSObjectPreview:
+		return i18n.T(i18n.KeyPageOSSPreview)
 	case PageRDSList:
 		return i18n.T(i18n.KeyPageRDSList)
 	case PageRDSDetail:
@@ -1071,6 +3095,178 @@ func (m Model) getPageTitle(page PageType) string {
 		return i18n.T(i18n.KeyPageRocketMQGroups)
 	case PageResourceFinder:
 		return i18n.T(i18n.KeyPageResourceFinder)
+	case PageJobs:
+		return i18n.T(i18n.KeyPageJobs)
+	case PageRAMUsers:
+		return i18n.T(i18n.KeyPageRAMUsers)
+	case PageRAMRoles:
+		return i18n.T(i18n.KeyPageRAMRoles)
+	case PageRAMPolicies:
+		return i18n.T(i18n.KeyPageRAMPolicies)
+	case PageRAMUserPolicies:
+		return i18n.T(i18n.KeyPageRAMUserPolicies)
+	case PageRAMDetail:
+		return i18n.T(i18n.KeyPageRAMDetail)
+	case PageECSMetrics:
+		return i18n.T(i18n.KeyPageECSMetrics)
+	case PageRDSMetrics:
+		return i18n.T(i18n.KeyPageRDSMetrics)
+	case PageSLSProjects:
+		return i18n.T(i18n.KeyPageSLSProjects)
+	case PageSLSLogtailConfigs:
+		return i18n.T(i18n.KeyPageSLSLogtailConfigs)
+	case PageSLSMachineGroups:
+		return i18n.T(i18n.KeyPageSLSMachineGroups)
+	case PageSLSMachines:
+		return i18n.T(i18n.KeyPageSLSMachines)
+	case PageSLSCoverage:
+		return i18n.T(i18n.KeyPageSLSCoverage)
+	case PageSLSDetail:
+		return i18n.T(i18n.KeyPageSLSDetail)
+	case PageConfigRules:
+		return i18n.T(i18n.KeyPageConfigRules)
+	case PageConfigResults:
+		return i18n.T(i18n.KeyPageConfigResults)
+	case PageConfigDetail:
+		return i18n.T(i18n.KeyPageConfigDetail)
+	case PageTagBrowser:
+		return i18n.T(i18n.KeyPageTagBrowser)
+	case PageTagResources:
+		return i18n.T(i18n.KeyPageTagResources)
+	case PageTagDetail:
+		return i18n.T(i18n.KeyPageTagDetail)
+	case PageBilling:
+		return i18n.T(i18n.KeyPageBilling)
+	case PageBillingDetail:
+		return i18n.T(i18n.KeyPageBillingDetail)
+	case PageDNSDangling:
+		return i18n.T(i18n.KeyPageDNSDangling)
+	case PageRAMRolePolicies:
+		return i18n.T(i18n.KeyPageRAMRolePolicies)
+	case PageNATList:
+		return i18n.T(i18n.KeyPageNATList)
+	case PageNATDetail:
+		return i18n.T(i18n.KeyPageNATDetail)
+	case PageSNATEntries:
+		return i18n.T(i18n.KeyPageSNATEntries)
+	case PageDNATEntries:
+		return i18n.T(i18n.KeyPageDNATEntries)
+	case PageOSSObjectVersions:
+		return i18n.T(i18n.KeyPageOSSVersions)
+	case PageALBList:
+		return i18n.T(i18n.KeyPageALBList)
+	case PageALBDetail:
+		return i18n.T(i18n.KeyPageALBDetail)
+	case PageALBListeners:
+		return i18n.T(i18n.KeyPageALBListeners)
+	case PageALBRules:
+		return i18n.T(i18n.KeyPageALBRules)
+	case PageALBRuleDetail:
+		return i18n.T(i18n.KeyPageALBRuleDetail)
+	case PageALBServerGroups:
+		return i18n.T(i18n.KeyPageALBServerGroups)
+	case PageNLBList:
+		return i18n.T(i18n.KeyPageNLBList)
+	case PageNLBDetail:
+		return i18n.T(i18n.KeyPageNLBDetail)
+	case PageNLBListeners:
+		return i18n.T(i18n.KeyPageNLBListeners)
+	case PageNLBServerGroups:
+		return i18n.T(i18n.KeyPageNLBServerGroups)
+	case PageLBServers:
+		return i18n.T(i18n.KeyPageLBServers)
+	case PageOSSBucketDetail:
+		return i18n.T(i18n.KeyPageOSSBucketDetail)
+	case PageACKClusters:
+		return i18n.T(i18n.KeyPageACKClusters)
+	case PageACKClusterDetail:
+		return i18n.T(i18n.KeyPageACKClusterDetail)
+	case PageACKNodePools:
+		return i18n.T(i18n.KeyPageACKNodePools)
+	case PageACKNodes:
+		return i18n.T(i18n.KeyPageACKNodes)
+	case PageOSSObjectScan:
+		return fmt.Sprintf("%s: %s", i18n.T(i18n.KeyPageOSSScan), m.ossScanPage.Scan().Location())
+	case PageACRInstances:
+		return i18n.T(i18n.KeyPageACRInstances)
+	case PageACRNamespaces:
+		return i18n.T(i18n.KeyPageACRNamespaces)
+	case PageACRRepositories:
+		return i18n.T(i18n.KeyPageACRRepositories)
+	case PageACRTags:
+		return i18n.T(i18n.KeyPageACRTags)
+	case PageACRTagDetail:
+		return i18n.T(i18n.KeyPageACRTagDetail)
+	case PageFCServices:
+		return i18n.T(i18n.KeyPageFCServices)
+	case PageFCFunctions:
+		return i18n.T(i18n.KeyPageFCFunctions)
+	case PageFCFunctionDetail:
+		return i18n.T(i18n.KeyPageFCFunctionDetail)
+	case PageSLBJSONDetail:
+		return i18n.T(i18n.KeyPageSLBJSONDetail)
+	case PageMongoDBList:
+		return i18n.T(i18n.KeyPageMongoDBList)
+	case PageMongoDBDetail:
+		return i18n.T(i18n.KeyPageMongoDBDetail)
+	case PageMongoDBJSONDetail:
+		return i18n.T(i18n.KeyPageMongoDBJSONDetail)
+	case PageMongoDBAccounts:
+		return i18n.T(i18n.KeyPageMongoDBAccounts)
+	case PageRDSJSONDetail:
+		return i18n.T(i18n.KeyPageRDSJSONDetail)
+	case PageRedisJSONDetail:
+		return i18n.T(i18n.KeyPageRedisJSONDetail)
+	case PageRocketMQJSONDetail:
+		return i18n.T(i18n.KeyPageRocketMQJSONDetail)
+	case PageElasticsearchList:
+		return i18n.T(i18n.KeyPageElasticsearchList)
+	case PageElasticsearchDetail:
+		return i18n.T(i18n.KeyPageElasticsearchDetail)
+	case PageElasticsearchJSONDetail:
+		return i18n.T(i18n.KeyPageElasticsearchJSONDetail)
+	case PageZoneCapacity:
+		return i18n.T(i18n.KeyPageZoneCapacity)
+	case PageKafkaList:
+		return i18n.T(i18n.KeyPageKafkaList)
+	case PageKafkaDetail:
+		return i18n.T(i18n.KeyPageKafkaDetail)
+	case PageKafkaJSONDetail:
+		return i18n.T(i18n.KeyPageKafkaJSONDetail)
+	case PageKafkaTopics:
+		return i18n.T(i18n.KeyPageKafkaTopics)
+	case PageKafkaGroups:
+		return i18n.T(i18n.KeyPageKafkaGroups)
+	case PageRocketMQLag:
+		return i18n.T(i18n.KeyPageRocketMQLag)
+	case PageRocketMQMessages:
+		return i18n.T(i18n.KeyPageRocketMQMessages)
+	case PageRedisParameters:
+		return i18n.T(i18n.KeyPageRedisParameters)
+	case PageRedisMetrics:
+		return i18n.T(i18n.KeyPageRedisMetrics)
+	case PageRecycleBin:
+		return i18n.T(i18n.KeyPageRecycleBin)
+	case PageOSSDeletedObjects:
+		return i18n.T(i18n.KeyPageOSSDeleted)
+	case PageRedisBackups:
+		return i18n.T(i18n.KeyPageRedisBackups)
+	case PageRDSBackups:
+		return i18n.T(i18n.KeyPageRDSBackups)
+	case PageRDSBinlogs:
+		return i18n.T(i18n.KeyPageRDSBinlogs)
+	case PageRDSSlowLog:
+		return i18n.T(i18n.KeyPageRDSSlowLog)
+	case PageChangelog:
+		return i18n.T(i18n.KeyPageChangelog)
+	case PageRecent:
+		return i18n.T(i18n.KeyPageRecent)
+	case PageUsage:
+		return i18n.T(i18n.KeyPageUsage)
+	case PageDNSImport:
+		return i18n.T(i18n.KeyPageDNSImport)
+	case PageAPITrace:
+		return i18n.T(i18n.KeyPageAPITrace)
 	default:
 		return i18n.T(i18n.KeyAppTitle)
 	}
@@ -1147,6 +3343,9 @@ func (m Model) updateCurrentPage(msg tea.Msg) (Model, tea.Cmd) {
 	case PageOSSObjectDetail:
 		m.ossDetailPage, cmd = m.ossDetailPage.Update(msg)
 
+	case PageOSSObjectPreview:
+		m.ossPreviewPage, cmd = m.ossPreviewPage.Update(msg)
+
 	case PageRDSList:
 		m.rdsListPage, cmd = m.rdsListPage.Update(msg)
 
@@ -1182,6 +3381,263 @@ func (m Model) updateCurrentPage(msg tea.Msg) (Model, tea.Cmd) {
 
 	case PageResourceFinder:
 		m.finderPage, cmd = m.finderPage.Update(msg)
+
+	case PageJobs:
+		m.jobsPage, cmd = m.jobsPage.Update(msg)
+
+	case PageRAMUsers:
+		m.ramUsersPage, cmd = m.ramUsersPage.Update(msg)
+
+	case PageRAMRoles:
+		m.ramRolesPage, cmd = m.ramRolesPage.Update(msg)
+
+	case PageRAMPolicies:
+		m.ramPoliciesPage, cmd = m.ramPoliciesPage.Update(msg)
+
+	case PageRAMUserPolicies:
+		m.ramUserPoliciesPage, cmd = m.ramUserPoliciesPage.Update(msg)
+
+	case PageRAMDetail:
+		m.ramDetailPage, cmd = m.ramDetailPage.Update(msg)
+
+	case PageECSMetrics:
+		m.ecsMetricsPage, cmd = m.ecsMetricsPage.Update(msg)
+
+	case PageRDSMetrics:
+		m.rdsMetricsPage, cmd = m.rdsMetricsPage.Update(msg)
+
+	case PageSLSProjects:
+		m.slsProjectsPage, cmd = m.slsProjectsPage.Update(msg)
+
+	case PageSLSLogtailConfigs:
+		m.slsConfigsPage, cmd = m.slsConfigsPage.Update(msg)
+
+	case PageSLSMachineGroups:
+		m.slsGroupsPage, cmd = m.slsGroupsPage.Update(msg)
+
+	case PageSLSMachines:
+		m.slsMachinesPage, cmd = m.slsMachinesPage.Update(msg)
+
+	case PageSLSCoverage:
+		m.slsCoveragePage, cmd = m.slsCoveragePage.Update(msg)
+
+	case PageSLSDetail:
+		m.slsDetailPage, cmd = m.slsDetailPage.Update(msg)
+
+	case PageConfigRules:
+		m.configRulesPage, cmd = m.configRulesPage.Update(msg)
+
+	case PageConfigResults:
+		m.configResultsPage, cmd = m.configResultsPage.Update(msg)
+
+	case PageConfigDetail:
+		m.configDetailPage, cmd = m.configDetailPage.Update(msg)
+
+	case PageTagBrowser:
+		m.tagBrowserPage, cmd = m.tagBrowserPage.Update(msg)
+
+	case PageTagResources:
+		m.tagResourcesPage, cmd = m.tagResourcesPage.Update(msg)
+
+	case PageTagDetail:
+		m.tagDetailPage, cmd = m.tagDetailPage.Update(msg)
+
+	case PageBilling:
+		m.billingPage, cmd = m.billingPage.Update(msg)
+
+	case PageBillingDetail:
+		m.billingDetailPage, cmd = m.billingDetailPage.Update(msg)
+
+	case PageDNSDangling:
+		m.dnsDanglingPage, cmd = m.dnsDanglingPage.Update(msg)
+
+	case PageRAMRolePolicies:
+		m.ramRolePoliciesPage, cmd = m.ramRolePoliciesPage.Update(msg)
+
+	case PageNATList:
+		m.natListPage, cmd = m.natListPage.Update(msg)
+
+	case PageNATDetail:
+		m.natDetailPage, cmd = m.natDetailPage.Update(msg)
+
+	case PageSNATEntries:
+		m.snatEntriesPage, cmd = m.snatEntriesPage.Update(msg)
+
+	case PageDNATEntries:
+		m.dnatEntriesPage, cmd = m.dnatEntriesPage.Update(msg)
+
+	case PageOSSObjectVersions:
+		m.ossVersionsPage, cmd = m.ossVersionsPage.Update(msg)
+
+	case PageALBList:
+		m.albListPage, cmd = m.albListPage.Update(msg)
+
+	case PageALBDetail:
+		m.albDetailPage, cmd = m.albDetailPage.Update(msg)
+
+	case PageALBListeners:
+		m.albListenersPage, cmd = m.albListenersPage.Update(msg)
+
+	case PageALBRules:
+		m.albRulesPage, cmd = m.albRulesPage.Update(msg)
+
+	case PageALBRuleDetail:
+		m.albRuleDetailPage, cmd = m.albRuleDetailPage.Update(msg)
+
+	case PageALBServerGroups:
+		m.albServerGroupsPage, cmd = m.albServerGroupsPage.Update(msg)
+
+	case PageNLBList:
+		m.nlbListPage, cmd = m.nlbListPage.Update(msg)
+
+	case PageNLBDetail:
+		m.nlbDetailPage, cmd = m.nlbDetailPage.Update(msg)
+
+	case PageNLBListeners:
+		m.nlbListenersPage, cmd = m.nlbListenersPage.Update(msg)
+
+	case PageNLBServerGroups:
+		m.nlbServerGroupsPage, cmd = m.nlbServerGroupsPage.Update(msg)
+
+	case PageLBServers:
+		m.lbServersPage, cmd = m.lbServersPage.Update(msg)
+
+	case PageOSSBucketDetail:
+		m.ossBucketDetailPage, cmd = m.ossBucketDetailPage.Update(msg)
+
+	case PageACKClusters:
+		m.ackClustersPage, cmd = m.ackClustersPage.Update(msg)
+
+	case PageACKClusterDetail:
+		m.ackClusterDetailPage, cmd = m.ackClusterDetailPage.Update(msg)
+
+	case PageACKNodePools:
+		m.ackNodePoolsPage, cmd = m.ackNodePoolsPage.Update(msg)
+
+	case PageACKNodes:
+		m.ackNodesPage, cmd = m.ackNodesPage.Update(msg)
+
+	case PageOSSObjectScan:
+		m.ossScanPage, cmd = m.ossScanPage.Update(msg)
+
+	case PageACRInstances:
+		m.acrInstancesPage, cmd = m.acrInstancesPage.Update(msg)
+
+	case PageACRNamespaces:
+		m.acrNamespacesPage, cmd = m.acrNamespacesPage.Update(msg)
+
+	case PageACRRepositories:
+		m.acrReposPage, cmd = m.acrReposPage.Update(msg)
+
+	case PageACRTags:
+		m.acrTagsPage, cmd = m.acrTagsPage.Update(msg)
+
+	case PageACRTagDetail:
+		m.acrTagDetailPage, cmd = m.acrTagDetailPage.Update(msg)
+
+	case PageFCServices:
+		m.fcServicesPage, cmd = m.fcServicesPage.Update(msg)
+
+	case PageFCFunctions:
+		m.fcFunctionsPage, cmd = m.fcFunctionsPage.Update(msg)
+
+	case PageFCFunctionDetail:
+		m.fcDetailPage, cmd = m.fcDetailPage.Update(msg)
+
+	case PageSLBJSONDetail:
+		m.slbJSONDetailPage, cmd = m.slbJSONDetailPage.Update(msg)
+
+	case PageMongoDBList:
+		m.mongoListPage, cmd = m.mongoListPage.Update(msg)
+
+	case PageMongoDBDetail:
+		m.mongoDetailPage, cmd = m.mongoDetailPage.Update(msg)
+
+	case PageMongoDBJSONDetail:
+		m.mongoJSONPage, cmd = m.mongoJSONPage.Update(msg)
+
+	case PageMongoDBAccounts:
+		m.mongoAccountsPage, cmd = m.mongoAccountsPage.Update(msg)
+
+	case PageRDSJSONDetail:
+		m.rdsJSONPage, cmd = m.rdsJSONPage.Update(msg)
+
+	case PageRedisJSONDetail:
+		m.redisJSONPage, cmd = m.redisJSONPage.Update(msg)
+
+	case PageRocketMQJSONDetail:
+		m.rocketmqJSONPage, cmd = m.rocketmqJSONPage.Update(msg)
+
+	case PageElasticsearchList:
+		m.esListPage, cmd = m.esListPage.Update(msg)
+
+	case PageElasticsearchDetail:
+		m.esDetailPage, cmd = m.esDetailPage.Update(msg)
+
+	case PageElasticsearchJSONDetail:
+		m.esJSONPage, cmd = m.esJSONPage.Update(msg)
+
+	case PageZoneCapacity:
+		m.zoneCapacityPage, cmd = m.zoneCapacityPage.Update(msg)
+
+	case PageKafkaList:
+		m.kafkaListPage, cmd = m.kafkaListPage.Update(msg)
+
+	case PageKafkaDetail:
+		m.kafkaDetailPage, cmd = m.kafkaDetailPage.Update(msg)
+
+	case PageKafkaJSONDetail:
+		m.kafkaJSONPage, cmd = m.kafkaJSONPage.Update(msg)
+
+	case PageKafkaTopics:
+		m.kafkaTopicsPage, cmd = m.kafkaTopicsPage.Update(msg)
+
+	case PageKafkaGroups:
+		m.kafkaGroupsPage, cmd = m.kafkaGroupsPage.Update(msg)
+
+	case PageRocketMQLag:
+		m.rocketmqLagPage, cmd = m.rocketmqLagPage.Update(msg)
+
+	case PageRocketMQMessages:
+		m.rocketmqMessagesPage, cmd = m.rocketmqMessagesPage.Update(msg)
+
+	case PageRedisParameters:
+		m.redisParamsPage, cmd = m.redisParamsPage.Update(msg)
+
+	case PageRedisMetrics:
+		m.redisMetricsPage, cmd = m.redisMetricsPage.Update(msg)
+
+	case PageRecycleBin:
+		m.recycleBinPage, cmd = m.recycleBinPage.Update(msg)
+
+	case PageOSSDeletedObjects:
+		m.ossDeletedPage, cmd = m.ossDeletedPage.Update(msg)
+
+	case PageRedisBackups:
+		m.redisBackupsPage, cmd = m.redisBackupsPage.Update(msg)
+
+	case PageRDSBackups:
+		m.rdsBackupsPage, cmd = m.rdsBackupsPage.Update(msg)
+
+	case PageRDSBinlogs:
+		m.rdsBinlogsPage, cmd = m.rdsBinlogsPage.Update(msg)
+
+	case PageRDSSlowLog:
+		m.rdsSlowLogPage, cmd = m.rdsSlowLogPage.Update(msg)
+
+	case PageChangelog:
+		m.changelogPage, cmd = m.changelogPage.Update(msg)
+
+	case PageRecent:
+		m.recentPage, cmd = m.recentPage.Update(msg)
+
+	case PageUsage:
+		m.usagePage, cmd = m.usagePage.Update(msg)
+
+	case PageDNSImport:
+		m.dnsImportPage, cmd = m.dnsImportPage.Update(msg)
+	case PageAPITrace:
+		m.apiTracePage, cmd = m.apiTracePage.Update(msg)
 	}
 
 	return m, cmd
@@ -1234,6 +3690,8 @@ func (m Model) updateCurrentPageSize(height int) Model {
 		m.ossObjectsPage = m.ossObjectsPage.SetSize(m.width, height)
 	case PageOSSObjectDetail:
 		m.ossDetailPage = m.ossDetailPage.SetSize(m.width, height)
+	case PageOSSObjectPreview:
+		m.ossPreviewPage = m.ossPreviewPage.SetSize(m.width, height)
 	case PageRDSList:
 		m.rdsListPage = m.rdsListPage.SetSize(m.width, height)
 	case PageRDSDetail:
@@ -1258,10 +3716,213 @@ func (m Model) updateCurrentPageSize(height int) Model {
 		m.rocketmqGroupsPage = m.rocketmqGroupsPage.SetSize(m.width, height)
 	case PageResourceFinder:
 		m.finderPage = m.finderPage.SetSize(m.width, height)
+	case PageJobs:
+		m.jobsPage = m.jobsPage.SetSize(m.width, height)
+	case PageRAMUsers:
+		m.ramUsersPage = m.ramUsersPage.SetSize(m.width, height)
+	case PageRAMRoles:
+		m.ramRolesPage = m.ramRolesPage.SetSize(m.width, height)
+	case PageRAMPolicies:
+		m.ramPoliciesPage = m.ramPoliciesPage.SetSize(m.width, height)
+	case PageRAMUserPolicies:
+		m.ramUserPoliciesPage = m.ramUserPoliciesPage.SetSize(m.width, height)
+	case PageRAMDetail:
+		m.ramDetailPage = m.ramDetailPage.SetSize(m.width, height)
+	case PageECSMetrics:
+		m.ecsMetricsPage = m.ecsMetricsPage.SetSize(m.width, height)
+	case PageRDSMetrics:
+		m.rdsMetricsPage = m.rdsMetricsPage.SetSize(m.width, height)
+	case PageSLSProjects:
+		m.slsProjectsPage = m.slsProjectsPage.SetSize(m.width, height)
+	case PageSLSLogtailConfigs:
+		m.slsConfigsPage = m.slsConfigsPage.SetSize(m.width, height)
+	case PageSLSMachineGroups:
+		m.slsGroupsPage = m.slsGroupsPage.SetSize(m.width, height)
+	case PageSLSMachines:
+		m.slsMachinesPage = m.slsMachinesPage.SetSize(m.width, height)
+	case PageSLSCoverage:
+		m.slsCoveragePage = m.slsCoveragePage.SetSize(m.width, height)
+	case PageSLSDetail:
+		m.slsDetailPage = m.slsDetailPage.SetSize(m.width, height)
+	case PageConfigRules:
+		m.configRulesPage = m.configRulesPage.SetSize(m.width, height)
+	case PageConfigResults:
+		m.configResultsPage = m.configResultsPage.SetSize(m.width, height)
+	case PageConfigDetail:
+		m.configDetailPage = m.configDetailPage.SetSize(m.width, height)
+	case PageTagBrowser:
+		m.tagBrowserPage = m.tagBrowserPage.SetSize(m.width, height)
+	case PageTagResources:
+		m.tagResourcesPage = m.tagResourcesPage.SetSize(m.width, height)
+	case PageTagDetail:
+		m.tagDetailPage = m.tagDetailPage.SetSize(m.width, height)
+	case PageBilling:
+		m.billingPage = m.billingPage.SetSize(m.width, height)
+	case PageBillingDetail:
+		m.billingDetailPage = m.billingDetailPage.SetSize(m.width, height)
+	case PageDNSDangling:
+		m.dnsDanglingPage = m.dnsDanglingPage.SetSize(m.width, height)
+	case PageRAMRolePolicies:
+		m.ramRolePoliciesPage = m.ramRolePoliciesPage.SetSize(m.width, height)
+	case PageNATList:
+		m.natListPage = m.natListPage.SetSize(m.width, height)
+	case PageNATDetail:
+		m.natDetailPage = m.natDetailPage.SetSize(m.width, height)
+	case PageSNATEntries:
+		m.snatEntriesPage = m.snatEntriesPage.SetSize(m.width, height)
+	case PageDNATEntries:
+		m.dnatEntriesPage = m.dnatEntriesPage.SetSize(m.width, height)
+	case PageOSSObjectVersions:
+		m.ossVersionsPage = m.ossVersionsPage.SetSize(m.width, height)
+	case PageALBList:
+		m.albListPage = m.albListPage.SetSize(m.width, height)
+	case PageALBDetail:
+		m.albDetailPage = m.albDetailPage.SetSize(m.width, height)
+	case PageALBListeners:
+		m.albListenersPage = m.albListenersPage.SetSize(m.width, height)
+	case PageALBRules:
+		m.albRulesPage = m.albRulesPage.SetSize(m.width, height)
+	case PageALBRuleDetail:
+		m.albRuleDetailPage = m.albRuleDetailPage.SetSize(m.width, height)
+	case PageALBServerGroups:
+		m.albServerGroupsPage = m.albServerGroupsPage.SetSize(m.width, height)
+	case PageNLBList:
+		m.nlbListPage = m.nlbListPage.SetSize(m.width, height)
+	case PageNLBDetail:
+		m.nlbDetailPage = m.nlbDetailPage.SetSize(m.width, height)
+	case PageNLBListeners:
+		m.nlbListenersPage = m.nlbListenersPage.SetSize(m.width, height)
+	case PageNLBServerGroups:
+		m.nlbServerGroupsPage = m.nlbServerGroupsPage.SetSize(m.width, height)
+	case PageLBServers:
+		m.lbServersPage = m.lbServersPage.SetSize(m.width, height)
+	case PageOSSBucketDetail:
+		m.ossBucketDetailPage = m.ossBucketDetailPage.SetSize(m.width, height)
+	case PageACKClusters:
+		m.ackClustersPage = m.ackClustersPage.SetSize(m.width, height)
+	case PageACKClusterDetail:
+		m.ackClusterDetailPage = m.ackClusterDetailPage.SetSize(m.width, height)
+	case PageACKNodePools:
+		m.ackNodePoolsPage = m.ackNodePoolsPage.SetSize(m.width, height)
+	case PageACKNodes:
+		m.ackNodesPage = m.ackNodesPage.SetSize(m.width, height)
+	case PageOSSObjectScan:
+		m.ossScanPage = m.ossScanPage.SetSize(m.width, height)
+	case PageACRInstances:
+		m.acrInstancesPage = m.acrInstancesPage.SetSize(m.width, height)
+	case PageACRNamespaces:
+		m.acrNamespacesPage = m.acrNamespacesPage.SetSize(m.width, height)
+	case PageACRRepositories:
+		m.acrReposPage = m.acrReposPage.SetSize(m.width, height)
+	case PageACRTags:
+		m.acrTagsPage = m.acrTagsPage.SetSize(m.width, height)
+	case PageACRTagDetail:
+		m.acrTagDetailPage = m.acrTagDetailPage.SetSize(m.width, height)
+	case PageFCServices:
+		m.fcServicesPage = m.fcServicesPage.SetSize(m.width, height)
+	case PageFCFunctions:
+		m.fcFunctionsPage = m.fcFunctionsPage.SetSize(m.width, height)
+	case PageFCFunctionDetail:
+		m.fcDetailPage = m.fcDetailPage.SetSize(m.width, height)
+	case PageSLBJSONDetail:
+		m.slbJSONDetailPage = m.slbJSONDetailPage.SetSize(m.width, height)
+	case PageMongoDBList:
+		m.mongoListPage = m.mongoListPage.SetSize(m.width, height)
+	case PageMongoDBDetail:
+		m.mongoDetailPage = m.mongoDetailPage.SetSize(m.width, height)
+	case PageMongoDBJSONDetail:
+		m.mongoJSONPage = m.mongoJSONPage.SetSize(m.width, height)
+	case PageMongoDBAccounts:
+		m.mongoAccountsPage = m.mongoAccountsPage.SetSize(m.width, height)
+	case PageRDSJSONDetail:
+		m.rdsJSONPage = m.rdsJSONPage.SetSize(m.width, height)
+	case PageRedisJSONDetail:
+		m.redisJSONPage = m.redisJSONPage.SetSize(m.width, height)
+	case PageRocketMQJSONDetail:
+		m.rocketmqJSONPage = m.rocketmqJSONPage.SetSize(m.width, height)
+	case PageElasticsearchList:
+		m.esListPage = m.esListPage.SetSize(m.width, height)
+	case PageElasticsearchDetail:
+		m.esDetailPage = m.esDetailPage.SetSize(m.width, height)
+	case PageElasticsearchJSONDetail:
+		m.esJSONPage = m.esJSONPage.SetSize(m.width, height)
+	case PageZoneCapacity:
+		m.zoneCapacityPage = m.zoneCapacityPage.SetSize(m.width, height)
+	case PageKafkaList:
+		m.kafkaListPage = m.kafkaListPage.SetSize(m.width, height)
+	case PageKafkaDetail:
+		m.kafkaDetailPage = m.kafkaDetailPage.SetSize(m.width, height)
+	case PageKafkaJSONDetail:
+		m.kafkaJSONPage = m.kafkaJSONPage.SetSize(m.width, height)
+	case PageKafkaTopics:
+		m.kafkaTopicsPage = m.kafkaTopicsPage.SetSize(m.width, height)
+	case PageKafkaGroups:
+		m.kafkaGroupsPage = m.kafkaGroupsPage.SetSize(m.width, height)
+	case PageRocketMQLag:
+		m.rocketmqLagPage = m.rocketmqLagPage.SetSize(m.width, height)
+	case PageRocketMQMessages:
+		m.rocketmqMessagesPage = m.rocketmqMessagesPage.SetSize(m.width, height)
+	case PageRedisParameters:
+		m.redisParamsPage = m.redisParamsPage.SetSize(m.width, height)
+	case PageRedisMetrics:
+		m.redisMetricsPage = m.redisMetricsPage.SetSize(m.width, height)
+	case PageRecycleBin:
+		m.recycleBinPage = m.recycleBinPage.SetSize(m.width, height)
+	case PageOSSDeletedObjects:
+		m.ossDeletedPage = m.ossDeletedPage.SetSize(m.width, height)
+	case PageRedisBackups:
+		m.redisBackupsPage = m.redisBackupsPage.SetSize(m.width, height)
+	case PageRDSBackups:
+		m.rdsBackupsPage = m.rdsBackupsPage.SetSize(m.width, height)
+	case PageRDSBinlogs:
+		m.rdsBinlogsPage = m.rdsBinlogsPage.SetSize(m.width, height)
+	case PageRDSSlowLog:
+		m.rdsSlowLogPage = m.rdsSlowLogPage.SetSize(m.width, height)
+	case PageChangelog:
+		m.changelogPage = m.changelogPage.SetSize(m.width, height)
+	case PageRecent:
+		m.recentPage = m.recentPage.SetSize(m.width, height)
+	case PageUsage:
+		m.usagePage = m.usagePage.SetSize(m.width, height)
+	case PageDNSImport:
+		m.dnsImportPage = m.dnsImportPage.SetSize(m.width, height)
+	case PageAPITrace:
+		m.apiTracePage = m.apiTracePage.SetSize(m.width, height)
 	}
 	return m
 }
 
+// switchRegion points clients and services at region, clears the cached
+// pages and returns to the menu
+func (m Model) switchRegion(region string) (Model, error) {
+	// Recreate clients with new region using UpdateRegion
+	newClients, err := m.clients.UpdateRegion(region)
+	if err != nil {
+		return m, err
+	}
+
+	// Clear cached data first
+	m = m.clearCachedData()
+
+	// Update region AFTER clearing cache
+	m.region = region
+	m.allRegions = false
+	m.header = m.header.SetRegion(region).SetTitle(i18n.T(i18n.KeyAppTitle))
+	m.modeLine = m.modeLine.SetRegion(region)
+
+	// Update clients and recreate services
+	m.clients = newClients
+	m.services = buildServices(newClients)
+	m.finderService = newFinderService(m.services)
+
+	// Set page state
+	m.currentPage = PageMenu
+	m.leaveAllPages()
+	m.previousPages = []PageType{}
+	m.hasAlternate = false
+	return m, nil
+}
+
 // clearCachedData resets all page models
 func (m Model) clearCachedData() Model {
 	m.ecsListPage = pages.NewECSListModel()
@@ -1271,7 +3932,14 @@ func (m Model) clearCachedData() Model {
 	m.ossBucketsPage = pages.NewOSSBucketsModel()
 	m.rdsListPage = pages.NewRDSListModel()
 	m.redisListPage = pages.NewRedisListModel()
+	m.mongoListPage = pages.NewMongoDBListModel()
+	m.esListPage = pages.NewElasticsearchListModel()
+	m.kafkaListPage = pages.NewKafkaListModel()
 	m.rocketmqListPage = pages.NewRocketMQListModel()
+	m.dnsTargets = nil
+	m.workspacePages = nil
+	m.resumeResource = nil
+	m.emptyState = nil
 	return m
 }
 
@@ -1281,6 +3949,12 @@ func (m Model) handleSearchQuery(query string) (Model, tea.Cmd) {
 		return m, nil
 	}
 
+	// A leading & filters rows instead of highlighting them, as in less
+	if strings.HasPrefix(query, "&") && m.isFilterablePage() {
+		m.search = m.search.SetQuery("")
+		return m.handleFilterQuery(strings.TrimPrefix(query, "&"))
+	}
+
 	// Route search to the current page's table or viewport
 	switch m.currentPage {
 	case PageECSList:
@@ -1325,6 +3999,8 @@ func (m Model) handleSearchQuery(query string) (Model, tea.Cmd) {
 		m.ossObjectsPage = m.ossObjectsPage.Search(query)
 	case PageOSSObjectDetail:
 		m.ossDetailPage = m.ossDetailPage.Search(query)
+	case PageOSSObjectPreview:
+		m.ossPreviewPage = m.ossPreviewPage.Search(query)
 	case PageRDSList:
 		m.rdsListPage = m.rdsListPage.Search(query)
 	case PageRDSDetail:
@@ -1349,6 +4025,360 @@ func (m Model) handleSearchQuery(query string) (Model, tea.Cmd) {
 		m.rocketmqGroupsPage = m.rocketmqGroupsPage.Search(query)
 	case PageResourceFinder:
 		m.finderPage = m.finderPage.Search(query)
+	case PageJobs:
+		m.jobsPage = m.jobsPage.Search(query)
+	case PageRAMUsers:
+		m.ramUsersPage = m.ramUsersPage.Search(query)
+	case PageRAMRoles:
+		m.ramRolesPage = m.ramRolesPage.Search(query)
+	case PageRAMPolicies:
+		m.ramPoliciesPage = m.ramPoliciesPage.Search(query)
+	case PageRAMUserPolicies:
+		m.ramUserPoliciesPage = m.ramUserPoliciesPage.Search(query)
+	case PageRAMDetail:
+		m.ramDetailPage = m.ramDetailPage.Search(query)
+	case PageSLSProjects:
+		m.slsProjectsPage = m.slsProjectsPage.Search(query)
+	case PageSLSLogtailConfigs:
+		m.slsConfigsPage = m.slsConfigsPage.Search(query)
+	case PageSLSMachineGroups:
+		m.slsGroupsPage = m.slsGroupsPage.Search(query)
+	case PageSLSMachines:
+		m.slsMachinesPage = m.slsMachinesPage.Search(query)
+	case PageSLSCoverage:
+		m.slsCoveragePage = m.slsCoveragePage.Search(query)
+	case PageSLSDetail:
+		m.slsDetailPage = m.slsDetailPage.Search(query)
+	case PageConfigRules:
+		m.configRulesPage = m.configRulesPage.Search(query)
+	case PageConfigResults:
+		m.configResultsPage = m.configResultsPage.Search(query)
+	case PageConfigDetail:
+		m.configDetailPage = m.configDetailPage.Search(query)
+	case PageTagBrowser:
+		m.tagBrowserPage = m.tagBrowserPage.Search(query)
+	case PageTagResources:
+		m.tagResourcesPage = m.tagResourcesPage.Search(query)
+	case PageTagDetail:
+		m.tagDetailPage = m.tagDetailPage.Search(query)
+	case PageBilling:
+		m.billingPage = m.billingPage.Search(query)
+	case PageBillingDetail:
+		m.billingDetailPage = m.billingDetailPage.Search(query)
+	case PageDNSDangling:
+		m.dnsDanglingPage = m.dnsDanglingPage.Search(query)
+	case PageRAMRolePolicies:
+		m.ramRolePoliciesPage = m.ramRolePoliciesPage.Search(query)
+	case PageNATList:
+		m.natListPage = m.natListPage.Search(query)
+	case PageNATDetail:
+		m.natDetailPage = m.natDetailPage.Search(query)
+	case PageSNATEntries:
+		m.snatEntriesPage = m.snatEntriesPage.Search(query)
+	case PageDNATEntries:
+		m.dnatEntriesPage = m.dnatEntriesPage.Search(query)
+	case PageOSSObjectVersions:
+		m.ossVersionsPage = m.ossVersionsPage.Search(query)
+	case PageALBList:
+		m.albListPage = m.albListPage.Search(query)
+	case PageALBDetail:
+		m.albDetailPage = m.albDetailPage.Search(query)
+	case PageALBListeners:
+		m.albListenersPage = m.albListenersPage.Search(query)
+	case PageALBRules:
+		m.albRulesPage = m.albRulesPage.Search(query)
+	case PageALBRuleDetail:
+		m.albRuleDetailPage = m.albRuleDetailPage.Search(query)
+	case PageALBServerGroups:
+		m.albServerGroupsPage = m.albServerGroupsPage.Search(query)
+	case PageNLBList:
+		m.nlbListPage = m.nlbListPage.Search(query)
+	case PageNLBDetail:
+		m.nlbDetailPage = m.nlbDetailPage.Search(query)
+	case PageNLBListeners:
+		m.nlbListenersPage = m.nlbListenersPage.Search(query)
+	case PageNLBServerGroups:
+		m.nlbServerGroupsPage = m.nlbServerGroupsPage.Search(query)
+	case PageLBServers:
+		m.lbServersPage = m.lbServersPage.Search(query)
+	case PageOSSBucketDetail:
+		m.ossBucketDetailPage = m.ossBucketDetailPage.Search(query)
+	case PageACKClusters:
+		m.ackClustersPage = m.ackClustersPage.Search(query)
+	case PageACKClusterDetail:
+		m.ackClusterDetailPage = m.ackClusterDetailPage.Search(query)
+	case PageACKNodePools:
+		m.ackNodePoolsPage = m.ackNodePoolsPage.Search(query)
+	case PageACKNodes:
+		m.ackNodesPage = m.ackNodesPage.Search(query)
+	case PageOSSObjectScan:
+		m.ossScanPage = m.ossScanPage.Search(query)
+	case PageACRInstances:
+		m.acrInstancesPage = m.acrInstancesPage.Search(query)
+	case PageACRNamespaces:
+		m.acrNamespacesPage = m.acrNamespacesPage.Search(query)
+	case PageACRRepositories:
+		m.acrReposPage = m.acrReposPage.Search(query)
+	case PageACRTags:
+		m.acrTagsPage = m.acrTagsPage.Search(query)
+	case PageACRTagDetail:
+		m.acrTagDetailPage = m.acrTagDetailPage.Search(query)
+	case PageFCServices:
+		m.fcServicesPage = m.fcServicesPage.Search(query)
+	case PageFCFunctions:
+		m.fcFunctionsPage = m.fcFunctionsPage.Search(query)
+	case PageFCFunctionDetail:
+		m.fcDetailPage = m.fcDetailPage.Search(query)
+	case PageSLBJSONDetail:
+		m.slbJSONDetailPage = m.slbJSONDetailPage.Search(query)
+	case PageMongoDBList:
+		m.mongoListPage = m.mongoListPage.Search(query)
+	case PageMongoDBDetail:
+		m.mongoDetailPage = m.mongoDetailPage.Search(query)
+	case PageMongoDBJSONDetail:
+		m.mongoJSONPage = m.mongoJSONPage.Search(query)
+	case PageMongoDBAccounts:
+		m.mongoAccountsPage = m.mongoAccountsPage.Search(query)
+	case PageRDSJSONDetail:
+		m.rdsJSONPage = m.rdsJSONPage.Search(query)
+	case PageRedisJSONDetail:
+		m.redisJSONPage = m.redisJSONPage.Search(query)
+	case PageRocketMQJSONDetail:
+		m.rocketmqJSONPage = m.rocketmqJSONPage.Search(query)
+	case PageElasticsearchList:
+		m.esListPage = m.esListPage.Search(query)
+	case PageElasticsearchDetail:
+		m.esDetailPage = m.esDetailPage.Search(query)
+	case PageElasticsearchJSONDetail:
+		m.esJSONPage = m.esJSONPage.Search(query)
+	case PageZoneCapacity:
+		m.zoneCapacityPage = m.zoneCapacityPage.Search(query)
+	case PageKafkaList:
+		m.kafkaListPage = m.kafkaListPage.Search(query)
+	case PageKafkaDetail:
+		m.kafkaDetailPage = m.kafkaDetailPage.Search(query)
+	case PageKafkaJSONDetail:
+		m.kafkaJSONPage = m.kafkaJSONPage.Search(query)
+	case PageKafkaTopics:
+		m.kafkaTopicsPage = m.kafkaTopicsPage.Search(query)
+	case PageKafkaGroups:
+		m.kafkaGroupsPage = m.kafkaGroupsPage.Search(query)
+	case PageRocketMQLag:
+		m.rocketmqLagPage = m.rocketmqLagPage.Search(query)
+	case PageRocketMQMessages:
+		m.rocketmqMessagesPage = m.rocketmqMessagesPage.Search(query)
+	case PageRedisParameters:
+		m.redisParamsPage = m.redisParamsPage.Search(query)
+	case PageRecycleBin:
+		m.recycleBinPage = m.recycleBinPage.Search(query)
+	case PageOSSDeletedObjects:
+		m.ossDeletedPage = m.ossDeletedPage.Search(query)
+	case PageRedisBackups:
+		m.redisBackupsPage = m.redisBackupsPage.Search(query)
+	case PageRDSBackups:
+		m.rdsBackupsPage = m.rdsBackupsPage.Search(query)
+	case PageRDSBinlogs:
+		m.rdsBinlogsPage = m.rdsBinlogsPage.Search(query)
+	case PageRDSSlowLog:
+		m.rdsSlowLogPage = m.rdsSlowLogPage.Search(query)
+	case PageChangelog:
+		m.changelogPage = m.changelogPage.Search(query)
+	case PageRecent:
+		m.recentPage = m.recentPage.Search(query)
+	case PageUsage:
+		m.usagePage = m.usagePage.Search(query)
+	case PageDNSImport:
+		m.dnsImportPage = m.dnsImportPage.Search(query)
+	case PageAPITrace:
+		m.apiTracePage = m.apiTracePage.Search(query)
+	}
+
+	return m, nil
+}
+
+// isFilterablePage reports whether the current page shows a table that can be filtered
+func (m Model) isFilterablePage() bool {
+	switch m.currentPage {
+	case PageECSList, PageECSDisks, PageECSNetworkInterfaces, PageSecurityGroups, PageSecurityGroupRules, PageSecurityGroupInstances, PageInstanceSecurityGroups, PageDNSDomains, PageDNSRecords, PageSLBList, PageSLBListeners, PageSLBVServerGroups, PageSLBBackendServers, PageSLBForwardingRules, PageSLBDefaultServers, PageOSSBuckets, PageOSSObjects, PageRDSList, PageRDSDatabases, PageRDSAccounts, PageRedisList, PageRedisAccounts, PageRocketMQList, PageRocketMQTopics, PageRocketMQGroups, PageJobs, PageRAMUsers, PageRAMRoles, PageRAMPolicies, PageRAMUserPolicies, PageSLSProjects, PageSLSLogtailConfigs, PageSLSMachineGroups, PageSLSMachines, PageSLSCoverage, PageConfigRules, PageConfigResults, PageTagBrowser, PageTagResources, PageBilling, PageDNSDangling, PageRAMRolePolicies, PageNATList, PageSNATEntries, PageDNATEntries, PageOSSObjectVersions, PageALBList, PageALBListeners, PageALBRules, PageALBServerGroups, PageNLBList, PageNLBListeners, PageNLBServerGroups, PageLBServers, PageACKClusters, PageACKNodePools, PageACKNodes, PageOSSObjectScan, PageACRInstances, PageACRNamespaces, PageACRRepositories, PageACRTags, PageFCServices, PageFCFunctions, PageMongoDBList, PageMongoDBAccounts, PageElasticsearchList, PageZoneCapacity, PageKafkaList, PageKafkaTopics, PageKafkaGroups, PageRocketMQLag, PageRocketMQMessages, PageRedisParameters, PageRecycleBin, PageOSSDeletedObjects, PageRedisBackups, PageRDSBackups, PageRDSBinlogs, PageRDSSlowLog, PageChangelog, PageRecent, PageUsage, PageDNSImport, PageAPITrace:
+		return true
+	}
+	return false
+}
+
+// handleFilterQuery applies a row filter to the current page's table. An empty
+// query restores all rows
+func (m Model) handleFilterQuery(query string) (Model, tea.Cmd) {
+	switch m.currentPage {
+	case PageECSList:
+		m.ecsListPage = m.ecsListPage.Filter(query)
+	case PageECSDisks:
+		m.ecsDiskPage = m.ecsDiskPage.Filter(query)
+	case PageECSNetworkInterfaces:
+		m.ecsENIPage = m.ecsENIPage.Filter(query)
+	case PageSecurityGroups:
+		m.sgListPage = m.sgListPage.Filter(query)
+	case PageSecurityGroupRules:
+		m.sgRulesPage = m.sgRulesPage.Filter(query)
+	case PageSecurityGroupInstances:
+		m.sgInstancesPage = m.sgInstancesPage.Filter(query)
+	case PageInstanceSecurityGroups:
+		m.instSGPage = m.instSGPage.Filter(query)
+	case PageDNSDomains:
+		m.dnsDomainsPage = m.dnsDomainsPage.Filter(query)
+	case PageDNSRecords:
+		m.dnsRecordsPage = m.dnsRecordsPage.Filter(query)
+	case PageSLBList:
+		m.slbListPage = m.slbListPage.Filter(query)
+	case PageSLBListeners:
+		m.slbListenersPage = m.slbListenersPage.Filter(query)
+	case PageSLBVServerGroups:
+		m.slbVServerPage = m.slbVServerPage.Filter(query)
+	case PageSLBBackendServers:
+		m.slbBackendPage = m.slbBackendPage.Filter(query)
+	case PageSLBForwardingRules:
+		m.slbForwardingRulesPage = m.slbForwardingRulesPage.Filter(query)
+	case PageSLBDefaultServers:
+		m.slbDefaultServersPage = m.slbDefaultServersPage.Filter(query)
+	case PageOSSBuckets:
+		m.ossBucketsPage = m.ossBucketsPage.Filter(query)
+	case PageOSSObjects:
+		m.ossObjectsPage = m.ossObjectsPage.Filter(query)
+	case PageRDSList:
+		m.rdsListPage = m.rdsListPage.Filter(query)
+	case PageRDSDatabases:
+		m.rdsDatabasesPage = m.rdsDatabasesPage.Filter(query)
+	case PageRDSAccounts:
+		m.rdsAccountsPage = m.rdsAccountsPage.Filter(query)
+	case PageRedisList:
+		m.redisListPage = m.redisListPage.Filter(query)
+	case PageRedisAccounts:
+		m.redisAccountsPage = m.redisAccountsPage.Filter(query)
+	case PageRocketMQList:
+		m.rocketmqListPage = m.rocketmqListPage.Filter(query)
+	case PageRocketMQTopics:
+		m.rocketmqTopicsPage = m.rocketmqTopicsPage.Filter(query)
+	case PageRocketMQGroups:
+		m.rocketmqGroupsPage = m.rocketmqGroupsPage.Filter(query)
+	case PageJobs:
+		m.jobsPage = m.jobsPage.Filter(query)
+	case PageRAMUsers:
+		m.ramUsersPage = m.ramUsersPage.Filter(query)
+	case PageRAMRoles:
+		m.ramRolesPage = m.ramRolesPage.Filter(query)
+	case PageRAMPolicies:
+		m.ramPoliciesPage = m.ramPoliciesPage.Filter(query)
+	case PageRAMUserPolicies:
+		m.ramUserPoliciesPage = m.ramUserPoliciesPage.Filter(query)
+	case PageSLSProjects:
+		m.slsProjectsPage = m.slsProjectsPage.Filter(query)
+	case PageSLSLogtailConfigs:
+		m.slsConfigsPage = m.slsConfigsPage.Filter(query)
+	case PageSLSMachineGroups:
+		m.slsGroupsPage = m.slsGroupsPage.Filter(query)
+	case PageSLSMachines:
+		m.slsMachinesPage = m.slsMachinesPage.Filter(query)
+	case PageSLSCoverage:
+		m.slsCoveragePage = m.slsCoveragePage.Filter(query)
+	case PageConfigRules:
+		m.configRulesPage = m.configRulesPage.Filter(query)
+	case PageConfigResults:
+		m.configResultsPage = m.configResultsPage.Filter(query)
+	case PageTagBrowser:
+		m.tagBrowserPage = m.tagBrowserPage.Filter(query)
+	case PageTagResources:
+		m.tagResourcesPage = m.tagResourcesPage.Filter(query)
+	case PageBilling:
+		m.billingPage = m.billingPage.Filter(query)
+	case PageDNSDangling:
+		m.dnsDanglingPage = m.dnsDanglingPage.Filter(query)
+	case PageRAMRolePolicies:
+		m.ramRolePoliciesPage = m.ramRolePoliciesPage.Filter(query)
+	case PageNATList:
+		m.natListPage = m.natListPage.Filter(query)
+	case PageSNATEntries:
+		m.snatEntriesPage = m.snatEntriesPage.Filter(query)
+	case PageDNATEntries:
+		m.dnatEntriesPage = m.dnatEntriesPage.Filter(query)
+	case PageOSSObjectVersions:
+		m.ossVersionsPage = m.ossVersionsPage.Filter(query)
+	case PageALBList:
+		m.albListPage = m.albListPage.Filter(query)
+	case PageALBListeners:
+		m.albListenersPage = m.albListenersPage.Filter(query)
+	case PageALBRules:
+		m.albRulesPage = m.albRulesPage.Filter(query)
+	case PageALBServerGroups:
+		m.albServerGroupsPage = m.albServerGroupsPage.Filter(query)
+	case PageNLBList:
+		m.nlbListPage = m.nlbListPage.Filter(query)
+	case PageNLBListeners:
+		m.nlbListenersPage = m.nlbListenersPage.Filter(query)
+	case PageNLBServerGroups:
+		m.nlbServerGroupsPage = m.nlbServerGroupsPage.Filter(query)
+	case PageLBServers:
+		m.lbServersPage = m.lbServersPage.Filter(query)
+	case PageACKClusters:
+		m.ackClustersPage = m.ackClustersPage.Filter(query)
+	case PageACKNodePools:
+		m.ackNodePoolsPage = m.ackNodePoolsPage.Filter(query)
+	case PageACKNodes:
+		m.ackNodesPage = m.ackNodesPage.Filter(query)
+	case PageOSSObjectScan:
+		m.ossScanPage = m.ossScanPage.Filter(query)
+	case PageACRInstances:
+		m.acrInstancesPage = m.acrInstancesPage.Filter(query)
+	case PageACRNamespaces:
+		m.acrNamespacesPage = m.acrNamespacesPage.Filter(query)
+	case PageACRRepositories:
+		m.acrReposPage = m.acrReposPage.Filter(query)
+	case PageACRTags:
+		m.acrTagsPage = m.acrTagsPage.Filter(query)
+	case PageFCServices:
+		m.fcServicesPage = m.fcServicesPage.Filter(query)
+	case PageFCFunctions:
+		m.fcFunctionsPage = m.fcFunctionsPage.Filter(query)
+	case PageMongoDBList:
+		m.mongoListPage = m.mongoListPage.Filter(query)
+	case PageMongoDBAccounts:
+		m.mongoAccountsPage = m.mongoAccountsPage.Filter(query)
+	case PageElasticsearchList:
+		m.esListPage = m.esListPage.Filter(query)
+	case PageZoneCapacity:
+		m.zoneCapacityPage = m.zoneCapacityPage.Filter(query)
+	case PageKafkaList:
+		m.kafkaListPage = m.kafkaListPage.Filter(query)
+	case PageKafkaTopics:
+		m.kafkaTopicsPage = m.kafkaTopicsPage.Filter(query)
+	case PageKafkaGroups:
+		m.kafkaGroupsPage = m.kafkaGroupsPage.Filter(query)
+	case PageRocketMQLag:
+		m.rocketmqLagPage = m.rocketmqLagPage.Filter(query)
+	case PageRocketMQMessages:
+		m.rocketmqMessagesPage = m.rocketmqMessagesPage.Filter(query)
+	case PageRedisParameters:
+		m.redisParamsPage = m.redisParamsPage.Filter(query)
+	case PageRecycleBin:
+		m.recycleBinPage = m.recycleBinPage.Filter(query)
+	case PageOSSDeletedObjects:
+		m.ossDeletedPage = m.ossDeletedPage.Filter(query)
+	case PageRedisBackups:
+		m.redisBackupsPage = m.redisBackupsPage.Filter(query)
+	case PageRDSBackups:
+		m.rdsBackupsPage = m.rdsBackupsPage.Filter(query)
+	case PageRDSBinlogs:
+		m.rdsBinlogsPage = m.rdsBinlogsPage.Filter(query)
+	case PageRDSSlowLog:
+		m.rdsSlowLogPage = m.rdsSlowLogPage.Filter(query)
+	case PageChangelog:
+		m.changelogPage = m.changelogPage.Filter(query)
+	case PageRecent:
+		m.recentPage = m.recentPage.Filter(query)
+	case PageUsage:
+		m.usagePage = m.usagePage.Filter(query)
+	case PageDNSImport:
+		m.dnsImportPage = m.dnsImportPage.Filter(query)
+	case PageAPITrace:
+		m.apiTracePage = m.apiTracePage.Filter(query)
 	}
 
 	return m, nil
@@ -1399,6 +4429,8 @@ func (m Model) handleSearchNext() (Model, tea.Cmd) {
 		m.ossObjectsPage = m.ossObjectsPage.NextSearchMatch()
 	case PageOSSObjectDetail:
 		m.ossDetailPage = m.ossDetailPage.NextSearchMatch()
+	case PageOSSObjectPreview:
+		m.ossPreviewPage = m.ossPreviewPage.NextSearchMatch()
 	case PageRDSList:
 		m.rdsListPage = m.rdsListPage.NextSearchMatch()
 	case PageRDSDetail:
@@ -1423,6 +4455,172 @@ func (m Model) handleSearchNext() (Model, tea.Cmd) {
 		m.rocketmqGroupsPage = m.rocketmqGroupsPage.NextSearchMatch()
 	case PageResourceFinder:
 		m.finderPage = m.finderPage.NextSearchMatch()
+	case PageJobs:
+		m.jobsPage = m.jobsPage.NextSearchMatch()
+	case PageRAMUsers:
+		m.ramUsersPage = m.ramUsersPage.NextSearchMatch()
+	case PageRAMRoles:
+		m.ramRolesPage = m.ramRolesPage.NextSearchMatch()
+	case PageRAMPolicies:
+		m.ramPoliciesPage = m.ramPoliciesPage.NextSearchMatch()
+	case PageRAMUserPolicies:
+		m.ramUserPoliciesPage = m.ramUserPoliciesPage.NextSearchMatch()
+	case PageRAMDetail:
+		m.ramDetailPage = m.ramDetailPage.NextSearchMatch()
+	case PageSLSProjects:
+		m.slsProjectsPage = m.slsProjectsPage.NextSearchMatch()
+	case PageSLSLogtailConfigs:
+		m.slsConfigsPage = m.slsConfigsPage.NextSearchMatch()
+	case PageSLSMachineGroups:
+		m.slsGroupsPage = m.slsGroupsPage.NextSearchMatch()
+	case PageSLSMachines:
+		m.slsMachinesPage = m.slsMachinesPage.NextSearchMatch()
+	case PageSLSCoverage:
+		m.slsCoveragePage = m.slsCoveragePage.NextSearchMatch()
+	case PageSLSDetail:
+		m.slsDetailPage = m.slsDetailPage.NextSearchMatch()
+	case PageConfigRules:
+		m.configRulesPage = m.configRulesPage.NextSearchMatch()
+	case PageConfigResults:
+		m.configResultsPage = m.configResultsPage.NextSearchMatch()
+	case PageConfigDetail:
+		m.configDetailPage = m.configDetailPage.NextSearchMatch()
+	case PageTagBrowser:
+		m.tagBrowserPage = m.tagBrowserPage.NextSearchMatch()
+	case PageTagResources:
+		m.tagResourcesPage = m.tagResourcesPage.NextSearchMatch()
+	case PageTagDetail:
+		m.tagDetailPage = m.tagDetailPage.NextSearchMatch()
+	case PageBilling:
+		m.billingPage = m.billingPage.NextSearchMatch()
+	case PageBillingDetail:
+		m.billingDetailPage = m.billingDetailPage.NextSearchMatch()
+	case PageDNSDangling:
+		m.dnsDanglingPage = m.dnsDanglingPage.NextSearchMatch()
+	case PageRAMRolePolicies:
+		m.ramRolePoliciesPage = m.ramRolePoliciesPage.NextSearchMatch()
+	case PageNATList:
+		m.natListPage = m.natListPage.NextSearchMatch()
+	case PageNATDetail:
+		m.natDetailPage = m.natDetailPage.NextSearchMatch()
+	case PageSNATEntries:
+		m.snatEntriesPage = m.snatEntriesPage.NextSearchMatch()
+	case PageDNATEntries:
+		m.dnatEntriesPage = m.dnatEntriesPage.NextSearchMatch()
+	case PageOSSObjectVersions:
+		m.ossVersionsPage = m.ossVersionsPage.NextSearchMatch()
+	case PageALBList:
+		m.albListPage = m.albListPage.NextSearchMatch()
+	case PageALBDetail:
+		m.albDetailPage = m.albDetailPage.NextSearchMatch()
+	case PageALBListeners:
+		m.albListenersPage = m.albListenersPage.NextSearchMatch()
+	case PageALBRules:
+		m.albRulesPage = m.albRulesPage.NextSearchMatch()
+	case PageALBRuleDetail:
+		m.albRuleDetailPage = m.albRuleDetailPage.NextSearchMatch()
+	case PageALBServerGroups:
+		m.albServerGroupsPage = m.albServerGroupsPage.NextSearchMatch()
+	case PageNLBList:
+		m.nlbListPage = m.nlbListPage.NextSearchMatch()
+	case PageNLBDetail:
+		m.nlbDetailPage = m.nlbDetailPage.NextSearchMatch()
+	case PageNLBListeners:
+		m.nlbListenersPage = m.nlbListenersPage.NextSearchMatch()
+	case PageNLBServerGroups:
+		m.nlbServerGroupsPage = m.nlbServerGroupsPage.NextSearchMatch()
+	case PageLBServers:
+		m.lbServersPage = m.lbServersPage.NextSearchMatch()
+	case PageOSSBucketDetail:
+		m.ossBucketDetailPage = m.ossBucketDetailPage.NextSearchMatch()
+	case PageACKClusters:
+		m.ackClustersPage = m.ackClustersPage.NextSearchMatch()
+	case PageACKClusterDetail:
+		m.ackClusterDetailPage = m.ackClusterDetailPage.NextSearchMatch()
+	case PageACKNodePools:
+		m.ackNodePoolsPage = m.ackNodePoolsPage.NextSearchMatch()
+	case PageACKNodes:
+		m.ackNodesPage = m.ackNodesPage.NextSearchMatch()
+	case PageOSSObjectScan:
+		m.ossScanPage = m.ossScanPage.NextSearchMatch()
+	case PageACRInstances:
+		m.acrInstancesPage = m.acrInstancesPage.NextSearchMatch()
+	case PageACRNamespaces:
+		m.acrNamespacesPage = m.acrNamespacesPage.NextSearchMatch()
+	case PageACRRepositories:
+		m.acrReposPage = m.acrReposPage.NextSearchMatch()
+	case PageACRTags:
+		m.acrTagsPage = m.acrTagsPage.NextSearchMatch()
+	case PageACRTagDetail:
+		m.acrTagDetailPage = m.acrTagDetailPage.NextSearchMatch()
+	case PageFCServices:
+		m.fcServicesPage = m.fcServicesPage.NextSearchMatch()
+	case PageFCFunctions:
+		m.fcFunctionsPage = m.fcFunctionsPage.NextSearchMatch()
+	case PageFCFunctionDetail:
+		m.fcDetailPage = m.fcDetailPage.NextSearchMatch()
+	case PageSLBJSONDetail:
+		m.slbJSONDetailPage = m.slbJSONDetailPage.NextSearchMatch()
+	case PageMongoDBList:
+		m.mongoListPage = m.mongoListPage.NextSearchMatch()
+	case PageMongoDBDetail:
+		m.mongoDetailPage = m.mongoDetailPage.NextSearchMatch()
+	case PageMongoDBJSONDetail:
+		m.mongoJSONPage = m.mongoJSONPage.NextSearchMatch()
+	case PageMongoDBAccounts:
+		m.mongoAccountsPage = m.mongoAccountsPage.NextSearchMatch()
+	case PageRDSJSONDetail:
+		m.rdsJSONPage = m.rdsJSONPage.NextSearchMatch()
+	case PageRedisJSONDetail:
+		m.redisJSONPage = m.redisJSONPage.NextSearchMatch()
+	case PageRocketMQJSONDetail:
+		m.rocketmqJSONPage = m.rocketmqJSONPage.NextSearchMatch()
+	case PageElasticsearchList:
+		m.esListPage = m.esListPage.NextSearchMatch()
+	case PageElasticsearchDetail:
+		m.esDetailPage = m.esDetailPage.NextSearchMatch()
+	case PageElasticsearchJSONDetail:
+		m.esJSONPage = m.esJSONPage.NextSearchMatch()
+	case PageZoneCapacity:
+		m.zoneCapacityPage = m.zoneCapacityPage.NextSearchMatch()
+	case PageKafkaList:
+		m.kafkaListPage = m.kafkaListPage.NextSearchMatch()
+	case PageKafkaDetail:
+		m.kafkaDetailPage = m.kafkaDetailPage.NextSearchMatch()
+	case PageKafkaJSONDetail:
+		m.kafkaJSONPage = m.kafkaJSONPage.NextSearchMatch()
+	case PageKafkaTopics:
+		m.kafkaTopicsPage = m.kafkaTopicsPage.NextSearchMatch()
+	case PageKafkaGroups:
+		m.kafkaGroupsPage = m.kafkaGroupsPage.NextSearchMatch()
+	case PageRocketMQLag:
+		m.rocketmqLagPage = m.rocketmqLagPage.NextSearchMatch()
+	case PageRocketMQMessages:
+		m.rocketmqMessagesPage = m.rocketmqMessagesPage.NextSearchMatch()
+	case PageRedisParameters:
+		m.redisParamsPage = m.redisParamsPage.NextSearchMatch()
+	case PageRecycleBin:
+		m.recycleBinPage = m.recycleBinPage.NextSearchMatch()
+	case PageOSSDeletedObjects:
+		m.ossDeletedPage = m.ossDeletedPage.NextSearchMatch()
+	case PageRedisBackups:
+		m.redisBackupsPage = m.redisBackupsPage.NextSearchMatch()
+	case PageRDSBackups:
+		m.rdsBackupsPage = m.rdsBackupsPage.NextSearchMatch()
+	case PageRDSBinlogs:
+		m.rdsBinlogsPage = m.rdsBinlogsPage.NextSearchMatch()
+	case PageRDSSlowLog:
+		m.rdsSlowLogPage = m.rdsSlowLogPage.NextSearchMatch()
+	case PageChangelog:
+		m.changelogPage = m.changelogPage.NextSearchMatch()
+	case PageRecent:
+		m.recentPage = m.recentPage.NextSearchMatch()
+	case PageUsage:
+		m.usagePage = m.usagePage.NextSearchMatch()
+	case PageDNSImport:
+		m.dnsImportPage = m.dnsImportPage.NextSearchMatch()
+	case PageAPITrace:
+		m.apiTracePage = m.apiTracePage.NextSearchMatch()
 	}
 
 	return m, nil
@@ -1473,6 +4671,8 @@ func (m Model) handleSearchPrev() (Model, tea.Cmd) {
 		m.ossObjectsPage = m.ossObjectsPage.PrevSearchMatch()
 	case PageOSSObjectDetail:
 		m.ossDetailPage = m.ossDetailPage.PrevSearchMatch()
+	case PageOSSObjectPreview:
+		m.ossPreviewPage = m.ossPreviewPage.PrevSearchMatch()
 	case PageRDSList:
 		m.rdsListPage = m.rdsListPage.PrevSearchMatch()
 	case PageRDSDetail:
@@ -1497,6 +4697,172 @@ func (m Model) handleSearchPrev() (Model, tea.Cmd) {
 		m.rocketmqGroupsPage = m.rocketmqGroupsPage.PrevSearchMatch()
 	case PageResourceFinder:
 		m.finderPage = m.finderPage.PrevSearchMatch()
+	case PageJobs:
+		m.jobsPage = m.jobsPage.PrevSearchMatch()
+	case PageRAMUsers:
+		m.ramUsersPage = m.ramUsersPage.PrevSearchMatch()
+	case PageRAMRoles:
+		m.ramRolesPage = m.ramRolesPage.PrevSearchMatch()
+	case PageRAMPolicies:
+		m.ramPoliciesPage = m.ramPoliciesPage.PrevSearchMatch()
+	case PageRAMUserPolicies:
+		m.ramUserPoliciesPage = m.ramUserPoliciesPage.PrevSearchMatch()
+	case PageRAMDetail:
+		m.ramDetailPage = m.ramDetailPage.PrevSearchMatch()
+	case PageSLSProjects:
+		m.slsProjectsPage = m.slsProjectsPage.PrevSearchMatch()
+	case PageSLSLogtailConfigs:
+		m.slsConfigsPage = m.slsConfigsPage.PrevSearchMatch()
+	case PageSLSMachineGroups:
+		m.slsGroupsPage = m.slsGroupsPage.PrevSearchMatch()
+	case PageSLSMachines:
+		m.slsMachinesPage = m.slsMachinesPage.PrevSearchMatch()
+	case PageSLSCoverage:
+		m.slsCoveragePage = m.slsCoveragePage.PrevSearchMatch()
+	case PageSLSDetail:
+		m.slsDetailPage = m.slsDetailPage.PrevSearchMatch()
+	case PageConfigRules:
+		m.configRulesPage = m.configRulesPage.PrevSearchMatch()
+	case PageConfigResults:
+		m.configResultsPage = m.configResultsPage.PrevSearchMatch()
+	case PageConfigDetail:
+		m.configDetailPage = m.configDetailPage.PrevSearchMatch()
+	case PageTagBrowser:
+		m.tagBrowserPage = m.tagBrowserPage.PrevSearchMatch()
+	case PageTagResources:
+		m.tagResourcesPage = m.tagResourcesPage.PrevSearchMatch()
+	case PageTagDetail:
+		m.tagDetailPage = m.tagDetailPage.PrevSearchMatch()
+	case PageBilling:
+		m.billingPage = m.billingPage.PrevSearchMatch()
+	case PageBillingDetail:
+		m.billingDetailPage = m.billingDetailPage.PrevSearchMatch()
+	case PageDNSDangling:
+		m.dnsDanglingPage = m.dnsDanglingPage.PrevSearchMatch()
+	case PageRAMRolePolicies:
+		m.ramRolePoliciesPage = m.ramRolePoliciesPage.PrevSearchMatch()
+	case PageNATList:
+		m.natListPage = m.natListPage.PrevSearchMatch()
+	case PageNATDetail:
+		m.natDetailPage = m.natDetailPage.PrevSearchMatch()
+	case PageSNATEntries:
+		m.snatEntriesPage = m.snatEntriesPage.PrevSearchMatch()
+	case PageDNATEntries:
+		m.dnatEntriesPage = m.dnatEntriesPage.PrevSearchMatch()
+	case PageOSSObjectVersions:
+		m.ossVersionsPage = m.ossVersionsPage.PrevSearchMatch()
+	case PageALBList:
+		m.albListPage = m.albListPage.PrevSearchMatch()
+	case PageALBDetail:
+		m.albDetailPage = m.albDetailPage.PrevSearchMatch()
+	case PageALBListeners:
+		m.albListenersPage = m.albListenersPage.PrevSearchMatch()
+	case PageALBRules:
+		m.albRulesPage = m.albRulesPage.PrevSearchMatch()
+	case PageALBRuleDetail:
+		m.albRuleDetailPage = m.albRuleDetailPage.PrevSearchMatch()
+	case PageALBServerGroups:
+		m.albServerGroupsPage = m.albServerGroupsPage.PrevSearchMatch()
+	case PageNLBList:
+		m.nlbListPage = m.nlbListPage.PrevSearchMatch()
+	case PageNLBDetail:
+		m.nlbDetailPage = m.nlbDetailPage.PrevSearchMatch()
+	case PageNLBListeners:
+		m.nlbListenersPage = m.nlbListenersPage.PrevSearchMatch()
+	case PageNLBServerGroups:
+		m.nlbServerGroupsPage = m.nlbServerGroupsPage.PrevSearchMatch()
+	case PageLBServers:
+		m.lbServersPage = m.lbServersPage.PrevSearchMatch()
+	case PageOSSBucketDetail:
+		m.ossBucketDetailPage = m.ossBucketDetailPage.PrevSearchMatch()
+	case PageACKClusters:
+		m.ackClustersPage = m.ackClustersPage.PrevSearchMatch()
+	case PageACKClusterDetail:
+		m.ackClusterDetailPage = m.ackClusterDetailPage.PrevSearchMatch()
+	case PageACKNodePools:
+		m.ackNodePoolsPage = m.ackNodePoolsPage.PrevSearchMatch()
+	case PageACKNodes:
+		m.ackNodesPage = m.ackNodesPage.PrevSearchMatch()
+	case PageOSSObjectScan:
+		m.ossScanPage = m.ossScanPage.PrevSearchMatch()
+	case PageACRInstances:
+		m.acrInstancesPage = m.acrInstancesPage.PrevSearchMatch()
+	case PageACRNamespaces:
+		m.acrNamespacesPage = m.acrNamespacesPage.PrevSearchMatch()
+	case PageACRRepositories:
+		m.acrReposPage = m.acrReposPage.PrevSearchMatch()
+	case PageACRTags:
+		m.acrTagsPage = m.acrTagsPage.PrevSearchMatch()
+	case PageACRTagDetail:
+		m.acrTagDetailPage = m.acrTagDetailPage.PrevSearchMatch()
+	case PageFCServices:
+		m.fcServicesPage = m.fcServicesPage.PrevSearchMatch()
+	case PageFCFunctions:
+		m.fcFunctionsPage = m.fcFunctionsPage.PrevSearchMatch()
+	case PageFCFunctionDetail:
+		m.fcDetailPage = m.fcDetailPage.PrevSearchMatch()
+	case PageSLBJSONDetail:
+		m.slbJSONDetailPage = m.slbJSONDetailPage.PrevSearchMatch()
+	case PageMongoDBList:
+		m.mongoListPage = m.mongoListPage.PrevSearchMatch()
+	case PageMongoDBDetail:
+		m.mongoDetailPage = m.mongoDetailPage.PrevSearchMatch()
+	case PageMongoDBJSONDetail:
+		m.mongoJSONPage = m.mongoJSONPage.PrevSearchMatch()
+	case PageMongoDBAccounts:
+		m.mongoAccountsPage = m.mongoAccountsPage.PrevSearchMatch()
+	case PageRDSJSONDetail:
+		m.rdsJSONPage = m.rdsJSONPage.PrevSearchMatch()
+	case PageRedisJSONDetail:
+		m.redisJSONPage = m.redisJSONPage.PrevSearchMatch()
+	case PageRocketMQJSONDetail:
+		m.rocketmqJSONPage = m.rocketmqJSONPage.PrevSearchMatch()
+	case PageElasticsearchList:
+		m.esListPage = m.esListPage.PrevSearchMatch()
+	case PageElasticsearchDetail:
+		m.esDetailPage = m.esDetailPage.PrevSearchMatch()
+	case PageElasticsearchJSONDetail:
+		m.esJSONPage = m.esJSONPage.PrevSearchMatch()
+	case PageZoneCapacity:
+		m.zoneCapacityPage = m.zoneCapacityPage.PrevSearchMatch()
+	case PageKafkaList:
+		m.kafkaListPage = m.kafkaListPage.PrevSearchMatch()
+	case PageKafkaDetail:
+		m.kafkaDetailPage = m.kafkaDetailPage.PrevSearchMatch()
+	case PageKafkaJSONDetail:
+		m.kafkaJSONPage = m.kafkaJSONPage.PrevSearchMatch()
+	case PageKafkaTopics:
+		m.kafkaTopicsPage = m.kafkaTopicsPage.PrevSearchMatch()
+	case PageKafkaGroups:
+		m.kafkaGroupsPage = m.kafkaGroupsPage.PrevSearchMatch()
+	case PageRocketMQLag:
+		m.rocketmqLagPage = m.rocketmqLagPage.PrevSearchMatch()
+	case PageRocketMQMessages:
+		m.rocketmqMessagesPage = m.rocketmqMessagesPage.PrevSearchMatch()
+	case PageRedisParameters:
+		m.redisParamsPage = m.redisParamsPage.PrevSearchMatch()
+	case PageRecycleBin:
+		m.recycleBinPage = m.recycleBinPage.PrevSearchMatch()
+	case PageOSSDeletedObjects:
+		m.ossDeletedPage = m.ossDeletedPage.PrevSearchMatch()
+	case PageRedisBackups:
+		m.redisBackupsPage = m.redisBackupsPage.PrevSearchMatch()
+	case PageRDSBackups:
+		m.rdsBackupsPage = m.rdsBackupsPage.PrevSearchMatch()
+	case PageRDSBinlogs:
+		m.rdsBinlogsPage = m.rdsBinlogsPage.PrevSearchMatch()
+	case PageRDSSlowLog:
+		m.rdsSlowLogPage = m.rdsSlowLogPage.PrevSearchMatch()
+	case PageChangelog:
+		m.changelogPage = m.changelogPage.PrevSearchMatch()
+	case PageRecent:
+		m.recentPage = m.recentPage.PrevSearchMatch()
+	case PageUsage:
+		m.usagePage = m.usagePage.PrevSearchMatch()
+	case PageDNSImport:
+		m.dnsImportPage = m.dnsImportPage.PrevSearchMatch()
+	case PageAPITrace:
+		m.apiTracePage = m.apiTracePage.PrevSearchMatch()
 	}
 
 	return m, nil
@@ -1515,7 +4881,7 @@ func (m *Model) GetClients() *client.AliyunClients {
 // loadRegions returns a command that loads regions with resources asynchronously
 func (m *Model) loadRegions() tea.Cmd {
 	return func() tea.Msg {
-		regions, err := m.regionService.GetRegionsWithResources()
+		regions, err := m.regionService.GetRegionsWithResources(m.loadCtx())
 		if err != nil {
 			return ErrorMsg{Err: fmt.Errorf("failed to load regions: %w", err)}
 		}
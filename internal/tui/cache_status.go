@@ -0,0 +1,106 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/cache"
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+)
+
+// cachedPage is a list page whose data comes from a cached service fetch
+type cachedPage struct {
+	resource string
+	load     func(context.Context, *Services) tea.Cmd
+}
+
+// cachedPages are the list pages that show their data's age in the mode line
+// and reload on the refresh key
+var cachedPages = map[PageType]cachedPage{
+	PageECSList:           {service.CacheECSInstances, func(ctx context.Context, s *Services) tea.Cmd { return LoadECSInstances(ctx, s.ECS) }},
+	PageSecurityGroups:    {service.CacheSecurityGroups, func(ctx context.Context, s *Services) tea.Cmd { return LoadSecurityGroups(ctx, s.ECS) }},
+	PageDNSDomains:        {service.CacheDNSDomains, func(ctx context.Context, s *Services) tea.Cmd { return LoadDNSDomains(ctx, s.DNS) }},
+	PageSLBList:           {service.CacheSLBInstances, func(ctx context.Context, s *Services) tea.Cmd { return LoadSLBInstances(ctx, s.SLB) }},
+	PageALBList:           {service.CacheALBLoadBalancers, func(ctx context.Context, s *Services) tea.Cmd { return LoadALBLoadBalancers(ctx, s.ALB) }},
+	PageNLBList:           {service.CacheNLBLoadBalancers, func(ctx context.Context, s *Services) tea.Cmd { return LoadNLBLoadBalancers(ctx, s.NLB) }},
+	PageOSSBuckets:        {service.CacheOSSBuckets, func(ctx context.Context, s *Services) tea.Cmd { return LoadOSSBuckets(ctx, s.OSS) }},
+	PageRDSList:           {service.CacheRDSDetailed, func(ctx context.Context, s *Services) tea.Cmd { return LoadRDSDetailedInstances(ctx, s.RDS) }},
+	PageRedisList:         {service.CacheRedisInstances, func(ctx context.Context, s *Services) tea.Cmd { return LoadRedisInstances(ctx, s.Redis) }},
+	PageMongoDBList:       {service.CacheMongoDBInstances, func(ctx context.Context, s *Services) tea.Cmd { return LoadMongoDBInstances(ctx, s.MongoDB) }},
+	PageElasticsearchList: {service.CacheESInstances, func(ctx context.Context, s *Services) tea.Cmd { return LoadElasticsearchInstances(ctx, s.ES) }},
+	PageKafkaList:         {service.CacheKafkaInstances, func(ctx context.Context, s *Services) tea.Cmd { return LoadKafkaInstances(ctx, s.Kafka) }},
+	PageRocketMQList:      {service.CacheRocketMQInstances, func(ctx context.Context, s *Services) tea.Cmd { return LoadRocketMQInstances(ctx, s.RocketMQ) }},
+	PageRAMUsers:          {service.CacheRAMUsers, func(ctx context.Context, s *Services) tea.Cmd { return LoadRAMUsers(ctx, s.RAM) }},
+	PageRAMRoles:          {service.CacheRAMRoles, func(ctx context.Context, s *Services) tea.Cmd { return LoadRAMRoles(ctx, s.RAM) }},
+	PageRAMPolicies:       {service.CacheRAMPolicies, func(ctx context.Context, s *Services) tea.Cmd { return LoadRAMPolicies(ctx, s.RAM) }},
+	PageSLSProjects:       {service.CacheSLSProjects, func(ctx context.Context, s *Services) tea.Cmd { return LoadSLSProjects(ctx, s.SLS) }},
+	PageConfigRules:       {service.CacheConfigRules, func(ctx context.Context, s *Services) tea.Cmd { return LoadConfigRules(ctx, s.Config) }},
+	PageNATList:           {service.CacheNATGateways, func(ctx context.Context, s *Services) tea.Cmd { return LoadNATGateways(ctx, s.NAT) }},
+	PageACKClusters:       {service.CacheACKClusters, func(ctx context.Context, s *Services) tea.Cmd { return LoadACKClusters(ctx, s.ACK) }},
+	PageACRInstances:      {service.CacheACRInstances, func(ctx context.Context, s *Services) tea.Cmd { return LoadACRInstances(ctx, s.ACR) }},
+	PageFCServices:        {service.CacheFCServices, func(ctx context.Context, s *Services) tea.Cmd { return LoadFCServices(ctx, s.FC) }},
+	PageTagBrowser:        {service.CacheTags, func(ctx context.Context, s *Services) tea.Cmd { return LoadTags(ctx, s.Tags) }},
+}
+
+// cacheStatus returns the mode line note of how old the current page's
+// cached data is, "" for pages not served from the cache. All Regions lists
+// mix results of many regions and show none
+func (m Model) cacheStatus() string {
+	page, ok := cachedPages[m.currentPage]
+	if !ok || m.loading || m.allRegions || m.services == nil {
+		return ""
+	}
+	age, ok := m.services.Cache.Age(page.resource)
+	if !ok || age < time.Second {
+		return ""
+	}
+	return fmt.Sprintf(i18n.T(i18n.KeyCacheAge), age.Round(time.Second))
+}
+
+// refreshPage drops the cached results of the current profile and region, or
+// of all its regions in All Regions mode, and loads the current list page
+// again. Other pages fetch afresh the next time they are opened
+func (m Model) refreshPage() (Model, tea.Cmd) {
+	region := m.region
+	if m.allRegions {
+		region = ""
+	}
+	cache.Default.Invalidate(m.profile, region)
+	m.prefetch.invalidate(m.profile, region)
+
+	page, ok := cachedPages[m.currentPage]
+	if !ok || m.loading {
+		return m, nil
+	}
+	if m.allRegions {
+		return m.reopenPage()
+	}
+	m.loading = true
+	m.modeLine = m.modeLine.SetPageInfo("")
+	return m, page.load(m.loadCtx(), m.services)
+}
+
+// invalidatePage drops the cached and prefetched data of a list page after a
+// write changed it, so the page fetches it afresh the next time it loads
+func (m Model) invalidatePage(page PageType) {
+	if cached, ok := cachedPages[page]; ok && m.services != nil {
+		m.services.Cache.Drop(cached.resource)
+	}
+	m.prefetch.drop(prefetchKey(m.profile, m.region, page))
+}
+
+// reopenPage opens the current page again in place, keeping the back stack
+// and the page to toggle to as they are
+func (m Model) reopenPage() (Model, tea.Cmd) {
+	previous := m.previousPages
+	alternate, hasAlternate := m.alternatePage, m.hasAlternate
+
+	m, cmd := m.navigateTo(m.currentPage, nil)
+	m.previousPages = previous
+	m.alternatePage, m.hasAlternate = alternate, hasAlternate
+	return m, cmd
+}
@@ -0,0 +1,46 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/changelog"
+	"aliyun-tui-viewer/internal/config"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// whatsNewSince tells whether to open the What's New page on startup, and
+// the version to list the releases since. It is shown once per upgrade: the
+// running version is recorded as seen. A first install has nothing new, but
+// a user of a version older than the state file, known from the finder
+// history, is shown the running release
+func whatsNewSince(returningUser bool) (string, bool) {
+	current := changelog.Current()
+	state := config.LoadState()
+	if state.LastSeenVersion != "" && changelog.CompareVersions(state.LastSeenVersion, current) >= 0 {
+		return "", false
+	}
+
+	since, show := state.LastSeenVersion, true
+	if since == "" {
+		since, show = previousRelease(), returningUser
+	}
+	state.LastSeenVersion = current
+	_ = state.Save() // Shown again next time if it can't be saved, which is harmless
+	return since, show
+}
+
+// previousRelease returns the release before the running one, "" when there
+// is none
+func previousRelease() string {
+	if releases := changelog.Releases(); len(releases) > 1 {
+		return releases[1].Version
+	}
+	return ""
+}
+
+// openWhatsNew opens the What's New page with the releases since a version
+func openWhatsNew(since string) tea.Cmd {
+	return func() tea.Msg {
+		return types.NavigateMsg{Page: types.PageChangelog, Data: since}
+	}
+}
@@ -0,0 +1,43 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/config"
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/tui/components"
+)
+
+// handleColumnsChosen shows the columns picked with the column chooser on
+// the current page and saves them for it, or restores its defaults
+func (m Model) handleColumnsChosen(msg components.ColumnsChosenMsg) (Model, tea.Cmd) {
+	name := m.currentPage.String()
+	switch {
+	case msg.Layout == nil:
+		delete(m.columnLayouts, name)
+	case m.columnLayouts == nil:
+		m.columnLayouts = map[string]config.ColumnLayout{name: *msg.Layout}
+	default:
+		m.columnLayouts[name] = *msg.Layout
+	}
+
+	m, cmd := m.updateCurrentPage(components.ColumnLayoutMsg{Layout: msg.Layout})
+	if err := config.SaveColumnLayout(name, msg.Layout); err != nil {
+		m.modal = components.NewErrorModal(fmt.Sprintf(i18n.T(i18n.KeyColumnsSaveFailed), err))
+	}
+	return m, cmd
+}
+
+// restoreColumnLayout shows the columns saved for the current page. Pages
+// are recreated when they load, so it runs after every update; tables that
+// already show the layout ignore it
+func (m Model) restoreColumnLayout() Model {
+	layout, ok := m.columnLayouts[m.currentPage.String()]
+	if !ok {
+		return m
+	}
+	m, _ = m.updateCurrentPage(components.ColumnLayoutMsg{Layout: &layout})
+	return m
+}
@@ -1,63 +1,248 @@
 package tui
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 
+	"aliyun-tui-viewer/internal/cache"
+	"aliyun-tui-viewer/internal/client"
+	"aliyun-tui-viewer/internal/config"
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/jobs"
 	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/pages"
 )
 
 // Services holds all service instances for data fetching
 type Services struct {
 	ECS      *service.ECSService
+	EIP      *service.EIPService
+	NAT      *service.NATService
 	DNS      *service.DNSService
 	SLB      *service.SLBService
+	ALB      *service.ALBService
+	NLB      *service.NLBService
+	ACK      *service.ACKService
+	ACR      *service.ACRService
+	FC       *service.FCService
 	RDS      *service.RDSService
 	OSS      *service.OSSService
 	Redis    *service.RedisService
+	MongoDB  *service.MongoDBService
+	ES       *service.ElasticsearchService
+	Kafka    *service.KafkaService
 	RocketMQ *service.RocketMQService
+	RAM      *service.RAMService
+	Monitor  *service.MonitorService
+	SLS      *service.SLSService
+	Config   *service.CloudConfigService
+	Tags     *service.TagService
+	Billing  *service.BillingService
+	Names    *service.NameResolver
+	// DNSTargets matches DNS records against the account's resources
+	DNSTargets *service.DNSTargetService
+	// Cache is the part of the shared cache the list fetches of this
+	// profile and region go through
+	Cache *cache.Scope
+	// Permissions are the products the profile turned out to have no
+	// permission for
+	Permissions *service.Permissions
 }
 
 // --- ECS Commands ---
 
-// LoadECSInstances creates a command to load ECS instances
-func LoadECSInstances(svc *service.ECSService) tea.Cmd {
+// ecsStreamSeq numbers the page by page loads of ECS instances
+var ecsStreamSeq atomic.Int64
+
+// LoadECSInstances creates a command to load ECS instances page by page: an
+// ECSInstancesBatchMsg follows each page but the last, so the list fills in
+// as they arrive, and ECSInstancesLoadedMsg the last. Cached instances load
+// at once
+func LoadECSInstances(ctx context.Context, svc *service.ECSService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		if instances, ok := svc.CachedInstances(); ok {
+			return ECSInstancesLoadedMsg{Instances: instances}
+		}
+		return loadECSInstancesPage(ctx, svc, ecsStreamSeq.Add(1), nil, "")()
+	})
+}
+
+// loadECSInstancesPage creates a command loading the page of ECS instances
+// nextToken points to after the instances loaded so far
+func loadECSInstancesPage(ctx context.Context, svc *service.ECSService, stream int64, loaded []ecs.Instance, nextToken string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		page, err := svc.FetchInstancesPage(ctx, nextToken)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		// A new slice, as the batch before still shares the old one
+		loaded = slices.Concat(loaded, page.Instances)
+		if page.NextToken == "" {
+			svc.CacheInstances(loaded)
+			return ECSInstancesLoadedMsg{Instances: loaded}
+		}
+		return ECSInstancesBatchMsg{
+			Instances: loaded,
+			Total:     page.TotalCount,
+			Stream:    stream,
+			next:      loadECSInstancesPage(ctx, svc, stream, loaded, page.NextToken),
+		}
+	})
+}
+
+// ecsLoadProgress returns the mode line note of how many ECS instances are
+// loaded out of how many
+func ecsLoadProgress(loaded, total int) string {
+	if total > 0 {
+		return fmt.Sprintf(i18n.T(i18n.KeyECSLoadProgress), loaded, total)
+	}
+	return fmt.Sprintf(i18n.T(i18n.KeyECSLoadProgressUnknown), loaded)
+}
+
+// LoadECSMetrics creates a command to load CloudMonitor metrics of an ECS instance
+func LoadECSMetrics(ctx context.Context, svc *service.MonitorService, instanceID string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		series, err := svc.FetchECSMetrics(ctx, instanceID, pages.MetricsWindow)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return ECSMetricsLoadedMsg{InstanceID: instanceID, Series: series}
+	})
+}
+
+// LoadECSSizing creates a command to compute a right-sizing recommendation
+// for an instance from its CloudMonitor history and its type family
+func LoadECSSizing(ctx context.Context, monitor *service.MonitorService, ecsSvc *service.ECSService, inst ecs.Instance) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		msg := ECSSizingLoadedMsg{InstanceID: inst.InstanceId, Family: inst.InstanceTypeFamily}
+		usage, err := monitor.FetchECSUtilization(ctx, inst.InstanceId, service.RightsizingWindow)
+		if err != nil {
+			msg.Err = err
+			return msg
+		}
+		types, err := ecsSvc.FetchInstanceTypes(ctx, inst.InstanceTypeFamily)
+		if err != nil {
+			msg.Err = err
+			return msg
+		}
+		msg.Recommendation = service.RecommendInstanceType(inst, usage, types)
+		return msg
+	})
+}
+
+// LoadZoneCapacity creates a command to load the instance families that can
+// be created in each zone
+func LoadZoneCapacity(ctx context.Context, svc *service.ECSService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		capacity, err := svc.FetchZoneCapacity(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return ZoneCapacityLoadedMsg{Capacity: capacity}
+	})
+}
+
+// LoadRDSMetrics creates a command to load CloudMonitor metrics of an RDS instance
+func LoadRDSMetrics(ctx context.Context, svc *service.MonitorService, instanceID string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		series, err := svc.FetchRDSMetrics(ctx, instanceID, pages.MetricsWindow)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return RDSMetricsLoadedMsg{InstanceID: instanceID, Series: series}
+	})
+}
+
+// LoadECSConsoleURL creates a command to create the management terminal URL of an instance
+func LoadECSConsoleURL(svc *service.ECSService, req pages.ECSConsoleRequestMsg) tea.Cmd {
 	return func() tea.Msg {
-		instances, err := svc.FetchInstances()
+		consoleURL, err := svc.CreateVncUrl(req.InstanceID, req.RegionID, req.IsWindows)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
-		return ECSInstancesLoadedMsg{Instances: instances}
+		return ECSConsoleURLLoadedMsg{InstanceID: req.InstanceID, URL: consoleURL, Open: req.Open}
 	}
 }
 
 // LoadSecurityGroups creates a command to load security groups
-func LoadSecurityGroups(svc *service.ECSService) tea.Cmd {
-	return func() tea.Msg {
-		groups, err := svc.FetchSecurityGroups()
+func LoadSecurityGroups(ctx context.Context, svc *service.ECSService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		groups, err := svc.FetchSecurityGroups(ctx)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
 		return SecurityGroupsLoadedMsg{SecurityGroups: groups}
-	}
+	})
 }
 
 // LoadSecurityGroupRules creates a command to load security group rules
-func LoadSecurityGroupRules(svc *service.ECSService, securityGroupId string) tea.Cmd {
-	return func() tea.Msg {
-		response, err := svc.FetchSecurityGroupRules(securityGroupId)
+func LoadSecurityGroupRules(ctx context.Context, svc *service.ECSService, securityGroupId string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		response, err := svc.FetchSecurityGroupRules(ctx, securityGroupId)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
 		return SecurityGroupRulesLoadedMsg{Response: response}
+	})
+}
+
+// AddSecurityGroupRule creates a command to add a rule to a security group
+func AddSecurityGroupRule(svc *service.ECSService, securityGroupId string, input service.SecurityGroupRuleInput) tea.Cmd {
+	return func() tea.Msg {
+		if err := svc.AuthorizeSecurityGroup(securityGroupId, input); err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return SecurityGroupRuleChangedMsg{
+			SecurityGroupId: securityGroupId,
+			Message:         fmt.Sprintf(i18n.T(i18n.KeySGRuleAdded), input.IpProtocol, input.PortRange, securityGroupId),
+		}
 	}
 }
 
-// LoadSecurityGroupInstances creates a command to load instances for a security group
-func LoadSecurityGroupInstances(svc *service.ECSService, securityGroupId string) tea.Cmd {
+// ModifySecurityGroupRule creates a command to replace the fields of a rule
+func ModifySecurityGroupRule(svc *service.ECSService, securityGroupId, ruleID string, input service.SecurityGroupRuleInput) tea.Cmd {
+	return func() tea.Msg {
+		if err := svc.ModifySecurityGroupRule(securityGroupId, ruleID, input); err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return SecurityGroupRuleChangedMsg{
+			SecurityGroupId: securityGroupId,
+			Message:         fmt.Sprintf(i18n.T(i18n.KeySGRuleUpdated), ruleID, securityGroupId),
+		}
+	}
+}
+
+// RevokeSecurityGroupRule creates a command to remove a rule from a security group
+func RevokeSecurityGroupRule(svc *service.ECSService, securityGroupId string, rule ecs.Permission) tea.Cmd {
 	return func() tea.Msg {
-		instances, err := svc.FetchInstancesBySecurityGroup(securityGroupId)
+		if err := svc.RevokeSecurityGroup(securityGroupId, rule); err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return SecurityGroupRuleChangedMsg{
+			SecurityGroupId: securityGroupId,
+			Message:         fmt.Sprintf(i18n.T(i18n.KeySGRuleRevoked), rule.IpProtocol, rule.PortRange, securityGroupId),
+		}
+	}
+}
+
+// LoadSecurityGroupInstances creates a command to load instances for a security group
+func LoadSecurityGroupInstances(ctx context.Context, svc *service.ECSService, securityGroupId string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		instances, err := svc.FetchInstancesBySecurityGroup(ctx, securityGroupId)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
@@ -65,13 +250,13 @@ func LoadSecurityGroupInstances(svc *service.ECSService, securityGroupId string)
 			Instances:       instances,
 			SecurityGroupId: securityGroupId,
 		}
-	}
+	})
 }
 
 // LoadInstanceSecurityGroups creates a command to load security groups for an instance
-func LoadInstanceSecurityGroups(svc *service.ECSService, instanceId string) tea.Cmd {
-	return func() tea.Msg {
-		groups, err := svc.FetchSecurityGroupsByInstance(instanceId)
+func LoadInstanceSecurityGroups(ctx context.Context, svc *service.ECSService, instanceId string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		groups, err := svc.FetchSecurityGroupsByInstance(ctx, instanceId)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
@@ -79,13 +264,13 @@ func LoadInstanceSecurityGroups(svc *service.ECSService, instanceId string) tea.
 			SecurityGroups: groups,
 			InstanceId:     instanceId,
 		}
-	}
+	})
 }
 
 // LoadECSDisks creates a command to load disks for an instance
-func LoadECSDisks(svc *service.ECSService, instanceId string) tea.Cmd {
-	return func() tea.Msg {
-		disks, err := svc.FetchDisks(instanceId)
+func LoadECSDisks(ctx context.Context, svc *service.ECSService, instanceId string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		disks, err := svc.FetchDisks(ctx, instanceId)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
@@ -93,13 +278,13 @@ func LoadECSDisks(svc *service.ECSService, instanceId string) tea.Cmd {
 			Disks:      disks,
 			InstanceId: instanceId,
 		}
-	}
+	})
 }
 
 // LoadECSNetworkInterfaces creates a command to load network interfaces for an instance
-func LoadECSNetworkInterfaces(svc *service.ECSService, instanceId string) tea.Cmd {
-	return func() tea.Msg {
-		enis, err := svc.FetchNetworkInterfaces(instanceId)
+func LoadECSNetworkInterfaces(ctx context.Context, svc *service.ECSService, instanceId string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		enis, err := svc.FetchNetworkInterfaces(ctx, instanceId)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
@@ -107,26 +292,26 @@ func LoadECSNetworkInterfaces(svc *service.ECSService, instanceId string) tea.Cm
 			NetworkInterfaces: enis,
 			InstanceId:        instanceId,
 		}
-	}
+	})
 }
 
 // --- DNS Commands ---
 
 // LoadDNSDomains creates a command to load DNS domains
-func LoadDNSDomains(svc *service.DNSService) tea.Cmd {
-	return func() tea.Msg {
-		domains, err := svc.FetchDomains()
+func LoadDNSDomains(ctx context.Context, svc *service.DNSService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		domains, err := svc.FetchDomains(ctx)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
 		return DNSDomainsLoadedMsg{Domains: domains}
-	}
+	})
 }
 
 // LoadDNSRecords creates a command to load DNS records for a domain
-func LoadDNSRecords(svc *service.DNSService, domainName string) tea.Cmd {
-	return func() tea.Msg {
-		records, err := svc.FetchDomainRecords(domainName)
+func LoadDNSRecords(ctx context.Context, svc *service.DNSService, domainName string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		records, err := svc.FetchDomainRecords(ctx, domainName)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
@@ -134,26 +319,111 @@ func LoadDNSRecords(svc *service.DNSService, domainName string) tea.Cmd {
 			Records:    records,
 			DomainName: domainName,
 		}
+	})
+}
+
+// AddDNSRecord creates a command to add a record to a domain
+func AddDNSRecord(svc *service.DNSService, domainName string, input service.DomainRecordInput) tea.Cmd {
+	return func() tea.Msg {
+		if _, err := svc.AddDomainRecord(domainName, input); err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return DNSRecordChangedMsg{
+			DomainName: domainName,
+			Message:    fmt.Sprintf(i18n.T(i18n.KeyDNSRecordAdded), dnsRecordFQDN(input.RR, domainName)),
+		}
+	}
+}
+
+// UpdateDNSRecord creates a command to update an existing record
+func UpdateDNSRecord(svc *service.DNSService, domainName, recordID string, input service.DomainRecordInput) tea.Cmd {
+	return func() tea.Msg {
+		if err := svc.UpdateDomainRecord(recordID, input); err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return DNSRecordChangedMsg{
+			DomainName: domainName,
+			Message:    fmt.Sprintf(i18n.T(i18n.KeyDNSRecordUpdated), dnsRecordFQDN(input.RR, domainName)),
+		}
+	}
+}
+
+// DeleteDNSRecord creates a command to delete a record
+func DeleteDNSRecord(svc *service.DNSService, record alidns.Record) tea.Cmd {
+	return func() tea.Msg {
+		if err := svc.DeleteDomainRecord(record.RecordId); err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return DNSRecordChangedMsg{
+			DomainName: record.DomainName,
+			Message:    fmt.Sprintf(i18n.T(i18n.KeyDNSRecordDeleted), dnsRecordFQDN(record.RR, record.DomainName)),
+		}
+	}
+}
+
+// ToggleDNSRecordStatus creates a command to pause an enabled record or enable a paused one
+func ToggleDNSRecordStatus(svc *service.DNSService, record alidns.Record) tea.Cmd {
+	return func() tea.Msg {
+		status, msgKey := "Disable", i18n.KeyDNSRecordPaused
+		if strings.EqualFold(record.Status, "DISABLE") {
+			status, msgKey = "Enable", i18n.KeyDNSRecordEnabled
+		}
+		if err := svc.SetDomainRecordStatus(record.RecordId, status); err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return DNSRecordChangedMsg{
+			DomainName: record.DomainName,
+			Message:    fmt.Sprintf(i18n.T(msgKey), dnsRecordFQDN(record.RR, record.DomainName)),
+		}
+	}
+}
+
+// PlanDNSImport returns a job that reads a CSV file of DNS changes and checks
+// them against the records of their domains, reporting the domains checked
+func PlanDNSImport(svc *service.DNSService, path string) jobRunner {
+	return func(ctx context.Context, report jobs.Reporter) (tea.Msg, error) {
+		changes, invalid, err := service.ReadDNSChangesFile(path)
+		if err != nil {
+			return ErrorMsg{Err: err}, err
+		}
+		plan, err := svc.PlanDNSChanges(ctx, path, changes, invalid, report)
+		if err != nil {
+			return ErrorMsg{Err: err}, err
+		}
+		return DNSImportPlannedMsg{Plan: plan}, nil
+	}
+}
+
+// ApplyDNSImport returns a job that applies the ready changes of an import,
+// reporting the changes done. A cancelled import still reports the changes
+// it made
+func ApplyDNSImport(svc *service.DNSService, plan *service.DNSImportPlan) jobRunner {
+	return func(ctx context.Context, report jobs.Reporter) (tea.Msg, error) {
+		result, err := svc.ApplyDNSImport(ctx, plan, report)
+		if result == nil {
+			return ErrorMsg{Err: err}, err
+		}
+		return DNSImportAppliedMsg{Plan: result, Err: err}, err
 	}
 }
 
 // --- SLB Commands ---
 
 // LoadSLBInstances creates a command to load SLB instances
-func LoadSLBInstances(svc *service.SLBService) tea.Cmd {
-	return func() tea.Msg {
-		lbs, err := svc.FetchInstances()
+func LoadSLBInstances(ctx context.Context, svc *service.SLBService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		lbs, err := svc.FetchInstances(ctx)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
 		return SLBInstancesLoadedMsg{LoadBalancers: lbs}
-	}
+	})
 }
 
 // LoadSLBListeners creates a command to load SLB listeners
-func LoadSLBListeners(svc *service.SLBService, loadBalancerId string) tea.Cmd {
-	return func() tea.Msg {
-		listeners, err := svc.FetchDetailedListeners(loadBalancerId)
+func LoadSLBListeners(ctx context.Context, svc *service.SLBService, loadBalancerId string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		listeners, err := svc.FetchDetailedListeners(ctx, loadBalancerId)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
@@ -161,13 +431,13 @@ func LoadSLBListeners(svc *service.SLBService, loadBalancerId string) tea.Cmd {
 			Listeners:      listeners,
 			LoadBalancerId: loadBalancerId,
 		}
-	}
+	})
 }
 
 // LoadSLBVServerGroups creates a command to load SLB VServer groups
-func LoadSLBVServerGroups(svc *service.SLBService, loadBalancerId string) tea.Cmd {
-	return func() tea.Msg {
-		groups, err := svc.FetchDetailedVServerGroups(loadBalancerId)
+func LoadSLBVServerGroups(ctx context.Context, svc *service.SLBService, loadBalancerId string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		groups, err := svc.FetchDetailedVServerGroups(ctx, loadBalancerId)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
@@ -175,13 +445,13 @@ func LoadSLBVServerGroups(svc *service.SLBService, loadBalancerId string) tea.Cm
 			VServerGroups:  groups,
 			LoadBalancerId: loadBalancerId,
 		}
-	}
+	})
 }
 
 // LoadSLBBackendServers creates a command to load backend servers
-func LoadSLBBackendServers(svc *service.SLBService, vServerGroupId string, ecsClient *ecs.Client) tea.Cmd {
-	return func() tea.Msg {
-		servers, err := svc.FetchDetailedBackendServers(vServerGroupId, ecsClient)
+func LoadSLBBackendServers(ctx context.Context, svc *service.SLBService, vServerGroupId string, ecsClient *ecs.Client) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		servers, err := svc.FetchDetailedBackendServers(ctx, vServerGroupId, ecsClient)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
@@ -189,13 +459,104 @@ func LoadSLBBackendServers(svc *service.SLBService, vServerGroupId string, ecsCl
 			BackendServers: servers,
 			VServerGroupId: vServerGroupId,
 		}
+	})
+}
+
+// LoadSLBBackendTargets creates a command to load the SLB instances to add
+// ECS backends to
+func LoadSLBBackendTargets(ctx context.Context, svc *service.SLBService, req pages.SLBBackendAddRequestMsg) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		lbs, err := svc.FetchInstances(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return SLBBackendTargetsLoadedMsg{Request: req, LoadBalancers: lbs}
+	})
+}
+
+// LoadSLBBackendGroups creates a command to load the VServer groups of the
+// SLB picked for new backends
+func LoadSLBBackendGroups(ctx context.Context, svc *service.SLBService, req pages.SLBBackendAddRequestMsg) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		groups, err := svc.FetchVServerGroups(ctx, req.LoadBalancerID)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return SLBBackendGroupsLoadedMsg{Request: req, VServerGroups: groups}
+	})
+}
+
+// AddSLBBackends creates a command to register backends in the VServer group
+// of req, creating the group named name when req has none
+func AddSLBBackends(svc *service.SLBService, req pages.SLBBackendAddRequestMsg, name string, backends []service.VServerBackend) tea.Cmd {
+	return func() tea.Msg {
+		done := SLBBackendsAddedMsg{
+			LoadBalancerID: req.LoadBalancerID,
+			VServerGroupID: req.VServerGroupID,
+			Count:          len(backends),
+		}
+		if req.VServerGroupID == "" {
+			id, err := svc.CreateVServerGroup(req.LoadBalancerID, name, backends)
+			if err != nil {
+				return ErrorMsg{Err: err}
+			}
+			done.VServerGroupID, done.Created = id, true
+			return done
+		}
+		if err := svc.AddVServerGroupBackendServers(req.VServerGroupID, backends); err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return done
 	}
 }
 
-// LoadSLBForwardingRules creates a command to load forwarding rules for a listener
-func LoadSLBForwardingRules(svc *service.SLBService, loadBalancerId string, listenerPort int, listenerProtocol string) tea.Cmd {
+// LoadSLBCertificates creates a command to load the server certificates to
+// bind a listener to
+func LoadSLBCertificates(ctx context.Context, svc *service.SLBService, req pages.SLBCertRotateRequestMsg) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		certs, err := svc.FetchServerCertificates(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return SLBCertificatesLoadedMsg{Request: req, Certificates: certs}
+	})
+}
+
+// UploadSLBCertificate creates a command to upload a server certificate for
+// the listener of req
+func UploadSLBCertificate(svc *service.SLBService, req pages.SLBCertRotateRequestMsg, name string, bundle *service.CertificateBundle) tea.Cmd {
 	return func() tea.Msg {
-		rules, err := svc.FetchForwardingRules(loadBalancerId, listenerPort, listenerProtocol)
+		id, err := svc.UploadServerCertificate(name, bundle)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return SLBCertUploadedMsg{
+			Request: req,
+			Certificate: slb.ServerCertificate{
+				ServerCertificateId:   id,
+				ServerCertificateName: name,
+				CommonName:            bundle.Leaf.Subject.CommonName,
+				ExpireTimeStamp:       bundle.Leaf.NotAfter.UnixMilli(),
+			},
+		}
+	}
+}
+
+// BindSLBCertificate creates a command to bind an HTTPS listener to a
+// server certificate
+func BindSLBCertificate(svc *service.SLBService, loadBalancerId string, port int, certificateId string) tea.Cmd {
+	return func() tea.Msg {
+		if err := svc.SetListenerServerCertificate(loadBalancerId, port, certificateId); err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return SLBCertBoundMsg{LoadBalancerID: loadBalancerId, Port: port, CertificateID: certificateId}
+	}
+}
+
+// LoadSLBForwardingRules creates a command to load forwarding rules for a listener
+func LoadSLBForwardingRules(ctx context.Context, svc *service.SLBService, loadBalancerId string, listenerPort int, listenerProtocol string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		rules, err := svc.FetchForwardingRules(ctx, loadBalancerId, listenerPort, listenerProtocol)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
@@ -205,13 +566,13 @@ func LoadSLBForwardingRules(svc *service.SLBService, loadBalancerId string, list
 			ListenerPort:     listenerPort,
 			ListenerProtocol: listenerProtocol,
 		}
-	}
+	})
 }
 
 // LoadSLBDefaultServers creates a command to load default backend servers
-func LoadSLBDefaultServers(svc *service.SLBService, loadBalancerId string, ecsClient *ecs.Client) tea.Cmd {
-	return func() tea.Msg {
-		servers, err := svc.FetchDefaultBackendServers(loadBalancerId, ecsClient)
+func LoadSLBDefaultServers(ctx context.Context, svc *service.SLBService, loadBalancerId string, ecsClient *ecs.Client) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		servers, err := svc.FetchDefaultBackendServers(ctx, loadBalancerId, ecsClient)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
@@ -219,26 +580,37 @@ func LoadSLBDefaultServers(svc *service.SLBService, loadBalancerId string, ecsCl
 			Servers:        servers,
 			LoadBalancerId: loadBalancerId,
 		}
-	}
+	})
 }
 
 // --- OSS Commands ---
 
 // LoadOSSBuckets creates a command to load OSS buckets
-func LoadOSSBuckets(svc *service.OSSService) tea.Cmd {
-	return func() tea.Msg {
-		buckets, err := svc.FetchBuckets()
+func LoadOSSBuckets(ctx context.Context, svc *service.OSSService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		buckets, err := svc.FetchBuckets(ctx)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
 		return OSSBucketsLoadedMsg{Buckets: buckets}
-	}
+	})
+}
+
+// LoadOSSBucketAudit creates a command to load the settings of a bucket
+func LoadOSSBucketAudit(ctx context.Context, svc *service.OSSService, bucketName string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		audit, err := svc.FetchBucketAudit(ctx, bucketName)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return OSSBucketAuditLoadedMsg{Audit: audit}
+	})
 }
 
 // LoadOSSObjects creates a command to load OSS objects with pagination
-func LoadOSSObjects(svc *service.OSSService, bucketName, marker string, pageSize, page int) tea.Cmd {
-	return func() tea.Msg {
-		result, err := svc.FetchObjects(bucketName, marker, pageSize)
+func LoadOSSObjects(ctx context.Context, svc *service.OSSService, bucketName, prefix, marker string, pageSize, page int) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		result, err := svc.FetchObjects(ctx, bucketName, prefix, "/", marker, pageSize)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
@@ -247,37 +619,142 @@ func LoadOSSObjects(svc *service.OSSService, bucketName, marker string, pageSize
 			BucketName: bucketName,
 			Page:       page,
 		}
+	})
+}
+
+// LoadOSSObjectPreview creates a command to fetch the start of an object for preview
+func LoadOSSObjectPreview(ctx context.Context, svc *service.OSSService, bucketName, objectKey string, maxBytes int64) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		content, err := svc.FetchObjectContent(ctx, bucketName, objectKey, maxBytes)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return OSSObjectPreviewLoadedMsg{BucketName: bucketName, Content: content}
+	})
+}
+
+// DownloadOSSObject returns a job that downloads an object, or one of its
+// versions when versionID is set, reporting bytes as they arrive
+func DownloadOSSObject(svc *service.OSSService, bucketName, objectKey, versionID, localPath string) jobRunner {
+	return func(ctx context.Context, report jobs.Reporter) (tea.Msg, error) {
+		path, err := svc.DownloadObject(ctx, bucketName, objectKey, versionID, localPath, report)
+		return OSSDownloadDoneMsg{ObjectKey: objectKey, LocalPath: path, Err: err}, err
+	}
+}
+
+// CopyOSSObjects returns a job that copies or moves objects to another
+// bucket or folder, reporting the objects done. A cancelled copy still
+// reports the objects it copied or moved
+func CopyOSSObjects(svc *service.OSSService, c service.ObjectCopy) jobRunner {
+	return func(ctx context.Context, report jobs.Reporter) (tea.Msg, error) {
+		result, err := svc.CopyObjects(ctx, c, report)
+		if result == nil {
+			return ErrorMsg{Err: err}, err
+		}
+		return OSSObjectsCopiedMsg{Copy: c, Result: result, Err: err}, err
+	}
+}
+
+// ScanOSSObjects creates a job that lists every object under the prefix of
+// scan with concurrent partitioned listings, reporting the objects found
+func ScanOSSObjects(svc *service.OSSService, scan pages.OSSScanNavData) jobRunner {
+	return func(ctx context.Context, report jobs.Reporter) (tea.Msg, error) {
+		result, err := svc.ScanObjects(ctx, scan.BucketName, scan.Prefix,
+			service.DefaultOSSScanWorkers, service.DefaultOSSScanLimit,
+			func(found int64) { report(found, 0) })
+		if err != nil {
+			return ErrorMsg{Err: err}, err
+		}
+		return OSSObjectsScannedMsg{Scan: scan, Result: result}, nil
+	}
+}
+
+// RestoreOSSArchiveObject creates a command to restore an archived object
+func RestoreOSSArchiveObject(svc *service.OSSService, bucketName string, object oss.ObjectProperties, days int, tier string) tea.Cmd {
+	return func() tea.Msg {
+		if err := svc.RestoreArchiveObject(bucketName, object.Key, object.StorageClass, days, tier); err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return OSSArchiveRestoreStartedMsg{BucketName: bucketName, ObjectKey: object.Key}
+	}
+}
+
+// LoadOSSObjectVersions creates a command to list the versions of an object
+func LoadOSSObjectVersions(ctx context.Context, svc *service.OSSService, bucketName, objectKey string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		versions, err := svc.FetchObjectVersions(ctx, bucketName, objectKey)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return OSSObjectVersionsLoadedMsg{BucketName: bucketName, ObjectKey: objectKey, Versions: versions}
+	})
+}
+
+// RestoreOSSObjectVersion creates a command to make a previous version of an
+// object the current one
+func RestoreOSSObjectVersion(svc *service.OSSService, bucketName string, version service.ObjectVersion) tea.Cmd {
+	return func() tea.Msg {
+		if err := svc.RestoreObjectVersion(bucketName, version.Key, version.VersionID); err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return OSSVersionRestoredMsg{
+			BucketName: bucketName,
+			ObjectKey:  version.Key,
+			Message:    fmt.Sprintf(i18n.T(i18n.KeyOSSVersionRestored), version.Key, version.VersionID),
+		}
+	}
+}
+
+// LoadOSSDeletedObjects creates a command to list the deleted objects under
+// a folder
+func LoadOSSDeletedObjects(ctx context.Context, svc *service.OSSService, bucketName, prefix string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		markers, truncated, err := svc.FetchDeletedObjects(ctx, bucketName, prefix)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return OSSDeletedObjectsLoadedMsg{BucketName: bucketName, Prefix: prefix, Markers: markers, Truncated: truncated}
+	})
+}
+
+// UndeleteOSSObject creates a command to remove the delete marker of an object
+func UndeleteOSSObject(svc *service.OSSService, bucketName string, marker service.ObjectVersion) tea.Cmd {
+	return func() tea.Msg {
+		if err := svc.RemoveDeleteMarker(bucketName, marker.Key, marker.VersionID); err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return OSSUndeletedMsg{BucketName: bucketName, ObjectKey: marker.Key}
 	}
 }
 
 // --- RDS Commands ---
 
 // LoadRDSInstances creates a command to load RDS instances
-func LoadRDSInstances(svc *service.RDSService) tea.Cmd {
-	return func() tea.Msg {
-		instances, err := svc.FetchInstances()
+func LoadRDSInstances(ctx context.Context, svc *service.RDSService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		instances, err := svc.FetchInstances(ctx)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
 		return RDSInstancesLoadedMsg{Instances: instances}
-	}
+	})
 }
 
 // LoadRDSDetailedInstances creates a command to load RDS instances with network info
-func LoadRDSDetailedInstances(svc *service.RDSService) tea.Cmd {
-	return func() tea.Msg {
-		instances, err := svc.FetchDetailedInstances()
+func LoadRDSDetailedInstances(ctx context.Context, svc *service.RDSService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		instances, err := svc.FetchDetailedInstances(ctx)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
 		return RDSDetailedInstancesLoadedMsg{Instances: instances}
-	}
+	})
 }
 
 // LoadRDSDatabases creates a command to load RDS databases
-func LoadRDSDatabases(svc *service.RDSService, instanceId string) tea.Cmd {
-	return func() tea.Msg {
-		databases, err := svc.FetchDatabases(instanceId)
+func LoadRDSDatabases(ctx context.Context, svc *service.RDSService, instanceId string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		databases, err := svc.FetchDatabases(ctx, instanceId)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
@@ -285,13 +762,23 @@ func LoadRDSDatabases(svc *service.RDSService, instanceId string) tea.Cmd {
 			Databases:  databases,
 			InstanceId: instanceId,
 		}
-	}
+	})
+}
+
+// LoadRDSAttributes creates a command to load the attributes of an RDS
+// instance. Failures are reported in the message, shown in the detail
+// sections the attributes fill in
+func LoadRDSAttributes(ctx context.Context, svc *service.RDSService, instanceID string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		attrs, err := svc.FetchInstanceAttributes(ctx, instanceID)
+		return RDSAttributesLoadedMsg{InstanceID: instanceID, Attributes: attrs, Err: err}
+	})
 }
 
 // LoadRDSAccounts creates a command to load RDS accounts
-func LoadRDSAccounts(svc *service.RDSService, instanceId string) tea.Cmd {
-	return func() tea.Msg {
-		accounts, err := svc.FetchAccounts(instanceId)
+func LoadRDSAccounts(ctx context.Context, svc *service.RDSService, instanceId string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		accounts, err := svc.FetchAccounts(ctx, instanceId)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
@@ -299,26 +786,36 @@ func LoadRDSAccounts(svc *service.RDSService, instanceId string) tea.Cmd {
 			Accounts:   accounts,
 			InstanceId: instanceId,
 		}
-	}
+	})
 }
 
 // --- Redis Commands ---
 
 // LoadRedisInstances creates a command to load Redis instances
-func LoadRedisInstances(svc *service.RedisService) tea.Cmd {
-	return func() tea.Msg {
-		instances, err := svc.FetchInstances()
+func LoadRedisInstances(ctx context.Context, svc *service.RedisService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		instances, err := svc.FetchInstances(ctx)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
 		return RedisInstancesLoadedMsg{Instances: instances}
-	}
+	})
+}
+
+// LoadRedisAttributes creates a command to load the attributes of a Redis
+// instance. Failures are reported in the message, shown in the detail
+// sections the attributes fill in
+func LoadRedisAttributes(ctx context.Context, svc *service.RedisService, instanceID string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		attrs, err := svc.FetchInstanceAttributes(ctx, instanceID)
+		return RedisAttributesLoadedMsg{InstanceID: instanceID, Attributes: attrs, Err: err}
+	})
 }
 
 // LoadRedisAccounts creates a command to load Redis accounts
-func LoadRedisAccounts(svc *service.RedisService, instanceId string) tea.Cmd {
-	return func() tea.Msg {
-		accounts, err := svc.FetchAccounts(instanceId)
+func LoadRedisAccounts(ctx context.Context, svc *service.RedisService, instanceId string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		accounts, err := svc.FetchAccounts(ctx, instanceId)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
@@ -326,68 +823,1051 @@ func LoadRedisAccounts(svc *service.RedisService, instanceId string) tea.Cmd {
 			Accounts:   accounts,
 			InstanceId: instanceId,
 		}
-	}
+	})
 }
 
-// --- RocketMQ Commands ---
-
-// LoadRocketMQInstances creates a command to load RocketMQ instances
-func LoadRocketMQInstances(svc *service.RocketMQService) tea.Cmd {
-	return func() tea.Msg {
-		instances, err := svc.FetchInstances()
+// LoadRedisParameters creates a command to load the parameters of a Redis instance
+func LoadRedisParameters(ctx context.Context, svc *service.RedisService, instanceId string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		params, err := svc.FetchParameters(ctx, instanceId)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
-		return RocketMQInstancesLoadedMsg{Instances: instances}
-	}
+		return RedisParametersLoadedMsg{
+			Parameters: params,
+			InstanceId: instanceId,
+		}
+	})
 }
 
-// LoadRocketMQTopics creates a command to load RocketMQ topics
-func LoadRocketMQTopics(svc *service.RocketMQService, instanceId string) tea.Cmd {
-	return func() tea.Msg {
-		topics, err := svc.FetchTopics(instanceId)
+// LoadRedisBackups creates a command to load the recent backups of a Redis instance
+func LoadRedisBackups(ctx context.Context, svc *service.RedisService, instanceId string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		backups, err := svc.FetchBackups(ctx, instanceId, service.RedisBackupWindow)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
-		return RocketMQTopicsLoadedMsg{
-			Topics:     topics,
+		return RedisBackupsLoadedMsg{
 			InstanceId: instanceId,
+			Backups:    backups,
 		}
-	}
+	})
 }
 
-// LoadRocketMQGroups creates a command to load RocketMQ groups
-func LoadRocketMQGroups(svc *service.RocketMQService, instanceId string) tea.Cmd {
+// TestInstanceConnection creates a command to test the connection to an
+// instance endpoint from the local machine
+func TestInstanceConnection(instanceID string, target service.ConnTestTarget) tea.Cmd {
 	return func() tea.Msg {
-		groups, err := svc.FetchGroups(instanceId)
-		if err != nil {
-			return ErrorMsg{Err: err}
-		}
-		return RocketMQGroupsLoadedMsg{
-			Groups:     groups,
-			InstanceId: instanceId,
-		}
+		return ConnTestedMsg{InstanceID: instanceID, Result: service.TestConnection(target)}
 	}
 }
 
-// --- Resource Finder Commands ---
-
-// FindResources creates a command to find resources by IP or domain
-func FindResources(svc *service.FinderService, query string) tea.Cmd {
-	return func() tea.Msg {
-		// Resolve the query to IPs
-		ips, domain, err := svc.ResolveToIPs(query)
+// LoadBastions creates a command to load the ECS instances a port forward to
+// an instance can go through
+func LoadBastions(ctx context.Context, svc *service.ECSService, req pages.PortForwardRequestMsg) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		instances, err := svc.FetchInstances(ctx)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
+		return BastionsLoadedMsg{Request: req, Instances: instances}
+	})
+}
 
-		// Find matching resources
-		result, err := svc.FindResources(ips, domain)
+// LoadRecentResource creates a command to load a recently viewed resource
+// afresh by ID. Data is nil when the resource no longer exists
+func LoadRecentResource(ctx context.Context, services *Services, item config.RecentResource) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		data, err := fetchRecentResource(ctx, services, item)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
+		return RecentResourceLoadedMsg{Item: item, Data: data}
+	})
+}
+
+// fetchRecentResource fetches a resource of a recently viewed type by ID
+func fetchRecentResource(ctx context.Context, services *Services, item config.RecentResource) (interface{}, error) {
+	switch item.Type {
+	case "ECS":
+		inst, err := services.ECS.FetchInstance(ctx, item.ID, item.Region)
+		if err != nil || inst == nil {
+			return nil, err
+		}
+		return *inst, nil
+	case "SLB":
+		lbs, err := services.SLB.FetchInstances(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, lb := range lbs {
+			if lb.LoadBalancerId == item.ID {
+				return lb, nil
+			}
+		}
+	case "RDS":
+		instances, err := services.RDS.FetchInstances(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, inst := range instances {
+			if inst.DBInstanceId == item.ID {
+				return inst, nil
+			}
+		}
+	case "Redis":
+		instances, err := services.Redis.FetchInstances(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, inst := range instances {
+			if inst.InstanceId == item.ID {
+				return inst, nil
+			}
+		}
+	case "RocketMQ":
+		instances, err := services.RocketMQ.FetchInstances(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, inst := range instances {
+			if inst.InstanceId == item.ID {
+				return inst, nil
+			}
+		}
+	case "MongoDB":
+		instances, err := services.MongoDB.FetchInstances(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, inst := range instances {
+			if inst.DBInstanceId == item.ID {
+				return inst, nil
+			}
+		}
+	case "Elasticsearch":
+		inst, err := services.ES.FetchInstance(ctx, item.ID)
+		if err != nil {
+			return nil, err
+		}
+		return *inst, nil
+	case "Kafka":
+		instances, err := services.Kafka.FetchInstances(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, inst := range instances {
+			if inst.InstanceId == item.ID {
+				return inst, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// ProbeAddress creates a command to probe the latency of a host with ICMP
+// and, when port is set, TCP connects. Both probes run at the same time
+func ProbeAddress(name, host string, port int) tea.Cmd {
+	return func() tea.Msg {
+		msg := ProbedMsg{Name: name}
+		var wg sync.WaitGroup
+		if port > 0 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				tcp := service.ProbeTCPPort(host, port, service.ProbeCount)
+				msg.TCP = &tcp
+			}()
+		}
+		msg.ICMP = service.ProbeICMPHost(host, service.ProbeCount)
+		wg.Wait()
+		return msg
+	}
+}
+
+// CheckDNSPropagation creates a command to ask the public resolvers for a
+// record of domainName
+func CheckDNSPropagation(ctx context.Context, domainName string, record alidns.Record) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		name := dnsRecordFQDN(record.RR, domainName)
+		return DNSPropagationCheckedMsg{
+			Record: record,
+			Result: service.CheckDNSPropagation(ctx, name, record.Type, record.Value),
+		}
+	})
+}
+
+// LoadRDSSlowLogs creates a command to load the slow query summary of an RDS
+// instance over the last days
+func LoadRDSSlowLogs(ctx context.Context, svc *service.RDSService, instanceId string, days int) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		summaries, err := svc.FetchSlowLogSummary(ctx, instanceId, days)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return RDSSlowLogsLoadedMsg{
+			InstanceId: instanceId,
+			Days:       days,
+			Summaries:  summaries,
+		}
+	})
+}
+
+// LoadRDSBackups creates a command to load the recent backup sets of an RDS instance
+func LoadRDSBackups(ctx context.Context, svc *service.RDSService, instanceId string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		backups, err := svc.FetchBackups(ctx, instanceId, service.RDSBackupWindow)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return RDSBackupsLoadedMsg{
+			InstanceId: instanceId,
+			Backups:    backups,
+		}
+	})
+}
+
+// LoadRDSBinlogs creates a command to load the recent binlog files of an RDS instance
+func LoadRDSBinlogs(ctx context.Context, svc *service.RDSService, instanceId string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		files, err := svc.FetchBinlogs(ctx, instanceId, service.RDSBackupWindow)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return RDSBinlogsLoadedMsg{
+			InstanceId: instanceId,
+			Files:      files,
+		}
+	})
+}
+
+// LoadRedisMetrics creates a command to load the performance metrics of a Redis instance
+func LoadRedisMetrics(ctx context.Context, svc *service.RedisService, instanceID string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		series, err := svc.FetchMetrics(ctx, instanceID, pages.MetricsWindow)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return RedisMetricsLoadedMsg{InstanceID: instanceID, Series: series}
+	})
+}
+
+// --- MongoDB Commands ---
+
+// LoadMongoDBInstances creates a command to load MongoDB instances
+func LoadMongoDBInstances(ctx context.Context, svc *service.MongoDBService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		instances, err := svc.FetchInstances(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return MongoDBInstancesLoadedMsg{Instances: instances}
+	})
+}
+
+// LoadMongoDBTopology creates a command to load the topology of a MongoDB
+// instance. Failures are reported in the message, shown in the detail
+// sections the topology fills in
+func LoadMongoDBTopology(ctx context.Context, svc *service.MongoDBService, instanceID string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		topology, err := svc.FetchTopology(ctx, instanceID)
+		return MongoDBTopologyLoadedMsg{InstanceID: instanceID, Topology: topology, Err: err}
+	})
+}
+
+// LoadMongoDBAccounts creates a command to load MongoDB accounts
+func LoadMongoDBAccounts(ctx context.Context, svc *service.MongoDBService, instanceId string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		accounts, err := svc.FetchAccounts(ctx, instanceId)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return MongoDBAccountsLoadedMsg{
+			Accounts:   accounts,
+			InstanceId: instanceId,
+		}
+	})
+}
+
+// --- Maintenance Window Commands ---
+
+// ModifyRDSMaintenanceWindow creates a command to change the maintenance
+// window of an RDS instance
+func ModifyRDSMaintenanceWindow(svc *service.RDSService, instanceID string, window service.MaintenanceWindow) tea.Cmd {
+	return func() tea.Msg {
+		if err := svc.ModifyMaintenanceWindow(instanceID, window); err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return maintenanceWindowChanged(PageRDSDetail, instanceID, window)
+	}
+}
+
+// ModifyRedisMaintenanceWindow creates a command to change the maintenance
+// window of a Redis instance
+func ModifyRedisMaintenanceWindow(svc *service.RedisService, instanceID string, window service.MaintenanceWindow) tea.Cmd {
+	return func() tea.Msg {
+		if err := svc.ModifyMaintenanceWindow(instanceID, window); err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return maintenanceWindowChanged(PageRedisDetail, instanceID, window)
+	}
+}
+
+// ModifyMongoDBMaintenanceWindow creates a command to change the maintenance
+// window of a MongoDB instance
+func ModifyMongoDBMaintenanceWindow(svc *service.MongoDBService, instanceID string, window service.MaintenanceWindow) tea.Cmd {
+	return func() tea.Msg {
+		if err := svc.ModifyMaintenanceWindow(instanceID, window); err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return maintenanceWindowChanged(PageMongoDBDetail, instanceID, window)
+	}
+}
+
+// maintenanceWindowChanged builds the message reporting a changed window
+func maintenanceWindowChanged(page PageType, instanceID string, window service.MaintenanceWindow) MaintenanceWindowChangedMsg {
+	return MaintenanceWindowChangedMsg{
+		Page:       page,
+		InstanceID: instanceID,
+		Message:    fmt.Sprintf(i18n.T(i18n.KeyMaintenanceWindowChanged), instanceID, window),
+	}
+}
+
+// --- Elasticsearch Commands ---
+
+// LoadElasticsearchInstances creates a command to load Elasticsearch instances
+func LoadElasticsearchInstances(ctx context.Context, svc *service.ElasticsearchService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		instances, err := svc.FetchInstances(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return ElasticsearchInstancesLoadedMsg{Instances: instances}
+	})
+}
+
+// --- Kafka Commands ---
+
+// LoadKafkaInstances creates a command to load Kafka instances
+func LoadKafkaInstances(ctx context.Context, svc *service.KafkaService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		instances, err := svc.FetchInstances(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return KafkaInstancesLoadedMsg{Instances: instances}
+	})
+}
+
+// LoadKafkaTopics creates a command to load Kafka topics
+func LoadKafkaTopics(ctx context.Context, svc *service.KafkaService, instanceId string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		topics, err := svc.FetchTopics(ctx, instanceId)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return KafkaTopicsLoadedMsg{
+			Topics:     topics,
+			InstanceId: instanceId,
+		}
+	})
+}
+
+// LoadKafkaGroups creates a command to load Kafka consumer groups with their lag
+func LoadKafkaGroups(ctx context.Context, svc *service.KafkaService, instanceId string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		groups, err := svc.FetchConsumerGroups(ctx, instanceId)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return KafkaGroupsLoadedMsg{
+			Groups:     groups,
+			InstanceId: instanceId,
+		}
+	})
+}
+
+// --- RocketMQ Commands ---
+
+// LoadRocketMQInstances creates a command to load RocketMQ instances
+func LoadRocketMQInstances(ctx context.Context, svc *service.RocketMQService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		instances, err := svc.FetchInstances(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return RocketMQInstancesLoadedMsg{Instances: instances}
+	})
+}
+
+// LoadRocketMQTopics creates a command to load RocketMQ topics with the lag
+// of their subscribed groups
+func LoadRocketMQTopics(ctx context.Context, svc *service.RocketMQService, instanceId string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		topics, err := svc.FetchTopicsWithLag(ctx, instanceId)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return RocketMQTopicsLoadedMsg{
+			Topics:     topics,
+			InstanceId: instanceId,
+		}
+	})
+}
+
+// LoadRocketMQInfo creates a command to load the base info of a RocketMQ
+// instance. Failures are reported in the message, shown in the detail
+// sections the info fills in
+func LoadRocketMQInfo(ctx context.Context, svc *service.RocketMQService, instanceID string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		info, err := svc.FetchInstanceInfo(ctx, instanceID)
+		return RocketMQInfoLoadedMsg{InstanceID: instanceID, Info: info, Err: err}
+	})
+}
+
+// LoadRocketMQGroups creates a command to load RocketMQ groups with their lag
+func LoadRocketMQGroups(ctx context.Context, svc *service.RocketMQService, instanceId string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		groups, err := svc.FetchGroupsWithLag(ctx, instanceId)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return RocketMQGroupsLoadedMsg{
+			Groups:     groups,
+			InstanceId: instanceId,
+		}
+	})
+}
+
+// LoadRocketMQMessages creates a command to load the messages stored in a
+// RocketMQ topic during the last hour
+func LoadRocketMQMessages(ctx context.Context, svc *service.RocketMQService, instanceId, topic string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		messages, err := svc.FetchMessages(ctx, instanceId, topic, time.Hour)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return RocketMQMessagesLoadedMsg{
+			Messages: messages,
+			Topic:    topic,
+		}
+	})
+}
+
+// --- RAM Commands ---
+
+// LoadRAMUsers creates a command to load RAM users with access keys and MFA status
+func LoadRAMUsers(ctx context.Context, svc *service.RAMService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		users, err := svc.FetchDetailedUsers(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return RAMUsersLoadedMsg{Users: users}
+	})
+}
+
+// LoadRAMRoles creates a command to load RAM roles
+func LoadRAMRoles(ctx context.Context, svc *service.RAMService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		roles, err := svc.FetchRoles(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return RAMRolesLoadedMsg{Roles: roles}
+	})
+}
+
+// LoadRAMPolicies creates a command to load RAM policies
+func LoadRAMPolicies(ctx context.Context, svc *service.RAMService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		policies, err := svc.FetchPolicies(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return RAMPoliciesLoadedMsg{Policies: policies}
+	})
+}
+
+// LoadRAMUserPolicies creates a command to load the policies attached to a RAM user
+func LoadRAMUserPolicies(ctx context.Context, svc *service.RAMService, userName string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		policies, err := svc.FetchUserPolicies(ctx, userName)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return RAMUserPoliciesLoadedMsg{
+			Policies: policies,
+			UserName: userName,
+		}
+	})
+}
+
+// LoadRAMRolePolicies creates a command to load the policies attached to a RAM role
+func LoadRAMRolePolicies(ctx context.Context, svc *service.RAMService, roleName string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		policies, err := svc.FetchRolePolicies(ctx, roleName)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return RAMRolePoliciesLoadedMsg{
+			Policies: policies,
+			RoleName: roleName,
+		}
+	})
+}
+
+// LoadSLBAttribute creates a command to load the attributes of an SLB
+// instance for its detail page. A failure is reported in the message, as the
+// page is usable without them
+func LoadSLBAttribute(ctx context.Context, svc *service.SLBService, loadBalancerID string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		attribute, err := svc.FetchListeners(ctx, loadBalancerID)
+		return SLBAttributeLoadedMsg{LoadBalancerID: loadBalancerID, Attribute: attribute, Err: err}
+	})
+}
+
+// LoadInstanceRAMRole creates a command to load the RAM role attached to an
+// ECS instance. Errors are reported in the message, as the role is optional
+func LoadInstanceRAMRole(ctx context.Context, svc *service.ECSService, instanceID, regionID string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		roleName, err := svc.FetchInstanceRAMRole(ctx, instanceID, regionID)
+		return InstanceRAMRoleLoadedMsg{InstanceID: instanceID, RoleName: roleName, Err: err}
+	})
+}
+
+// --- Log Service Commands ---
+
+// LoadSLSProjects creates a command to load Log Service projects
+func LoadSLSProjects(ctx context.Context, svc *service.SLSService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		projects, err := svc.FetchProjects(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return SLSProjectsLoadedMsg{Projects: projects}
+	})
+}
+
+// LoadSLSLogtail creates a command to load a project's Logtail configs and
+// machine groups, matched against the ECS instances of the region
+func LoadSLSLogtail(ctx context.Context, slsSvc *service.SLSService, ecsSvc *service.ECSService, project string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		instances, err := ecsSvc.FetchInstances(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		overview, err := slsSvc.FetchLogtailOverview(ctx, project, instances)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return SLSLogtailLoadedMsg{Overview: overview}
+	})
+}
+
+// --- Cloud Config Commands ---
+
+// LoadConfigRules creates a command to load Cloud Config rules
+func LoadConfigRules(ctx context.Context, svc *service.CloudConfigService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		rules, err := svc.FetchRules(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return ConfigRulesLoadedMsg{Rules: rules}
+	})
+}
+
+// LoadConfigResults creates a command to load the non-compliant resources of a rule
+func LoadConfigResults(ctx context.Context, svc *service.CloudConfigService, ruleID string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		results, err := svc.FetchNonCompliantResources(ctx, ruleID)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return ConfigResultsLoadedMsg{RuleID: ruleID, Results: results}
+	})
+}
+
+// LoadRAMPolicyDetail creates a command to load a policy's default version document
+func LoadRAMPolicyDetail(ctx context.Context, svc *service.RAMService, policyName, policyType string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		detail, err := svc.FetchPolicyDetail(ctx, policyName, policyType)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return RAMPolicyDetailLoadedMsg{Detail: detail}
+	})
+}
+
+// --- Tag Commands ---
+
+// LoadTags creates a command to load the tag keys and values in use
+func LoadTags(ctx context.Context, svc *service.TagService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		tags, err := svc.FetchTags(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return TagsLoadedMsg{Tags: tags}
+	})
+}
+
+// LoadTaggedResources creates a command to load the resources carrying a tag
+func LoadTaggedResources(ctx context.Context, svc *service.TagService, tag service.TagPair) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		resources, err := svc.FetchTaggedResources(ctx, tag.Key, tag.Value)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return TaggedResourcesLoadedMsg{Tag: tag, Resources: resources}
+	})
+}
+
+// --- ALB/NLB Commands ---
+
+// LoadALBLoadBalancers creates a command to load ALB instances
+func LoadALBLoadBalancers(ctx context.Context, svc *service.ALBService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		loadBalancers, err := svc.FetchLoadBalancers(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return ALBLoadBalancersLoadedMsg{LoadBalancers: loadBalancers}
+	})
+}
+
+// LoadALBListeners creates a command to load the listeners of an ALB instance
+func LoadALBListeners(ctx context.Context, svc *service.ALBService, loadBalancerID string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		listeners, err := svc.FetchListeners(ctx, loadBalancerID)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return ALBListenersLoadedMsg{LoadBalancerID: loadBalancerID, Listeners: listeners}
+	})
+}
+
+// LoadALBRules creates a command to load the forwarding rules of an ALB listener
+func LoadALBRules(ctx context.Context, svc *service.ALBService, listenerID string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		rules, err := svc.FetchRules(ctx, listenerID)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return ALBRulesLoadedMsg{ListenerID: listenerID, Rules: rules}
+	})
+}
+
+// LoadALBServerGroups creates a command to load the server groups of an ALB instance
+func LoadALBServerGroups(ctx context.Context, svc *service.ALBService, loadBalancerID string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		groups, err := svc.FetchServerGroups(ctx, loadBalancerID)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return ALBServerGroupsLoadedMsg{LoadBalancerID: loadBalancerID, ServerGroups: groups}
+	})
+}
+
+// LoadNLBLoadBalancers creates a command to load NLB instances
+func LoadNLBLoadBalancers(ctx context.Context, svc *service.NLBService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		loadBalancers, err := svc.FetchLoadBalancers(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return NLBLoadBalancersLoadedMsg{LoadBalancers: loadBalancers}
+	})
+}
+
+// LoadNLBListeners creates a command to load the listeners of an NLB instance
+func LoadNLBListeners(ctx context.Context, svc *service.NLBService, loadBalancerID string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		listeners, err := svc.FetchListeners(ctx, loadBalancerID)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return NLBListenersLoadedMsg{LoadBalancerID: loadBalancerID, Listeners: listeners}
+	})
+}
+
+// LoadNLBServerGroups creates a command to load the server groups of an NLB instance
+func LoadNLBServerGroups(ctx context.Context, svc *service.NLBService, loadBalancerID string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		groups, err := svc.FetchServerGroups(ctx, loadBalancerID)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return NLBServerGroupsLoadedMsg{LoadBalancerID: loadBalancerID, ServerGroups: groups}
+	})
+}
+
+// LoadLBServers creates a command to load the backend servers of an ALB or
+// NLB server group
+func LoadLBServers(ctx context.Context, services *Services, group pages.LBServerGroupNavData) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		var servers []service.LBServer
+		var err error
+		if group.Kind == pages.LBKindNLB {
+			servers, err = services.NLB.FetchServerGroupServers(ctx, group.ServerGroupID)
+		} else {
+			servers, err = services.ALB.FetchServerGroupServers(ctx, group.ServerGroupID)
+		}
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return LBServersLoadedMsg{ServerGroupID: group.ServerGroupID, Servers: servers}
+	})
+}
+
+// --- ACK Commands ---
+
+// LoadACKClusters creates a command to load ACK clusters
+func LoadACKClusters(ctx context.Context, svc *service.ACKService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		clusters, err := svc.FetchClusters(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return ACKClustersLoadedMsg{Clusters: clusters}
+	})
+}
+
+// LoadACKClusterDetail creates a command to load a cluster with its node pools
+func LoadACKClusterDetail(ctx context.Context, svc *service.ACKService, clusterID string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		detail, err := svc.FetchClusterDetail(ctx, clusterID)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return ACKClusterDetailLoadedMsg{Detail: detail}
+	})
+}
+
+// LoadACKNodePools creates a command to load the node pools of a cluster
+func LoadACKNodePools(ctx context.Context, svc *service.ACKService, clusterID string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		nodePools, err := svc.FetchNodePools(ctx, clusterID)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return ACKNodePoolsLoadedMsg{ClusterID: clusterID, NodePools: nodePools}
+	})
+}
+
+// LoadACKNodes creates a command to load the nodes of a node pool
+func LoadACKNodes(ctx context.Context, svc *service.ACKService, nodePool pages.ACKNodePoolNavData) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		nodes, err := svc.FetchNodes(ctx, nodePool.ClusterID, nodePool.NodePoolID)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return ACKNodesLoadedMsg{NodePoolID: nodePool.NodePoolID, Nodes: nodes}
+	})
+}
+
+// OpenACKNodeInstance creates a command opening the detail of the ECS
+// instance backing a cluster node
+func OpenACKNodeInstance(ctx context.Context, svc *service.ECSService, instanceID, regionID string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		instance, err := svc.FetchInstance(ctx, instanceID, regionID)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		if instance == nil {
+			return ErrorMsg{Err: fmt.Errorf(i18n.T(i18n.KeyACKNodeInstanceNotFound), instanceID, regionID)}
+		}
+		return NavigateMsg{Page: PageECSDetail, Data: *instance}
+	})
+}
+
+// --- ACR Commands ---
+
+// LoadACRInstances creates a command to load ACR Enterprise Edition instances
+func LoadACRInstances(ctx context.Context, svc *service.ACRService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		instances, err := svc.FetchInstances(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return ACRInstancesLoadedMsg{Instances: instances}
+	})
+}
+
+// LoadACRNamespaces creates a command to load the namespaces of an instance
+func LoadACRNamespaces(ctx context.Context, svc *service.ACRService, instanceID string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		namespaces, err := svc.FetchNamespaces(ctx, instanceID)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return ACRNamespacesLoadedMsg{InstanceID: instanceID, Namespaces: namespaces}
+	})
+}
+
+// LoadACRRepositories creates a command to load the repositories of a namespace
+func LoadACRRepositories(ctx context.Context, svc *service.ACRService, namespace pages.ACRNamespaceNavData) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		repositories, err := svc.FetchRepositories(ctx, namespace.InstanceID, namespace.Namespace)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return ACRRepositoriesLoadedMsg{Namespace: namespace, Repositories: repositories}
+	})
+}
+
+// LoadACRTags creates a command to load the image tags of a repository
+func LoadACRTags(ctx context.Context, svc *service.ACRService, repo service.ACRRepository) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		tags, err := svc.FetchTags(ctx, repo)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return ACRTagsLoadedMsg{RepoID: repo.RepoID, Tags: tags}
+	})
+}
+
+// --- FC Commands ---
+
+// LoadFCServices creates a command to load Function Compute services
+func LoadFCServices(ctx context.Context, svc *service.FCService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		services, err := svc.FetchServices(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return FCServicesLoadedMsg{Services: services}
+	})
+}
+
+// LoadFCFunctions creates a command to load the functions of a service
+func LoadFCFunctions(ctx context.Context, svc *service.FCService, serviceName string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		functions, err := svc.FetchFunctions(ctx, serviceName)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return FCFunctionsLoadedMsg{ServiceName: serviceName, Functions: functions}
+	})
+}
+
+// LoadFCFunctionStats creates a command to load the invocation counts of the
+// functions of a service. A failure is reported in the message rather than
+// as an error, as the functions are usable without them
+func LoadFCFunctionStats(ctx context.Context, svc *service.FCService, monitor *service.MonitorService, serviceName string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		accountID, err := svc.AccountID(ctx)
+		if err != nil {
+			return FCFunctionStatsLoadedMsg{ServiceName: serviceName, Err: err}
+		}
+		stats, err := monitor.FetchFCInvocationStats(ctx, accountID, svc.Region(), serviceName)
+		return FCFunctionStatsLoadedMsg{ServiceName: serviceName, Stats: stats, Err: err}
+	})
+}
+
+// --- NAT Commands ---
+
+// LoadNATGateways creates a command to load NAT gateways
+func LoadNATGateways(ctx context.Context, svc *service.NATService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		gateways, err := svc.FetchNATGateways(ctx)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return NATGatewaysLoadedMsg{Gateways: gateways}
+	})
+}
+
+// LoadSNATEntries creates a command to load the entries of a NAT gateway's SNAT tables
+func LoadSNATEntries(ctx context.Context, svc *service.NATService, gateway vpc.NatGateway) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		var entries []vpc.SnatTableEntry
+		for _, tableID := range gateway.SnatTableIds.SnatTableId {
+			tableEntries, err := svc.FetchSNATEntries(ctx, tableID)
+			if err != nil {
+				return ErrorMsg{Err: err}
+			}
+			entries = append(entries, tableEntries...)
+		}
+		return SNATEntriesLoadedMsg{GatewayID: gateway.NatGatewayId, Entries: entries}
+	})
+}
+
+// LoadDNATEntries creates a command to load the entries of a NAT gateway's DNAT tables
+func LoadDNATEntries(ctx context.Context, svc *service.NATService, gateway vpc.NatGateway) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		var entries []vpc.ForwardTableEntry
+		for _, tableID := range gateway.ForwardTableIds.ForwardTableId {
+			tableEntries, err := svc.FetchDNATEntries(ctx, tableID)
+			if err != nil {
+				return ErrorMsg{Err: err}
+			}
+			entries = append(entries, tableEntries...)
+		}
+		return DNATEntriesLoadedMsg{Gateway: gateway, Entries: entries}
+	})
+}
+
+// OpenDNATTarget creates a command opening the detail of the ECS instance
+// a DNAT entry forwards to
+func OpenDNATTarget(ctx context.Context, svc *service.ECSService, vpcID, internalIP string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		instance, err := svc.FetchInstanceByPrivateIP(ctx, vpcID, internalIP)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		if instance == nil {
+			return ErrorMsg{Err: fmt.Errorf(i18n.T(i18n.KeyDNATTargetNotFound), internalIP, vpcID)}
+		}
+		return NavigateMsg{Page: PageECSDetail, Data: *instance}
+	})
+}
+
+// --- Billing Commands ---
+
+// LoadBilling creates a command to load the billing overview of the current month
+func LoadBilling(ctx context.Context, svc *service.BillingService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		overview, err := svc.FetchOverview(ctx, time.Now())
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return BillingLoadedMsg{Overview: overview}
+	})
+}
+
+// LoadInstanceCost creates a command to load the month-to-date cost of an
+// instance. Errors are reported in the message, as the cost is optional
+func LoadInstanceCost(ctx context.Context, svc *service.BillingService, instanceID string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		cost, err := svc.FetchInstanceCost(ctx, instanceID, time.Now())
+		return InstanceCostLoadedMsg{InstanceID: instanceID, Cost: cost, Err: err}
+	})
+}
+
+// --- Recycle Bin Commands ---
+
+// LoadRecycleBin creates a command to list the recycled ECS and RDS instances.
+// A product that cannot be listed does not hide the other one
+func LoadRecycleBin(ctx context.Context, ecsSvc *service.ECSService, rdsSvc *service.RDSService) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		ecsResources, ecsErr := ecsSvc.FetchRecycledInstances(ctx)
+		rdsResources, rdsErr := rdsSvc.FetchRecycledInstances(ctx)
+		if ecsErr != nil && rdsErr != nil {
+			return ErrorMsg{Err: errors.Join(ecsErr, rdsErr)}
+		}
+
+		msg := RecycleBinLoadedMsg{Resources: append(ecsResources, rdsResources...)}
+		switch {
+		case ecsErr != nil:
+			msg.Err = fmt.Errorf(i18n.T(i18n.KeyRecyclePartial), service.RecycledProductECS, ecsErr)
+		case rdsErr != nil:
+			msg.Err = fmt.Errorf(i18n.T(i18n.KeyRecyclePartial), service.RecycledProductRDS, rdsErr)
+		}
+		service.SortRecycledResources(msg.Resources)
+		return msg
+	})
+}
+
+// CancelECSAutoRelease creates a command to cancel the scheduled release of
+// an ECS instance
+func CancelECSAutoRelease(svc *service.ECSService, instanceId string) tea.Cmd {
+	return func() tea.Msg {
+		if err := svc.CancelAutoRelease(instanceId); err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return ECSReleaseCancelledMsg{InstanceId: instanceId}
+	}
+}
+
+// --- Resource Finder Commands ---
+
+// FindResources creates a job finding resources by IP or domain, recording
+// how far each section got in progress
+func FindResources(svc *service.FinderService, query string, progress *service.FindProgress) jobRunner {
+	return func(ctx context.Context, report jobs.Reporter) (tea.Msg, error) {
+		// Resolve the query to IPs
+		ips, domain, err := svc.ResolveToIPs(ctx, query)
+		if err != nil {
+			return ErrorMsg{Err: err}, err
+		}
+
+		// Find matching resources, counting finished sections as the job's progress
+		progress.Notify(func(finished, sections int) {
+			report(int64(finished), int64(sections))
+		})
+		result, err := svc.FindResourcesWithProgress(ctx, ips, domain, progress)
+		if err != nil {
+			return ErrorMsg{Err: err}, err
+		}
+
+		return FindResourceResultMsg{Result: result}, nil
+	}
+}
+
+// finderProfileConcurrency bounds the profiles searched at the same time
+const finderProfileConcurrency = 4
+
+// newFinderService creates a finder service searching the given services
+func newFinderService(s *Services) *service.FinderService {
+	finder := service.NewFinderService(
+		s.ECS, s.DNS, s.SLB,
+		s.RDS, s.Redis, s.RocketMQ,
+		s.EIP, s.NAT,
+	)
+	finder.SetPermissions(s.Permissions)
+	return finder
+}
+
+// FindResourcesInProfiles runs a finder query in each of the given profiles,
+// each with its own client set, and returns the results in profile order
+func FindResourcesInProfiles(ctx context.Context, profiles []string, query string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		results := make([]service.ProfileFindResult, len(profiles))
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, finderProfileConcurrency)
+
+		for i, profile := range profiles {
+			wg.Add(1)
+			go func(idx int, profile string) {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+				results[idx] = findInProfile(ctx, profile, query)
+			}(i, profile)
+		}
+
+		wg.Wait()
+		return FindProfilesResultMsg{Query: query, Results: results}
+	})
+}
+
+// findInProfile runs a finder query with the credentials and region of a
+// profile, without making it the current one
+func findInProfile(ctx context.Context, profile, query string) service.ProfileFindResult {
+	found := service.ProfileFindResult{Profile: profile}
+
+	cfg, err := config.LoadProfileConfig(profile, "")
+	if err != nil {
+		found.Err = err
+		return found
+	}
+	found.RegionID = cfg.RegionID
+
+	clients, err := client.NewAliyunClients(client.NewProfileConfig(cfg))
+	if err != nil {
+		found.Err = fmt.Errorf("creating clients: %w", err)
+		return found
+	}
 
-		return FindResourceResultMsg{Result: result}
+	svc := newFinderService(buildServices(clients))
+	ips, domain, err := svc.ResolveToIPs(ctx, query)
+	if err != nil {
+		found.Err = err
+		return found
 	}
+	found.Result, found.Err = svc.FindResources(ctx, ips, domain)
+	return found
 }
 
@@ -0,0 +1,130 @@
+package components
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+
+	"aliyun-tui-viewer/internal/theme"
+)
+
+// barBlocks are the partial block glyphs used for sub-cell bar precision
+var barBlocks = []string{"", "▏", "▎", "▍", "▌", "▋", "▊", "▉"}
+
+// unitScales maps size suffixes to their multiplier so "512 MB" and "1.5 GB"
+// are comparable within the same column
+var unitScales = map[string]float64{
+	"B":   1,
+	"KB":  1024,
+	"KIB": 1024,
+	"MB":  1024 * 1024,
+	"MIB": 1024 * 1024,
+	"GB":  1024 * 1024 * 1024,
+	"GIB": 1024 * 1024 * 1024,
+	"TB":  1024 * 1024 * 1024 * 1024,
+	"TIB": 1024 * 1024 * 1024 * 1024,
+}
+
+// barStat holds the per-column data needed to scale bars
+type barStat struct {
+	max       float64
+	textWidth int
+}
+
+// parseNumericCell extracts the magnitude of a cell such as "40", "1,024" or "1.50 GB"
+func parseNumericCell(s string) (float64, bool) {
+	s = strings.ReplaceAll(strings.TrimSpace(s), ",", "")
+	end := 0
+	for end < len(s) && (s[end] >= '0' && s[end] <= '9' || s[end] == '.' || (end == 0 && s[end] == '-')) {
+		end++
+	}
+	if end == 0 {
+		return 0, false
+	}
+
+	value, err := strconv.ParseFloat(s[:end], 64)
+	if err != nil {
+		return 0, false
+	}
+	if scale, ok := unitScales[strings.ToUpper(strings.TrimSpace(s[end:]))]; ok {
+		value *= scale
+	}
+	return value, true
+}
+
+// renderBar renders a horizontal bar of the given cell width filled to ratio
+func renderBar(ratio float64, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	ratio = math.Max(0, math.Min(1, ratio))
+
+	eighths := int(math.Round(ratio * float64(width*8)))
+	full := eighths / 8
+	bar := strings.Repeat("█", full) + barBlocks[eighths%8]
+	return bar + strings.Repeat(" ", width-runewidth.StringWidth(bar))
+}
+
+// computeBarStats recalculates the scale of every bar column from the current rows
+func (m *TableModel) computeBarStats() {
+	m.barStats = make(map[int]barStat, len(m.barColumns))
+	for colIdx := range m.barColumns {
+		var stat barStat
+		for _, row := range m.rows {
+			if colIdx >= len(row) {
+				continue
+			}
+			if value, ok := parseNumericCell(row[colIdx]); ok && value > stat.max {
+				stat.max = value
+			}
+			if w := runewidth.StringWidth(row[colIdx]); w > stat.textWidth {
+				stat.textWidth = w
+			}
+		}
+		m.barStats[colIdx] = stat
+	}
+}
+
+// renderBarCell renders a numeric cell as right-aligned text followed by a bar
+// scaled against the column maximum. ok is false when the cell can't carry a bar.
+func (m TableModel) renderBarCell(colIdx int, content string, width int, isSelected bool) (string, bool) {
+	stat, exists := m.barStats[colIdx]
+	if !exists || stat.max <= 0 {
+		return "", false
+	}
+	value, parsed := parseNumericCell(content)
+	barWidth := width - stat.textWidth - 1
+	if !parsed || barWidth < 2 {
+		return "", false
+	}
+
+	text := strings.Repeat(" ", stat.textWidth-runewidth.StringWidth(content)) + content
+	if m.searchQuery != "" {
+		text = m.highlightSearchMatch(text)
+	}
+
+	bar := renderBar(value/stat.max, barWidth)
+	if !isSelected {
+		bar = m.styles.Bar.Render(bar)
+	}
+	return text + " " + bar, true
+}
+
+// SetBarColumns enables inline bar rendering for the given numeric column indices.
+// The column needs enough width to fit both the value and a short bar.
+func (m TableModel) SetBarColumns(columns ...int) TableModel {
+	m.barColumns = make(map[int]bool, len(columns))
+	for _, col := range columns {
+		m.barColumns[col] = true
+	}
+	m.computeBarStats()
+	return m
+}
+
+// defaultBarStyle returns the style used for inline bars
+func defaultBarStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(theme.Current().Primary)
+}
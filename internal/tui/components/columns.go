@@ -0,0 +1,284 @@
+package components
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"aliyun-tui-viewer/internal/config"
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/theme"
+)
+
+// ColumnChooserRequestMsg asks the app to open the column chooser on the
+// columns of the current page's table
+type ColumnChooserRequestMsg struct {
+	Columns  []table.Column
+	Visible  []int // Indices of the columns shown, in order
+	Defaults []int // Indices of the columns shown by default
+}
+
+// ColumnsChosenMsg is sent when the column chooser is saved. Layout is nil
+// when the default columns were restored
+type ColumnsChosenMsg struct {
+	Layout *config.ColumnLayout
+}
+
+// ColumnLayoutMsg sets the columns a table shows, nil for its defaults.
+// Pages pass it on to their table like any other message
+type ColumnLayoutMsg struct {
+	Layout *config.ColumnLayout
+}
+
+// SetHiddenColumns hides columns, by index, unless the column layout shows
+// them, e.g. for details only some want in the list
+func (m TableModel) SetHiddenColumns(columns ...int) TableModel {
+	m.hiddenColumns = make(map[int]bool, len(columns))
+	for _, col := range columns {
+		m.hiddenColumns[col] = true
+	}
+	return m
+}
+
+// SetColumnLayout shows the columns of layout in its order, or the default
+// columns for nil. Columns added since the layout was saved show at the end
+func (m TableModel) SetColumnLayout(layout *config.ColumnLayout) TableModel {
+	if layout != nil {
+		copied := *layout
+		copied.Visible = slices.Clone(layout.Visible)
+		layout = &copied
+	}
+	m.layout = layout
+	return m
+}
+
+// sameLayout reports whether layout is the one the table shows
+func (m TableModel) sameLayout(layout *config.ColumnLayout) bool {
+	if m.layout == nil || layout == nil {
+		return m.layout == layout
+	}
+	return m.layout.Count == layout.Count && slices.Equal(m.layout.Visible, layout.Visible)
+}
+
+// defaultColumns returns the indices of the columns shown by default
+func (m TableModel) defaultColumns() []int {
+	columns := make([]int, 0, len(m.columns))
+	for i := range m.columns {
+		if !m.hiddenColumns[i] {
+			columns = append(columns, i)
+		}
+	}
+	return columns
+}
+
+// visibleColumns returns the indices of the columns shown, in order
+func (m TableModel) visibleColumns() []int {
+	if m.layout == nil {
+		return m.defaultColumns()
+	}
+	seen := make(map[int]bool, len(m.columns))
+	columns := make([]int, 0, len(m.columns))
+	for _, col := range m.layout.Visible {
+		if col >= 0 && col < len(m.columns) && !seen[col] {
+			seen[col] = true
+			columns = append(columns, col)
+		}
+	}
+	for col := m.layout.Count; col < len(m.columns); col++ {
+		if !seen[col] && !m.hiddenColumns[col] {
+			columns = append(columns, col)
+		}
+	}
+	if len(columns) == 0 {
+		return m.defaultColumns()
+	}
+	return columns
+}
+
+// requestColumnChooser asks the app to open the column chooser
+func (m TableModel) requestColumnChooser() tea.Cmd {
+	if len(m.columns) == 0 {
+		return nil
+	}
+	req := ColumnChooserRequestMsg{
+		Columns:  m.columns,
+		Visible:  m.visibleColumns(),
+		Defaults: m.defaultColumns(),
+	}
+	return func() tea.Msg { return req }
+}
+
+// ColumnChooserModel represents the column chooser overlay: every column of
+// a table, checked when shown, in the order they show
+type ColumnChooserModel struct {
+	Visible  bool
+	title    string
+	columns  []table.Column
+	defaults []int
+	order    []int // Column indices, shown ones first
+	shown    map[int]bool
+	reset    bool // Order and shown are the defaults, saved as no layout
+	cursor   int
+	styles   ColumnChooserStyles
+}
+
+// ColumnChooserStyles defines styles for the column chooser
+type ColumnChooserStyles struct {
+	Border   lipgloss.Style
+	Title    lipgloss.Style
+	Column   lipgloss.Style
+	Hidden   lipgloss.Style
+	Selected lipgloss.Style
+	Hint     lipgloss.Style
+}
+
+// DefaultColumnChooserStyles returns default column chooser styles
+func DefaultColumnChooserStyles() ColumnChooserStyles {
+	th := theme.Current()
+	return ColumnChooserStyles{
+		Border: lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(th.Primary).
+			Padding(0, 2),
+		Title: lipgloss.NewStyle().
+			Foreground(th.Primary).
+			Bold(true),
+		Column: lipgloss.NewStyle().
+			Foreground(th.Text),
+		Hidden: lipgloss.NewStyle().
+			Foreground(th.MutedText),
+		Selected: lipgloss.NewStyle().
+			Foreground(th.OnPrimary).
+			Background(th.Primary).
+			Bold(true),
+		Hint: lipgloss.NewStyle().
+			Foreground(th.MutedText),
+	}
+}
+
+// NewColumnChooserModel creates a visible column chooser for the columns of
+// req, titled after the page
+func NewColumnChooserModel(title string, req ColumnChooserRequestMsg) ColumnChooserModel {
+	m := ColumnChooserModel{
+		Visible:  true,
+		title:    title,
+		columns:  req.Columns,
+		defaults: req.Defaults,
+		styles:   DefaultColumnChooserStyles(),
+	}
+	m.arrange(req.Visible)
+	return m
+}
+
+// arrange lists the visible columns first, in order, then the others
+func (m *ColumnChooserModel) arrange(visible []int) {
+	m.shown = make(map[int]bool, len(m.columns))
+	m.order = make([]int, 0, len(m.columns))
+	for _, col := range visible {
+		m.shown[col] = true
+		m.order = append(m.order, col)
+	}
+	for col := range m.columns {
+		if !m.shown[col] {
+			m.order = append(m.order, col)
+		}
+	}
+}
+
+// Update implements tea.Model
+func (m ColumnChooserModel) Update(msg tea.Msg) (ColumnChooserModel, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch keyMsg.String() {
+	case "esc", "q":
+		m.Visible = false
+	case "enter":
+		m.Visible = false
+		chosen := ColumnsChosenMsg{}
+		if !m.reset {
+			chosen.Layout = m.layout()
+		}
+		return m, func() tea.Msg { return chosen }
+	case "j", "down":
+		m.cursor = min(m.cursor+1, len(m.order)-1)
+	case "k", "up":
+		m.cursor = max(m.cursor-1, 0)
+	case " ", "x":
+		col := m.order[m.cursor]
+		// A table shows at least one column
+		if !m.shown[col] || m.shownCount() > 1 {
+			m.shown[col] = !m.shown[col]
+			m.reset = false
+		}
+	case "J", "shift+down":
+		if m.cursor < len(m.order)-1 {
+			m.order[m.cursor], m.order[m.cursor+1] = m.order[m.cursor+1], m.order[m.cursor]
+			m.cursor++
+			m.reset = false
+		}
+	case "K", "shift+up":
+		if m.cursor > 0 {
+			m.order[m.cursor], m.order[m.cursor-1] = m.order[m.cursor-1], m.order[m.cursor]
+			m.cursor--
+			m.reset = false
+		}
+	case "r":
+		m.arrange(m.defaults)
+		m.reset = true
+	}
+	return m, nil
+}
+
+// shownCount returns how many columns are checked
+func (m ColumnChooserModel) shownCount() int {
+	count := 0
+	for _, shown := range m.shown {
+		if shown {
+			count++
+		}
+	}
+	return count
+}
+
+// layout returns the checked columns in the order they are listed
+func (m ColumnChooserModel) layout() *config.ColumnLayout {
+	layout := &config.ColumnLayout{Count: len(m.columns)}
+	for _, col := range m.order {
+		if m.shown[col] {
+			layout.Visible = append(layout.Visible, col)
+		}
+	}
+	return layout
+}
+
+// View renders the columns with their check boxes above the keys
+func (m ColumnChooserModel) View() string {
+	lines := make([]string, len(m.order))
+	for i, col := range m.order {
+		check, style := "[ ]", m.styles.Hidden
+		if m.shown[col] {
+			check, style = "[x]", m.styles.Column
+		}
+		line := fmt.Sprintf("%s %s", check, m.columns[col].Title)
+		if i == m.cursor {
+			style = m.styles.Selected
+		}
+		lines[i] = style.Render(line)
+	}
+
+	return m.styles.Border.Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		m.styles.Title.Render(fmt.Sprintf(i18n.T(i18n.KeyColumnsTitle), m.title)),
+		"",
+		strings.Join(lines, "\n"),
+		"",
+		m.styles.Hint.Render(i18n.T(i18n.KeyColumnsHint)),
+	))
+}
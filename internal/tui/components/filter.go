@@ -0,0 +1,85 @@
+package components
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+)
+
+// filterTerm is one whitespace-separated token of a filter query. A column of
+// -1 matches the value against every cell of the row
+type filterTerm struct {
+	column int
+	value  string
+}
+
+// normalizeColumnName folds a column title or filter key so "Zone ID",
+// "zone_id" and "zone-id" all compare equal
+func normalizeColumnName(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '_', '-', '/':
+			return -1
+		}
+		return r
+	}, strings.ToLower(s))
+}
+
+// resolveFilterColumn finds the column named by key, preferring an exact
+// title over a prefix match
+func resolveFilterColumn(columns []table.Column, key string) int {
+	key = normalizeColumnName(key)
+	if key == "" {
+		return -1
+	}
+	for i, col := range columns {
+		if normalizeColumnName(col.Title) == key {
+			return i
+		}
+	}
+	for i, col := range columns {
+		if strings.HasPrefix(normalizeColumnName(col.Title), key) {
+			return i
+		}
+	}
+	return -1
+}
+
+// parseFilterQuery splits a query such as "status:Running web" into terms.
+// A col:value token whose column is unknown is treated as plain text
+func parseFilterQuery(columns []table.Column, query string) []filterTerm {
+	var terms []filterTerm
+	for _, token := range strings.Fields(query) {
+		term := filterTerm{column: -1, value: strings.ToLower(token)}
+		if name, value, ok := strings.Cut(token, ":"); ok && value != "" {
+			if col := resolveFilterColumn(columns, name); col >= 0 {
+				term = filterTerm{column: col, value: strings.ToLower(value)}
+			}
+		}
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// matches reports whether row satisfies the term
+func (t filterTerm) matches(row table.Row) bool {
+	if t.column >= 0 {
+		return t.column < len(row) && strings.Contains(strings.ToLower(row[t.column]), t.value)
+	}
+	for _, cell := range row {
+		if strings.Contains(strings.ToLower(cell), t.value) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAll reports whether row satisfies every term
+func matchesAll(terms []filterTerm, row table.Row) bool {
+	for _, term := range terms {
+		if !term.matches(row) {
+			return false
+		}
+	}
+	return true
+}
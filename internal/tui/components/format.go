@@ -0,0 +1,203 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+)
+
+// ColumnFormat names how the values of a column are turned into cell text
+type ColumnFormat string
+
+// Built-in column formats
+const (
+	FormatText      ColumnFormat = ""         // fmt.Sprint of the value
+	FormatSize      ColumnFormat = "size"     // Byte counts, e.g. "1.50 GB"
+	FormatDuration  ColumnFormat = "duration" // time.Duration or milliseconds, e.g. "1m30s"
+	FormatCurrency  ColumnFormat = "currency" // Amounts with two decimals, e.g. "1,234.50"
+	FormatPercent   ColumnFormat = "percent"  // Percentages of 100, e.g. "42.5%"
+	FormatIPList    ColumnFormat = "ip_list"  // []string of addresses, comma separated
+	FormatTimestamp ColumnFormat = "time"     // time.Time or API timestamps, as the config file "time_format" asks
+)
+
+// CellFormatter turns the value of a cell into its text
+type CellFormatter func(value interface{}) string
+
+// formatters is the registry of column formats. Values a formatter does not
+// handle, such as a string already formatted by the page, are shown as is
+var formatters = map[ColumnFormat]CellFormatter{
+	FormatText:      formatText,
+	FormatSize:      formatSizeCell,
+	FormatDuration:  formatDurationCell,
+	FormatCurrency:  formatCurrencyCell,
+	FormatPercent:   formatPercentCell,
+	FormatIPList:    formatIPListCell,
+	FormatTimestamp: formatTimeCell,
+}
+
+// RegisterFormatter adds a column format, or replaces the formatter of an
+// existing one
+func RegisterFormatter(format ColumnFormat, formatter CellFormatter) {
+	formatters[format] = formatter
+}
+
+// FormatCell formats value as the cells of a column with the given format
+func FormatCell(format ColumnFormat, value interface{}) string {
+	if value == nil {
+		return "-"
+	}
+	if formatter, ok := formatters[format]; ok {
+		return formatter(value)
+	}
+	return formatText(value)
+}
+
+// ColumnSpec declares a table column with the format of its values
+type ColumnSpec struct {
+	Title  string
+	Width  int
+	Format ColumnFormat
+}
+
+// NewTableModelFromSpecs creates a table whose columns format the values
+// given to SetValues by their spec
+func NewTableModelFromSpecs(specs []ColumnSpec, title string) TableModel {
+	columns := make([]table.Column, len(specs))
+	formats := make([]ColumnFormat, len(specs))
+	for i, spec := range specs {
+		columns[i] = table.Column{Title: spec.Title, Width: spec.Width}
+		formats[i] = spec.Format
+	}
+	m := NewTableModel(columns, title)
+	m.formats = formats
+	return m
+}
+
+// SetColumnFormat sets the format of a column for SetValues
+func (m TableModel) SetColumnFormat(column int, format ColumnFormat) TableModel {
+	if column < 0 || column >= len(m.columns) {
+		return m
+	}
+	formats := make([]ColumnFormat, len(m.columns))
+	copy(formats, m.formats)
+	formats[column] = format
+	m.formats = formats
+	return m
+}
+
+// SetValues sets the rows from raw cell values, formatting each by the format
+// of its column
+func (m TableModel) SetValues(values [][]interface{}) TableModel {
+	rows := make([]table.Row, len(values))
+	for i, cells := range values {
+		row := make(table.Row, len(cells))
+		for col, value := range cells {
+			format := FormatText
+			if col < len(m.formats) {
+				format = m.formats[col]
+			}
+			row[col] = FormatCell(format, value)
+		}
+		rows[i] = row
+	}
+	return m.SetRows(rows)
+}
+
+// FormatBytes formats a byte count as a human readable size
+func FormatBytes(size int64) string {
+	const (
+		KB = 1024
+		MB = KB * 1024
+		GB = MB * 1024
+	)
+
+	switch {
+	case size >= GB:
+		return fmt.Sprintf("%.2f GB", float64(size)/float64(GB))
+	case size >= MB:
+		return fmt.Sprintf("%.2f MB", float64(size)/float64(MB))
+	case size >= KB:
+		return fmt.Sprintf("%.2f KB", float64(size)/float64(KB))
+	default:
+		return fmt.Sprintf("%d B", size)
+	}
+}
+
+// FormatAmount formats an amount of money with two decimals and thousands
+// separators, e.g. 1234.5 as "1,234.50"
+func FormatAmount(amount float64) string {
+	s := fmt.Sprintf("%.2f", amount)
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	whole, frac, _ := strings.Cut(s, ".")
+	for i := len(whole) - 3; i > 0; i -= 3 {
+		whole = whole[:i] + "," + whole[i:]
+	}
+	return sign + whole + "." + frac
+}
+
+func formatText(value interface{}) string {
+	return fmt.Sprint(value)
+}
+
+func formatSizeCell(value interface{}) string {
+	switch v := value.(type) {
+	case int64:
+		return FormatBytes(v)
+	case int:
+		return FormatBytes(int64(v))
+	case float64:
+		return FormatBytes(int64(v))
+	default:
+		return formatText(value)
+	}
+}
+
+// formatDurationCell rounds durations to the second; integers are taken as
+// milliseconds, the unit most APIs report delays in
+func formatDurationCell(value interface{}) string {
+	var d time.Duration
+	switch v := value.(type) {
+	case time.Duration:
+		d = v
+	case int64:
+		d = time.Duration(v) * time.Millisecond
+	case int:
+		d = time.Duration(v) * time.Millisecond
+	default:
+		return formatText(value)
+	}
+	if d <= 0 {
+		return "0s"
+	}
+	return d.Round(time.Second).String()
+}
+
+func formatCurrencyCell(value interface{}) string {
+	if v, ok := value.(float64); ok {
+		return FormatAmount(v)
+	}
+	return formatText(value)
+}
+
+func formatPercentCell(value interface{}) string {
+	if v, ok := value.(float64); ok {
+		return fmt.Sprintf("%.1f%%", v)
+	}
+	return formatText(value)
+}
+
+func formatIPListCell(value interface{}) string {
+	ips, ok := value.([]string)
+	if !ok {
+		return formatText(value)
+	}
+	if len(ips) == 0 {
+		return "-"
+	}
+	return strings.Join(ips, ", ")
+}
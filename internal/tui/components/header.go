@@ -6,15 +6,19 @@ import (
 	"github.com/charmbracelet/lipgloss"
 
 	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/theme"
 )
 
 // HeaderModel represents the header bar at the top
 type HeaderModel struct {
-	title   string
-	profile string
-	region  string
-	width   int
-	styles  HeaderStyles
+	title    string
+	profile  string
+	role     string // Role assumed by the profile, if any
+	region   string
+	readOnly bool
+	width    int
+	tabs     TabsModel // Shown in the line below the header
+	styles   HeaderStyles
 }
 
 // HeaderStyles defines styles for the header
@@ -23,24 +27,29 @@ type HeaderStyles struct {
 	Title      lipgloss.Style
 	Profile    lipgloss.Style
 	Region     lipgloss.Style
+	ReadOnly   lipgloss.Style
 	Separator  lipgloss.Style
 }
 
 // DefaultHeaderStyles returns default header styles
 func DefaultHeaderStyles() HeaderStyles {
+	th := theme.Current()
 	return HeaderStyles{
 		Background: lipgloss.NewStyle().
-			Background(lipgloss.Color("#1F2937")).
-			Foreground(lipgloss.Color("#E5E7EB")),
+			Background(th.Bar).
+			Foreground(th.Text),
 		Title: lipgloss.NewStyle().
 			Bold(true).
-			Foreground(lipgloss.Color("#7C3AED")),
+			Foreground(th.Primary),
 		Profile: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#06B6D4")),
+			Foreground(th.Secondary),
 		Region: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#10B981")),
+			Foreground(th.Success),
+		ReadOnly: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(th.Accent),
 		Separator: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#6B7280")),
+			Foreground(th.MutedText),
 	}
 }
 
@@ -50,6 +59,7 @@ func NewHeaderModel(title, profile, region string) HeaderModel {
 		title:   title,
 		profile: profile,
 		region:  region,
+		tabs:    NewTabsModel(),
 		styles:  DefaultHeaderStyles(),
 	}
 }
@@ -66,15 +76,34 @@ func (m HeaderModel) SetProfile(profile string) HeaderModel {
 	return m
 }
 
+// SetRole sets the RAM role the current profile assumes, "" for none
+func (m HeaderModel) SetRole(role string) HeaderModel {
+	m.role = role
+	return m
+}
+
+// SetReadOnly sets whether changes to cloud resources are disabled
+func (m HeaderModel) SetReadOnly(readOnly bool) HeaderModel {
+	m.readOnly = readOnly
+	return m
+}
+
 // SetRegion sets the current region
 func (m HeaderModel) SetRegion(region string) HeaderModel {
 	m.region = region
 	return m
 }
 
+// SetTabs sets the titles of the open tabs and which of them is current
+func (m HeaderModel) SetTabs(titles []string, active int) HeaderModel {
+	m.tabs = m.tabs.SetTabs(titles, active)
+	return m
+}
+
 // SetWidth sets the header width
 func (m HeaderModel) SetWidth(width int) HeaderModel {
 	m.width = width
+	m.tabs = m.tabs.SetWidth(width)
 	return m
 }
 
@@ -85,10 +114,17 @@ func (m HeaderModel) View() string {
 
 	// Profile and Region
 	sep := m.styles.Separator.Render(" | ")
-	profilePart := m.styles.Profile.Render(fmt.Sprintf("%s: %s", i18n.T(i18n.KeyHeaderProfile), m.profile))
+	profile := fmt.Sprintf("%s: %s", i18n.T(i18n.KeyHeaderProfile), m.profile)
+	if m.role != "" {
+		profile += fmt.Sprintf(" (%s: %s)", i18n.T(i18n.KeyHeaderRole), m.role)
+	}
+	profilePart := m.styles.Profile.Render(profile)
 	regionPart := m.styles.Region.Render(fmt.Sprintf("%s: %s", i18n.T(i18n.KeyHeaderRegion), m.region))
 
 	content := titlePart + sep + profilePart + sep + regionPart
+	if m.readOnly {
+		content += sep + m.styles.ReadOnly.Render(i18n.T(i18n.KeyHeaderReadOnly))
+	}
 
 	// Pad to full width
 	contentLen := lipgloss.Width(content)
@@ -98,9 +134,9 @@ func (m HeaderModel) View() string {
 			Render("")
 	}
 
-	// Add empty line after header for spacing
+	// Add empty line after header for spacing, where the tabs show if any
 	return m.styles.Background.
 		Width(m.width).
-		Render(content) + "\n"
+		Render(content) + "\n" + m.tabs.View()
 }
 
@@ -0,0 +1,270 @@
+package components
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/theme"
+)
+
+// HelpSection is a category of keys listed by the help overlay
+type HelpSection struct {
+	Title string
+	Keys  []key.Binding
+}
+
+// HelpModel represents the full-screen help overlay: the keys of a page by
+// category, searchable by key or description
+type HelpModel struct {
+	Visible  bool
+	title    string
+	sections []HelpSection
+	input    textinput.Model
+	offset   int // First line shown
+	width    int
+	height   int
+	styles   HelpStyles
+}
+
+// HelpStyles defines styles for the help overlay
+type HelpStyles struct {
+	Border  lipgloss.Style
+	Title   lipgloss.Style
+	Section lipgloss.Style
+	Key     lipgloss.Style
+	Desc    lipgloss.Style
+	Hint    lipgloss.Style
+}
+
+// DefaultHelpStyles returns default help overlay styles
+func DefaultHelpStyles() HelpStyles {
+	th := theme.Current()
+	return HelpStyles{
+		Border: lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(th.Primary).
+			Padding(0, 2),
+		Title: lipgloss.NewStyle().
+			Foreground(th.Primary).
+			Bold(true),
+		Section: lipgloss.NewStyle().
+			Foreground(th.Accent).
+			Bold(true),
+		Key: lipgloss.NewStyle().
+			Foreground(th.Success).
+			Bold(true),
+		Desc: lipgloss.NewStyle().
+			Foreground(th.Text),
+		Hint: lipgloss.NewStyle().
+			Foreground(th.MutedText),
+	}
+}
+
+// helpKeyWidth is the width of the key column
+const helpKeyWidth = 16
+
+// NewHelpModel creates a visible help overlay listing sections under title
+func NewHelpModel(title string, sections []HelpSection, width, height int) HelpModel {
+	th := theme.Current()
+	ti := textinput.New()
+	ti.CharLimit = 50
+	ti.Width = 30
+	ti.Prompt = "/"
+	ti.PromptStyle = lipgloss.NewStyle().
+		Foreground(th.Accent).
+		Bold(true)
+	ti.TextStyle = lipgloss.NewStyle().
+		Foreground(th.Text)
+
+	return HelpModel{
+		Visible:  true,
+		title:    title,
+		sections: sections,
+		input:    ti,
+		width:    width,
+		height:   height,
+		styles:   DefaultHelpStyles(),
+	}
+}
+
+// HelpBindings returns the bindings of a key map struct that have help, in
+// field order, including those of embedded key maps. Disabled bindings and
+// repeats of the same help are left out
+func HelpBindings(keyMap any) []key.Binding {
+	var bindings []key.Binding
+	seen := make(map[key.Help]bool)
+	var collect func(v reflect.Value)
+	collect = func(v reflect.Value) {
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Field(i)
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+			switch binding := field.Interface().(type) {
+			case key.Binding:
+				help := binding.Help()
+				if binding.Enabled() && help.Key != "" && !seen[help] {
+					seen[help] = true
+					bindings = append(bindings, binding)
+				}
+			default:
+				if field.Kind() == reflect.Struct {
+					collect(field)
+				}
+			}
+		}
+	}
+
+	v := reflect.ValueOf(keyMap)
+	if v.Kind() == reflect.Struct {
+		collect(v)
+	}
+	return bindings
+}
+
+// SetSize sets the size of the overlay
+func (m HelpModel) SetSize(width, height int) HelpModel {
+	m.width = width
+	m.height = height
+	return m
+}
+
+// Hide hides the overlay
+func (m HelpModel) Hide() HelpModel {
+	m.Visible = false
+	return m
+}
+
+// Update handles keys: / searches, j/k and the page keys scroll, esc clears
+// the search or closes the overlay, as do q and ?
+func (m HelpModel) Update(msg tea.Msg) (HelpModel, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		// Such as the cursor blinking
+		var cmd tea.Cmd
+		m.input, cmd = m.input.Update(msg)
+		return m, cmd
+	}
+
+	if m.input.Focused() {
+		switch keyMsg.String() {
+		case "esc":
+			m.input.SetValue("")
+			m.input.Blur()
+		case "enter":
+			m.input.Blur()
+		default:
+			var cmd tea.Cmd
+			m.input, cmd = m.input.Update(msg)
+			m.offset = 0
+			return m, cmd
+		}
+		m.offset = 0
+		return m, nil
+	}
+
+	switch keyMsg.String() {
+	case "/":
+		m.input.SetValue("")
+		m.offset = 0
+		return m, m.input.Focus()
+	case "esc":
+		if m.input.Value() != "" {
+			m.input.SetValue("")
+			m.offset = 0
+			return m, nil
+		}
+		m.Visible = false
+	case "q", "?":
+		m.Visible = false
+	case "j", "down":
+		m.offset++
+	case "k", "up":
+		m.offset--
+	case "ctrl+d", "pgdown":
+		m.offset += m.bodyHeight()
+	case "ctrl+u", "pgup":
+		m.offset -= m.bodyHeight()
+	case "g", "home":
+		m.offset = 0
+	case "G", "end":
+		m.offset = len(m.lines())
+	}
+	m.offset = max(0, min(m.offset, len(m.lines())-m.bodyHeight()))
+	return m, nil
+}
+
+// bodyHeight is how many lines of keys fit, below the title and above the
+// search line and hint
+func (m HelpModel) bodyHeight() int {
+	// Border, title with its gap, and search line with hint
+	return max(1, m.height-2-2-2)
+}
+
+// lines renders the sections with the keys matching the search, one line
+// per key under a line per section
+func (m HelpModel) lines() []string {
+	query := strings.ToLower(m.input.Value())
+	var lines []string
+	for _, section := range m.sections {
+		var rows []string
+		for _, binding := range section.Keys {
+			help := binding.Help()
+			if query != "" && !strings.Contains(strings.ToLower(help.Key), query) &&
+				!strings.Contains(strings.ToLower(help.Desc), query) {
+				continue
+			}
+			rows = append(rows, "  "+m.styles.Key.Render(padString(help.Key, helpKeyWidth-1)+" ")+m.styles.Desc.Render(help.Desc))
+		}
+		if len(rows) == 0 {
+			continue
+		}
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, m.styles.Section.Render(section.Title))
+		lines = append(lines, rows...)
+	}
+	return lines
+}
+
+// View renders the overlay over the whole screen
+func (m HelpModel) View() string {
+	lines := m.lines()
+	if len(lines) == 0 {
+		lines = []string{m.styles.Hint.Render(fmt.Sprintf(i18n.T(i18n.KeyHelpNoMatch), m.input.Value()))}
+	}
+	body := lines[min(m.offset, len(lines)):]
+	if len(body) > m.bodyHeight() {
+		body = body[:m.bodyHeight()]
+	}
+	for len(body) < m.bodyHeight() {
+		body = append(body, "")
+	}
+
+	footer := m.styles.Hint.Render(i18n.T(i18n.KeyHelpHint))
+	if m.input.Focused() || m.input.Value() != "" {
+		footer = m.input.View()
+	}
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		m.styles.Title.Render(m.title),
+		"",
+		strings.Join(body, "\n"),
+		"",
+		footer,
+	)
+	// The border takes 2 columns and 2 lines
+	return m.styles.Border.
+		Width(max(0, m.width-2)).
+		Height(max(0, m.height-2)).
+		Render(content)
+}
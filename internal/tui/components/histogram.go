@@ -0,0 +1,73 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// histogramBuckets is the most buckets a latency histogram splits into
+const histogramBuckets = 5
+
+// histogramBarWidth is the cell width of the longest histogram bar, which
+// keeps a histogram inside a 60 column modal
+const histogramBarWidth = 24
+
+// LatencyHistogram renders round trip times as one line per latency bucket,
+// each with a bar scaled to the fullest bucket and the sample count
+func LatencyHistogram(samples []time.Duration) string {
+	if len(samples) == 0 {
+		return ""
+	}
+
+	lo, hi := samples[0], samples[0]
+	for _, s := range samples {
+		if s < lo {
+			lo = s
+		}
+		if s > hi {
+			hi = s
+		}
+	}
+
+	buckets := min(histogramBuckets, len(samples))
+	step := (hi - lo) / time.Duration(buckets)
+	if step <= 0 {
+		buckets, step = 1, 1 // All samples are equal
+	}
+
+	counts := make([]int, buckets)
+	for _, s := range samples {
+		idx := min(int((s-lo)/step), buckets-1)
+		counts[idx]++
+	}
+	fullest := 0
+	for _, c := range counts {
+		fullest = max(fullest, c)
+	}
+
+	var b strings.Builder
+	for i, c := range counts {
+		from := lo + time.Duration(i)*step
+		to := from + step
+		if i == buckets-1 {
+			to = hi
+		}
+		label := fmt.Sprintf("%s-%s", formatMillis(from), formatMillis(to))
+		if buckets == 1 {
+			label = formatMillis(lo)
+		}
+		fmt.Fprintf(&b, "%17s ms %s %d\n", label, renderBar(float64(c)/float64(fullest), histogramBarWidth), c)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// formatMillis shows a duration in milliseconds, with two decimals below
+// 10ms so the buckets of a fast local probe stay apart
+func formatMillis(d time.Duration) string {
+	ms := float64(d) / float64(time.Millisecond)
+	if ms < 10 {
+		return fmt.Sprintf("%.2f", ms)
+	}
+	return fmt.Sprintf("%.1f", ms)
+}
@@ -0,0 +1,83 @@
+package components
+
+import "slices"
+
+// maxViewHistory is how many view changes a table can undo
+const maxViewHistory = 50
+
+// viewState is the part of a table's view the user changes: the filter
+// query and the sort
+type viewState struct {
+	filterQuery string
+	sortColumn  int
+	sortOrder   SortOrder
+}
+
+// viewEvent is a change of a table's view, recorded so it can be undone and
+// redone
+type viewEvent struct {
+	before viewState
+	after  viewState
+}
+
+// currentView returns the table's view state
+func (m TableModel) currentView() viewState {
+	return viewState{
+		filterQuery: m.filterQuery,
+		sortColumn:  m.sortColumn,
+		sortOrder:   m.sortOrder,
+	}
+}
+
+// record appends the change from before to the current view to the undo
+// history, unless the view did not change. A new change discards the redo
+// history. The stacks are clipped before appending since table values are
+// copied and must not share their backing arrays
+func (m *TableModel) record(before viewState) {
+	after := m.currentView()
+	if before == after {
+		return
+	}
+	m.undoStack = append(slices.Clip(m.undoStack), viewEvent{before: before, after: after})
+	if len(m.undoStack) > maxViewHistory {
+		m.undoStack = m.undoStack[len(m.undoStack)-maxViewHistory:]
+	}
+	m.redoStack = nil
+}
+
+// restoreView applies a recorded view state, keeping the selected row
+func (m TableModel) restoreView(state viewState) TableModel {
+	selected := m.SelectedRow()
+	m.filterQuery = state.filterQuery
+	m.sortColumn = state.sortColumn
+	m.sortOrder = state.sortOrder
+	m.applyView()
+	m = m.ClearSearch()
+	m.reselect(selected)
+	m.computeBarStats()
+	return m
+}
+
+// Undo reverts the last filter or sort change. ok is false when there is
+// nothing to undo
+func (m TableModel) Undo() (TableModel, bool) {
+	if len(m.undoStack) == 0 {
+		return m, false
+	}
+	event := m.undoStack[len(m.undoStack)-1]
+	m.undoStack = slices.Clip(m.undoStack[:len(m.undoStack)-1])
+	m.redoStack = append(slices.Clip(m.redoStack), event)
+	return m.restoreView(event.before), true
+}
+
+// Redo applies the last undone change again. ok is false when there is
+// nothing to redo
+func (m TableModel) Redo() (TableModel, bool) {
+	if len(m.redoStack) == 0 {
+		return m, false
+	}
+	event := m.redoStack[len(m.redoStack)-1]
+	m.redoStack = slices.Clip(m.redoStack[:len(m.redoStack)-1])
+	m.undoStack = append(slices.Clip(m.undoStack), event)
+	return m.restoreView(event.after), true
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 
 	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/theme"
 )
 
 // ModalType represents different types of modals
@@ -23,7 +24,9 @@ const (
 	ModalTypeConfirm
 	ModalTypeProfileSelect
 	ModalTypeRegionSelect
-	ModalTypeInput // Input dialog for user text input
+	ModalTypeInput  // Input dialog for user text input
+	ModalTypeForm   // Multi-field input form
+	ModalTypeSelect // Pick one of a list of options
 )
 
 // ModalModel represents a modal dialog
@@ -54,8 +57,46 @@ type ModalModel struct {
 	inputHistory []string // History items
 	historyIndex int      // Current position in history (-1 means not browsing)
 	currentInput string   // Saved current input when browsing history
+
+	// For confirm and form dialogs, echoed back in ConfirmedMsg/FormSubmittedMsg
+	// so the receiver can tell which action the dialog belongs to
+	actionID   string
+	actionData interface{}
+
+	// For form dialog
+	formFields []FormField
+	formInputs []textinput.Model
+	formFocus  int
+
+	// For select dialog
+	selectList list.Model
+}
+
+// FormField describes a single input of a form modal
+type FormField struct {
+	Key         string // Key in FormSubmittedMsg.Values
+	Label       string
+	Value       string // Initial value
+	Placeholder string
+	Secret      bool // Mask the input, e.g. for passwords
+}
+
+// SelectOption is a choice of a select modal
+type SelectOption struct {
+	Value string // Value in OptionSelectedMsg
+	Label string
+}
+
+// optionItem implements list.Item for select modals
+type optionItem struct {
+	value   string
+	display string
 }
 
+func (i optionItem) Title() string       { return i.display }
+func (i optionItem) Description() string { return "" }
+func (i optionItem) FilterValue() string { return i.display }
+
 // ModalStyles defines styles for the modal
 type ModalStyles struct {
 	Overlay      lipgloss.Style
@@ -71,32 +112,33 @@ type ModalStyles struct {
 
 // DefaultModalStyles returns default modal styles
 func DefaultModalStyles() ModalStyles {
+	th := theme.Current()
 	return ModalStyles{
 		Overlay: lipgloss.NewStyle().
-			Background(lipgloss.Color("#000000")),
+			Background(th.Overlay),
 		Container: lipgloss.NewStyle().
 			BorderStyle(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("#7C3AED")).
+			BorderForeground(th.Primary).
 			Padding(1, 2).
-			Background(lipgloss.Color("#1F2937")),
+			Background(th.Bar),
 		Title: lipgloss.NewStyle().
 			Bold(true).
-			Foreground(lipgloss.Color("#7C3AED")).
+			Foreground(th.Primary).
 			MarginBottom(1),
 		Message: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#E5E7EB")),
+			Foreground(th.Text),
 		Button: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#E5E7EB")).
-			Background(lipgloss.Color("#374151")).
+			Foreground(th.Text).
+			Background(th.Selected).
 			Padding(0, 2),
 		Help: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#9CA3AF")),
+			Foreground(th.SubtleText),
 		InfoColor: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#3B82F6")),
+			Foreground(th.Info),
 		ErrorColor: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#EF4444")),
+			Foreground(th.Error),
 		SuccessColor: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#10B981")),
+			Foreground(th.Success),
 	}
 }
 
@@ -151,6 +193,7 @@ func NewSuccessModal(message string) ModalModel {
 
 // NewProfileSelectionModal creates a profile selection modal
 func NewProfileSelectionModal(profiles []string, currentProfile string) ModalModel {
+	th := theme.Current()
 	// Create list items
 	items := make([]list.Item, len(profiles))
 	selectedIdx := 0
@@ -169,11 +212,11 @@ func NewProfileSelectionModal(profiles []string, currentProfile string) ModalMod
 	delegate.SetSpacing(0)           // No spacing between items
 	delegate.ShowDescription = false // Don't show description
 	delegate.Styles.SelectedTitle = delegate.Styles.SelectedTitle.
-		Foreground(lipgloss.Color("#F59E0B")).
+		Foreground(th.Accent).
 		Bold(true).
-		Background(lipgloss.Color("#374151"))
+		Background(th.Selected)
 	delegate.Styles.NormalTitle = delegate.Styles.NormalTitle.
-		Foreground(lipgloss.Color("#E5E7EB"))
+		Foreground(th.Text)
 
 	// Calculate appropriate height based on number of profiles
 	// Add extra height for filter input (3 lines: prompt + input + spacing)
@@ -186,15 +229,15 @@ func NewProfileSelectionModal(profiles []string, currentProfile string) ModalMod
 	l.SetShowHelp(true)         // Show help to indicate / for filter
 	l.Styles.Title = lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#7C3AED"))
+		Foreground(th.Primary)
 	l.Styles.FilterPrompt = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#F59E0B"))
+		Foreground(th.Accent)
 	l.Styles.FilterCursor = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#F59E0B"))
+		Foreground(th.Accent)
 	l.FilterInput.PromptStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#F59E0B"))
+		Foreground(th.Accent)
 	l.FilterInput.Cursor.Style = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#F59E0B"))
+		Foreground(th.Accent)
 
 	// Select current profile
 	l.Select(selectedIdx)
@@ -224,6 +267,9 @@ func (i profileItem) Description() string { return "" }
 func (i profileItem) FilterValue() string { return i.name }
 
 // regionItem implements list.Item for region selection
+// AllRegionsID is the region selection entry that aggregates every region with resources
+const AllRegionsID = "all"
+
 type regionItem struct {
 	id      string
 	display string
@@ -254,18 +300,19 @@ func NewInputModal(title, prompt, placeholder string) ModalModel {
 
 // NewInputModalWithHistory creates an input dialog modal with history support
 func NewInputModalWithHistory(title, prompt, placeholder string, history []string) ModalModel {
+	th := theme.Current()
 	ti := textinput.New()
 	ti.Placeholder = placeholder
 	ti.CharLimit = 256
 	ti.Width = 50
 	ti.Prompt = ""
 	ti.PromptStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#F59E0B")).
+		Foreground(th.Accent).
 		Bold(true)
 	ti.TextStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#E5E7EB"))
+		Foreground(th.Text)
 	ti.PlaceholderStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#6B7280"))
+		Foreground(th.MutedText)
 	ti.Focus()
 
 	return ModalModel{
@@ -282,22 +329,162 @@ func NewInputModalWithHistory(title, prompt, placeholder string, history []strin
 	}
 }
 
+// NewConfirmModal creates a yes/no confirmation modal. id and data are
+// returned in ConfirmedMsg when the user confirms
+func NewConfirmModal(id, message string, data interface{}) ModalModel {
+	return ModalModel{
+		Visible:    true,
+		modalType:  ModalTypeConfirm,
+		title:      i18n.T(i18n.KeyModalConfirm),
+		message:    message,
+		actionID:   id,
+		actionData: data,
+		styles:     DefaultModalStyles(),
+		width:      60,
+		height:     10,
+	}
+}
+
+// NewFormModal creates a form modal with one text input per field. id and
+// data are returned in FormSubmittedMsg together with the entered values
+func NewFormModal(id, title string, fields []FormField, data interface{}) ModalModel {
+	th := theme.Current()
+	inputs := make([]textinput.Model, len(fields))
+	for i, f := range fields {
+		ti := textinput.New()
+		ti.Placeholder = f.Placeholder
+		ti.CharLimit = 512
+		ti.Width = 40
+		ti.Prompt = ""
+		ti.TextStyle = lipgloss.NewStyle().
+			Foreground(th.Text)
+		ti.PlaceholderStyle = lipgloss.NewStyle().
+			Foreground(th.MutedText)
+		ti.SetValue(f.Value)
+		if f.Secret {
+			ti.EchoMode = textinput.EchoPassword
+		}
+		if i == 0 {
+			ti.Focus()
+		}
+		inputs[i] = ti
+	}
+
+	return ModalModel{
+		Visible:    true,
+		modalType:  ModalTypeForm,
+		title:      title,
+		actionID:   id,
+		actionData: data,
+		formFields: fields,
+		formInputs: inputs,
+		styles:     DefaultModalStyles(),
+		width:      64,
+		height:     len(fields)*2 + 6,
+	}
+}
+
+// NewSelectModal creates a modal to pick one of options, with the option
+// whose value is selected highlighted. id and data are returned in
+// OptionSelectedMsg together with the picked value
+func NewSelectModal(id, title string, options []SelectOption, selected string, data interface{}) ModalModel {
+	th := theme.Current()
+	items := make([]list.Item, len(options))
+	selectedIdx := 0
+	for i, o := range options {
+		display := o.Label
+		if o.Value == selected {
+			display += " (" + i18n.T(i18n.KeyModalCurrent) + ")"
+			selectedIdx = i
+		}
+		items[i] = optionItem{value: o.Value, display: display}
+	}
+
+	delegate := list.NewDefaultDelegate()
+	delegate.SetHeight(1)
+	delegate.SetSpacing(0)
+	delegate.ShowDescription = false
+	delegate.Styles.SelectedTitle = delegate.Styles.SelectedTitle.
+		Foreground(th.Accent).
+		Bold(true).
+		Background(th.Selected)
+	delegate.Styles.NormalTitle = delegate.Styles.NormalTitle.
+		Foreground(th.Text)
+
+	listHeight := min(len(items)+6, 18)
+
+	l := list.New(items, delegate, 55, listHeight)
+	l.Title = title
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(true)
+	l.Styles.Title = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(th.Primary)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().
+		Foreground(th.Accent)
+	l.Styles.FilterCursor = lipgloss.NewStyle().
+		Foreground(th.Accent)
+	l.FilterInput.PromptStyle = lipgloss.NewStyle().
+		Foreground(th.Accent)
+	l.FilterInput.Cursor.Style = lipgloss.NewStyle().
+		Foreground(th.Accent)
+	l.Select(selectedIdx)
+
+	return ModalModel{
+		Visible:    true,
+		modalType:  ModalTypeSelect,
+		title:      title,
+		actionID:   id,
+		actionData: data,
+		selectList: l,
+		styles:     DefaultModalStyles(),
+		width:      60,
+		height:     listHeight + 4,
+	}
+}
+
+// focusFormField moves the focus of a form modal to the field at idx
+func (m ModalModel) focusFormField(idx int) ModalModel {
+	if len(m.formInputs) == 0 {
+		return m
+	}
+	idx = (idx + len(m.formInputs)) % len(m.formInputs)
+	m.formInputs[m.formFocus].Blur()
+	m.formFocus = idx
+	m.formInputs[m.formFocus].Focus()
+	return m
+}
+
+// formValues collects the current values of a form modal by field key
+func (m ModalModel) formValues() map[string]string {
+	values := make(map[string]string, len(m.formFields))
+	for i, f := range m.formFields {
+		values[f.Key] = strings.TrimSpace(m.formInputs[i].Value())
+	}
+	return values
+}
+
 // SetRegions updates the region list and exits loading state
 func (m ModalModel) SetRegions(regions []string, currentRegion string) ModalModel {
+	th := theme.Current()
 	if m.modalType != ModalTypeRegionSelect {
 		return m
 	}
 
-	// Create list items
-	items := make([]list.Item, len(regions))
+	// Create list items, with the All Regions entry first
+	items := make([]list.Item, 0, len(regions)+1)
 	selectedIdx := 0
-	for i, r := range regions {
+	for i, r := range append([]string{AllRegionsID}, regions...) {
 		displayName := r
+		if r == AllRegionsID {
+			displayName = i18n.T(i18n.KeyAllRegions)
+		}
 		if r == currentRegion {
-			displayName = r + " (" + i18n.T(i18n.KeyModalCurrent) + ")"
+			displayName += " (" + i18n.T(i18n.KeyModalCurrent) + ")"
 			selectedIdx = i
 		}
-		items[i] = regionItem{id: r, display: displayName}
+		items = append(items, regionItem{id: r, display: displayName})
 	}
 
 	// Create compact delegate with minimal spacing
@@ -306,15 +493,15 @@ func (m ModalModel) SetRegions(regions []string, currentRegion string) ModalMode
 	delegate.SetSpacing(0)
 	delegate.ShowDescription = false
 	delegate.Styles.SelectedTitle = delegate.Styles.SelectedTitle.
-		Foreground(lipgloss.Color("#F59E0B")).
+		Foreground(th.Accent).
 		Bold(true).
-		Background(lipgloss.Color("#374151"))
+		Background(th.Selected)
 	delegate.Styles.NormalTitle = delegate.Styles.NormalTitle.
-		Foreground(lipgloss.Color("#E5E7EB"))
+		Foreground(th.Text)
 
 	// Calculate appropriate height based on number of regions
 	// Add extra height for filter input (3 lines: prompt + input + spacing)
-	listHeight := min(len(regions)+6, 18)
+	listHeight := min(len(items)+6, 18)
 
 	l := list.New(items, delegate, 55, listHeight)
 	l.Title = i18n.T(i18n.KeyModalSelectRegion)
@@ -323,15 +510,15 @@ func (m ModalModel) SetRegions(regions []string, currentRegion string) ModalMode
 	l.SetShowHelp(true) // Show help to indicate / for filter
 	l.Styles.Title = lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#7C3AED"))
+		Foreground(th.Primary)
 	l.Styles.FilterPrompt = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#F59E0B"))
+		Foreground(th.Accent)
 	l.Styles.FilterCursor = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#F59E0B"))
+		Foreground(th.Accent)
 	l.FilterInput.PromptStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#F59E0B"))
+		Foreground(th.Accent)
 	l.FilterInput.Cursor.Style = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#F59E0B"))
+		Foreground(th.Accent)
 
 	// Select current region
 	l.Select(selectedIdx)
@@ -464,6 +651,36 @@ func (m ModalModel) Update(msg tea.Msg) (ModalModel, tea.Cmd) {
 			m.regionList, cmd = m.regionList.Update(msg)
 			return m, cmd
 
+		case ModalTypeSelect:
+			// If list is filtering, let it handle all key events
+			if m.selectList.FilterState() == list.Filtering {
+				var cmd tea.Cmd
+				m.selectList, cmd = m.selectList.Update(msg)
+				return m, cmd
+			}
+
+			switch msg.String() {
+			case "enter":
+				if item, ok := m.selectList.SelectedItem().(optionItem); ok {
+					m.Visible = false
+					id, data := m.actionID, m.actionData
+					return m, func() tea.Msg {
+						return OptionSelectedMsg{ID: id, Value: item.value, Data: data}
+					}
+				}
+
+			case "esc", "q":
+				m.Visible = false
+				return m, func() tea.Msg {
+					return ModalDismissedMsg{}
+				}
+			}
+
+			// Forward all other keys to list (for j/k navigation and / filtering)
+			var cmd tea.Cmd
+			m.selectList, cmd = m.selectList.Update(msg)
+			return m, cmd
+
 		case ModalTypeInput:
 			switch {
 			case key.Matches(msg, key.NewBinding(key.WithKeys("enter"))):
@@ -519,6 +736,55 @@ func (m ModalModel) Update(msg tea.Msg) (ModalModel, tea.Cmd) {
 			m.inputField, cmd = m.inputField.Update(msg)
 			return m, cmd
 
+		case ModalTypeConfirm:
+			switch msg.String() {
+			case "y", "Y":
+				m.Visible = false
+				id, data := m.actionID, m.actionData
+				return m, func() tea.Msg {
+					return ConfirmedMsg{ID: id, Data: data}
+				}
+			case "n", "N", "q", "esc":
+				m.Visible = false
+				return m, func() tea.Msg {
+					return ModalDismissedMsg{}
+				}
+			}
+			return m, nil
+
+		case ModalTypeForm:
+			switch msg.String() {
+			case "esc":
+				m.Visible = false
+				return m, func() tea.Msg {
+					return ModalDismissedMsg{}
+				}
+
+			case "tab", "down":
+				return m.focusFormField(m.formFocus + 1), nil
+
+			case "shift+tab", "up":
+				return m.focusFormField(m.formFocus - 1), nil
+
+			case "enter":
+				// Enter advances to the next field, and submits on the last one
+				if m.formFocus < len(m.formInputs)-1 {
+					return m.focusFormField(m.formFocus + 1), nil
+				}
+				fallthrough
+
+			case "ctrl+s":
+				m.Visible = false
+				id, data, values := m.actionID, m.actionData, m.formValues()
+				return m, func() tea.Msg {
+					return FormSubmittedMsg{ID: id, Values: values, Data: data}
+				}
+			}
+
+			var cmd tea.Cmd
+			m.formInputs[m.formFocus], cmd = m.formInputs[m.formFocus].Update(msg)
+			return m, cmd
+
 		default:
 			// Info/Error/Success modals - dismiss on any key
 			switch msg.Type {
@@ -545,6 +811,13 @@ func (m ModalModel) Update(msg tea.Msg) (ModalModel, tea.Cmd) {
 		return m, cmd
 	}
 
+	// Update select list if applicable
+	if m.modalType == ModalTypeSelect {
+		var cmd tea.Cmd
+		m.selectList, cmd = m.selectList.Update(msg)
+		return m, cmd
+	}
+
 	// Update input field if applicable
 	if m.modalType == ModalTypeInput {
 		var cmd tea.Cmd
@@ -552,6 +825,13 @@ func (m ModalModel) Update(msg tea.Msg) (ModalModel, tea.Cmd) {
 		return m, cmd
 	}
 
+	// Update focused form field if applicable (cursor blink)
+	if m.modalType == ModalTypeForm && len(m.formInputs) > 0 {
+		var cmd tea.Cmd
+		m.formInputs[m.formFocus], cmd = m.formInputs[m.formFocus].Update(msg)
+		return m, cmd
+	}
+
 	return m, nil
 }
 
@@ -584,6 +864,11 @@ func (m ModalModel) View() string {
 			Width(m.width).
 			Render(m.regionList.View())
 
+	case ModalTypeSelect:
+		return m.styles.Container.
+			Width(m.width).
+			Render(m.selectList.View())
+
 	case ModalTypeInfo:
 		title := m.styles.InfoColor.Render(m.title)
 		content.WriteString(m.styles.Title.Render(title))
@@ -625,6 +910,38 @@ func (m ModalModel) View() string {
 			}
 		}
 		content.WriteString(m.styles.Help.Render(helpText))
+
+	case ModalTypeConfirm:
+		title := m.styles.ErrorColor.Render("? " + m.title)
+		content.WriteString(m.styles.Title.Render(title))
+		content.WriteString("\n\n")
+		content.WriteString(m.styles.Message.Render(m.message))
+		content.WriteString("\n\n")
+		content.WriteString(m.styles.Button.Render(" " + i18n.T(i18n.KeyModalConfirm) + " (y) "))
+		content.WriteString("  ")
+		content.WriteString(m.styles.Button.Render(" " + i18n.T(i18n.KeyModalCancel) + " (n/Esc) "))
+
+	case ModalTypeForm:
+		content.WriteString(m.styles.Title.Render(m.title))
+		content.WriteString("\n\n")
+		labelWidth := 0
+		for _, f := range m.formFields {
+			labelWidth = max(labelWidth, lipgloss.Width(f.Label))
+		}
+		labelStyle := m.styles.Message.Width(labelWidth + 2)
+		focusedLabelStyle := labelStyle.Foreground(theme.Current().Accent).Bold(true)
+		for i, f := range m.formFields {
+			style := labelStyle
+			if i == m.formFocus {
+				style = focusedLabelStyle
+			}
+			content.WriteString(style.Render(f.Label))
+			content.WriteString(m.formInputs[i].View())
+			content.WriteString("\n")
+		}
+		content.WriteString("\n")
+		helpText := "Tab/↑↓: " + i18n.T(i18n.KeyModalNextField) + " | Enter/C-s: " + i18n.T(i18n.KeyModalSubmit) + " | Esc: " + i18n.T(i18n.KeyModalCancel)
+		content.WriteString(m.styles.Help.Render(helpText))
 	}
 
 	return m.styles.Container.
@@ -647,6 +964,26 @@ type InputSubmittedMsg struct {
 	Value string
 }
 
+// ConfirmedMsg is sent when a confirm modal is accepted
+type ConfirmedMsg struct {
+	ID   string
+	Data interface{}
+}
+
+// FormSubmittedMsg is sent when a form modal is submitted
+type FormSubmittedMsg struct {
+	ID     string
+	Values map[string]string
+	Data   interface{}
+}
+
+// OptionSelectedMsg is sent when an option of a select modal is picked
+type OptionSelectedMsg struct {
+	ID    string
+	Value string
+	Data  interface{}
+}
+
 // ModalDismissedMsg is sent when the modal is dismissed
 type ModalDismissedMsg struct{}
 
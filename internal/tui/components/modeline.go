@@ -2,10 +2,12 @@ package components
 
 import (
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 
+	"aliyun-tui-viewer/internal/theme"
 	"aliyun-tui-viewer/internal/tui/types"
 )
 
@@ -15,6 +17,9 @@ type ModeLineModel struct {
 	region   string
 	page     types.PageType
 	pageInfo string // Optional additional info (e.g., page number)
+	cacheAge string // Age of the page's cached data, shown when there is no page info
+	status   string // Background task status, kept across page changes
+	readOnly bool   // Hides the shortcuts that change cloud resources
 	width    int
 	styles   ModeLineStyles
 }
@@ -29,17 +34,18 @@ type ModeLineStyles struct {
 
 // DefaultModeLineStyles returns default mode line styles
 func DefaultModeLineStyles() ModeLineStyles {
+	th := theme.Current()
 	return ModeLineStyles{
 		Background: lipgloss.NewStyle().
-			Background(lipgloss.Color("#1F2937")).
-			Foreground(lipgloss.Color("#E5E7EB")),
+			Background(th.Bar).
+			Foreground(th.Text),
 		Key: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#F59E0B")).
+			Foreground(th.Accent).
 			Bold(true),
 		Help: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#9CA3AF")),
+			Foreground(th.SubtleText),
 		Separator: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#6B7280")),
+			Foreground(th.MutedText),
 	}
 }
 
@@ -78,6 +84,27 @@ func (m ModeLineModel) SetPageInfo(info string) ModeLineModel {
 	return m
 }
 
+// SetCacheStatus sets how old the page's cached data is, e.g. "cached 35s
+// ago". An empty string clears it
+func (m ModeLineModel) SetCacheStatus(status string) ModeLineModel {
+	m.cacheAge = status
+	return m
+}
+
+// SetStatus sets the background task status (e.g., download progress).
+// An empty string clears it
+func (m ModeLineModel) SetStatus(status string) ModeLineModel {
+	m.status = status
+	return m
+}
+
+// SetReadOnly sets whether the shortcuts that change cloud resources are
+// hidden, as read-only mode disables them
+func (m ModeLineModel) SetReadOnly(readOnly bool) ModeLineModel {
+	m.readOnly = readOnly
+	return m
+}
+
 // SetWidth sets the mode line width
 func (m ModeLineModel) SetWidth(width int) ModeLineModel {
 	m.width = width
@@ -98,12 +125,21 @@ func (m ModeLineModel) GetRegion() string {
 func (m ModeLineModel) View() string {
 	// Get shortcuts and format with highlighted keys
 	shortcuts := m.getShortcuts()
+	if m.readOnly {
+		shortcuts = withoutShortcuts(shortcuts, mutatingShortcuts[m.page])
+	}
 	formattedShortcuts := m.formatShortcuts(shortcuts)
 
 	// Add page info if present
 	content := " " + formattedShortcuts
-	if m.pageInfo != "" {
-		content = " " + m.styles.Help.Render(m.pageInfo) + m.styles.Separator.Render(" | ") + formattedShortcuts
+	if info := m.pageInfo; info != "" || m.cacheAge != "" {
+		if info == "" {
+			info = m.cacheAge
+		}
+		content = " " + m.styles.Help.Render(info) + m.styles.Separator.Render(" | ") + formattedShortcuts
+	}
+	if m.status != "" {
+		content = " " + m.styles.Key.Render(m.status) + m.styles.Separator.Render(" |") + content
 	}
 
 	return m.styles.Background.
@@ -139,103 +175,404 @@ func (m ModeLineModel) formatShortcuts(shortcuts string) string {
 	return strings.Join(formattedParts, m.styles.Separator.Render(" | "))
 }
 
+// mutatingShortcuts are the keys of each page's shortcuts that change cloud
+// resources, hidden in read-only mode
+var mutatingShortcuts = map[types.PageType][]string{
+	types.PageECSList:            {"B"},
+	types.PageSecurityGroupRules: {"a", "e", "d"},
+	types.PageDNSRecords:         {"a", "e", "d", "p"},
+	types.PageDNSImport:          {"a"},
+	types.PageSLBListeners:       {"C"},
+	types.PageSLBVServerGroups:   {"c", "a"},
+	types.PageOSSObjects:         {"r", "c/m"},
+	types.PageOSSObjectScan:      {"r"},
+	types.PageOSSObjectVersions:  {"r"},
+	types.PageOSSDeletedObjects:  {"r"},
+	types.PageRDSDetail:          {"W"},
+	types.PageRedisDetail:        {"W"},
+	types.PageMongoDBDetail:      {"W"},
+	types.PageRecycleBin:         {"r"},
+}
+
+// IsMutatingShortcut reports whether key is a shortcut of page that changes
+// cloud resources, hidden in read-only mode
+func IsMutatingShortcut(page types.PageType, key string) bool {
+	for _, shortcut := range mutatingShortcuts[page] {
+		if shortcut == key || slices.Contains(strings.Split(shortcut, "/"), key) {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutShortcuts drops the shortcuts of keys from a "key: description | ..."
+// list
+func withoutShortcuts(shortcuts string, keys []string) string {
+	if len(keys) == 0 {
+		return shortcuts
+	}
+	var kept []string
+	for _, part := range strings.Split(shortcuts, " | ") {
+		key, _, _ := strings.Cut(part, ":")
+		if !slices.Contains(keys, key) {
+			kept = append(kept, part)
+		}
+	}
+	return strings.Join(kept, " | ")
+}
+
 // getShortcuts returns the context-sensitive shortcuts for the current page
 func (m ModeLineModel) getShortcuts() string {
 	switch m.page {
 	case types.PageMenu:
-		return "Enter: Select | j/k: Navigate | F: Find Resource | Q: Quit | P: Profile | R: Region"
+		return "Enter: Select | j/k: Navigate | F: Find Resource | J: Jobs | Ctrl+^: Last Page | ?: Help | Q: Quit | P: Profile | R: Region"
 
 	case types.PageECSList:
-		return "j/k: Navigate | Enter: Details | v: JSON | s: Disks | e: ENI | g: Security Groups | /: Search | yy: Copy | q: Back"
+		return "j/k: Navigate | Enter: Details | v: JSON | s: Disks | e: ENI | g: Security Groups | p: Spot | U: GPU | I: Probe | t: Tag Filter | Z: Zone Capacity | Space/V: Select | B: Add to SLB | Y: Copy IDs/IPs | /: Search | f: Filter | yy: Copy | q: Back"
 
 	case types.PageECSDetail:
-		return "j/k: Row | Tab/S-Tab: Section | yy: Copy | q/Esc: Back"
+		return "j/k: Row | Tab/S-Tab: Section | z: Zoom | m: Metrics | p: Role Policies | c/o: Console URL / Open Console | yy: Copy | q/Esc: Back"
 
 	case types.PageECSJSONDetail:
 		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
 
 	case types.PageECSDisks:
-		return "j/k: Navigate | Enter: Details | /: Search | yy: Copy | q: Back"
+		return "j/k: Navigate | Enter: Details | /: Search | f: Filter | yy: Copy | q: Back"
 
 	case types.PageECSNetworkInterfaces:
-		return "j/k: Navigate | Enter: Details | /: Search | yy: Copy | q: Back"
+		return "j/k: Navigate | Enter: Details | /: Search | f: Filter | yy: Copy | q: Back"
 
 	case types.PageSecurityGroups:
-		return "j/k: Navigate | Enter: Rules | s: Instances | /: Search | yy: Copy | q: Back"
+		return "j/k: Navigate | Enter: Rules | s: Instances | /: Search | f: Filter | yy: Copy | q: Back"
 
 	case types.PageSecurityGroupRules:
-		return "j/k: Navigate | /: Search | yy: Copy | q: Back"
+		return "j/k: Navigate | a: Add | e: Edit | d: Revoke | /: Search | f: Filter | yy: Copy | q: Back"
 
 	case types.PageSecurityGroupInstances, types.PageInstanceSecurityGroups:
-		return "j/k: Navigate | Enter: Details | /: Search | yy: Copy | q: Back"
+		return "j/k: Navigate | Enter: Details | /: Search | f: Filter | yy: Copy | q: Back"
 
 	case types.PageDNSDomains:
-		return "j/k: Navigate | Enter: Records | /: Search | yy: Copy | q: Back"
+		return "j/k: Navigate | Enter: Records | D: Dangling Records | I: Import | /: Search | f: Filter | yy: Copy | q: Back"
 
 	case types.PageDNSRecords:
-		return "j/k: Navigate | /: Search | yy: Copy | q: Back"
+		return "j/k: Navigate | a: Add | e: Edit | d: Delete | p: Pause/Enable | c: Check Propagation | /: Search | f: Filter | yy: Copy | q: Back"
 
 	case types.PageSLBList:
-		return "j/k: Navigate | Enter: Details | l: Listeners | v: VServer Groups | s: Default Servers | /: Search | q: Back"
+		return "j/k: Navigate | Enter: Details | l: Listeners | v: VServer Groups | s: Default Servers | I: Probe | t: Tag Filter | /: Search | f: Filter | q: Back"
 
 	case types.PageSLBDetail:
-		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+		return "j/k: Row | Tab/S-Tab: Section | z: Zoom | v: JSON | l: Listeners | s: Default Servers | yy: Copy | q/Esc: Back"
 
 	case types.PageSLBListeners:
-		return "j/k: Navigate | Enter: Forwarding Rules (HTTP/HTTPS) | /: Search | yy: Copy | q: Back"
+		return "j/k: Navigate | Enter: Forwarding Rules (HTTP/HTTPS) | C: Certificate (HTTPS) | /: Search | f: Filter | yy: Copy | q: Back"
 
 	case types.PageSLBVServerGroups:
-		return "j/k: Navigate | Enter: Backend Servers | /: Search | yy: Copy | q: Back"
+		return "j/k: Navigate | Enter: Backend Servers | c: Create Group | a: Add Marked ECS | /: Search | f: Filter | yy: Copy | q: Back"
 
 	case types.PageSLBBackendServers:
-		return "j/k: Navigate | /: Search | yy: Copy | q: Back"
+		return "j/k: Navigate | /: Search | f: Filter | yy: Copy | q: Back"
 
 	case types.PageSLBForwardingRules:
-		return "j/k: Navigate | Enter: Details | /: Search | yy: Copy | q: Back"
+		return "j/k: Navigate | Enter: Details | /: Search | f: Filter | yy: Copy | q: Back"
 
 	case types.PageSLBDefaultServers:
-		return "j/k: Navigate | Enter: Details | /: Search | yy: Copy | q: Back"
+		return "j/k: Navigate | Enter: Details | /: Search | f: Filter | yy: Copy | q: Back"
 
 	case types.PageOSSBuckets:
-		return "j/k: Navigate | Enter: Objects | /: Search | q: Back"
+		return "j/k: Navigate | Enter: Objects | i: Bucket Detail | /: Search | f: Filter | q: Back"
 
 	case types.PageOSSObjects:
-		return "j/k: Navigate | Enter: Details | [/]: Prev/Next Page | 0: First | /: Search | q: Back"
+		return "j/k: Navigate | Enter: Open | Backspace: Parent | v: Preview | V: Versions | x: Deleted | d/s: Download | r: Restore Archive | Space: Mark | c/m: Copy/Move | [/]: Prev/Next Page | 0: First | A: Load All | p: Prefix Search | /: Search | f: Filter | q: Back"
 
-	case types.PageOSSObjectDetail:
+	case types.PageOSSObjectDetail, types.PageOSSObjectPreview:
 		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search"
 
 	case types.PageRDSList:
-		return "j/k: Navigate | Enter: Details | D: Databases | A: Accounts | /: Search | q: Back"
+		return "j/k: Navigate | Enter: Details | D: Databases | A: Accounts | M: Metrics | B: Backups | L: Slow Log | T: Test Conn. | I: Probe | O: Forward | t: Tag Filter | /: Search | f: Filter | q: Back"
 
 	case types.PageRDSDetail:
-		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search"
+		return "j/k: Row | Tab/S-Tab: Section | z: Zoom | v: JSON | D: Databases | A: Accounts | M: Metrics | W: Maint. Window | C: Connect | yy: Copy | q/Esc: Back"
 
 	case types.PageRDSDatabases, types.PageRDSAccounts:
-		return "j/k: Navigate | Enter: Details | /: Search | yy: Copy | q: Back"
+		return "j/k: Navigate | Enter: Details | /: Search | f: Filter | yy: Copy | q: Back"
 
 	case types.PageRedisList:
-		return "j/k: Navigate | Enter: Details | A: Accounts | /: Search | yy: Copy | q: Back"
+		return "j/k: Navigate | Enter: Details | A: Accounts | P: Parameters | M: Performance | B: Backups | T: Test Conn. | I: Probe | O: Forward | t: Tag Filter | /: Search | f: Filter | yy: Copy | q: Back"
 
 	case types.PageRedisDetail:
-		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search"
+		return "j/k: Row | Tab/S-Tab: Section | z: Zoom | v: JSON | A: Accounts | W: Maint. Window | C: Connect | yy: Copy | q/Esc: Back"
 
 	case types.PageRedisAccounts:
-		return "j/k: Navigate | Enter: Details | /: Search | yy: Copy | q: Back"
+		return "j/k: Navigate | Enter: Details | /: Search | f: Filter | yy: Copy | q: Back"
 
 	case types.PageRocketMQList:
-		return "j/k: Navigate | Enter: Details | T: Topics | G: Groups | /: Search | q: Back"
+		return "j/k: Navigate | Enter: Details | T: Topics | G: Groups | /: Search | f: Filter | q: Back"
 
 	case types.PageRocketMQDetail:
-		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search"
+		return "j/k: Row | Tab/S-Tab: Section | z: Zoom | v: JSON | T: Topics | G: Groups | yy: Copy | q/Esc: Back"
+
+	case types.PageRocketMQTopics:
+		return "j/k: Navigate | Enter: Subscribed Groups | m: Messages | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageRocketMQGroups:
+		return "j/k: Navigate | Enter: Lag per Topic | /: Search | f: Filter | yy: Copy | q: Back"
 
-	case types.PageRocketMQTopics, types.PageRocketMQGroups:
-		return "j/k: Navigate | Enter: Details | /: Search | yy: Copy | q: Back"
+	case types.PageJobs:
+		return "j/k: Navigate | x: Cancel | c: Clear Finished | /: Search | f: Filter | q: Back"
 
 	case types.PageResourceFinder:
-		return "j/k: Navigate | Tab/S-Tab: Section | Enter: Details | yy: Copy | q: Back"
+		return "j/k: Navigate | Tab/S-Tab: Section | z: Zoom | Enter: Details | A: All Profiles | yy: Copy | q: Back"
+
+	case types.PageRAMUsers:
+		return "j/k: Navigate | Enter: Policies | v: Details | o: Roles | p: All Policies | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageRAMRoles:
+		return "j/k: Navigate | Enter: Details | p: Attached Policies | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageRAMPolicies:
+		return "j/k: Navigate | Enter: Document | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageRAMUserPolicies:
+		return "j/k: Navigate | Enter: Document | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageRAMDetail:
+		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+
+	case types.PageECSMetrics, types.PageRDSMetrics, types.PageRedisMetrics:
+		return "j/k: Scroll | r: Refresh | q/Esc: Back"
+
+	case types.PageSLSProjects:
+		return "j/k: Navigate | Enter: Logtail Configs | m: Machine Groups | c: ECS Coverage | v: Details | /: Search | f: Filter | q: Back"
+
+	case types.PageSLSLogtailConfigs:
+		return "j/k: Navigate | Enter: Details | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageSLSMachineGroups:
+		return "j/k: Navigate | Enter: Machines | v: Details | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageSLSMachines:
+		return "j/k: Navigate | Enter: Details | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageSLSCoverage:
+		return "j/k: Navigate | Enter: Details | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageSLSDetail:
+		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+
+	case types.PageConfigRules:
+		return "j/k: Navigate | Enter: Non-compliant Resources | v: Details | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageConfigResults:
+		return "j/k: Navigate | Enter: Details | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageConfigDetail:
+		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+
+	case types.PageTagBrowser:
+		return "j/k: Navigate | Enter: Tagged Resources | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageTagResources:
+		return "j/k: Navigate | Enter: Open Filtered List | v: Details | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageTagDetail:
+		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+
+	case types.PageBilling:
+		return "j/k: Navigate | v: Details | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageBillingDetail:
+		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+
+	case types.PageDNSDangling:
+		return "j/k: Navigate | Enter: Domain Records | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageRAMRolePolicies:
+		return "j/k: Navigate | Enter: Document | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageNATList:
+		return "j/k: Navigate | Enter: Details | s: SNAT Entries | d: DNAT Entries | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageNATDetail:
+		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+
+	case types.PageSNATEntries:
+		return "j/k: Navigate | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageDNATEntries:
+		return "j/k: Navigate | Enter: Target Instance | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageOSSObjectVersions:
+		return "j/k: Navigate | Enter: Details | d/s: Download Version | r: Restore Version | /: Search | f: Filter | q: Back"
+
+	case types.PageALBList:
+		return "j/k: Navigate | Enter: Details | l: Listeners | v: Server Groups | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageALBDetail:
+		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+
+	case types.PageALBListeners:
+		return "j/k: Navigate | Enter: Forwarding Rules | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageALBRules:
+		return "j/k: Navigate | Enter: Details | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageALBRuleDetail:
+		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+
+	case types.PageALBServerGroups:
+		return "j/k: Navigate | Enter: Backend Servers | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageNLBList:
+		return "j/k: Navigate | Enter: Details | l: Listeners | v: Server Groups | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageNLBDetail:
+		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+
+	case types.PageNLBListeners:
+		return "j/k: Navigate | Enter: Backend Servers | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageNLBServerGroups:
+		return "j/k: Navigate | Enter: Backend Servers | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageLBServers:
+		return "j/k: Navigate | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageOSSBucketDetail:
+		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+
+	case types.PageACKClusters:
+		return "j/k: Navigate | Enter: Details | p: Node Pools | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageACKClusterDetail:
+		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+
+	case types.PageACKNodePools:
+		return "j/k: Navigate | Enter: Nodes | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageACKNodes:
+		return "j/k: Navigate | Enter: ECS Instance | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageOSSObjectScan:
+		return "j/k: Navigate | Enter: Details | v: Preview | V: Versions | d/s: Download | r: Restore Archive | /: Search | f: Filter | q: Back"
+
+	case types.PageACRInstances:
+		return "j/k: Navigate | Enter: Namespaces | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageACRNamespaces:
+		return "j/k: Navigate | Enter: Repositories | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageACRRepositories:
+		return "j/k: Navigate | Enter: Tags | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageACRTags:
+		return "j/k: Navigate | Enter: Details | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageACRTagDetail:
+		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+
+	case types.PageFCServices:
+		return "j/k: Navigate | Enter: Functions | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageFCFunctions:
+		return "j/k: Navigate | Enter: Details | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageFCFunctionDetail:
+		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+
+	case types.PageSLBJSONDetail:
+		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+
+	case types.PageMongoDBList:
+		return "j/k: Navigate | Enter: Details | A: Accounts | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageMongoDBDetail:
+		return "j/k: Row | Tab/S-Tab: Section | z: Zoom | v: JSON | A: Accounts | W: Maint. Window | yy: Copy | q/Esc: Back"
+
+	case types.PageMongoDBJSONDetail:
+		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+
+	case types.PageMongoDBAccounts:
+		return "j/k: Navigate | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageRDSJSONDetail:
+		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+
+	case types.PageRedisJSONDetail:
+		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+
+	case types.PageRocketMQJSONDetail:
+		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+
+	case types.PageElasticsearchList:
+		return "j/k: Navigate | Enter: Details | /: Search | f: Filter | yy: Copy Endpoint | q: Back"
+
+	case types.PageElasticsearchDetail:
+		return "j/k: Row | Tab/S-Tab: Section | z: Zoom | v: JSON | yy: Copy | q/Esc: Back"
+
+	case types.PageElasticsearchJSONDetail:
+		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+
+	case types.PageZoneCapacity:
+		return "j/k: Navigate | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageKafkaList:
+		return "j/k: Navigate | Enter: Details | T: Topics | G: Groups | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageKafkaDetail:
+		return "j/k: Row | Tab/S-Tab: Section | z: Zoom | v: JSON | T: Topics | G: Groups | yy: Copy | q/Esc: Back"
+
+	case types.PageKafkaJSONDetail:
+		return "q/Esc: Back | yy: Copy | e: Edit | v: Pager | /: Search | n/N: Next/Prev"
+
+	case types.PageKafkaTopics:
+		return "j/k: Navigate | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageKafkaGroups:
+		return "j/k: Navigate | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageRocketMQLag:
+		return "j/k: Navigate | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageRocketMQMessages:
+		return "j/k: Navigate | Enter: Details | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageRedisParameters:
+		return "j/k: Navigate | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageRecycleBin:
+		return "j/k: Navigate | Enter: Details | r: Restore | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageOSSDeletedObjects:
+		return "j/k: Navigate | Enter/V: Versions | r: Undelete | /: Search | f: Filter | q: Back"
+
+	case types.PageRedisBackups:
+		return "j/k: Navigate | Enter: Details | yy: Copy Download URL | /: Search | f: Filter | q: Back"
+
+	case types.PageRDSBackups:
+		return "j/k: Navigate | Enter: Details | b: Binlogs | yy: Copy Download URL | /: Search | f: Filter | q: Back"
+
+	case types.PageRDSBinlogs:
+		return "j/k: Navigate | Enter: Details | yy: Copy Download URL | /: Search | f: Filter | q: Back"
+
+	case types.PageRDSSlowLog:
+		return "j/k: Navigate | Enter: Details | e: Most Executed | l: Slowest | d: Date Range | yy: Copy SQL | /: Search | f: Filter | q: Back"
+
+	case types.PageChangelog:
+		return "j/k: Navigate | a: All Releases | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageRecent:
+		return "j/k: Navigate | Enter: Open | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageUsage:
+		return "j/k: Navigate | /: Search | f: Filter | 1-9: Sort | yy: Copy | q: Back"
+
+	case types.PageDNSImport:
+		return "j/k: Navigate | a: Apply | /: Search | f: Filter | yy: Copy | q: Back"
+
+	case types.PageAPITrace:
+		return "j/k: Navigate | /: Search | f: Filter | 1-9: Sort | yy: Copy | q: Back"
 
 	default:
-		return "q/Esc: Back | Q: Quit | P: Profile | R: Region"
+		return "q/Esc: Back | Ctrl+^: Last Page | ?: Help | Q: Quit | P: Profile | R: Region"
 	}
 }
@@ -0,0 +1,47 @@
+package components
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// DoubleClickTime is how soon a second click on the same row makes a double
+// click, which opens the row like enter
+const DoubleClickTime = 500 * time.Millisecond
+
+// WheelRows is how many rows a turn of the mouse wheel moves the cursor
+const WheelRows = 3
+
+// ClickTracker tells double clicks from single ones
+type ClickTracker struct {
+	lastTime time.Time
+	target   int
+}
+
+// Click records a click on target, e.g. a row index, and reports whether it
+// is the second click of a double click on it
+func (c *ClickTracker) Click(target int) bool {
+	now := time.Now()
+	double := target == c.target && now.Sub(c.lastTime) < DoubleClickTime
+	c.lastTime = now
+	c.target = target
+	if double {
+		// A third click starts over
+		c.lastTime = time.Time{}
+	}
+	return double
+}
+
+// IsClick reports whether msg is a press of the left button
+func IsClick(msg tea.MouseMsg) bool {
+	return msg.Button == tea.MouseButtonLeft && msg.Action == tea.MouseActionPress
+}
+
+// PressEnter returns a command pressing enter, so a double click opens a row
+// the way the page opens it from the keyboard
+func PressEnter() tea.Cmd {
+	return func() tea.Msg {
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+
+	"aliyun-tui-viewer/internal/theme"
 )
 
 // SearchModel represents a vim-style search bar
@@ -13,6 +15,9 @@ type SearchModel struct {
 	query    string
 	width    int
 	styles   SearchStyles
+
+	// filtering is set while the bar edits a table filter instead of a search
+	filtering bool
 }
 
 // SearchStyles defines styles for the search bar
@@ -25,31 +30,33 @@ type SearchStyles struct {
 
 // DefaultSearchStyles returns default search styles
 func DefaultSearchStyles() SearchStyles {
+	th := theme.Current()
 	return SearchStyles{
 		Label: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#F59E0B")).
+			Foreground(th.Accent).
 			Bold(true),
 		Input: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#E5E7EB")),
+			Foreground(th.Text),
 		Cursor: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#E5E7EB")),
+			Foreground(th.Text),
 		Background: lipgloss.NewStyle().
-			Background(lipgloss.Color("#1F2937")),
+			Background(th.Bar),
 	}
 }
 
 // NewSearchModel creates a new search model
 func NewSearchModel() SearchModel {
+	th := theme.Current()
 	ti := textinput.New()
 	ti.Placeholder = ""
 	ti.CharLimit = 100
 	ti.Width = 50
 	ti.Prompt = "/"
 	ti.PromptStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#F59E0B")).
+		Foreground(th.Accent).
 		Bold(true)
 	ti.TextStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#E5E7EB"))
+		Foreground(th.Text)
 
 	return SearchModel{
 		input:  ti,
@@ -61,6 +68,18 @@ func NewSearchModel() SearchModel {
 // Activate activates the search bar
 func (m SearchModel) Activate() SearchModel {
 	m.Active = true
+	m.filtering = false
+	m.input.Prompt = "/"
+	m.input.SetValue("")
+	m.input.Focus()
+	return m
+}
+
+// ActivateFilter activates the bar for entering a table filter
+func (m SearchModel) ActivateFilter() SearchModel {
+	m.Active = true
+	m.filtering = true
+	m.input.Prompt = "&"
 	m.input.SetValue("")
 	m.input.Focus()
 	return m
@@ -112,10 +131,18 @@ func (m SearchModel) Update(msg tea.Msg) (SearchModel, tea.Cmd) {
 	case tea.KeyMsg:
 		switch msg.Type {
 		case tea.KeyEnter:
-			// Execute search
-			m.query = m.input.Value()
 			m.Active = false
 			m.input.Blur()
+			if m.filtering {
+				// Apply filter, leaving the search query for n/N untouched
+				query := m.input.Value()
+				return m, func() tea.Msg {
+					return FilterExecuteMsg{Query: query}
+				}
+			}
+
+			// Execute search
+			m.query = m.input.Value()
 			return m, func() tea.Msg {
 				return SearchExecuteMsg{Query: m.query}
 			}
@@ -163,3 +190,9 @@ type SearchExecuteMsg struct {
 // SearchCancelMsg is sent when search is cancelled
 type SearchCancelMsg struct{}
 
+// FilterExecuteMsg is sent when a table filter is applied. An empty query
+// clears the filter
+type FilterExecuteMsg struct {
+	Query string
+}
+
@@ -0,0 +1,130 @@
+package components
+
+import "sort"
+
+// ToggleSelection adds the highlighted row to the selection, or removes it,
+// and moves to the next row, so that space pressed repeatedly selects a run
+// of rows
+func (m TableModel) ToggleSelection() TableModel {
+	index := m.SelectedRow()
+	if index < 0 || index >= len(m.allRows) {
+		return m
+	}
+	selection := m.copySelection()
+	if selection[index] {
+		delete(selection, index)
+	} else {
+		selection[index] = true
+	}
+	m.selection = selection
+	m.moveCursor(1)
+	return m
+}
+
+// toggleRange starts a range at the highlighted row, which the cursor then
+// extends, or adds the rows of the range to the selection
+func (m TableModel) toggleRange() TableModel {
+	if len(m.rows) == 0 {
+		return m
+	}
+	if !m.ranging {
+		m.ranging = true
+		m.rangeStart = m.cursor
+		return m
+	}
+	m.selection = m.copySelection()
+	for _, index := range m.rangeIndices() {
+		m.selection[index] = true
+	}
+	m.ranging = false
+	return m
+}
+
+// rangeIndices returns the indices in allRows of the rows between the start
+// of the range and the cursor
+func (m TableModel) rangeIndices() []int {
+	if !m.ranging {
+		return nil
+	}
+	from, to := min(m.rangeStart, m.cursor), max(m.rangeStart, m.cursor)
+	indices := make([]int, 0, to-from+1)
+	for pos := from; pos <= to && pos < len(m.rows); pos++ {
+		indices = append(indices, m.sourceIndex(pos))
+	}
+	return indices
+}
+
+// inSelection reports whether the visible row at pos is selected or in the
+// range being selected
+func (m TableModel) inSelection(pos int) bool {
+	if m.selection[m.sourceIndex(pos)] {
+		return true
+	}
+	return m.ranging && pos >= min(m.rangeStart, m.cursor) && pos <= max(m.rangeStart, m.cursor)
+}
+
+// copySelection returns a copy of the selection to change, so that copies of
+// the model do not share it
+func (m TableModel) copySelection() map[int]bool {
+	selection := make(map[int]bool, len(m.selection)+1)
+	for index := range m.selection {
+		selection[index] = true
+	}
+	return selection
+}
+
+// SelectionIndices returns the indices, as passed to SetRows, of the selected
+// rows and of those in the range being selected, in ascending order. Rows a
+// filter hides stay selected
+func (m TableModel) SelectionIndices() []int {
+	seen := make(map[int]bool, len(m.selection))
+	var indices []int
+	for index := range m.selection {
+		seen[index] = true
+		indices = append(indices, index)
+	}
+	for _, index := range m.rangeIndices() {
+		if !seen[index] {
+			indices = append(indices, index)
+		}
+	}
+	sort.Ints(indices)
+	return indices
+}
+
+// SelectionCount returns how many rows are selected
+func (m TableModel) SelectionCount() int {
+	return len(m.SelectionIndices())
+}
+
+// SelectionData returns the data of the selected rows, in the order of the
+// rows passed to SetRows
+func (m TableModel) SelectionData() []interface{} {
+	var data []interface{}
+	for _, index := range m.SelectionIndices() {
+		if index < len(m.rowData) && m.rowData[index] != nil {
+			data = append(data, m.rowData[index])
+		}
+	}
+	return data
+}
+
+// SetSelection selects the rows at indices, as passed to SetRows, e.g. to
+// keep the selection when the page sets new rows
+func (m TableModel) SetSelection(indices []int) TableModel {
+	m.selection = make(map[int]bool, len(indices))
+	for _, index := range indices {
+		if index >= 0 && index < len(m.allRows) {
+			m.selection[index] = true
+		}
+	}
+	m.ranging = false
+	return m
+}
+
+// ClearSelection unselects every row
+func (m TableModel) ClearSelection() TableModel {
+	m.selection = nil
+	m.ranging = false
+	return m
+}
@@ -0,0 +1,100 @@
+package components
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+)
+
+// SortOrder is the direction a table column is sorted in
+type SortOrder int
+
+// Sort orders: SortNone keeps rows in the order they were set
+const (
+	SortNone SortOrder = iota
+	SortAscending
+	SortDescending
+)
+
+// indicator returns the glyph shown next to the sorted column's title
+func (o SortOrder) indicator() string {
+	if o == SortDescending {
+		return "▼"
+	}
+	return "▲"
+}
+
+// compareCells orders two cells, by time when both are timestamps, numerically
+// when both start with a number (sizes such as "1.5 GB", ports, weights,
+// counts) and case-insensitively otherwise. Empty and "-" cells sort after everything else
+func compareCells(a, b string) int {
+	aEmpty, bEmpty := isEmptyCell(a), isEmptyCell(b)
+	switch {
+	case aEmpty && bEmpty:
+		return 0
+	case aEmpty:
+		return 1
+	case bEmpty:
+		return -1
+	}
+
+	if at, ok := parseTimeCell(a); ok {
+		if bt, ok := parseTimeCell(b); ok {
+			switch {
+			case at < bt:
+				return -1
+			case at > bt:
+				return 1
+			default:
+				return 0
+			}
+		}
+	}
+	if av, ok := parseNumericCell(a); ok {
+		if bv, ok := parseNumericCell(b); ok {
+			switch {
+			case av < bv:
+				return -1
+			case av > bv:
+				return 1
+			default:
+				return 0
+			}
+		}
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// isEmptyCell reports whether a cell holds no value
+func isEmptyCell(s string) bool {
+	s = strings.TrimSpace(s)
+	return s == "" || s == "-"
+}
+
+// sortedRowOrder returns the indices of rows ordered by column. Ties keep
+// their original order, and empty cells stay last in both directions
+func sortedRowOrder(rows []table.Row, column int, order SortOrder) []int {
+	indices := make([]int, len(rows))
+	for i := range indices {
+		indices[i] = i
+	}
+	if order == SortNone {
+		return indices
+	}
+
+	cell := func(i int) string {
+		if column < len(rows[i]) {
+			return rows[i][column]
+		}
+		return ""
+	}
+	sort.SliceStable(indices, func(x, y int) bool {
+		a, b := cell(indices[x]), cell(indices[y])
+		if order == SortDescending && !isEmptyCell(a) && !isEmptyCell(b) {
+			a, b = b, a
+		}
+		return compareCells(a, b) < 0
+	})
+	return indices
+}
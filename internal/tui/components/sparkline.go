@@ -0,0 +1,56 @@
+package components
+
+import (
+	"math"
+	"strings"
+)
+
+// sparkBlocks are the glyphs of a sparkline, from lowest to highest
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline renders values as a single-line chart at most width cells wide.
+// When there are more values than cells, each cell shows the average of its
+// values. Heights are scaled to ceiling, or to the largest value when
+// ceiling is 0
+func Sparkline(values []float64, width int, ceiling float64) string {
+	if width <= 0 || len(values) == 0 {
+		return ""
+	}
+
+	cells := resampleValues(values, width)
+	top := ceiling
+	if top <= 0 {
+		for _, v := range cells {
+			top = math.Max(top, v)
+		}
+	}
+
+	var b strings.Builder
+	for _, v := range cells {
+		level := 0
+		if top > 0 {
+			level = int(math.Round(math.Max(0, math.Min(1, v/top)) * float64(len(sparkBlocks)-1)))
+		}
+		b.WriteRune(sparkBlocks[level])
+	}
+	return b.String()
+}
+
+// resampleValues averages values down to at most width buckets
+func resampleValues(values []float64, width int) []float64 {
+	if len(values) <= width {
+		return values
+	}
+
+	cells := make([]float64, width)
+	for i := range cells {
+		start := i * len(values) / width
+		end := (i + 1) * len(values) / width
+		sum := 0.0
+		for _, v := range values[start:end] {
+			sum += v
+		}
+		cells[i] = sum / float64(end-start)
+	}
+	return cells
+}
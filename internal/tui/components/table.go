@@ -10,6 +10,10 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/mattn/go-runewidth"
+
+	"aliyun-tui-viewer/internal/config"
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/theme"
 )
 
 // TableModel wraps bubbles/table with additional features
@@ -28,6 +32,10 @@ type TableModel struct {
 	matchRows   []int // Row indices that match search
 	keys        TableKeyMap
 
+	// Search matcher set by the page, e.g. for IPs inside CIDR blocks. Rows
+	// it matches are found besides those containing the query
+	searchMatcher func(index int, query string) bool
+
 	// Cursor and scroll for custom rendering
 	cursor       int
 	scrollOffset int
@@ -36,9 +44,48 @@ type TableModel struct {
 	yankLastTime time.Time
 	yankCount    int
 
+	// Double click tracking
+	clicks ClickTracker
+
 	// Row data for copying
 	rowData []interface{}
 
+	// Column filter and sort: rows holds the visible, ordered subset of
+	// allRows and sourceRows maps each visible row back to its index in
+	// allRows (nil when rows is allRows as given)
+	allRows     []table.Row
+	sourceRows  []int
+	filterQuery string
+	sortColumn  int
+	sortOrder   SortOrder
+
+	// Row filter set by the page, e.g. resources carrying a tag. It is
+	// applied together with the filter query
+	rowFilter      func(index int) bool
+	rowFilterLabel string
+
+	// Numeric columns rendered with inline bars
+	barColumns map[int]bool
+	barStats   map[int]barStat
+
+	// Formats of the values given to SetValues, by column
+	formats []ColumnFormat
+
+	// Filter and sort changes that u and ctrl+r undo and redo
+	undoStack []viewEvent
+	redoStack []viewEvent
+
+	// Rows selected for batch copying, by index in allRows, and the visible
+	// row the range being selected with V started on
+	selection  map[int]bool
+	ranging    bool
+	rangeStart int
+
+	// Columns shown: those of the layout picked with the column chooser, or
+	// all but the hidden ones when there is none
+	layout        *config.ColumnLayout
+	hiddenColumns map[int]bool
+
 	// Styles
 	styles TableStyles
 }
@@ -53,6 +100,14 @@ type TableKeyMap struct {
 	End      key.Binding
 	Enter    key.Binding
 	Yank     key.Binding
+	Sort     key.Binding
+	SortBy   key.Binding
+	Undo     key.Binding
+	Redo     key.Binding
+
+	Select      key.Binding
+	SelectRange key.Binding
+	Columns     key.Binding
 }
 
 // DefaultTableKeyMap returns default key bindings
@@ -90,6 +145,34 @@ func DefaultTableKeyMap() TableKeyMap {
 			key.WithKeys("y"),
 			key.WithHelp("yy", "copy"),
 		),
+		Sort: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp("s", "cycle sort"),
+		),
+		SortBy: key.NewBinding(
+			key.WithKeys("1", "2", "3", "4", "5", "6", "7", "8", "9"),
+			key.WithHelp("1-9", "sort by column"),
+		),
+		Undo: key.NewBinding(
+			key.WithKeys("u"),
+			key.WithHelp("u", "undo filter/sort"),
+		),
+		Redo: key.NewBinding(
+			key.WithKeys("ctrl+r"),
+			key.WithHelp("ctrl+r", "redo filter/sort"),
+		),
+		Select: key.NewBinding(
+			key.WithKeys(" "),
+			key.WithHelp("space", "select"),
+		),
+		SelectRange: key.NewBinding(
+			key.WithKeys("V"),
+			key.WithHelp("V", "select range"),
+		),
+		Columns: key.NewBinding(
+			key.WithKeys("c", "alt+c"),
+			key.WithHelp("c/alt+c", "choose columns"),
+		),
 	}
 }
 
@@ -101,37 +184,46 @@ type TableStyles struct {
 	Border      lipgloss.Style
 	Title       lipgloss.Style
 	SearchMatch lipgloss.Style
+	Bar         lipgloss.Style
+	Marked      lipgloss.Style
 }
 
 // DefaultTableStyles returns default table styles
 func DefaultTableStyles() TableStyles {
+	th := theme.Current()
 	return TableStyles{
 		Header: lipgloss.NewStyle().
 			Bold(true).
-			Foreground(lipgloss.Color("#F59E0B")).
+			Foreground(th.Accent).
 			Padding(0, 1),
 		Cell: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#E5E7EB")).
+			Foreground(th.Text).
 			Padding(0, 1),
 		Selected: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FFFFFF")).
-			Background(lipgloss.Color("#7C3AED")).
+			Foreground(th.OnPrimary).
+			Background(th.Primary).
 			Bold(true).
 			Padding(0, 1),
 		Border: lipgloss.NewStyle().
 			BorderStyle(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("#374151")),
+			BorderForeground(th.Border),
 		Title: lipgloss.NewStyle().
 			Bold(true).
-			Foreground(lipgloss.Color("#7C3AED")),
+			Foreground(th.Primary),
 		SearchMatch: lipgloss.NewStyle().
-			Background(lipgloss.Color("#CA8A04")).
-			Foreground(lipgloss.Color("#000000")),
+			Background(th.CurrentMatch).
+			Foreground(th.OnMatch),
+		Bar: defaultBarStyle(),
+		Marked: lipgloss.NewStyle().
+			Foreground(th.Secondary).
+			Bold(true).
+			Padding(0, 1),
 	}
 }
 
 // NewTableModel creates a new table model
 func NewTableModel(columns []table.Column, title string) TableModel {
+	th := theme.Current()
 	t := table.New(
 		table.WithColumns(columns),
 		table.WithFocused(true),
@@ -142,17 +234,17 @@ func NewTableModel(columns []table.Column, title string) TableModel {
 	s := table.DefaultStyles()
 	s.Header = s.Header.
 		BorderStyle(lipgloss.NormalBorder()).
-		BorderForeground(lipgloss.Color("#374151")).
+		BorderForeground(th.Border).
 		BorderBottom(true).
 		Bold(true).
-		Foreground(lipgloss.Color("#F59E0B"))
+		Foreground(th.Accent)
 	// Selected style for the entire row - background color will extend to cell width
 	s.Selected = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FFFFFF")).
-		Background(lipgloss.Color("#7C3AED")).
+		Foreground(th.OnPrimary).
+		Background(th.Primary).
 		Bold(true)
 	s.Cell = s.Cell.
-		Foreground(lipgloss.Color("#E5E7EB"))
+		Foreground(th.Text)
 
 	t.SetStyles(s)
 	t.Focus() // Ensure table starts focused
@@ -176,14 +268,44 @@ func (m TableModel) SetShowTitle(show bool) TableModel {
 
 // SetRows sets the table rows
 func (m TableModel) SetRows(rows []table.Row) TableModel {
-	m.rows = rows
+	m.allRows = rows
+	m.applyView()
 	m.cursor = 0
 	m.scrollOffset = 0
-	// Clear search when data changes
+	// Clear search and selection when data changes
 	m.searchQuery = ""
 	m.searchIndex = -1
 	m.searchCount = 0
 	m.matchRows = nil
+	m.selection = nil
+	m.ranging = false
+	m.computeBarStats()
+	return m
+}
+
+// UpdateRow replaces the row at index, as passed to SetRows, keeping the
+// selection, scroll position and search
+func (m TableModel) UpdateRow(index int, row table.Row) TableModel {
+	if index < 0 || index >= len(m.allRows) {
+		return m
+	}
+	selected := m.SelectedRow()
+
+	rows := make([]table.Row, len(m.allRows))
+	copy(rows, m.allRows)
+	rows[index] = row
+	m.allRows = rows
+	m.applyView()
+	m.computeBarStats()
+
+	for pos := range m.rows {
+		if m.sourceIndex(pos) == selected {
+			m.cursor = pos
+			break
+		}
+	}
+	m.cursor = max(0, min(m.cursor, len(m.rows)-1))
+	m.ensureCursorVisible()
 	return m
 }
 
@@ -227,24 +349,218 @@ func (m TableModel) SetColumns(columns []table.Column) TableModel {
 	return m
 }
 
-// SelectedRow returns the currently selected row index
+// Columns returns the table columns
+func (m TableModel) Columns() []table.Column {
+	return m.columns
+}
+
+// SetCursor selects the row at index, clamped to the available rows. When a
+// filter hides that row the cursor moves to the first visible row
+func (m TableModel) SetCursor(index int) TableModel {
+	m.cursor = max(0, min(m.displayIndex(index), len(m.rows)-1))
+	m.ensureCursorVisible()
+	return m
+}
+
+// SelectedRow returns the currently selected row index. The index always
+// refers to the rows passed to SetRows, even while rows are filtered or sorted
 func (m TableModel) SelectedRow() int {
-	return m.cursor
+	return m.sourceIndex(m.cursor)
 }
 
 // SelectedRowData returns the data for the selected row
 func (m TableModel) SelectedRowData() interface{} {
-	if m.cursor >= 0 && m.cursor < len(m.rowData) {
-		return m.rowData[m.cursor]
+	if index := m.SelectedRow(); index >= 0 && index < len(m.rowData) {
+		return m.rowData[index]
 	}
 	return nil
 }
 
-// RowCount returns the number of rows
+// RowCount returns the number of visible rows
 func (m TableModel) RowCount() int {
 	return len(m.rows)
 }
 
+// TotalRowCount returns the number of rows before filtering
+func (m TableModel) TotalRowCount() int {
+	return len(m.allRows)
+}
+
+// Rows returns the visible rows in display order
+func (m TableModel) Rows() []table.Row {
+	return m.rows
+}
+
+// Title returns the table title
+func (m TableModel) Title() string {
+	return m.title
+}
+
+// sourceIndex maps a visible row position to its index in allRows
+func (m TableModel) sourceIndex(pos int) int {
+	if m.sourceRows == nil {
+		return pos
+	}
+	if pos < 0 || pos >= len(m.sourceRows) {
+		return -1
+	}
+	return m.sourceRows[pos]
+}
+
+// displayIndex maps an index in allRows to its visible row position, 0 if hidden
+func (m TableModel) displayIndex(index int) int {
+	if m.sourceRows == nil {
+		return index
+	}
+	for pos, source := range m.sourceRows {
+		if source == index {
+			return pos
+		}
+	}
+	return 0
+}
+
+// reselect moves the cursor back to the row at source index selected after
+// the visible rows changed, or to the top if it is no longer visible
+func (m *TableModel) reselect(selected int) {
+	// The rows of a range being selected no longer lie between its ends
+	m.ranging = false
+	m.scrollOffset = 0
+	m.cursor = 0
+	if selected >= 0 {
+		for pos := range m.rows {
+			if m.sourceIndex(pos) == selected {
+				m.cursor = pos
+				break
+			}
+		}
+	}
+	m.ensureCursorVisible()
+}
+
+// Filter hides rows that do not match query. Whitespace-separated terms must
+// all match; a term of the form col:value only checks the column whose title
+// starts with col, any other term matches text in any cell. An empty query
+// restores the full set of rows
+func (m TableModel) Filter(query string) TableModel {
+	selected := m.SelectedRow()
+	before := m.currentView()
+	m.filterQuery = strings.TrimSpace(query)
+	m.record(before)
+	m.applyView()
+	m = m.ClearSearch()
+	m.reselect(selected)
+	m.computeBarStats()
+	return m
+}
+
+// FilterQuery returns the active filter query
+func (m TableModel) FilterQuery() string {
+	return m.filterQuery
+}
+
+// SetRowFilter hides the rows for which keep returns false. keep receives the
+// index of a row passed to SetRows; label describes the filter in the status
+// line. A nil keep removes the row filter
+func (m TableModel) SetRowFilter(label string, keep func(index int) bool) TableModel {
+	selected := m.SelectedRow()
+	m.rowFilter = keep
+	m.rowFilterLabel = label
+	if keep == nil {
+		m.rowFilterLabel = ""
+	}
+	m.applyView()
+	m = m.ClearSearch()
+	m.reselect(selected)
+	m.computeBarStats()
+	return m
+}
+
+// filtered reports whether the filter query or a row filter hides rows
+func (m TableModel) filtered() bool {
+	return m.filterQuery != "" || m.rowFilter != nil
+}
+
+// filterLabel describes the active filters, e.g. "web [tag env=prod]"
+func (m TableModel) filterLabel() string {
+	switch {
+	case m.rowFilter == nil:
+		return m.filterQuery
+	case m.filterQuery == "":
+		return fmt.Sprintf("[%s]", m.rowFilterLabel)
+	default:
+		return fmt.Sprintf("%s [%s]", m.filterQuery, m.rowFilterLabel)
+	}
+}
+
+// applyView rebuilds the visible rows from allRows, the sort order and the
+// filter query
+func (m *TableModel) applyView() {
+	if !m.filtered() && m.sortOrder == SortNone {
+		m.rows = m.allRows
+		m.sourceRows = nil
+		return
+	}
+
+	order := sortedRowOrder(m.allRows, m.sortColumn, m.sortOrder)
+	terms := parseFilterQuery(m.columns, m.filterQuery)
+	m.rows = make([]table.Row, 0, len(order))
+	m.sourceRows = make([]int, 0, len(order))
+	for _, i := range order {
+		if matchesAll(terms, m.allRows[i]) && (m.rowFilter == nil || m.rowFilter(i)) {
+			m.rows = append(m.rows, m.allRows[i])
+			m.sourceRows = append(m.sourceRows, i)
+		}
+	}
+}
+
+// SortBy sorts rows by column, or reverses the order if rows are already
+// sorted ascending by that column. The selected row stays selected
+func (m TableModel) SortBy(column int) TableModel {
+	if column < 0 || column >= len(m.columns) {
+		return m
+	}
+	order := SortAscending
+	if column == m.sortColumn && m.sortOrder == SortAscending {
+		order = SortDescending
+	}
+	return m.setSort(column, order)
+}
+
+// SetSort sorts rows by column in the given order, e.g. for a page key that
+// puts the largest values first. The selected row stays selected
+func (m TableModel) SetSort(column int, order SortOrder) TableModel {
+	if column < 0 || column >= len(m.columns) {
+		return m
+	}
+	return m.setSort(column, order)
+}
+
+// CycleSort steps the sort column through ascending, descending and unsorted
+func (m TableModel) CycleSort() TableModel {
+	switch m.sortOrder {
+	case SortNone:
+		return m.setSort(m.sortColumn, SortAscending)
+	case SortAscending:
+		return m.setSort(m.sortColumn, SortDescending)
+	default:
+		return m.setSort(m.sortColumn, SortNone)
+	}
+}
+
+// setSort applies a sort and keeps the cursor on the selected row
+func (m TableModel) setSort(column int, order SortOrder) TableModel {
+	selected := m.SelectedRow()
+	before := m.currentView()
+	m.sortColumn = column
+	m.sortOrder = order
+	m.record(before)
+	m.applyView()
+	m = m.ClearSearch()
+	m.reselect(selected)
+	return m
+}
+
 // Init implements tea.Model
 func (m TableModel) Init() tea.Cmd {
 	return nil
@@ -268,6 +584,12 @@ func (m TableModel) Update(msg tea.Msg) (TableModel, tea.Cmd) {
 			if m.yankCount >= 2 {
 				// Double-y detected, return copy command
 				m.yankCount = 0
+				if m.SelectionCount() > 0 {
+					data := m.SelectionData()
+					return m, func() tea.Msg {
+						return CopyDataMsg{Data: data}
+					}
+				}
 				if data := m.SelectedRowData(); data != nil {
 					return m, func() tea.Msg {
 						return CopyDataMsg{Data: data}
@@ -276,11 +598,41 @@ func (m TableModel) Update(msg tea.Msg) (TableModel, tea.Cmd) {
 			}
 			return m, nil
 
+		case key.Matches(msg, m.keys.Select):
+			m = m.ToggleSelection()
+			return m, nil
+
+		case key.Matches(msg, m.keys.SelectRange):
+			m = m.toggleRange()
+			return m, nil
+
+		case key.Matches(msg, m.keys.Sort):
+			m = m.CycleSort()
+			return m, nil
+
+		case key.Matches(msg, m.keys.SortBy):
+			// Digits count the columns shown
+			if n, visible := int(msg.String()[0]-'1'), m.visibleColumns(); n < len(visible) {
+				m = m.SortBy(visible[n])
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.Columns):
+			return m, m.requestColumnChooser()
+
+		case key.Matches(msg, m.keys.Undo):
+			m, _ = m.Undo()
+			return m, nil
+
+		case key.Matches(msg, m.keys.Redo):
+			m, _ = m.Redo()
+			return m, nil
+
 		case key.Matches(msg, m.keys.Enter):
 			// Return selection message
 			return m, func() tea.Msg {
 				return TableSelectMsg{
-					Index: m.cursor,
+					Index: m.SelectedRow(),
 					Data:  m.SelectedRowData(),
 				}
 			}
@@ -313,11 +665,74 @@ func (m TableModel) Update(msg tea.Msg) (TableModel, tea.Cmd) {
 			}
 			return m, nil
 		}
+
+	case tea.MouseMsg:
+		return m.handleMouse(msg)
+
+	case ColumnLayoutMsg:
+		if !m.sameLayout(msg.Layout) {
+			m = m.SetColumnLayout(msg.Layout)
+		}
+		return m, nil
 	}
 
 	return m, nil
 }
 
+// handleMouse handles a mouse event positioned relative to the table: the
+// wheel moves the cursor, a click selects a row or sorts by the column whose
+// header it is on, and a double click opens the row like enter
+func (m TableModel) handleMouse(msg tea.MouseMsg) (TableModel, tea.Cmd) {
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		m.moveCursor(-WheelRows)
+		return m, nil
+	case tea.MouseButtonWheelDown:
+		m.moveCursor(WheelRows)
+		return m, nil
+	}
+	if !IsClick(msg) {
+		return m, nil
+	}
+
+	// The header is below the title and the top border, the rows below the
+	// header and its separator
+	header := 1
+	if m.showTitle && m.title != "" {
+		header++
+	}
+	line := msg.Y - header
+	if line == 0 {
+		if column := m.columnAt(msg.X); column >= 0 {
+			return m.SortBy(column), nil
+		}
+		return m, nil
+	}
+
+	row := m.scrollOffset + line - 2
+	if line < 2 || line-2 >= m.visibleRows() || row >= len(m.rows) {
+		return m, nil
+	}
+	m.cursor = row
+	if m.clicks.Click(row) {
+		return m, PressEnter()
+	}
+	return m, nil
+}
+
+// columnAt returns the column at x, relative to the table, or -1 for none
+func (m TableModel) columnAt(x int) int {
+	// Past the left border, each column is padded on both sides
+	right := 1
+	for _, i := range m.visibleColumns() {
+		right += m.columns[i].Width + 2
+		if x >= 1 && x < right {
+			return i
+		}
+	}
+	return -1
+}
+
 // moveCursor moves the cursor by delta and ensures it stays within bounds
 func (m *TableModel) moveCursor(delta int) {
 	if len(m.rows) == 0 {
@@ -362,17 +777,25 @@ func (m TableModel) visibleRows() int {
 
 // View implements tea.Model
 func (m TableModel) View() string {
+	th := theme.Current()
 	var b strings.Builder
 
 	// Title (only show if explicitly enabled)
 	if m.showTitle && m.title != "" {
-		title := m.styles.Title.Render(m.title)
-		b.WriteString(title)
+		title := m.title
+		if m.filtered() {
+			title = fmt.Sprintf(i18n.T(i18n.KeyTableRowsOf), title, len(m.rows), len(m.allRows))
+		}
+		if count := m.SelectionCount(); count > 0 {
+			title = fmt.Sprintf(i18n.T(i18n.KeyTableSelectedTitle), title, count)
+		}
+		b.WriteString(m.styles.Title.Render(title))
 		b.WriteString("\n")
 	}
 
-	// Render custom table
-	tableContent := m.renderTable()
+	// Render custom table, cutting rows wider than the table rather than
+	// wrapping them, e.g. in the left pane of the split view
+	tableContent := lipgloss.NewStyle().MaxWidth(max(0, m.width-2)).Render(m.renderTable())
 
 	// Add border
 	bordered := m.styles.Border.
@@ -381,12 +804,33 @@ func (m TableModel) View() string {
 
 	b.WriteString(bordered)
 
+	// Filter info
+	if m.filtered() {
+		filterInfo := fmt.Sprintf(i18n.T(i18n.KeyTableFilterInfo), m.filterLabel(), len(m.rows), len(m.allRows))
+		b.WriteString("\n")
+		b.WriteString(lipgloss.NewStyle().
+			Foreground(th.Success).
+			Render(filterInfo))
+	}
+
+	// Selection info
+	if count := m.SelectionCount(); count > 0 || m.ranging {
+		info := fmt.Sprintf(i18n.T(i18n.KeyTableSelectionInfo), count)
+		if m.ranging {
+			info = fmt.Sprintf(i18n.T(i18n.KeyTableRangeInfo), count)
+		}
+		b.WriteString("\n")
+		b.WriteString(lipgloss.NewStyle().
+			Foreground(th.Secondary).
+			Render(info))
+	}
+
 	// Search info
 	if m.searchQuery != "" {
-		searchInfo := fmt.Sprintf(" Search: %s (%d/%d) ", m.searchQuery, m.searchIndex+1, m.searchCount)
+		searchInfo := fmt.Sprintf(i18n.T(i18n.KeySearchInfo), m.searchQuery, m.searchIndex+1, m.searchCount)
 		b.WriteString("\n")
 		b.WriteString(lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#F59E0B")).
+			Foreground(th.Accent).
 			Render(searchInfo))
 	}
 
@@ -398,20 +842,26 @@ func (m TableModel) renderTable() string {
 	var b strings.Builder
 
 	// Render header
-	headerCells := make([]string, len(m.columns))
-	for i, col := range m.columns {
+	columns := m.visibleColumns()
+	headerCells := make([]string, len(columns))
+	for pos, i := range columns {
+		col := m.columns[i]
 		cell := truncateString(col.Title, col.Width)
+		if i == m.sortColumn && m.sortOrder != SortNone {
+			// Keep the indicator visible when the title is truncated
+			cell = truncateString(col.Title, col.Width-2) + " " + m.sortOrder.indicator()
+		}
 		cell = padString(cell, col.Width)
-		headerCells[i] = m.styles.Header.Render(cell)
+		headerCells[pos] = m.styles.Header.Render(cell)
 	}
 	b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, headerCells...))
 	b.WriteString("\n")
 
 	// Header separator
-	separatorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#374151"))
+	separatorStyle := lipgloss.NewStyle().Foreground(theme.Current().Border)
 	totalWidth := 0
-	for _, col := range m.columns {
-		totalWidth += col.Width + 2 // +2 for padding
+	for _, i := range columns {
+		totalWidth += m.columns[i].Width + 2 // +2 for padding
 	}
 	b.WriteString(separatorStyle.Render(strings.Repeat("─", totalWidth)))
 	b.WriteString("\n")
@@ -426,29 +876,42 @@ func (m TableModel) renderTable() string {
 	for rowIdx := m.scrollOffset; rowIdx < endIdx; rowIdx++ {
 		row := m.rows[rowIdx]
 		isSelected := rowIdx == m.cursor
+		isMarked := m.inSelection(rowIdx)
 
-		rowCells := make([]string, len(m.columns))
-		for colIdx, col := range m.columns {
+		rowCells := make([]string, len(columns))
+		for pos, colIdx := range columns {
+			col := m.columns[colIdx]
 			cellContent := ""
 			if colIdx < len(row) {
 				cellContent = row[colIdx]
 			}
+			if pos == 0 && isMarked {
+				cellContent = "* " + cellContent
+			}
 
-			// Truncate and pad cell content
-			displayContent := truncateString(cellContent, col.Width)
-			displayContent = padString(displayContent, col.Width)
+			// Truncate and pad cell content, or draw an inline bar for numeric columns
+			displayContent, isBar := "", false
+			if m.barColumns[colIdx] {
+				displayContent, isBar = m.renderBarCell(colIdx, cellContent, col.Width, isSelected)
+			}
+			if !isBar {
+				displayContent = truncateString(cellContent, col.Width)
+				displayContent = padString(displayContent, col.Width)
 
-			// Apply search highlighting to the content
-			if m.searchQuery != "" {
-				displayContent = m.highlightSearchMatch(displayContent)
+				// Apply search highlighting to the content
+				if m.searchQuery != "" {
+					displayContent = m.highlightSearchMatch(displayContent)
+				}
 			}
 
 			// Apply row style
 			if isSelected {
 				// For selected row, apply selected style
-				rowCells[colIdx] = m.styles.Selected.Render(displayContent)
+				rowCells[pos] = m.styles.Selected.Render(displayContent)
+			} else if isMarked {
+				rowCells[pos] = m.styles.Marked.Render(displayContent)
 			} else {
-				rowCells[colIdx] = m.styles.Cell.Render(displayContent)
+				rowCells[pos] = m.styles.Cell.Render(displayContent)
 			}
 		}
 
@@ -461,9 +924,9 @@ func (m TableModel) renderTable() string {
 	// Fill empty rows if needed
 	for i := endIdx - m.scrollOffset; i < visible; i++ {
 		b.WriteString("\n")
-		emptyCells := make([]string, len(m.columns))
-		for colIdx, col := range m.columns {
-			emptyCells[colIdx] = m.styles.Cell.Render(strings.Repeat(" ", col.Width))
+		emptyCells := make([]string, len(columns))
+		for pos, colIdx := range columns {
+			emptyCells[pos] = m.styles.Cell.Render(strings.Repeat(" ", m.columns[colIdx].Width))
 		}
 		b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, emptyCells...))
 	}
@@ -543,11 +1006,16 @@ func (m TableModel) Search(query string) TableModel {
 	m.searchQuery = query
 	lowerQuery := strings.ToLower(query)
 
-	// Find matching rows
+	// Find matching rows, in the columns shown
 	m.matchRows = nil
+	columns := m.visibleColumns()
 	for i, row := range m.rows {
-		for _, cell := range row {
-			if strings.Contains(strings.ToLower(cell), lowerQuery) {
+		if m.searchMatcher != nil && m.searchMatcher(m.sourceIndex(i), query) {
+			m.matchRows = append(m.matchRows, i)
+			continue
+		}
+		for _, col := range columns {
+			if col < len(row) && strings.Contains(strings.ToLower(row[col]), lowerQuery) {
 				m.matchRows = append(m.matchRows, i)
 				break
 			}
@@ -617,6 +1085,13 @@ func (m TableModel) PrevSearchMatch() TableModel {
 	return m
 }
 
+// SetSearchMatcher makes search also find the rows match reports for query.
+// match receives the index of a row passed to SetRows; nil removes it
+func (m TableModel) SetSearchMatcher(match func(index int, query string) bool) TableModel {
+	m.searchMatcher = match
+	return m
+}
+
 // ClearSearch clears the search
 func (m TableModel) ClearSearch() TableModel {
 	m.searchQuery = ""
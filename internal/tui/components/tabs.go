@@ -0,0 +1,82 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"aliyun-tui-viewer/internal/theme"
+)
+
+// TabsModel represents the tab bar below the header, naming each open tab
+// after its page. It shows only while more than one tab is open
+type TabsModel struct {
+	titles []string
+	active int
+	width  int
+	styles TabsStyles
+}
+
+// TabsStyles defines styles for the tab bar
+type TabsStyles struct {
+	Tab    lipgloss.Style
+	Active lipgloss.Style
+}
+
+// DefaultTabsStyles returns default tab bar styles
+func DefaultTabsStyles() TabsStyles {
+	th := theme.Current()
+	return TabsStyles{
+		Tab: lipgloss.NewStyle().
+			Foreground(th.SubtleText).
+			Padding(0, 1),
+		Active: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(th.OnPrimary).
+			Background(th.Primary).
+			Padding(0, 1),
+	}
+}
+
+// NewTabsModel creates an empty tab bar
+func NewTabsModel() TabsModel {
+	return TabsModel{styles: DefaultTabsStyles()}
+}
+
+// SetTabs sets the titles of the open tabs and which of them is current
+func (m TabsModel) SetTabs(titles []string, active int) TabsModel {
+	m.titles = titles
+	m.active = active
+	return m
+}
+
+// SetWidth sets the tab bar width
+func (m TabsModel) SetWidth(width int) TabsModel {
+	m.width = width
+	return m
+}
+
+// View renders the tabs numbered from 1, their titles shortened to share the
+// width, or "" for a single tab
+func (m TabsModel) View() string {
+	if len(m.titles) <= 1 {
+		return ""
+	}
+
+	// Each tab has a padding column on both sides
+	titleWidth := m.width/len(m.titles) - 2
+	tabs := make([]string, len(m.titles))
+	for i, title := range m.titles {
+		label := fmt.Sprintf("%d %s", i+1, title)
+		if titleWidth > 0 {
+			label = truncateString(label, titleWidth)
+		}
+		style := m.styles.Tab
+		if i == m.active {
+			style = m.styles.Active
+		}
+		tabs[i] = style.Render(label)
+	}
+	return lipgloss.NewStyle().MaxWidth(m.width).Render(strings.Join(tabs, ""))
+}
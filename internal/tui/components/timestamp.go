@@ -0,0 +1,183 @@
+package components
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"aliyun-tui-viewer/internal/config"
+	"aliyun-tui-viewer/internal/i18n"
+)
+
+// TimeLayout is how timestamps are shown in the local timezone
+const TimeLayout = "2006-01-02 15:04:05"
+
+// timeLayouts are the layouts of the timestamps the APIs return, tried in
+// order. Those without a zone are in local time
+var timeLayouts = []string{
+	time.RFC3339,               // Most APIs, e.g. "2025-06-01T07:04:05Z", fractions included
+	"2006-01-02T15:04Z07:00",   // ECS and SLB, e.g. "2025-06-01T07:04Z"
+	"2006-01-02T15:04:05Z0700", // e.g. "2025-06-01T15:04:05+0800"
+	TimeLayout,                 // SLS and RocketMQ, already local
+	"2006-01-02",
+	time.RFC1123,
+}
+
+// relativeUnits are the units of relative times, largest first. A time is
+// given in the largest unit it is a whole number of away, and one less than
+// a minute away is "just now"
+var relativeUnits = []struct {
+	size time.Duration
+	key  string
+}{
+	{365 * 24 * time.Hour, i18n.KeyTimeYears},
+	{30 * 24 * time.Hour, i18n.KeyTimeMonths},
+	{24 * time.Hour, i18n.KeyTimeDays},
+	{time.Hour, i18n.KeyTimeHours},
+	{time.Minute, i18n.KeyTimeMinutes},
+}
+
+// timeFormat caches the config file "time_format" field
+var timeFormat string
+
+// TimeFormat returns how timestamps are shown: config.TimeFormatAbsolute,
+// config.TimeFormatRelative or config.TimeFormatBoth
+func TimeFormat() string {
+	if timeFormat == "" {
+		timeFormat = config.GetTimeFormat()
+	}
+	return timeFormat
+}
+
+// SetTimeFormat sets how timestamps are shown (for testing purposes)
+func SetTimeFormat(format string) {
+	timeFormat = format
+}
+
+// ParseTime parses a timestamp as the APIs return it, reporting whether it
+// is in one of the known layouts
+func ParseTime(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// FormatTime formats t in the local timezone, from now, or both, as the
+// config file asks. It is "-" for the zero time
+func FormatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	switch TimeFormat() {
+	case config.TimeFormatRelative:
+		return FormatRelativeTime(t)
+	case config.TimeFormatBoth:
+		return fmt.Sprintf("%s (%s)", t.Local().Format(TimeLayout), FormatRelativeTime(t))
+	default:
+		return t.Local().Format(TimeLayout)
+	}
+}
+
+// FormatTimeString formats a timestamp as the APIs return it like
+// FormatTime. A value in no known layout is shown as is, "-" when empty
+func FormatTimeString(value string) string {
+	t, ok := ParseTime(value)
+	if !ok {
+		if strings.TrimSpace(value) == "" {
+			return "-"
+		}
+		return value
+	}
+	return FormatTime(t)
+}
+
+// FormatUnixMillis formats a Unix timestamp in milliseconds like FormatTime,
+// "-" for 0
+func FormatUnixMillis(ms int64) string {
+	if ms == 0 {
+		return "-"
+	}
+	return FormatTime(time.UnixMilli(ms))
+}
+
+// FormatRelativeTime formats how long ago t was, e.g. "2h ago", or how soon
+// it comes, e.g. "in 3d"
+func FormatRelativeTime(t time.Time) string {
+	span := time.Until(t)
+	template := i18n.T(i18n.KeyTimeIn)
+	if span < 0 {
+		span = -span
+		template = i18n.T(i18n.KeyTimeAgo)
+	}
+	for _, unit := range relativeUnits {
+		if span >= unit.size {
+			return fmt.Sprintf(template, fmt.Sprintf(i18n.T(unit.key), int(span/unit.size)))
+		}
+	}
+	return i18n.T(i18n.KeyTimeJustNow)
+}
+
+// parseRelativeTime parses a time FormatRelativeTime returned back into how
+// far from now it is, negative in the past, to sort relative times by
+func parseRelativeTime(s string) (time.Duration, bool) {
+	s = strings.TrimSpace(s)
+	if s == i18n.T(i18n.KeyTimeJustNow) {
+		return 0, true
+	}
+	for _, side := range []struct {
+		key  string
+		sign time.Duration
+	}{{i18n.KeyTimeAgo, -1}, {i18n.KeyTimeIn, 1}} {
+		prefix, suffix, _ := strings.Cut(i18n.T(side.key), "%s")
+		if !strings.HasPrefix(s, prefix) || !strings.HasSuffix(s, suffix) || len(s) <= len(prefix)+len(suffix) {
+			continue
+		}
+		span := s[len(prefix) : len(s)-len(suffix)]
+		digits := len(span) - len(strings.TrimLeft(span, "0123456789"))
+		n, err := strconv.Atoi(span[:digits])
+		if err != nil {
+			continue
+		}
+		for _, unit := range relativeUnits {
+			if fmt.Sprintf(i18n.T(unit.key), n) == span {
+				return side.sign * time.Duration(n) * unit.size, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// parseTimeCell parses a cell FormatTime rendered into Unix seconds, so that
+// times sort by when they are rather than by their text. Relative times are
+// counted from the current minute, as precise as they are
+func parseTimeCell(s string) (int64, bool) {
+	s = strings.TrimSpace(s)
+	if len(s) >= len(TimeLayout) {
+		if t, err := time.ParseInLocation(TimeLayout, s[:len(TimeLayout)], time.Local); err == nil {
+			return t.Unix(), true
+		}
+	}
+	if span, ok := parseRelativeTime(s); ok {
+		return time.Now().Truncate(time.Minute).Add(span).Unix(), true
+	}
+	return 0, false
+}
+
+func formatTimeCell(value interface{}) string {
+	switch v := value.(type) {
+	case time.Time:
+		return FormatTime(v)
+	case string:
+		return FormatTimeString(v)
+	default:
+		return formatText(value)
+	}
+}
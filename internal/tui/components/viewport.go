@@ -11,6 +11,9 @@ import (
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/theme"
 )
 
 // ViewportModel wraps bubbles/viewport for JSON detail views
@@ -21,6 +24,7 @@ type ViewportModel struct {
 	showHelp    bool // Whether to show help text in View
 	content     string
 	rawContent  string // Original unhighlighted content
+	syntax      string // Highlighting for text content; empty means JSON-marshaled data
 	data        interface{}
 	width       int
 	height      int
@@ -107,27 +111,28 @@ type ViewportStyles struct {
 
 // DefaultViewportStyles returns default viewport styles
 func DefaultViewportStyles() ViewportStyles {
+	th := theme.Current()
 	return ViewportStyles{
 		Border: lipgloss.NewStyle().
 			BorderStyle(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("#374151")),
+			BorderForeground(th.Border),
 		Title: lipgloss.NewStyle().
 			Bold(true).
-			Foreground(lipgloss.Color("#7C3AED")),
+			Foreground(th.Primary),
 		JSONKey: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#06B6D4")),
+			Foreground(th.Secondary),
 		JSONString: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#10B981")),
+			Foreground(th.Success),
 		JSONNumber: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#F59E0B")),
+			Foreground(th.Accent),
 		JSONBoolean: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#7C3AED")),
+			Foreground(th.Primary),
 		JSONNull: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#6B7280")).
+			Foreground(th.MutedText).
 			Italic(true),
 		SearchMatch: lipgloss.NewStyle().
-			Background(lipgloss.Color("#CA8A04")).
-			Foreground(lipgloss.Color("#000000")),
+			Background(th.CurrentMatch).
+			Foreground(th.OnMatch),
 	}
 }
 
@@ -153,6 +158,38 @@ func NewViewportModel(title string, data interface{}) ViewportModel {
 	return m
 }
 
+// Syntaxes supported for text content
+const (
+	SyntaxJSON  = "json"
+	SyntaxYAML  = "yaml"
+	SyntaxPlain = "plain"
+)
+
+// TextContent is raw text shown in a viewport; it is copied and opened as-is
+// rather than marshaled as JSON
+type TextContent string
+
+// NewTextViewportModel creates a viewport showing raw text highlighted as syntax
+func NewTextViewportModel(title, text, syntax string) ViewportModel {
+	m := NewViewportModel(title, nil)
+	return m.SetText(text, syntax)
+}
+
+// SetText replaces the content with raw text highlighted as syntax.
+// Copy, edit and pager act on the text itself.
+func (m ViewportModel) SetText(text, syntax string) ViewportModel {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\t", "    ")
+
+	m.data = TextContent(text)
+	m.syntax = syntax
+	m.rawContent = text
+	m.content = m.highlight(m.rawContent)
+	m.viewport.SetContent(m.content)
+	m.viewport.GotoTop()
+	return m
+}
+
 // SetShowTitle sets whether to show the title in the view
 func (m ViewportModel) SetShowTitle(show bool) ViewportModel {
 	m.showTitle = show
@@ -181,6 +218,66 @@ func (m ViewportModel) formatContent() ViewportModel {
 	return m
 }
 
+// highlight applies the highlighting matching the content's syntax
+func (m ViewportModel) highlight(s string) string {
+	switch m.syntax {
+	case SyntaxYAML:
+		return m.highlightYAML(s)
+	case SyntaxPlain:
+		return s
+	default:
+		return m.highlightJSON(s)
+	}
+}
+
+// yamlKeyRegex matches "key:" (optionally a list item) at the start of a YAML line
+var yamlKeyRegex = regexp.MustCompile(`^(\s*(?:-\s+)?)([^\s#:'"][^:]*?|"[^"]*"|'[^']*')(:)(\s+.*|$)`)
+
+// yamlNumberRegex matches plain integer and decimal scalars
+var yamlNumberRegex = regexp.MustCompile(`^-?\d+(\.\d+)?$`)
+
+// highlightYAML applies syntax highlighting to YAML, line by line
+func (m ViewportModel) highlightYAML(yamlStr string) string {
+	lines := strings.Split(yamlStr, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "#"):
+			lines[i] = m.styles.JSONNull.Render(line)
+		case trimmed == "---" || trimmed == "...":
+			lines[i] = m.styles.JSONBoolean.Render(line)
+		default:
+			if parts := yamlKeyRegex.FindStringSubmatch(line); parts != nil {
+				value := strings.TrimLeft(parts[4], " ")
+				spacing := parts[4][:len(parts[4])-len(value)]
+				lines[i] = parts[1] + m.styles.JSONKey.Render(parts[2]) + parts[3] + spacing + m.highlightYAMLScalar(value)
+			} else if strings.HasPrefix(trimmed, "- ") {
+				indent := line[:len(line)-len(strings.TrimLeft(line, " "))]
+				lines[i] = indent + "- " + m.highlightYAMLScalar(strings.TrimPrefix(trimmed, "- "))
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// highlightYAMLScalar styles a YAML scalar value by its apparent type
+func (m ViewportModel) highlightYAMLScalar(value string) string {
+	switch {
+	case value == "":
+		return value
+	case value == "null" || value == "~":
+		return m.styles.JSONNull.Render(value)
+	case value == "true" || value == "false":
+		return m.styles.JSONBoolean.Render(value)
+	case yamlNumberRegex.MatchString(value):
+		return m.styles.JSONNumber.Render(value)
+	case strings.HasPrefix(value, "#"):
+		return m.styles.JSONNull.Render(value)
+	default:
+		return m.styles.JSONString.Render(value)
+	}
+}
+
 // highlightJSON applies syntax highlighting to JSON
 func (m ViewportModel) highlightJSON(jsonStr string) string {
 	var result strings.Builder
@@ -262,6 +359,7 @@ func (m ViewportModel) highlightLine(line string) string {
 // SetData sets the data and reformats
 func (m ViewportModel) SetData(data interface{}) ViewportModel {
 	m.data = data
+	m.syntax = ""
 	return m.formatContent()
 }
 
@@ -357,6 +455,7 @@ func (m ViewportModel) Update(msg tea.Msg) (ViewportModel, tea.Cmd) {
 
 // View implements tea.Model
 func (m ViewportModel) View() string {
+	th := theme.Current()
 	var b strings.Builder
 
 	// Title (only show if explicitly enabled)
@@ -369,8 +468,8 @@ func (m ViewportModel) View() string {
 	// Help text (only show if explicitly enabled)
 	if m.showHelp {
 		help := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#6B7280")).
-			Render("q/Esc: back | yy: copy | e: edit | v: pager | /: search | n/N: next/prev")
+			Foreground(th.MutedText).
+			Render(i18n.T(i18n.KeyViewportHelp))
 		b.WriteString(help)
 		b.WriteString("\n")
 	}
@@ -384,10 +483,10 @@ func (m ViewportModel) View() string {
 
 	// Search info
 	if m.searchQuery != "" {
-		searchInfo := fmt.Sprintf(" Search: %s (%d/%d) ", m.searchQuery, m.searchIndex+1, m.searchCount)
+		searchInfo := fmt.Sprintf(i18n.T(i18n.KeySearchInfo), m.searchQuery, m.searchIndex+1, m.searchCount)
 		b.WriteString("\n")
 		b.WriteString(lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#F59E0B")).
+			Foreground(th.Accent).
 			Render(searchInfo))
 	}
 
@@ -400,7 +499,7 @@ func (m ViewportModel) Search(query string) ViewportModel {
 		m.searchQuery = ""
 		m.searchIndex = -1
 		m.searchCount = 0
-		m.content = m.highlightJSON(m.rawContent)
+		m.content = m.highlight(m.rawContent)
 		m.viewport.SetContent(m.content)
 		return m
 	}
@@ -427,8 +526,8 @@ func (m ViewportModel) Search(query string) ViewportModel {
 
 // highlightSearchMatches highlights search matches in the content
 func (m ViewportModel) highlightSearchMatches(query string) string {
-	// First apply JSON highlighting
-	highlighted := m.highlightJSON(m.rawContent)
+	// First apply syntax highlighting
+	highlighted := m.highlight(m.rawContent)
 
 	// Then highlight search matches (case insensitive)
 	lowerHighlighted := strings.ToLower(highlighted)
@@ -513,7 +612,7 @@ func (m ViewportModel) ClearSearch() ViewportModel {
 	m.searchQuery = ""
 	m.searchIndex = -1
 	m.searchCount = 0
-	m.content = m.highlightJSON(m.rawContent)
+	m.content = m.highlight(m.rawContent)
 	m.viewport.SetContent(m.content)
 	return m
 }
@@ -0,0 +1,221 @@
+package tui
+
+import (
+	"fmt"
+	"net"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/config"
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/pages"
+)
+
+// Action IDs for connection test, quick-connect and latency probe modals
+const (
+	actionConnTest = "conn.test"
+	actionConnect  = "conn.connect"
+	actionProbe    = "conn.probe"
+)
+
+// handleConnTestRequest asks for the endpoint to test and, for protocols the
+// test can log in with, the optional credentials
+func (m Model) handleConnTestRequest(msg pages.ConnTestRequestMsg) (Model, tea.Cmd) {
+	fields := []components.FormField{
+		{Key: "address", Label: i18n.T(i18n.KeyConnTestAddress), Value: msg.Address, Placeholder: "host:port"},
+	}
+	if msg.Protocol != service.ConnProtocolTCP {
+		fields = append(fields,
+			components.FormField{Key: "username", Label: i18n.T(i18n.KeyConnTestUsername)},
+			components.FormField{Key: "password", Label: i18n.T(i18n.KeyConnTestPassword), Secret: true},
+		)
+	}
+	m.modal = components.NewFormModal(actionConnTest, fmt.Sprintf(i18n.T(i18n.KeyConnTestTitle), msg.InstanceID), fields, msg)
+	return m, nil
+}
+
+// handleConnTestSubmitted runs the test with the entered endpoint
+func (m Model) handleConnTestSubmitted(msg components.FormSubmittedMsg) (Model, tea.Cmd) {
+	req, ok := msg.Data.(pages.ConnTestRequestMsg)
+	if !ok {
+		return m, nil
+	}
+	address := strings.TrimSpace(msg.Values["address"])
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		m.modal = components.NewErrorModal(i18n.T(i18n.KeyConnTestNoAddress))
+		return m, nil
+	}
+
+	m.loading = true
+	return m, TestInstanceConnection(req.InstanceID, service.ConnTestTarget{
+		Address:  address,
+		Protocol: req.Protocol,
+		Username: strings.TrimSpace(msg.Values["username"]),
+		Password: msg.Values["password"],
+	})
+}
+
+// handleConnTested reports the latency of a test, or which step failed so a
+// network problem can be told from a credential problem
+func (m Model) handleConnTested(msg ConnTestedMsg) (Model, tea.Cmd) {
+	m.loading = false
+	r := msg.Result
+	latency := r.Latency.Round(time.Millisecond).String()
+
+	switch r.Stage {
+	case service.ConnStageConnect:
+		m.modal = components.NewErrorModal(fmt.Sprintf(i18n.T(i18n.KeyConnTestConnectFailed), r.Address, r.Err))
+	case service.ConnStageAuth:
+		m.modal = components.NewErrorModal(fmt.Sprintf(i18n.T(i18n.KeyConnTestAuthFailed), r.Address, latency, r.Err))
+	default:
+		lines := []string{fmt.Sprintf(i18n.T(i18n.KeyConnTestConnected), r.Address, latency)}
+		if r.Server != "" {
+			lines = append(lines, fmt.Sprintf(i18n.T(i18n.KeyConnTestServer), r.Server))
+		}
+		if r.Authenticated {
+			lines = append(lines, i18n.T(i18n.KeyConnTestLoggedIn))
+		} else {
+			lines = append(lines, i18n.T(i18n.KeyConnTestNotLoggedIn))
+		}
+		m.modal = components.NewSuccessModal(strings.Join(lines, "\n"))
+	}
+	return m, nil
+}
+
+// handleConnectRequest asks for the account and endpoint to open the
+// instance's command line client with
+func (m Model) handleConnectRequest(msg pages.ConnectRequestMsg) (Model, tea.Cmd) {
+	template, err := config.GetConnectCommand(msg.Engine)
+	if err != nil {
+		m.modal = components.NewErrorModal(err.Error())
+		return m, nil
+	}
+	client := strings.Fields(template)[0]
+	m.modal = components.NewFormModal(actionConnect, fmt.Sprintf(i18n.T(i18n.KeyConnectTitle), msg.InstanceID, client),
+		[]components.FormField{
+			{Key: "account", Label: i18n.T(i18n.KeyConnectAccount), Value: msg.Account},
+			{Key: "address", Label: i18n.T(i18n.KeyConnTestAddress), Value: msg.Address, Placeholder: "host:port"},
+		},
+		msg)
+	return m, nil
+}
+
+// handleConnectSubmitted suspends the TUI and runs the client until it exits.
+// The client asks for the password itself, so it never passes through here
+func (m Model) handleConnectSubmitted(msg components.FormSubmittedMsg) (Model, tea.Cmd) {
+	req, ok := msg.Data.(pages.ConnectRequestMsg)
+	if !ok {
+		return m, nil
+	}
+	account := strings.TrimSpace(msg.Values["account"])
+	if account == "" {
+		m.modal = components.NewErrorModal(i18n.T(i18n.KeyConnectNoAccount))
+		return m, nil
+	}
+	host, port, err := net.SplitHostPort(strings.TrimSpace(msg.Values["address"]))
+	if err != nil {
+		m.modal = components.NewErrorModal(i18n.T(i18n.KeyConnTestNoAddress))
+		return m, nil
+	}
+
+	template, err := config.GetConnectCommand(req.Engine)
+	if err != nil {
+		m.modal = components.NewErrorModal(err.Error())
+		return m, nil
+	}
+	args := config.ExpandConnectCommand(template, host, port, account)
+	if _, err := exec.LookPath(args[0]); err != nil {
+		m.modal = components.NewErrorModal(fmt.Sprintf(i18n.T(i18n.KeyConnectNoClient), args[0], strings.ToLower(req.Engine)))
+		return m, nil
+	}
+
+	return m, tea.ExecProcess(exec.Command(args[0], args[1:]...), func(err error) tea.Msg {
+		if err != nil {
+			return ErrorMsg{Err: fmt.Errorf("%s exited with error: %w", args[0], err)}
+		}
+		return EditorClosedMsg{} // Nothing to refresh once the client exits
+	})
+}
+
+// handleProbeRequest asks for the host and the optional TCP port to probe
+func (m Model) handleProbeRequest(msg pages.ProbeRequestMsg) (Model, tea.Cmd) {
+	port := ""
+	if msg.Port > 0 {
+		port = strconv.Itoa(msg.Port)
+	}
+	m.modal = components.NewFormModal(actionProbe, fmt.Sprintf(i18n.T(i18n.KeyProbeTitle), msg.Name),
+		[]components.FormField{
+			{Key: "host", Label: i18n.T(i18n.KeyProbeHost), Value: msg.Host},
+			{Key: "port", Label: i18n.T(i18n.KeyProbePort), Value: port},
+		},
+		msg)
+	return m, nil
+}
+
+// handleProbeSubmitted runs the probe with the entered host and port
+func (m Model) handleProbeSubmitted(msg components.FormSubmittedMsg) (Model, tea.Cmd) {
+	req, ok := msg.Data.(pages.ProbeRequestMsg)
+	if !ok {
+		return m, nil
+	}
+	host := strings.TrimSpace(msg.Values["host"])
+	if host == "" {
+		m.modal = components.NewErrorModal(i18n.T(i18n.KeyProbeNoHost))
+		return m, nil
+	}
+	port := 0
+	if value := strings.TrimSpace(msg.Values["port"]); value != "" {
+		var err error
+		if port, err = strconv.Atoi(value); err != nil || port < 1 || port > 65535 {
+			m.modal = components.NewErrorModal(i18n.T(i18n.KeyProbeBadPort))
+			return m, nil
+		}
+	}
+
+	m.loading = true
+	return m, ProbeAddress(req.Name, host, port)
+}
+
+// handleProbed shows the summary and latency histogram of each probe
+func (m Model) handleProbed(msg ProbedMsg) (Model, tea.Cmd) {
+	m.loading = false
+	sections := []string{probeReport(msg.ICMP)}
+	if msg.TCP != nil {
+		sections = append(sections, probeReport(*msg.TCP))
+	}
+	m.modal = components.NewInfoModal(fmt.Sprintf(i18n.T(i18n.KeyProbeTitle), msg.Name) + "\n\n" + strings.Join(sections, "\n\n"))
+	return m, nil
+}
+
+// probeReport describes one probe: its loss, its min/avg/max round trip and
+// how the round trips spread
+func probeReport(r service.ProbeResult) string {
+	if r.Sent() == 0 {
+		return fmt.Sprintf(i18n.T(i18n.KeyProbeFailed), r.Kind, r.Target, r.Err)
+	}
+	lines := []string{fmt.Sprintf(i18n.T(i18n.KeyProbeSummary), r.Kind, r.Target, len(r.Samples), r.Sent(), r.LossPercent())}
+	if len(r.Samples) == 0 {
+		lines = append(lines, i18n.T(i18n.KeyProbeNoReply))
+		if r.Err != nil {
+			lines = append(lines, r.Err.Error())
+		}
+		return strings.Join(lines, "\n")
+	}
+	fastest, mean, slowest := r.Stats()
+	lines = append(lines,
+		fmt.Sprintf(i18n.T(i18n.KeyProbeStats), roundLatency(fastest), roundLatency(mean), roundLatency(slowest)),
+		components.LatencyHistogram(r.Samples),
+	)
+	return strings.Join(lines, "\n")
+}
+
+// roundLatency rounds a round trip time for display
+func roundLatency(d time.Duration) time.Duration {
+	return d.Round(10 * time.Microsecond)
+}
@@ -0,0 +1,76 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/client"
+	"aliyun-tui-viewer/internal/jobs"
+	"aliyun-tui-viewer/internal/tui/pages"
+)
+
+// credentials returns the credentials shared by the current clients
+func (m Model) credentials() *client.Credentials {
+	return m.clients.GetConfig().Credentials
+}
+
+// withCredentialRefresh wraps cmd so that a failure caused by expired
+// credentials refreshes them and replays cmd once. The error is only
+// surfaced if the refresh itself fails
+func withCredentialRefresh(creds *client.Credentials, cmd tea.Cmd) tea.Cmd {
+	if cmd == nil || creds == nil {
+		return cmd
+	}
+
+	return func() tea.Msg {
+		msg := cmd()
+		if batch, ok := msg.(tea.BatchMsg); ok {
+			for i := range batch {
+				batch[i] = withCredentialRefresh(creds, batch[i])
+			}
+			return batch
+		}
+
+		err := msgError(msg)
+		if !client.IsCredentialExpired(err) {
+			return msg
+		}
+		if refreshErr := creds.Refresh(); refreshErr != nil {
+			return ErrorMsg{Err: fmt.Errorf("refreshing expired credentials: %w (request failed with: %v)", refreshErr, err)}
+		}
+		return cmd()
+	}
+}
+
+// withCredentialRefreshRun is withCredentialRefresh for background jobs
+func withCredentialRefreshRun(creds *client.Credentials, run jobRunner) jobRunner {
+	if creds == nil {
+		return run
+	}
+
+	return func(ctx context.Context, report jobs.Reporter) (tea.Msg, error) {
+		msg, err := run(ctx, report)
+		if !client.IsCredentialExpired(err) {
+			return msg, err
+		}
+		if refreshErr := creds.Refresh(); refreshErr != nil {
+			err = fmt.Errorf("refreshing expired credentials: %w (request failed with: %v)", refreshErr, err)
+			return ErrorMsg{Err: err}, err
+		}
+		return run(ctx, report)
+	}
+}
+
+// msgError returns the error carried by a failure message, if any
+func msgError(msg tea.Msg) error {
+	switch msg := msg.(type) {
+	case ErrorMsg:
+		return msg.Err
+	case pages.OSSErrorMsg:
+		return msg.Err
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,293 @@
+package tui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/jobs"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/pages"
+)
+
+// Action IDs for DNS record modals
+const (
+	actionDNSAddRecord    = "dns.add_record"
+	actionDNSEditRecord   = "dns.edit_record"
+	actionDNSDeleteRecord = "dns.delete_record"
+	actionDNSImport       = "dns.import"
+	actionDNSImportApply  = "dns.import_apply"
+)
+
+// dnsRecordFQDN returns the full name of a record, e.g. "www.example.com"
+func dnsRecordFQDN(rr, domainName string) string {
+	if rr == "" || rr == "@" {
+		return domainName
+	}
+	return rr + "." + domainName
+}
+
+// dnsRecordFormFields returns the form fields for adding or editing a record
+func dnsRecordFormFields(record *alidns.Record) []components.FormField {
+	fields := []components.FormField{
+		{Key: "rr", Label: i18n.T(i18n.KeyColRR), Placeholder: "www, @, *"},
+		{Key: "type", Label: i18n.T(i18n.KeyColRecordType), Value: "A", Placeholder: "A, AAAA, CNAME, MX, TXT, NS, SRV, CAA"},
+		{Key: "value", Label: i18n.T(i18n.KeyColRecordValue), Placeholder: "192.168.1.1"},
+		{Key: "ttl", Label: i18n.T(i18n.KeyColTTL), Value: "600"},
+		{Key: "line", Label: i18n.T(i18n.KeyColLine), Value: "default"},
+		{Key: "priority", Label: i18n.T(i18n.KeyLabelPriority), Placeholder: "1-50"},
+	}
+	if record != nil {
+		fields[0].Value = record.RR
+		fields[1].Value = record.Type
+		fields[2].Value = record.Value
+		fields[3].Value = strconv.FormatInt(record.TTL, 10)
+		fields[4].Value = record.Line
+		if record.Priority > 0 {
+			fields[5].Value = strconv.FormatInt(record.Priority, 10)
+		}
+	}
+	return fields
+}
+
+// parseDNSRecordForm converts submitted form values into a record input
+func parseDNSRecordForm(values map[string]string) (service.DomainRecordInput, error) {
+	input := service.DomainRecordInput{
+		RR:    values["rr"],
+		Type:  strings.ToUpper(values["type"]),
+		Value: values["value"],
+		Line:  values["line"],
+	}
+	if input.RR == "" || input.Type == "" || input.Value == "" {
+		return input, fmt.Errorf("%s", i18n.T(i18n.KeyDNSFieldRequired))
+	}
+	if ttl := values["ttl"]; ttl != "" {
+		n, err := strconv.ParseInt(ttl, 10, 64)
+		if err != nil || n <= 0 {
+			return input, fmt.Errorf(i18n.T(i18n.KeyDNSInvalidTTL), ttl)
+		}
+		input.TTL = n
+	}
+	if p := values["priority"]; p != "" && input.Type == "MX" {
+		n, err := strconv.ParseInt(p, 10, 64)
+		if err != nil {
+			return input, fmt.Errorf("invalid MX priority: %s", p)
+		}
+		input.Priority = n
+	}
+	return input, nil
+}
+
+// handleDNSRecordAction opens the modal (or runs the command) for a write
+// action requested from the DNS records page
+func (m Model) handleDNSRecordAction(msg pages.DNSRecordActionMsg) (Model, tea.Cmd) {
+	switch msg.Action {
+	case pages.DNSRecordAdd:
+		m.modal = components.NewFormModal(actionDNSAddRecord,
+			fmt.Sprintf("%s - %s", i18n.T(i18n.KeyDNSAddRecord), msg.DomainName),
+			dnsRecordFormFields(nil), msg.DomainName)
+
+	case pages.DNSRecordEdit:
+		if msg.Record == nil {
+			return m, nil
+		}
+		m.modal = components.NewFormModal(actionDNSEditRecord,
+			fmt.Sprintf("%s - %s", i18n.T(i18n.KeyDNSEditRecord), dnsRecordFQDN(msg.Record.RR, msg.DomainName)),
+			dnsRecordFormFields(msg.Record), *msg.Record)
+
+	case pages.DNSRecordDelete:
+		if msg.Record == nil {
+			return m, nil
+		}
+		m.modal = components.NewConfirmModal(actionDNSDeleteRecord,
+			fmt.Sprintf(i18n.T(i18n.KeyDNSConfirmDelete),
+				dnsRecordFQDN(msg.Record.RR, msg.DomainName), msg.Record.Type, msg.Record.Value),
+			*msg.Record)
+
+	case pages.DNSRecordToggle:
+		if msg.Record == nil {
+			return m, nil
+		}
+		m.loading = true
+		return m, ToggleDNSRecordStatus(m.services.DNS, *msg.Record)
+	}
+
+	return m, nil
+}
+
+// handleDNSFormSubmitted runs the add/edit command for a submitted record form
+func (m Model) handleDNSFormSubmitted(msg components.FormSubmittedMsg) (Model, tea.Cmd) {
+	input, err := parseDNSRecordForm(msg.Values)
+	if err != nil {
+		m.modal = components.NewErrorModal(err.Error())
+		return m, nil
+	}
+
+	switch msg.ID {
+	case actionDNSAddRecord:
+		if domainName, ok := msg.Data.(string); ok {
+			m.loading = true
+			return m, AddDNSRecord(m.services.DNS, domainName, input)
+		}
+	case actionDNSEditRecord:
+		if record, ok := msg.Data.(alidns.Record); ok {
+			m.loading = true
+			return m, UpdateDNSRecord(m.services.DNS, record.DomainName, record.RecordId, input)
+		}
+	}
+	return m, nil
+}
+
+// handleDNSImportRequest prompts for the CSV file of DNS changes to import
+func (m Model) handleDNSImportRequest() (Model, tea.Cmd) {
+	m.modal = components.NewFormModal(actionDNSImport, i18n.T(i18n.KeyDNSImport),
+		[]components.FormField{
+			{Key: "path", Label: i18n.T(i18n.KeyDNSImportPath), Placeholder: "~/dns-changes.csv"},
+		},
+		nil)
+	return m, nil
+}
+
+// handleDNSImportSubmitted opens the dry run of the entered file, which
+// checks its changes against the records of their domains
+func (m Model) handleDNSImportSubmitted(msg components.FormSubmittedMsg) (Model, tea.Cmd) {
+	path := strings.TrimSpace(msg.Values["path"])
+	if path == "" {
+		return m, nil
+	}
+	return m.navigateTo(PageDNSImport, path)
+}
+
+// handleDNSImportApplyRequest asks for confirmation before applying the
+// ready changes of an import. A file with invalid rows is refused as a whole,
+// since applying part of a migration is worse than fixing the file first
+func (m Model) handleDNSImportApplyRequest(msg pages.DNSImportApplyRequestMsg) (Model, tea.Cmd) {
+	plan := msg.Plan
+	if n := plan.Count(service.DNSChangeInvalid); n > 0 {
+		m.modal = components.NewErrorModal(fmt.Sprintf(i18n.T(i18n.KeyDNSImportInvalid), n))
+		return m, nil
+	}
+	n := plan.Count(service.DNSChangeReady)
+	if n == 0 {
+		m.modal = components.NewInfoModal(i18n.T(i18n.KeyDNSImportNothing))
+		return m, nil
+	}
+	m.modal = components.NewConfirmModal(actionDNSImportApply,
+		fmt.Sprintf(i18n.T(i18n.KeyDNSImportConfirm), n, plan.Source), plan)
+	return m, nil
+}
+
+// startDNSImportApply applies the ready changes of a confirmed import as a job
+func (m Model) startDNSImportApply(plan *service.DNSImportPlan) (Model, tea.Cmd) {
+	m, _, cmd := m.startJob(fmt.Sprintf(i18n.T(i18n.KeyJobDNSImportApply), plan.Source), jobs.UnitItems,
+		ApplyDNSImport(m.services.DNS, plan))
+	return m, cmd
+}
+
+// handleDNSImportApplied shows the result of each applied change
+func (m Model) handleDNSImportApplied(msg DNSImportAppliedMsg) (Model, tea.Cmd) {
+	plan := msg.Plan
+	if plan.Count(service.DNSChangeApplied) > 0 {
+		m.invalidatePage(PageDNSDomains)
+	}
+	if m.dnsImportPage.Plan() != nil && m.dnsImportPage.Plan().Source == plan.Source {
+		m.dnsImportPage = m.dnsImportPage.SetData(plan)
+	}
+
+	applied, failed := plan.Count(service.DNSChangeApplied), plan.Count(service.DNSChangeFailed)
+	if msg.Err != nil {
+		m.modal = components.NewErrorModal(fmt.Sprintf(i18n.T(i18n.KeyDNSImportCancelled), applied, failed, plan.Count(service.DNSChangeReady)))
+	} else if failed > 0 {
+		m.modal = components.NewErrorModal(fmt.Sprintf(i18n.T(i18n.KeyDNSImportFailed), applied, failed))
+	} else {
+		m.modal = components.NewSuccessModal(fmt.Sprintf(i18n.T(i18n.KeyDNSImportDone), applied))
+	}
+	return m, nil
+}
+
+// handleDNSPropagationCheckRequest asks the public resolvers for the record
+func (m Model) handleDNSPropagationCheckRequest(msg pages.DNSPropagationCheckRequestMsg) (Model, tea.Cmd) {
+	m.loading = true
+	return m, CheckDNSPropagation(m.loadCtx(), msg.DomainName, msg.Record)
+}
+
+// handleDNSPropagationChecked shows what each resolver answered, the ones not
+// returning the configured value highlighted
+func (m Model) handleDNSPropagationChecked(msg DNSPropagationCheckedMsg) (Model, tea.Cmd) {
+	m.loading = false
+	r := msg.Result
+	if r.Err != nil {
+		m.modal = components.NewErrorModal(r.Err.Error())
+		return m, nil
+	}
+
+	lines := []string{
+		fmt.Sprintf(i18n.T(i18n.KeyDNSPropagationTitle), r.Name, r.Type),
+		fmt.Sprintf(i18n.T(i18n.KeyDNSPropagationExpected), r.Expected),
+		"",
+	}
+	behind := 0
+	for _, a := range r.Answers {
+		line := fmt.Sprintf("%s %s (%s, %s): %s", propagationMark(a.State), a.Resolver.Name, a.Resolver.Address,
+			a.Latency.Round(time.Millisecond), propagationDetail(a))
+		if a.State != service.PropagationMatch {
+			behind++
+			line = m.styles.StatusWarning.Render(line)
+		}
+		lines = append(lines, line)
+	}
+	lines = append(lines, "")
+	if behind == 0 {
+		lines = append(lines, i18n.T(i18n.KeyDNSPropagationAll))
+	} else {
+		lines = append(lines, fmt.Sprintf(i18n.T(i18n.KeyDNSPropagationSome), behind, len(r.Answers)))
+	}
+	if msg.Record.Status == "DISABLE" {
+		lines = append(lines, i18n.T(i18n.KeyDNSPropagationPaused))
+	}
+	if msg.Record.Line != "" && msg.Record.Line != "default" {
+		lines = append(lines, fmt.Sprintf(i18n.T(i18n.KeyDNSPropagationLine), msg.Record.Line))
+	}
+
+	if r.Propagated() {
+		m.modal = components.NewSuccessModal(strings.Join(lines, "\n"))
+	} else {
+		m.modal = components.NewInfoModal(strings.Join(lines, "\n"))
+	}
+	return m, nil
+}
+
+// propagationMark marks a resolver's answer in the propagation report
+func propagationMark(state string) string {
+	switch state {
+	case service.PropagationMatch:
+		return "✓"
+	case service.PropagationStale:
+		return "✗"
+	case service.PropagationMissing:
+		return "-"
+	}
+	return "!"
+}
+
+// propagationDetail describes a resolver's answer: the values it returns
+// instead of the configured one, for how long it may keep caching them, or
+// why it could not be asked
+func propagationDetail(a service.PropagationAnswer) string {
+	switch a.State {
+	case service.PropagationMatch:
+		return i18n.T(i18n.KeyDNSPropagationMatch)
+	case service.PropagationStale:
+		return fmt.Sprintf(i18n.T(i18n.KeyDNSPropagationStale), strings.Join(a.Values, ", "), a.TTL)
+	case service.PropagationMissing:
+		return i18n.T(i18n.KeyDNSPropagationMissing)
+	}
+	return fmt.Sprintf(i18n.T(i18n.KeyDNSPropagationFailed), a.Err)
+}
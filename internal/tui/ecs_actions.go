@@ -0,0 +1,44 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/pages"
+)
+
+// Action IDs for ECS modals
+const (
+	actionECSCopy = "ecs.copy"
+)
+
+// handleECSCopyRequest opens the picker of what to copy of the instances
+func (m Model) handleECSCopyRequest(msg pages.ECSCopyRequestMsg) (Model, tea.Cmd) {
+	options := []components.SelectOption{
+		{Value: pages.ECSCopyIDs, Label: i18n.T(i18n.KeyECSCopyIDs)},
+		{Value: pages.ECSCopyPrivateIPs, Label: i18n.T(i18n.KeyECSCopyPrivateIPs)},
+		{Value: pages.ECSCopyPublicIPs, Label: i18n.T(i18n.KeyECSCopyPublicIPs)},
+	}
+	m.modal = components.NewSelectModal(actionECSCopy,
+		fmt.Sprintf(i18n.T(i18n.KeyECSCopyTitle), len(msg.Instances)),
+		options, "", msg)
+	return m, nil
+}
+
+// handleECSCopySelected copies the picked field of the instances, one per
+// line
+func (m Model) handleECSCopySelected(msg components.OptionSelectedMsg) (Model, tea.Cmd) {
+	req, ok := msg.Data.(pages.ECSCopyRequestMsg)
+	if !ok {
+		return m, nil
+	}
+	text := pages.ECSCopyText(req.Instances, msg.Value)
+	if text == "" {
+		m.modal = components.NewErrorModal(i18n.T(i18n.KeyECSCopyNone))
+		return m, nil
+	}
+	return m, CopyToClipboard(components.TextContent(text))
+}
@@ -0,0 +1,173 @@
+package tui
+
+import (
+	"context"
+
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/theme"
+	"aliyun-tui-viewer/internal/tui/components"
+)
+
+// emptyStateTypes maps the regional list pages to the Resource Center type
+// of the resources they list, for counting them in the other regions
+var emptyStateTypes = map[PageType]string{
+	PageECSList:           "ACS::ECS::Instance",
+	PageSLBList:           "ACS::SLB::LoadBalancer",
+	PageALBList:           "ACS::ALB::LoadBalancer",
+	PageNLBList:           "ACS::NLB::LoadBalancer",
+	PageRDSList:           "ACS::RDS::DBInstance",
+	PageRedisList:         "ACS::Redis::DBInstance",
+	PageMongoDBList:       "ACS::MongoDB::DBInstance",
+	PageElasticsearchList: "ACS::Elasticsearch::Instance",
+	PageKafkaList:         "ACS::AliKafka::Instance",
+	PageRocketMQList:      "ACS::RocketMQ::Instance",
+}
+
+// emptyState describes a list page that loaded without resources in the
+// current region, and where else the account has them
+type emptyState struct {
+	page    PageType
+	region  string
+	counts  map[string]int // Resources per other region, nil while counting
+	err     error          // Set when the other regions could not be counted
+	loading bool
+}
+
+// RegionCountsLoadedMsg contains how many resources of an empty page's type
+// the other regions hold
+type RegionCountsLoadedMsg struct {
+	Page   PageType
+	Counts map[string]int
+	Err    error
+}
+
+// LoadRegionCounts creates a command counting the resources of a list page's
+// type per region, from the cached Resource Center scan
+func LoadRegionCounts(ctx context.Context, regionSvc *service.RegionService, page PageType) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		counts, err := regionSvc.GetResourceCounts(ctx, emptyStateTypes[page])
+		return RegionCountsLoadedMsg{Page: page, Counts: counts, Err: err}
+	})
+}
+
+// checkEmptyList records whether the list page that just loaded count
+// resources is empty, and starts counting the other regions if it is.
+// Aggregated All Regions lists have no other region to point at
+func (m Model) checkEmptyList(page PageType, count int) (Model, tea.Cmd) {
+	if _, ok := emptyStateTypes[page]; !ok || m.allRegions || m.currentPage != page {
+		return m, nil
+	}
+	if count > 0 {
+		m.emptyState = nil
+		return m, nil
+	}
+	m.emptyState = &emptyState{page: page, region: m.region, loading: true}
+	return m, LoadRegionCounts(m.loadCtx(), m.regionService, page)
+}
+
+// handleRegionCountsLoaded fills in the counts of the empty page they were
+// requested for, ignoring them if the user has moved on
+func (m Model) handleRegionCountsLoaded(msg RegionCountsLoadedMsg) (tea.Model, tea.Cmd) {
+	if m.emptyState == nil || m.emptyState.page != msg.Page {
+		return m, nil
+	}
+	state := *m.emptyState
+	state.loading = false
+	state.err = msg.Err
+	state.counts = make(map[string]int, len(msg.Counts))
+	for region, n := range msg.Counts {
+		if region != state.region {
+			state.counts[region] = n
+		}
+	}
+	m.emptyState = &state
+	return m, nil
+}
+
+// showsEmptyState reports whether the current page is replaced by its empty state
+func (m Model) showsEmptyState() bool {
+	return m.emptyState != nil && m.emptyState.page == m.currentPage && !m.loading && !m.allRegions
+}
+
+// mostPopulousRegion returns the other region with the most resources, the
+// alphabetically first on a tie, or "" when no other region has any
+func (s *emptyState) mostPopulousRegion() string {
+	best := ""
+	for region, n := range s.counts {
+		if best == "" || n > s.counts[best] || (n == s.counts[best] && region < best) {
+			best = region
+		}
+	}
+	return best
+}
+
+// emptyStateView renders the empty state of the current page: what is
+// missing, which regions have it and the key to jump there
+func (m Model) emptyStateView() string {
+	state := m.emptyState
+	title := m.getPageTitle(state.page)
+
+	lines := []string{
+		RenderTitle(fmt.Sprintf(i18n.T(i18n.KeyEmptyStateTitle), title, state.region)),
+		"",
+	}
+	switch {
+	case state.loading:
+		lines = append(lines, RenderInfo(i18n.T(i18n.KeyEmptyStateCounting)))
+	case state.err != nil:
+		lines = append(lines, RenderError(fmt.Sprintf(i18n.T(i18n.KeyEmptyStateCountFailed), state.err)))
+	case len(state.counts) == 0:
+		lines = append(lines, RenderInfo(i18n.T(i18n.KeyEmptyStateNoOthers)))
+	default:
+		regions := make([]string, 0, len(state.counts))
+		for region := range state.counts {
+			regions = append(regions, region)
+		}
+		sort.Slice(regions, func(i, j int) bool {
+			if state.counts[regions[i]] != state.counts[regions[j]] {
+				return state.counts[regions[i]] > state.counts[regions[j]]
+			}
+			return regions[i] < regions[j]
+		})
+
+		lines = append(lines, fmt.Sprintf(i18n.T(i18n.KeyEmptyStateOthers), len(regions)))
+		for _, region := range regions {
+			lines = append(lines, fmt.Sprintf("  %-20s %d", region, state.counts[region]))
+		}
+		lines = append(lines, "",
+			RenderSuccess(fmt.Sprintf(i18n.T(i18n.KeyEmptyStateJump), state.mostPopulousRegion())),
+			RenderInfo(i18n.T(i18n.KeyEmptyStateCached)))
+	}
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Current().MutedText).
+		Padding(1, 3).
+		Render(strings.Join(lines, "\n"))
+	return Center(box, m.width, m.height-2)
+}
+
+// jumpToPopulousRegion switches to the region with the most resources of the
+// empty page and opens the page again there
+func (m Model) jumpToPopulousRegion() (tea.Model, tea.Cmd) {
+	page := m.emptyState.page
+	region := m.emptyState.mostPopulousRegion()
+	if region == "" {
+		return m, nil
+	}
+
+	next, err := m.switchRegion(region)
+	if err != nil {
+		m.modal = components.NewErrorModal(fmt.Sprintf("Failed to create clients: %v", err))
+		return m, nil
+	}
+	return next.navigateTo(page, nil)
+}
@@ -0,0 +1,89 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/jobs"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/theme"
+)
+
+// finderSearch is a resource finder query running as the load job, shown
+// section by section while it runs
+type finderSearch struct {
+	query    string
+	progress *service.FindProgress
+}
+
+// startFinderSearch runs a finder query as the load job, so esc can cancel it
+func (m Model) startFinderSearch(query string) (Model, tea.Cmd) {
+	progress := service.NewFindProgress()
+	m.finderSearch = &finderSearch{query: query, progress: progress}
+	m.loading = true
+	return m.startLoadJob(fmt.Sprintf(i18n.T(i18n.KeyJobFind), query), jobs.UnitItems,
+		FindResources(m.finderService, query, progress))
+}
+
+// searchingFinder reports whether a finder query is loading
+func (m Model) searchingFinder() bool {
+	return m.finderSearch != nil && m.loading && m.loadJob != 0
+}
+
+// cancelFinderSearch cancels the running finder query and stays where the
+// query was started
+func (m Model) cancelFinderSearch() (Model, tea.Cmd) {
+	if job, ok := m.jobManager.Get(m.loadJob); ok && m.jobManager.Cancel(m.loadJob) {
+		m.modeLine = m.modeLine.SetPageInfo(fmt.Sprintf(i18n.T(i18n.KeyJobCancelled), job.Title))
+	}
+	m.finderSearch = nil
+	m.loading = false
+	m.loadStarted = time.Time{} // A cancelled search rings no bell
+	return m.refreshJobs(), nil
+}
+
+// finderStepStatus renders how far a section of a finder query got
+func finderStepStatus(step service.FindStep) string {
+	switch {
+	case step.Denied:
+		return RenderInfo(i18n.T(i18n.KeyNoPermission))
+	case step.Err != nil:
+		return RenderError("✗")
+	case step.Finished:
+		return RenderSuccess("✓")
+	case step.Total == 0:
+		return "…"
+	case step.Unit == service.FindUnitPages:
+		return fmt.Sprintf(i18n.T(i18n.KeyFinderProgressPages), step.Done, step.Total)
+	case step.Unit == service.FindUnitDomains:
+		return fmt.Sprintf(i18n.T(i18n.KeyFinderProgressDomains), step.Done, step.Total)
+	default:
+		return fmt.Sprintf("%d/%d", step.Done, step.Total)
+	}
+}
+
+// finderSearchView renders the sections of the running finder query with
+// their progress, redrawn on each job tick
+func (m Model) finderSearchView() string {
+	search := m.finderSearch
+	lines := []string{
+		RenderTitle(fmt.Sprintf(i18n.T(i18n.KeyFinderSearching), search.query)),
+		"",
+	}
+	for _, step := range search.progress.Steps() {
+		lines = append(lines, fmt.Sprintf("  %-10s %s", step.Section, finderStepStatus(step)))
+	}
+	lines = append(lines, "", RenderInfo(i18n.T(i18n.KeyFinderCancelHint)))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Current().MutedText).
+		Padding(1, 3).
+		Render(strings.Join(lines, "\n"))
+	return Center(box, m.width, m.height-2)
+}
@@ -0,0 +1,176 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/pages"
+)
+
+// pageKeyMaps are the key maps of the pages that have keys of their own,
+// whose help the help overlay lists
+var pageKeyMaps = map[PageType]any{
+	PageMenu:                   pages.DefaultMenuKeyMap(),
+	PageECSList:                pages.DefaultECSListKeyMap(),
+	PageECSDetail:              pages.DefaultECSDetailKeyMap(),
+	PageECSDisks:               pages.DefaultECSDiskKeyMap(),
+	PageECSNetworkInterfaces:   pages.DefaultECSENIKeyMap(),
+	PageSecurityGroups:         pages.DefaultSecurityGroupsKeyMap(),
+	PageSecurityGroupRules:     pages.DefaultSecurityGroupRulesKeyMap(),
+	PageSecurityGroupInstances: pages.DefaultECSListKeyMap(),
+	PageInstanceSecurityGroups: pages.DefaultSecurityGroupsKeyMap(),
+	PageDNSDomains:             pages.DefaultDNSDomainsKeyMap(),
+	PageDNSRecords:             pages.DefaultDNSRecordsKeyMap(),
+	PageSLBList:                pages.DefaultSLBListKeyMap(),
+	PageSLBDetail:              pages.DefaultSLBDetailKeyMap(),
+	PageSLBListeners:           pages.DefaultSLBListenersKeyMap(),
+	PageSLBVServerGroups:       pages.DefaultSLBVServerGroupsKeyMap(),
+	PageSLBForwardingRules:     pages.DefaultSLBForwardingRulesKeyMap(),
+	PageSLBDefaultServers:      pages.DefaultSLBDefaultServersKeyMap(),
+	PageOSSBuckets:             pages.DefaultOSSBucketsKeyMap(),
+	PageOSSObjects:             pages.DefaultOSSObjectsKeyMap(),
+	PageRDSList:                pages.DefaultRDSListKeyMap(),
+	PageRDSDetail:              pages.DefaultRDSDetailKeyMap(),
+	PageRedisList:              pages.DefaultRedisListKeyMap(),
+	PageRedisDetail:            pages.DefaultRedisDetailKeyMap(),
+	PageRocketMQList:           pages.DefaultRocketMQListKeyMap(),
+	PageRocketMQDetail:         pages.DefaultRocketMQDetailKeyMap(),
+	PageRocketMQTopics:         pages.DefaultRocketMQTopicsKeyMap(),
+	PageRocketMQGroups:         pages.DefaultRocketMQGroupsKeyMap(),
+	PageResourceFinder:         pages.DefaultFinderKeyMap(),
+	PageJobs:                   pages.DefaultJobsKeyMap(),
+	PageRAMUsers:               pages.DefaultRAMUsersKeyMap(),
+	PageRAMRoles:               pages.DefaultRAMRolesKeyMap(),
+	PageRAMPolicies:            pages.DefaultRAMPoliciesKeyMap(),
+	PageRAMUserPolicies:        pages.DefaultRAMPoliciesKeyMap(),
+	PageECSMetrics:             pages.DefaultMetricsKeyMap(),
+	PageRDSMetrics:             pages.DefaultMetricsKeyMap(),
+	PageSLSProjects:            pages.DefaultSLSProjectsKeyMap(),
+	PageSLSLogtailConfigs:      pages.DefaultSLSListKeyMap(),
+	PageSLSMachineGroups:       pages.DefaultSLSListKeyMap(),
+	PageSLSMachines:            pages.DefaultSLSListKeyMap(),
+	PageSLSCoverage:            pages.DefaultSLSListKeyMap(),
+	PageConfigRules:            pages.DefaultConfigRulesKeyMap(),
+	PageConfigResults:          pages.DefaultConfigRulesKeyMap(),
+	PageTagBrowser:             pages.DefaultTagBrowserKeyMap(),
+	PageTagResources:           pages.DefaultTagResourcesKeyMap(),
+	PageBilling:                pages.DefaultBillingKeyMap(),
+	PageDNSDangling:            pages.DefaultDNSDanglingKeyMap(),
+	PageRAMRolePolicies:        pages.DefaultRAMPoliciesKeyMap(),
+	PageNATList:                pages.DefaultNATListKeyMap(),
+	PageDNATEntries:            pages.DefaultDNATEntriesKeyMap(),
+	PageOSSObjectVersions:      pages.DefaultOSSObjectVersionsKeyMap(),
+	PageALBList:                pages.DefaultALBListKeyMap(),
+	PageALBListeners:           pages.DefaultALBListenersKeyMap(),
+	PageALBRules:               pages.DefaultALBRulesKeyMap(),
+	PageALBServerGroups:        pages.DefaultLBServerGroupsKeyMap(),
+	PageNLBList:                pages.DefaultALBListKeyMap(),
+	PageNLBListeners:           pages.DefaultLBServerGroupsKeyMap(),
+	PageNLBServerGroups:        pages.DefaultLBServerGroupsKeyMap(),
+	PageACKClusters:            pages.DefaultACKClustersKeyMap(),
+	PageACKNodePools:           pages.DefaultACKNodePoolsKeyMap(),
+	PageACKNodes:               pages.DefaultACKNodesKeyMap(),
+	PageOSSObjectScan:          pages.DefaultOSSObjectsKeyMap(),
+	PageACRInstances:           pages.DefaultACRKeyMap("namespaces"),
+	PageACRNamespaces:          pages.DefaultACRKeyMap("repositories"),
+	PageACRRepositories:        pages.DefaultACRKeyMap("tags"),
+	PageACRTags:                pages.DefaultACRKeyMap("details"),
+	PageFCServices:             pages.DefaultFCKeyMap("functions"),
+	PageFCFunctions:            pages.DefaultFCKeyMap("detail"),
+	PageMongoDBList:            pages.DefaultMongoDBListKeyMap(),
+	PageMongoDBDetail:          pages.DefaultMongoDBDetailKeyMap(),
+	PageElasticsearchList:      pages.DefaultElasticsearchListKeyMap(),
+	PageElasticsearchDetail:    pages.DefaultElasticsearchDetailKeyMap(),
+	PageKafkaList:              pages.DefaultKafkaListKeyMap(),
+	PageKafkaDetail:            pages.DefaultKafkaDetailKeyMap(),
+	PageRocketMQMessages:       pages.DefaultRocketMQMessagesKeyMap(),
+	PageRedisMetrics:           pages.DefaultMetricsKeyMap(),
+	PageRecycleBin:             pages.DefaultRecycleBinKeyMap(),
+	PageOSSDeletedObjects:      pages.DefaultOSSDeletedObjectsKeyMap(),
+	PageRedisBackups:           pages.DefaultRedisBackupsKeyMap(),
+	PageRDSBackups:             pages.DefaultRDSBackupsKeyMap(),
+	PageRDSBinlogs:             pages.DefaultRDSBinlogsKeyMap(),
+	PageRDSSlowLog:             pages.DefaultRDSSlowLogKeyMap(),
+	PageChangelog:              pages.DefaultChangelogKeyMap(),
+	PageRecent:                 pages.DefaultRecentKeyMap(),
+	PageDNSImport:              pages.DefaultDNSImportKeyMap(),
+}
+
+// viewerPages show a JSON document in the viewport, with its keys
+var viewerPages = map[PageType]bool{
+	PageECSJSONDetail:           true,
+	PageOSSObjectDetail:         true,
+	PageRAMDetail:               true,
+	PageSLSDetail:               true,
+	PageConfigDetail:            true,
+	PageTagDetail:               true,
+	PageBillingDetail:           true,
+	PageNATDetail:               true,
+	PageALBDetail:               true,
+	PageALBRuleDetail:           true,
+	PageNLBDetail:               true,
+	PageOSSBucketDetail:         true,
+	PageACKClusterDetail:        true,
+	PageACRTagDetail:            true,
+	PageFCFunctionDetail:        true,
+	PageSLBJSONDetail:           true,
+	PageMongoDBJSONDetail:       true,
+	PageRDSJSONDetail:           true,
+	PageRedisJSONDetail:         true,
+	PageRocketMQJSONDetail:      true,
+	PageElasticsearchJSONDetail: true,
+	PageKafkaJSONDetail:         true,
+}
+
+// openHelp shows the help overlay with the keys of the current page, then
+// those of its table or viewer, then the global ones. Keys that change cloud
+// resources are left out in read-only mode
+func (m Model) openHelp() (Model, tea.Cmd) {
+	var sections []components.HelpSection
+	if keyMap, ok := pageKeyMaps[m.currentPage]; ok {
+		sections = append(sections, components.HelpSection{
+			Title: i18n.T(i18n.KeyHelpPage),
+			Keys:  m.allowedKeys(components.HelpBindings(keyMap)),
+		})
+	}
+	switch {
+	case m.isFilterablePage():
+		sections = append(sections, components.HelpSection{
+			Title: i18n.T(i18n.KeyHelpTable),
+			Keys:  components.HelpBindings(components.DefaultTableKeyMap()),
+		})
+	case viewerPages[m.currentPage]:
+		sections = append(sections, components.HelpSection{
+			Title: i18n.T(i18n.KeyHelpViewer),
+			Keys:  components.HelpBindings(components.DefaultViewportKeyMap()),
+		})
+	}
+
+	// In the order of the groups of KeyMap.FullHelp
+	titles := []string{i18n.KeyHelpNavigation, i18n.KeyHelpTabs, i18n.KeyHelpSearch, i18n.KeyHelpGoTo, i18n.KeyHelpGeneral}
+	for i, group := range m.keys.FullHelp() {
+		sections = append(sections, components.HelpSection{Title: i18n.T(titles[i]), Keys: group})
+	}
+
+	title := fmt.Sprintf(i18n.T(i18n.KeyHelpTitle), m.getPageTitle(m.currentPage))
+	m.help = components.NewHelpModel(title, sections, m.width, m.height)
+	return m, nil
+}
+
+// allowedKeys drops the keys that change cloud resources in read-only mode
+func (m Model) allowedKeys(bindings []key.Binding) []key.Binding {
+	if !m.readOnly {
+		return bindings
+	}
+	var allowed []key.Binding
+	for _, binding := range bindings {
+		if !components.IsMutatingShortcut(m.currentPage, binding.Help().Key) {
+			allowed = append(allowed, binding)
+		}
+	}
+	return allowed
+}
@@ -0,0 +1,140 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/jobs"
+	"aliyun-tui-viewer/internal/tui/pages"
+)
+
+// jobsTickInterval is how often progress is redrawn while jobs are running
+const jobsTickInterval = 500 * time.Millisecond
+
+// jobRunner does the work of a background job. The returned message is
+// applied when the job finishes, unless the job was cancelled
+type jobRunner func(ctx context.Context, report jobs.Reporter) (tea.Msg, error)
+
+// JobFinishedMsg is sent when a background job returns
+type JobFinishedMsg struct {
+	ID     int
+	Result tea.Msg
+}
+
+// jobsTickMsg refreshes job progress in the mode line and the jobs page
+type jobsTickMsg struct{}
+
+// startJob registers a job with the manager and runs it in the background
+func (m Model) startJob(title string, unit jobs.Unit, run jobRunner) (Model, int, tea.Cmd) {
+	id, ctx, report := m.jobManager.Start(title, unit)
+	manager := m.jobManager
+	run = withCredentialRefreshRun(m.credentials(), run)
+
+	cmd := func() tea.Msg {
+		result, err := run(ctx, report)
+		manager.Finish(id, err)
+		return JobFinishedMsg{ID: id, Result: result}
+	}
+
+	m = m.refreshJobs()
+	var tick tea.Cmd
+	m, tick = m.scheduleJobsTick()
+	return m, id, tea.Batch(cmd, tick)
+}
+
+// startLoadJob runs a job that feeds the current page, so cancelling it also
+// ends the page's loading state
+func (m Model) startLoadJob(title string, unit jobs.Unit, run jobRunner) (Model, tea.Cmd) {
+	m, id, cmd := m.startJob(title, unit, run)
+	m.loadJob = id
+	return m, cmd
+}
+
+// scheduleJobsTick starts the progress ticker unless it is already running
+func (m Model) scheduleJobsTick() (Model, tea.Cmd) {
+	if m.jobsTicking {
+		return m, nil
+	}
+	m.jobsTicking = true
+	return m, tea.Tick(jobsTickInterval, func(time.Time) tea.Msg {
+		return jobsTickMsg{}
+	})
+}
+
+// handleJobsTick redraws progress and keeps ticking while jobs are running
+func (m Model) handleJobsTick() (Model, tea.Cmd) {
+	m.jobsTicking = false
+	m = m.refreshJobs()
+	if len(m.jobManager.Running()) == 0 {
+		return m, nil
+	}
+	return m.scheduleJobsTick()
+}
+
+// handleJobFinished applies a job's result and reports cancellations
+func (m Model) handleJobFinished(msg JobFinishedMsg) (tea.Model, tea.Cmd) {
+	job, _ := m.jobManager.Get(msg.ID)
+	pageBound := m.loadJob == msg.ID
+	if pageBound {
+		m.loadJob = 0
+	}
+
+	var cmd tea.Cmd
+	if job.Status == jobs.StatusCancelled && !keptWhenCancelled(msg.Result) {
+		if pageBound {
+			m.loading = false
+			m.finderSearch = nil
+		}
+	} else if msg.Result != nil {
+		model, resultCmd := m.update(msg.Result)
+		next, ok := model.(Model)
+		if !ok {
+			return model, resultCmd
+		}
+		m, cmd = next, resultCmd
+	}
+
+	m = m.refreshJobs()
+	if !pageBound && job.Status != jobs.StatusCancelled && m.shouldRingBellAfter(job.Elapsed()) {
+		cmd = tea.Batch(cmd, RingBell())
+	}
+	return m, cmd
+}
+
+// keptWhenCancelled reports whether a job result is applied even though the
+// job was cancelled: the changes an import made, or the objects a copy or
+// move got through, before it stopped
+func keptWhenCancelled(result tea.Msg) bool {
+	switch result.(type) {
+	case DNSImportAppliedMsg, OSSObjectsCopiedMsg:
+		return true
+	}
+	return false
+}
+
+// handleJobCancelRequest cancels a running job from the jobs page
+func (m Model) handleJobCancelRequest(msg pages.JobCancelRequestMsg) (Model, tea.Cmd) {
+	if job, ok := m.jobManager.Get(msg.ID); ok && m.jobManager.Cancel(msg.ID) {
+		m.modeLine = m.modeLine.SetPageInfo(fmt.Sprintf(i18n.T(i18n.KeyJobCancelled), job.Title))
+	}
+	return m.refreshJobs(), nil
+}
+
+// handleJobsClearRequest drops finished jobs from the jobs page
+func (m Model) handleJobsClearRequest() (Model, tea.Cmd) {
+	m.jobManager.ClearFinished()
+	return m.refreshJobs(), nil
+}
+
+// refreshJobs updates the mode line status and the jobs page from the manager
+func (m Model) refreshJobs() Model {
+	m.modeLine = m.modeLine.SetStatus(pages.FormatJobStatusLine(m.jobManager.Running()))
+	if m.currentPage == PageJobs {
+		m.jobsPage = m.jobsPage.SetData(m.jobManager.Jobs())
+	}
+	return m
+}
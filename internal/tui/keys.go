@@ -23,6 +23,7 @@ type KeyMap struct {
 	Search     key.Binding
 	SearchNext key.Binding
 	SearchPrev key.Binding
+	Filter     key.Binding
 
 	// Actions
 	Yank        key.Binding
@@ -50,6 +51,31 @@ type KeyMap struct {
 
 	// Resource Finder
 	FindResource key.Binding // F - find resource by IP/domain
+
+	// Background jobs
+	Jobs key.Binding // J - open the jobs page
+
+	// Recently viewed resources
+	Recent key.Binding // ctrl+o - jump to a recently viewed resource
+
+	// API call trace
+	APITrace key.Binding // ctrl+l - open the API trace page
+
+	// Page toggle
+	TogglePage key.Binding // ctrl+^ - flip between current and previously viewed page
+
+	// Split view
+	SplitView key.Binding // | - show a preview of the highlighted row next to the list
+
+	// Tabs
+	NewTab   key.Binding // ctrl+t - open a tab on the main menu
+	CloseTab key.Binding // ctrl+w - close the current tab
+	NextTab  key.Binding // alt+right - switch to the next tab
+	PrevTab  key.Binding // alt+left - switch to the previous tab
+	GoToTab  key.Binding // alt+1 to alt+9 - switch to a tab by its number
+
+	// Language
+	Language key.Binding // alt+l - switch to the next language
 }
 
 // DefaultKeyMap returns the default key bindings
@@ -108,6 +134,10 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("N"),
 			key.WithHelp("N", "prev match"),
 		),
+		Filter: key.NewBinding(
+			key.WithKeys("f"),
+			key.WithHelp("f", "filter rows"),
+		),
 
 		// Actions
 		Yank: key.NewBinding(
@@ -123,8 +153,8 @@ func DefaultKeyMap() KeyMap {
 			key.WithHelp("v", "view in pager"),
 		),
 		Refresh: key.NewBinding(
-			key.WithKeys("r", "ctrl+r"),
-			key.WithHelp("r", "refresh"),
+			key.WithKeys("f5", "ctrl+g"),
+			key.WithHelp("F5", "refresh"),
 		),
 		Profile: key.NewBinding(
 			key.WithKeys("P"),
@@ -192,6 +222,62 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("F"),
 			key.WithHelp("F", "find resource"),
 		),
+
+		// Background jobs
+		Jobs: key.NewBinding(
+			key.WithKeys("J"),
+			key.WithHelp("J", "jobs"),
+		),
+
+		// Recently viewed resources
+		Recent: key.NewBinding(
+			key.WithKeys("ctrl+o"),
+			key.WithHelp("ctrl+o", "recent resources"),
+		),
+
+		// API call trace
+		APITrace: key.NewBinding(
+			key.WithKeys("ctrl+l"),
+			key.WithHelp("ctrl+l", "API trace"),
+		),
+
+		// Page toggle
+		TogglePage: key.NewBinding(
+			key.WithKeys("ctrl+^"),
+			key.WithHelp("ctrl+^", "previous page"),
+		),
+		SplitView: key.NewBinding(
+			key.WithKeys("|"),
+			key.WithHelp("|", "split preview"),
+		),
+
+		// Tabs
+		NewTab: key.NewBinding(
+			key.WithKeys("ctrl+t"),
+			key.WithHelp("ctrl+t", "new tab"),
+		),
+		CloseTab: key.NewBinding(
+			key.WithKeys("ctrl+w"),
+			key.WithHelp("ctrl+w", "close tab"),
+		),
+		NextTab: key.NewBinding(
+			key.WithKeys("alt+right"),
+			key.WithHelp("alt+→", "next tab"),
+		),
+		PrevTab: key.NewBinding(
+			key.WithKeys("alt+left"),
+			key.WithHelp("alt+←", "previous tab"),
+		),
+		GoToTab: key.NewBinding(
+			key.WithKeys("alt+1", "alt+2", "alt+3", "alt+4", "alt+5", "alt+6", "alt+7", "alt+8", "alt+9"),
+			key.WithHelp("alt+1-9", "go to tab"),
+		),
+
+		// Language
+		Language: key.NewBinding(
+			key.WithKeys("alt+l"),
+			key.WithHelp("alt+l", "switch language"),
+		),
 	}
 }
 
@@ -203,13 +289,14 @@ func (k KeyMap) ShortHelp() []key.Binding {
 	return []key.Binding{k.VimDown, k.VimUp, k.Enter, k.Back, k.Search, k.Quit}
 }
 
-// FullHelp returns key bindings for the full help view
+// FullHelp returns the keys that work on every page, for the help overlay
 func (k KeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.VimUp, k.VimDown, k.Enter, k.Back},        // Navigation
-		{k.Search, k.SearchNext, k.SearchPrev},      // Search
-		{k.Yank, k.Edit, k.ViewPager, k.Profile},    // Actions
-		{k.Quit, k.Help, k.Refresh},                 // General
+		{k.VimUp, k.VimDown, k.Enter, k.Back, k.TogglePage},                       // Navigation
+		{k.NewTab, k.CloseTab, k.NextTab, k.PrevTab, k.GoToTab},                   // Tabs
+		{k.Search, k.SearchNext, k.SearchPrev, k.Filter},                          // Search
+		{k.FindResource, k.Jobs, k.Recent, k.APITrace},                            // Go to
+		{k.Profile, k.Region, k.Refresh, k.SplitView, k.Language, k.Help, k.Quit}, // General
 	}
 }
 
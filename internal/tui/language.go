@@ -0,0 +1,27 @@
+package tui
+
+import (
+	"aliyun-tui-viewer/internal/config"
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/tui/pages"
+)
+
+// switchLanguage switches the interface to the next language, including
+// those of the locale file. The menu and header follow at once; pages already
+// open keep their column titles until they are opened again
+func (m Model) switchLanguage() Model {
+	i18n.SetLocale(i18n.NextLocale())
+
+	m.menuPage = pages.NewMenuModel()
+	if m.usage != nil && config.GetMenuOrder() == config.MenuOrderUsage {
+		m.menuPage = m.menuPage.OrderByUsage(m.usage.Pages)
+	}
+	m.menuPage = m.menuPage.SetSize(m.width, m.height-3)
+
+	// The new menu gets its counts and permission marks again
+	m.menuCountsKey = ""
+	m.deniedKey = ""
+
+	m.header = m.header.SetTitle(m.getPageTitle(m.currentPage))
+	return m
+}
@@ -0,0 +1,85 @@
+package tui
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// pageLoads holds the contexts the API calls of the pages on the navigation
+// stack run with, one per depth, all derived from the context of the whole
+// session. Leaving a page cancels its calls, so an abandoned load neither
+// keeps running nor reports into the page that replaced it. One set is
+// shared by every copy of the model
+type pageLoads struct {
+	root    context.Context
+	cancel  context.CancelFunc
+	levels  []context.Context
+	cancels []context.CancelFunc
+}
+
+// newPageLoads creates the contexts of a session
+func newPageLoads() *pageLoads {
+	root, cancel := context.WithCancel(context.Background())
+	return &pageLoads{root: root, cancel: cancel}
+}
+
+// at returns the context of the page at depth on the navigation stack,
+// creating it on the first load of the page
+func (l *pageLoads) at(depth int) context.Context {
+	for len(l.levels) <= depth {
+		ctx, cancel := context.WithCancel(l.root)
+		l.levels = append(l.levels, ctx)
+		l.cancels = append(l.cancels, cancel)
+	}
+	return l.levels[depth]
+}
+
+// leave cancels the calls of the pages at depth and deeper, which have been
+// left or replaced
+func (l *pageLoads) leave(depth int) {
+	for i := max(depth, 0); i < len(l.cancels); i++ {
+		l.cancels[i]()
+	}
+	if depth < len(l.levels) {
+		l.levels = l.levels[:max(depth, 0)]
+		l.cancels = l.cancels[:max(depth, 0)]
+	}
+}
+
+// close cancels every call of the session
+func (l *pageLoads) close() {
+	l.cancel()
+}
+
+// loadCtx returns the context of the current page's loads
+func (m Model) loadCtx() context.Context {
+	return m.loads.at(len(m.previousPages))
+}
+
+// sessionCtx returns the context of the calls that belong to no page, such
+// as the menu counts and prefetches
+func (m Model) sessionCtx() context.Context {
+	return m.loads.root
+}
+
+// leaveAllPages cancels the loads of every page when the navigation stack is
+// reset, as switching profile or region does
+func (m Model) leaveAllPages() {
+	m.loads.leave(0)
+}
+
+// unlessLeft wraps a load command so its message is dropped when ctx was
+// cancelled by the time it finishes: the page it was for has been left
+func unlessLeft(ctx context.Context, cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		msg := cmd()
+		if ctx.Err() != nil {
+			return nil
+		}
+		return msg
+	}
+}
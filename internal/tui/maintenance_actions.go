@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/pages"
+)
+
+// Action IDs for maintenance window modals
+const (
+	actionMaintenanceWindow = "maintenance.window"
+)
+
+// maintenanceListPages are the list pages each detail page is opened from,
+// whose cached instances hold the maintenance window
+var maintenanceListPages = map[PageType]PageType{
+	PageRDSDetail:     PageRDSList,
+	PageRedisDetail:   PageRedisList,
+	PageMongoDBDetail: PageMongoDBList,
+}
+
+// handleMaintenanceWindowRequest opens the picker of maintenance windows for
+// the instance of an RDS, Redis or MongoDB detail page. A current window the
+// picker does not offer, e.g. a longer RDS window, is listed first
+func (m Model) handleMaintenanceWindowRequest(msg pages.MaintenanceWindowRequestMsg) (Model, tea.Cmd) {
+	var options []components.SelectOption
+	if current, err := service.ParseMaintenanceWindow(msg.Current); err == nil {
+		options = append(options, maintenanceWindowOption(current))
+	}
+	for _, window := range service.MaintenanceWindows() {
+		if window.String() != msg.Current {
+			options = append(options, maintenanceWindowOption(window))
+		}
+	}
+
+	m.modal = components.NewSelectModal(actionMaintenanceWindow,
+		fmt.Sprintf(i18n.T(i18n.KeyMaintenanceWindowTitle), msg.InstanceID),
+		options, msg.Current, msg)
+	return m, nil
+}
+
+// maintenanceWindowOption lists a window with its local time
+func maintenanceWindowOption(window service.MaintenanceWindow) components.SelectOption {
+	return components.SelectOption{
+		Value: window.String(),
+		Label: fmt.Sprintf("%s  (%s)", window, window.Local()),
+	}
+}
+
+// handleMaintenanceWindowSelected applies the picked maintenance window
+func (m Model) handleMaintenanceWindowSelected(msg components.OptionSelectedMsg) (Model, tea.Cmd) {
+	req, ok := msg.Data.(pages.MaintenanceWindowRequestMsg)
+	if !ok || msg.Value == req.Current {
+		return m, nil
+	}
+	window, err := service.ParseMaintenanceWindow(msg.Value)
+	if err != nil {
+		m.modal = components.NewErrorModal(err.Error())
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	switch req.Page {
+	case PageRDSDetail:
+		cmd = ModifyRDSMaintenanceWindow(m.services.RDS, req.InstanceID, window)
+	case PageRedisDetail:
+		cmd = ModifyRedisMaintenanceWindow(m.services.Redis, req.InstanceID, window)
+	case PageMongoDBDetail:
+		cmd = ModifyMongoDBMaintenanceWindow(m.services.MongoDB, req.InstanceID, window)
+	default:
+		return m, nil
+	}
+	m.loading = true
+	return m, cmd
+}
+
+// handleMaintenanceWindowChanged reports the changed window, drops the cached
+// list of the product and reloads the detail page still showing the instance
+func (m Model) handleMaintenanceWindowChanged(msg MaintenanceWindowChangedMsg) (Model, tea.Cmd) {
+	m.loading = false
+	m.modal = components.NewSuccessModal(msg.Message)
+	m.invalidatePage(maintenanceListPages[msg.Page])
+
+	switch {
+	case msg.Page == PageRDSDetail && m.rdsDetailPage.InstanceID() == msg.InstanceID:
+		return m, LoadRDSAttributes(m.loadCtx(), m.services.RDS, msg.InstanceID)
+	case msg.Page == PageRedisDetail && m.redisDetailPage.InstanceID() == msg.InstanceID:
+		return m, LoadRedisAttributes(m.loadCtx(), m.services.Redis, msg.InstanceID)
+	case msg.Page == PageMongoDBDetail && m.mongoDetailPage.InstanceID() == msg.InstanceID:
+		return m, LoadMongoDBTopology(m.loadCtx(), m.services.MongoDB, msg.InstanceID)
+	}
+	return m, nil
+}
@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/config"
+	"aliyun-tui-viewer/internal/service"
+)
+
+// menuCountTypes maps the menu's services to the Resource Center type they
+// are counted by: the regional list pages and a few more
+var menuCountTypes = func() map[PageType]string {
+	countTypes := map[PageType]string{
+		PageSecurityGroups: "ACS::ECS::SecurityGroup",
+		PageOSSBuckets:     "ACS::OSS::Bucket",
+		PageNATList:        "ACS::VPC::NatGateway",
+	}
+	for page, resourceType := range emptyStateTypes {
+		countTypes[page] = resourceType
+	}
+	return countTypes
+}()
+
+// MenuCountsLoadedMsg contains the resources the menu's services have in a
+// region, and those the previous run saw there
+type MenuCountsLoadedMsg struct {
+	Profile  string
+	Region   string
+	Counts   map[string]int // By Resource Center type
+	Previous map[string]int // Nil when no run counted this region before
+	Err      error
+}
+
+// LoadMenuCounts creates a command counting the resources of the menu's
+// services with a fresh Resource Center scan. The counts replace the saved
+// ones, after the saved ones are read to compare with
+func LoadMenuCounts(ctx context.Context, regionSvc *service.RegionService, profile, region string) tea.Cmd {
+	return unlessLeft(ctx, func() tea.Msg {
+		found, err := regionSvc.RefreshResourceCounts(ctx, region)
+		if err != nil {
+			return MenuCountsLoadedMsg{Profile: profile, Region: region, Err: err}
+		}
+
+		// Types without resources are saved as 0, so they show growth next time
+		counts := make(map[string]int, len(menuCountTypes))
+		for _, resourceType := range menuCountTypes {
+			counts[resourceType] = found[resourceType]
+		}
+		var previous map[string]int
+		if snapshot := config.LoadResourceCounts(profile, region); snapshot != nil {
+			previous = snapshot.Counts
+		}
+		_ = config.SaveResourceCounts(profile, region, counts) // Compared against the same counts again next time if it fails
+		return MenuCountsLoadedMsg{Profile: profile, Region: region, Counts: counts, Previous: previous}
+	})
+}
+
+// refreshMenuCounts starts counting the menu's services when the menu shows
+// counts of another profile or region than the current ones
+func (m Model) refreshMenuCounts() (Model, tea.Cmd) {
+	key := m.profile + "|" + m.region
+	if m.currentPage != PageMenu || m.allRegions || m.regionService == nil || m.menuCountsKey == key {
+		return m, nil
+	}
+	m.menuCountsKey = key
+	m.menuPage = m.menuPage.SetCounts(nil, nil)
+	return m, LoadMenuCounts(m.sessionCtx(), m.regionService, m.profile, m.region)
+}
+
+// handleMenuCountsLoaded shows the counts on the menu, with how they changed
+// since the first count of the region this session loaded. Later counts in the
+// same session keep comparing with the previous run's, so a change stays
+// visible. Failures leave the menu without counts
+func (m Model) handleMenuCountsLoaded(msg MenuCountsLoadedMsg) (tea.Model, tea.Cmd) {
+	if msg.Err != nil || msg.Profile != m.profile || msg.Region != m.region {
+		return m, nil
+	}
+
+	key := msg.Profile + "|" + msg.Region
+	baseline, ok := m.menuCountBaselines[key]
+	if !ok {
+		baseline = msg.Previous
+		m.menuCountBaselines[key] = baseline
+	}
+
+	counts := make(map[PageType]int, len(menuCountTypes))
+	previous := make(map[PageType]int, len(menuCountTypes))
+	for page, resourceType := range menuCountTypes {
+		counts[page] = msg.Counts[resourceType]
+		if n, ok := baseline[resourceType]; ok {
+			previous[page] = n
+		}
+	}
+	m.menuPage = m.menuPage.SetCounts(counts, previous)
+	return m, nil
+}
@@ -1,14 +1,26 @@
 package tui
 
 import (
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+	cloudconfig "github.com/aliyun/alibaba-cloud-sdk-go/services/config"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr_ee"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/dds"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/nlb"
 	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	tea "github.com/charmbracelet/bubbletea"
 
+	"aliyun-tui-viewer/internal/config"
 	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/pages"
 	"aliyun-tui-viewer/internal/tui/types"
 )
 
@@ -17,40 +29,127 @@ type PageType = types.PageType
 
 // Re-export page constants
 const (
-	PageMenu                   = types.PageMenu
-	PageECSList                = types.PageECSList
-	PageECSDetail              = types.PageECSDetail
-	PageECSJSONDetail          = types.PageECSJSONDetail
-	PageECSDisks               = types.PageECSDisks
-	PageECSNetworkInterfaces   = types.PageECSNetworkInterfaces
-	PageSecurityGroups         = types.PageSecurityGroups
-	PageSecurityGroupRules     = types.PageSecurityGroupRules
-	PageSecurityGroupInstances = types.PageSecurityGroupInstances
-	PageInstanceSecurityGroups = types.PageInstanceSecurityGroups
-	PageDNSDomains             = types.PageDNSDomains
-	PageDNSRecords             = types.PageDNSRecords
-	PageSLBList                = types.PageSLBList
-	PageSLBDetail              = types.PageSLBDetail
-	PageSLBListeners           = types.PageSLBListeners
-	PageSLBVServerGroups       = types.PageSLBVServerGroups
-	PageSLBBackendServers      = types.PageSLBBackendServers
-	PageSLBForwardingRules     = types.PageSLBForwardingRules
-	PageSLBDefaultServers      = types.PageSLBDefaultServers
-	PageOSSBuckets             = types.PageOSSBuckets
-	PageOSSObjects             = types.PageOSSObjects
-	PageOSSObjectDetail        = types.PageOSSObjectDetail
-	PageRDSList                = types.PageRDSList
-	PageRDSDetail              = types.PageRDSDetail
-	PageRDSDatabases           = types.PageRDSDatabases
-	PageRDSAccounts            = types.PageRDSAccounts
-	PageRedisList              = types.PageRedisList
-	PageRedisDetail            = types.PageRedisDetail
-	PageRedisAccounts          = types.PageRedisAccounts
-	PageRocketMQList           = types.PageRocketMQList
-	PageRocketMQDetail         = types.PageRocketMQDetail
-	PageRocketMQTopics         = types.PageRocketMQTopics
-	PageRocketMQGroups         = types.PageRocketMQGroups
-	PageResourceFinder         = types.PageResourceFinder
+	PageMenu                    = types.PageMenu
+	PageECSList                 = types.PageECSList
+	PageECSDetail               = types.PageECSDetail
+	PageECSJSONDetail           = types.PageECSJSONDetail
+	PageECSDisks                = types.PageECSDisks
+	PageECSNetworkInterfaces    = types.PageECSNetworkInterfaces
+	PageSecurityGroups          = types.PageSecurityGroups
+	PageSecurityGroupRules      = types.PageSecurityGroupRules
+	PageSecurityGroupInstances  = types.PageSecurityGroupInstances
+	PageInstanceSecurityGroups  = types.PageInstanceSecurityGroups
+	PageDNSDomains              = types.PageDNSDomains
+	PageDNSRecords              = types.PageDNSRecords
+	PageSLBList                 = types.PageSLBList
+	PageSLBDetail               = types.PageSLBDetail
+	PageSLBListeners            = types.PageSLBListeners
+	PageSLBVServerGroups        = types.PageSLBVServerGroups
+	PageSLBBackendServers       = types.PageSLBBackendServers
+	PageSLBForwardingRules      = types.PageSLBForwardingRules
+	PageSLBDefaultServers       = types.PageSLBDefaultServers
+	PageOSSBuckets              = types.PageOSSBuckets
+	PageOSSObjects              = types.PageOSSObjects
+	PageOSSObjectDetail         = types.PageOSSObjectDetail
+	PageOSSObjectPreview        = types.PageOSSObjectPreview
+	PageRDSList                 = types.PageRDSList
+	PageRDSDetail               = types.PageRDSDetail
+	PageRDSDatabases            = types.PageRDSDatabases
+	PageRDSAccounts             = types.PageRDSAccounts
+	PageRedisList               = types.PageRedisList
+	PageRedisDetail             = types.PageRedisDetail
+	PageRedisAccounts           = types.PageRedisAccounts
+	PageRocketMQList            = types.PageRocketMQList
+	PageRocketMQDetail          = types.PageRocketMQDetail
+	PageRocketMQTopics          = types.PageRocketMQTopics
+	PageRocketMQGroups          = types.PageRocketMQGroups
+	PageResourceFinder          = types.PageResourceFinder
+	PageJobs                    = types.PageJobs
+	PageRAMUsers                = types.PageRAMUsers
+	PageRAMRoles                = types.PageRAMRoles
+	PageRAMPolicies             = types.PageRAMPolicies
+	PageRAMUserPolicies         = types.PageRAMUserPolicies
+	PageRAMDetail               = types.PageRAMDetail
+	PageECSMetrics              = types.PageECSMetrics
+	PageRDSMetrics              = types.PageRDSMetrics
+	PageSLSProjects             = types.PageSLSProjects
+	PageSLSLogtailConfigs       = types.PageSLSLogtailConfigs
+	PageSLSMachineGroups        = types.PageSLSMachineGroups
+	PageSLSMachines             = types.PageSLSMachines
+	PageSLSCoverage             = types.PageSLSCoverage
+	PageSLSDetail               = types.PageSLSDetail
+	PageConfigRules             = types.PageConfigRules
+	PageConfigResults           = types.PageConfigResults
+	PageConfigDetail            = types.PageConfigDetail
+	PageTagBrowser              = types.PageTagBrowser
+	PageTagResources            = types.PageTagResources
+	PageTagDetail               = types.PageTagDetail
+	PageBilling                 = types.PageBilling
+	PageBillingDetail           = types.PageBillingDetail
+	PageDNSDangling             = types.PageDNSDangling
+	PageRAMRolePolicies         = types.PageRAMRolePolicies
+	PageNATList                 = types.PageNATList
+	PageNATDetail               = types.PageNATDetail
+	PageSNATEntries             = types.PageSNATEntries
+	PageDNATEntries             = types.PageDNATEntries
+	PageOSSObjectVersions       = types.PageOSSObjectVersions
+	PageALBList                 = types.PageALBList
+	PageALBDetail               = types.PageALBDetail
+	PageALBListeners            = types.PageALBListeners
+	PageALBRules                = types.PageALBRules
+	PageALBRuleDetail           = types.PageALBRuleDetail
+	PageALBServerGroups         = types.PageALBServerGroups
+	PageNLBList                 = types.PageNLBList
+	PageNLBDetail               = types.PageNLBDetail
+	PageNLBListeners            = types.PageNLBListeners
+	PageNLBServerGroups         = types.PageNLBServerGroups
+	PageLBServers               = types.PageLBServers
+	PageOSSBucketDetail         = types.PageOSSBucketDetail
+	PageACKClusters             = types.PageACKClusters
+	PageACKClusterDetail        = types.PageACKClusterDetail
+	PageACKNodePools            = types.PageACKNodePools
+	PageACKNodes                = types.PageACKNodes
+	PageOSSObjectScan           = types.PageOSSObjectScan
+	PageACRInstances            = types.PageACRInstances
+	PageACRNamespaces           = types.PageACRNamespaces
+	PageACRRepositories         = types.PageACRRepositories
+	PageACRTags                 = types.PageACRTags
+	PageACRTagDetail            = types.PageACRTagDetail
+	PageFCServices              = types.PageFCServices
+	PageFCFunctions             = types.PageFCFunctions
+	PageFCFunctionDetail        = types.PageFCFunctionDetail
+	PageSLBJSONDetail           = types.PageSLBJSONDetail
+	PageMongoDBList             = types.PageMongoDBList
+	PageMongoDBDetail           = types.PageMongoDBDetail
+	PageMongoDBJSONDetail       = types.PageMongoDBJSONDetail
+	PageMongoDBAccounts         = types.PageMongoDBAccounts
+	PageRDSJSONDetail           = types.PageRDSJSONDetail
+	PageRedisJSONDetail         = types.PageRedisJSONDetail
+	PageRocketMQJSONDetail      = types.PageRocketMQJSONDetail
+	PageElasticsearchList       = types.PageElasticsearchList
+	PageElasticsearchDetail     = types.PageElasticsearchDetail
+	PageElasticsearchJSONDetail = types.PageElasticsearchJSONDetail
+	PageZoneCapacity            = types.PageZoneCapacity
+	PageKafkaList               = types.PageKafkaList
+	PageKafkaDetail             = types.PageKafkaDetail
+	PageKafkaJSONDetail         = types.PageKafkaJSONDetail
+	PageKafkaTopics             = types.PageKafkaTopics
+	PageKafkaGroups             = types.PageKafkaGroups
+	PageRocketMQLag             = types.PageRocketMQLag
+	PageRocketMQMessages        = types.PageRocketMQMessages
+	PageRedisParameters         = types.PageRedisParameters
+	PageRedisMetrics            = types.PageRedisMetrics
+	PageRecycleBin              = types.PageRecycleBin
+	PageOSSDeletedObjects       = types.PageOSSDeletedObjects
+	PageRedisBackups            = types.PageRedisBackups
+	PageRDSBackups              = types.PageRDSBackups
+	PageRDSBinlogs              = types.PageRDSBinlogs
+	PageRDSSlowLog              = types.PageRDSSlowLog
+	PageChangelog               = types.PageChangelog
+	PageRecent                  = types.PageRecent
+	PageUsage                   = types.PageUsage
+	PageDNSImport               = types.PageDNSImport
+	PageAPITrace                = types.PageAPITrace
 )
 
 // NavigateMsg requests navigation to a specific page
@@ -78,6 +177,15 @@ type ECSInstancesLoadedMsg struct {
 	Instances []ecs.Instance
 }
 
+// ECSInstancesBatchMsg contains the ECS instances loaded so far while more
+// pages follow
+type ECSInstancesBatchMsg struct {
+	Instances []ecs.Instance // Every instance loaded so far
+	Total     int            // Instances in the region, 0 when unknown
+	Stream    int64          // Identifies the load, so a superseded one stops
+	next      tea.Cmd        // Loads the next page
+}
+
 // ECSInstanceSelectedMsg indicates an ECS instance was selected
 type ECSInstanceSelectedMsg struct {
 	Instance ecs.Instance
@@ -95,6 +203,40 @@ type ECSNetworkInterfacesLoadedMsg struct {
 	InstanceId        string
 }
 
+// ECSMetricsLoadedMsg contains CloudMonitor metrics of an ECS instance
+type ECSMetricsLoadedMsg struct {
+	InstanceID string
+	Series     []service.MetricSeries
+}
+
+// ECSSizingLoadedMsg contains the right-sizing recommendation of an ECS
+// instance. Err is shown in place of the recommendation rather than as an error
+type ECSSizingLoadedMsg struct {
+	InstanceID     string
+	Family         string
+	Recommendation service.SizingRecommendation
+	Err            error
+}
+
+// ZoneCapacityLoadedMsg contains the instance families that can be created in
+// each zone
+type ZoneCapacityLoadedMsg struct {
+	Capacity []service.ZoneFamilyCapacity
+}
+
+// RDSMetricsLoadedMsg contains CloudMonitor metrics of an RDS instance
+type RDSMetricsLoadedMsg struct {
+	InstanceID string
+	Series     []service.MetricSeries
+}
+
+// ECSConsoleURLLoadedMsg contains the management terminal URL of an ECS instance
+type ECSConsoleURLLoadedMsg struct {
+	InstanceID string
+	URL        string
+	Open       bool // Open in the browser instead of copying
+}
+
 // --- Security Groups Messages ---
 
 // SecurityGroupsLoadedMsg contains loaded security groups
@@ -107,6 +249,12 @@ type SecurityGroupRulesLoadedMsg struct {
 	Response *ecs.DescribeSecurityGroupAttributeResponse
 }
 
+// SecurityGroupRuleChangedMsg is sent after a rule was added, modified or revoked
+type SecurityGroupRuleChangedMsg struct {
+	SecurityGroupId string
+	Message         string
+}
+
 // SecurityGroupInstancesLoadedMsg contains instances for a security group
 type SecurityGroupInstancesLoadedMsg struct {
 	Instances       []ecs.Instance
@@ -132,6 +280,36 @@ type DNSRecordsLoadedMsg struct {
 	DomainName string
 }
 
+// DNSTargetsLoadedMsg contains the index of resources DNS records are matched against
+type DNSTargetsLoadedMsg struct {
+	Index *service.DNSTargetIndex
+}
+
+// DanglingDNSLoadedMsg contains the records of every domain whose target
+// matches no account resource
+type DanglingDNSLoadedMsg struct {
+	Records  []service.DanglingDNSRecord
+	Complete bool // False when some regions or products could not be listed
+}
+
+// DNSRecordChangedMsg is sent after a record was added, updated, deleted or paused
+type DNSRecordChangedMsg struct {
+	DomainName string
+	Message    string
+}
+
+// DNSImportPlannedMsg contains the dry run of a DNS changes file
+type DNSImportPlannedMsg struct {
+	Plan *service.DNSImportPlan
+}
+
+// DNSImportAppliedMsg contains the changes of an import once applied. Err is
+// set when the import was stopped, the changes not made yet still ready
+type DNSImportAppliedMsg struct {
+	Plan *service.DNSImportPlan
+	Err  error
+}
+
 // --- SLB Messages ---
 
 // SLBInstancesLoadedMsg contains loaded SLB instances
@@ -157,6 +335,49 @@ type SLBBackendServersLoadedMsg struct {
 	VServerGroupId string
 }
 
+// SLBBackendTargetsLoadedMsg contains the SLB instances ECS backends can be
+// added to
+type SLBBackendTargetsLoadedMsg struct {
+	Request       pages.SLBBackendAddRequestMsg
+	LoadBalancers []slb.LoadBalancer
+}
+
+// SLBBackendGroupsLoadedMsg contains the VServer groups of the SLB picked for
+// new backends
+type SLBBackendGroupsLoadedMsg struct {
+	Request       pages.SLBBackendAddRequestMsg
+	VServerGroups []slb.VServerGroup
+}
+
+// SLBBackendsAddedMsg reports backends registered in a VServer group, Created
+// when the group is new
+type SLBBackendsAddedMsg struct {
+	LoadBalancerID string
+	VServerGroupID string
+	Created        bool
+	Count          int
+}
+
+// SLBCertificatesLoadedMsg contains the server certificates an HTTPS
+// listener can be bound to
+type SLBCertificatesLoadedMsg struct {
+	Request      pages.SLBCertRotateRequestMsg
+	Certificates []slb.ServerCertificate
+}
+
+// SLBCertUploadedMsg reports a server certificate uploaded for a listener
+type SLBCertUploadedMsg struct {
+	Request     pages.SLBCertRotateRequestMsg
+	Certificate slb.ServerCertificate
+}
+
+// SLBCertBoundMsg reports an HTTPS listener bound to another certificate
+type SLBCertBoundMsg struct {
+	LoadBalancerID string
+	Port           int
+	CertificateID  string
+}
+
 // SLBForwardingRulesLoadedMsg contains loaded forwarding rules
 type SLBForwardingRulesLoadedMsg struct {
 	Rules            []service.ForwardingRuleDetail
@@ -178,6 +399,11 @@ type OSSBucketsLoadedMsg struct {
 	Buckets []oss.BucketProperties
 }
 
+// OSSBucketAuditLoadedMsg contains the settings of a bucket
+type OSSBucketAuditLoadedMsg struct {
+	Audit *service.BucketAudit
+}
+
 // OSSObjectsLoadedMsg contains loaded OSS objects with pagination
 type OSSObjectsLoadedMsg struct {
 	Result     *service.ObjectListResult
@@ -185,6 +411,68 @@ type OSSObjectsLoadedMsg struct {
 	Page       int
 }
 
+// OSSObjectPreviewLoadedMsg contains the leading content of an object for preview
+type OSSObjectPreviewLoadedMsg struct {
+	BucketName string
+	Content    *service.ObjectContent
+}
+
+// OSSObjectsScannedMsg contains every object found under a prefix
+type OSSObjectsScannedMsg struct {
+	Scan   pages.OSSScanNavData
+	Result *service.ObjectScanResult
+}
+
+// OSSArchiveRestoreStartedMsg is sent when OSS accepted the restore of an
+// archived object
+type OSSArchiveRestoreStartedMsg struct {
+	BucketName string
+	ObjectKey  string
+}
+
+// OSSObjectVersionsLoadedMsg contains the versions of an object
+type OSSObjectVersionsLoadedMsg struct {
+	BucketName string
+	ObjectKey  string
+	Versions   []service.ObjectVersion
+}
+
+// OSSVersionRestoredMsg is sent after a previous version became the current one
+type OSSVersionRestoredMsg struct {
+	BucketName string
+	ObjectKey  string
+	Message    string
+}
+
+// OSSDeletedObjectsLoadedMsg contains the deleted objects under a folder
+type OSSDeletedObjectsLoadedMsg struct {
+	BucketName string
+	Prefix     string
+	Markers    []service.ObjectVersion
+	Truncated  bool
+}
+
+// OSSUndeletedMsg is sent after the delete marker of an object was removed
+type OSSUndeletedMsg struct {
+	BucketName string
+	ObjectKey  string
+}
+
+// OSSObjectsCopiedMsg is sent when a copy or move of objects finishes. Err
+// is set when it was stopped, Result holding the objects done by then
+type OSSObjectsCopiedMsg struct {
+	Copy   service.ObjectCopy
+	Result *service.ObjectCopyResult
+	Err    error
+}
+
+// OSSDownloadDoneMsg is sent when a download finishes or fails
+type OSSDownloadDoneMsg struct {
+	ObjectKey string
+	LocalPath string
+	Err       error
+}
+
 // OSSObjectSelectedMsg indicates an OSS object was selected
 type OSSObjectSelectedMsg struct {
 	Object oss.ObjectProperties
@@ -208,6 +496,14 @@ type RDSDatabasesLoadedMsg struct {
 	InstanceId string
 }
 
+// RDSAttributesLoadedMsg contains the attributes of an RDS instance, with the
+// error that prevented loading them
+type RDSAttributesLoadedMsg struct {
+	InstanceID string
+	Attributes *service.RDSInstanceAttributes
+	Err        error
+}
+
 // RDSAccountsLoadedMsg contains loaded RDS accounts
 type RDSAccountsLoadedMsg struct {
 	Accounts   []rds.DBInstanceAccount
@@ -221,12 +517,149 @@ type RedisInstancesLoadedMsg struct {
 	Instances []r_kvstore.KVStoreInstance
 }
 
+// RedisAttributesLoadedMsg contains the attributes of a Redis instance, with
+// the error that prevented loading them
+type RedisAttributesLoadedMsg struct {
+	InstanceID string
+	Attributes *service.RedisInstanceAttributes
+	Err        error
+}
+
 // RedisAccountsLoadedMsg contains loaded Redis accounts
 type RedisAccountsLoadedMsg struct {
 	Accounts   []r_kvstore.Account
 	InstanceId string
 }
 
+// ConnTestedMsg contains the result of a connection test
+type ConnTestedMsg struct {
+	InstanceID string
+	Result     service.ConnTestResult
+}
+
+// BastionsLoadedMsg contains the ECS instances a port forward can go through
+type BastionsLoadedMsg struct {
+	Request   pages.PortForwardRequestMsg
+	Instances []ecs.Instance
+}
+
+// RecentResourceLoadedMsg contains a recently viewed resource loaded afresh,
+// Data is nil when it no longer exists
+type RecentResourceLoadedMsg struct {
+	Item config.RecentResource
+	Data interface{}
+}
+
+// DNSPropagationCheckedMsg contains the answers of the public resolvers for
+// a DNS record
+type DNSPropagationCheckedMsg struct {
+	Record alidns.Record
+	Result service.PropagationResult
+}
+
+// ProbedMsg contains the results of a latency probe. TCP is nil when no port
+// was probed
+type ProbedMsg struct {
+	Name string
+	ICMP service.ProbeResult
+	TCP  *service.ProbeResult
+}
+
+// RDSSlowLogsLoadedMsg contains the slow queries of an RDS instance summed up
+// per SQL template
+type RDSSlowLogsLoadedMsg struct {
+	InstanceId string
+	Days       int
+	Summaries  []service.SlowQuerySummary
+}
+
+// RDSBackupsLoadedMsg contains the recent backup sets of an RDS instance
+type RDSBackupsLoadedMsg struct {
+	InstanceId string
+	Backups    []rds.Backup
+}
+
+// RDSBinlogsLoadedMsg contains the recent binlog files of an RDS instance
+type RDSBinlogsLoadedMsg struct {
+	InstanceId string
+	Files      []rds.BinLogFile
+}
+
+// RedisBackupsLoadedMsg contains the recent backups of a Redis instance
+type RedisBackupsLoadedMsg struct {
+	InstanceId string
+	Backups    []r_kvstore.Backup
+}
+
+// RedisParametersLoadedMsg contains the parameters of a Redis instance
+type RedisParametersLoadedMsg struct {
+	Parameters []service.RedisParameter
+	InstanceId string
+}
+
+// RedisMetricsLoadedMsg contains the performance metrics of a Redis instance
+type RedisMetricsLoadedMsg struct {
+	InstanceID string
+	Series     []service.MetricSeries
+}
+
+// --- MongoDB Messages ---
+
+// MongoDBInstancesLoadedMsg contains loaded MongoDB instances
+type MongoDBInstancesLoadedMsg struct {
+	Instances []dds.DBInstance
+}
+
+// MongoDBTopologyLoadedMsg contains the topology of a MongoDB instance, with
+// the error that prevented loading it
+type MongoDBTopologyLoadedMsg struct {
+	InstanceID string
+	Topology   *service.MongoDBTopology
+	Err        error
+}
+
+// MongoDBAccountsLoadedMsg contains loaded MongoDB accounts
+type MongoDBAccountsLoadedMsg struct {
+	Accounts   []dds.Account
+	InstanceId string
+}
+
+// --- Maintenance Window Messages ---
+
+// MaintenanceWindowChangedMsg is sent after the maintenance window of an RDS,
+// Redis or MongoDB instance was changed. Page is the detail page of the product
+type MaintenanceWindowChangedMsg struct {
+	Page       PageType
+	InstanceID string
+	Message    string
+}
+
+// --- Elasticsearch Messages ---
+
+// ElasticsearchInstancesLoadedMsg contains loaded Elasticsearch instances
+type ElasticsearchInstancesLoadedMsg struct {
+	Instances []service.ElasticsearchInstance
+}
+
+// --- Kafka Messages ---
+
+// KafkaInstancesLoadedMsg contains loaded Kafka instances
+type KafkaInstancesLoadedMsg struct {
+	Instances []alikafka.InstanceVO
+}
+
+// KafkaTopicsLoadedMsg contains loaded Kafka topics
+type KafkaTopicsLoadedMsg struct {
+	Topics     []alikafka.TopicVO
+	InstanceId string
+}
+
+// KafkaGroupsLoadedMsg contains loaded Kafka consumer groups
+type KafkaGroupsLoadedMsg struct {
+	Groups     []service.KafkaConsumerGroup
+	InstanceId string
+}
+
 // --- RocketMQ Messages ---
 
 // RocketMQInstancesLoadedMsg contains loaded RocketMQ instances
@@ -240,12 +673,286 @@ type RocketMQTopicsLoadedMsg struct {
 	InstanceId string
 }
 
+// RocketMQInfoLoadedMsg contains the base info of a RocketMQ instance, with
+// the error that prevented loading it
+type RocketMQInfoLoadedMsg struct {
+	InstanceID string
+	Info       *service.RocketMQInstanceInfo
+	Err        error
+}
+
 // RocketMQGroupsLoadedMsg contains loaded RocketMQ groups
 type RocketMQGroupsLoadedMsg struct {
 	Groups     []service.RocketMQGroup
 	InstanceId string
 }
 
+// RocketMQMessagesLoadedMsg contains the messages of a RocketMQ topic
+type RocketMQMessagesLoadedMsg struct {
+	Messages []service.RocketMQMessage
+	Topic    string
+}
+
+// --- RAM Messages ---
+
+// RAMUsersLoadedMsg contains loaded RAM users with their access keys and MFA devices
+type RAMUsersLoadedMsg struct {
+	Users []service.RAMUserDetail
+}
+
+// RAMRolesLoadedMsg contains loaded RAM roles
+type RAMRolesLoadedMsg struct {
+	Roles []ram.Role
+}
+
+// RAMPoliciesLoadedMsg contains loaded RAM policies
+type RAMPoliciesLoadedMsg struct {
+	Policies []ram.Policy
+}
+
+// RAMUserPoliciesLoadedMsg contains the policies attached to a RAM user
+type RAMUserPoliciesLoadedMsg struct {
+	Policies []ram.Policy
+	UserName string
+}
+
+// RAMRolePoliciesLoadedMsg contains the policies attached to a RAM role
+type RAMRolePoliciesLoadedMsg struct {
+	Policies []ram.Policy
+	RoleName string
+}
+
+// SLBAttributeLoadedMsg contains the attributes of an SLB instance, with its
+// listeners and default servers
+type SLBAttributeLoadedMsg struct {
+	LoadBalancerID string
+	Attribute      *slb.DescribeLoadBalancerAttributeResponse
+	Err            error
+}
+
+// InstanceRAMRoleLoadedMsg contains the RAM role attached to an ECS instance
+type InstanceRAMRoleLoadedMsg struct {
+	InstanceID string
+	RoleName   string // Empty when the instance has no role
+	Err        error
+}
+
+// RAMPolicyDetailLoadedMsg contains a policy with its default version document
+type RAMPolicyDetailLoadedMsg struct {
+	Detail *service.RAMPolicyDetail
+}
+
+// --- Log Service Messages ---
+
+// SLSProjectsLoadedMsg contains loaded Log Service projects
+type SLSProjectsLoadedMsg struct {
+	Projects []service.SLSProject
+}
+
+// SLSLogtailLoadedMsg contains a project's Logtail configs, machine groups
+// and ECS coverage
+type SLSLogtailLoadedMsg struct {
+	Overview service.LogtailOverview
+}
+
+// --- Cloud Config Messages ---
+
+// ConfigRulesLoadedMsg contains loaded Cloud Config rules
+type ConfigRulesLoadedMsg struct {
+	Rules []cloudconfig.ConfigRule
+}
+
+// ConfigResultsLoadedMsg contains the non-compliant resources of a rule
+type ConfigResultsLoadedMsg struct {
+	RuleID  string
+	Results []cloudconfig.EvaluationResult
+}
+
+// --- Tag Messages ---
+
+// TagsLoadedMsg contains the tag keys and values in use
+type TagsLoadedMsg struct {
+	Tags []service.TagPair
+}
+
+// TaggedResourcesLoadedMsg contains the resources carrying a tag
+type TaggedResourcesLoadedMsg struct {
+	Tag       service.TagPair
+	Resources []service.TaggedResource
+}
+
+// TagFilterLoadedMsg contains the tag filter to apply to a list page
+type TagFilterLoadedMsg struct {
+	Page   PageType
+	Filter *pages.TagFilter
+}
+
+// --- ALB/NLB Messages ---
+
+// ALBLoadBalancersLoadedMsg contains loaded ALB instances
+type ALBLoadBalancersLoadedMsg struct {
+	LoadBalancers []alb.LoadBalancer
+}
+
+// ALBListenersLoadedMsg contains the listeners of an ALB instance
+type ALBListenersLoadedMsg struct {
+	LoadBalancerID string
+	Listeners      []alb.Listener
+}
+
+// ALBRulesLoadedMsg contains the forwarding rules of an ALB listener
+type ALBRulesLoadedMsg struct {
+	ListenerID string
+	Rules      []alb.Rule
+}
+
+// ALBServerGroupsLoadedMsg contains the server groups of an ALB instance
+type ALBServerGroupsLoadedMsg struct {
+	LoadBalancerID string
+	ServerGroups   []alb.ServerGroup
+}
+
+// NLBLoadBalancersLoadedMsg contains loaded NLB instances
+type NLBLoadBalancersLoadedMsg struct {
+	LoadBalancers []nlb.LoadbalancerInfo
+}
+
+// NLBListenersLoadedMsg contains the listeners of an NLB instance
+type NLBListenersLoadedMsg struct {
+	LoadBalancerID string
+	Listeners      []nlb.ListenerInfo
+}
+
+// NLBServerGroupsLoadedMsg contains the server groups of an NLB instance
+type NLBServerGroupsLoadedMsg struct {
+	LoadBalancerID string
+	ServerGroups   []nlb.ServerGroup
+}
+
+// LBServersLoadedMsg contains the backend servers of an ALB or NLB server group
+type LBServersLoadedMsg struct {
+	ServerGroupID string
+	Servers       []service.LBServer
+}
+
+// --- ACK Messages ---
+
+// ACKClustersLoadedMsg contains loaded ACK clusters
+type ACKClustersLoadedMsg struct {
+	Clusters []service.ACKCluster
+}
+
+// ACKClusterDetailLoadedMsg contains a cluster with its node pools
+type ACKClusterDetailLoadedMsg struct {
+	Detail *service.ACKClusterDetail
+}
+
+// ACKNodePoolsLoadedMsg contains the node pools of a cluster
+type ACKNodePoolsLoadedMsg struct {
+	ClusterID string
+	NodePools []service.ACKNodePool
+}
+
+// ACKNodesLoadedMsg contains the nodes of a node pool
+type ACKNodesLoadedMsg struct {
+	NodePoolID string
+	Nodes      []cs.Node
+}
+
+// --- ACR Messages ---
+
+// ACRInstancesLoadedMsg contains loaded ACR instances
+type ACRInstancesLoadedMsg struct {
+	Instances []cr_ee.InstancesItem
+}
+
+// ACRNamespacesLoadedMsg contains the namespaces of an instance
+type ACRNamespacesLoadedMsg struct {
+	InstanceID string
+	Namespaces []cr_ee.NamespacesItem
+}
+
+// ACRRepositoriesLoadedMsg contains the repositories of a namespace
+type ACRRepositoriesLoadedMsg struct {
+	Namespace    pages.ACRNamespaceNavData
+	Repositories []service.ACRRepository
+}
+
+// ACRTagsLoadedMsg contains the image tags of a repository
+type ACRTagsLoadedMsg struct {
+	RepoID string
+	Tags   []service.ACRTag
+}
+
+// --- FC Messages ---
+
+// FCServicesLoadedMsg contains loaded Function Compute services
+type FCServicesLoadedMsg struct {
+	Services []service.FCServiceInfo
+}
+
+// FCFunctionsLoadedMsg contains the functions of a service
+type FCFunctionsLoadedMsg struct {
+	ServiceName string
+	Functions   []service.FCFunction
+}
+
+// FCFunctionStatsLoadedMsg contains the recent invocation counts of the
+// functions of a service, keyed by function name
+type FCFunctionStatsLoadedMsg struct {
+	ServiceName string
+	Stats       map[string]service.FCInvocationStats
+	Err         error
+}
+
+// --- NAT Messages ---
+
+// NATGatewaysLoadedMsg contains loaded NAT gateways
+type NATGatewaysLoadedMsg struct {
+	Gateways []vpc.NatGateway
+}
+
+// SNATEntriesLoadedMsg contains the SNAT entries of a NAT gateway
+type SNATEntriesLoadedMsg struct {
+	GatewayID string
+	Entries   []vpc.SnatTableEntry
+}
+
+// DNATEntriesLoadedMsg contains the DNAT entries of a NAT gateway
+type DNATEntriesLoadedMsg struct {
+	Gateway vpc.NatGateway
+	Entries []vpc.ForwardTableEntry
+}
+
+// --- Billing Messages ---
+
+// BillingLoadedMsg contains the billing overview of the current month
+type BillingLoadedMsg struct {
+	Overview *service.BillingOverview
+}
+
+// InstanceCostLoadedMsg contains the month-to-date cost of an instance
+type InstanceCostLoadedMsg struct {
+	InstanceID string
+	Cost       *service.InstanceCost
+	Err        error
+}
+
+// --- Recycle Bin Messages ---
+
+// RecycleBinLoadedMsg contains the recycled instances of the region. Err is
+// set when one of the products could not be listed
+type RecycleBinLoadedMsg struct {
+	Resources []service.RecycledResource
+	Err       error
+}
+
+// ECSReleaseCancelledMsg is sent after the scheduled release of an instance
+// was cancelled
+type ECSReleaseCancelledMsg struct {
+	InstanceId string
+}
+
 // --- Resource Finder Messages ---
 
 // FindResourceStartMsg indicates resource finding should start
@@ -258,6 +965,13 @@ type FindResourceResultMsg struct {
 	Result *service.FindResult
 }
 
+// FindProfilesResultMsg contains the results of a finder query run in every
+// configured profile
+type FindProfilesResultMsg struct {
+	Query   string
+	Results []service.ProfileFindResult
+}
+
 // --- Search Messages ---
 
 // SearchStartMsg indicates search mode should start
@@ -287,6 +1001,9 @@ type CopyToClipboardMsg struct {
 // CopiedMsg indicates data was copied successfully
 type CopiedMsg struct{}
 
+// BrowserOpenedMsg indicates a URL was handed to the browser
+type BrowserOpenedMsg struct{}
+
 // OpenEditorMsg requests opening data in external editor
 type OpenEditorMsg struct {
 	Data interface{}
@@ -0,0 +1,35 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// contentTop is the first line of the pages, below the header and the line
+// the tabs show in
+const contentTop = 2
+
+// handleMouse passes a mouse event to the current page, positioned relative
+// to the page, which selects and opens rows with it. The overlays, the
+// search bar and the loading view take none; the split view's preview only
+// scrolls
+func (m Model) handleMouse(msg tea.MouseMsg) (Model, tea.Cmd) {
+	if m.modal.Visible || m.help.Visible || m.search.Active || m.loading || m.showsEmptyState() {
+		return m, nil
+	}
+	msg.Y -= contentTop
+	if msg.Y < 0 {
+		return m, nil
+	}
+
+	if m.splitShown() {
+		if left, _ := m.splitWidths(); msg.X >= left {
+			if tea.MouseEvent(msg).IsWheel() {
+				var cmd tea.Cmd
+				m.preview, cmd = m.preview.Update(msg)
+				return m, cmd
+			}
+			return m, nil
+		}
+	}
+	return m.updateCurrentPage(msg)
+}
@@ -0,0 +1,44 @@
+package tui
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/service"
+)
+
+// NamesResolvedMsg is sent when referenced IDs have been looked up, so pages
+// showing them can re-render with names
+type NamesResolvedMsg struct{}
+
+// ResolveNames creates a command fetching the names of IDs not cached yet.
+// Names are best effort: lookups that fail (e.g. without permission on the
+// VPC or Resource Manager APIs) leave the raw IDs in place
+func ResolveNames(ctx context.Context, resolver *service.NameResolver, refs service.NameRefs) tea.Cmd {
+	if len(resolver.Missing(refs)) == 0 {
+		return nil
+	}
+	return func() tea.Msg {
+		_ = resolver.Resolve(ctx, refs)
+		return NamesResolvedMsg{}
+	}
+}
+
+// applyResolvedNames re-renders the pages that show referenced IDs
+func (m Model) applyResolvedNames() Model {
+	names := m.services.Names
+	m.ecsDetailPage = m.ecsDetailPage.SetNames(names)
+	m.slbDetailPage = m.slbDetailPage.SetNames(names)
+	m.mongoDetailPage = m.mongoDetailPage.SetNames(names)
+	m.esDetailPage = m.esDetailPage.SetNames(names)
+	m.kafkaDetailPage = m.kafkaDetailPage.SetNames(names)
+	m.rdsDetailPage = m.rdsDetailPage.SetNames(names)
+	m.redisDetailPage = m.redisDetailPage.SetNames(names)
+	m.ecsENIPage = m.ecsENIPage.SetNames(names)
+	m.sgListPage = m.sgListPage.SetNames(names)
+	m.instSGPage = m.instSGPage.SetNames(names)
+	m.sgRulesPage = m.sgRulesPage.SetNames(names)
+	m.slbDefaultServersPage = m.slbDefaultServersPage.SetNames(names)
+	return m
+}
@@ -0,0 +1,334 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/jobs"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/pages"
+)
+
+// Action IDs for OSS modals
+const (
+	actionOSSDownload       = "oss.download"
+	actionOSSRestoreVersion = "oss.restore_version"
+	actionOSSPrefixSearch   = "oss.prefix_search"
+	actionOSSRestoreArchive = "oss.restore_archive"
+	actionOSSUndelete       = "oss.undelete"
+	actionOSSCopy           = "oss.copy"
+)
+
+// handleOSSDownloadRequest prompts for the destination path of an object download
+func (m Model) handleOSSDownloadRequest(msg pages.OSSDownloadRequestMsg) (Model, tea.Cmd) {
+	// Archived objects must be restored first; the restore state of previous
+	// versions is not listed, so OSS reports it for those
+	if status := service.RestoreStatusOf(msg.Object); msg.VersionID == "" && !status.CanRead() {
+		m.modal = components.NewErrorModal(fmt.Sprintf(i18n.T(i18n.KeyOSSRestoreRequired),
+			msg.Object.Key, msg.Object.StorageClass, status))
+		return m, nil
+	}
+
+	defaultPath := path.Base(msg.Object.Key)
+	if cwd, err := os.Getwd(); err == nil {
+		defaultPath = filepath.Join(cwd, defaultPath)
+	}
+
+	title := fmt.Sprintf("%s - oss://%s/%s", i18n.T(i18n.KeyOSSDownload), msg.BucketName, msg.Object.Key)
+	if msg.VersionID != "" {
+		title += fmt.Sprintf(" (%s)", msg.VersionID)
+	}
+	m.modal = components.NewFormModal(actionOSSDownload, title,
+		[]components.FormField{
+			{Key: "path", Label: i18n.T(i18n.KeyOSSDownloadPath), Value: defaultPath},
+		},
+		msg)
+	return m, nil
+}
+
+// handleOSSDownloadSubmitted starts the download once a destination was entered
+func (m Model) handleOSSDownloadSubmitted(msg components.FormSubmittedMsg) (Model, tea.Cmd) {
+	req, ok := msg.Data.(pages.OSSDownloadRequestMsg)
+	if !ok || msg.Values["path"] == "" {
+		return m, nil
+	}
+
+	title := fmt.Sprintf(i18n.T(i18n.KeyJobDownload), req.BucketName, req.Object.Key)
+	if req.VersionID != "" {
+		title = fmt.Sprintf(i18n.T(i18n.KeyJobDownloadVersion), req.BucketName, req.Object.Key, req.VersionID)
+	}
+	m, _, cmd := m.startJob(title, jobs.UnitBytes,
+		DownloadOSSObject(m.services.OSS, req.BucketName, req.Object.Key, req.VersionID, msg.Values["path"]))
+	return m, cmd
+}
+
+// handleOSSScanRequest opens the scan of every object under a folder, or
+// first asks for the key prefix to search for
+func (m Model) handleOSSScanRequest(msg pages.OSSScanRequestMsg) (Model, tea.Cmd) {
+	if !msg.Prompt {
+		return m.navigateTo(PageOSSObjectScan, pages.OSSScanNavData{BucketName: msg.BucketName, Prefix: msg.Prefix})
+	}
+	m.modal = components.NewFormModal(actionOSSPrefixSearch, fmt.Sprintf(i18n.T(i18n.KeyOSSPrefixSearch), msg.BucketName),
+		[]components.FormField{
+			{Key: "prefix", Label: i18n.T(i18n.KeyOSSScanPrefix), Value: msg.Prefix},
+		},
+		msg)
+	return m, nil
+}
+
+// handleOSSPrefixSearchSubmitted scans the objects whose keys start with the
+// entered prefix
+func (m Model) handleOSSPrefixSearchSubmitted(msg components.FormSubmittedMsg) (Model, tea.Cmd) {
+	req, ok := msg.Data.(pages.OSSScanRequestMsg)
+	if !ok {
+		return m, nil
+	}
+	return m.navigateTo(PageOSSObjectScan, pages.OSSScanNavData{
+		BucketName: req.BucketName,
+		Prefix:     msg.Values["prefix"],
+		Search:     true,
+	})
+}
+
+// handleOSSArchiveRestoreRequest prompts for how long to keep the restored
+// copy of an archived object and, for cold archive classes, the restore tier
+func (m Model) handleOSSArchiveRestoreRequest(msg pages.OSSArchiveRestoreRequestMsg) (Model, tea.Cmd) {
+	options, ok := service.ArchiveRestoreOptionsFor(msg.Object.StorageClass)
+	if !ok {
+		return m, nil
+	}
+
+	fields := []components.FormField{
+		{Key: "days", Label: fmt.Sprintf(i18n.T(i18n.KeyOSSRestoreDays), options.MaxDays), Value: "1"},
+	}
+	if len(options.Tiers) > 0 {
+		fields = append(fields, components.FormField{
+			Key:   "tier",
+			Label: fmt.Sprintf(i18n.T(i18n.KeyOSSRestoreTier), strings.Join(options.Tiers, "/")),
+			Value: options.DefaultTier,
+		})
+	}
+	m.modal = components.NewFormModal(actionOSSRestoreArchive,
+		fmt.Sprintf(i18n.T(i18n.KeyOSSRestoreArchive), msg.BucketName, msg.Object.Key, msg.Object.StorageClass),
+		fields, msg)
+	return m, nil
+}
+
+// handleOSSArchiveRestoreSubmitted validates the restore options and starts
+// the restore
+func (m Model) handleOSSArchiveRestoreSubmitted(msg components.FormSubmittedMsg) (Model, tea.Cmd) {
+	req, ok := msg.Data.(pages.OSSArchiveRestoreRequestMsg)
+	if !ok {
+		return m, nil
+	}
+	options, _ := service.ArchiveRestoreOptionsFor(req.Object.StorageClass)
+
+	days, err := strconv.Atoi(strings.TrimSpace(msg.Values["days"]))
+	if err != nil || days < 1 || days > options.MaxDays {
+		m.modal = components.NewErrorModal(fmt.Sprintf(i18n.T(i18n.KeyOSSRestoreInvalidDays), options.MaxDays))
+		return m, nil
+	}
+
+	tier := ""
+	if len(options.Tiers) > 0 {
+		// Accept the tier in any case, e.g. "bulk"
+		input := strings.TrimSpace(msg.Values["tier"])
+		for _, t := range options.Tiers {
+			if strings.EqualFold(t, input) {
+				tier = t
+			}
+		}
+		if tier == "" {
+			m.modal = components.NewErrorModal(fmt.Sprintf(i18n.T(i18n.KeyOSSRestoreInvalidTier), strings.Join(options.Tiers, ", ")))
+			return m, nil
+		}
+	}
+
+	m.loading = true
+	return m, RestoreOSSArchiveObject(m.services.OSS, req.BucketName, req.Object, days, tier)
+}
+
+// handleOSSArchiveRestoreStarted reports a started restore and refreshes the
+// folder listing so the Restore column shows it in progress
+func (m Model) handleOSSArchiveRestoreStarted(msg OSSArchiveRestoreStartedMsg) (Model, tea.Cmd) {
+	m.loading = false
+	m.modal = components.NewSuccessModal(fmt.Sprintf(i18n.T(i18n.KeyOSSRestoreStarted), msg.ObjectKey))
+	if m.currentPage == PageOSSObjects && m.ossObjectsPage.BucketName() == msg.BucketName {
+		return m, m.ossObjectsPage.Reload()
+	}
+	return m, nil
+}
+
+// handleOSSRestoreVersionRequest asks for confirmation before restoring a version
+func (m Model) handleOSSRestoreVersionRequest(msg pages.OSSRestoreVersionRequestMsg) (Model, tea.Cmd) {
+	v := msg.Version
+	m.modal = components.NewConfirmModal(actionOSSRestoreVersion,
+		fmt.Sprintf(i18n.T(i18n.KeyOSSConfirmRestore), msg.BucketName, v.Key, v.VersionID,
+			components.FormatTime(v.LastModified)),
+		msg)
+	return m, nil
+}
+
+// handleOSSVersionRestored reports a restored version and reloads the versions
+// so the restored copy shows as the current one
+func (m Model) handleOSSVersionRestored(msg OSSVersionRestoredMsg) (Model, tea.Cmd) {
+	m.modal = components.NewSuccessModal(msg.Message)
+	if m.currentPage == PageOSSObjectVersions && m.ossVersionsPage.ObjectKey() == msg.ObjectKey {
+		return m, LoadOSSObjectVersions(m.loadCtx(), m.services.OSS, msg.BucketName, msg.ObjectKey)
+	}
+	return m, nil
+}
+
+// handleOSSUndeleteRequest asks for confirmation before removing the delete
+// marker of an object
+func (m Model) handleOSSUndeleteRequest(msg pages.OSSUndeleteRequestMsg) (Model, tea.Cmd) {
+	m.modal = components.NewConfirmModal(actionOSSUndelete,
+		fmt.Sprintf(i18n.T(i18n.KeyOSSConfirmUndelete), msg.BucketName, msg.Marker.Key),
+		msg)
+	return m, nil
+}
+
+// handleOSSUndeleted reports a restored object and lists the deleted objects
+// again, which no longer include it
+func (m Model) handleOSSUndeleted(msg OSSUndeletedMsg) (Model, tea.Cmd) {
+	m.modal = components.NewSuccessModal(fmt.Sprintf(i18n.T(i18n.KeyOSSUndeleted), msg.ObjectKey))
+	if m.currentPage == PageOSSDeletedObjects && m.ossDeletedPage.BucketName() == msg.BucketName {
+		return m, LoadOSSDeletedObjects(m.loadCtx(), m.services.OSS, msg.BucketName, m.ossDeletedPage.Prefix())
+	}
+	return m, nil
+}
+
+// handleOSSDownloadDone reports the result of a finished download
+func (m Model) handleOSSDownloadDone(msg OSSDownloadDoneMsg) (Model, tea.Cmd) {
+	if msg.Err != nil {
+		m.modal = components.NewErrorModal(msg.Err.Error())
+		return m, nil
+	}
+	m.modal = components.NewSuccessModal(fmt.Sprintf(i18n.T(i18n.KeyOSSDownloaded), msg.ObjectKey, msg.LocalPath))
+	return m, nil
+}
+
+// ossCopyMaxFailures is how many failed objects a copy report lists
+const ossCopyMaxFailures = 5
+
+// ossCopyWhat describes the objects a copy request picked
+func ossCopyWhat(req pages.OSSCopyRequestMsg) string {
+	if req.Folder != "" {
+		return fmt.Sprintf(i18n.T(i18n.KeyOSSCopyWhatFolder), req.BucketName, req.Folder)
+	}
+	return fmt.Sprintf(i18n.T(i18n.KeyOSSCopyWhatKeys), len(req.Keys), req.BucketName, req.BasePrefix)
+}
+
+// handleOSSCopyRequest prompts for the bucket and folder to copy or move
+// objects to, the current ones by default
+func (m Model) handleOSSCopyRequest(msg pages.OSSCopyRequestMsg) (Model, tea.Cmd) {
+	title := fmt.Sprintf(i18n.T(i18n.KeyOSSCopy), ossCopyWhat(msg))
+	if msg.Move {
+		title = fmt.Sprintf(i18n.T(i18n.KeyOSSMove), ossCopyWhat(msg))
+	}
+	m.modal = components.NewFormModal(actionOSSCopy, title,
+		[]components.FormField{
+			{Key: "bucket", Label: i18n.T(i18n.KeyOSSCopyBucket), Value: msg.BucketName},
+			{Key: "prefix", Label: i18n.T(i18n.KeyOSSCopyPrefix), Value: msg.BasePrefix},
+		},
+		msg)
+	return m, nil
+}
+
+// handleOSSCopySubmitted starts the copy once a destination was entered
+func (m Model) handleOSSCopySubmitted(msg components.FormSubmittedMsg) (Model, tea.Cmd) {
+	req, ok := msg.Data.(pages.OSSCopyRequestMsg)
+	if !ok {
+		return m, nil
+	}
+	bucket := strings.TrimSpace(msg.Values["bucket"])
+	if bucket == "" {
+		m.modal = components.NewErrorModal(i18n.T(i18n.KeyOSSCopyBucketRequired))
+		return m, nil
+	}
+
+	// A folder is a key prefix ending in a slash
+	prefix := strings.TrimPrefix(strings.TrimSpace(msg.Values["prefix"]), "/")
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	c := service.ObjectCopy{
+		SourceBucket: req.BucketName,
+		Keys:         req.Keys,
+		Folder:       req.Folder,
+		BasePrefix:   req.BasePrefix,
+		DestBucket:   bucket,
+		DestPrefix:   prefix,
+		Move:         req.Move,
+	}
+	title := fmt.Sprintf(i18n.T(i18n.KeyJobOSSCopy), ossCopyWhat(req), bucket, prefix)
+	if req.Move {
+		title = fmt.Sprintf(i18n.T(i18n.KeyJobOSSMove), ossCopyWhat(req), bucket, prefix)
+	}
+	m, _, cmd := m.startJob(title, jobs.UnitItems, CopyOSSObjects(m.services.OSS, c))
+	return m, cmd
+}
+
+// handleOSSObjectsCopied reports a finished or stopped copy, listing the
+// first objects that failed, and refreshes the folder listing when it shows either bucket
+func (m Model) handleOSSObjectsCopied(msg OSSObjectsCopiedMsg) (Model, tea.Cmd) {
+	c, result := msg.Copy, msg.Result
+	var text string
+	if msg.Err != nil {
+		format := i18n.KeyOSSCopyStopped
+		if c.Move {
+			format = i18n.KeyOSSMoveStopped
+		}
+		text = fmt.Sprintf(i18n.T(format), result.Copied, pages.FormatSize(result.Bytes), c.DestBucket, c.DestPrefix,
+			result.Total-result.Copied)
+	} else {
+		format := i18n.KeyOSSCopied
+		if c.Move {
+			format = i18n.KeyOSSMoved
+		}
+		text = fmt.Sprintf(i18n.T(format), result.Copied, pages.FormatSize(result.Bytes), c.DestBucket, c.DestPrefix)
+	}
+	if result.CrossRegion {
+		text += "\n" + i18n.T(i18n.KeyOSSCopyCrossRegion)
+	}
+
+	if len(result.Failed) > 0 {
+		keys := make([]string, 0, len(result.Failed))
+		for k := range result.Failed {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		text += "\n\n" + fmt.Sprintf(i18n.T(i18n.KeyOSSCopyFailed), len(keys))
+		for i, k := range keys {
+			if i == ossCopyMaxFailures {
+				text += "\n  ..."
+				break
+			}
+			text += fmt.Sprintf("\n  %s: %v", k, result.Failed[k])
+		}
+	}
+	if len(result.Failed) == 0 && msg.Err == nil {
+		m.modal = components.NewSuccessModal(text)
+	} else {
+		m.modal = components.NewErrorModal(text)
+	}
+
+	if bucket := m.ossObjectsPage.BucketName(); bucket == c.SourceBucket || bucket == c.DestBucket {
+		m.ossObjectsPage = m.ossObjectsPage.ClearMarks()
+		if m.currentPage == PageOSSObjects {
+			return m, m.ossObjectsPage.Reload()
+		}
+	}
+	return m, nil
+}
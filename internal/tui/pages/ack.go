@@ -0,0 +1,477 @@
+package pages
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cs"
+
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// ACKClustersModel represents the ACK clusters list page
+type ACKClustersModel struct {
+	table    components.TableModel
+	clusters []service.ACKCluster
+	width    int
+	height   int
+	keys     ACKClustersKeyMap
+}
+
+// ACKClustersKeyMap defines key bindings
+type ACKClustersKeyMap struct {
+	Enter     key.Binding
+	NodePools key.Binding
+}
+
+// DefaultACKClustersKeyMap returns default key bindings
+func DefaultACKClustersKeyMap() ACKClustersKeyMap {
+	return ACKClustersKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "details"),
+		),
+		NodePools: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "node pools"),
+		),
+	}
+}
+
+// NewACKClustersModel creates a new ACK clusters model
+func NewACKClustersModel() ACKClustersModel {
+	columns := []table.Column{
+		{Title: "Cluster ID", Width: 34},
+		{Title: "Name", Width: 24},
+		{Title: "Type", Width: 18},
+		{Title: "Spec", Width: 14},
+		{Title: "Version", Width: 16},
+		{Title: "Nodes", Width: 6},
+		{Title: "State", Width: 10},
+		{Title: "VPC ID", Width: 26},
+	}
+
+	return ACKClustersModel{
+		table: components.NewTableModel(columns, "ACK Clusters"),
+		keys:  DefaultACKClustersKeyMap(),
+	}
+}
+
+// SetData sets the clusters data
+func (m ACKClustersModel) SetData(clusters []service.ACKCluster) ACKClustersModel {
+	m.clusters = clusters
+
+	rows := make([]table.Row, len(clusters))
+	rowData := make([]interface{}, len(clusters))
+
+	for i, cluster := range clusters {
+		rows[i] = table.Row{
+			cluster.ClusterID,
+			cluster.Name,
+			cluster.ClusterType,
+			valueOrDash(cluster.ClusterSpec),
+			cluster.CurrentVersion,
+			fmt.Sprintf("%d", cluster.Size),
+			cluster.State,
+			cluster.VpcID,
+		}
+		rowData[i] = cluster
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m ACKClustersModel) SetSize(width, height int) ACKClustersModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedCluster returns the selected cluster
+func (m ACKClustersModel) SelectedCluster() *service.ACKCluster {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.clusters) {
+		return &m.clusters[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m ACKClustersModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m ACKClustersModel) Update(msg tea.Msg) (ACKClustersModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		page := types.PageMenu
+		switch {
+		case key.Matches(msg, m.keys.Enter):
+			page = types.PageACKClusterDetail
+		case key.Matches(msg, m.keys.NodePools):
+			page = types.PageACKNodePools
+		}
+		if page != types.PageMenu {
+			if cluster := m.SelectedCluster(); cluster != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{Page: page, Data: *cluster}
+				}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m ACKClustersModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m ACKClustersModel) Search(query string) ACKClustersModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m ACKClustersModel) Filter(query string) ACKClustersModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m ACKClustersModel) NextSearchMatch() ACKClustersModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m ACKClustersModel) PrevSearchMatch() ACKClustersModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// ACKNodePoolNavData contains the data needed to navigate to the nodes of
+// a node pool
+type ACKNodePoolNavData struct {
+	ClusterID    string
+	RegionID     string
+	NodePoolID   string
+	NodePoolName string
+}
+
+// ACKNodePoolsModel lists the node pools of an ACK cluster
+type ACKNodePoolsModel struct {
+	table     components.TableModel
+	nodePools []service.ACKNodePool
+	cluster   service.ACKCluster
+	width     int
+	height    int
+	keys      ACKNodePoolsKeyMap
+}
+
+// ACKNodePoolsKeyMap defines key bindings
+type ACKNodePoolsKeyMap struct {
+	Enter key.Binding
+}
+
+// DefaultACKNodePoolsKeyMap returns default key bindings
+func DefaultACKNodePoolsKeyMap() ACKNodePoolsKeyMap {
+	return ACKNodePoolsKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "nodes"),
+		),
+	}
+}
+
+// NewACKNodePoolsModel creates a new node pools model for a cluster
+func NewACKNodePoolsModel(cluster service.ACKCluster) ACKNodePoolsModel {
+	columns := []table.Column{
+		{Title: "Node Pool ID / Name", Width: 45},
+		{Title: "Instance Types", Width: 30},
+		{Title: "Healthy / Total", Width: 16},
+		{Title: "Auto Scaling", Width: 12},
+		{Title: "Runtime", Width: 20},
+		{Title: "State", Width: 10},
+	}
+
+	return ACKNodePoolsModel{
+		table:   components.NewTableModel(columns, fmt.Sprintf("Node Pools of %s", cluster.Name)),
+		cluster: cluster,
+		keys:    DefaultACKNodePoolsKeyMap(),
+	}
+}
+
+// ClusterID returns the ID of the cluster whose node pools are listed
+func (m ACKNodePoolsModel) ClusterID() string {
+	return m.cluster.ClusterID
+}
+
+// SetData sets the node pools data
+func (m ACKNodePoolsModel) SetData(nodePools []service.ACKNodePool) ACKNodePoolsModel {
+	m.nodePools = nodePools
+
+	rows := make([]table.Row, len(nodePools))
+	rowData := make([]interface{}, len(nodePools))
+
+	for i, pool := range nodePools {
+		name := pool.Info.Name
+		if pool.Info.IsDefault {
+			name += " (default)"
+		}
+		autoScaling := "off"
+		if pool.AutoScaling.Enable {
+			autoScaling = fmt.Sprintf("%d-%d", pool.AutoScaling.MinInstances, pool.AutoScaling.MaxInstances)
+		}
+		runtime := strings.TrimSpace(pool.KubernetesConfig.Runtime + " " + pool.KubernetesConfig.RuntimeVersion)
+		rows[i] = table.Row{
+			fmt.Sprintf("%s / %s", pool.Info.NodePoolID, name),
+			valueOrDash(strings.Join(pool.ScalingGroup.InstanceTypes, ", ")),
+			fmt.Sprintf("%d / %d", pool.Status.HealthyNodes, pool.Status.TotalNodes),
+			autoScaling,
+			valueOrDash(runtime),
+			pool.Status.State,
+		}
+		rowData[i] = pool
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("Node Pools of %s (%d)", m.cluster.Name, len(nodePools)))
+	return m
+}
+
+// SetSize sets the size
+func (m ACKNodePoolsModel) SetSize(width, height int) ACKNodePoolsModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedNodePool returns the selected node pool
+func (m ACKNodePoolsModel) SelectedNodePool() *service.ACKNodePool {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.nodePools) {
+		return &m.nodePools[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m ACKNodePoolsModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m ACKNodePoolsModel) Update(msg tea.Msg) (ACKNodePoolsModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		if pool := m.SelectedNodePool(); pool != nil {
+			navData := ACKNodePoolNavData{
+				ClusterID:    m.cluster.ClusterID,
+				RegionID:     m.cluster.RegionID,
+				NodePoolID:   pool.Info.NodePoolID,
+				NodePoolName: pool.Info.Name,
+			}
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageACKNodes, Data: navData}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m ACKNodePoolsModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m ACKNodePoolsModel) Search(query string) ACKNodePoolsModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m ACKNodePoolsModel) Filter(query string) ACKNodePoolsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m ACKNodePoolsModel) NextSearchMatch() ACKNodePoolsModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m ACKNodePoolsModel) PrevSearchMatch() ACKNodePoolsModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// ACKNodesModel lists the nodes of an ACK node pool
+type ACKNodesModel struct {
+	table    components.TableModel
+	nodes    []cs.Node
+	nodePool ACKNodePoolNavData
+	width    int
+	height   int
+	keys     ACKNodesKeyMap
+}
+
+// ACKNodesKeyMap defines key bindings
+type ACKNodesKeyMap struct {
+	Enter key.Binding
+}
+
+// DefaultACKNodesKeyMap returns default key bindings
+func DefaultACKNodesKeyMap() ACKNodesKeyMap {
+	return ACKNodesKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "ECS instance"),
+		),
+	}
+}
+
+// NewACKNodesModel creates a new nodes model for a node pool
+func NewACKNodesModel(nodePool ACKNodePoolNavData) ACKNodesModel {
+	columns := []table.Column{
+		{Title: "Node Name", Width: 34},
+		{Title: "Instance ID", Width: 24},
+		{Title: "Instance Type", Width: 18},
+		{Title: "IP", Width: 16},
+		{Title: "Node Status", Width: 12},
+		{Title: "Instance Status", Width: 15},
+		{Title: "Created", Width: 22},
+	}
+
+	return ACKNodesModel{
+		table:    components.NewTableModel(columns, fmt.Sprintf("Nodes of %s", nodePool.NodePoolName)),
+		nodePool: nodePool,
+		keys:     DefaultACKNodesKeyMap(),
+	}
+}
+
+// NodePool returns the node pool whose nodes are listed
+func (m ACKNodesModel) NodePool() ACKNodePoolNavData {
+	return m.nodePool
+}
+
+// SetData sets the nodes data
+func (m ACKNodesModel) SetData(nodes []cs.Node) ACKNodesModel {
+	m.nodes = nodes
+
+	rows := make([]table.Row, len(nodes))
+	rowData := make([]interface{}, len(nodes))
+
+	for i, node := range nodes {
+		rows[i] = table.Row{
+			node.NodeName,
+			valueOrDash(node.InstanceId),
+			valueOrDash(node.InstanceType),
+			valueOrDash(strings.Join(node.IpAddress, ", ")),
+			node.NodeStatus,
+			valueOrDash(node.InstanceStatus),
+			components.FormatTimeString(node.CreationTime),
+		}
+		rowData[i] = node
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("Nodes of %s (%d)", m.nodePool.NodePoolName, len(nodes)))
+	return m
+}
+
+// SetSize sets the size
+func (m ACKNodesModel) SetSize(width, height int) ACKNodesModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedNode returns the selected node
+func (m ACKNodesModel) SelectedNode() *cs.Node {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.nodes) {
+		return &m.nodes[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m ACKNodesModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m ACKNodesModel) Update(msg tea.Msg) (ACKNodesModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		// Only nodes backed by an ECS instance of the account can be opened
+		if node := m.SelectedNode(); node != nil && node.IsAliyunNode && node.InstanceId != "" {
+			instanceID, regionID := node.InstanceId, m.nodePool.RegionID
+			return m, func() tea.Msg {
+				return ACKNodeInstanceRequestMsg{InstanceID: instanceID, RegionID: regionID}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m ACKNodesModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m ACKNodesModel) Search(query string) ACKNodesModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m ACKNodesModel) Filter(query string) ACKNodesModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m ACKNodesModel) NextSearchMatch() ACKNodesModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m ACKNodesModel) PrevSearchMatch() ACKNodesModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// ACKNodeInstanceRequestMsg asks the app to open the ECS instance of a node
+type ACKNodeInstanceRequestMsg struct {
+	InstanceID string
+	RegionID   string
+}
@@ -0,0 +1,509 @@
+package pages
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr_ee"
+
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// acrTime formats an ACR timestamp, "-" when it is not set
+func acrTime(t time.Time) string {
+	return components.FormatTime(t)
+}
+
+// ACRKeyMap defines the key bindings shared by the ACR pages
+type ACRKeyMap struct {
+	Enter key.Binding
+}
+
+// DefaultACRKeyMap returns default key bindings, with help for what enter opens
+func DefaultACRKeyMap(enterHelp string) ACRKeyMap {
+	return ACRKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", enterHelp),
+		),
+	}
+}
+
+// ACRInstancesModel lists the ACR Enterprise Edition instances
+type ACRInstancesModel struct {
+	table     components.TableModel
+	instances []cr_ee.InstancesItem
+	width     int
+	height    int
+	keys      ACRKeyMap
+}
+
+// NewACRInstancesModel creates a new ACR instances model
+func NewACRInstancesModel() ACRInstancesModel {
+	columns := []table.Column{
+		{Title: "Instance ID", Width: 24},
+		{Title: "Name", Width: 28},
+		{Title: "Specification", Width: 14},
+		{Title: "Status", Width: 12},
+		{Title: "Region", Width: 14},
+		{Title: "Created", Width: 22},
+	}
+
+	return ACRInstancesModel{
+		table: components.NewTableModel(columns, "ACR Instances"),
+		keys:  DefaultACRKeyMap("namespaces"),
+	}
+}
+
+// SetData sets the instances data
+func (m ACRInstancesModel) SetData(instances []cr_ee.InstancesItem) ACRInstancesModel {
+	m.instances = instances
+
+	rows := make([]table.Row, len(instances))
+	rowData := make([]interface{}, len(instances))
+
+	for i, inst := range instances {
+		rows[i] = table.Row{
+			inst.InstanceId,
+			inst.InstanceName,
+			inst.InstanceSpecification,
+			inst.InstanceStatus,
+			inst.RegionId,
+			components.FormatTimeString(inst.CreateTime),
+		}
+		rowData[i] = inst
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m ACRInstancesModel) SetSize(width, height int) ACRInstancesModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedInstance returns the selected instance
+func (m ACRInstancesModel) SelectedInstance() *cr_ee.InstancesItem {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.instances) {
+		return &m.instances[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m ACRInstancesModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m ACRInstancesModel) Update(msg tea.Msg) (ACRInstancesModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		if inst := m.SelectedInstance(); inst != nil {
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageACRNamespaces, Data: *inst}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m ACRInstancesModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m ACRInstancesModel) Search(query string) ACRInstancesModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m ACRInstancesModel) Filter(query string) ACRInstancesModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m ACRInstancesModel) NextSearchMatch() ACRInstancesModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m ACRInstancesModel) PrevSearchMatch() ACRInstancesModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// ACRNamespaceNavData contains the data needed to navigate to the
+// repositories of a namespace
+type ACRNamespaceNavData struct {
+	InstanceID   string
+	InstanceName string
+	Namespace    string
+}
+
+// ACRNamespacesModel lists the namespaces of an ACR instance
+type ACRNamespacesModel struct {
+	table      components.TableModel
+	namespaces []cr_ee.NamespacesItem
+	instance   cr_ee.InstancesItem
+	width      int
+	height     int
+	keys       ACRKeyMap
+}
+
+// NewACRNamespacesModel creates a new namespaces model
+func NewACRNamespacesModel(instance cr_ee.InstancesItem) ACRNamespacesModel {
+	columns := []table.Column{
+		{Title: "Namespace", Width: 30},
+		{Title: "Namespace ID", Width: 24},
+		{Title: "Status", Width: 12},
+		{Title: "Default Repo Type", Width: 18},
+		{Title: "Auto Create Repo", Width: 16},
+	}
+
+	return ACRNamespacesModel{
+		table:    components.NewTableModel(columns, fmt.Sprintf("Namespaces of ACR Instance: %s", instance.InstanceName)),
+		instance: instance,
+		keys:     DefaultACRKeyMap("repositories"),
+	}
+}
+
+// InstanceID returns the instance whose namespaces are listed
+func (m ACRNamespacesModel) InstanceID() string {
+	return m.instance.InstanceId
+}
+
+// SetData sets the namespaces data
+func (m ACRNamespacesModel) SetData(namespaces []cr_ee.NamespacesItem) ACRNamespacesModel {
+	m.namespaces = namespaces
+
+	rows := make([]table.Row, len(namespaces))
+	rowData := make([]interface{}, len(namespaces))
+
+	for i, ns := range namespaces {
+		rows[i] = table.Row{
+			ns.NamespaceName,
+			ns.NamespaceId,
+			ns.NamespaceStatus,
+			ns.DefaultRepoType,
+			fmt.Sprintf("%t", ns.AutoCreateRepo),
+		}
+		rowData[i] = ns
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m ACRNamespacesModel) SetSize(width, height int) ACRNamespacesModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m ACRNamespacesModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m ACRNamespacesModel) Update(msg tea.Msg) (ACRNamespacesModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		idx := m.table.SelectedRow()
+		if idx >= 0 && idx < len(m.namespaces) {
+			nav := ACRNamespaceNavData{
+				InstanceID:   m.instance.InstanceId,
+				InstanceName: m.instance.InstanceName,
+				Namespace:    m.namespaces[idx].NamespaceName,
+			}
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageACRRepositories, Data: nav}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m ACRNamespacesModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m ACRNamespacesModel) Search(query string) ACRNamespacesModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m ACRNamespacesModel) Filter(query string) ACRNamespacesModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m ACRNamespacesModel) NextSearchMatch() ACRNamespacesModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m ACRNamespacesModel) PrevSearchMatch() ACRNamespacesModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// ACRRepositoriesModel lists the repositories of an ACR namespace
+type ACRRepositoriesModel struct {
+	table        components.TableModel
+	repositories []service.ACRRepository
+	namespace    ACRNamespaceNavData
+	width        int
+	height       int
+	keys         ACRKeyMap
+}
+
+// NewACRRepositoriesModel creates a new repositories model
+func NewACRRepositoriesModel(namespace ACRNamespaceNavData) ACRRepositoriesModel {
+	columns := []table.Column{
+		{Title: "Repository", Width: 30},
+		{Title: "Repo ID", Width: 24},
+		{Title: "Type", Width: 8},
+		{Title: "Status", Width: 10},
+		{Title: "Modified", Width: 22},
+		{Title: "Summary", Width: 36},
+	}
+
+	return ACRRepositoriesModel{
+		table:     components.NewTableModel(columns, fmt.Sprintf("Repositories in %s / %s", namespace.InstanceName, namespace.Namespace)),
+		namespace: namespace,
+		keys:      DefaultACRKeyMap("tags"),
+	}
+}
+
+// Namespace returns the namespace whose repositories are listed
+func (m ACRRepositoriesModel) Namespace() ACRNamespaceNavData {
+	return m.namespace
+}
+
+// SetData sets the repositories data
+func (m ACRRepositoriesModel) SetData(repositories []service.ACRRepository) ACRRepositoriesModel {
+	m.repositories = repositories
+
+	rows := make([]table.Row, len(repositories))
+	rowData := make([]interface{}, len(repositories))
+
+	for i, repo := range repositories {
+		rows[i] = table.Row{
+			repo.Name,
+			repo.RepoID,
+			repo.RepoType,
+			repo.Status,
+			acrTime(repo.ModifiedTime),
+			valueOrDash(repo.Summary),
+		}
+		rowData[i] = repo
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m ACRRepositoriesModel) SetSize(width, height int) ACRRepositoriesModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m ACRRepositoriesModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m ACRRepositoriesModel) Update(msg tea.Msg) (ACRRepositoriesModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		idx := m.table.SelectedRow()
+		if idx >= 0 && idx < len(m.repositories) {
+			repo := m.repositories[idx]
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageACRTags, Data: repo}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m ACRRepositoriesModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m ACRRepositoriesModel) Search(query string) ACRRepositoriesModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m ACRRepositoriesModel) Filter(query string) ACRRepositoriesModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m ACRRepositoriesModel) NextSearchMatch() ACRRepositoriesModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m ACRRepositoriesModel) PrevSearchMatch() ACRRepositoriesModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// ACRTagsModel lists the image tags of a repository, most recently pushed
+// first
+type ACRTagsModel struct {
+	table  components.TableModel
+	tags   []service.ACRTag
+	repo   service.ACRRepository
+	width  int
+	height int
+	keys   ACRKeyMap
+}
+
+// NewACRTagsModel creates a new image tags model
+func NewACRTagsModel(repo service.ACRRepository) ACRTagsModel {
+	columns := []components.ColumnSpec{
+		{Title: "Tag", Width: 28},
+		{Title: "Digest", Width: 73},
+		{Title: "Size", Width: 12, Format: components.FormatSize},
+		{Title: "Pushed", Width: 22},
+		{Title: "Status", Width: 10},
+	}
+
+	return ACRTagsModel{
+		table: components.NewTableModelFromSpecs(columns, fmt.Sprintf("Tags of %s/%s", repo.Namespace, repo.Name)),
+		repo:  repo,
+		keys:  DefaultACRKeyMap("details"),
+	}
+}
+
+// Repository returns the repository whose tags are listed
+func (m ACRTagsModel) Repository() service.ACRRepository {
+	return m.repo
+}
+
+// SetData sets the tags data
+func (m ACRTagsModel) SetData(tags []service.ACRTag) ACRTagsModel {
+	m.tags = tags
+
+	values := make([][]interface{}, len(tags))
+	rowData := make([]interface{}, len(tags))
+
+	for i, tag := range tags {
+		values[i] = []interface{}{
+			tag.Tag,
+			tag.Digest,
+			tag.Size,
+			acrTime(tag.Updated),
+			tag.Status,
+		}
+		rowData[i] = tag
+	}
+
+	m.table = m.table.SetValues(values)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m ACRTagsModel) SetSize(width, height int) ACRTagsModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m ACRTagsModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m ACRTagsModel) Update(msg tea.Msg) (ACRTagsModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		idx := m.table.SelectedRow()
+		if idx >= 0 && idx < len(m.tags) {
+			tag := m.tags[idx]
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageACRTagDetail, Data: tag}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m ACRTagsModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m ACRTagsModel) Search(query string) ACRTagsModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m ACRTagsModel) Filter(query string) ACRTagsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m ACRTagsModel) NextSearchMatch() ACRTagsModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m ACRTagsModel) PrevSearchMatch() ACRTagsModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
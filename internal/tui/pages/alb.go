@@ -0,0 +1,655 @@
+package pages
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alb"
+
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// ALBListModel represents the Application Load Balancer list page
+type ALBListModel struct {
+	table         components.TableModel
+	loadBalancers []alb.LoadBalancer
+	width         int
+	height        int
+	keys          ALBListKeyMap
+}
+
+// ALBListKeyMap defines key bindings
+type ALBListKeyMap struct {
+	Enter        key.Binding
+	Listeners    key.Binding
+	ServerGroups key.Binding
+}
+
+// DefaultALBListKeyMap returns default key bindings
+func DefaultALBListKeyMap() ALBListKeyMap {
+	return ALBListKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "details"),
+		),
+		Listeners: key.NewBinding(
+			key.WithKeys("l"),
+			key.WithHelp("l", "listeners"),
+		),
+		ServerGroups: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "server groups"),
+		),
+	}
+}
+
+// NewALBListModel creates a new ALB list model
+func NewALBListModel() ALBListModel {
+	columns := []table.Column{
+		{Title: "Load Balancer ID", Width: 26},
+		{Title: "Name", Width: 24},
+		{Title: "DNS Name", Width: 48},
+		{Title: "Address Type", Width: 12},
+		{Title: "Edition", Width: 10},
+		{Title: "Status", Width: 12},
+		{Title: "VPC ID", Width: 26},
+	}
+
+	return ALBListModel{
+		table: components.NewTableModel(columns, "Application Load Balancers"),
+		keys:  DefaultALBListKeyMap(),
+	}
+}
+
+// SetData sets the ALB instances data
+func (m ALBListModel) SetData(loadBalancers []alb.LoadBalancer) ALBListModel {
+	m.loadBalancers = loadBalancers
+
+	rows := make([]table.Row, len(loadBalancers))
+	rowData := make([]interface{}, len(loadBalancers))
+
+	for i, lb := range loadBalancers {
+		rows[i] = table.Row{
+			lb.LoadBalancerId,
+			valueOrDash(lb.LoadBalancerName),
+			lb.DNSName,
+			lb.AddressType,
+			lb.LoadBalancerEdition,
+			lb.LoadBalancerStatus,
+			lb.VpcId,
+		}
+		rowData[i] = lb
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m ALBListModel) SetSize(width, height int) ALBListModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedLoadBalancer returns the selected ALB instance
+func (m ALBListModel) SelectedLoadBalancer() *alb.LoadBalancer {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.loadBalancers) {
+		return &m.loadBalancers[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m ALBListModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m ALBListModel) Update(msg tea.Msg) (ALBListModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		page := types.PageMenu
+		switch {
+		case key.Matches(msg, m.keys.Enter):
+			page = types.PageALBDetail
+		case key.Matches(msg, m.keys.Listeners):
+			page = types.PageALBListeners
+		case key.Matches(msg, m.keys.ServerGroups):
+			page = types.PageALBServerGroups
+		}
+		if page != types.PageMenu {
+			if lb := m.SelectedLoadBalancer(); lb != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{Page: page, Data: *lb}
+				}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m ALBListModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m ALBListModel) Search(query string) ALBListModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m ALBListModel) Filter(query string) ALBListModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m ALBListModel) NextSearchMatch() ALBListModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m ALBListModel) PrevSearchMatch() ALBListModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// ALBListenersModel lists the listeners of an ALB instance
+type ALBListenersModel struct {
+	table          components.TableModel
+	listeners      []alb.Listener
+	loadBalancerID string
+	width          int
+	height         int
+	keys           ALBListenersKeyMap
+}
+
+// ALBListenersKeyMap defines key bindings
+type ALBListenersKeyMap struct {
+	Enter key.Binding
+}
+
+// DefaultALBListenersKeyMap returns default key bindings
+func DefaultALBListenersKeyMap() ALBListenersKeyMap {
+	return ALBListenersKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "forwarding rules"),
+		),
+	}
+}
+
+// NewALBListenersModel creates a new ALB listeners model
+func NewALBListenersModel() ALBListenersModel {
+	columns := []table.Column{
+		{Title: "Listener ID", Width: 26},
+		{Title: "Protocol", Width: 10},
+		{Title: "Port", Width: 8},
+		{Title: "Status", Width: 10},
+		{Title: "Default Server Groups", Width: 40},
+		{Title: "Description", Width: 30},
+	}
+
+	return ALBListenersModel{
+		table: components.NewTableModel(columns, "ALB Listeners"),
+		keys:  DefaultALBListenersKeyMap(),
+	}
+}
+
+// SetData sets the listeners of an ALB instance
+func (m ALBListenersModel) SetData(listeners []alb.Listener, loadBalancerID string) ALBListenersModel {
+	m.listeners = listeners
+	m.loadBalancerID = loadBalancerID
+
+	rows := make([]table.Row, len(listeners))
+	rowData := make([]interface{}, len(listeners))
+
+	for i, listener := range listeners {
+		var groups []string
+		for _, action := range listener.DefaultActions {
+			for _, tuple := range action.ForwardGroupConfig.ServerGroupTuples {
+				groups = append(groups, tuple.ServerGroupId)
+			}
+		}
+		rows[i] = table.Row{
+			listener.ListenerId,
+			listener.ListenerProtocol,
+			fmt.Sprintf("%d", listener.ListenerPort),
+			listener.ListenerStatus,
+			valueOrDash(strings.Join(groups, ", ")),
+			valueOrDash(listener.ListenerDescription),
+		}
+		rowData[i] = listener
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("Listeners for ALB: %s", loadBalancerID))
+	return m
+}
+
+// SetSize sets the size
+func (m ALBListenersModel) SetSize(width, height int) ALBListenersModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedListener returns the selected listener
+func (m ALBListenersModel) SelectedListener() *alb.Listener {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.listeners) {
+		return &m.listeners[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m ALBListenersModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m ALBListenersModel) Update(msg tea.Msg) (ALBListenersModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		if listener := m.SelectedListener(); listener != nil {
+			selected := *listener
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageALBRules, Data: selected}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m ALBListenersModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m ALBListenersModel) Search(query string) ALBListenersModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m ALBListenersModel) Filter(query string) ALBListenersModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m ALBListenersModel) NextSearchMatch() ALBListenersModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m ALBListenersModel) PrevSearchMatch() ALBListenersModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// ALBRulesModel lists the forwarding rules of an ALB listener in the order
+// they are evaluated
+type ALBRulesModel struct {
+	table      components.TableModel
+	rules      []alb.Rule
+	listenerID string
+	width      int
+	height     int
+	keys       ALBRulesKeyMap
+}
+
+// ALBRulesKeyMap defines key bindings
+type ALBRulesKeyMap struct {
+	Enter key.Binding
+}
+
+// DefaultALBRulesKeyMap returns default key bindings
+func DefaultALBRulesKeyMap() ALBRulesKeyMap {
+	return ALBRulesKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "details"),
+		),
+	}
+}
+
+// NewALBRulesModel creates a new ALB rules model
+func NewALBRulesModel() ALBRulesModel {
+	columns := []table.Column{
+		{Title: "Priority", Width: 8},
+		{Title: "Name", Width: 24},
+		{Title: "Conditions", Width: 50},
+		{Title: "Actions", Width: 50},
+		{Title: "Status", Width: 10},
+	}
+
+	return ALBRulesModel{
+		table: components.NewTableModel(columns, "ALB Forwarding Rules"),
+		keys:  DefaultALBRulesKeyMap(),
+	}
+}
+
+// SetData sets the rules of a listener
+func (m ALBRulesModel) SetData(rules []alb.Rule, listenerID string) ALBRulesModel {
+	m.rules = rules
+	m.listenerID = listenerID
+
+	rows := make([]table.Row, len(rules))
+	rowData := make([]interface{}, len(rules))
+
+	for i, rule := range rules {
+		conditions := make([]string, len(rule.RuleConditions))
+		for j, c := range rule.RuleConditions {
+			conditions[j] = ALBConditionSummary(c)
+		}
+		actions := make([]string, len(rule.RuleActions))
+		for j, a := range rule.RuleActions {
+			actions[j] = ALBActionSummary(a)
+		}
+		rows[i] = table.Row{
+			fmt.Sprintf("%d", rule.Priority),
+			valueOrDash(rule.RuleName),
+			valueOrDash(strings.Join(conditions, "; ")),
+			valueOrDash(strings.Join(actions, "; ")),
+			rule.RuleStatus,
+		}
+		rowData[i] = rule
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("Forwarding Rules for Listener: %s", listenerID))
+	return m
+}
+
+// ALBConditionSummary renders a rule condition as a short expression such
+// as "Host=a.example.com,b.example.com"
+func ALBConditionSummary(c alb.Condition) string {
+	var values []string
+	switch c.Type {
+	case "Host":
+		values = c.HostConfig.Values
+	case "Path":
+		values = c.PathConfig.Values
+	case "Method":
+		values = c.MethodConfig.Values
+	case "SourceIp":
+		values = c.SourceIpConfig.Values
+	case "Header":
+		return fmt.Sprintf("Header[%s]=%s", c.HeaderConfig.Key, strings.Join(c.HeaderConfig.Values, ","))
+	case "QueryString":
+		for _, v := range c.QueryStringConfig.Values {
+			values = append(values, v.Key+":"+v.Value)
+		}
+	case "Cookie":
+		for _, v := range c.CookieConfig.Values {
+			values = append(values, v.Key+":"+v.Value)
+		}
+	}
+	return c.Type + "=" + strings.Join(values, ",")
+}
+
+// ALBActionSummary renders a rule action as a short expression such as
+// "Forward→sgp-xxx(100)" or "Redirect 301→https://example.com"
+func ALBActionSummary(a alb.Action) string {
+	switch a.Type {
+	case "ForwardGroup":
+		groups := make([]string, len(a.ForwardGroupConfig.ServerGroupTuples))
+		for i, tuple := range a.ForwardGroupConfig.ServerGroupTuples {
+			groups[i] = fmt.Sprintf("%s(%d)", tuple.ServerGroupId, tuple.Weight)
+		}
+		return "Forward→" + strings.Join(groups, ",")
+	case "Redirect":
+		r := a.RedirectConfig
+		return fmt.Sprintf("Redirect %s→%s://%s:%s%s", r.HttpCode, r.Protocol, r.Host, r.Port, r.Path)
+	case "FixedResponse":
+		return fmt.Sprintf("FixedResponse %s", a.FixedResponseConfig.HttpCode)
+	case "Rewrite":
+		r := a.RewriteConfig
+		return fmt.Sprintf("Rewrite %s%s", r.Host, r.Path)
+	}
+	return a.Type
+}
+
+// SetSize sets the size
+func (m ALBRulesModel) SetSize(width, height int) ALBRulesModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedRule returns the selected rule
+func (m ALBRulesModel) SelectedRule() *alb.Rule {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.rules) {
+		return &m.rules[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m ALBRulesModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m ALBRulesModel) Update(msg tea.Msg) (ALBRulesModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		if rule := m.SelectedRule(); rule != nil {
+			selected := *rule
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageALBRuleDetail, Data: selected}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m ALBRulesModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m ALBRulesModel) Search(query string) ALBRulesModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m ALBRulesModel) Filter(query string) ALBRulesModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m ALBRulesModel) NextSearchMatch() ALBRulesModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m ALBRulesModel) PrevSearchMatch() ALBRulesModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// ALBServerGroupsModel lists the server groups used by an ALB instance
+type ALBServerGroupsModel struct {
+	table          components.TableModel
+	groups         []alb.ServerGroup
+	loadBalancerID string
+	width          int
+	height         int
+	keys           LBServerGroupsKeyMap
+}
+
+// LBServerGroupsKeyMap defines key bindings shared by the ALB and NLB
+// server group pages
+type LBServerGroupsKeyMap struct {
+	Enter key.Binding
+}
+
+// DefaultLBServerGroupsKeyMap returns default key bindings
+func DefaultLBServerGroupsKeyMap() LBServerGroupsKeyMap {
+	return LBServerGroupsKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "backend servers"),
+		),
+	}
+}
+
+// NewALBServerGroupsModel creates a new ALB server groups model
+func NewALBServerGroupsModel() ALBServerGroupsModel {
+	columns := []table.Column{
+		{Title: "Server Group ID / Name", Width: 45},
+		{Title: "Type", Width: 10},
+		{Title: "Protocol", Width: 8},
+		{Title: "Scheduler", Width: 10},
+		{Title: "Health Check", Width: 30},
+		{Title: "Servers", Width: 10},
+		{Title: "Status", Width: 12},
+	}
+
+	return ALBServerGroupsModel{
+		table: components.NewTableModel(columns, "ALB Server Groups").SetBarColumns(5),
+		keys:  DefaultLBServerGroupsKeyMap(),
+	}
+}
+
+// SetData sets the server groups of an ALB instance
+func (m ALBServerGroupsModel) SetData(groups []alb.ServerGroup, loadBalancerID string) ALBServerGroupsModel {
+	m.groups = groups
+	m.loadBalancerID = loadBalancerID
+
+	rows := make([]table.Row, len(groups))
+	rowData := make([]interface{}, len(groups))
+
+	for i, group := range groups {
+		healthCheck := "off"
+		if hc := group.HealthCheckConfig; hc.HealthCheckEnabled {
+			healthCheck = strings.TrimSpace(fmt.Sprintf("%s %s", hc.HealthCheckProtocol, hc.HealthCheckPath))
+		}
+		rows[i] = table.Row{
+			lbServerGroupIDName(group.ServerGroupId, group.ServerGroupName),
+			group.ServerGroupType,
+			group.Protocol,
+			group.Scheduler,
+			healthCheck,
+			fmt.Sprintf("%d", group.ServerCount),
+			group.ServerGroupStatus,
+		}
+		rowData[i] = group
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("Server Groups for ALB: %s", loadBalancerID))
+	return m
+}
+
+// lbServerGroupIDName combines a server group ID and its name for display
+func lbServerGroupIDName(id, name string) string {
+	if name == "" {
+		return id
+	}
+	return fmt.Sprintf("%s / %s", id, name)
+}
+
+// SetSize sets the size
+func (m ALBServerGroupsModel) SetSize(width, height int) ALBServerGroupsModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedServerGroup returns the selected server group
+func (m ALBServerGroupsModel) SelectedServerGroup() *alb.ServerGroup {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.groups) {
+		return &m.groups[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m ALBServerGroupsModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m ALBServerGroupsModel) Update(msg tea.Msg) (ALBServerGroupsModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		if group := m.SelectedServerGroup(); group != nil {
+			navData := LBServerGroupNavData{
+				Kind:            LBKindALB,
+				ServerGroupID:   group.ServerGroupId,
+				ServerGroupName: group.ServerGroupName,
+			}
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageLBServers, Data: navData}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m ALBServerGroupsModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m ALBServerGroupsModel) Search(query string) ALBServerGroupsModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m ALBServerGroupsModel) Filter(query string) ALBServerGroupsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m ALBServerGroupsModel) NextSearchMatch() ALBServerGroupsModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m ALBServerGroupsModel) PrevSearchMatch() ALBServerGroupsModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
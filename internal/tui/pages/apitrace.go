@@ -0,0 +1,136 @@
+package pages
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/logging"
+	"aliyun-tui-viewer/internal/tui/components"
+)
+
+// APITraceModel represents the API trace page: the last API calls with their
+// latency and errors, newest first
+type APITraceModel struct {
+	table  components.TableModel
+	calls  []logging.Call
+	width  int
+	height int
+}
+
+// NewAPITraceModel creates a new API trace model
+func NewAPITraceModel() APITraceModel {
+	columns := []components.ColumnSpec{
+		{Title: i18n.T(i18n.KeyColTraceTime), Width: 10},
+		{Title: i18n.T(i18n.KeyColTraceAction), Width: 32},
+		{Title: i18n.T(i18n.KeyColTraceHost), Width: 30},
+		{Title: i18n.T(i18n.KeyColTraceLatency), Width: 10},
+		{Title: i18n.T(i18n.KeyColTraceAttempts), Width: 6},
+		{Title: i18n.T(i18n.KeyColStatus), Width: 7},
+		{Title: i18n.T(i18n.KeyColTraceRequestID), Width: 38},
+		{Title: i18n.T(i18n.KeyColTraceError), Width: 50},
+	}
+
+	return APITraceModel{
+		table: components.NewTableModelFromSpecs(columns, i18n.T(i18n.KeyPageAPITrace)),
+	}
+}
+
+// SetData sets the calls, keeping the selection on the same call
+func (m APITraceModel) SetData(calls []logging.Call) APITraceModel {
+	selected := 0
+	if call := m.SelectedCall(); call != nil {
+		selected = call.Seq
+	}
+	m.calls = calls
+
+	values := make([][]interface{}, len(calls))
+	rowData := make([]interface{}, len(calls))
+	cursor := 0
+	for i, call := range calls {
+		status := "-"
+		if call.Status != 0 {
+			status = fmt.Sprintf("%d", call.Status)
+		}
+		values[i] = []interface{}{
+			call.Time.Local().Format("15:04:05"),
+			call.Action,
+			call.Host,
+			// Milliseconds sort numerically
+			fmt.Sprintf("%d ms", call.Latency.Milliseconds()),
+			call.Attempts,
+			status,
+			call.RequestID,
+			call.Err,
+		}
+		rowData[i] = call
+		if call.Seq == selected {
+			cursor = i
+		}
+	}
+
+	m.table = m.table.SetValues(values)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetCursor(cursor)
+	m.table = m.table.SetTitle(fmt.Sprintf(i18n.T(i18n.KeyAPITraceTitle), len(calls), logging.TraceSize))
+	return m
+}
+
+// SetSize sets the size
+func (m APITraceModel) SetSize(width, height int) APITraceModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedCall returns the selected call
+func (m APITraceModel) SelectedCall() *logging.Call {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.calls) {
+		return &m.calls[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m APITraceModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m APITraceModel) Update(msg tea.Msg) (APITraceModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m APITraceModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m APITraceModel) Search(query string) APITraceModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m APITraceModel) Filter(query string) APITraceModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m APITraceModel) NextSearchMatch() APITraceModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m APITraceModel) PrevSearchMatch() APITraceModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
@@ -0,0 +1,198 @@
+package pages
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/theme"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// BillingModel shows the balance and the current month's spend per product
+type BillingModel struct {
+	table    components.TableModel
+	overview *service.BillingOverview
+	width    int
+	height   int
+	keys     BillingKeyMap
+}
+
+// BillingKeyMap defines key bindings
+type BillingKeyMap struct {
+	Detail key.Binding
+}
+
+// DefaultBillingKeyMap returns default key bindings
+func DefaultBillingKeyMap() BillingKeyMap {
+	return BillingKeyMap{
+		Detail: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "details"),
+		),
+	}
+}
+
+// NewBillingModel creates a new billing model
+func NewBillingModel() BillingModel {
+	columns := []components.ColumnSpec{
+		{Title: "Product", Width: 36},
+		{Title: "Product Code", Width: 20},
+		{Title: "Before Discount", Width: 16, Format: components.FormatCurrency},
+		{Title: "Amount", Width: 32, Format: components.FormatCurrency},
+		{Title: "Share", Width: 8, Format: components.FormatPercent},
+	}
+
+	return BillingModel{
+		table: components.NewTableModelFromSpecs(columns, i18n.T(i18n.KeyPageBilling)).SetBarColumns(3),
+		keys:  DefaultBillingKeyMap(),
+	}
+}
+
+// SetData sets the billing overview
+func (m BillingModel) SetData(overview *service.BillingOverview) BillingModel {
+	m.overview = overview
+
+	values := make([][]interface{}, len(overview.Products))
+	rowData := make([]interface{}, len(overview.Products))
+
+	for i, p := range overview.Products {
+		var share interface{} // Shown as "-" without spend this month
+		if overview.MonthToDate > 0 {
+			share = p.Amount / overview.MonthToDate * 100
+		}
+		values[i] = []interface{}{
+			valueOrDash(p.ProductName),
+			p.ProductCode,
+			p.GrossAmount,
+			p.Amount,
+			share,
+		}
+		rowData[i] = p
+	}
+
+	m.table = m.table.SetValues(values)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m BillingModel) SetSize(width, height int) BillingModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, max(height-2, 1)) // Account for the summary line and its margin
+	return m
+}
+
+// Init implements tea.Model
+func (m BillingModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m BillingModel) Update(msg tea.Msg) (BillingModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Detail) && m.overview != nil {
+		overview := *m.overview
+		return m, func() tea.Msg {
+			return types.NavigateMsg{Page: types.PageBillingDetail, Data: overview}
+		}
+	}
+
+	// The table is below the summary and a blank line
+	if mouse, ok := msg.(tea.MouseMsg); ok {
+		mouse.Y -= lipgloss.Height(m.summary()) + 1
+		msg = mouse
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m BillingModel) View() string {
+	return m.summary() + "\n\n" + m.table.View()
+}
+
+// summary renders the balance and spend line shown above the table
+func (m BillingModel) summary() string {
+	th := theme.Current()
+	labelStyle := lipgloss.NewStyle().Bold(true).Foreground(th.Primary)
+	valueStyle := lipgloss.NewStyle().Foreground(th.Text)
+	if m.overview == nil {
+		return "  " + lipgloss.NewStyle().Foreground(th.SubtleText).Render(i18n.T(i18n.KeyBillingLoading))
+	}
+
+	o := m.overview
+	currency := ""
+	if o.Currency != "" {
+		currency = " " + o.Currency
+	}
+	parts := []string{
+		labelStyle.Render(i18n.T(i18n.KeyBillingCycle)+": ") + valueStyle.Render(o.BillingCycle),
+		labelStyle.Render(i18n.T(i18n.KeyBillingMonthToDate)+": ") + valueStyle.Render(FormatAmount(o.MonthToDate)+currency),
+		labelStyle.Render(i18n.T(i18n.KeyBillingYesterday)+": ") + valueStyle.Render(FormatAmount(o.Yesterday)+currency),
+		labelStyle.Render(i18n.T(i18n.KeyBillingBalance)+": ") + valueStyle.Render(valueOrDash(o.AvailableAmount)+currency),
+	}
+	return "  " + strings.Join(parts, "   ")
+}
+
+// Search searches in the list
+func (m BillingModel) Search(query string) BillingModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m BillingModel) Filter(query string) BillingModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m BillingModel) NextSearchMatch() BillingModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m BillingModel) PrevSearchMatch() BillingModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// FormatAmount formats a billed amount with two decimals and thousands
+// separators, e.g. 1234.5 as "1,234.50"
+func FormatAmount(amount float64) string {
+	return components.FormatAmount(amount)
+}
+
+// formatInstanceCost renders the month-to-date cost of an instance for
+// detail pages, e.g. "12.34 CNY (2026-10, PayAsYouGo)"
+func formatInstanceCost(cost *service.InstanceCost, err error) string {
+	switch {
+	case err != nil:
+		return fmt.Sprintf("%s: %v", i18n.T(i18n.KeyBillingUnavailable), err)
+	case cost == nil:
+		return i18n.T(i18n.KeyBillingLoading)
+	}
+
+	line := FormatAmount(cost.Amount)
+	if cost.Currency != "" {
+		line += " " + cost.Currency
+	}
+	details := []string{cost.BillingCycle}
+	if cost.Subscription != "" {
+		details = append(details, cost.Subscription)
+	}
+	if cost.GrossAmount > cost.Amount {
+		details = append(details, fmt.Sprintf("%s %s", FormatAmount(cost.GrossAmount), i18n.T(i18n.KeyBillingBeforeDiscount)))
+	}
+	return fmt.Sprintf("%s (%s)", line, strings.Join(details, ", "))
+}
@@ -0,0 +1,138 @@
+package pages
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+)
+
+// zoneCapacityStatus summarizes the stock of a family in a zone
+func zoneCapacityStatus(c service.ZoneFamilyCapacity) string {
+	switch {
+	case len(c.Available) > 0:
+		return i18n.T(i18n.KeyCapacityAvailable)
+	case len(c.Low) > 0:
+		return i18n.T(i18n.KeyCapacityLow)
+	default:
+		return i18n.T(i18n.KeyCapacitySoldOut)
+	}
+}
+
+// shortInstanceTypes lists instance types without their family prefix, e.g.
+// "large, xlarge" for ecs.g7.large and ecs.g7.xlarge
+func shortInstanceTypes(family string, instanceTypes []string) string {
+	if len(instanceTypes) == 0 {
+		return "-"
+	}
+	short := make([]string, len(instanceTypes))
+	for i, t := range instanceTypes {
+		short[i] = strings.TrimPrefix(t, family+".")
+	}
+	return strings.Join(short, ", ")
+}
+
+// ZoneCapacityModel represents the zone capacity page, listing per zone which
+// instance families can currently be created
+type ZoneCapacityModel struct {
+	table    components.TableModel
+	capacity []service.ZoneFamilyCapacity
+	width    int
+	height   int
+}
+
+// NewZoneCapacityModel creates a new zone capacity model
+func NewZoneCapacityModel() ZoneCapacityModel {
+	columns := []table.Column{
+		{Title: "Zone", Width: 18},
+		{Title: "Family", Width: 18},
+		{Title: "Status", Width: 10},
+		{Title: "In Stock", Width: 8},
+		{Title: "Low", Width: 5},
+		{Title: "Sold Out", Width: 8},
+		{Title: "Creatable Types", Width: 60},
+	}
+
+	return ZoneCapacityModel{
+		table: components.NewTableModel(columns, "Zone Capacity (pay-as-you-go)"),
+	}
+}
+
+// SetData sets the zone capacity data
+func (m ZoneCapacityModel) SetData(capacity []service.ZoneFamilyCapacity) ZoneCapacityModel {
+	m.capacity = capacity
+
+	rows := make([]table.Row, len(capacity))
+	rowData := make([]interface{}, len(capacity))
+
+	for i, c := range capacity {
+		rows[i] = table.Row{
+			c.ZoneId,
+			c.Family,
+			zoneCapacityStatus(c),
+			fmt.Sprintf("%d", len(c.Available)),
+			fmt.Sprintf("%d", len(c.Low)),
+			fmt.Sprintf("%d", len(c.SoldOut)),
+			shortInstanceTypes(c.Family, append(append([]string{}, c.Available...), c.Low...)),
+		}
+		rowData[i] = c
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m ZoneCapacityModel) SetSize(width, height int) ZoneCapacityModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m ZoneCapacityModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m ZoneCapacityModel) Update(msg tea.Msg) (ZoneCapacityModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m ZoneCapacityModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m ZoneCapacityModel) Search(query string) ZoneCapacityModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m ZoneCapacityModel) Filter(query string) ZoneCapacityModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m ZoneCapacityModel) NextSearchMatch() ZoneCapacityModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m ZoneCapacityModel) PrevSearchMatch() ZoneCapacityModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
@@ -0,0 +1,143 @@
+package pages
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/changelog"
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/tui/components"
+)
+
+// ChangelogModel represents the What's New page: the pages and key bindings
+// each release added, one row per addition
+type ChangelogModel struct {
+	table    components.TableModel
+	releases []changelog.Release
+	since    string // Version the page was opened after an upgrade from, "" from the menu
+	showAll  bool
+	width    int
+	height   int
+	keys     ChangelogKeyMap
+}
+
+// ChangelogKeyMap defines key bindings
+type ChangelogKeyMap struct {
+	All key.Binding
+}
+
+// DefaultChangelogKeyMap returns default key bindings
+func DefaultChangelogKeyMap() ChangelogKeyMap {
+	return ChangelogKeyMap{
+		All: key.NewBinding(
+			key.WithKeys("a"),
+			key.WithHelp("a", "all releases"),
+		),
+	}
+}
+
+// NewChangelogModel creates a new What's New model. since is the version
+// upgraded from, whose newer releases are shown; "" shows every release
+func NewChangelogModel(since string) ChangelogModel {
+	columns := []components.ColumnSpec{
+		{Title: "Version", Width: 9},
+		{Title: "Kind", Width: 6},
+		{Title: "Page / Key", Width: 30},
+		{Title: "Open / Where", Width: 30},
+		{Title: "What It Does", Width: 70},
+	}
+
+	m := ChangelogModel{
+		table:    components.NewTableModelFromSpecs(columns, i18n.T(i18n.KeyPageChangelog)),
+		releases: changelog.Releases(),
+		since:    since,
+		keys:     DefaultChangelogKeyMap(),
+	}
+	return m.refresh()
+}
+
+// refresh fills the table with the releases to show, pages before keys
+func (m ChangelogModel) refresh() ChangelogModel {
+	releases := m.releases
+	if m.since != "" && !m.showAll {
+		releases = changelog.Since(m.since)
+	}
+
+	var values [][]interface{}
+	var rowData []interface{}
+	for _, r := range releases {
+		for _, p := range r.Pages {
+			values = append(values, []interface{}{r.Version, "Page", p.Name, p.Open, p.Summary})
+			rowData = append(rowData, p)
+		}
+		for _, b := range r.Keys {
+			values = append(values, []interface{}{r.Version, "Key", b.Key, b.Where, b.Summary})
+			rowData = append(rowData, b)
+		}
+	}
+
+	m.table = m.table.SetValues(values)
+	m.table = m.table.SetRowData(rowData)
+	if m.since != "" && !m.showAll {
+		m.table = m.table.SetTitle(fmt.Sprintf(i18n.T(i18n.KeyChangelogSince), changelog.Current(), m.since))
+	} else {
+		m.table = m.table.SetTitle(fmt.Sprintf(i18n.T(i18n.KeyChangelogAll), changelog.Current()))
+	}
+	return m
+}
+
+// SetSize sets the size
+func (m ChangelogModel) SetSize(width, height int) ChangelogModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m ChangelogModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m ChangelogModel) Update(msg tea.Msg) (ChangelogModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.All) && m.since != "" {
+		m.showAll = !m.showAll
+		return m.refresh(), nil
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m ChangelogModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m ChangelogModel) Search(query string) ChangelogModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m ChangelogModel) Filter(query string) ChangelogModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m ChangelogModel) NextSearchMatch() ChangelogModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m ChangelogModel) PrevSearchMatch() ChangelogModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
@@ -0,0 +1,311 @@
+package pages
+
+import (
+	"fmt"
+
+	cloudconfig "github.com/aliyun/alibaba-cloud-sdk-go/services/config"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// ConfigRulesModel represents the Cloud Config rules page
+type ConfigRulesModel struct {
+	table  components.TableModel
+	rules  []cloudconfig.ConfigRule
+	width  int
+	height int
+	keys   ConfigRulesKeyMap
+}
+
+// ConfigRulesKeyMap defines key bindings
+type ConfigRulesKeyMap struct {
+	Enter  key.Binding
+	Detail key.Binding
+}
+
+// DefaultConfigRulesKeyMap returns default key bindings
+func DefaultConfigRulesKeyMap() ConfigRulesKeyMap {
+	return ConfigRulesKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "non-compliant resources"),
+		),
+		Detail: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "details"),
+		),
+	}
+}
+
+// NewConfigRulesModel creates a new Cloud Config rules model
+func NewConfigRulesModel() ConfigRulesModel {
+	columns := []table.Column{
+		{Title: "Rule Name", Width: 36},
+		{Title: "Risk", Width: 8},
+		{Title: "Compliance", Width: 15},
+		{Title: "Non-compliant", Width: 14},
+		{Title: "State", Width: 12},
+		{Title: "Resource Types", Width: 36},
+		{Title: "Last Evaluated", Width: 20},
+	}
+
+	return ConfigRulesModel{
+		table: components.NewTableModel(columns, "Cloud Config Rules"),
+		keys:  DefaultConfigRulesKeyMap(),
+	}
+}
+
+// SetData sets the rules data
+func (m ConfigRulesModel) SetData(rules []cloudconfig.ConfigRule) ConfigRulesModel {
+	m.rules = rules
+
+	rows := make([]table.Row, len(rules))
+	rowData := make([]interface{}, len(rules))
+
+	for i, rule := range rules {
+		nonCompliant := "-"
+		if rule.Compliance.ComplianceType == service.ComplianceNonCompliant {
+			nonCompliant = fmt.Sprintf("%d", rule.Compliance.Count)
+		}
+
+		rows[i] = table.Row{
+			rule.ConfigRuleName,
+			configRiskLevel(rule.RiskLevel),
+			valueOrDash(rule.Compliance.ComplianceType),
+			nonCompliant,
+			rule.ConfigRuleState,
+			valueOrDash(rule.ResourceTypesScope),
+			formatUnixMillis(rule.ConfigRuleEvaluationStatus.LastSuccessfulEvaluationTimestamp),
+		}
+		rowData[i] = rule
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m ConfigRulesModel) SetSize(width, height int) ConfigRulesModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedRule returns the selected rule
+func (m ConfigRulesModel) SelectedRule() *cloudconfig.ConfigRule {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.rules) {
+		return &m.rules[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m ConfigRulesModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m ConfigRulesModel) Update(msg tea.Msg) (ConfigRulesModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.keys.Enter):
+			if rule := m.SelectedRule(); rule != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{Page: types.PageConfigResults, Data: *rule}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Detail):
+			if rule := m.SelectedRule(); rule != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{Page: types.PageConfigDetail, Data: *rule}
+				}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m ConfigRulesModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m ConfigRulesModel) Search(query string) ConfigRulesModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m ConfigRulesModel) Filter(query string) ConfigRulesModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m ConfigRulesModel) NextSearchMatch() ConfigRulesModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m ConfigRulesModel) PrevSearchMatch() ConfigRulesModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// ConfigResultsModel represents the non-compliant resources of a rule
+type ConfigResultsModel struct {
+	table   components.TableModel
+	rule    cloudconfig.ConfigRule
+	results []cloudconfig.EvaluationResult
+	width   int
+	height  int
+	keys    ConfigRulesKeyMap
+}
+
+// NewConfigResultsModel creates a new evaluation results model for a rule
+func NewConfigResultsModel(rule cloudconfig.ConfigRule) ConfigResultsModel {
+	columns := []table.Column{
+		{Title: "Resource ID", Width: 28},
+		{Title: "Resource Name", Width: 28},
+		{Title: "Resource Type", Width: 24},
+		{Title: "Region", Width: 16},
+		{Title: "Risk", Width: 8},
+		{Title: "Evaluated", Width: 20},
+		{Title: "Annotation", Width: 50},
+	}
+
+	return ConfigResultsModel{
+		table: components.NewTableModel(columns, fmt.Sprintf("Non-compliant Resources: %s", rule.ConfigRuleName)),
+		rule:  rule,
+		keys:  DefaultConfigRulesKeyMap(),
+	}
+}
+
+// SetData sets the evaluation results data
+func (m ConfigResultsModel) SetData(results []cloudconfig.EvaluationResult) ConfigResultsModel {
+	m.results = results
+
+	rows := make([]table.Row, len(results))
+	rowData := make([]interface{}, len(results))
+
+	for i, result := range results {
+		qualifier := result.EvaluationResultIdentifier.EvaluationResultQualifier
+		rows[i] = table.Row{
+			qualifier.ResourceId,
+			valueOrDash(qualifier.ResourceName),
+			qualifier.ResourceType,
+			qualifier.RegionId,
+			configRiskLevel(result.RiskLevel),
+			formatUnixMillis(result.ResultRecordedTimestamp),
+			valueOrDash(result.Annotation),
+		}
+		rowData[i] = result
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m ConfigResultsModel) SetSize(width, height int) ConfigResultsModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// RuleID returns the ID of the rule whose results are shown
+func (m ConfigResultsModel) RuleID() string {
+	return m.rule.ConfigRuleId
+}
+
+// SelectedResult returns the selected evaluation result
+func (m ConfigResultsModel) SelectedResult() *cloudconfig.EvaluationResult {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.results) {
+		return &m.results[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m ConfigResultsModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m ConfigResultsModel) Update(msg tea.Msg) (ConfigResultsModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && (key.Matches(msg, m.keys.Enter) || key.Matches(msg, m.keys.Detail)) {
+		if result := m.SelectedResult(); result != nil {
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageConfigDetail, Data: *result}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m ConfigResultsModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m ConfigResultsModel) Search(query string) ConfigResultsModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m ConfigResultsModel) Filter(query string) ConfigResultsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m ConfigResultsModel) NextSearchMatch() ConfigResultsModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m ConfigResultsModel) PrevSearchMatch() ConfigResultsModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// configRiskLevel names a Cloud Config risk level (1 high, 2 medium, 3 low)
+func configRiskLevel(level int) string {
+	switch level {
+	case 1:
+		return "High"
+	case 2:
+		return "Medium"
+	case 3:
+		return "Low"
+	}
+	return "-"
+}
+
+// formatUnixMillis formats a Unix timestamp in milliseconds, or "-" for 0
+func formatUnixMillis(ms int64) string {
+	return components.FormatUnixMillis(ms)
+}
@@ -1,10 +1,20 @@
 package pages
 
 import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
 	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
 )
 
 // DetailModel is a generic detail view model for displaying JSON data
@@ -87,6 +97,11 @@ func (m DetailModel) PrevSearchMatch() DetailModel {
 	return m
 }
 
+// regionColumn is appended to list pages that aggregate all regions
+func regionColumn() table.Column {
+	return table.Column{Title: i18n.T(i18n.KeyColRegion), Width: 16}
+}
+
 // BaseListModel is a base model for list pages
 type BaseListModel struct {
 	table    components.TableModel
@@ -167,6 +182,12 @@ func (m BaseListModel) Search(query string) BaseListModel {
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m BaseListModel) Filter(query string) BaseListModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m BaseListModel) NextSearchMatch() BaseListModel {
 	m.table = m.table.NextSearchMatch()
@@ -179,3 +200,170 @@ func (m BaseListModel) PrevSearchMatch() BaseListModel {
 	return m
 }
 
+
+// sectionAnchor records the content line where a section's title is rendered
+type sectionAnchor struct {
+	line  int
+	title string
+}
+
+// pinSectionTitle replaces the first line of a viewport view with the title of
+// the section scrolled into at yOffset, once that title has left the viewport
+func pinSectionTitle(view string, yOffset int, anchors []sectionAnchor, style lipgloss.Style) string {
+	current := -1
+	for i, anchor := range anchors {
+		if anchor.line > yOffset {
+			break
+		}
+		current = i
+	}
+	if current < 0 || anchors[current].line == yOffset {
+		return view
+	}
+
+	pinned := style.Render(anchors[current].title)
+	if idx := strings.Index(view, "\n"); idx >= 0 {
+		return pinned + view[idx:]
+	}
+	return pinned
+}
+
+// maintenanceWindowKey is the detail key that changes the maintenance window
+// of an RDS, Redis or MongoDB instance
+func maintenanceWindowKey() key.Binding {
+	return key.NewBinding(
+		key.WithKeys("W"),
+		key.WithHelp("W", "maintenance window"),
+	)
+}
+
+// MaintenanceWindowRequestMsg asks the app to pick a new maintenance window
+// for the instance shown on an RDS, Redis or MongoDB detail page
+type MaintenanceWindowRequestMsg struct {
+	Page       types.PageType // Detail page the request comes from, which tells the product
+	InstanceID string
+	Current    string // Current window as "02:00Z-03:00Z", empty while unknown
+}
+
+// formatMaintenanceWindow shows a maintenance window in UTC, as the APIs take
+// it, followed by the local time, e.g. "02:00Z-03:00Z (10:00-11:00 CST)"
+func formatMaintenanceWindow(window string) string {
+	parsed, err := service.ParseMaintenanceWindow(window)
+	if err != nil {
+		return valueOrDash(window)
+	}
+	return fmt.Sprintf("%s (%s)", parsed, parsed.Local())
+}
+
+// ConnTestRequestMsg asks the app to test the connection to an RDS or Redis
+// endpoint from the local machine
+type ConnTestRequestMsg struct {
+	InstanceID string
+	Address    string // Suggested host:port, which the user can change
+	Protocol   string // One of the service.ConnProtocol constants
+}
+
+// connTestBinding is the key that tests the connection to an instance
+func connTestBinding() key.Binding {
+	return key.NewBinding(
+		key.WithKeys("T"),
+		key.WithHelp("T", "test connection"),
+	)
+}
+
+// requestConnTest sends a ConnTestRequestMsg
+func requestConnTest(instanceID, host string, port int, protocol string) tea.Cmd {
+	address := ""
+	if host != "" {
+		address = net.JoinHostPort(host, strconv.Itoa(port))
+	}
+	return func() tea.Msg {
+		return ConnTestRequestMsg{InstanceID: instanceID, Address: address, Protocol: protocol}
+	}
+}
+
+// PortForwardRequestMsg asks the app to forward a local port to an RDS or
+// Redis endpoint through an SSH bastion ECS instance
+type PortForwardRequestMsg struct {
+	InstanceID string
+	Endpoint   string // Suggested host:port, the internal endpoint when known
+}
+
+// portForwardBinding is the key that forwards a local port to an instance
+func portForwardBinding() key.Binding {
+	return key.NewBinding(
+		key.WithKeys("O"),
+		key.WithHelp("O", "port forward"),
+	)
+}
+
+// requestPortForward sends a PortForwardRequestMsg
+func requestPortForward(instanceID, host string, port int) tea.Cmd {
+	endpoint := ""
+	if host != "" {
+		endpoint = net.JoinHostPort(host, strconv.Itoa(port))
+	}
+	return func() tea.Msg {
+		return PortForwardRequestMsg{InstanceID: instanceID, Endpoint: endpoint}
+	}
+}
+
+// ProbeRequestMsg asks the app to probe the latency of a resource address
+// with ICMP and, when a port is known, TCP connects
+type ProbeRequestMsg struct {
+	Name string // Resource shown in the probe title
+	Host string
+	Port int // Suggested TCP port, 0 for ICMP only
+}
+
+// probeBinding is the key that probes the latency of a resource address
+func probeBinding() key.Binding {
+	return key.NewBinding(
+		key.WithKeys("I"),
+		key.WithHelp("I", "latency probe"),
+	)
+}
+
+// requestProbe sends a ProbeRequestMsg
+func requestProbe(name, host string, port int) tea.Cmd {
+	return func() tea.Msg {
+		return ProbeRequestMsg{Name: name, Host: host, Port: port}
+	}
+}
+
+// ConnectRequestMsg asks the app to launch the command line client of an RDS
+// or Redis instance, after asking for the account to connect as
+type ConnectRequestMsg struct {
+	InstanceID string
+	Engine     string // Engine of the client command, e.g. MySQL or Redis
+	Address    string // Suggested host:port, which the user can change
+	Account    string // Suggested account, may be empty
+}
+
+// connectBinding is the key that opens the command line client of an instance
+func connectBinding() key.Binding {
+	return key.NewBinding(
+		key.WithKeys("C"),
+		key.WithHelp("C", "connect"),
+	)
+}
+
+// preferredEndpoint returns the endpoint to connect to from this machine: the
+// public one when there is one, else the first other one, else fallback
+func preferredEndpoint(infos []endpointInfo, fallback string) string {
+	for _, info := range infos {
+		if info.ipType == "Public" {
+			return info.address
+		}
+	}
+	if len(infos) > 0 {
+		return infos[0].address
+	}
+	return fallback
+}
+
+// endpointInfo is a connection endpoint of an instance as host:port
+type endpointInfo struct {
+	ipType  string
+	address string
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
 
+	"aliyun-tui-viewer/internal/service"
 	"aliyun-tui-viewer/internal/tui/components"
 	"aliyun-tui-viewer/internal/tui/types"
 )
@@ -24,7 +25,9 @@ type DNSDomainsModel struct {
 
 // DNSDomainsKeyMap defines key bindings
 type DNSDomainsKeyMap struct {
-	Enter key.Binding
+	Enter    key.Binding
+	Dangling key.Binding
+	Import   key.Binding
 }
 
 // DefaultDNSDomainsKeyMap returns default key bindings
@@ -34,6 +37,14 @@ func DefaultDNSDomainsKeyMap() DNSDomainsKeyMap {
 			key.WithKeys("enter"),
 			key.WithHelp("enter", "records"),
 		),
+		Dangling: key.NewBinding(
+			key.WithKeys("D"),
+			key.WithHelp("D", "dangling records"),
+		),
+		Import: key.NewBinding(
+			key.WithKeys("I"),
+			key.WithHelp("I", "import changes"),
+		),
 	}
 }
 
@@ -108,6 +119,14 @@ func (m DNSDomainsModel) Update(msg tea.Msg) (DNSDomainsModel, tea.Cmd) {
 					}
 				}
 			}
+
+		case key.Matches(msg, m.keys.Dangling):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageDNSDangling}
+			}
+
+		case key.Matches(msg, m.keys.Import):
+			return m, func() tea.Msg { return DNSImportRequestMsg{} }
 		}
 	}
 
@@ -121,12 +140,23 @@ func (m DNSDomainsModel) View() string {
 	return m.table.View()
 }
 
+// Table returns the list's table, used to render it outside the TUI
+func (m DNSDomainsModel) Table() components.TableModel {
+	return m.table
+}
+
 // Search searches in the list
 func (m DNSDomainsModel) Search(query string) DNSDomainsModel {
 	m.table = m.table.Search(query)
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m DNSDomainsModel) Filter(query string) DNSDomainsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m DNSDomainsModel) NextSearchMatch() DNSDomainsModel {
 	m.table = m.table.NextSearchMatch()
@@ -144,8 +174,70 @@ type DNSRecordsModel struct {
 	table      components.TableModel
 	records    []alidns.Record
 	domainName string
+	targets    *service.DNSTargetIndex // nil until the account's resources are indexed
 	width      int
 	height     int
+	keys       DNSRecordsKeyMap
+}
+
+// DNSRecordsKeyMap defines key bindings for record management
+type DNSRecordsKeyMap struct {
+	Add    key.Binding
+	Edit   key.Binding
+	Delete key.Binding
+	Toggle key.Binding
+	Check  key.Binding
+}
+
+// DefaultDNSRecordsKeyMap returns default key bindings
+func DefaultDNSRecordsKeyMap() DNSRecordsKeyMap {
+	return DNSRecordsKeyMap{
+		Add: key.NewBinding(
+			key.WithKeys("a"),
+			key.WithHelp("a", "add record"),
+		),
+		Edit: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "edit record"),
+		),
+		Delete: key.NewBinding(
+			key.WithKeys("d"),
+			key.WithHelp("d", "delete record"),
+		),
+		Toggle: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "pause/enable record"),
+		),
+		Check: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "check propagation"),
+		),
+	}
+}
+
+// DNSRecordAction identifies a write action on a DNS record
+type DNSRecordAction int
+
+const (
+	DNSRecordAdd DNSRecordAction = iota
+	DNSRecordEdit
+	DNSRecordDelete
+	DNSRecordToggle
+)
+
+// DNSRecordActionMsg asks the app to start a write flow for a record.
+// Record is nil for DNSRecordAdd
+type DNSRecordActionMsg struct {
+	Action     DNSRecordAction
+	DomainName string
+	Record     *alidns.Record
+}
+
+// DNSPropagationCheckRequestMsg asks the app to check whether public
+// resolvers return a record as configured
+type DNSPropagationCheckRequestMsg struct {
+	DomainName string
+	Record     alidns.Record
 }
 
 // NewDNSRecordsModel creates a new DNS records model
@@ -157,10 +249,12 @@ func NewDNSRecordsModel() DNSRecordsModel {
 		{Title: "Value", Width: 40},
 		{Title: "TTL", Width: 10},
 		{Title: "Status", Width: 10},
+		{Title: "Target", Width: 36},
 	}
 
 	return DNSRecordsModel{
 		table: components.NewTableModel(columns, "DNS Records"),
+		keys:  DefaultDNSRecordsKeyMap(),
 	}
 }
 
@@ -180,6 +274,7 @@ func (m DNSRecordsModel) SetData(records []alidns.Record, domainName string) DNS
 			record.Value,
 			fmt.Sprintf("%d", record.TTL),
 			record.Status,
+			m.targetLabel(record),
 		}
 		rowData[i] = record
 	}
@@ -190,6 +285,42 @@ func (m DNSRecordsModel) SetData(records []alidns.Record, domainName string) DNS
 	return m
 }
 
+// SetTargets sets the index used to show the resource each record points
+// at, re-rendering the rows
+func (m DNSRecordsModel) SetTargets(targets *service.DNSTargetIndex) DNSRecordsModel {
+	m.targets = targets
+	if m.domainName == "" {
+		return m
+	}
+	cursor := m.table.SelectedRow()
+	m = m.SetData(m.records, m.domainName)
+	m.table = m.table.SetCursor(cursor)
+	return m
+}
+
+// targetLabel describes the resource an A or CNAME record points at
+func (m DNSRecordsModel) targetLabel(record alidns.Record) string {
+	if m.targets == nil {
+		if record.Type == "A" || record.Type == "CNAME" {
+			return "…"
+		}
+		return ""
+	}
+
+	match := m.targets.Match(record)
+	switch match.State {
+	case service.DNSTargetFound:
+		return match.Target.Label()
+	case service.DNSTargetMissing:
+		return "Not found (dangling?)"
+	case service.DNSTargetExternal:
+		return "External"
+	case service.DNSTargetUnknown:
+		return "Unknown"
+	}
+	return ""
+}
+
 // SetSize sets the size
 func (m DNSRecordsModel) SetSize(width, height int) DNSRecordsModel {
 	m.width = width
@@ -203,8 +334,60 @@ func (m DNSRecordsModel) Init() tea.Cmd {
 	return nil
 }
 
+// SelectedRecord returns the selected record
+func (m DNSRecordsModel) SelectedRecord() *alidns.Record {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.records) {
+		record := m.records[idx]
+		if record.DomainName == "" {
+			record.DomainName = m.domainName
+		}
+		return &record
+	}
+	return nil
+}
+
+// DomainName returns the domain whose records are shown
+func (m DNSRecordsModel) DomainName() string {
+	return m.domainName
+}
+
 // Update implements tea.Model
 func (m DNSRecordsModel) Update(msg tea.Msg) (DNSRecordsModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && m.domainName != "" {
+		if key.Matches(msg, m.keys.Check) {
+			record := m.SelectedRecord()
+			if record == nil {
+				return m, nil
+			}
+			req := DNSPropagationCheckRequestMsg{DomainName: m.domainName, Record: *record}
+			return m, func() tea.Msg { return req }
+		}
+		action := DNSRecordAction(-1)
+		switch {
+		case key.Matches(msg, m.keys.Add):
+			action = DNSRecordAdd
+		case key.Matches(msg, m.keys.Edit):
+			action = DNSRecordEdit
+		case key.Matches(msg, m.keys.Delete):
+			action = DNSRecordDelete
+		case key.Matches(msg, m.keys.Toggle):
+			action = DNSRecordToggle
+		}
+		if action >= 0 {
+			var record *alidns.Record
+			if action != DNSRecordAdd {
+				if record = m.SelectedRecord(); record == nil {
+					return m, nil
+				}
+			}
+			domainName := m.domainName
+			return m, func() tea.Msg {
+				return DNSRecordActionMsg{Action: action, DomainName: domainName, Record: record}
+			}
+		}
+	}
+
 	var cmd tea.Cmd
 	m.table, cmd = m.table.Update(msg)
 	return m, cmd
@@ -221,6 +404,12 @@ func (m DNSRecordsModel) Search(query string) DNSRecordsModel {
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m DNSRecordsModel) Filter(query string) DNSRecordsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m DNSRecordsModel) NextSearchMatch() DNSRecordsModel {
 	m.table = m.table.NextSearchMatch()
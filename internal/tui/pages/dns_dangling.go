@@ -0,0 +1,165 @@
+package pages
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// DNSDanglingModel lists the DNS records of every domain that point at
+// resources the account does not own, a subdomain takeover risk
+type DNSDanglingModel struct {
+	table   components.TableModel
+	records []service.DanglingDNSRecord
+	width   int
+	height  int
+	keys    DNSDanglingKeyMap
+}
+
+// DNSDanglingKeyMap defines key bindings
+type DNSDanglingKeyMap struct {
+	Enter key.Binding
+}
+
+// DefaultDNSDanglingKeyMap returns default key bindings
+func DefaultDNSDanglingKeyMap() DNSDanglingKeyMap {
+	return DNSDanglingKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "domain records"),
+		),
+	}
+}
+
+// NewDNSDanglingModel creates a new dangling DNS records model
+func NewDNSDanglingModel() DNSDanglingModel {
+	columns := []table.Column{
+		{Title: "Domain", Width: 28},
+		{Title: "RR", Width: 20},
+		{Title: "Type", Width: 8},
+		{Title: "Value", Width: 40},
+		{Title: "Status", Width: 10},
+		{Title: "Finding", Width: 40},
+	}
+
+	return DNSDanglingModel{
+		table: components.NewTableModel(columns, "Dangling DNS Records"),
+		keys:  DefaultDNSDanglingKeyMap(),
+	}
+}
+
+// SetData sets the dangling records. complete is false when some regions or
+// products could not be listed, so findings may be false positives
+func (m DNSDanglingModel) SetData(records []service.DanglingDNSRecord, complete bool) DNSDanglingModel {
+	m.records = records
+
+	rows := make([]table.Row, len(records))
+	rowData := make([]interface{}, len(records))
+
+	for i, r := range records {
+		rows[i] = table.Row{
+			r.Domain,
+			r.Record.RR,
+			r.Record.Type,
+			r.Record.Value,
+			r.Record.Status,
+			danglingFinding(r),
+		}
+		rowData[i] = r.Record
+	}
+
+	title := fmt.Sprintf("Dangling DNS Records (%d)", len(records))
+	if !complete {
+		title += " - some resources could not be listed"
+	}
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(title)
+	return m
+}
+
+// danglingFinding explains why a record is reported
+func danglingFinding(r service.DanglingDNSRecord) string {
+	switch {
+	case r.State == service.DNSTargetUnknown:
+		return "Unverified, resource index incomplete"
+	case strings.EqualFold(r.Record.Type, "A"):
+		return "Public IP not owned by the account"
+	default:
+		return "Alibaba Cloud host not in the account"
+	}
+}
+
+// SetSize sets the size
+func (m DNSDanglingModel) SetSize(width, height int) DNSDanglingModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedRecord returns the selected dangling record
+func (m DNSDanglingModel) SelectedRecord() *service.DanglingDNSRecord {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.records) {
+		return &m.records[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m DNSDanglingModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m DNSDanglingModel) Update(msg tea.Msg) (DNSDanglingModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		if r := m.SelectedRecord(); r != nil {
+			domain := r.Domain
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageDNSRecords, Data: domain}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m DNSDanglingModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m DNSDanglingModel) Search(query string) DNSDanglingModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m DNSDanglingModel) Filter(query string) DNSDanglingModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m DNSDanglingModel) NextSearchMatch() DNSDanglingModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m DNSDanglingModel) PrevSearchMatch() DNSDanglingModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
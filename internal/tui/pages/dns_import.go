@@ -0,0 +1,166 @@
+package pages
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+)
+
+// DNSImportRequestMsg asks the app to prompt for a CSV file of DNS changes
+type DNSImportRequestMsg struct{}
+
+// DNSImportApplyRequestMsg asks the app to confirm and apply the ready
+// changes of an import
+type DNSImportApplyRequestMsg struct {
+	Plan *service.DNSImportPlan
+}
+
+// DNSImportModel shows the dry run of a DNS changes file, and once applied
+// the result of each change
+type DNSImportModel struct {
+	table  components.TableModel
+	plan   *service.DNSImportPlan
+	width  int
+	height int
+	keys   DNSImportKeyMap
+}
+
+// DNSImportKeyMap defines key bindings
+type DNSImportKeyMap struct {
+	Apply key.Binding
+}
+
+// DefaultDNSImportKeyMap returns default key bindings
+func DefaultDNSImportKeyMap() DNSImportKeyMap {
+	return DNSImportKeyMap{
+		Apply: key.NewBinding(
+			key.WithKeys("a"),
+			key.WithHelp("a", "apply"),
+		),
+	}
+}
+
+// NewDNSImportModel creates a new DNS import model
+func NewDNSImportModel() DNSImportModel {
+	columns := []components.ColumnSpec{
+		{Title: "Line", Width: 6},
+		{Title: "Action", Width: 8},
+		{Title: "Name", Width: 32},
+		{Title: "Type", Width: 8},
+		{Title: "Before", Width: 30},
+		{Title: "After", Width: 30},
+		{Title: "Status", Width: 40},
+	}
+
+	return DNSImportModel{
+		table: components.NewTableModelFromSpecs(columns, i18n.T(i18n.KeyPageDNSImport)),
+		keys:  DefaultDNSImportKeyMap(),
+	}
+}
+
+// SetData sets the planned, or applied, changes
+func (m DNSImportModel) SetData(plan *service.DNSImportPlan) DNSImportModel {
+	m.plan = plan
+
+	values := make([][]interface{}, len(plan.Changes))
+	rowData := make([]interface{}, len(plan.Changes))
+	for i, c := range plan.Changes {
+		status := c.Status.String()
+		if c.Reason != "" {
+			status += ": " + c.Reason
+		}
+		values[i] = []interface{}{
+			c.Line,
+			c.Action,
+			c.Name(),
+			c.Type,
+			valueOrDash(c.Before()),
+			valueOrDash(c.After()),
+			status,
+		}
+		rowData[i] = c
+	}
+
+	m.table = m.table.SetValues(values)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(m.title())
+	return m
+}
+
+// title sums up the plan by status
+func (m DNSImportModel) title() string {
+	p := m.plan
+	if p.Count(service.DNSChangeApplied)+p.Count(service.DNSChangeFailed) > 0 {
+		return fmt.Sprintf(i18n.T(i18n.KeyDNSImportAppliedTitle), filepath.Base(p.Source),
+			p.Count(service.DNSChangeApplied), p.Count(service.DNSChangeFailed), p.Count(service.DNSChangeUnchanged))
+	}
+	return fmt.Sprintf(i18n.T(i18n.KeyDNSImportTitle), filepath.Base(p.Source),
+		p.Count(service.DNSChangeReady), p.Count(service.DNSChangeUnchanged), p.Count(service.DNSChangeInvalid))
+}
+
+// Plan returns the changes shown
+func (m DNSImportModel) Plan() *service.DNSImportPlan {
+	return m.plan
+}
+
+// SetSize sets the size
+func (m DNSImportModel) SetSize(width, height int) DNSImportModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m DNSImportModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m DNSImportModel) Update(msg tea.Msg) (DNSImportModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Apply) && m.plan != nil {
+		plan := m.plan
+		return m, func() tea.Msg {
+			return DNSImportApplyRequestMsg{Plan: plan}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m DNSImportModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m DNSImportModel) Search(query string) DNSImportModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m DNSImportModel) Filter(query string) DNSImportModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m DNSImportModel) NextSearchMatch() DNSImportModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m DNSImportModel) PrevSearchMatch() DNSImportModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
@@ -2,6 +2,7 @@ package pages
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/table"
@@ -10,19 +11,34 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 
 	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
 	"aliyun-tui-viewer/internal/tui/components"
 	"aliyun-tui-viewer/internal/tui/types"
 )
 
 // ECSListModel represents the ECS instances list page
 type ECSListModel struct {
-	table     components.TableModel
-	instances []ecs.Instance
-	width     int
-	height    int
-	keys      ECSListKeyMap
+	table      components.TableModel
+	all        []ecs.Instance // Every instance set by SetData
+	instances  []ecs.Instance // Instances shown, after the spot/GPU filter
+	category   ecsCategory
+	title      string
+	width      int
+	height     int
+	keys       ECSListKeyMap
+	showRegion bool       // Region column, when listing all regions
+	tagFilter  *TagFilter // Set by t, nil to show every resource
 }
 
+// ecsCategory restricts the ECS list to one kind of instance
+type ecsCategory int
+
+const (
+	ecsCategoryAll ecsCategory = iota
+	ecsCategorySpot
+	ecsCategoryGPU
+)
+
 // ECSListKeyMap defines key bindings for ECS list
 type ECSListKeyMap struct {
 	Enter             key.Binding
@@ -30,6 +46,13 @@ type ECSListKeyMap struct {
 	SecurityGroups    key.Binding
 	Disks             key.Binding
 	NetworkInterfaces key.Binding
+	SpotOnly          key.Binding
+	GPUOnly           key.Binding
+	TagFilter         key.Binding
+	ZoneCapacity      key.Binding
+	Probe             key.Binding
+	AddToSLB          key.Binding
+	CopyBatch         key.Binding
 }
 
 // DefaultECSListKeyMap returns default key bindings
@@ -55,7 +78,93 @@ func DefaultECSListKeyMap() ECSListKeyMap {
 			key.WithKeys("e"),
 			key.WithHelp("e", "network interfaces"),
 		),
+		SpotOnly: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "spot instances only"),
+		),
+		GPUOnly: key.NewBinding(
+			key.WithKeys("U"),
+			key.WithHelp("U", "GPU instances only"),
+		),
+		TagFilter: tagFilterBinding(),
+		ZoneCapacity: key.NewBinding(
+			key.WithKeys("Z"),
+			key.WithHelp("Z", "zone capacity"),
+		),
+		Probe: probeBinding(),
+		AddToSLB: key.NewBinding(
+			key.WithKeys("B"),
+			key.WithHelp("B", "add to SLB"),
+		),
+		CopyBatch: key.NewBinding(
+			key.WithKeys("Y"),
+			key.WithHelp("Y", "copy IDs/IPs"),
+		),
+	}
+}
+
+// Fields of ECS instances that Y copies, one instance per line
+const (
+	ECSCopyIDs        = "ids"
+	ECSCopyPrivateIPs = "private_ips"
+	ECSCopyPublicIPs  = "public_ips"
+)
+
+// ECSCopyRequestMsg asks the app which field of the instances to copy
+type ECSCopyRequestMsg struct {
+	Instances []ecs.Instance
+}
+
+// ECSCopyText returns the field of each instance, one per line, leaving out
+// the instances that have none, such as those without a public IP
+func ECSCopyText(instances []ecs.Instance, field string) string {
+	lines := make([]string, 0, len(instances))
+	for _, inst := range instances {
+		value := ""
+		switch field {
+		case ECSCopyIDs:
+			value = inst.InstanceId
+		case ECSCopyPrivateIPs:
+			value = ECSPrivateIP(inst)
+		case ECSCopyPublicIPs:
+			value = ECSPublicIP(inst)
+		}
+		if value != "" {
+			lines = append(lines, value)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ECSPrivateIP returns the first private IP of inst, in a VPC or the classic
+// network, or "" when it has none
+func ECSPrivateIP(inst ecs.Instance) string {
+	if len(inst.VpcAttributes.PrivateIpAddress.IpAddress) > 0 {
+		return inst.VpcAttributes.PrivateIpAddress.IpAddress[0]
+	}
+	if len(inst.InnerIpAddress.IpAddress) > 0 {
+		return inst.InnerIpAddress.IpAddress[0]
 	}
+	return ""
+}
+
+// ECSPublicIP returns the public IP of inst, or the EIP bound to it, or ""
+// when it has neither
+func ECSPublicIP(inst ecs.Instance) string {
+	if len(inst.PublicIpAddress.IpAddress) > 0 {
+		return inst.PublicIpAddress.IpAddress[0]
+	}
+	return inst.EipAddress.IpAddress
+}
+
+// SLBBackendAddRequestMsg asks the app to register ECS instances as backend
+// servers of an SLB VServer group. The SLB and the group are picked when not
+// set; with only LoadBalancerID set, a new group is created on that SLB
+type SLBBackendAddRequestMsg struct {
+	Instances        []ecs.Instance
+	LoadBalancerID   string
+	VServerGroupID   string
+	VServerGroupName string
 }
 
 // NewECSListModel creates a new ECS list model
@@ -65,66 +174,176 @@ func NewECSListModel() ECSListModel {
 		{Title: i18n.T(i18n.KeyColStatus), Width: 10},
 		{Title: i18n.T(i18n.KeyColZone), Width: 18},
 		{Title: i18n.T(i18n.KeyColCPURAM), Width: 10},
+		{Title: i18n.T(i18n.KeyColGPU), Width: 16},
 		{Title: i18n.T(i18n.KeyColPrivateIP), Width: 16},
 		{Title: i18n.T(i18n.KeyColPublicIP), Width: 16},
 		{Title: i18n.T(i18n.KeyColName), Width: 30},
 		{Title: i18n.T(i18n.KeyColExpired), Width: 22},
+		{Title: i18n.T(i18n.KeyColSpot), Width: 12},
+		{Title: i18n.T(i18n.KeyColImageID), Width: 30},
+		{Title: i18n.T(i18n.KeyColOSName), Width: 30},
 	}
 
 	return ECSListModel{
-		table: components.NewTableModel(columns, i18n.T(i18n.KeyPageECSList)),
+		// Image and OS show once picked with the column chooser
+		table: components.NewTableModel(columns, i18n.T(i18n.KeyPageECSList)).SetHiddenColumns(10, 11),
+		title: i18n.T(i18n.KeyPageECSList),
 		keys:  DefaultECSListKeyMap(),
 	}
 }
 
-// SetData sets the ECS instances data
+// SetData sets the ECS instances data. Instances still listed stay
+// selected
 func (m ECSListModel) SetData(instances []ecs.Instance) ECSListModel {
+	m.all = instances
+	m.table = m.table.SetTitle(m.categoryTitle())
+	return m.applyCategory()
+}
+
+// setCategory shows only spot or GPU instances, or all of them again
+func (m ECSListModel) setCategory(category ecsCategory) ECSListModel {
+	m.category = category
+	m.table = m.table.SetTitle(m.categoryTitle())
+	return m.applyCategory()
+}
+
+// toggleCategory switches to category, or back to all instances if it is
+// already shown
+func (m ECSListModel) toggleCategory(category ecsCategory) ECSListModel {
+	if m.category == category {
+		return m.setCategory(ecsCategoryAll)
+	}
+	return m.setCategory(category)
+}
+
+// categoryTitle returns the table title with the active category
+func (m ECSListModel) categoryTitle() string {
+	title := m.title
+	switch m.category {
+	case ecsCategorySpot:
+		title = fmt.Sprintf("%s [%s]", m.title, i18n.T(i18n.KeyFilterSpotOnly))
+	case ecsCategoryGPU:
+		title = fmt.Sprintf("%s [%s]", m.title, i18n.T(i18n.KeyFilterGPUOnly))
+	}
+	return title
+}
+
+// applyCategory rebuilds the rows from the instances in the active category,
+// keeping the selected ones that it still shows selected
+func (m ECSListModel) applyCategory() ECSListModel {
+	marked := make(map[string]bool)
+	for _, inst := range m.MarkedInstances() {
+		marked[inst.InstanceId] = true
+	}
+
+	instances := m.all
+	if m.category != ecsCategoryAll {
+		instances = make([]ecs.Instance, 0, len(m.all))
+		for _, inst := range m.all {
+			if (m.category == ecsCategorySpot && IsSpotInstance(inst)) || (m.category == ecsCategoryGPU && inst.GPUAmount > 0) {
+				instances = append(instances, inst)
+			}
+		}
+	}
 	m.instances = instances
 
 	rows := make([]table.Row, len(instances))
 	rowData := make([]interface{}, len(instances))
+	var selection []int
 
 	for i, inst := range instances {
-		// Private IP
-		privateIP := "N/A"
-		if len(inst.VpcAttributes.PrivateIpAddress.IpAddress) > 0 {
-			privateIP = inst.VpcAttributes.PrivateIpAddress.IpAddress[0]
-		} else if len(inst.InnerIpAddress.IpAddress) > 0 {
-			privateIP = inst.InnerIpAddress.IpAddress[0]
+		rows[i] = m.instanceRow(inst)
+		rowData[i] = inst
+		if marked[inst.InstanceId] {
+			selection = append(selection, i)
 		}
+	}
 
-		// Public IP
-		publicIP := "N/A"
-		if len(inst.PublicIpAddress.IpAddress) > 0 {
-			publicIP = inst.PublicIpAddress.IpAddress[0]
-		} else if inst.EipAddress.IpAddress != "" {
-			publicIP = inst.EipAddress.IpAddress
-		}
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetSelection(selection)
+	return m.applyTagFilter()
+}
 
-		// CPU/RAM
-		cpuRam := fmt.Sprintf("%dC/%dG", inst.Cpu, inst.Memory/1024)
+// instanceRow returns the table row of an instance
+func (m ECSListModel) instanceRow(inst ecs.Instance) table.Row {
+	// Private IP
+	privateIP := ECSPrivateIP(inst)
+	if privateIP == "" {
+		privateIP = "N/A"
+	}
 
-		// Expired Time
-		expiredTime := "N/A"
-		if inst.ExpiredTime != "" {
-			expiredTime = inst.ExpiredTime
-		}
+	// Public IP
+	publicIP := ECSPublicIP(inst)
+	if publicIP == "" {
+		publicIP = "N/A"
+	}
+
+	// CPU/RAM
+	cpuRam := fmt.Sprintf("%dC/%dG", inst.Cpu, inst.Memory/1024)
+
+	// Expired Time
+	expiredTime := "N/A"
+	if inst.ExpiredTime != "" {
+		expiredTime = components.FormatTimeString(inst.ExpiredTime)
+	}
 
-		rows[i] = table.Row{
-			inst.InstanceId,
-			inst.Status,
-			inst.ZoneId,
-			cpuRam,
-			privateIP,
-			publicIP,
-			inst.InstanceName,
-			expiredTime,
+	row := table.Row{
+		inst.InstanceId,
+		inst.Status,
+		inst.ZoneId,
+		cpuRam,
+		FormatGPU(inst),
+		privateIP,
+		publicIP,
+		inst.InstanceName,
+		expiredTime,
+		SpotStatus(inst),
+		inst.ImageId,
+		inst.OSName,
+	}
+	if m.showRegion {
+		row = append(row, inst.RegionId)
+	}
+	return row
+}
+
+// MarkedInstances returns the instances selected with space or V, in list
+// order
+func (m ECSListModel) MarkedInstances() []ecs.Instance {
+	var marked []ecs.Instance
+	for _, index := range m.table.SelectionIndices() {
+		if index < len(m.instances) {
+			marked = append(marked, m.instances[index])
 		}
-		rowData[i] = inst
 	}
+	return marked
+}
 
-	m.table = m.table.SetRows(rows)
-	m.table = m.table.SetRowData(rowData)
+// ClearMarks unselects every instance
+func (m ECSListModel) ClearMarks() ECSListModel {
+	m.table = m.table.ClearSelection()
+	return m
+}
+
+// batchInstances returns the instances a batch action applies to: the
+// marked ones, else the highlighted one
+func (m ECSListModel) batchInstances() []ecs.Instance {
+	if marked := m.MarkedInstances(); len(marked) > 0 {
+		return marked
+	}
+	if inst := m.SelectedInstance(); inst != nil {
+		return []ecs.Instance{*inst}
+	}
+	return nil
+}
+
+// SetShowRegion adds a Region column, used when listing all regions
+func (m ECSListModel) SetShowRegion(show bool) ECSListModel {
+	if show && !m.showRegion {
+		m.table = m.table.SetColumns(append(m.table.Columns(), regionColumn()))
+	}
+	m.showRegion = show
 	return m
 }
 
@@ -138,7 +357,8 @@ func (m ECSListModel) SetSize(width, height int) ECSListModel {
 
 // SetTitle sets the title
 func (m ECSListModel) SetTitle(title string) ECSListModel {
-	m.table = m.table.SetTitle(title)
+	m.title = title
+	m.table = m.table.SetTitle(m.categoryTitle())
 	return m
 }
 
@@ -151,6 +371,25 @@ func (m ECSListModel) SelectedInstance() *ecs.Instance {
 	return nil
 }
 
+// SetTagFilter shows only the resources carrying the filter's tag, or every
+// resource again when f is nil
+func (m ECSListModel) SetTagFilter(f *TagFilter) ECSListModel {
+	m.tagFilter = f
+	return m.applyTagFilter()
+}
+
+// TagFilter returns the active tag filter, or nil
+func (m ECSListModel) TagFilter() *TagFilter {
+	return m.tagFilter
+}
+
+// applyTagFilter applies the tag filter to the current rows
+func (m ECSListModel) applyTagFilter() ECSListModel {
+	instances := m.instances
+	m.table = applyTagFilter(m.table, m.tagFilter, func(i int) string { return instances[i].InstanceId })
+	return m
+}
+
 // Init implements tea.Model
 func (m ECSListModel) Init() tea.Cmd {
 	return nil
@@ -212,6 +451,44 @@ func (m ECSListModel) Update(msg tea.Msg) (ECSListModel, tea.Cmd) {
 					}
 				}
 			}
+
+		case key.Matches(msg, m.keys.SpotOnly):
+			return m.toggleCategory(ecsCategorySpot), nil
+
+		case key.Matches(msg, m.keys.GPUOnly):
+			return m.toggleCategory(ecsCategoryGPU), nil
+
+		case key.Matches(msg, m.keys.TagFilter):
+			return m, requestTagFilter(m.tagFilter)
+
+		case key.Matches(msg, m.keys.ZoneCapacity):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageZoneCapacity}
+			}
+
+		case key.Matches(msg, m.keys.Probe):
+			if inst := m.SelectedInstance(); inst != nil {
+				port := 22
+				if strings.EqualFold(inst.OSType, "windows") {
+					port = 3389
+				}
+				return m, requestProbe(inst.InstanceId, service.InstanceAddress(*inst), port)
+			}
+
+		case key.Matches(msg, m.keys.AddToSLB):
+			// The marked instances, else the selected one
+			if instances := m.batchInstances(); len(instances) > 0 {
+				return m, func() tea.Msg {
+					return SLBBackendAddRequestMsg{Instances: instances}
+				}
+			}
+
+		case key.Matches(msg, m.keys.CopyBatch):
+			if instances := m.batchInstances(); len(instances) > 0 {
+				return m, func() tea.Msg {
+					return ECSCopyRequestMsg{Instances: instances}
+				}
+			}
 		}
 	}
 
@@ -225,12 +502,23 @@ func (m ECSListModel) View() string {
 	return m.table.View()
 }
 
+// Table returns the list's table, used to render it outside the TUI
+func (m ECSListModel) Table() components.TableModel {
+	return m.table
+}
+
 // Search searches in the list
 func (m ECSListModel) Search(query string) ECSListModel {
 	m.table = m.table.Search(query)
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m ECSListModel) Filter(query string) ECSListModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m ECSListModel) NextSearchMatch() ECSListModel {
 	m.table = m.table.NextSearchMatch()
@@ -243,3 +531,44 @@ func (m ECSListModel) PrevSearchMatch() ECSListModel {
 	return m
 }
 
+// IsSpotInstance reports whether inst is a spot (preemptible) instance
+func IsSpotInstance(inst ecs.Instance) bool {
+	return inst.SpotStrategy != "" && inst.SpotStrategy != "NoSpot"
+}
+
+// IsSpotReclaiming reports whether a spot instance is locked because it is
+// being, or has been, reclaimed
+func IsSpotReclaiming(inst ecs.Instance) bool {
+	for _, lock := range inst.OperationLocks.LockReason {
+		if lock.LockReason == "Recycling" {
+			return true
+		}
+	}
+	return false
+}
+
+// SpotStatus returns the interruption status of a spot instance, or "-" for
+// pay-as-you-go and subscription instances
+func SpotStatus(inst ecs.Instance) string {
+	switch {
+	case !IsSpotInstance(inst):
+		return "-"
+	case IsSpotReclaiming(inst):
+		return i18n.T(i18n.KeySpotReclaiming)
+	default:
+		return i18n.T(i18n.KeySpotActive)
+	}
+}
+
+// FormatGPU returns the GPU count and type of inst, e.g. "4 x NVIDIA V100",
+// or "-" when it has no GPU
+func FormatGPU(inst ecs.Instance) string {
+	if inst.GPUAmount <= 0 {
+		return "-"
+	}
+	spec := strings.TrimSpace(inst.GPUSpec)
+	if spec == "" {
+		return fmt.Sprintf("%d", inst.GPUAmount)
+	}
+	return fmt.Sprintf("%d x %s", inst.GPUAmount, spec)
+}
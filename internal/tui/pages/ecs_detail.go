@@ -13,28 +13,17 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 
 	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/theme"
 	"aliyun-tui-viewer/internal/tui/components"
-)
-
-// Colors matching the main interface's purple theme
-var (
-	primaryColor    = lipgloss.Color("#7C3AED") // Purple
-	secondaryColor  = lipgloss.Color("#06B6D4") // Cyan
-	accentColor     = lipgloss.Color("#F59E0B") // Amber
-	successColor    = lipgloss.Color("#10B981") // Green
-	errorColor      = lipgloss.Color("#EF4444") // Red
-	warningColor    = lipgloss.Color("#F59E0B") // Amber
-	textColor       = lipgloss.Color("#E5E7EB") // Light gray
-	subtleTextColor = lipgloss.Color("#9CA3AF") // Gray
-	mutedTextColor  = lipgloss.Color("#6B7280") // Dark gray
-	borderColor     = lipgloss.Color("#374151") // Dark border
-	selectedBg      = lipgloss.Color("#374151") // Selected background
+	"aliyun-tui-viewer/internal/tui/types"
 )
 
 // DetailRow represents a single row in a section
 type DetailRow struct {
-	Label string
-	Value string
+	Label  string
+	Value  string
+	Status bool // The value is a status, shown with a colored indicator
 }
 
 // DetailSection represents a section with multiple rows
@@ -46,13 +35,21 @@ type DetailSection struct {
 // ECSDetailModel represents the ECS instance detail page with formatted view
 type ECSDetailModel struct {
 	instance       ecs.Instance
+	names          *service.NameResolver // Resolves referenced IDs to names, may be nil
+	cost           *service.InstanceCost // Month-to-date cost, nil until loaded
+	costErr        error
+	ramRole        string // Attached RAM role name, "" when it has none
+	ramRoleLoaded  bool
+	ramRoleErr     error
 	sections       []DetailSection
 	viewport       viewport.Model // Scrollable viewport
 	width          int
 	height         int
 	keys           ECSDetailKeyMap
-	currentSection int // Currently focused section
-	currentRow     int // Currently focused row within section
+	currentSection int             // Currently focused section
+	currentRow     int             // Currently focused row within section
+	zoomed         bool            // Only the focused section is rendered
+	anchors        []sectionAnchor // Title lines of rendered sections, for the sticky header
 	yankLastTime   time.Time
 	yankCount      int
 }
@@ -68,6 +65,11 @@ type ECSDetailKeyMap struct {
 	Top         key.Binding
 	Bottom      key.Binding
 	Yank        key.Binding
+	Zoom        key.Binding
+	Metrics     key.Binding
+	RolePolicy  key.Binding
+	CopyConsole key.Binding
+	OpenConsole key.Binding
 }
 
 // DefaultECSDetailKeyMap returns default key bindings
@@ -109,6 +111,26 @@ func DefaultECSDetailKeyMap() ECSDetailKeyMap {
 			key.WithKeys("y"),
 			key.WithHelp("yy", "copy value"),
 		),
+		Zoom: key.NewBinding(
+			key.WithKeys("z"),
+			key.WithHelp("z", "zoom section"),
+		),
+		Metrics: key.NewBinding(
+			key.WithKeys("m"),
+			key.WithHelp("m", "metrics"),
+		),
+		RolePolicy: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "RAM role policies"),
+		),
+		CopyConsole: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "copy console URL"),
+		),
+		OpenConsole: key.NewBinding(
+			key.WithKeys("o"),
+			key.WithHelp("o", "open console"),
+		),
 	}
 }
 
@@ -145,7 +167,8 @@ func (m *ECSDetailModel) buildSections() {
 			{Label: i18n.T(i18n.KeyLabelInstanceStatus), Value: inst.Status},
 			{Label: i18n.T(i18n.KeyLabelAvailZone), Value: inst.ZoneId},
 			{Label: i18n.T(i18n.KeyLabelChargeType), Value: m.formatChargeType(inst.InstanceChargeType)},
-			{Label: i18n.T(i18n.KeyLabelExpireTime), Value: m.formatValue(inst.ExpiredTime)},
+			{Label: i18n.T(i18n.KeyLabelExpireTime), Value: components.FormatTimeString(inst.ExpiredTime)},
+			{Label: i18n.T(i18n.KeyBillingCost), Value: formatInstanceCost(m.cost, m.costErr)},
 		},
 	}
 
@@ -157,10 +180,10 @@ func (m *ECSDetailModel) buildSections() {
 			{Label: i18n.T(i18n.KeyLabelCPUMemory), Value: fmt.Sprintf("%d vCPU / %d GiB", inst.Cpu, inst.Memory/1024)},
 			{Label: i18n.T(i18n.KeyColPublicIP), Value: m.getPublicIP()},
 			{Label: i18n.T(i18n.KeyColPrivateIP), Value: m.getPrivateIPs()},
-			{Label: i18n.T(i18n.KeyLabelImageID), Value: inst.ImageId},
+			{Label: i18n.T(i18n.KeyLabelImageID), Value: m.names.Label(service.KindImage, inst.ImageId)},
 			{Label: i18n.T(i18n.KeyLabelOSName), Value: m.formatValue(inst.OSName)},
-			{Label: i18n.T(i18n.KeyLabelVPC), Value: m.formatValue(inst.VpcAttributes.VpcId)},
-			{Label: i18n.T(i18n.KeyLabelVSwitch), Value: m.formatValue(inst.VpcAttributes.VSwitchId)},
+			{Label: i18n.T(i18n.KeyLabelVPC), Value: m.formatValue(m.names.Label(service.KindVPC, inst.VpcAttributes.VpcId))},
+			{Label: i18n.T(i18n.KeyLabelVSwitch), Value: m.formatValue(m.names.Label(service.KindVSwitch, inst.VpcAttributes.VSwitchId))},
 			{Label: i18n.T(i18n.KeyLabelNetworkType), Value: m.formatNetworkType(inst.InstanceNetworkType)},
 			{Label: i18n.T(i18n.KeyLabelBandwidth), Value: fmt.Sprintf("In: %d Mbps / Out: %d Mbps", inst.InternetMaxBandwidthIn, inst.InternetMaxBandwidthOut)},
 			{Label: i18n.T(i18n.KeyLabelBandwidthCharge), Value: m.formatValue(inst.InternetChargeType)},
@@ -171,7 +194,8 @@ func (m *ECSDetailModel) buildSections() {
 	boundResources := DetailSection{
 		Title: i18n.T(i18n.KeySectionBoundRes),
 		Rows: []DetailRow{
-			{Label: i18n.T(i18n.KeyLabelSecurityGroup), Value: fmt.Sprintf(i18n.T(i18n.KeyCountSG), len(inst.SecurityGroupIds.SecurityGroupId))},
+			{Label: i18n.T(i18n.KeyLabelSecurityGroup), Value: m.getSecurityGroups()},
+			{Label: i18n.T(i18n.KeyLabelRAMRole), Value: m.formatRAMRole()},
 			{Label: i18n.T(i18n.KeyFinderENI), Value: fmt.Sprintf(i18n.T(i18n.KeyCountENI), len(inst.NetworkInterfaces.NetworkInterface))},
 			{Label: i18n.T(i18n.KeyLabelEIPID), Value: m.formatValue(inst.EipAddress.AllocationId)},
 			{Label: i18n.T(i18n.KeyLabelSecondaryIP), Value: m.getSecondaryIPs()},
@@ -182,7 +206,7 @@ func (m *ECSDetailModel) buildSections() {
 	groupInfo := DetailSection{
 		Title: i18n.T(i18n.KeySectionGroupInfo),
 		Rows: []DetailRow{
-			{Label: i18n.T(i18n.KeyLabelResourceGroup), Value: m.formatValue(inst.ResourceGroupId)},
+			{Label: i18n.T(i18n.KeyLabelResourceGroup), Value: m.formatValue(m.names.Label(service.KindResourceGroup, inst.ResourceGroupId))},
 			{Label: i18n.T(i18n.KeyLabelTags), Value: m.getTags()},
 		},
 	}
@@ -193,13 +217,105 @@ func (m *ECSDetailModel) buildSections() {
 		Rows: []DetailRow{
 			{Label: i18n.T(i18n.KeyLabelHostname), Value: m.formatValue(inst.HostName)},
 			{Label: i18n.T(i18n.KeyColDescription), Value: m.formatValue(inst.Description)},
-			{Label: i18n.T(i18n.KeyColCreatedAt), Value: m.formatValue(inst.CreationTime)},
+			{Label: i18n.T(i18n.KeyColCreatedAt), Value: components.FormatTimeString(inst.CreationTime)},
 			{Label: i18n.T(i18n.KeyLabelKeyPair), Value: m.formatValue(inst.KeyPairName)},
 			{Label: i18n.T(i18n.KeyLabelSerialNumber), Value: m.formatValue(inst.SerialNumber)},
 		},
 	}
 
-	m.sections = []DetailSection{basicInfo, configInfo, boundResources, groupInfo, otherInfo}
+	m.sections = []DetailSection{basicInfo, configInfo}
+
+	// Spot and GPU sections, only for instances of those kinds
+	if IsSpotInstance(inst) {
+		m.sections = append(m.sections, DetailSection{
+			Title: i18n.T(i18n.KeySectionSpot),
+			Rows: []DetailRow{
+				{Label: i18n.T(i18n.KeyLabelSpotStrategy), Value: m.formatSpotStrategy(inst.SpotStrategy)},
+				{Label: i18n.T(i18n.KeyLabelSpotPriceLimit), Value: m.formatSpotPriceLimit(inst)},
+				{Label: i18n.T(i18n.KeyLabelSpotDuration), Value: m.formatSpotDuration(inst.SpotDuration)},
+				{Label: i18n.T(i18n.KeyLabelSpotInterruption), Value: m.formatValue(inst.SpotInterruptionBehavior)},
+				{Label: i18n.T(i18n.KeyLabelSpotStatus), Value: SpotStatus(inst)},
+			},
+		})
+	}
+	if inst.GPUAmount > 0 {
+		m.sections = append(m.sections, DetailSection{
+			Title: i18n.T(i18n.KeySectionGPU),
+			Rows: []DetailRow{
+				{Label: i18n.T(i18n.KeyLabelGPUSpec), Value: m.formatValue(inst.GPUSpec)},
+				{Label: i18n.T(i18n.KeyLabelGPUAmount), Value: fmt.Sprintf("%d", inst.GPUAmount)},
+			},
+		})
+	}
+
+	m.sections = append(m.sections, boundResources, groupInfo, otherInfo)
+}
+
+// SetNames sets the resolver used to show the names of referenced resources
+func (m ECSDetailModel) SetNames(names *service.NameResolver) ECSDetailModel {
+	m.names = names
+	if len(m.sections) == 0 {
+		return m
+	}
+	m.buildSections()
+	m.updateViewportContent()
+	return m
+}
+
+// SetCost sets the month-to-date cost of the instance, or the error that
+// prevented loading it
+func (m ECSDetailModel) SetCost(cost *service.InstanceCost, err error) ECSDetailModel {
+	m.cost = cost
+	m.costErr = err
+	m.buildSections()
+	m.updateViewportContent()
+	return m
+}
+
+// SetRAMRole sets the RAM role attached to the instance, or the error that
+// prevented loading it
+func (m ECSDetailModel) SetRAMRole(roleName string, err error) ECSDetailModel {
+	m.ramRole = roleName
+	m.ramRoleErr = err
+	m.ramRoleLoaded = true
+	m.buildSections()
+	m.updateViewportContent()
+	return m
+}
+
+// formatRAMRole renders the attached RAM role row
+func (m ECSDetailModel) formatRAMRole() string {
+	switch {
+	case m.ramRoleErr != nil:
+		return fmt.Sprintf("%s: %v", i18n.T(i18n.KeyRAMRoleUnavailable), m.ramRoleErr)
+	case !m.ramRoleLoaded:
+		return i18n.T(i18n.KeyRAMRoleLoading)
+	case m.ramRole == "":
+		return i18n.T(i18n.KeyRAMRoleNone)
+	}
+	return m.ramRole
+}
+
+// InstanceID returns the ID of the shown instance
+func (m ECSDetailModel) InstanceID() string {
+	return m.instance.InstanceId
+}
+
+// RegionID returns the region of the shown instance
+func (m ECSDetailModel) RegionID() string {
+	return m.instance.RegionId
+}
+
+// NameRefs returns the IDs referenced by the instance
+func (m ECSDetailModel) NameRefs() service.NameRefs {
+	inst := m.instance
+	refs := make(service.NameRefs)
+	refs.Add(service.KindImage, inst.ImageId)
+	refs.Add(service.KindVPC, inst.VpcAttributes.VpcId)
+	refs.Add(service.KindVSwitch, inst.VpcAttributes.VSwitchId)
+	refs.Add(service.KindSecurityGroup, inst.SecurityGroupIds.SecurityGroupId...)
+	refs.Add(service.KindResourceGroup, inst.ResourceGroupId)
+	return refs
 }
 
 // SetSize sets the size of the detail view
@@ -219,9 +335,17 @@ func (m *ECSDetailModel) updateViewportContent() {
 	}
 
 	var sections []string
+	m.anchors = nil
+	line := 0
 	for i, section := range m.sections {
+		if m.zoomed && i != m.currentSection {
+			continue
+		}
 		isFocused := (i == m.currentSection)
-		sections = append(sections, m.renderSection(section, i, isFocused))
+		rendered := m.renderSection(section, i, isFocused)
+		sections = append(sections, rendered)
+		m.anchors = append(m.anchors, sectionAnchor{line: line, title: section.Title})
+		line += lipgloss.Height(rendered)
 	}
 
 	content := lipgloss.JoinVertical(lipgloss.Left, sections...)
@@ -232,8 +356,9 @@ func (m *ECSDetailModel) updateViewportContent() {
 func (m *ECSDetailModel) ensureSelectedVisible() {
 	// Calculate approximate line position of current selection
 	// Each section has: 1 title line + 1 margin + rows + 3 border lines (top padding, bottom padding, margin)
+	// When zoomed, the focused section is the only one rendered
 	linePos := 0
-	for i := 0; i < m.currentSection; i++ {
+	for i := 0; i < m.currentSection && !m.zoomed; i++ {
 		// Title (1) + margin (1) + border top (1) + padding (1) + rows + padding (1) + border bottom (1) + margin (1)
 		linePos += 1 + 1 + 1 + 1 + len(m.sections[i].Rows) + 1 + 1 + 1
 	}
@@ -246,9 +371,9 @@ func (m *ECSDetailModel) ensureSelectedVisible() {
 	viewportTop := m.viewport.YOffset
 	viewportBottom := viewportTop + m.viewport.Height - 1
 
-	// Scroll if needed
-	if linePos < viewportTop {
-		m.viewport.SetYOffset(linePos)
+	// Scroll if needed, leaving the first line for the sticky section title
+	if linePos-1 < viewportTop {
+		m.viewport.SetYOffset(linePos - 1)
 	} else if linePos > viewportBottom {
 		m.viewport.SetYOffset(linePos - m.viewport.Height + 1)
 	}
@@ -279,6 +404,31 @@ func (m ECSDetailModel) Update(msg tea.Msg) (ECSDetailModel, tea.Cmd) {
 		case key.Matches(msg, m.keys.PrevSection):
 			m = m.prevSection()
 			needsUpdate = true
+		case key.Matches(msg, m.keys.Zoom):
+			m.zoomed = !m.zoomed
+			m.viewport.GotoTop()
+			needsUpdate = true
+		case key.Matches(msg, m.keys.Metrics):
+			instance := m.instance
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageECSMetrics, Data: instance}
+			}
+		case key.Matches(msg, m.keys.RolePolicy):
+			if m.ramRole == "" {
+				return m, nil
+			}
+			roleName := m.ramRole
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageRAMRolePolicies, Data: roleName}
+			}
+		case key.Matches(msg, m.keys.CopyConsole), key.Matches(msg, m.keys.OpenConsole):
+			request := ECSConsoleRequestMsg{
+				InstanceID: m.instance.InstanceId,
+				RegionID:   m.instance.RegionId,
+				IsWindows:  strings.EqualFold(m.instance.OSType, "windows"),
+				Open:       key.Matches(msg, m.keys.OpenConsole),
+			}
+			return m, func() tea.Msg { return request }
 		case key.Matches(msg, m.keys.PageUp):
 			m.viewport, cmd = m.viewport.Update(msg)
 			return m, cmd
@@ -323,6 +473,17 @@ func (m ECSDetailModel) Update(msg tea.Msg) (ECSDetailModel, tea.Cmd) {
 			m.viewport, cmd = m.viewport.Update(msg)
 			return m, cmd
 		}
+	case tea.MouseMsg:
+		if !components.IsClick(msg) {
+			// Such as the wheel, which scrolls
+			m.viewport, cmd = m.viewport.Update(msg)
+			return m, cmd
+		}
+		// A click focuses the row under it
+		if section, row, ok := sectionRowAt(m.sections, m.anchors, m.currentSection, m.zoomed, m.viewport.YOffset+msg.Y); ok {
+			m.currentSection, m.currentRow = section, row
+			needsUpdate = true
+		}
 	default:
 		// Delegate other messages to viewport
 		m.viewport, cmd = m.viewport.Update(msg)
@@ -390,25 +551,27 @@ func (m ECSDetailModel) View() string {
 	if len(m.sections) == 0 {
 		return i18n.T(i18n.KeyActionLoading)
 	}
-	return m.viewport.View()
+	stickyStyle := lipgloss.NewStyle().Bold(true).Foreground(theme.Current().Primary)
+	return pinSectionTitle(m.viewport.View(), m.viewport.YOffset, m.anchors, stickyStyle)
 }
 
 // renderSection renders a single section
 func (m ECSDetailModel) renderSection(section DetailSection, sectionIdx int, isFocused bool) string {
-	// Section title style - purple when focused
+	th := theme.Current()
+	// Section title style - primary color when focused
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(subtleTextColor).
+		Foreground(th.SubtleText).
 		MarginBottom(1)
 
 	if isFocused {
-		titleStyle = titleStyle.Foreground(primaryColor)
+		titleStyle = titleStyle.Foreground(th.Primary)
 	}
 
-	// Section border style - purple when focused
-	borderFg := borderColor
+	// Section border style - primary color when focused
+	borderFg := th.Border
 	if isFocused {
-		borderFg = primaryColor
+		borderFg = th.Primary
 	}
 
 	// Calculate inner width
@@ -432,7 +595,11 @@ func (m ECSDetailModel) renderSection(section DetailSection, sectionIdx int, isF
 	}
 
 	content := lipgloss.JoinVertical(lipgloss.Left, rows...)
-	titleRendered := titleStyle.Render(section.Title)
+	title := section.Title
+	if m.zoomed {
+		title += lipgloss.NewStyle().Foreground(th.MutedText).Render(fmt.Sprintf("  [%d/%d] %s", sectionIdx+1, len(m.sections), i18n.T(i18n.KeySectionZoomHint)))
+	}
+	titleRendered := titleStyle.Render(title)
 	boxContent := sectionBorderStyle.Render(content)
 
 	return lipgloss.JoinVertical(lipgloss.Left, titleRendered, boxContent, "")
@@ -440,6 +607,7 @@ func (m ECSDetailModel) renderSection(section DetailSection, sectionIdx int, isF
 
 // renderRow renders a single row
 func (m ECSDetailModel) renderRow(row DetailRow, isSelected bool) string {
+	th := theme.Current()
 	// Calculate row width (account for borders and padding)
 	rowWidth := m.width - 12
 	if rowWidth < 40 {
@@ -447,16 +615,16 @@ func (m ECSDetailModel) renderRow(row DetailRow, isSelected bool) string {
 	}
 
 	if isSelected {
-		// Selected row style: purple background, white text, bold
+		// Selected row style: primary background, bold
 		selectedStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FFFFFF")).
-			Background(primaryColor).
+			Foreground(th.OnPrimary).
+			Background(th.Primary).
 			Bold(true)
 
 		labelStyle := selectedStyle.Width(18)
 		valueStyle := selectedStyle
 
-		// For status, keep the indicator but use white text
+		// For status, keep the indicator but use the selected text color
 		value := row.Value
 		if row.Label == i18n.T(i18n.KeyLabelInstanceStatus) {
 			switch value {
@@ -475,9 +643,9 @@ func (m ECSDetailModel) renderRow(row DetailRow, isSelected bool) string {
 			valueStyle.Render(value),
 		)
 
-		// Ensure the entire row has purple background
+		// Ensure the entire row has the primary background
 		rowStyle := lipgloss.NewStyle().
-			Background(primaryColor).
+			Background(th.Primary).
 			Width(rowWidth)
 
 		return rowStyle.Render(rowContent)
@@ -485,11 +653,11 @@ func (m ECSDetailModel) renderRow(row DetailRow, isSelected bool) string {
 
 	// Normal row style
 	labelStyle := lipgloss.NewStyle().
-		Foreground(subtleTextColor).
+		Foreground(th.SubtleText).
 		Width(18)
 
 	valueStyle := lipgloss.NewStyle().
-		Foreground(textColor)
+		Foreground(th.Text)
 
 	// Style for status values
 	value := row.Value
@@ -499,11 +667,11 @@ func (m ECSDetailModel) renderRow(row DetailRow, isSelected bool) string {
 	if row.Label == i18n.T(i18n.KeyLabelInstanceStatus) {
 		switch value {
 		case "Running":
-			styledValue = lipgloss.NewStyle().Foreground(successColor).Bold(true).Render("● " + value)
+			styledValue = lipgloss.NewStyle().Foreground(th.Success).Bold(true).Render("● " + value)
 		case "Stopped":
-			styledValue = lipgloss.NewStyle().Foreground(errorColor).Bold(true).Render("● " + value)
+			styledValue = lipgloss.NewStyle().Foreground(th.Error).Bold(true).Render("● " + value)
 		default:
-			styledValue = lipgloss.NewStyle().Foreground(warningColor).Bold(true).Render("● " + value)
+			styledValue = lipgloss.NewStyle().Foreground(th.Warning).Bold(true).Render("● " + value)
 		}
 	}
 
@@ -517,6 +685,24 @@ func (m ECSDetailModel) renderRow(row DetailRow, isSelected bool) string {
 }
 
 // Helper functions
+// getSecurityGroups lists the instance's security groups by name once they
+// are resolved, and falls back to a count until then
+func (m ECSDetailModel) getSecurityGroups() string {
+	ids := m.instance.SecurityGroupIds.SecurityGroupId
+	labels := make([]string, 0, len(ids))
+	for _, id := range ids {
+		name, ok := m.names.Name(service.KindSecurityGroup, id)
+		if !ok {
+			return fmt.Sprintf(i18n.T(i18n.KeyCountSG), len(ids))
+		}
+		labels = append(labels, fmt.Sprintf("%s (%s)", name, id))
+	}
+	if len(labels) == 0 {
+		return fmt.Sprintf(i18n.T(i18n.KeyCountSG), 0)
+	}
+	return strings.Join(labels, ", ")
+}
+
 func (m ECSDetailModel) formatValue(value string) string {
 	if value == "" {
 		return "-"
@@ -524,6 +710,35 @@ func (m ECSDetailModel) formatValue(value string) string {
 	return value
 }
 
+// formatSpotStrategy formats the bidding strategy of a spot instance
+func (m ECSDetailModel) formatSpotStrategy(strategy string) string {
+	switch strategy {
+	case "SpotAsPriceGo":
+		return i18n.T(i18n.KeySpotAsPriceGo)
+	case "SpotWithPriceLimit":
+		return i18n.T(i18n.KeySpotWithPriceLimit)
+	default:
+		return m.formatValue(strategy)
+	}
+}
+
+// formatSpotPriceLimit formats the hourly price limit, which is only set for
+// the SpotWithPriceLimit strategy
+func (m ECSDetailModel) formatSpotPriceLimit(inst ecs.Instance) string {
+	if inst.SpotStrategy != "SpotWithPriceLimit" || inst.SpotPriceLimit <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%g / hour", inst.SpotPriceLimit)
+}
+
+// formatSpotDuration formats the protection period of a spot instance
+func (m ECSDetailModel) formatSpotDuration(hours int) string {
+	if hours <= 0 {
+		return i18n.T(i18n.KeySpotNoProtection)
+	}
+	return fmt.Sprintf("%d h", hours)
+}
+
 func (m ECSDetailModel) formatChargeType(chargeType string) string {
 	switch chargeType {
 	case "PrePaid":
@@ -620,3 +835,12 @@ func (m ECSDetailModel) NextSearchMatch() ECSDetailModel {
 func (m ECSDetailModel) PrevSearchMatch() ECSDetailModel {
 	return m
 }
+
+// ECSConsoleRequestMsg asks the app to create the management terminal URL of
+// an instance and copy it to the clipboard, or open it when Open is set
+type ECSConsoleRequestMsg struct {
+	InstanceID string
+	RegionID   string
+	IsWindows  bool
+	Open       bool
+}
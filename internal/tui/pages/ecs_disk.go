@@ -11,21 +11,11 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 
 	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/theme"
 	"aliyun-tui-viewer/internal/tui/components"
 	"aliyun-tui-viewer/internal/tui/types"
 )
 
-// Colors for the disk page
-var (
-	diskPrimaryColor    = lipgloss.Color("#7C3AED") // Purple
-	diskSecondaryColor  = lipgloss.Color("#06B6D4") // Cyan
-	diskSuccessColor    = lipgloss.Color("#10B981") // Green
-	diskWarningColor    = lipgloss.Color("#F59E0B") // Amber
-	diskTextColor       = lipgloss.Color("#E5E7EB") // Light gray
-	diskSubtleTextColor = lipgloss.Color("#9CA3AF") // Gray
-	diskBorderColor     = lipgloss.Color("#374151") // Dark border
-)
-
 // ECSDiskModel represents the ECS disk/storage page
 type ECSDiskModel struct {
 	table      components.TableModel
@@ -59,7 +49,7 @@ func NewECSDiskModel(instanceId string) ECSDiskModel {
 		{Title: i18n.T(i18n.KeyColDiskAttribute), Width: 8},
 		{Title: i18n.T(i18n.KeyColStatus), Width: 8},
 		{Title: i18n.T(i18n.KeyColType), Width: 22},
-		{Title: i18n.T(i18n.KeyColSize), Width: 12},
+		{Title: i18n.T(i18n.KeyColSize), Width: 24},
 		{Title: i18n.T(i18n.KeyColDiskIOPS), Width: 10},
 		{Title: i18n.T(i18n.KeyColDiskDeleteBehavior), Width: 14},
 		{Title: i18n.T(i18n.KeyLabelChargeType), Width: 12},
@@ -67,7 +57,7 @@ func NewECSDiskModel(instanceId string) ECSDiskModel {
 	}
 
 	return ECSDiskModel{
-		table:      components.NewTableModel(columns, i18n.T(i18n.KeyPageECSDisks)),
+		table:      components.NewTableModel(columns, i18n.T(i18n.KeyPageECSDisks)).SetBarColumns(5),
 		instanceId: instanceId,
 		keys:       DefaultECSDiskKeyMap(),
 	}
@@ -188,6 +178,13 @@ func (m ECSDiskModel) Update(msg tea.Msg) (ECSDiskModel, tea.Cmd) {
 				}
 			}
 		}
+
+	case tea.MouseMsg:
+		// The table is below the overview and a blank line
+		msg.Y -= lipgloss.Height(m.renderOverviewSection()) + 1
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		return m, cmd
 	}
 
 	var cmd tea.Cmd
@@ -209,19 +206,20 @@ func (m ECSDiskModel) View() string {
 
 // renderOverviewSection renders the usage overview section
 func (m ECSDiskModel) renderOverviewSection() string {
+	th := theme.Current()
 	// Title style
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(diskPrimaryColor).
+		Foreground(th.Primary).
 		MarginBottom(1)
 
 	// Label style
 	labelStyle := lipgloss.NewStyle().
-		Foreground(diskSubtleTextColor)
+		Foreground(th.SubtleText)
 
 	// Value style
 	valueStyle := lipgloss.NewStyle().
-		Foreground(diskSecondaryColor).
+		Foreground(th.Secondary).
 		Bold(true)
 
 	// Calculate total and system/data disk counts
@@ -266,7 +264,7 @@ func (m ECSDiskModel) renderOverviewSection() string {
 
 	sectionStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(diskBorderColor).
+		BorderForeground(th.Border).
 		Padding(1, 2).
 		Width(innerWidth)
 
@@ -293,7 +291,7 @@ func (m ECSDiskModel) formatDiskCategory(category string) string {
 	case "cloud_essd_entry":
 		return i18n.T(i18n.KeyDiskCloudEssdEntry)
 	case "ephemeral_ssd":
-		return "Local SSD"
+		return i18n.T(i18n.KeyDiskLocalSSD)
 	default:
 		return category
 	}
@@ -327,6 +325,12 @@ func (m ECSDiskModel) Search(query string) ECSDiskModel {
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m ECSDiskModel) Filter(query string) ECSDiskModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m ECSDiskModel) NextSearchMatch() ECSDiskModel {
 	m.table = m.table.NextSearchMatch()
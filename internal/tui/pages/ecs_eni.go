@@ -11,6 +11,7 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 
 	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
 	"aliyun-tui-viewer/internal/tui/components"
 	"aliyun-tui-viewer/internal/tui/types"
 )
@@ -19,6 +20,7 @@ import (
 type ECSENIModel struct {
 	table      components.TableModel
 	enis       []ecs.NetworkInterfaceSet
+	names      *service.NameResolver
 	instanceId string
 	width      int
 	height     int
@@ -91,9 +93,9 @@ func (m ECSENIModel) SetData(enis []ecs.NetworkInterfaceSet) ECSENIModel {
 		}
 
 		// VPC / VSwitch
-		vpcInfo := eni.VpcId
+		vpcInfo := m.names.Label(service.KindVPC, eni.VpcId)
 		if eni.VSwitchId != "" {
-			vpcInfo = eni.VSwitchId
+			vpcInfo = m.names.Label(service.KindVSwitch, eni.VSwitchId)
 		}
 		if vpcInfo == "" {
 			vpcInfo = "-"
@@ -112,10 +114,7 @@ func (m ECSENIModel) SetData(enis []ecs.NetworkInterfaceSet) ECSENIModel {
 		}
 
 		// Creation time
-		creationTime := eni.CreationTime
-		if creationTime == "" {
-			creationTime = "-"
-		}
+		creationTime := components.FormatTimeString(eni.CreationTime)
 
 		rows[i] = table.Row{
 			eni.NetworkInterfaceId,
@@ -136,6 +135,25 @@ func (m ECSENIModel) SetData(enis []ecs.NetworkInterfaceSet) ECSENIModel {
 	return m
 }
 
+// SetNames sets the resolver used to show VPC and vSwitch names, re-rendering the rows
+func (m ECSENIModel) SetNames(names *service.NameResolver) ECSENIModel {
+	m.names = names
+	cursor := m.table.SelectedRow()
+	m = m.SetData(m.enis)
+	m.table = m.table.SetCursor(cursor)
+	return m
+}
+
+// NameRefs returns the IDs referenced by the rows
+func (m ECSENIModel) NameRefs() service.NameRefs {
+	refs := make(service.NameRefs)
+	for _, eni := range m.enis {
+		refs.Add(service.KindVPC, eni.VpcId)
+		refs.Add(service.KindVSwitch, eni.VSwitchId)
+	}
+	return refs
+}
+
 // SetSize sets the size
 func (m ECSENIModel) SetSize(width, height int) ECSENIModel {
 	m.width = width
@@ -236,6 +254,12 @@ func (m ECSENIModel) Search(query string) ECSENIModel {
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m ECSENIModel) Filter(query string) ECSENIModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m ECSENIModel) NextSearchMatch() ECSENIModel {
 	m.table = m.table.NextSearchMatch()
@@ -0,0 +1,172 @@
+package pages
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// ElasticsearchListModel represents the Elasticsearch instances list page
+type ElasticsearchListModel struct {
+	table      components.TableModel
+	instances  []service.ElasticsearchInstance
+	width      int
+	height     int
+	keys       ElasticsearchListKeyMap
+	showRegion bool // Region column, when listing all regions
+}
+
+// ElasticsearchListKeyMap defines key bindings
+type ElasticsearchListKeyMap struct {
+	Enter key.Binding
+}
+
+// DefaultElasticsearchListKeyMap returns default key bindings
+func DefaultElasticsearchListKeyMap() ElasticsearchListKeyMap {
+	return ElasticsearchListKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "details"),
+		),
+	}
+}
+
+// NewElasticsearchListModel creates a new Elasticsearch list model
+func NewElasticsearchListModel() ElasticsearchListModel {
+	columns := []table.Column{
+		{Title: "Instance ID", Width: 24},
+		{Title: "Name", Width: 25},
+		{Title: "Version", Width: 16},
+		{Title: "Nodes", Width: 6},
+		{Title: "Spec", Width: 26},
+		{Title: "Endpoint", Width: 60},
+		{Title: "Status", Width: 10},
+	}
+
+	return ElasticsearchListModel{
+		table: components.NewTableModel(columns, "Elasticsearch Instances"),
+		keys:  DefaultElasticsearchListKeyMap(),
+	}
+}
+
+// SetData sets the Elasticsearch instances data. The row data is the
+// endpoint, so yy copies it ready to connect; the detail has the JSON
+func (m ElasticsearchListModel) SetData(instances []service.ElasticsearchInstance) ElasticsearchListModel {
+	m.instances = instances
+
+	rows := make([]table.Row, len(instances))
+	rowData := make([]interface{}, len(instances))
+
+	for i, inst := range instances {
+		endpoint := inst.Endpoint()
+		rows[i] = table.Row{
+			inst.InstanceId,
+			valueOrDash(inst.Description),
+			valueOrDash(inst.EsVersion),
+			fmt.Sprintf("%d", inst.NodeAmount),
+			valueOrDash(inst.NodeSpec.Spec),
+			valueOrDash(endpoint),
+			inst.Status,
+		}
+		if m.showRegion {
+			rows[i] = append(rows[i], inst.RegionId)
+		}
+		if endpoint != "" {
+			rowData[i] = components.TextContent(endpoint)
+		} else {
+			rowData[i] = inst
+		}
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetShowRegion adds a Region column, used when listing all regions
+func (m ElasticsearchListModel) SetShowRegion(show bool) ElasticsearchListModel {
+	if show && !m.showRegion {
+		m.table = m.table.SetColumns(append(m.table.Columns(), regionColumn()))
+	}
+	m.showRegion = show
+	return m
+}
+
+// SetSize sets the size
+func (m ElasticsearchListModel) SetSize(width, height int) ElasticsearchListModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedInstance returns the selected instance
+func (m ElasticsearchListModel) SelectedInstance() *service.ElasticsearchInstance {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.instances) {
+		return &m.instances[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m ElasticsearchListModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m ElasticsearchListModel) Update(msg tea.Msg) (ElasticsearchListModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.Enter):
+			if inst := m.SelectedInstance(); inst != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageElasticsearchDetail,
+						Data: *inst,
+					}
+				}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m ElasticsearchListModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m ElasticsearchListModel) Search(query string) ElasticsearchListModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m ElasticsearchListModel) Filter(query string) ElasticsearchListModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m ElasticsearchListModel) NextSearchMatch() ElasticsearchListModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m ElasticsearchListModel) PrevSearchMatch() ElasticsearchListModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
@@ -0,0 +1,279 @@
+package pages
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// ElasticsearchDetailModel is the formatted detail page of an Elasticsearch
+// cluster. The list already describes every cluster, so nothing loads after
+// the page opens besides the names of referenced resources
+type ElasticsearchDetailModel struct {
+	instance service.ElasticsearchInstance
+	names    *service.NameResolver // Resolves referenced IDs to names, may be nil
+	view     SectionDetailModel
+	keys     ElasticsearchDetailKeyMap
+}
+
+// ElasticsearchDetailKeyMap defines the Elasticsearch detail keys besides the
+// navigation
+type ElasticsearchDetailKeyMap struct {
+	JSON key.Binding
+}
+
+// DefaultElasticsearchDetailKeyMap returns default key bindings
+func DefaultElasticsearchDetailKeyMap() ElasticsearchDetailKeyMap {
+	return ElasticsearchDetailKeyMap{
+		JSON: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "JSON"),
+		),
+	}
+}
+
+// NewElasticsearchDetailModel creates a new Elasticsearch detail model
+func NewElasticsearchDetailModel(instance service.ElasticsearchInstance) ElasticsearchDetailModel {
+	m := ElasticsearchDetailModel{
+		instance: instance,
+		view:     NewSectionDetailModel(),
+		keys:     DefaultElasticsearchDetailKeyMap(),
+	}
+	return m.rebuild()
+}
+
+// NewElasticsearchDetailModelFromInterface creates a new Elasticsearch detail
+// model from interface{}, and false when data is not an Elasticsearch instance
+func NewElasticsearchDetailModelFromInterface(data interface{}) (ElasticsearchDetailModel, bool) {
+	if instance, ok := data.(service.ElasticsearchInstance); ok {
+		return NewElasticsearchDetailModel(instance), true
+	}
+	return ElasticsearchDetailModel{}, false
+}
+
+// InstanceID returns the ID of the shown instance
+func (m ElasticsearchDetailModel) InstanceID() string {
+	return m.instance.InstanceId
+}
+
+// NameRefs returns the IDs referenced by the instance
+func (m ElasticsearchDetailModel) NameRefs() service.NameRefs {
+	refs := make(service.NameRefs)
+	refs.Add(service.KindVPC, m.instance.NetworkConfig.VpcId)
+	refs.Add(service.KindVSwitch, m.instance.NetworkConfig.VswitchId)
+	refs.Add(service.KindResourceGroup, m.instance.ResourceGroupId)
+	return refs
+}
+
+// SetNames sets the resolver used to show the names of referenced resources
+func (m ElasticsearchDetailModel) SetNames(names *service.NameResolver) ElasticsearchDetailModel {
+	m.names = names
+	if m.instance.InstanceId == "" {
+		return m
+	}
+	return m.rebuild()
+}
+
+// rebuild renders the sections from the current data
+func (m ElasticsearchDetailModel) rebuild() ElasticsearchDetailModel {
+	inst := m.instance
+
+	basicInfo := DetailSection{
+		Title: i18n.T(i18n.KeySectionBasicInfo),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelInstanceID), Value: inst.InstanceId},
+			{Label: i18n.T(i18n.KeyLabelInstanceName), Value: valueOrDash(inst.Description)},
+			{Label: i18n.T(i18n.KeyLabelInstanceStatus), Value: valueOrDash(inst.Status), Status: true},
+			{Label: i18n.T(i18n.KeyLabelEngineVersion), Value: valueOrDash(inst.EsVersion)},
+			{Label: i18n.T(i18n.KeyLabelEdition), Value: valueOrDash(inst.InstanceCategory)},
+			{Label: i18n.T(i18n.KeyColRegion), Value: valueOrDash(inst.RegionId)},
+			{Label: i18n.T(i18n.KeyLabelAvailZone), Value: m.formatZones()},
+			{Label: i18n.T(i18n.KeyColCreatedAt), Value: components.FormatTimeString(inst.CreatedAt)},
+		},
+	}
+
+	endpoints := DetailSection{Title: i18n.T(i18n.KeySectionEndpoints)}
+	for _, endpoint := range []struct{ label, value string }{
+		{"Elasticsearch", inst.Endpoint()},
+		{"Elasticsearch (Internet)", inst.PublicEndpoint()},
+		{"Kibana", inst.KibanaEndpoint()},
+	} {
+		if endpoint.value != "" {
+			endpoints.Rows = append(endpoints.Rows, DetailRow{Label: endpoint.label, Value: endpoint.value})
+		}
+	}
+	if len(endpoints.Rows) == 0 {
+		endpoints.Rows = []DetailRow{{Label: endpoints.Title, Value: "-"}}
+	}
+
+	spec := DetailSection{
+		Title: i18n.T(i18n.KeySectionSpec),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelDataNodes), Value: formatElasticsearchNodes(inst.NodeAmount, inst.NodeSpec.Spec, inst.NodeSpec.Disk, inst.NodeSpec.DiskType)},
+			{Label: i18n.T(i18n.KeyLabelMasterNodes), Value: m.formatMasterNodes()},
+			{Label: i18n.T(i18n.KeyLabelKibanaNodes), Value: m.formatKibanaNodes()},
+		},
+	}
+
+	network := DetailSection{
+		Title: i18n.T(i18n.KeySectionNetwork),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelNetworkType), Value: valueOrDash(inst.NetworkConfig.Type)},
+			{Label: i18n.T(i18n.KeyLabelVPC), Value: valueOrDash(m.names.Label(service.KindVPC, inst.NetworkConfig.VpcId))},
+			{Label: i18n.T(i18n.KeyLabelVSwitch), Value: valueOrDash(m.names.Label(service.KindVSwitch, inst.NetworkConfig.VswitchId))},
+		},
+	}
+
+	whitelist := DetailSection{
+		Title: i18n.T(i18n.KeySectionWhitelist),
+		Rows: []DetailRow{
+			{Label: "Elasticsearch", Value: summarizeIPList(strings.Join(inst.EsIPWhitelist, ","))},
+			{Label: "Elasticsearch (Internet)", Value: summarizeIPList(strings.Join(inst.PublicIpWhitelist, ","))},
+			{Label: "Kibana", Value: summarizeIPList(strings.Join(inst.KibanaIPWhitelist, ","))},
+		},
+	}
+
+	billing := DetailSection{
+		Title: i18n.T(i18n.KeySectionBilling),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelChargeType), Value: m.formatChargeType()},
+			{Label: i18n.T(i18n.KeyLabelExpireTime), Value: m.formatExpiry()},
+		},
+	}
+
+	groupInfo := DetailSection{
+		Title: i18n.T(i18n.KeySectionGroupInfo),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelResourceGroup), Value: valueOrDash(m.names.Label(service.KindResourceGroup, inst.ResourceGroupId))},
+			{Label: i18n.T(i18n.KeyLabelTags), Value: m.formatTags()},
+		},
+	}
+
+	m.view = m.view.SetSections([]DetailSection{basicInfo, endpoints, spec, network, whitelist, billing, groupInfo})
+	return m
+}
+
+// formatElasticsearchNodes formats a node group, e.g.
+// "3 × elasticsearch.sn2ne.large, 100 GB cloud_ssd"
+func formatElasticsearchNodes(amount int, spec string, diskGB int, diskType string) string {
+	if amount == 0 || spec == "" {
+		return "-"
+	}
+	value := fmt.Sprintf("%d × %s", amount, spec)
+	if diskGB > 0 {
+		value += fmt.Sprintf(", %d GB %s", diskGB, diskType)
+	}
+	return strings.TrimSpace(value)
+}
+
+// formatMasterNodes shows the dedicated master nodes, which small clusters
+// go without
+func (m ElasticsearchDetailModel) formatMasterNodes() string {
+	if !m.instance.DedicateMaster {
+		return "-"
+	}
+	master := m.instance.MasterConfiguration
+	return formatElasticsearchNodes(master.Amount, master.Spec, master.Disk, master.DiskType)
+}
+
+// formatKibanaNodes shows the Kibana nodes
+func (m ElasticsearchDetailModel) formatKibanaNodes() string {
+	kibana := m.instance.KibanaConfiguration
+	return formatElasticsearchNodes(kibana.Amount, kibana.Spec, 0, "")
+}
+
+// formatZones lists the zones the cluster is deployed in
+func (m ElasticsearchDetailModel) formatZones() string {
+	if len(m.instance.ZoneInfos) == 0 {
+		return valueOrDash(m.instance.NetworkConfig.VsArea)
+	}
+	zones := make([]string, len(m.instance.ZoneInfos))
+	for i, zone := range m.instance.ZoneInfos {
+		zones[i] = zone.ZoneId
+	}
+	return strings.Join(zones, ", ")
+}
+
+// formatChargeType shows the billing method in the wording of the ECS detail
+func (m ElasticsearchDetailModel) formatChargeType() string {
+	switch m.instance.PaymentType {
+	case "prepaid":
+		return i18n.T(i18n.KeyChargePrePaid)
+	case "postpaid":
+		return i18n.T(i18n.KeyChargePostPaid)
+	default:
+		return valueOrDash(m.instance.PaymentType)
+	}
+}
+
+// formatExpiry shows when a subscription instance expires
+func (m ElasticsearchDetailModel) formatExpiry() string {
+	if m.instance.PaymentType != "prepaid" {
+		return "-"
+	}
+	return formatUnixMillis(m.instance.Endtime)
+}
+
+// formatTags formats the instance tags as "key: value" pairs
+func (m ElasticsearchDetailModel) formatTags() string {
+	if len(m.instance.Tags) == 0 {
+		return "-"
+	}
+	tags := make([]string, len(m.instance.Tags))
+	for i, tag := range m.instance.Tags {
+		tags[i] = fmt.Sprintf("%s: %s", tag.TagKey, tag.TagValue)
+	}
+	return strings.Join(tags, ", ")
+}
+
+// SetSize sets the size of the detail view
+func (m ElasticsearchDetailModel) SetSize(width, height int) ElasticsearchDetailModel {
+	m.view = m.view.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m ElasticsearchDetailModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m ElasticsearchDetailModel) Update(msg tea.Msg) (ElasticsearchDetailModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.JSON) {
+		instance := m.instance
+		return m, func() tea.Msg {
+			return types.NavigateMsg{Page: types.PageElasticsearchJSONDetail, Data: instance}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.view, cmd = m.view.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m ElasticsearchDetailModel) View() string {
+	return m.view.View()
+}
+
+// Search placeholder for interface compatibility
+func (m ElasticsearchDetailModel) Search(query string) ElasticsearchDetailModel {
+	return m
+}
+
+// NextSearchMatch placeholder
+func (m ElasticsearchDetailModel) NextSearchMatch() ElasticsearchDetailModel {
+	return m
+}
+
+// PrevSearchMatch placeholder
+func (m ElasticsearchDetailModel) PrevSearchMatch() ElasticsearchDetailModel {
+	return m
+}
@@ -0,0 +1,325 @@
+package pages
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// fcEnvNamesShown is how many environment variable names the functions table
+// lists before eliding the rest
+const fcEnvNamesShown = 3
+
+// fcTime formats an FC timestamp, given in RFC 3339, "-" when it is not set
+func fcTime(value string) string {
+	return components.FormatTimeString(value)
+}
+
+// fcEnvSummary summarizes the environment variables of a function by count
+// and names, leaving the values, which often hold secrets, out of the table
+func fcEnvSummary(fn service.FCFunction) string {
+	names := fn.EnvNames()
+	if len(names) == 0 {
+		return "-"
+	}
+	if len(names) > fcEnvNamesShown {
+		names = append(names[:fcEnvNamesShown], "…")
+	}
+	return fmt.Sprintf("%d: %s", len(fn.EnvironmentVariables), strings.Join(names, ", "))
+}
+
+// FCKeyMap defines the key bindings shared by the FC pages
+type FCKeyMap struct {
+	Enter key.Binding
+}
+
+// DefaultFCKeyMap returns default key bindings, with help for what enter opens
+func DefaultFCKeyMap(enterHelp string) FCKeyMap {
+	return FCKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", enterHelp),
+		),
+	}
+}
+
+// FCServicesModel lists the Function Compute services of the region
+type FCServicesModel struct {
+	table    components.TableModel
+	services []service.FCServiceInfo
+	width    int
+	height   int
+	keys     FCKeyMap
+}
+
+// NewFCServicesModel creates a new FC services model
+func NewFCServicesModel() FCServicesModel {
+	columns := []table.Column{
+		{Title: "Service", Width: 30},
+		{Title: "Description", Width: 30},
+		{Title: "Internet", Width: 9},
+		{Title: "VPC", Width: 24},
+		{Title: "Log Store", Width: 36},
+		{Title: "Role", Width: 40},
+		{Title: "Modified", Width: 20},
+	}
+
+	return FCServicesModel{
+		table: components.NewTableModel(columns, "FC Services"),
+		keys:  DefaultFCKeyMap("functions"),
+	}
+}
+
+// SetData sets the services data
+func (m FCServicesModel) SetData(services []service.FCServiceInfo) FCServicesModel {
+	m.services = services
+
+	rows := make([]table.Row, len(services))
+	rowData := make([]interface{}, len(services))
+
+	for i, svc := range services {
+		logStore := "-"
+		if svc.LogConfig.Project != "" {
+			logStore = svc.LogConfig.Project + "/" + svc.LogConfig.Logstore
+		}
+		rows[i] = table.Row{
+			svc.ServiceName,
+			valueOrDash(svc.Description),
+			fmt.Sprintf("%t", svc.InternetAccess),
+			valueOrDash(svc.VpcConfig.VpcID),
+			logStore,
+			valueOrDash(svc.Role),
+			fcTime(svc.LastModifiedTime),
+		}
+		rowData[i] = svc
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m FCServicesModel) SetSize(width, height int) FCServicesModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedService returns the selected service
+func (m FCServicesModel) SelectedService() *service.FCServiceInfo {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.services) {
+		return &m.services[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m FCServicesModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m FCServicesModel) Update(msg tea.Msg) (FCServicesModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		if svc := m.SelectedService(); svc != nil {
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageFCFunctions, Data: *svc}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m FCServicesModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m FCServicesModel) Search(query string) FCServicesModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m FCServicesModel) Filter(query string) FCServicesModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m FCServicesModel) NextSearchMatch() FCServicesModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m FCServicesModel) PrevSearchMatch() FCServicesModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// FCFunctionsModel lists the functions of an FC service with their recent
+// invocation counts, which load after the functions
+type FCFunctionsModel struct {
+	table     components.TableModel
+	functions []service.FCFunction
+	service   service.FCServiceInfo
+	stats     map[string]service.FCInvocationStats
+	statsErr  error // Set when the invocation counts could not be loaded
+	width     int
+	height    int
+	keys      FCKeyMap
+}
+
+// NewFCFunctionsModel creates a new functions model
+func NewFCFunctionsModel(svc service.FCServiceInfo) FCFunctionsModel {
+	columns := []table.Column{
+		{Title: "Function", Width: 30},
+		{Title: "Runtime", Width: 16},
+		{Title: "Memory", Width: 9},
+		{Title: "Timeout", Width: 9},
+		{Title: "Concurrency", Width: 11},
+		{Title: "Env", Width: 40},
+		{Title: "Invocations 24h", Width: 15},
+		{Title: "Errors 24h", Width: 11},
+		{Title: "Modified", Width: 20},
+	}
+
+	return FCFunctionsModel{
+		table:   components.NewTableModel(columns, fmt.Sprintf("Functions of FC Service: %s", svc.ServiceName)).SetBarColumns(6),
+		service: svc,
+		keys:    DefaultFCKeyMap("detail"),
+	}
+}
+
+// ServiceName returns the service whose functions are listed
+func (m FCFunctionsModel) ServiceName() string {
+	return m.service.ServiceName
+}
+
+// SetData sets the functions data
+func (m FCFunctionsModel) SetData(functions []service.FCFunction) FCFunctionsModel {
+	m.functions = functions
+
+	rows := make([]table.Row, len(functions))
+	rowData := make([]interface{}, len(functions))
+
+	for i, fn := range functions {
+		invocations, errors := "-", "-"
+		if m.statsErr != nil {
+			invocations, errors = "n/a", "n/a"
+		} else if m.stats != nil {
+			stats := m.stats[fn.FunctionName]
+			invocations = fmt.Sprintf("%d", stats.Invocations)
+			errors = fmt.Sprintf("%d", stats.Errors())
+		}
+
+		rows[i] = table.Row{
+			fn.FunctionName,
+			valueOrDash(fn.Runtime),
+			fmt.Sprintf("%d MB", fn.MemorySize),
+			fmt.Sprintf("%ds", fn.Timeout),
+			fmt.Sprintf("%d", fn.InstanceConcurrency),
+			fcEnvSummary(fn),
+			invocations,
+			errors,
+			fcTime(fn.LastModifiedTime),
+		}
+		rowData[i] = fn
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetStats sets the invocation counts of the functions and re-renders the
+// rows. err is set when they could not be loaded
+func (m FCFunctionsModel) SetStats(stats map[string]service.FCInvocationStats, err error) FCFunctionsModel {
+	m.stats = stats
+	m.statsErr = err
+	cursor := m.table.SelectedRow()
+	m = m.SetData(m.functions)
+	m.table = m.table.SetCursor(cursor)
+	return m
+}
+
+// SetSize sets the size
+func (m FCFunctionsModel) SetSize(width, height int) FCFunctionsModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedFunction returns the selected function
+func (m FCFunctionsModel) SelectedFunction() *service.FCFunction {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.functions) {
+		return &m.functions[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m FCFunctionsModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m FCFunctionsModel) Update(msg tea.Msg) (FCFunctionsModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		if fn := m.SelectedFunction(); fn != nil {
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageFCFunctionDetail, Data: *fn}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m FCFunctionsModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m FCFunctionsModel) Search(query string) FCFunctionsModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m FCFunctionsModel) Filter(query string) FCFunctionsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m FCFunctionsModel) NextSearchMatch() FCFunctionsModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m FCFunctionsModel) PrevSearchMatch() FCFunctionsModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
@@ -13,6 +13,7 @@ import (
 
 	"aliyun-tui-viewer/internal/i18n"
 	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/theme"
 	"aliyun-tui-viewer/internal/tui/components"
 	"aliyun-tui-viewer/internal/tui/types"
 )
@@ -30,6 +31,10 @@ type FinderSection struct {
 // FinderModel represents the resource finder results page
 type FinderModel struct {
 	result         *service.FindResult
+	query          string                      // Query of a search across profiles
+	profileResults []service.ProfileFindResult // Results per profile of a search across profiles
+	currentProfile string                      // Profile whose resources open in their detail views
+	headerLines    int                         // Lines above the first section
 	sections       []FinderSection
 	viewport       viewport.Model // Scrollable viewport
 	currentSection int
@@ -37,8 +42,11 @@ type FinderModel struct {
 	width          int
 	height         int
 	keys           FinderKeyMap
+	zoomed         bool // Only the focused section is rendered
+	anchors        []sectionAnchor // Title lines of rendered sections, for the sticky header
 	yankLastTime   time.Time
 	yankCount      int
+	clicks         components.ClickTracker
 	styles         FinderStyles
 }
 
@@ -57,36 +65,37 @@ type FinderStyles struct {
 
 // DefaultFinderStyles returns default finder styles matching the table component
 func DefaultFinderStyles() FinderStyles {
+	th := theme.Current()
 	return FinderStyles{
 		Header: lipgloss.NewStyle().
 			Bold(true).
-			Foreground(lipgloss.Color("#F59E0B")).
+			Foreground(th.Accent).
 			Padding(0, 1),
 		Cell: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#E5E7EB")).
+			Foreground(th.Text).
 			Padding(0, 1),
 		Selected: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FFFFFF")).
-			Background(lipgloss.Color("#7C3AED")).
+			Foreground(th.OnPrimary).
+			Background(th.Primary).
 			Bold(true).
 			Padding(0, 1),
 		Border: lipgloss.NewStyle().
 			BorderStyle(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("#374151")),
+			BorderForeground(th.Border),
 		FocusedBorder: lipgloss.NewStyle().
 			BorderStyle(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("#7C3AED")),
+			BorderForeground(th.Primary),
 		Title: lipgloss.NewStyle().
 			Bold(true).
-			Foreground(lipgloss.Color("#7C3AED")),
+			Foreground(th.Primary),
 		SectionTitle: lipgloss.NewStyle().
 			Bold(true).
-			Foreground(lipgloss.Color("#7C3AED")).
+			Foreground(th.Primary).
 			MarginBottom(1),
 		Separator: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#374151")),
+			Foreground(th.Border),
 		Empty: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#6B7280")).
+			Foreground(th.MutedText).
 			Italic(true).
 			Padding(0, 1),
 	}
@@ -104,6 +113,8 @@ type FinderKeyMap struct {
 	Bottom      key.Binding
 	Enter       key.Binding
 	Yank        key.Binding
+	Zoom        key.Binding
+	AllProfiles key.Binding
 }
 
 // DefaultFinderKeyMap returns default key bindings
@@ -149,6 +160,14 @@ func DefaultFinderKeyMap() FinderKeyMap {
 			key.WithKeys("y"),
 			key.WithHelp("yy", "copy"),
 		),
+		Zoom: key.NewBinding(
+			key.WithKeys("z"),
+			key.WithHelp("z", "zoom section"),
+		),
+		AllProfiles: key.NewBinding(
+			key.WithKeys("A"),
+			key.WithHelp("A", "search all profiles"),
+		),
 	}
 }
 
@@ -165,6 +184,24 @@ func NewFinderModel(result *service.FindResult) FinderModel {
 	return m
 }
 
+// NewProfilesFinderModel creates a finder model for a query run in several
+// profiles. Only the sections with matches are shown, prefixed with their
+// profile; resources of profiles other than currentProfile open as JSON
+// because the detail views query the current profile
+func NewProfilesFinderModel(query string, results []service.ProfileFindResult, currentProfile string) FinderModel {
+	m := FinderModel{
+		query:          query,
+		profileResults: results,
+		currentProfile: currentProfile,
+		keys:           DefaultFinderKeyMap(),
+		styles:         DefaultFinderStyles(),
+		viewport:       viewport.New(80, 20), // Initial size, will be updated by SetSize
+	}
+	m.buildProfileSections()
+	m.updateViewportContent()
+	return m
+}
+
 // buildSections builds the display sections from the result - always shows all resource types
 func (m *FinderModel) buildSections() {
 	m.sections = nil
@@ -172,15 +209,70 @@ func (m *FinderModel) buildSections() {
 	if m.result == nil {
 		return
 	}
+	m.sections = resultSections(m.result)
+}
+
+// buildProfileSections builds the sections with matches of every profile,
+// and an error section for each profile that could not be searched
+func (m *FinderModel) buildProfileSections() {
+	m.sections = nil
+
+	for _, found := range m.profileResults {
+		prefix := fmt.Sprintf("[%s %s] ", found.Profile, found.RegionID)
+		if found.Err != nil {
+			m.sections = append(m.sections, FinderSection{
+				Title:     prefix + i18n.T(i18n.KeyFinderProfileFailed),
+				Columns:   []string{i18n.T(i18n.KeyColErrorMessage)},
+				ColWidths: []int{100},
+				Rows:      [][]string{{found.Err.Error()}},
+			})
+			continue
+		}
+
+		for _, section := range resultSections(found.Result) {
+			if len(section.Rows) == 0 {
+				continue
+			}
+			section.Title = prefix + section.Title
+			if found.Profile != m.currentProfile {
+				section.PageType = types.PageECSJSONDetail
+			}
+			m.sections = append(m.sections, section)
+		}
+	}
+}
+
+// finderMatchReasons are the labels of the reasons a section matched
+var finderMatchReasons = map[string]string{
+	service.FindMatchIP:      i18n.KeyFinderMatchIP,
+	service.FindMatchAddress: i18n.KeyFinderMatchAddress,
+	service.FindMatchID:      i18n.KeyFinderMatchID,
+	service.FindMatchName:    i18n.KeyFinderMatchName,
+	service.FindMatchTag:     i18n.KeyFinderMatchTag,
+}
+
+// finderSectionTitle titles a section with its count and, when it has
+// matches, why they were found, e.g. "ECS Instances (2) · matched by tag"
+func finderSectionTitle(key string, count int, reason string) string {
+	title := fmt.Sprintf("%s (%d)", i18n.T(key), count)
+	if label, ok := finderMatchReasons[reason]; ok && count > 0 {
+		title += " · " + fmt.Sprintf(i18n.T(i18n.KeyFinderMatchedBy), i18n.T(label))
+	}
+	return title
+}
+
+// resultSections builds one section per resource type of a result
+func resultSections(result *service.FindResult) []FinderSection {
+	var sections []FinderSection
 
 	// ECS Instances Section - always show
 	ecsSection := FinderSection{
-		Title:     fmt.Sprintf("%s (%d)", i18n.T(i18n.KeyFinderECS), len(m.result.ECSInstances)),
+		Title:     finderSectionTitle(i18n.KeyFinderECS, len(result.ECSInstances), result.Reasons["ECS"]),
 		Columns:   []string{i18n.T(i18n.KeyColInstanceID), i18n.T(i18n.KeyColName), i18n.T(i18n.KeyColPublicIP), i18n.T(i18n.KeyColPrivateIP), i18n.T(i18n.KeyColStatus)},
 		ColWidths: []int{24, 20, 16, 16, 10},
 		PageType:  types.PageECSDetail,
 	}
-	for _, inst := range m.result.ECSInstances {
+	for _, inst := range result.ECSInstances {
 		publicIP := "-"
 		if len(inst.PublicIpAddress.IpAddress) > 0 {
 			publicIP = inst.PublicIpAddress.IpAddress[0]
@@ -200,16 +292,16 @@ func (m *FinderModel) buildSections() {
 		})
 		ecsSection.Data = append(ecsSection.Data, inst)
 	}
-	m.sections = append(m.sections, ecsSection)
+	sections = append(sections, ecsSection)
 
 	// ENI Section - always show
 	eniSection := FinderSection{
-		Title:     fmt.Sprintf("%s (%d)", i18n.T(i18n.KeyFinderENI), len(m.result.ENIs)),
+		Title:     finderSectionTitle(i18n.KeyFinderENI, len(result.ENIs), result.Reasons["ENI"]),
 		Columns:   []string{i18n.T(i18n.KeyColENIID), i18n.T(i18n.KeyColPrivateIP), i18n.T(i18n.KeyColType), i18n.T(i18n.KeyColStatus), i18n.T(i18n.KeyColAttachedInst)},
 		ColWidths: []int{24, 16, 10, 10, 24},
 		PageType:  types.PageECSJSONDetail,
 	}
-	for _, eni := range m.result.ENIs {
+	for _, eni := range result.ENIs {
 		eniType := i18n.T(i18n.KeyENISecondary)
 		if eni.Type == "Primary" {
 			eniType = i18n.T(i18n.KeyENIPrimary)
@@ -223,16 +315,16 @@ func (m *FinderModel) buildSections() {
 		})
 		eniSection.Data = append(eniSection.Data, eni)
 	}
-	m.sections = append(m.sections, eniSection)
+	sections = append(sections, eniSection)
 
 	// SLB Section - always show
 	slbSection := FinderSection{
-		Title:     fmt.Sprintf("%s (%d)", i18n.T(i18n.KeyFinderSLB), len(m.result.SLBInstances)),
+		Title:     finderSectionTitle(i18n.KeyFinderSLB, len(result.SLBInstances), result.Reasons["SLB"]),
 		Columns:   []string{i18n.T(i18n.KeyColSLBID), i18n.T(i18n.KeyColName), i18n.T(i18n.KeyColAddress), i18n.T(i18n.KeyColType), i18n.T(i18n.KeyColStatus)},
 		ColWidths: []int{24, 20, 16, 16, 10},
 		PageType:  types.PageSLBDetail,
 	}
-	for _, lb := range m.result.SLBInstances {
+	for _, lb := range result.SLBInstances {
 		slbSection.Rows = append(slbSection.Rows, []string{
 			lb.LoadBalancerId,
 			lb.LoadBalancerName,
@@ -242,16 +334,16 @@ func (m *FinderModel) buildSections() {
 		})
 		slbSection.Data = append(slbSection.Data, lb)
 	}
-	m.sections = append(m.sections, slbSection)
+	sections = append(sections, slbSection)
 
 	// DNS Records Section - always show
 	dnsSection := FinderSection{
-		Title:     fmt.Sprintf("%s (%d)", i18n.T(i18n.KeyFinderDNS), len(m.result.DNSRecords)),
+		Title:     finderSectionTitle(i18n.KeyFinderDNS, len(result.DNSRecords), result.Reasons["DNS"]),
 		Columns:   []string{i18n.T(i18n.KeyColDomain), i18n.T(i18n.KeyColRR), i18n.T(i18n.KeyColType), i18n.T(i18n.KeyColRecordValue), i18n.T(i18n.KeyColTTL)},
 		ColWidths: []int{24, 20, 8, 20, 8},
 		PageType:  types.PageECSJSONDetail,
 	}
-	for _, match := range m.result.DNSRecords {
+	for _, match := range result.DNSRecords {
 		dnsSection.Rows = append(dnsSection.Rows, []string{
 			match.DomainName,
 			match.Record.RR,
@@ -261,16 +353,16 @@ func (m *FinderModel) buildSections() {
 		})
 		dnsSection.Data = append(dnsSection.Data, match.Record)
 	}
-	m.sections = append(m.sections, dnsSection)
+	sections = append(sections, dnsSection)
 
 	// RDS Section - always show
 	rdsSection := FinderSection{
-		Title:     fmt.Sprintf("%s (%d)", i18n.T(i18n.KeyFinderRDS), len(m.result.RDSInstances)),
+		Title:     finderSectionTitle(i18n.KeyFinderRDS, len(result.RDSInstances), result.Reasons["RDS"]),
 		Columns:   []string{i18n.T(i18n.KeyColInstanceID), i18n.T(i18n.KeyColDescription), i18n.T(i18n.KeyColEngine), i18n.T(i18n.KeyColConnString), i18n.T(i18n.KeyColConnString), i18n.T(i18n.KeyColStatus)},
 		ColWidths: []int{24, 16, 12, 30, 30, 10},
 		PageType:  types.PageRDSDetail,
 	}
-	for _, detail := range m.result.RDSInstances {
+	for _, detail := range result.RDSInstances {
 		internalAddr := detail.InternalConnectionStr
 		if internalAddr == "" {
 			internalAddr = "-"
@@ -289,16 +381,16 @@ func (m *FinderModel) buildSections() {
 		})
 		rdsSection.Data = append(rdsSection.Data, detail.Instance)
 	}
-	m.sections = append(m.sections, rdsSection)
+	sections = append(sections, rdsSection)
 
 	// Redis Section - always show
 	redisSection := FinderSection{
-		Title:     fmt.Sprintf("%s (%d)", i18n.T(i18n.KeyFinderRedis), len(m.result.RedisInstances)),
+		Title:     finderSectionTitle(i18n.KeyFinderRedis, len(result.RedisInstances), result.Reasons["Redis"]),
 		Columns:   []string{i18n.T(i18n.KeyColInstanceID), i18n.T(i18n.KeyColName), i18n.T(i18n.KeyColConnDomain), i18n.T(i18n.KeyColPrivateIP), i18n.T(i18n.KeyColStatus)},
 		ColWidths: []int{24, 20, 30, 16, 10},
 		PageType:  types.PageRedisDetail,
 	}
-	for _, inst := range m.result.RedisInstances {
+	for _, inst := range result.RedisInstances {
 		redisSection.Rows = append(redisSection.Rows, []string{
 			inst.InstanceId,
 			inst.InstanceName,
@@ -308,16 +400,16 @@ func (m *FinderModel) buildSections() {
 		})
 		redisSection.Data = append(redisSection.Data, inst)
 	}
-	m.sections = append(m.sections, redisSection)
+	sections = append(sections, redisSection)
 
 	// RocketMQ Section - always show
 	rocketmqSection := FinderSection{
-		Title:     fmt.Sprintf("%s (%d)", i18n.T(i18n.KeyFinderRocketMQ), len(m.result.RocketMQInstances)),
+		Title:     finderSectionTitle(i18n.KeyFinderRocketMQ, len(result.RocketMQInstances), result.Reasons["RocketMQ"]),
 		Columns:   []string{i18n.T(i18n.KeyColInstanceID), i18n.T(i18n.KeyColName), i18n.T(i18n.KeyColStatus)},
 		ColWidths: []int{30, 30, 12},
 		PageType:  types.PageRocketMQDetail,
 	}
-	for _, inst := range m.result.RocketMQInstances {
+	for _, inst := range result.RocketMQInstances {
 		status := i18n.T(i18n.KeyStatusUnknown)
 		switch inst.InstanceStatus {
 		case 0:
@@ -334,7 +426,54 @@ func (m *FinderModel) buildSections() {
 		})
 		rocketmqSection.Data = append(rocketmqSection.Data, inst)
 	}
-	m.sections = append(m.sections, rocketmqSection)
+	sections = append(sections, rocketmqSection)
+
+	// EIP Section - always show
+	eipSection := FinderSection{
+		Title:     finderSectionTitle(i18n.KeyFinderEIP, len(result.EIPs), result.Reasons["EIP"]),
+		Columns:   []string{i18n.T(i18n.KeyColAllocationID), i18n.T(i18n.KeyColPublicIP), i18n.T(i18n.KeyColBandwidth), i18n.T(i18n.KeyColBoundTo), i18n.T(i18n.KeyColStatus)},
+		ColWidths: []int{24, 16, 16, 30, 10},
+		PageType:  types.PageECSJSONDetail,
+	}
+	for _, eip := range result.EIPs {
+		bandwidth := eip.Bandwidth + "M"
+		if eip.BandwidthPackageId != "" {
+			bandwidth += " " + eip.BandwidthPackageId
+		}
+		boundTo := "-"
+		if eip.InstanceId != "" {
+			boundTo = fmt.Sprintf("%s %s", eip.InstanceType, eip.InstanceId)
+		}
+		eipSection.Rows = append(eipSection.Rows, []string{
+			eip.AllocationId,
+			eip.IpAddress,
+			bandwidth,
+			boundTo,
+			eip.Status,
+		})
+		eipSection.Data = append(eipSection.Data, eip)
+	}
+	sections = append(sections, eipSection)
+
+	// NAT Gateway Section - always show
+	natSection := FinderSection{
+		Title:     finderSectionTitle(i18n.KeyFinderNAT, len(result.NATGateways), result.Reasons["NAT"]),
+		Columns:   []string{i18n.T(i18n.KeyColNATGatewayID), i18n.T(i18n.KeyColName), i18n.T(i18n.KeyColPublicIP), i18n.T(i18n.KeyColVPC), i18n.T(i18n.KeyColStatus)},
+		ColWidths: []int{24, 20, 30, 24, 10},
+		PageType:  types.PageNATDetail,
+	}
+	for _, gw := range result.NATGateways {
+		natSection.Rows = append(natSection.Rows, []string{
+			gw.NatGatewayId,
+			gw.Name,
+			valueOrDash(strings.Join(NATPublicIPs(gw), ", ")),
+			gw.VpcId,
+			gw.Status,
+		})
+		natSection.Data = append(natSection.Data, gw)
+	}
+	sections = append(sections, natSection)
+	return sections
 }
 
 // SetSize sets the size of the finder view
@@ -349,22 +488,50 @@ func (m FinderModel) SetSize(width, height int) FinderModel {
 
 // updateViewportContent renders all content and sets it to viewport
 func (m *FinderModel) updateViewportContent() {
-	if m.result == nil {
+	if m.result == nil && m.profileResults == nil {
 		return
 	}
 
 	var b strings.Builder
 
 	// Header
-	query := m.result.Query
-	if len(m.result.ResolvedIPs) > 0 {
-		query = fmt.Sprintf("%s → %s", m.result.Query, strings.Join(m.result.ResolvedIPs, ", "))
+	summaryStyle := lipgloss.NewStyle().Foreground(theme.Current().SubtleText).Bold(true)
+	if m.profileResults != nil {
+		b.WriteString(m.styles.Title.Render(fmt.Sprintf("%s: %s", i18n.T(i18n.KeyFinderResult), m.query)))
+		b.WriteString("\n")
+		total := 0
+		var unmatched []string
+		for _, found := range m.profileResults {
+			if found.Err != nil {
+				continue
+			}
+			total += found.Result.TotalCount()
+			if !found.Result.HasResults() {
+				unmatched = append(unmatched, found.Profile)
+			}
+		}
+		b.WriteString(summaryStyle.Render(fmt.Sprintf(i18n.T(i18n.KeyFinderProfilesTotal), total, len(m.profileResults))))
+		b.WriteString("\n")
+		if len(unmatched) > 0 {
+			b.WriteString(m.styles.Empty.UnsetPadding().Render(fmt.Sprintf(i18n.T(i18n.KeyFinderProfilesNoMatch), strings.Join(unmatched, ", "))))
+			b.WriteString("\n")
+		}
+	} else {
+		query := m.result.Query
+		if len(m.result.ResolvedIPs) > 0 {
+			query = fmt.Sprintf("%s → %s", m.result.Query, strings.Join(m.result.ResolvedIPs, ", "))
+		}
+		b.WriteString(m.styles.Title.Render(fmt.Sprintf("%s: %s", i18n.T(i18n.KeyFinderResult), query)))
+		b.WriteString("\n")
+		b.WriteString(summaryStyle.Render(fmt.Sprintf(i18n.T(i18n.KeyFinderTotalMatches), m.result.TotalCount())))
+		b.WriteString("\n")
+		if len(m.result.Denied) > 0 {
+			b.WriteString(m.styles.Empty.UnsetPadding().Render(fmt.Sprintf(i18n.T(i18n.KeyFinderDenied), strings.Join(m.result.Denied, ", "))))
+			b.WriteString("\n")
+		}
 	}
-	b.WriteString(m.styles.Title.Render(fmt.Sprintf("%s: %s", i18n.T(i18n.KeyFinderResult), query)))
 	b.WriteString("\n")
-	b.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("#9CA3AF")).Bold(true).
-		Render(fmt.Sprintf(i18n.T(i18n.KeyFinderTotalMatches), m.result.TotalCount())))
-	b.WriteString("\n\n")
+	m.headerLines = strings.Count(b.String(), "\n")
 
 	// Calculate section width
 	sectionWidth := m.width - 4
@@ -373,7 +540,11 @@ func (m *FinderModel) updateViewportContent() {
 	}
 
 	// Render each section
+	m.anchors = nil
 	for i, section := range m.sections {
+		if m.zoomed && i != m.currentSection {
+			continue
+		}
 		isFocused := i == m.currentSection
 
 		// Render section content
@@ -387,6 +558,8 @@ func (m *FinderModel) updateViewportContent() {
 			bordered = m.styles.Border.Width(sectionWidth).Render(sectionContent)
 		}
 
+		// Section title sits right below the top border
+		m.anchors = append(m.anchors, sectionAnchor{line: strings.Count(b.String(), "\n") + 1, title: section.Title})
 		b.WriteString(bordered)
 		b.WriteString("\n")
 	}
@@ -396,11 +569,13 @@ func (m *FinderModel) updateViewportContent() {
 
 // ensureSelectedVisible adjusts viewport scroll to keep selected row visible
 func (m *FinderModel) ensureSelectedVisible() {
-	// Calculate approximate line position of current selection
-	// Header: 3 lines (title + count + empty line)
-	linePos := 3
+	// Calculate approximate line position of current selection, starting
+	// below the header (title + count + empty line, and the profiles
+	// without matches in a search across profiles)
+	linePos := m.headerLines
 
-	for i := 0; i < m.currentSection; i++ {
+	// When zoomed, the focused section is the only one rendered
+	for i := 0; i < m.currentSection && !m.zoomed; i++ {
 		section := m.sections[i]
 		// Each section has: border top (1) + title (1) + header (1) + separator (1) + rows + border bottom (1) + margin (1)
 		rowCount := len(section.Rows)
@@ -419,14 +594,33 @@ func (m *FinderModel) ensureSelectedVisible() {
 	viewportTop := m.viewport.YOffset
 	viewportBottom := viewportTop + m.viewport.Height - 1
 
-	// Scroll if needed
-	if linePos < viewportTop {
-		m.viewport.SetYOffset(linePos)
+	// Scroll if needed, leaving the first line for the sticky section title
+	if linePos-1 < viewportTop {
+		m.viewport.SetYOffset(linePos - 1)
 	} else if linePos > viewportBottom {
 		m.viewport.SetYOffset(linePos - m.viewport.Height + 1)
 	}
 }
 
+// rowAt returns the section and row at a line of the content, found from
+// the anchors of the section titles; when zoomed, the current section is the
+// only one. The rows start below the title, the header and its separator
+func (m FinderModel) rowAt(line int) (int, int, bool) {
+	for i, anchor := range m.anchors {
+		section := i
+		if m.zoomed {
+			section = m.currentSection
+		}
+		if section >= len(m.sections) {
+			break
+		}
+		if row := line - anchor.line - 3; row >= 0 && row < len(m.sections[section].Rows) {
+			return section, row, true
+		}
+	}
+	return 0, 0, false
+}
+
 // Init implements tea.Model
 func (m FinderModel) Init() tea.Cmd {
 	return nil
@@ -452,6 +646,10 @@ func (m FinderModel) Update(msg tea.Msg) (FinderModel, tea.Cmd) {
 		case key.Matches(msg, m.keys.PrevSection):
 			m = m.prevSection()
 			needsUpdate = true
+		case key.Matches(msg, m.keys.Zoom):
+			m.zoomed = !m.zoomed
+			m.viewport.GotoTop()
+			needsUpdate = true
 		case key.Matches(msg, m.keys.PageUp):
 			m.viewport, cmd = m.viewport.Update(msg)
 			return m, cmd
@@ -476,6 +674,14 @@ func (m FinderModel) Update(msg tea.Msg) (FinderModel, tea.Cmd) {
 			needsUpdate = true
 		case key.Matches(msg, m.keys.Enter):
 			return m, m.handleEnter()
+		case key.Matches(msg, m.keys.AllProfiles):
+			query := m.query
+			if m.result != nil {
+				query = m.result.Query
+			}
+			return m, func() tea.Msg {
+				return FinderAllProfilesMsg{Query: query}
+			}
 		case key.Matches(msg, m.keys.Yank):
 			// Handle double-y for yank
 			now := time.Now()
@@ -504,6 +710,23 @@ func (m FinderModel) Update(msg tea.Msg) (FinderModel, tea.Cmd) {
 			m.viewport, cmd = m.viewport.Update(msg)
 			return m, cmd
 		}
+	case tea.MouseMsg:
+		if !components.IsClick(msg) {
+			// Such as the wheel, which scrolls
+			m.viewport, cmd = m.viewport.Update(msg)
+			return m, cmd
+		}
+		// A click focuses the row under it, a double click opens it
+		line := m.viewport.YOffset + msg.Y
+		section, row, ok := m.rowAt(line)
+		if !ok {
+			return m, nil
+		}
+		m.currentSection, m.currentRow = section, row
+		if m.clicks.Click(line) {
+			return m, m.handleEnter()
+		}
+		needsUpdate = true
 	default:
 		// Delegate other messages to viewport
 		m.viewport, cmd = m.viewport.Update(msg)
@@ -603,21 +826,28 @@ func (m FinderModel) prevSection() FinderModel {
 
 // View implements tea.Model
 func (m FinderModel) View() string {
-	if m.result == nil {
+	if m.result == nil && m.profileResults == nil {
 		return i18n.T(i18n.KeyFinderNoMatch)
 	}
-	return m.viewport.View()
+	stickyStyle := m.styles.SectionTitle.UnsetMarginBottom()
+	return pinSectionTitle(m.viewport.View(), m.viewport.YOffset, m.anchors, stickyStyle)
 }
 
 // renderSection renders a single section's table content
 func (m FinderModel) renderSection(section FinderSection, isFocused bool, width int) string {
+	th := theme.Current()
 	var b strings.Builder
 
 	// Section title
 	if isFocused {
-		b.WriteString(m.styles.SectionTitle.Render(section.Title))
+		title := section.Title
+		if m.zoomed {
+			title += lipgloss.NewStyle().Foreground(th.MutedText).Bold(false).
+				Render(fmt.Sprintf("  [%d/%d] %s", m.currentSection+1, len(m.sections), i18n.T(i18n.KeySectionZoomHint)))
+		}
+		b.WriteString(m.styles.SectionTitle.Render(title))
 	} else {
-		b.WriteString(lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#9CA3AF")).Render(section.Title))
+		b.WriteString(lipgloss.NewStyle().Bold(true).Foreground(th.SubtleText).Render(section.Title))
 	}
 	b.WriteString("\n")
 
@@ -711,3 +941,9 @@ func (m FinderModel) NextSearchMatch() FinderModel {
 func (m FinderModel) PrevSearchMatch() FinderModel {
 	return m
 }
+
+// FinderAllProfilesMsg asks the app to run the finder query again in every
+// configured profile
+type FinderAllProfilesMsg struct {
+	Query string
+}
@@ -0,0 +1,225 @@
+package pages
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/jobs"
+	"aliyun-tui-viewer/internal/tui/components"
+)
+
+// JobsModel represents the background jobs page
+type JobsModel struct {
+	table  components.TableModel
+	jobs   []jobs.Job
+	width  int
+	height int
+	keys   JobsKeyMap
+}
+
+// JobsKeyMap defines key bindings for the jobs page
+type JobsKeyMap struct {
+	Cancel key.Binding
+	Clear  key.Binding
+}
+
+// DefaultJobsKeyMap returns default key bindings
+func DefaultJobsKeyMap() JobsKeyMap {
+	return JobsKeyMap{
+		Cancel: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "cancel job"),
+		),
+		Clear: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "clear finished"),
+		),
+	}
+}
+
+// NewJobsModel creates a new jobs page model
+func NewJobsModel() JobsModel {
+	columns := []table.Column{
+		{Title: "#", Width: 4},
+		{Title: i18n.T(i18n.KeyColJob), Width: 48},
+		{Title: i18n.T(i18n.KeyColStatus), Width: 10},
+		{Title: i18n.T(i18n.KeyColProgress), Width: 28},
+		{Title: i18n.T(i18n.KeyColElapsed), Width: 10},
+		{Title: i18n.T(i18n.KeyColJobError), Width: 40},
+	}
+
+	return JobsModel{
+		table: components.NewTableModel(columns, i18n.T(i18n.KeyPageJobs)),
+		keys:  DefaultJobsKeyMap(),
+	}
+}
+
+// SetData sets the job snapshots, keeping the selection on the same job
+func (m JobsModel) SetData(list []jobs.Job) JobsModel {
+	selectedID := 0
+	if job := m.SelectedJob(); job != nil {
+		selectedID = job.ID
+	}
+	m.jobs = list
+
+	rows := make([]table.Row, len(list))
+	rowData := make([]interface{}, len(list))
+	cursor := 0
+
+	for i, job := range list {
+		result := "-"
+		if job.Err != nil {
+			result = job.Err.Error()
+		}
+
+		rows[i] = table.Row{
+			fmt.Sprintf("%d", job.ID),
+			job.Title,
+			formatJobStatus(job.Status),
+			formatJobProgress(job),
+			job.Elapsed().Round(time.Second).String(),
+			result,
+		}
+		rowData[i] = job
+		if job.ID == selectedID {
+			cursor = i
+		}
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetCursor(cursor)
+	return m
+}
+
+// SetSize sets the size
+func (m JobsModel) SetSize(width, height int) JobsModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedJob returns the selected job
+func (m JobsModel) SelectedJob() *jobs.Job {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.jobs) {
+		return &m.jobs[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m JobsModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m JobsModel) Update(msg tea.Msg) (JobsModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.Cancel):
+			if job := m.SelectedJob(); job != nil && job.Status == jobs.StatusRunning {
+				id := job.ID
+				return m, func() tea.Msg {
+					return JobCancelRequestMsg{ID: id}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Clear):
+			return m, func() tea.Msg {
+				return JobsClearRequestMsg{}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m JobsModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m JobsModel) Search(query string) JobsModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m JobsModel) Filter(query string) JobsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m JobsModel) NextSearchMatch() JobsModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m JobsModel) PrevSearchMatch() JobsModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// FormatJobStatusLine summarises running jobs for the mode line.
+// It returns an empty string when nothing is running
+func FormatJobStatusLine(running []jobs.Job) string {
+	switch len(running) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf("%s: %s", running[0].Title, formatJobProgress(running[0]))
+	default:
+		return fmt.Sprintf(i18n.T(i18n.KeyJobsRunning), len(running))
+	}
+}
+
+func formatJobStatus(status jobs.Status) string {
+	switch status {
+	case jobs.StatusRunning:
+		return i18n.T(i18n.KeyJobStatusRunning)
+	case jobs.StatusDone:
+		return i18n.T(i18n.KeyJobStatusDone)
+	case jobs.StatusFailed:
+		return i18n.T(i18n.KeyJobStatusFailed)
+	case jobs.StatusCancelled:
+		return i18n.T(i18n.KeyJobStatusCancelled)
+	default:
+		return status.String()
+	}
+}
+
+func formatJobProgress(job jobs.Job) string {
+	if job.Total <= 0 && job.Done == 0 {
+		return "-"
+	}
+
+	done, total := fmt.Sprintf("%d", job.Done), fmt.Sprintf("%d", job.Total)
+	if job.Unit == jobs.UnitBytes {
+		done, total = FormatSize(job.Done), FormatSize(job.Total)
+	}
+	if percent := job.Percent(); percent >= 0 {
+		return fmt.Sprintf("%s / %s (%d%%)", done, total, percent)
+	}
+	return done
+}
+
+// JobCancelRequestMsg asks the app to cancel a running job
+type JobCancelRequestMsg struct {
+	ID int
+}
+
+// JobsClearRequestMsg asks the app to forget finished jobs
+type JobsClearRequestMsg struct{}
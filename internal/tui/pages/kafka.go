@@ -0,0 +1,458 @@
+package pages
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
+
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// kafkaInstanceStatus names the service status code of an instance
+func kafkaInstanceStatus(status int) string {
+	switch status {
+	case 0:
+		return "Pending"
+	case 1:
+		return "Deploying"
+	case 5:
+		return "Running"
+	case 15:
+		return "Expired"
+	default:
+		return fmt.Sprintf("%d", status)
+	}
+}
+
+// kafkaSpecType names the edition of an instance
+func kafkaSpecType(specType string) string {
+	switch specType {
+	case "normal":
+		return "Standard"
+	case "professional":
+		return "Professional"
+	case "professionalForHighRead":
+		return "Professional (High Read)"
+	default:
+		return valueOrDash(specType)
+	}
+}
+
+// kafkaDiskType names the disk type code of an instance
+func kafkaDiskType(diskType int) string {
+	switch diskType {
+	case 0:
+		return "Ultra Disk"
+	case 1:
+		return "SSD"
+	default:
+		return fmt.Sprintf("%d", diskType)
+	}
+}
+
+// KafkaListModel represents the Kafka instances list page
+type KafkaListModel struct {
+	table      components.TableModel
+	instances  []alikafka.InstanceVO
+	width      int
+	height     int
+	keys       KafkaListKeyMap
+	showRegion bool // Region column, when listing all regions
+}
+
+// KafkaListKeyMap defines key bindings
+type KafkaListKeyMap struct {
+	Enter  key.Binding
+	Topics key.Binding
+	Groups key.Binding
+}
+
+// DefaultKafkaListKeyMap returns default key bindings
+func DefaultKafkaListKeyMap() KafkaListKeyMap {
+	return KafkaListKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "details"),
+		),
+		Topics: key.NewBinding(
+			key.WithKeys("T"),
+			key.WithHelp("T", "topics"),
+		),
+		Groups: key.NewBinding(
+			key.WithKeys("G"),
+			key.WithHelp("G", "consumer groups"),
+		),
+	}
+}
+
+// NewKafkaListModel creates a new Kafka list model
+func NewKafkaListModel() KafkaListModel {
+	columns := []table.Column{
+		{Title: "Instance ID", Width: 28},
+		{Title: "Name", Width: 28},
+		{Title: "Edition", Width: 14},
+		{Title: "Status", Width: 10},
+		{Title: "Traffic Spec", Width: 14},
+		{Title: "Topics", Width: 10},
+		{Title: "Disk (GB)", Width: 9},
+		{Title: "Zone", Width: 16},
+	}
+
+	return KafkaListModel{
+		table: components.NewTableModel(columns, "Kafka Instances"),
+		keys:  DefaultKafkaListKeyMap(),
+	}
+}
+
+// SetData sets the Kafka instances data
+func (m KafkaListModel) SetData(instances []alikafka.InstanceVO) KafkaListModel {
+	m.instances = instances
+
+	rows := make([]table.Row, len(instances))
+	rowData := make([]interface{}, len(instances))
+
+	for i, inst := range instances {
+		rows[i] = table.Row{
+			inst.InstanceId,
+			valueOrDash(inst.Name),
+			kafkaSpecType(inst.SpecType),
+			kafkaInstanceStatus(inst.ServiceStatus),
+			valueOrDash(inst.IoMaxSpec),
+			fmt.Sprintf("%d/%d", inst.UsedTopicCount, inst.TopicNumLimit),
+			fmt.Sprintf("%d", inst.DiskSize),
+			valueOrDash(inst.ZoneId),
+		}
+		if m.showRegion {
+			rows[i] = append(rows[i], inst.RegionId)
+		}
+		rowData[i] = inst
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetShowRegion adds a Region column, used when listing all regions
+func (m KafkaListModel) SetShowRegion(show bool) KafkaListModel {
+	if show && !m.showRegion {
+		m.table = m.table.SetColumns(append(m.table.Columns(), regionColumn()))
+	}
+	m.showRegion = show
+	return m
+}
+
+// SetSize sets the size
+func (m KafkaListModel) SetSize(width, height int) KafkaListModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedInstance returns the selected instance
+func (m KafkaListModel) SelectedInstance() *alikafka.InstanceVO {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.instances) {
+		return &m.instances[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m KafkaListModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m KafkaListModel) Update(msg tea.Msg) (KafkaListModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.Enter):
+			if inst := m.SelectedInstance(); inst != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageKafkaDetail,
+						Data: *inst,
+					}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Topics):
+			if inst := m.SelectedInstance(); inst != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageKafkaTopics,
+						Data: inst.InstanceId,
+					}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Groups):
+			if inst := m.SelectedInstance(); inst != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageKafkaGroups,
+						Data: inst.InstanceId,
+					}
+				}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m KafkaListModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m KafkaListModel) Search(query string) KafkaListModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m KafkaListModel) Filter(query string) KafkaListModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m KafkaListModel) NextSearchMatch() KafkaListModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m KafkaListModel) PrevSearchMatch() KafkaListModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// KafkaTopicsModel represents the Kafka topics page
+type KafkaTopicsModel struct {
+	table      components.TableModel
+	topics     []alikafka.TopicVO
+	instanceId string
+	width      int
+	height     int
+}
+
+// NewKafkaTopicsModel creates a new Kafka topics model
+func NewKafkaTopicsModel() KafkaTopicsModel {
+	columns := []table.Column{
+		{Title: "Topic Name", Width: 40},
+		{Title: "Partitions", Width: 10},
+		{Title: "Storage", Width: 10},
+		{Title: "Status", Width: 12},
+		{Title: "Created", Width: 20},
+		{Title: "Remark", Width: 40},
+	}
+
+	return KafkaTopicsModel{
+		table: components.NewTableModel(columns, "Kafka Topics"),
+	}
+}
+
+// SetData sets the topics data
+func (m KafkaTopicsModel) SetData(topics []alikafka.TopicVO, instanceId string) KafkaTopicsModel {
+	m.topics = topics
+	m.instanceId = instanceId
+
+	rows := make([]table.Row, len(topics))
+	rowData := make([]interface{}, len(topics))
+
+	for i, topic := range topics {
+		// Local storage keeps messages on the brokers, cloud storage on disks
+		storage := "Cloud"
+		if topic.LocalTopic {
+			storage = "Local"
+		}
+		if topic.CompactTopic {
+			storage += ", compact"
+		}
+
+		rows[i] = table.Row{
+			topic.Topic,
+			fmt.Sprintf("%d", topic.PartitionNum),
+			storage,
+			valueOrDash(topic.StatusName),
+			formatUnixMillis(topic.CreateTime),
+			valueOrDash(topic.Remark),
+		}
+		rowData[i] = topic
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("Topics for Kafka: %s", instanceId))
+	return m
+}
+
+// SetSize sets the size
+func (m KafkaTopicsModel) SetSize(width, height int) KafkaTopicsModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m KafkaTopicsModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m KafkaTopicsModel) Update(msg tea.Msg) (KafkaTopicsModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m KafkaTopicsModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m KafkaTopicsModel) Search(query string) KafkaTopicsModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m KafkaTopicsModel) Filter(query string) KafkaTopicsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m KafkaTopicsModel) NextSearchMatch() KafkaTopicsModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m KafkaTopicsModel) PrevSearchMatch() KafkaTopicsModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// KafkaGroupsModel represents the Kafka consumer groups page
+type KafkaGroupsModel struct {
+	table      components.TableModel
+	groups     []service.KafkaConsumerGroup
+	instanceId string
+	width      int
+	height     int
+}
+
+// NewKafkaGroupsModel creates a new Kafka consumer groups model
+func NewKafkaGroupsModel() KafkaGroupsModel {
+	columns := []table.Column{
+		{Title: "Group ID", Width: 40},
+		{Title: "Lag", Width: 12},
+		{Title: "Last Consumed", Width: 20},
+		{Title: "Topics", Width: 40},
+		{Title: "Remark", Width: 30},
+	}
+
+	return KafkaGroupsModel{
+		table: components.NewTableModel(columns, "Kafka Consumer Groups"),
+	}
+}
+
+// SetData sets the consumer groups data. Groups whose progress could not be
+// read show "?" as their lag
+func (m KafkaGroupsModel) SetData(groups []service.KafkaConsumerGroup, instanceId string) KafkaGroupsModel {
+	m.groups = groups
+	m.instanceId = instanceId
+
+	rows := make([]table.Row, len(groups))
+	rowData := make([]interface{}, len(groups))
+
+	for i, group := range groups {
+		lag, lastConsumed, topics := "?", "?", "?"
+		if group.LagErr == nil {
+			lag = fmt.Sprintf("%d", group.TotalLag)
+			lastConsumed = formatUnixMillis(group.LastTimestamp)
+			topics = valueOrDash(strings.Join(group.Topics, ", "))
+		}
+
+		rows[i] = table.Row{
+			group.ConsumerId,
+			lag,
+			lastConsumed,
+			topics,
+			valueOrDash(group.Remark),
+		}
+		rowData[i] = group
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("Consumer Groups for Kafka: %s", instanceId))
+	return m
+}
+
+// SetSize sets the size
+func (m KafkaGroupsModel) SetSize(width, height int) KafkaGroupsModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m KafkaGroupsModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m KafkaGroupsModel) Update(msg tea.Msg) (KafkaGroupsModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m KafkaGroupsModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m KafkaGroupsModel) Search(query string) KafkaGroupsModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m KafkaGroupsModel) Filter(query string) KafkaGroupsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m KafkaGroupsModel) NextSearchMatch() KafkaGroupsModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m KafkaGroupsModel) PrevSearchMatch() KafkaGroupsModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
@@ -0,0 +1,279 @@
+package pages
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// KafkaDetailModel is the formatted detail page of a Kafka instance. The
+// instance list already has the endpoints and limits, so nothing loads after
+// the page opens besides the names of referenced resources
+type KafkaDetailModel struct {
+	instance alikafka.InstanceVO
+	names    *service.NameResolver // Resolves referenced IDs to names, may be nil
+	view     SectionDetailModel
+	keys     KafkaDetailKeyMap
+}
+
+// KafkaDetailKeyMap defines the Kafka detail keys besides the navigation
+type KafkaDetailKeyMap struct {
+	JSON   key.Binding
+	Topics key.Binding
+	Groups key.Binding
+}
+
+// DefaultKafkaDetailKeyMap returns default key bindings
+func DefaultKafkaDetailKeyMap() KafkaDetailKeyMap {
+	listKeys := DefaultKafkaListKeyMap()
+	return KafkaDetailKeyMap{
+		JSON: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "JSON"),
+		),
+		Topics: listKeys.Topics,
+		Groups: listKeys.Groups,
+	}
+}
+
+// NewKafkaDetailModel creates a new Kafka detail model
+func NewKafkaDetailModel(instance alikafka.InstanceVO) KafkaDetailModel {
+	m := KafkaDetailModel{
+		instance: instance,
+		view:     NewSectionDetailModel(),
+		keys:     DefaultKafkaDetailKeyMap(),
+	}
+	return m.rebuild()
+}
+
+// NewKafkaDetailModelFromInterface creates a new Kafka detail model from
+// interface{}, and false when data is not a Kafka instance
+func NewKafkaDetailModelFromInterface(data interface{}) (KafkaDetailModel, bool) {
+	if instance, ok := data.(alikafka.InstanceVO); ok {
+		return NewKafkaDetailModel(instance), true
+	}
+	return KafkaDetailModel{}, false
+}
+
+// InstanceID returns the ID of the shown instance
+func (m KafkaDetailModel) InstanceID() string {
+	return m.instance.InstanceId
+}
+
+// NameRefs returns the IDs referenced by the instance
+func (m KafkaDetailModel) NameRefs() service.NameRefs {
+	refs := make(service.NameRefs)
+	refs.Add(service.KindVPC, m.instance.VpcId)
+	refs.Add(service.KindVSwitch, m.instance.VSwitchId)
+	refs.Add(service.KindSecurityGroup, m.instance.SecurityGroup)
+	refs.Add(service.KindResourceGroup, m.instance.ResourceGroupId)
+	return refs
+}
+
+// SetNames sets the resolver used to show the names of referenced resources
+func (m KafkaDetailModel) SetNames(names *service.NameResolver) KafkaDetailModel {
+	m.names = names
+	if m.instance.InstanceId == "" {
+		return m
+	}
+	return m.rebuild()
+}
+
+// rebuild renders the sections from the current data
+func (m KafkaDetailModel) rebuild() KafkaDetailModel {
+	inst := m.instance
+
+	basicInfo := DetailSection{
+		Title: i18n.T(i18n.KeySectionBasicInfo),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelInstanceID), Value: inst.InstanceId},
+			{Label: i18n.T(i18n.KeyLabelInstanceName), Value: valueOrDash(inst.Name)},
+			{Label: i18n.T(i18n.KeyLabelInstanceStatus), Value: kafkaInstanceStatus(inst.ServiceStatus), Status: true},
+			{Label: i18n.T(i18n.KeyLabelEdition), Value: kafkaSpecType(inst.SpecType)},
+			{Label: i18n.T(i18n.KeyColRegion), Value: valueOrDash(inst.RegionId)},
+			{Label: i18n.T(i18n.KeyLabelAvailZone), Value: valueOrDash(inst.ZoneId)},
+			{Label: i18n.T(i18n.KeyColCreatedAt), Value: formatUnixMillis(inst.CreateTime)},
+		},
+	}
+
+	// The domain endpoints replace the IP lists on newer instances
+	endpoints := DetailSection{Title: i18n.T(i18n.KeySectionEndpoints)}
+	for _, endpoint := range []struct{ label, value string }{
+		{"Default (VPC)", firstNonEmpty(inst.DomainEndpoint, inst.EndPoint)},
+		{"SSL (Internet)", firstNonEmpty(inst.SslDomainEndpoint, inst.SslEndPoint)},
+		{"SASL (VPC)", inst.SaslDomainEndpoint},
+	} {
+		if endpoint.value != "" {
+			endpoints.Rows = append(endpoints.Rows, DetailRow{Label: endpoint.label, Value: endpoint.value})
+		}
+	}
+	if len(endpoints.Rows) == 0 {
+		endpoints.Rows = []DetailRow{{Label: endpoints.Title, Value: "-"}}
+	}
+
+	spec := DetailSection{
+		Title: i18n.T(i18n.KeySectionSpec),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelTrafficSpec), Value: m.formatTraffic()},
+			{Label: i18n.T(i18n.KeyLabelStorage), Value: fmt.Sprintf("%d GB %s", inst.DiskSize, kafkaDiskType(inst.DiskType))},
+			{Label: i18n.T(i18n.KeyLabelMessageRetention), Value: fmt.Sprintf("%d h", inst.MsgRetain)},
+			{Label: i18n.T(i18n.KeyLabelTopicCapacity), Value: fmt.Sprintf("%d/%d", inst.UsedTopicCount, inst.TopicNumLimit)},
+			{Label: i18n.T(i18n.KeyLabelPartitions), Value: fmt.Sprintf("%d", inst.UsedPartitionCount)},
+			{Label: i18n.T(i18n.KeyLabelConsumerGroups), Value: fmt.Sprintf("%d", inst.UsedGroupCount)},
+		},
+	}
+
+	network := DetailSection{
+		Title: i18n.T(i18n.KeySectionNetwork),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelNetworkType), Value: m.formatDeployType()},
+			{Label: i18n.T(i18n.KeyLabelVPC), Value: valueOrDash(m.names.Label(service.KindVPC, inst.VpcId))},
+			{Label: i18n.T(i18n.KeyLabelVSwitch), Value: valueOrDash(m.names.Label(service.KindVSwitch, inst.VSwitchId))},
+			{Label: i18n.T(i18n.KeyLabelSecurityGroup), Value: valueOrDash(m.names.Label(service.KindSecurityGroup, inst.SecurityGroup))},
+		},
+	}
+
+	billing := DetailSection{
+		Title: i18n.T(i18n.KeySectionBilling),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelChargeType), Value: m.formatChargeType()},
+			{Label: i18n.T(i18n.KeyLabelExpireTime), Value: m.formatExpiry()},
+		},
+	}
+
+	groupInfo := DetailSection{
+		Title: i18n.T(i18n.KeySectionGroupInfo),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelResourceGroup), Value: valueOrDash(m.names.Label(service.KindResourceGroup, inst.ResourceGroupId))},
+			{Label: i18n.T(i18n.KeyLabelTags), Value: m.formatTags()},
+		},
+	}
+
+	m.view = m.view.SetSections([]DetailSection{basicInfo, endpoints, spec, network, billing, groupInfo})
+	return m
+}
+
+// firstNonEmpty returns the first of values that is not empty
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// formatTraffic shows the traffic spec with the peak traffic it allows
+func (m KafkaDetailModel) formatTraffic() string {
+	if m.instance.IoMax == 0 {
+		return valueOrDash(m.instance.IoMaxSpec)
+	}
+	return fmt.Sprintf("%s (%d MB/s)", valueOrDash(m.instance.IoMaxSpec), m.instance.IoMax)
+}
+
+// formatDeployType shows from where the instance is reachable
+func (m KafkaDetailModel) formatDeployType() string {
+	switch m.instance.DeployType {
+	case 4:
+		return "Internet and VPC"
+	case 5:
+		return "VPC"
+	default:
+		return fmt.Sprintf("%d", m.instance.DeployType)
+	}
+}
+
+// formatChargeType shows the billing method in the wording of the ECS detail
+func (m KafkaDetailModel) formatChargeType() string {
+	switch m.instance.PaidType {
+	case 0:
+		return i18n.T(i18n.KeyChargePrePaid)
+	case 1:
+		return i18n.T(i18n.KeyChargePostPaid)
+	case 3:
+		return "Serverless"
+	default:
+		return fmt.Sprintf("%d", m.instance.PaidType)
+	}
+}
+
+// formatExpiry shows when a subscription instance expires
+func (m KafkaDetailModel) formatExpiry() string {
+	if m.instance.PaidType != 0 {
+		return "-"
+	}
+	return formatUnixMillis(m.instance.ExpiredTime)
+}
+
+// formatTags formats the instance tags as "key: value" pairs
+func (m KafkaDetailModel) formatTags() string {
+	if len(m.instance.Tags.TagVO) == 0 {
+		return "-"
+	}
+	tags := make([]string, len(m.instance.Tags.TagVO))
+	for i, tag := range m.instance.Tags.TagVO {
+		tags[i] = fmt.Sprintf("%s: %s", tag.Key, tag.Value)
+	}
+	return strings.Join(tags, ", ")
+}
+
+// SetSize sets the size of the detail view
+func (m KafkaDetailModel) SetSize(width, height int) KafkaDetailModel {
+	m.view = m.view.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m KafkaDetailModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m KafkaDetailModel) Update(msg tea.Msg) (KafkaDetailModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		var nav *types.NavigateMsg
+		switch {
+		case key.Matches(msg, m.keys.JSON):
+			nav = &types.NavigateMsg{Page: types.PageKafkaJSONDetail, Data: m.instance}
+		case key.Matches(msg, m.keys.Topics):
+			nav = &types.NavigateMsg{Page: types.PageKafkaTopics, Data: m.instance.InstanceId}
+		case key.Matches(msg, m.keys.Groups):
+			nav = &types.NavigateMsg{Page: types.PageKafkaGroups, Data: m.instance.InstanceId}
+		}
+		if nav != nil {
+			return m, func() tea.Msg { return *nav }
+		}
+	}
+
+	var cmd tea.Cmd
+	m.view, cmd = m.view.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m KafkaDetailModel) View() string {
+	return m.view.View()
+}
+
+// Search placeholder for interface compatibility
+func (m KafkaDetailModel) Search(query string) KafkaDetailModel {
+	return m
+}
+
+// NextSearchMatch placeholder
+func (m KafkaDetailModel) NextSearchMatch() KafkaDetailModel {
+	return m
+}
+
+// PrevSearchMatch placeholder
+func (m KafkaDetailModel) PrevSearchMatch() KafkaDetailModel {
+	return m
+}
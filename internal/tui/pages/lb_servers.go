@@ -0,0 +1,138 @@
+package pages
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+)
+
+// LBKind tells which new-generation load balancer product a server group
+// belongs to
+type LBKind string
+
+const (
+	LBKindALB LBKind = "ALB"
+	LBKindNLB LBKind = "NLB"
+)
+
+// LBServerGroupNavData contains the data needed to navigate to the backend
+// servers of an ALB or NLB server group
+type LBServerGroupNavData struct {
+	Kind            LBKind
+	ServerGroupID   string
+	ServerGroupName string
+}
+
+// LBServersModel lists the backend servers of an ALB or NLB server group
+type LBServersModel struct {
+	table   components.TableModel
+	servers []service.LBServer
+	group   LBServerGroupNavData
+	width   int
+	height  int
+}
+
+// NewLBServersModel creates a new backend servers model
+func NewLBServersModel(group LBServerGroupNavData) LBServersModel {
+	columns := []table.Column{
+		{Title: "Server ID", Width: 26},
+		{Title: "Type", Width: 8},
+		{Title: "IP", Width: 16},
+		{Title: "Port", Width: 8},
+		{Title: "Weight", Width: 8},
+		{Title: "Zone", Width: 16},
+		{Title: "Status", Width: 12},
+		{Title: "Description", Width: 30},
+	}
+
+	return LBServersModel{
+		table: components.NewTableModel(columns, fmt.Sprintf("%s Backend Servers", group.Kind)),
+		group: group,
+	}
+}
+
+// ServerGroup returns the server group whose servers are listed
+func (m LBServersModel) ServerGroup() LBServerGroupNavData {
+	return m.group
+}
+
+// SetData sets the backend servers data
+func (m LBServersModel) SetData(servers []service.LBServer) LBServersModel {
+	m.servers = servers
+
+	rows := make([]table.Row, len(servers))
+	rowData := make([]interface{}, len(servers))
+
+	for i, server := range servers {
+		rows[i] = table.Row{
+			server.ServerID,
+			server.ServerType,
+			valueOrDash(server.ServerIP),
+			fmt.Sprintf("%d", server.Port),
+			fmt.Sprintf("%d", server.Weight),
+			valueOrDash(server.ZoneID),
+			server.Status,
+			valueOrDash(server.Description),
+		}
+		rowData[i] = server
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("Backend Servers for %s Server Group: %s",
+		m.group.Kind, lbServerGroupIDName(m.group.ServerGroupID, m.group.ServerGroupName)))
+	return m
+}
+
+// SetSize sets the size
+func (m LBServersModel) SetSize(width, height int) LBServersModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m LBServersModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m LBServersModel) Update(msg tea.Msg) (LBServersModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m LBServersModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m LBServersModel) Search(query string) LBServersModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m LBServersModel) Filter(query string) LBServersModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m LBServersModel) NextSearchMatch() LBServersModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m LBServersModel) PrevSearchMatch() LBServersModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
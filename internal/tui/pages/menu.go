@@ -1,12 +1,16 @@
 package pages
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
 	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/theme"
 	"aliyun-tui-viewer/internal/tui/types"
 )
 
@@ -16,9 +20,39 @@ type MenuItem struct {
 	description string
 	shortcut    rune
 	page        types.PageType
+
+	// Resources of the service in the region, and their change since the
+	// previous run's count
+	counted bool
+	count   int
+	delta   int
+
+	// The profile has no permission for the service
+	denied bool
+}
+
+// deniedStyle marks the services the profile has no permission for
+func deniedStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(theme.Current().Error).Italic(true)
+}
+
+// Title returns the service name, followed by its resource count and the
+// count's change once known, e.g. "(s) ECS Instances  42 (+3)", or by a
+// "no permission" mark
+func (i MenuItem) Title() string {
+	if i.denied {
+		return i.title + "  " + deniedStyle().Render(i18n.T(i18n.KeyNoPermission))
+	}
+	if !i.counted {
+		return i.title
+	}
+	title := fmt.Sprintf("%s  %d", i.title, i.count)
+	if i.delta != 0 {
+		title += fmt.Sprintf(" (%+d)", i.delta)
+	}
+	return title
 }
 
-func (i MenuItem) Title() string       { return i.title }
 func (i MenuItem) Description() string { return i.description }
 func (i MenuItem) FilterValue() string { return i.title }
 
@@ -37,10 +71,28 @@ type MenuKeyMap struct {
 	SG       key.Binding
 	DNS      key.Binding
 	SLB      key.Binding
+	ALB      key.Binding
+	NLB      key.Binding
+	ACK      key.Binding
+	ACR      key.Binding
+	FC       key.Binding
 	OSS      key.Binding
 	RDS      key.Binding
 	Redis    key.Binding
+	MongoDB  key.Binding
+	ES       key.Binding
+	Kafka    key.Binding
 	RocketMQ key.Binding
+	RAM      key.Binding
+	SLS      key.Binding
+	Config   key.Binding
+	Tags     key.Binding
+	NAT      key.Binding
+	Billing  key.Binding
+	Recycle  key.Binding
+	WhatsNew key.Binding
+	Recent   key.Binding
+	Usage    key.Binding
 	Quit     key.Binding
 }
 
@@ -67,6 +119,26 @@ func DefaultMenuKeyMap() MenuKeyMap {
 			key.WithKeys("b"),
 			key.WithHelp("b", "SLB"),
 		),
+		ALB: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "ALB"),
+		),
+		NLB: key.NewBinding(
+			key.WithKeys("w"),
+			key.WithHelp("w", "NLB"),
+		),
+		ACK: key.NewBinding(
+			key.WithKeys("K"),
+			key.WithHelp("K", "ACK Clusters"),
+		),
+		ACR: key.NewBinding(
+			key.WithKeys("C"),
+			key.WithHelp("C", "Container Registry"),
+		),
+		FC: key.NewBinding(
+			key.WithKeys("u"),
+			key.WithHelp("u", "Function Compute"),
+		),
 		OSS: key.NewBinding(
 			key.WithKeys("o"),
 			key.WithHelp("o", "OSS"),
@@ -79,10 +151,62 @@ func DefaultMenuKeyMap() MenuKeyMap {
 			key.WithKeys("i"),
 			key.WithHelp("i", "Redis"),
 		),
+		MongoDB: key.NewBinding(
+			key.WithKeys("M"),
+			key.WithHelp("M", "MongoDB"),
+		),
+		ES: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "Elasticsearch"),
+		),
+		Kafka: key.NewBinding(
+			key.WithKeys("A"),
+			key.WithHelp("A", "Kafka"),
+		),
 		RocketMQ: key.NewBinding(
 			key.WithKeys("m"),
 			key.WithHelp("m", "RocketMQ"),
 		),
+		RAM: key.NewBinding(
+			key.WithKeys("a"),
+			key.WithHelp("a", "RAM"),
+		),
+		SLS: key.NewBinding(
+			key.WithKeys("l"),
+			key.WithHelp("l", "Log Service"),
+		),
+		Config: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "Cloud Config"),
+		),
+		Tags: key.NewBinding(
+			key.WithKeys("t"),
+			key.WithHelp("t", "Tags"),
+		),
+		NAT: key.NewBinding(
+			key.WithKeys("n"),
+			key.WithHelp("n", "NAT Gateways"),
+		),
+		Billing: key.NewBinding(
+			key.WithKeys("$"),
+			key.WithHelp("$", "Billing"),
+		),
+		Recycle: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "Recycle Bin"),
+		),
+		WhatsNew: key.NewBinding(
+			key.WithKeys("W"),
+			key.WithHelp("W", "What's New"),
+		),
+		Recent: key.NewBinding(
+			key.WithKeys("H"),
+			key.WithHelp("H", "Recent"),
+		),
+		Usage: key.NewBinding(
+			key.WithKeys("U"),
+			key.WithHelp("U", "Usage Stats"),
+		),
 		Quit: key.NewBinding(
 			key.WithKeys("Q"),
 			key.WithHelp("Q", "quit"),
@@ -92,32 +216,51 @@ func DefaultMenuKeyMap() MenuKeyMap {
 
 // NewMenuModel creates a new menu model
 func NewMenuModel() MenuModel {
+	th := theme.Current()
 	items := []list.Item{
 		MenuItem{title: i18n.T(i18n.KeyMenuECS), description: i18n.T(i18n.KeyMenuECSDesc), shortcut: 's', page: types.PageECSList},
 		MenuItem{title: i18n.T(i18n.KeyMenuSG), description: i18n.T(i18n.KeyMenuSGDesc), shortcut: 'g', page: types.PageSecurityGroups},
 		MenuItem{title: i18n.T(i18n.KeyMenuDNS), description: i18n.T(i18n.KeyMenuDNSDesc), shortcut: 'd', page: types.PageDNSDomains},
 		MenuItem{title: i18n.T(i18n.KeyMenuSLB), description: i18n.T(i18n.KeyMenuSLBDesc), shortcut: 'b', page: types.PageSLBList},
+		MenuItem{title: i18n.T(i18n.KeyMenuALB), description: i18n.T(i18n.KeyMenuALBDesc), shortcut: 'p', page: types.PageALBList},
+		MenuItem{title: i18n.T(i18n.KeyMenuNLB), description: i18n.T(i18n.KeyMenuNLBDesc), shortcut: 'w', page: types.PageNLBList},
+		MenuItem{title: i18n.T(i18n.KeyMenuACK), description: i18n.T(i18n.KeyMenuACKDesc), shortcut: 'K', page: types.PageACKClusters},
+		MenuItem{title: i18n.T(i18n.KeyMenuACR), description: i18n.T(i18n.KeyMenuACRDesc), shortcut: 'C', page: types.PageACRInstances},
+		MenuItem{title: i18n.T(i18n.KeyMenuFC), description: i18n.T(i18n.KeyMenuFCDesc), shortcut: 'u', page: types.PageFCServices},
 		MenuItem{title: i18n.T(i18n.KeyMenuOSS), description: i18n.T(i18n.KeyMenuOSSDesc), shortcut: 'o', page: types.PageOSSBuckets},
 		MenuItem{title: i18n.T(i18n.KeyMenuRDS), description: i18n.T(i18n.KeyMenuRDSDesc), shortcut: 'r', page: types.PageRDSList},
 		MenuItem{title: i18n.T(i18n.KeyMenuRedis), description: i18n.T(i18n.KeyMenuRedisDesc), shortcut: 'i', page: types.PageRedisList},
+		MenuItem{title: i18n.T(i18n.KeyMenuMongoDB), description: i18n.T(i18n.KeyMenuMongoDBDesc), shortcut: 'M', page: types.PageMongoDBList},
+		MenuItem{title: i18n.T(i18n.KeyMenuElasticsearch), description: i18n.T(i18n.KeyMenuElasticsearchDesc), shortcut: 'e', page: types.PageElasticsearchList},
+		MenuItem{title: i18n.T(i18n.KeyMenuKafka), description: i18n.T(i18n.KeyMenuKafkaDesc), shortcut: 'A', page: types.PageKafkaList},
 		MenuItem{title: i18n.T(i18n.KeyMenuRocketMQ), description: i18n.T(i18n.KeyMenuRocketMQDesc), shortcut: 'm', page: types.PageRocketMQList},
+		MenuItem{title: i18n.T(i18n.KeyMenuRAM), description: i18n.T(i18n.KeyMenuRAMDesc), shortcut: 'a', page: types.PageRAMUsers},
+		MenuItem{title: i18n.T(i18n.KeyMenuSLS), description: i18n.T(i18n.KeyMenuSLSDesc), shortcut: 'l', page: types.PageSLSProjects},
+		MenuItem{title: i18n.T(i18n.KeyMenuConfig), description: i18n.T(i18n.KeyMenuConfigDesc), shortcut: 'c', page: types.PageConfigRules},
+		MenuItem{title: i18n.T(i18n.KeyMenuTags), description: i18n.T(i18n.KeyMenuTagsDesc), shortcut: 't', page: types.PageTagBrowser},
+		MenuItem{title: i18n.T(i18n.KeyMenuNAT), description: i18n.T(i18n.KeyMenuNATDesc), shortcut: 'n', page: types.PageNATList},
+		MenuItem{title: i18n.T(i18n.KeyMenuBilling), description: i18n.T(i18n.KeyMenuBillingDesc), shortcut: '$', page: types.PageBilling},
+		MenuItem{title: i18n.T(i18n.KeyMenuRecycleBin), description: i18n.T(i18n.KeyMenuRecycleBinDesc), shortcut: 'x', page: types.PageRecycleBin},
+		MenuItem{title: i18n.T(i18n.KeyMenuChangelog), description: i18n.T(i18n.KeyMenuChangelogDesc), shortcut: 'W', page: types.PageChangelog},
+		MenuItem{title: i18n.T(i18n.KeyMenuRecent), description: i18n.T(i18n.KeyMenuRecentDesc), shortcut: 'H', page: types.PageRecent},
+		MenuItem{title: i18n.T(i18n.KeyMenuUsage), description: i18n.T(i18n.KeyMenuUsageDesc), shortcut: 'U', page: types.PageUsage},
 	}
 
 	// Create delegate
 	delegate := list.NewDefaultDelegate()
-	// Selected item: purple background with white text for title only
+	// Selected item: primary background for title only
 	delegate.Styles.SelectedTitle = delegate.Styles.SelectedTitle.
-		Foreground(lipgloss.Color("#FFFFFF")).
-		Background(lipgloss.Color("#7C3AED")).
+		Foreground(th.OnPrimary).
+		Background(th.Primary).
 		Bold(true).
-		BorderLeftForeground(lipgloss.Color("#7C3AED"))
+		BorderLeftForeground(th.Primary)
 	delegate.Styles.SelectedDesc = delegate.Styles.SelectedDesc.
-		Foreground(lipgloss.Color("#9CA3AF")).
+		Foreground(th.SubtleText).
 		Border(lipgloss.Border{}, false, false, false, false)
 	delegate.Styles.NormalTitle = delegate.Styles.NormalTitle.
-		Foreground(lipgloss.Color("#E5E7EB"))
+		Foreground(th.Text)
 	delegate.Styles.NormalDesc = delegate.Styles.NormalDesc.
-		Foreground(lipgloss.Color("#6B7280"))
+		Foreground(th.MutedText)
 
 	l := list.New(items, delegate, 0, 0)
 	l.SetShowTitle(false) // Title is now shown in the header bar
@@ -131,6 +274,44 @@ func NewMenuModel() MenuModel {
 	}
 }
 
+// OrderByUsage puts the most opened services first, by opens keyed by page
+// name. Services opened equally often keep their order
+func (m MenuModel) OrderByUsage(opens map[string]int) MenuModel {
+	items := m.list.Items()
+	sort.SliceStable(items, func(i, j int) bool {
+		return opens[items[i].(MenuItem).page.String()] > opens[items[j].(MenuItem).page.String()]
+	})
+	m.list.SetItems(items)
+	m.list.Select(0)
+	return m
+}
+
+// SetCounts shows the resource counts of the services in counts, and their
+// change for those also in previous. Nil counts clear them all
+func (m MenuModel) SetCounts(counts, previous map[types.PageType]int) MenuModel {
+	for i, listItem := range m.list.Items() {
+		item := listItem.(MenuItem)
+		item.count, item.counted = counts[item.page]
+		item.delta = 0
+		if before, ok := previous[item.page]; ok && item.counted {
+			item.delta = item.count - before
+		}
+		m.list.SetItem(i, item)
+	}
+	return m
+}
+
+// SetDenied marks the services in denied as ones the profile has no
+// permission for, and clears the mark of the others
+func (m MenuModel) SetDenied(denied map[types.PageType]bool) MenuModel {
+	for i, listItem := range m.list.Items() {
+		item := listItem.(MenuItem)
+		item.denied = denied[item.page]
+		m.list.SetItem(i, item)
+	}
+	return m
+}
+
 // SetSize sets the menu size
 func (m MenuModel) SetSize(width, height int) MenuModel {
 	m.width = width
@@ -177,6 +358,31 @@ func (m MenuModel) Update(msg tea.Msg) (MenuModel, tea.Cmd) {
 				return types.NavigateMsg{Page: types.PageSLBList}
 			}
 
+		case key.Matches(msg, m.keys.ALB):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageALBList}
+			}
+
+		case key.Matches(msg, m.keys.NLB):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageNLBList}
+			}
+
+		case key.Matches(msg, m.keys.ACK):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageACKClusters}
+			}
+
+		case key.Matches(msg, m.keys.ACR):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageACRInstances}
+			}
+
+		case key.Matches(msg, m.keys.FC):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageFCServices}
+			}
+
 		case key.Matches(msg, m.keys.OSS):
 			return m, func() tea.Msg {
 				return types.NavigateMsg{Page: types.PageOSSBuckets}
@@ -192,11 +398,74 @@ func (m MenuModel) Update(msg tea.Msg) (MenuModel, tea.Cmd) {
 				return types.NavigateMsg{Page: types.PageRedisList}
 			}
 
+		case key.Matches(msg, m.keys.MongoDB):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageMongoDBList}
+			}
+
+		case key.Matches(msg, m.keys.ES):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageElasticsearchList}
+			}
+
+		case key.Matches(msg, m.keys.Kafka):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageKafkaList}
+			}
+
 		case key.Matches(msg, m.keys.RocketMQ):
 			return m, func() tea.Msg {
 				return types.NavigateMsg{Page: types.PageRocketMQList}
 			}
 
+		case key.Matches(msg, m.keys.RAM):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageRAMUsers}
+			}
+
+		case key.Matches(msg, m.keys.SLS):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageSLSProjects}
+			}
+
+		case key.Matches(msg, m.keys.Config):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageConfigRules}
+			}
+
+		case key.Matches(msg, m.keys.Tags):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageTagBrowser}
+			}
+
+		case key.Matches(msg, m.keys.NAT):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageNATList}
+			}
+
+		case key.Matches(msg, m.keys.Billing):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageBilling}
+			}
+
+		case key.Matches(msg, m.keys.Recycle):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageRecycleBin}
+			}
+
+		case key.Matches(msg, m.keys.WhatsNew):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageChangelog}
+			}
+		case key.Matches(msg, m.keys.Recent):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageRecent}
+			}
+		case key.Matches(msg, m.keys.Usage):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageUsage}
+			}
+
 		case key.Matches(msg, m.keys.Quit):
 			return m, tea.Quit
 		}
@@ -0,0 +1,296 @@
+package pages
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/theme"
+	"aliyun-tui-viewer/internal/tui/components"
+)
+
+// MetricsWindow is the time window shown by the metrics pages
+const MetricsWindow = time.Hour
+
+// MetricsModel shows the metrics of an ECS, RDS or Redis instance as sparklines
+type MetricsModel struct {
+	instanceID   string
+	instanceName string
+	series       []service.MetricSeries
+	sizing       string // Right-sizing line, ECS only
+	updatedAt    time.Time
+	viewport     viewport.Model
+	width        int
+	height       int
+	keys         MetricsKeyMap
+}
+
+// MetricsKeyMap defines key bindings for the metrics pages
+type MetricsKeyMap struct {
+	Refresh key.Binding
+}
+
+// DefaultMetricsKeyMap returns default key bindings
+func DefaultMetricsKeyMap() MetricsKeyMap {
+	return MetricsKeyMap{
+		Refresh: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "refresh"),
+		),
+	}
+}
+
+// newMetricsModel creates a new metrics page for an instance
+func newMetricsModel(instanceID, instanceName string) MetricsModel {
+	return MetricsModel{
+		instanceID:   instanceID,
+		instanceName: instanceName,
+		viewport:     viewport.New(80, 20), // Initial size, will be updated by SetSize
+		keys:         DefaultMetricsKeyMap(),
+	}
+}
+
+// NewECSMetricsModelFromInterface creates a metrics page from ECS navigation data
+func NewECSMetricsModelFromInterface(data interface{}) (MetricsModel, bool) {
+	if inst, ok := data.(ecs.Instance); ok {
+		m := newMetricsModel(inst.InstanceId, inst.InstanceName)
+		m.sizing = i18n.T(i18n.KeySizingLoading)
+		return m, true
+	}
+	return MetricsModel{}, false
+}
+
+// NewRDSMetricsModelFromInterface creates a metrics page from RDS navigation data
+func NewRDSMetricsModelFromInterface(data interface{}) (MetricsModel, bool) {
+	if inst, ok := data.(rds.DBInstance); ok {
+		return newMetricsModel(inst.DBInstanceId, inst.DBInstanceDescription), true
+	}
+	return MetricsModel{}, false
+}
+
+// NewRedisMetricsModelFromInterface creates a performance page from Redis navigation data
+func NewRedisMetricsModelFromInterface(data interface{}) (MetricsModel, bool) {
+	if inst, ok := data.(r_kvstore.KVStoreInstance); ok {
+		return newMetricsModel(inst.InstanceId, inst.InstanceName), true
+	}
+	return MetricsModel{}, false
+}
+
+// InstanceID returns the ID of the instance shown
+func (m MetricsModel) InstanceID() string {
+	return m.instanceID
+}
+
+// SetData sets the fetched metric series
+func (m MetricsModel) SetData(series []service.MetricSeries) MetricsModel {
+	m.series = series
+	m.updatedAt = time.Now()
+	m.updateViewportContent()
+	return m
+}
+
+// SetSizing sets the right-sizing recommendation, or the error computing it
+func (m MetricsModel) SetSizing(rec service.SizingRecommendation, family string, err error) MetricsModel {
+	m.sizing = formatSizing(rec, family, err)
+	m.updateViewportContent()
+	return m
+}
+
+// formatSizing renders a recommendation as one line, e.g. "CPU p95 4.0% over
+// 14 days - consider downsizing to ecs.g7.large (2 vCPU / 8 GiB)"
+func formatSizing(rec service.SizingRecommendation, family string, err error) string {
+	if err != nil {
+		return fmt.Sprintf("%s: %v", i18n.T(i18n.KeySizingUnavailable), err)
+	}
+	usage := rec.Utilization
+	if usage.Samples == 0 {
+		return i18n.T(i18n.KeySizingNoHistory)
+	}
+
+	line := fmt.Sprintf("CPU p95 %.1f%%", usage.CPUP95)
+	if usage.HasMemory {
+		line += fmt.Sprintf(", memory p95 %.1f%%", usage.MemoryP95)
+	}
+	line += fmt.Sprintf(" over %d days - ", int(usage.Window.Hours()/24))
+
+	var suggested string
+	if t := rec.Suggested; t != nil {
+		suggested = fmt.Sprintf("%s (%d vCPU / %g GiB)", t.InstanceTypeId, t.CpuCoreCount, t.MemorySize)
+	}
+	switch {
+	case rec.Action == service.SizingDownsize && suggested != "":
+		return line + fmt.Sprintf(i18n.T(i18n.KeySizingDownsize), suggested)
+	case rec.Action == service.SizingDownsize:
+		return line + fmt.Sprintf(i18n.T(i18n.KeySizingNoSmaller), family)
+	case rec.Action == service.SizingUpsize && suggested != "":
+		return line + fmt.Sprintf(i18n.T(i18n.KeySizingUpsize), suggested)
+	case rec.Action == service.SizingUpsize:
+		return line + fmt.Sprintf(i18n.T(i18n.KeySizingNoLarger), family)
+	default:
+		return line + i18n.T(i18n.KeySizingKeep)
+	}
+}
+
+// SetSize sets the size
+func (m MetricsModel) SetSize(width, height int) MetricsModel {
+	m.width = width
+	m.height = height
+	m.viewport.Width = width
+	m.viewport.Height = max(height-2, 1) // Account for the header line and its margin
+	m.updateViewportContent()
+	return m
+}
+
+// updateViewportContent renders the series for the current width
+func (m *MetricsModel) updateViewportContent() {
+	th := theme.Current()
+	labelStyle := lipgloss.NewStyle().Bold(true).Foreground(th.Primary)
+	unitStyle := lipgloss.NewStyle().Foreground(th.MutedText)
+	sparkStyle := lipgloss.NewStyle().Foreground(th.Secondary)
+	statStyle := lipgloss.NewStyle().Foreground(th.SubtleText)
+	errStyle := lipgloss.NewStyle().Foreground(th.Error)
+
+	sparkWidth := max(m.width-4, 10)
+	var b strings.Builder
+	if m.sizing != "" {
+		b.WriteString("  " + labelStyle.Render(i18n.T(i18n.KeySizingTitle)) + "\n")
+		b.WriteString("  " + statStyle.Render(m.sizing) + "\n\n")
+	}
+	for _, series := range m.series {
+		b.WriteString("  " + labelStyle.Render(series.Label) + " " + unitStyle.Render("("+series.Unit+")") + "\n")
+
+		switch {
+		case series.Err != nil:
+			b.WriteString("  " + errStyle.Render(series.Err.Error()) + "\n")
+		case len(series.Points) == 0:
+			notice := i18n.T(i18n.KeyMetricsNoData)
+			if strings.HasPrefix(series.Metric, "memory_") {
+				notice += " - " + i18n.T(i18n.KeyMetricsAgentRequired)
+			}
+			b.WriteString("  " + unitStyle.Render(notice) + "\n")
+		default:
+			values := series.Values()
+			ceiling := 0.0
+			if series.Unit == service.UnitPercent {
+				ceiling = 100 // Keep utilization comparable across instances
+			}
+			spark := components.Sparkline(values, sparkWidth, ceiling)
+			b.WriteString("  " + sparkStyle.Render(spark) + "\n")
+			b.WriteString("  " + unitStyle.Render(timeAxis(series.Points, lipgloss.Width(spark))) + "\n")
+			b.WriteString("  " + statStyle.Render(formatMetricStats(values, series.Unit)) + "\n")
+		}
+		b.WriteString("\n")
+	}
+	m.viewport.SetContent(b.String())
+}
+
+// timeAxis renders the start and end time of points under a sparkline of width cells
+func timeAxis(points []service.MetricPoint, width int) string {
+	start := points[0].Time.Format("15:04")
+	end := points[len(points)-1].Time.Format("15:04")
+	gap := width - len(start) - len(end)
+	if gap < 1 {
+		return start
+	}
+	return start + strings.Repeat(" ", gap) + end
+}
+
+// formatMetricStats summarises values as min/avg/max/last
+func formatMetricStats(values []float64, unit string) string {
+	minValue, maxValue, sum := math.Inf(1), math.Inf(-1), 0.0
+	for _, v := range values {
+		minValue = math.Min(minValue, v)
+		maxValue = math.Max(maxValue, v)
+		sum += v
+	}
+	return fmt.Sprintf("min %s   avg %s   max %s   last %s",
+		formatMetricValue(minValue, unit),
+		formatMetricValue(sum/float64(len(values)), unit),
+		formatMetricValue(maxValue, unit),
+		formatMetricValue(values[len(values)-1], unit))
+}
+
+// formatMetricValue formats a metric value with its unit
+func formatMetricValue(value float64, unit string) string {
+	switch unit {
+	case service.UnitPercent:
+		return fmt.Sprintf("%.1f%%", value)
+	case service.UnitBytesPerSec:
+		return FormatSize(int64(value)) + "/s"
+	case service.UnitBitsPerSec:
+		for _, scale := range []struct {
+			factor float64
+			suffix string
+		}{{1e9, "Gbit/s"}, {1e6, "Mbit/s"}, {1e3, "Kbit/s"}} {
+			if value >= scale.factor {
+				return fmt.Sprintf("%.2f %s", value/scale.factor, scale.suffix)
+			}
+		}
+		return fmt.Sprintf("%.0f bit/s", value)
+	default:
+		return fmt.Sprintf("%.2f %s", value, unit)
+	}
+}
+
+// Init implements tea.Model
+func (m MetricsModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m MetricsModel) Update(msg tea.Msg) (MetricsModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Refresh) {
+		instanceID := m.instanceID
+		return m, func() tea.Msg {
+			return MetricsRefreshRequestMsg{InstanceID: instanceID}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m MetricsModel) View() string {
+	name := m.instanceID
+	if m.instanceName != "" {
+		name = fmt.Sprintf("%s (%s)", m.instanceName, m.instanceID)
+	}
+	info := fmt.Sprintf("%s · last %s · %s averages", name, formatWindow(MetricsWindow), formatWindow(service.MetricPeriod))
+	if !m.updatedAt.IsZero() {
+		info += " · updated " + m.updatedAt.Format("15:04:05")
+	}
+
+	header := lipgloss.NewStyle().Foreground(theme.Current().SubtleText).MarginBottom(1).Render(" " + info)
+	return header + "\n" + m.viewport.View()
+}
+
+// formatWindow formats a duration as "1h" or "5m" rather than "1h0m0s"
+func formatWindow(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
+
+// MetricsRefreshRequestMsg asks the app to fetch an instance's metrics again
+type MetricsRefreshRequestMsg struct {
+	InstanceID string
+}
@@ -0,0 +1,294 @@
+package pages
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/dds"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// mongoDBArchitecture names the architecture of an instance
+func mongoDBArchitecture(instanceType string) string {
+	switch instanceType {
+	case service.MongoDBTypeReplicaSet:
+		return i18n.T(i18n.KeyMongoDBReplicaSet)
+	case service.MongoDBTypeSharding:
+		return i18n.T(i18n.KeyMongoDBSharded)
+	default:
+		return valueOrDash(instanceType)
+	}
+}
+
+// MongoDBListModel represents the MongoDB instances list page
+type MongoDBListModel struct {
+	table      components.TableModel
+	instances  []dds.DBInstance
+	width      int
+	height     int
+	keys       MongoDBListKeyMap
+	showRegion bool // Region column, when listing all regions
+}
+
+// MongoDBListKeyMap defines key bindings
+type MongoDBListKeyMap struct {
+	Enter    key.Binding
+	Accounts key.Binding
+}
+
+// DefaultMongoDBListKeyMap returns default key bindings
+func DefaultMongoDBListKeyMap() MongoDBListKeyMap {
+	return MongoDBListKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "details"),
+		),
+		Accounts: key.NewBinding(
+			key.WithKeys("A"),
+			key.WithHelp("A", "accounts"),
+		),
+	}
+}
+
+// NewMongoDBListModel creates a new MongoDB list model
+func NewMongoDBListModel() MongoDBListModel {
+	columns := []table.Column{
+		{Title: "Instance ID", Width: 25},
+		{Title: "Name", Width: 25},
+		{Title: "Architecture", Width: 16},
+		{Title: "Version", Width: 8},
+		{Title: "Class", Width: 22},
+		{Title: "Storage (GB)", Width: 12},
+		{Title: "Status", Width: 12},
+		{Title: "Zone", Width: 16},
+	}
+
+	return MongoDBListModel{
+		table: components.NewTableModel(columns, "MongoDB Instances"),
+		keys:  DefaultMongoDBListKeyMap(),
+	}
+}
+
+// SetData sets the MongoDB instances data
+func (m MongoDBListModel) SetData(instances []dds.DBInstance) MongoDBListModel {
+	m.instances = instances
+
+	rows := make([]table.Row, len(instances))
+	rowData := make([]interface{}, len(instances))
+
+	for i, inst := range instances {
+		rows[i] = table.Row{
+			inst.DBInstanceId,
+			valueOrDash(inst.DBInstanceDescription),
+			mongoDBArchitecture(inst.DBInstanceType),
+			valueOrDash(inst.EngineVersion),
+			valueOrDash(inst.DBInstanceClass),
+			fmt.Sprintf("%d", inst.DBInstanceStorage),
+			inst.DBInstanceStatus,
+			valueOrDash(inst.ZoneId),
+		}
+		if m.showRegion {
+			rows[i] = append(rows[i], inst.RegionId)
+		}
+		rowData[i] = inst
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetShowRegion adds a Region column, used when listing all regions
+func (m MongoDBListModel) SetShowRegion(show bool) MongoDBListModel {
+	if show && !m.showRegion {
+		m.table = m.table.SetColumns(append(m.table.Columns(), regionColumn()))
+	}
+	m.showRegion = show
+	return m
+}
+
+// SetSize sets the size
+func (m MongoDBListModel) SetSize(width, height int) MongoDBListModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedInstance returns the selected instance
+func (m MongoDBListModel) SelectedInstance() *dds.DBInstance {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.instances) {
+		return &m.instances[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m MongoDBListModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m MongoDBListModel) Update(msg tea.Msg) (MongoDBListModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.Enter):
+			if inst := m.SelectedInstance(); inst != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageMongoDBDetail,
+						Data: *inst,
+					}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Accounts):
+			if inst := m.SelectedInstance(); inst != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageMongoDBAccounts,
+						Data: inst.DBInstanceId,
+					}
+				}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m MongoDBListModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m MongoDBListModel) Search(query string) MongoDBListModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m MongoDBListModel) Filter(query string) MongoDBListModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m MongoDBListModel) NextSearchMatch() MongoDBListModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m MongoDBListModel) PrevSearchMatch() MongoDBListModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// MongoDBAccountsModel represents the MongoDB accounts page
+type MongoDBAccountsModel struct {
+	table      components.TableModel
+	accounts   []dds.Account
+	instanceId string
+	width      int
+	height     int
+}
+
+// NewMongoDBAccountsModel creates a new MongoDB accounts model
+func NewMongoDBAccountsModel() MongoDBAccountsModel {
+	columns := []table.Column{
+		{Title: "Account Name", Width: 25},
+		{Title: "Role", Width: 12},
+		{Title: "Status", Width: 12},
+		{Title: "Description", Width: 40},
+	}
+
+	return MongoDBAccountsModel{
+		table: components.NewTableModel(columns, "MongoDB Accounts"),
+	}
+}
+
+// SetData sets the accounts data
+func (m MongoDBAccountsModel) SetData(accounts []dds.Account, instanceId string) MongoDBAccountsModel {
+	m.accounts = accounts
+	m.instanceId = instanceId
+
+	rows := make([]table.Row, len(accounts))
+	rowData := make([]interface{}, len(accounts))
+
+	for i, account := range accounts {
+		rows[i] = table.Row{
+			account.AccountName,
+			valueOrDash(account.CharacterType), // normal on replica sets, db (shard), cs (config server) or mongos on sharded clusters
+			account.AccountStatus,
+			valueOrDash(account.AccountDescription),
+		}
+		rowData[i] = account
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("Accounts for MongoDB: %s", instanceId))
+	return m
+}
+
+// SetSize sets the size
+func (m MongoDBAccountsModel) SetSize(width, height int) MongoDBAccountsModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m MongoDBAccountsModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m MongoDBAccountsModel) Update(msg tea.Msg) (MongoDBAccountsModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m MongoDBAccountsModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m MongoDBAccountsModel) Search(query string) MongoDBAccountsModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m MongoDBAccountsModel) Filter(query string) MongoDBAccountsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m MongoDBAccountsModel) NextSearchMatch() MongoDBAccountsModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m MongoDBAccountsModel) PrevSearchMatch() MongoDBAccountsModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
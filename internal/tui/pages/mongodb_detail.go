@@ -0,0 +1,401 @@
+package pages
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/dds"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// MongoDBDetailModel is the formatted detail page of a MongoDB instance. The
+// connection strings and the replica set members or sharded cluster nodes
+// come from the topology, which loads after the page opens
+type MongoDBDetailModel struct {
+	instance dds.DBInstance
+	names    *service.NameResolver // Resolves referenced IDs to names, may be nil
+	topology *service.MongoDBTopology
+	topoErr  error
+	view     SectionDetailModel
+	keys     MongoDBDetailKeyMap
+}
+
+// MongoDBDetailKeyMap defines the MongoDB detail keys besides the navigation
+type MongoDBDetailKeyMap struct {
+	JSON        key.Binding
+	Accounts    key.Binding
+	Maintenance key.Binding
+}
+
+// DefaultMongoDBDetailKeyMap returns default key bindings
+func DefaultMongoDBDetailKeyMap() MongoDBDetailKeyMap {
+	return MongoDBDetailKeyMap{
+		JSON: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "JSON"),
+		),
+		Accounts:    DefaultMongoDBListKeyMap().Accounts,
+		Maintenance: maintenanceWindowKey(),
+	}
+}
+
+// NewMongoDBDetailModel creates a new MongoDB detail model
+func NewMongoDBDetailModel(instance dds.DBInstance) MongoDBDetailModel {
+	m := MongoDBDetailModel{
+		instance: instance,
+		view:     NewSectionDetailModel(),
+		keys:     DefaultMongoDBDetailKeyMap(),
+	}
+	return m.rebuild()
+}
+
+// NewMongoDBDetailModelFromInterface creates a new MongoDB detail model from
+// interface{}, and false when data is not a MongoDB instance
+func NewMongoDBDetailModelFromInterface(data interface{}) (MongoDBDetailModel, bool) {
+	if instance, ok := data.(dds.DBInstance); ok {
+		return NewMongoDBDetailModel(instance), true
+	}
+	return MongoDBDetailModel{}, false
+}
+
+// InstanceID returns the ID of the shown instance
+func (m MongoDBDetailModel) InstanceID() string {
+	return m.instance.DBInstanceId
+}
+
+// NameRefs returns the IDs referenced by the instance
+func (m MongoDBDetailModel) NameRefs() service.NameRefs {
+	refs := make(service.NameRefs)
+	refs.Add(service.KindVPC, m.instance.VPCId)
+	refs.Add(service.KindVSwitch, m.instance.VSwitchId)
+	refs.Add(service.KindResourceGroup, m.instance.ResourceGroupId)
+	return refs
+}
+
+// SetNames sets the resolver used to show the names of referenced resources
+func (m MongoDBDetailModel) SetNames(names *service.NameResolver) MongoDBDetailModel {
+	m.names = names
+	if m.instance.DBInstanceId == "" {
+		return m
+	}
+	return m.rebuild()
+}
+
+// SetTopology sets the instance topology, or the error that prevented
+// loading it. Its attributes replace the list entry, which lacks some of them
+func (m MongoDBDetailModel) SetTopology(topology *service.MongoDBTopology, err error) MongoDBDetailModel {
+	m.topology = topology
+	m.topoErr = err
+	if topology != nil {
+		m.instance = topology.Instance
+	}
+	return m.rebuild()
+}
+
+// rebuild renders the sections from the current data
+func (m MongoDBDetailModel) rebuild() MongoDBDetailModel {
+	inst := m.instance
+
+	basicInfo := DetailSection{
+		Title: i18n.T(i18n.KeySectionBasicInfo),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelInstanceID), Value: inst.DBInstanceId},
+			{Label: i18n.T(i18n.KeyLabelInstanceName), Value: valueOrDash(inst.DBInstanceDescription)},
+			{Label: i18n.T(i18n.KeyLabelInstanceStatus), Value: valueOrDash(inst.DBInstanceStatus), Status: true},
+			{Label: i18n.T(i18n.KeyLabelArchitecture), Value: mongoDBArchitecture(inst.DBInstanceType)},
+			{Label: i18n.T(i18n.KeyLabelEngineVersion), Value: strings.TrimSpace(inst.Engine + " " + inst.EngineVersion)},
+			{Label: i18n.T(i18n.KeyLabelStorageEngine), Value: valueOrDash(inst.StorageEngine)},
+			{Label: i18n.T(i18n.KeyColSpec), Value: valueOrDash(inst.DBInstanceClass)},
+			{Label: i18n.T(i18n.KeyLabelStorage), Value: m.formatStorage()},
+			{Label: i18n.T(i18n.KeyLabelMaxConnections), Value: fmt.Sprintf("%d", inst.MaxConnections)},
+			{Label: i18n.T(i18n.KeyColRegion), Value: valueOrDash(inst.RegionId)},
+			{Label: i18n.T(i18n.KeyLabelAvailZone), Value: m.formatZones()},
+			{Label: i18n.T(i18n.KeyColCreatedAt), Value: components.FormatTimeString(inst.CreationTime)},
+			{Label: i18n.T(i18n.KeyLabelMaintenance), Value: m.formatMaintenance()},
+		},
+	}
+
+	network := DetailSection{
+		Title: i18n.T(i18n.KeySectionNetwork),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelNetworkType), Value: valueOrDash(inst.NetworkType)},
+			{Label: i18n.T(i18n.KeyLabelVPC), Value: valueOrDash(m.names.Label(service.KindVPC, inst.VPCId))},
+			{Label: i18n.T(i18n.KeyLabelVSwitch), Value: valueOrDash(m.names.Label(service.KindVSwitch, inst.VSwitchId))},
+		},
+	}
+
+	billing := DetailSection{
+		Title: i18n.T(i18n.KeySectionBilling),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelChargeType), Value: m.formatChargeType()},
+			{Label: i18n.T(i18n.KeyLabelExpireTime), Value: m.formatExpiry()},
+		},
+	}
+
+	groupInfo := DetailSection{
+		Title: i18n.T(i18n.KeySectionGroupInfo),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelResourceGroup), Value: valueOrDash(m.names.Label(service.KindResourceGroup, inst.ResourceGroupId))},
+			{Label: i18n.T(i18n.KeyLabelTags), Value: m.formatTags()},
+		},
+	}
+
+	sections := []DetailSection{basicInfo, network, m.connectionsSection()}
+	sections = append(sections, m.topologySections()...)
+	m.view = m.view.SetSections(append(sections, billing, groupInfo))
+	return m
+}
+
+// connectionsSection lists the connection strings, one row per network
+func (m MongoDBDetailModel) connectionsSection() DetailSection {
+	section := DetailSection{Title: i18n.T(i18n.KeySectionConnections)}
+	if row, ok := m.topologyPlaceholder(section.Title); ok {
+		section.Rows = []DetailRow{row}
+		return section
+	}
+
+	for _, conn := range m.topology.Connections {
+		section.Rows = append(section.Rows, DetailRow{Label: conn.Network, Value: conn.URI})
+	}
+	if len(section.Rows) == 0 {
+		section.Rows = []DetailRow{{Label: section.Title, Value: "-"}}
+	}
+	return section
+}
+
+// topologySections describes the replica set members, or the mongos, shard
+// and config server nodes of a sharded cluster
+func (m MongoDBDetailModel) topologySections() []DetailSection {
+	if m.instance.DBInstanceType != service.MongoDBTypeSharding {
+		section := DetailSection{Title: i18n.T(i18n.KeySectionReplicaSet)}
+		if m.instance.ReplicaSetName != "" {
+			section.Title = fmt.Sprintf("%s: %s", section.Title, m.instance.ReplicaSetName)
+		}
+		if row, ok := m.topologyPlaceholder(i18n.T(i18n.KeySectionReplicaSet)); ok {
+			section.Rows = []DetailRow{row}
+			return []DetailSection{section}
+		}
+		for _, member := range m.topology.Members {
+			section.Rows = append(section.Rows, DetailRow{
+				Label: valueOrDash(member.ReplicaSetRole),
+				Value: fmt.Sprintf("%s:%s  %s", member.ConnectionDomain, member.ConnectionPort, mongoDBNetworkLabel(member)),
+			})
+		}
+		return []DetailSection{withNodeCount(section)}
+	}
+
+	// The instance list already carries the nodes of sharded clusters, so
+	// they show before the topology loads
+	inst := m.instance
+	mongos := DetailSection{Title: i18n.T(i18n.KeySectionMongos)}
+	for _, node := range inst.MongosList.MongosAttribute {
+		mongos.Rows = append(mongos.Rows, DetailRow{
+			Label: node.NodeId,
+			Value: mongoDBNodeValue(node.NodeClass, 0, node.Status, mongoDBEndpoint(node.ConnectSting, node.Port)),
+		})
+	}
+	shards := DetailSection{Title: i18n.T(i18n.KeySectionShards)}
+	for _, node := range inst.ShardList.ShardAttribute {
+		shards.Rows = append(shards.Rows, DetailRow{
+			Label: node.NodeId,
+			Value: mongoDBNodeValue(node.NodeClass, node.NodeStorage, node.Status, mongoDBEndpoint(node.ConnectString, node.Port)),
+		})
+	}
+	configServers := DetailSection{Title: i18n.T(i18n.KeySectionConfigServers)}
+	for _, node := range inst.ConfigserverList.ConfigserverAttribute {
+		configServers.Rows = append(configServers.Rows, DetailRow{
+			Label: node.NodeId,
+			Value: mongoDBNodeValue(node.NodeClass, node.NodeStorage, node.Status, mongoDBEndpoint(node.ConnectString, node.Port)),
+		})
+	}
+	return []DetailSection{withNodeCount(mongos), withNodeCount(shards), withNodeCount(configServers)}
+}
+
+// topologyPlaceholder returns the row shown while the topology loads or
+// when it failed, and false once it is loaded
+func (m MongoDBDetailModel) topologyPlaceholder(label string) (DetailRow, bool) {
+	switch {
+	case m.topoErr != nil:
+		return DetailRow{Label: label, Value: fmt.Sprintf("%s: %v", i18n.T(i18n.KeyDetailUnavailable), m.topoErr)}, true
+	case m.topology == nil:
+		return DetailRow{Label: label, Value: i18n.T(i18n.KeyActionLoading)}, true
+	}
+	return DetailRow{}, false
+}
+
+// withNodeCount adds the node count to a topology section title, with a
+// "no nodes" row when it is empty
+func withNodeCount(section DetailSection) DetailSection {
+	section.Title = fmt.Sprintf("%s (%d)", section.Title, len(section.Rows))
+	if len(section.Rows) == 0 {
+		section.Rows = []DetailRow{{Label: "-", Value: i18n.T(i18n.KeyMongoDBNoNodes)}}
+	}
+	return section
+}
+
+// mongoDBNetworkLabel shows the network of a replica set member endpoint
+func mongoDBNetworkLabel(member dds.ReplicaSet) string {
+	if member.ConnectionType != "" && !strings.EqualFold(member.ConnectionType, member.NetworkType) {
+		return fmt.Sprintf("(%s, %s)", member.NetworkType, member.ConnectionType)
+	}
+	return fmt.Sprintf("(%s)", valueOrDash(member.NetworkType))
+}
+
+// mongoDBEndpoint formats a node address, "" when the node has none
+func mongoDBEndpoint(host string, port int) string {
+	if host == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", host, port)
+}
+
+// mongoDBNodeValue summarizes a sharded cluster node: class, storage when it
+// has any, status and address
+func mongoDBNodeValue(class string, storageGB int, status, endpoint string) string {
+	parts := []string{valueOrDash(class)}
+	if storageGB > 0 {
+		parts = append(parts, fmt.Sprintf("%d GB", storageGB))
+	}
+	parts = append(parts, valueOrDash(status))
+	if endpoint != "" {
+		parts = append(parts, endpoint)
+	}
+	return strings.Join(parts, "  ")
+}
+
+// formatStorage shows the storage size and type
+func (m MongoDBDetailModel) formatStorage() string {
+	if m.instance.DBInstanceStorage == 0 {
+		return "-"
+	}
+	if m.instance.StorageType == "" {
+		return fmt.Sprintf("%d GB", m.instance.DBInstanceStorage)
+	}
+	return fmt.Sprintf("%d GB (%s)", m.instance.DBInstanceStorage, m.instance.StorageType)
+}
+
+// formatZones shows the primary zone with the secondary and hidden zones of
+// multi-zone instances
+func (m MongoDBDetailModel) formatZones() string {
+	zones := []string{valueOrDash(m.instance.ZoneId)}
+	for _, zone := range []string{m.instance.SecondaryZoneId, m.instance.HiddenZoneId} {
+		if zone != "" && zone != m.instance.ZoneId {
+			zones = append(zones, zone)
+		}
+	}
+	return strings.Join(zones, ", ")
+}
+
+// maintenanceWindow returns the maintenance window of the instance
+func (m MongoDBDetailModel) maintenanceWindow() service.MaintenanceWindow {
+	return service.MaintenanceWindow{Start: m.instance.MaintainStartTime, End: m.instance.MaintainEndTime}
+}
+
+// formatMaintenance shows the maintenance window in UTC, as the API reports
+// it, and local time
+func (m MongoDBDetailModel) formatMaintenance() string {
+	if m.instance.MaintainStartTime == "" {
+		return "-"
+	}
+	return formatMaintenanceWindow(m.maintenanceWindow().String())
+}
+
+// formatChargeType shows the billing method in the wording of the ECS detail
+func (m MongoDBDetailModel) formatChargeType() string {
+	switch m.instance.ChargeType {
+	case "PrePaid":
+		return i18n.T(i18n.KeyChargePrePaid)
+	case "PostPaid":
+		return i18n.T(i18n.KeyChargePostPaid)
+	default:
+		return valueOrDash(m.instance.ChargeType)
+	}
+}
+
+// formatExpiry shows when a subscription instance expires
+func (m MongoDBDetailModel) formatExpiry() string {
+	if m.instance.ChargeType != "PrePaid" {
+		return "-"
+	}
+	return components.FormatTimeString(m.instance.ExpireTime)
+}
+
+// formatTags formats the instance tags as "key: value" pairs
+func (m MongoDBDetailModel) formatTags() string {
+	if len(m.instance.Tags.Tag) == 0 {
+		return "-"
+	}
+	tags := make([]string, len(m.instance.Tags.Tag))
+	for i, tag := range m.instance.Tags.Tag {
+		tags[i] = fmt.Sprintf("%s: %s", tag.Key, tag.Value)
+	}
+	return strings.Join(tags, ", ")
+}
+
+// SetSize sets the size of the detail view
+func (m MongoDBDetailModel) SetSize(width, height int) MongoDBDetailModel {
+	m.view = m.view.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m MongoDBDetailModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m MongoDBDetailModel) Update(msg tea.Msg) (MongoDBDetailModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		var nav *types.NavigateMsg
+		switch {
+		case key.Matches(msg, m.keys.JSON):
+			var data interface{} = m.instance
+			if m.topology != nil {
+				data = m.topology
+			}
+			nav = &types.NavigateMsg{Page: types.PageMongoDBJSONDetail, Data: data}
+		case key.Matches(msg, m.keys.Accounts):
+			nav = &types.NavigateMsg{Page: types.PageMongoDBAccounts, Data: m.instance.DBInstanceId}
+		case key.Matches(msg, m.keys.Maintenance):
+			req := MaintenanceWindowRequestMsg{Page: types.PageMongoDBDetail, InstanceID: m.instance.DBInstanceId}
+			if m.instance.MaintainStartTime != "" {
+				req.Current = m.maintenanceWindow().String()
+			}
+			return m, func() tea.Msg { return req }
+		}
+		if nav != nil {
+			return m, func() tea.Msg { return *nav }
+		}
+	}
+
+	var cmd tea.Cmd
+	m.view, cmd = m.view.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m MongoDBDetailModel) View() string {
+	return m.view.View()
+}
+
+// Search placeholder for interface compatibility
+func (m MongoDBDetailModel) Search(query string) MongoDBDetailModel {
+	return m
+}
+
+// NextSearchMatch placeholder
+func (m MongoDBDetailModel) NextSearchMatch() MongoDBDetailModel {
+	return m
+}
+
+// PrevSearchMatch placeholder
+func (m MongoDBDetailModel) PrevSearchMatch() MongoDBDetailModel {
+	return m
+}
@@ -0,0 +1,433 @@
+package pages
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// NATListModel represents the NAT gateways list page
+type NATListModel struct {
+	table    components.TableModel
+	gateways []vpc.NatGateway
+	width    int
+	height   int
+	keys     NATListKeyMap
+}
+
+// NATListKeyMap defines key bindings
+type NATListKeyMap struct {
+	Enter key.Binding
+	SNAT  key.Binding
+	DNAT  key.Binding
+}
+
+// DefaultNATListKeyMap returns default key bindings
+func DefaultNATListKeyMap() NATListKeyMap {
+	return NATListKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "details"),
+		),
+		SNAT: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp("s", "SNAT entries"),
+		),
+		DNAT: key.NewBinding(
+			key.WithKeys("d"),
+			key.WithHelp("d", "DNAT entries"),
+		),
+	}
+}
+
+// NewNATListModel creates a new NAT gateways list model
+func NewNATListModel() NATListModel {
+	columns := []components.ColumnSpec{
+		{Title: "NAT Gateway ID", Width: 26},
+		{Title: "Name", Width: 24},
+		{Title: "Type", Width: 10},
+		{Title: "Spec", Width: 8},
+		{Title: "Status", Width: 10},
+		{Title: "VPC ID", Width: 26},
+		{Title: "Public IPs", Width: 34, Format: components.FormatIPList},
+	}
+
+	return NATListModel{
+		table: components.NewTableModelFromSpecs(columns, "NAT Gateways"),
+		keys:  DefaultNATListKeyMap(),
+	}
+}
+
+// SetData sets the NAT gateways data
+func (m NATListModel) SetData(gateways []vpc.NatGateway) NATListModel {
+	m.gateways = gateways
+
+	values := make([][]interface{}, len(gateways))
+	rowData := make([]interface{}, len(gateways))
+
+	for i, gw := range gateways {
+		values[i] = []interface{}{
+			gw.NatGatewayId,
+			gw.Name,
+			gw.NatType,
+			valueOrDash(gw.Spec),
+			gw.Status,
+			gw.VpcId,
+			NATPublicIPs(gw),
+		}
+		rowData[i] = gw
+	}
+
+	m.table = m.table.SetValues(values)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// NATPublicIPs returns the EIP addresses bound to a NAT gateway
+func NATPublicIPs(gw vpc.NatGateway) []string {
+	var ips []string
+	for _, ip := range gw.IpLists.IpList {
+		if ip.IpAddress != "" {
+			ips = append(ips, ip.IpAddress)
+		}
+	}
+	return ips
+}
+
+// SetSize sets the size
+func (m NATListModel) SetSize(width, height int) NATListModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedGateway returns the selected NAT gateway
+func (m NATListModel) SelectedGateway() *vpc.NatGateway {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.gateways) {
+		return &m.gateways[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m NATListModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m NATListModel) Update(msg tea.Msg) (NATListModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		page := types.PageMenu
+		switch {
+		case key.Matches(msg, m.keys.Enter):
+			page = types.PageNATDetail
+		case key.Matches(msg, m.keys.SNAT):
+			page = types.PageSNATEntries
+		case key.Matches(msg, m.keys.DNAT):
+			page = types.PageDNATEntries
+		}
+		if page != types.PageMenu {
+			if gw := m.SelectedGateway(); gw != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{Page: page, Data: *gw}
+				}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m NATListModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m NATListModel) Search(query string) NATListModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m NATListModel) Filter(query string) NATListModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m NATListModel) NextSearchMatch() NATListModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m NATListModel) PrevSearchMatch() NATListModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// SNATEntriesModel lists the SNAT entries of a NAT gateway: which vSwitches
+// or CIDR blocks reach the internet through which public IPs
+type SNATEntriesModel struct {
+	table     components.TableModel
+	entries   []vpc.SnatTableEntry
+	gatewayID string
+	width     int
+	height    int
+}
+
+// NewSNATEntriesModel creates a new SNAT entries model
+func NewSNATEntriesModel() SNATEntriesModel {
+	columns := []table.Column{
+		{Title: "SNAT Entry ID", Width: 26},
+		{Title: "Name", Width: 24},
+		{Title: "Source", Width: 30},
+		{Title: "SNAT IPs", Width: 34},
+		{Title: "Status", Width: 10},
+	}
+
+	return SNATEntriesModel{
+		table: components.NewTableModel(columns, "SNAT Entries"),
+	}
+}
+
+// SetData sets the SNAT entries of a gateway
+func (m SNATEntriesModel) SetData(entries []vpc.SnatTableEntry, gatewayID string) SNATEntriesModel {
+	m.entries = entries
+	m.gatewayID = gatewayID
+
+	rows := make([]table.Row, len(entries))
+	rowData := make([]interface{}, len(entries))
+
+	for i, entry := range entries {
+		// An entry's source is either a vSwitch or a CIDR block
+		source := entry.SourceCIDR
+		if entry.SourceVSwitchId != "" {
+			source = entry.SourceVSwitchId
+		}
+		rows[i] = table.Row{
+			entry.SnatEntryId,
+			entry.SnatEntryName,
+			source,
+			entry.SnatIp,
+			entry.Status,
+		}
+		rowData[i] = entry
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("SNAT Entries for %s", gatewayID))
+	return m
+}
+
+// GatewayID returns the NAT gateway whose entries are shown
+func (m SNATEntriesModel) GatewayID() string {
+	return m.gatewayID
+}
+
+// SetSize sets the size
+func (m SNATEntriesModel) SetSize(width, height int) SNATEntriesModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m SNATEntriesModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m SNATEntriesModel) Update(msg tea.Msg) (SNATEntriesModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m SNATEntriesModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m SNATEntriesModel) Search(query string) SNATEntriesModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m SNATEntriesModel) Filter(query string) SNATEntriesModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m SNATEntriesModel) NextSearchMatch() SNATEntriesModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m SNATEntriesModel) PrevSearchMatch() SNATEntriesModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// DNATTargetRequestMsg asks the app to open the ECS instance a DNAT entry
+// forwards to
+type DNATTargetRequestMsg struct {
+	VpcID      string
+	InternalIP string
+}
+
+// DNATEntriesModel lists the DNAT (port forwarding) entries of a NAT gateway
+type DNATEntriesModel struct {
+	table   components.TableModel
+	entries []vpc.ForwardTableEntry
+	gateway vpc.NatGateway
+	width   int
+	height  int
+	keys    DNATEntriesKeyMap
+}
+
+// DNATEntriesKeyMap defines key bindings
+type DNATEntriesKeyMap struct {
+	Enter key.Binding
+}
+
+// DefaultDNATEntriesKeyMap returns default key bindings
+func DefaultDNATEntriesKeyMap() DNATEntriesKeyMap {
+	return DNATEntriesKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "target instance"),
+		),
+	}
+}
+
+// NewDNATEntriesModel creates a new DNAT entries model
+func NewDNATEntriesModel() DNATEntriesModel {
+	columns := []table.Column{
+		{Title: "DNAT Entry ID", Width: 26},
+		{Title: "Name", Width: 24},
+		{Title: "Protocol", Width: 8},
+		{Title: "External", Width: 24},
+		{Title: "Internal", Width: 24},
+		{Title: "Status", Width: 10},
+	}
+
+	return DNATEntriesModel{
+		table: components.NewTableModel(columns, "DNAT Entries"),
+		keys:  DefaultDNATEntriesKeyMap(),
+	}
+}
+
+// SetData sets the DNAT entries of a gateway
+func (m DNATEntriesModel) SetData(entries []vpc.ForwardTableEntry, gateway vpc.NatGateway) DNATEntriesModel {
+	m.entries = entries
+	m.gateway = gateway
+
+	rows := make([]table.Row, len(entries))
+	rowData := make([]interface{}, len(entries))
+
+	for i, entry := range entries {
+		rows[i] = table.Row{
+			entry.ForwardEntryId,
+			entry.ForwardEntryName,
+			entry.IpProtocol,
+			entry.ExternalIp + ":" + entry.ExternalPort,
+			entry.InternalIp + ":" + entry.InternalPort,
+			entry.Status,
+		}
+		rowData[i] = entry
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("DNAT Entries for %s", gateway.NatGatewayId))
+	return m
+}
+
+// GatewayID returns the NAT gateway whose entries are shown
+func (m DNATEntriesModel) GatewayID() string {
+	return m.gateway.NatGatewayId
+}
+
+// SetSize sets the size
+func (m DNATEntriesModel) SetSize(width, height int) DNATEntriesModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedEntry returns the selected DNAT entry
+func (m DNATEntriesModel) SelectedEntry() *vpc.ForwardTableEntry {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.entries) {
+		return &m.entries[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m DNATEntriesModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m DNATEntriesModel) Update(msg tea.Msg) (DNATEntriesModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		if entry := m.SelectedEntry(); entry != nil {
+			request := DNATTargetRequestMsg{VpcID: m.gateway.VpcId, InternalIP: entry.InternalIp}
+			return m, func() tea.Msg { return request }
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m DNATEntriesModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m DNATEntriesModel) Search(query string) DNATEntriesModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m DNATEntriesModel) Filter(query string) DNATEntriesModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m DNATEntriesModel) NextSearchMatch() DNATEntriesModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m DNATEntriesModel) PrevSearchMatch() DNATEntriesModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
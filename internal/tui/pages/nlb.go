@@ -0,0 +1,417 @@
+package pages
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/nlb"
+
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// NLBListModel represents the Network Load Balancer list page
+type NLBListModel struct {
+	table         components.TableModel
+	loadBalancers []nlb.LoadbalancerInfo
+	width         int
+	height        int
+	keys          ALBListKeyMap
+}
+
+// NewNLBListModel creates a new NLB list model
+func NewNLBListModel() NLBListModel {
+	columns := []table.Column{
+		{Title: "Load Balancer ID", Width: 26},
+		{Title: "Name", Width: 24},
+		{Title: "DNS Name", Width: 48},
+		{Title: "Address Type", Width: 12},
+		{Title: "Addresses", Width: 34},
+		{Title: "Status", Width: 12},
+		{Title: "VPC ID", Width: 26},
+	}
+
+	return NLBListModel{
+		table: components.NewTableModel(columns, "Network Load Balancers"),
+		keys:  DefaultALBListKeyMap(),
+	}
+}
+
+// SetData sets the NLB instances data
+func (m NLBListModel) SetData(loadBalancers []nlb.LoadbalancerInfo) NLBListModel {
+	m.loadBalancers = loadBalancers
+
+	rows := make([]table.Row, len(loadBalancers))
+	rowData := make([]interface{}, len(loadBalancers))
+
+	for i, lb := range loadBalancers {
+		rows[i] = table.Row{
+			lb.LoadBalancerId,
+			valueOrDash(lb.LoadBalancerName),
+			lb.DNSName,
+			lb.AddressType,
+			valueOrDash(strings.Join(NLBAddresses(lb), ", ")),
+			lb.LoadBalancerStatus,
+			lb.VpcId,
+		}
+		rowData[i] = lb
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// NLBAddresses returns the addresses of an NLB instance across its zones,
+// public addresses first
+func NLBAddresses(lb nlb.LoadbalancerInfo) []string {
+	var public, private []string
+	for _, zone := range lb.ZoneMappings {
+		for _, addr := range zone.LoadBalancerAddresses {
+			if addr.PublicIPv4Address != "" {
+				public = append(public, addr.PublicIPv4Address)
+			}
+			if addr.PrivateIPv4Address != "" {
+				private = append(private, addr.PrivateIPv4Address)
+			}
+		}
+	}
+	return append(public, private...)
+}
+
+// SetSize sets the size
+func (m NLBListModel) SetSize(width, height int) NLBListModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedLoadBalancer returns the selected NLB instance
+func (m NLBListModel) SelectedLoadBalancer() *nlb.LoadbalancerInfo {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.loadBalancers) {
+		return &m.loadBalancers[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m NLBListModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m NLBListModel) Update(msg tea.Msg) (NLBListModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		page := types.PageMenu
+		switch {
+		case key.Matches(msg, m.keys.Enter):
+			page = types.PageNLBDetail
+		case key.Matches(msg, m.keys.Listeners):
+			page = types.PageNLBListeners
+		case key.Matches(msg, m.keys.ServerGroups):
+			page = types.PageNLBServerGroups
+		}
+		if page != types.PageMenu {
+			if lb := m.SelectedLoadBalancer(); lb != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{Page: page, Data: *lb}
+				}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m NLBListModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m NLBListModel) Search(query string) NLBListModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m NLBListModel) Filter(query string) NLBListModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m NLBListModel) NextSearchMatch() NLBListModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m NLBListModel) PrevSearchMatch() NLBListModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// NLBListenersModel lists the listeners of an NLB instance. NLB has no
+// forwarding rules: every listener forwards to a single server group
+type NLBListenersModel struct {
+	table          components.TableModel
+	listeners      []nlb.ListenerInfo
+	loadBalancerID string
+	width          int
+	height         int
+	keys           LBServerGroupsKeyMap
+}
+
+// NewNLBListenersModel creates a new NLB listeners model
+func NewNLBListenersModel() NLBListenersModel {
+	columns := []table.Column{
+		{Title: "Listener ID", Width: 26},
+		{Title: "Protocol", Width: 10},
+		{Title: "Port", Width: 12},
+		{Title: "Server Group", Width: 26},
+		{Title: "Status", Width: 10},
+		{Title: "Description", Width: 30},
+	}
+
+	return NLBListenersModel{
+		table: components.NewTableModel(columns, "NLB Listeners"),
+		keys:  DefaultLBServerGroupsKeyMap(),
+	}
+}
+
+// SetData sets the listeners of an NLB instance
+func (m NLBListenersModel) SetData(listeners []nlb.ListenerInfo, loadBalancerID string) NLBListenersModel {
+	m.listeners = listeners
+	m.loadBalancerID = loadBalancerID
+
+	rows := make([]table.Row, len(listeners))
+	rowData := make([]interface{}, len(listeners))
+
+	for i, listener := range listeners {
+		// Multi-port listeners report a port range instead of a single port
+		port := fmt.Sprintf("%d", listener.ListenerPort)
+		if listener.ListenerPort == 0 && listener.StartPort != "" {
+			port = listener.StartPort + "-" + listener.EndPort
+		}
+		rows[i] = table.Row{
+			listener.ListenerId,
+			listener.ListenerProtocol,
+			port,
+			valueOrDash(listener.ServerGroupId),
+			listener.ListenerStatus,
+			valueOrDash(listener.ListenerDescription),
+		}
+		rowData[i] = listener
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("Listeners for NLB: %s", loadBalancerID))
+	return m
+}
+
+// SetSize sets the size
+func (m NLBListenersModel) SetSize(width, height int) NLBListenersModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedListener returns the selected listener
+func (m NLBListenersModel) SelectedListener() *nlb.ListenerInfo {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.listeners) {
+		return &m.listeners[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m NLBListenersModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m NLBListenersModel) Update(msg tea.Msg) (NLBListenersModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		if listener := m.SelectedListener(); listener != nil && listener.ServerGroupId != "" {
+			navData := LBServerGroupNavData{Kind: LBKindNLB, ServerGroupID: listener.ServerGroupId}
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageLBServers, Data: navData}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m NLBListenersModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m NLBListenersModel) Search(query string) NLBListenersModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m NLBListenersModel) Filter(query string) NLBListenersModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m NLBListenersModel) NextSearchMatch() NLBListenersModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m NLBListenersModel) PrevSearchMatch() NLBListenersModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// NLBServerGroupsModel lists the server groups used by an NLB instance
+type NLBServerGroupsModel struct {
+	table          components.TableModel
+	groups         []nlb.ServerGroup
+	loadBalancerID string
+	width          int
+	height         int
+	keys           LBServerGroupsKeyMap
+}
+
+// NewNLBServerGroupsModel creates a new NLB server groups model
+func NewNLBServerGroupsModel() NLBServerGroupsModel {
+	columns := []table.Column{
+		{Title: "Server Group ID / Name", Width: 45},
+		{Title: "Type", Width: 10},
+		{Title: "Protocol", Width: 8},
+		{Title: "Scheduler", Width: 10},
+		{Title: "Health Check", Width: 20},
+		{Title: "Servers", Width: 10},
+		{Title: "Status", Width: 12},
+	}
+
+	return NLBServerGroupsModel{
+		table: components.NewTableModel(columns, "NLB Server Groups").SetBarColumns(5),
+		keys:  DefaultLBServerGroupsKeyMap(),
+	}
+}
+
+// SetData sets the server groups of an NLB instance
+func (m NLBServerGroupsModel) SetData(groups []nlb.ServerGroup, loadBalancerID string) NLBServerGroupsModel {
+	m.groups = groups
+	m.loadBalancerID = loadBalancerID
+
+	rows := make([]table.Row, len(groups))
+	rowData := make([]interface{}, len(groups))
+
+	for i, group := range groups {
+		healthCheck := "off"
+		if group.HealthCheck.HealthCheckEnabled {
+			healthCheck = group.HealthCheck.HealthCheckType
+		}
+		rows[i] = table.Row{
+			lbServerGroupIDName(group.ServerGroupId, group.ServerGroupName),
+			group.ServerGroupType,
+			group.Protocol,
+			group.Scheduler,
+			healthCheck,
+			fmt.Sprintf("%d", group.ServerCount),
+			group.ServerGroupStatus,
+		}
+		rowData[i] = group
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("Server Groups for NLB: %s", loadBalancerID))
+	return m
+}
+
+// SetSize sets the size
+func (m NLBServerGroupsModel) SetSize(width, height int) NLBServerGroupsModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedServerGroup returns the selected server group
+func (m NLBServerGroupsModel) SelectedServerGroup() *nlb.ServerGroup {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.groups) {
+		return &m.groups[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m NLBServerGroupsModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m NLBServerGroupsModel) Update(msg tea.Msg) (NLBServerGroupsModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		if group := m.SelectedServerGroup(); group != nil {
+			navData := LBServerGroupNavData{
+				Kind:            LBKindNLB,
+				ServerGroupID:   group.ServerGroupId,
+				ServerGroupName: group.ServerGroupName,
+			}
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageLBServers, Data: navData}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m NLBServerGroupsModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m NLBServerGroupsModel) Search(query string) NLBServerGroupsModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m NLBServerGroupsModel) Filter(query string) NLBServerGroupsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m NLBServerGroupsModel) NextSearchMatch() NLBServerGroupsModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m NLBServerGroupsModel) PrevSearchMatch() NLBServerGroupsModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
@@ -1,7 +1,9 @@
 package pages
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/table"
@@ -10,7 +12,9 @@ import (
 
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 
+	"aliyun-tui-viewer/internal/i18n"
 	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/theme"
 	"aliyun-tui-viewer/internal/tui/components"
 	"aliyun-tui-viewer/internal/tui/types"
 )
@@ -26,7 +30,8 @@ type OSSBucketsModel struct {
 
 // OSSBucketsKeyMap defines key bindings
 type OSSBucketsKeyMap struct {
-	Enter key.Binding
+	Enter  key.Binding
+	Detail key.Binding
 }
 
 // DefaultOSSBucketsKeyMap returns default key bindings
@@ -36,6 +41,10 @@ func DefaultOSSBucketsKeyMap() OSSBucketsKeyMap {
 			key.WithKeys("enter"),
 			key.WithHelp("enter", "objects"),
 		),
+		Detail: key.NewBinding(
+			key.WithKeys("i"),
+			key.WithHelp("i", "bucket detail"),
+		),
 	}
 }
 
@@ -65,7 +74,7 @@ func (m OSSBucketsModel) SetData(buckets []oss.BucketProperties) OSSBucketsModel
 		rows[i] = table.Row{
 			bucket.Name,
 			bucket.Location,
-			bucket.CreationDate.Format("2006-01-02 15:04:05"),
+			components.FormatTime(bucket.CreationDate),
 			bucket.StorageClass,
 		}
 		rowData[i] = bucket
@@ -112,6 +121,16 @@ func (m OSSBucketsModel) Update(msg tea.Msg) (OSSBucketsModel, tea.Cmd) {
 					}
 				}
 			}
+
+		case key.Matches(msg, m.keys.Detail):
+			if bucket := m.SelectedBucket(); bucket != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageOSSBucketDetail,
+						Data: bucket.Name,
+					}
+				}
+			}
 		}
 	}
 
@@ -125,12 +144,23 @@ func (m OSSBucketsModel) View() string {
 	return m.table.View()
 }
 
+// Table returns the list's table, used to render it outside the TUI
+func (m OSSBucketsModel) Table() components.TableModel {
+	return m.table
+}
+
 // Search searches in the list
 func (m OSSBucketsModel) Search(query string) OSSBucketsModel {
 	m.table = m.table.Search(query)
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m OSSBucketsModel) Filter(query string) OSSBucketsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m OSSBucketsModel) NextSearchMatch() OSSBucketsModel {
 	m.table = m.table.NextSearchMatch()
@@ -148,6 +178,8 @@ type OSSObjectsModel struct {
 	table      components.TableModel
 	objects    []oss.ObjectProperties
 	bucketName string
+	prefix     string     // Current folder, "" for the bucket root
+	entries    []ossEntry // Table rows: parent link, folders, then objects
 	width      int
 	height     int
 	keys       OSSObjectsKeyMap
@@ -161,14 +193,40 @@ type OSSObjectsModel struct {
 	previousMarkers []string
 	pageSize        int
 	ossSvc          *service.OSSService
+	ctx             context.Context // Loads of the page run with it, cancelled once the page is left
+}
+
+// ossDelimiter separates folder levels in object keys
+const ossDelimiter = "/"
+
+// ossPaginationStyle renders the line below the objects table
+func ossPaginationStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(theme.Current().Secondary)
+}
+
+// ossEntry is one row of the objects table
+type ossEntry struct {
+	prefix string // Folder to open; for the parent link, the folder above
+	parent bool
+	object *oss.ObjectProperties
 }
 
 // OSSObjectsKeyMap defines key bindings
 type OSSObjectsKeyMap struct {
 	Enter     key.Binding
+	Up        key.Binding
 	NextPage  key.Binding
 	PrevPage  key.Binding
 	FirstPage key.Binding
+	Download  key.Binding
+	Preview   key.Binding
+	Versions  key.Binding
+	LoadAll   key.Binding
+	Search    key.Binding
+	Restore   key.Binding
+	Deleted   key.Binding
+	Copy      key.Binding
+	Move      key.Binding
 }
 
 // DefaultOSSObjectsKeyMap returns default key bindings
@@ -178,6 +236,10 @@ func DefaultOSSObjectsKeyMap() OSSObjectsKeyMap {
 			key.WithKeys("enter"),
 			key.WithHelp("enter", "details"),
 		),
+		Up: key.NewBinding(
+			key.WithKeys("backspace"),
+			key.WithHelp("backspace", "parent folder"),
+		),
 		NextPage: key.NewBinding(
 			key.WithKeys("]"),
 			key.WithHelp("]", "next page"),
@@ -190,59 +252,180 @@ func DefaultOSSObjectsKeyMap() OSSObjectsKeyMap {
 			key.WithKeys("0"),
 			key.WithHelp("0", "first page"),
 		),
+		Download: key.NewBinding(
+			key.WithKeys("d", "s"),
+			key.WithHelp("d/s", "download"),
+		),
+		Preview: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "preview"),
+		),
+		Versions: key.NewBinding(
+			key.WithKeys("h"),
+			key.WithHelp("h", "versions"),
+		),
+		LoadAll: key.NewBinding(
+			key.WithKeys("A"),
+			key.WithHelp("A", "load all under folder"),
+		),
+		Search: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "prefix search"),
+		),
+		Restore: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "restore archived object"),
+		),
+		Deleted: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "deleted objects"),
+		),
+		Copy: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "copy"),
+		),
+		Move: key.NewBinding(
+			key.WithKeys("m"),
+			key.WithHelp("m", "move"),
+		),
 	}
 }
 
 // NewOSSObjectsModel creates a new OSS objects model
-func NewOSSObjectsModel(svc *service.OSSService, bucketName string) OSSObjectsModel {
+func NewOSSObjectsModel(ctx context.Context, svc *service.OSSService, bucketName string) OSSObjectsModel {
 	columns := []table.Column{
 		{Title: "Object Key", Width: 80},
-		{Title: "Size", Width: 12},
+		{Title: "Size", Width: 24},
 		{Title: "Last Modified", Width: 22},
 		{Title: "Storage Class", Width: 14},
+		{Title: "Restore", Width: 28},
 		{Title: "ETag", Width: 36},
 	}
 
 	return OSSObjectsModel{
-		table:           components.NewTableModel(columns, fmt.Sprintf("Objects in %s", bucketName)),
+		table:           components.NewTableModel(columns, fmt.Sprintf("Objects in %s", bucketName)).SetBarColumns(1),
 		bucketName:      bucketName,
 		keys:            DefaultOSSObjectsKeyMap(),
 		pageSize:        20,
 		currentPage:     1,
 		previousMarkers: []string{},
 		ossSvc:          svc,
+		ctx:             ctx,
 	}
 }
 
 // SetData sets the objects data with pagination info
 func (m OSSObjectsModel) SetData(result *service.ObjectListResult, bucketName string, page int) OSSObjectsModel {
+	// The selection is relative to a folder, so another folder starts
+	// without any; objects still listed in the same one stay selected
+	selected := make(map[string]bool)
+	if result.Prefix == m.prefix && bucketName == m.bucketName {
+		for _, obj := range m.SelectedObjects() {
+			selected[obj.Key] = true
+		}
+	}
 	m.objects = result.Objects
 	m.bucketName = bucketName
+	m.prefix = result.Prefix
 	m.currentPage = page
 	m.hasNextPage = result.IsTruncated
 	m.nextMarker = result.NextMarker
 	m.hasPrevPage = len(m.previousMarkers) > 0
 
-	rows := make([]table.Row, len(m.objects))
-	rowData := make([]interface{}, len(m.objects))
+	m.entries = nil
+	var rows []table.Row
+	var rowData []interface{}
+	var selection []int
 
-	for i, obj := range m.objects {
-		rows[i] = table.Row{
-			obj.Key,
-			formatSize(obj.Size),
-			obj.LastModified.Format("2006-01-02 15:04:05"),
-			obj.StorageClass,
-			obj.ETag,
+	if m.prefix != "" {
+		m.entries = append(m.entries, ossEntry{prefix: parentPrefix(m.prefix), parent: true})
+		rows = append(rows, table.Row{"..", "-", "", "", "", ""})
+		rowData = append(rowData, parentPrefix(m.prefix))
+	}
+	for _, prefix := range result.Prefixes {
+		m.entries = append(m.entries, ossEntry{prefix: prefix})
+		rows = append(rows, table.Row{strings.TrimPrefix(prefix, m.prefix), "-", "", "", "", ""})
+		rowData = append(rowData, prefix)
+	}
+	for i := range m.objects {
+		obj := &m.objects[i]
+		// The folder placeholder object duplicates the folder itself
+		if m.prefix != "" && obj.Key == m.prefix {
+			continue
 		}
-		rowData[i] = obj
+		if selected[obj.Key] {
+			selection = append(selection, len(rows))
+		}
+		m.entries = append(m.entries, ossEntry{object: obj})
+		rows = append(rows, m.objectRow(*obj))
+		rowData = append(rowData, *obj)
 	}
 
 	m.table = m.table.SetRows(rows)
 	m.table = m.table.SetRowData(rowData)
-	m.table = m.table.SetTitle(fmt.Sprintf("Objects in %s (Page %d)", bucketName, page))
+	m.table = m.table.SetSelection(selection)
+	m.table = m.table.SetTitle(m.title())
+	return m
+}
+
+// objectRow returns the table row of an object
+func (m OSSObjectsModel) objectRow(obj oss.ObjectProperties) table.Row {
+	return table.Row{
+		strings.TrimPrefix(obj.Key, m.prefix),
+		FormatSize(obj.Size),
+		components.FormatTime(obj.LastModified),
+		obj.StorageClass,
+		valueOrDash(service.RestoreStatusOf(obj).String()),
+		obj.ETag,
+	}
+}
+
+// title returns the table title with the folder and page
+func (m OSSObjectsModel) title() string {
+	return fmt.Sprintf(i18n.T(i18n.KeyOSSObjectsTitle), m.Breadcrumb(), m.currentPage)
+}
+
+// SelectedObjects returns the objects selected with space or V, leaving out
+// the folders and the parent link
+func (m OSSObjectsModel) SelectedObjects() []oss.ObjectProperties {
+	var objects []oss.ObjectProperties
+	for _, data := range m.table.SelectionData() {
+		if obj, ok := data.(oss.ObjectProperties); ok {
+			objects = append(objects, obj)
+		}
+	}
+	return objects
+}
+
+// ClearMarks unselects every object
+func (m OSSObjectsModel) ClearMarks() OSSObjectsModel {
+	m.table = m.table.ClearSelection()
 	return m
 }
 
+// copyRequest returns what c or m copies: the selected objects, else the
+// highlighted object, else every object in the highlighted folder
+func (m OSSObjectsModel) copyRequest(move bool) (OSSCopyRequestMsg, bool) {
+	req := OSSCopyRequestMsg{BucketName: m.bucketName, BasePrefix: m.prefix, Move: move}
+	if selected := m.SelectedObjects(); len(selected) > 0 {
+		for _, obj := range selected {
+			req.Keys = append(req.Keys, obj.Key)
+		}
+		return req, true
+	}
+
+	entry := m.selectedEntry()
+	switch {
+	case entry == nil || entry.parent:
+		return req, false
+	case entry.object != nil:
+		req.Keys = []string{entry.object.Key}
+	default:
+		req.Folder = entry.prefix
+	}
+	return req, true
+}
+
 // SetSize sets the size
 func (m OSSObjectsModel) SetSize(width, height int) OSSObjectsModel {
 	m.width = width
@@ -251,15 +434,60 @@ func (m OSSObjectsModel) SetSize(width, height int) OSSObjectsModel {
 	return m
 }
 
-// SelectedObject returns the selected object
+// SelectedObject returns the selected object, or nil if a folder row is selected
 func (m OSSObjectsModel) SelectedObject() *oss.ObjectProperties {
+	if entry := m.selectedEntry(); entry != nil {
+		return entry.object
+	}
+	return nil
+}
+
+// selectedEntry returns the selected table row
+func (m OSSObjectsModel) selectedEntry() *ossEntry {
 	idx := m.table.SelectedRow()
-	if idx >= 0 && idx < len(m.objects) {
-		return &m.objects[idx]
+	if idx >= 0 && idx < len(m.entries) {
+		return &m.entries[idx]
 	}
 	return nil
 }
 
+// Breadcrumb returns the bucket and current folder path, e.g. "my-bucket / logs / 2024"
+func (m OSSObjectsModel) Breadcrumb() string {
+	parts := []string{m.bucketName}
+	for _, segment := range strings.Split(strings.TrimSuffix(m.prefix, ossDelimiter), ossDelimiter) {
+		if segment != "" {
+			parts = append(parts, segment)
+		}
+	}
+	return strings.Join(parts, " / ")
+}
+
+// openPrefix starts listing the given folder from its first page
+func (m OSSObjectsModel) openPrefix(prefix string) (OSSObjectsModel, tea.Cmd) {
+	m.currentMarker = ""
+	m.previousMarkers = []string{}
+	return m, loadOSSObjects(m.ctx, m.ossSvc, m.bucketName, prefix, "", m.pageSize, 1)
+}
+
+// Reload lists the current page of the current folder again
+func (m OSSObjectsModel) Reload() tea.Cmd {
+	return loadOSSObjects(m.ctx, m.ossSvc, m.bucketName, m.prefix, m.currentMarker, m.pageSize, m.currentPage)
+}
+
+// BucketName returns the bucket being browsed
+func (m OSSObjectsModel) BucketName() string {
+	return m.bucketName
+}
+
+// parentPrefix returns the folder containing prefix, "" at the bucket root
+func parentPrefix(prefix string) string {
+	trimmed := strings.TrimSuffix(prefix, ossDelimiter)
+	if idx := strings.LastIndex(trimmed, ossDelimiter); idx >= 0 {
+		return trimmed[:idx+1]
+	}
+	return ""
+}
+
 // Init implements tea.Model
 func (m OSSObjectsModel) Init() tea.Cmd {
 	return nil
@@ -271,20 +499,29 @@ func (m OSSObjectsModel) Update(msg tea.Msg) (OSSObjectsModel, tea.Cmd) {
 	case tea.KeyMsg:
 		switch {
 		case key.Matches(msg, m.keys.Enter):
-			if obj := m.SelectedObject(); obj != nil {
+			if entry := m.selectedEntry(); entry != nil {
+				if entry.object == nil {
+					return m.openPrefix(entry.prefix)
+				}
+				obj := *entry.object
 				return m, func() tea.Msg {
 					return types.NavigateMsg{
 						Page: types.PageOSSObjectDetail,
-						Data: *obj,
+						Data: obj,
 					}
 				}
 			}
 
+		case key.Matches(msg, m.keys.Up):
+			if m.prefix != "" {
+				return m.openPrefix(parentPrefix(m.prefix))
+			}
+
 		case key.Matches(msg, m.keys.NextPage):
 			if m.hasNextPage {
 				m.previousMarkers = append(m.previousMarkers, m.currentMarker)
 				m.currentMarker = m.nextMarker
-				return m, loadOSSObjects(m.ossSvc, m.bucketName, m.nextMarker, m.pageSize, m.currentPage+1)
+				return m, loadOSSObjects(m.ctx, m.ossSvc, m.bucketName, m.prefix, m.nextMarker, m.pageSize, m.currentPage+1)
 			}
 
 		case key.Matches(msg, m.keys.PrevPage):
@@ -292,13 +529,75 @@ func (m OSSObjectsModel) Update(msg tea.Msg) (OSSObjectsModel, tea.Cmd) {
 				lastIdx := len(m.previousMarkers) - 1
 				m.currentMarker = m.previousMarkers[lastIdx]
 				m.previousMarkers = m.previousMarkers[:lastIdx]
-				return m, loadOSSObjects(m.ossSvc, m.bucketName, m.currentMarker, m.pageSize, m.currentPage-1)
+				return m, loadOSSObjects(m.ctx, m.ossSvc, m.bucketName, m.prefix, m.currentMarker, m.pageSize, m.currentPage-1)
 			}
 
 		case key.Matches(msg, m.keys.FirstPage):
-			m.currentMarker = ""
-			m.previousMarkers = []string{}
-			return m, loadOSSObjects(m.ossSvc, m.bucketName, "", m.pageSize, 1)
+			return m.openPrefix(m.prefix)
+
+		case key.Matches(msg, m.keys.LoadAll), key.Matches(msg, m.keys.Search):
+			req := OSSScanRequestMsg{
+				BucketName: m.bucketName,
+				Prefix:     m.prefix,
+				Prompt:     key.Matches(msg, m.keys.Search),
+			}
+			return m, func() tea.Msg { return req }
+
+		case key.Matches(msg, m.keys.Download):
+			// Folder placeholder objects have nothing to download
+			if obj := m.SelectedObject(); obj != nil && !strings.HasSuffix(obj.Key, "/") {
+				bucketName, object := m.bucketName, *obj
+				return m, func() tea.Msg {
+					return OSSDownloadRequestMsg{BucketName: bucketName, Object: object}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Restore):
+			if obj := m.SelectedObject(); obj != nil {
+				if _, ok := service.ArchiveRestoreOptionsFor(obj.StorageClass); ok {
+					bucketName, object := m.bucketName, *obj
+					return m, func() tea.Msg {
+						return OSSArchiveRestoreRequestMsg{BucketName: bucketName, Object: object}
+					}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Copy), key.Matches(msg, m.keys.Move):
+			if req, ok := m.copyRequest(key.Matches(msg, m.keys.Move)); ok {
+				return m, func() tea.Msg { return req }
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.Deleted):
+			bucketName, prefix := m.bucketName, m.prefix
+			return m, func() tea.Msg {
+				return types.NavigateMsg{
+					Page: types.PageOSSDeletedObjects,
+					Data: OSSDeletedNavData{BucketName: bucketName, Prefix: prefix},
+				}
+			}
+
+		case key.Matches(msg, m.keys.Versions):
+			if obj := m.SelectedObject(); obj != nil {
+				bucketName, object := m.bucketName, *obj
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageOSSObjectVersions,
+						Data: OSSObjectNavData{BucketName: bucketName, Object: object},
+					}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Preview):
+			if obj := m.SelectedObject(); obj != nil && !strings.HasSuffix(obj.Key, "/") {
+				bucketName, object := m.bucketName, *obj
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageOSSObjectPreview,
+						Data: OSSObjectNavData{BucketName: bucketName, Object: object},
+					}
+				}
+			}
 		}
 	}
 
@@ -310,23 +609,21 @@ func (m OSSObjectsModel) Update(msg tea.Msg) (OSSObjectsModel, tea.Cmd) {
 // View implements tea.Model
 func (m OSSObjectsModel) View() string {
 	// Build pagination info
-	pageInfo := fmt.Sprintf("Page %d", m.currentPage)
+	pageInfo := fmt.Sprintf(i18n.T(i18n.KeyOSSPage), m.currentPage)
 	if m.hasNextPage {
 		pageInfo += "+"
 	}
 
 	navHelp := ""
 	if m.hasPrevPage {
-		navHelp += "[ Prev | "
+		navHelp += i18n.T(i18n.KeyOSSPrevPage) + " | "
 	}
 	if m.hasNextPage {
-		navHelp += "] Next | "
+		navHelp += i18n.T(i18n.KeyOSSNextPage) + " | "
 	}
-	navHelp += "0 First"
+	navHelp += i18n.T(i18n.KeyOSSFirstPage)
 
-	paginationLine := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#06B6D4")).
-		Render(fmt.Sprintf(" %s | %s ", pageInfo, navHelp))
+	paginationLine := ossPaginationStyle().Render(fmt.Sprintf(" %s | %s ", pageInfo, navHelp))
 
 	return m.table.View() + "\n" + paginationLine
 }
@@ -337,6 +634,12 @@ func (m OSSObjectsModel) Search(query string) OSSObjectsModel {
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m OSSObjectsModel) Filter(query string) OSSObjectsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m OSSObjectsModel) NextSearchMatch() OSSObjectsModel {
 	m.table = m.table.NextSearchMatch()
@@ -349,24 +652,9 @@ func (m OSSObjectsModel) PrevSearchMatch() OSSObjectsModel {
 	return m
 }
 
-// Helper function to format file size
-func formatSize(size int64) string {
-	const (
-		KB = 1024
-		MB = KB * 1024
-		GB = MB * 1024
-	)
-
-	switch {
-	case size >= GB:
-		return fmt.Sprintf("%.2f GB", float64(size)/float64(GB))
-	case size >= MB:
-		return fmt.Sprintf("%.2f MB", float64(size)/float64(MB))
-	case size >= KB:
-		return fmt.Sprintf("%.2f KB", float64(size)/float64(KB))
-	default:
-		return fmt.Sprintf("%d B", size)
-	}
+// FormatSize formats a byte count as a human readable size
+func FormatSize(size int64) string {
+	return components.FormatBytes(size)
 }
 
 // OSSObjectsLoadedMsg contains loaded OSS objects with pagination
@@ -376,15 +664,41 @@ type OSSObjectsLoadedMsg struct {
 	Page       int
 }
 
+// OSSDownloadRequestMsg asks the app to prompt for a destination and download an object.
+// VersionID, if set, selects a previous version of the object
+type OSSDownloadRequestMsg struct {
+	BucketName string
+	Object     oss.ObjectProperties
+	VersionID  string
+}
+
+// OSSCopyRequestMsg asks the app to prompt for a destination and copy or
+// move objects there: Keys, or with Folder set every object under it. Keys
+// keep their path below BasePrefix, the folder they were picked in
+type OSSCopyRequestMsg struct {
+	BucketName string
+	BasePrefix string
+	Keys       []string
+	Folder     string
+	Move       bool
+}
+
+// OSSArchiveRestoreRequestMsg asks the app to prompt for the restore options
+// of an Archive, ColdArchive or DeepColdArchive object and restore it
+type OSSArchiveRestoreRequestMsg struct {
+	BucketName string
+	Object     oss.ObjectProperties
+}
+
 // OSSErrorMsg indicates an OSS error
 type OSSErrorMsg struct {
 	Err error
 }
 
 // loadOSSObjects creates a command to load OSS objects with pagination
-func loadOSSObjects(svc *service.OSSService, bucketName, marker string, pageSize, page int) tea.Cmd {
+func loadOSSObjects(ctx context.Context, svc *service.OSSService, bucketName, prefix, marker string, pageSize, page int) tea.Cmd {
 	return func() tea.Msg {
-		result, err := svc.FetchObjects(bucketName, marker, pageSize)
+		result, err := svc.FetchObjects(ctx, bucketName, prefix, ossDelimiter, marker, pageSize)
 		if err != nil {
 			return OSSErrorMsg{Err: err}
 		}
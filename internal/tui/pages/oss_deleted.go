@@ -0,0 +1,192 @@
+package pages
+
+import (
+	"fmt"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// OSSDeletedObjectsModel lists the objects under a folder of a versioned
+// bucket whose current version is a delete marker
+type OSSDeletedObjectsModel struct {
+	table      components.TableModel
+	markers    []service.ObjectVersion
+	bucketName string
+	prefix     string
+	width      int
+	height     int
+	keys       OSSDeletedObjectsKeyMap
+}
+
+// OSSDeletedObjectsKeyMap defines key bindings
+type OSSDeletedObjectsKeyMap struct {
+	Versions key.Binding
+	Restore  key.Binding
+}
+
+// DefaultOSSDeletedObjectsKeyMap returns default key bindings
+func DefaultOSSDeletedObjectsKeyMap() OSSDeletedObjectsKeyMap {
+	return OSSDeletedObjectsKeyMap{
+		Versions: key.NewBinding(
+			key.WithKeys("enter", "h"),
+			key.WithHelp("enter/h", "versions"),
+		),
+		Restore: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "undelete"),
+		),
+	}
+}
+
+// OSSDeletedNavData identifies the folder whose deleted objects are listed
+type OSSDeletedNavData struct {
+	BucketName string
+	Prefix     string
+}
+
+// NewOSSDeletedObjectsModel creates a new deleted objects model
+func NewOSSDeletedObjectsModel(bucketName, prefix string) OSSDeletedObjectsModel {
+	columns := []table.Column{
+		{Title: "Key", Width: 60},
+		{Title: "Deleted At", Width: 22},
+		{Title: "Delete Marker ID", Width: 36},
+	}
+
+	return OSSDeletedObjectsModel{
+		table:      components.NewTableModel(columns, fmt.Sprintf("Deleted objects in oss://%s/%s", bucketName, prefix)),
+		bucketName: bucketName,
+		prefix:     prefix,
+		keys:       DefaultOSSDeletedObjectsKeyMap(),
+	}
+}
+
+// SetData sets the delete markers, most recent first
+func (m OSSDeletedObjectsModel) SetData(markers []service.ObjectVersion, truncated bool) OSSDeletedObjectsModel {
+	m.markers = markers
+
+	rows := make([]table.Row, len(markers))
+	rowData := make([]interface{}, len(markers))
+
+	for i, v := range markers {
+		rows[i] = table.Row{
+			v.Key,
+			components.FormatTime(v.LastModified),
+			v.VersionID,
+		}
+		rowData[i] = v
+	}
+
+	title := fmt.Sprintf("Deleted objects in oss://%s/%s (%d)", m.bucketName, m.prefix, len(markers))
+	if truncated {
+		title += " " + i18n.T(i18n.KeyOSSDeletedTruncated)
+	}
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(title)
+	return m
+}
+
+// SetSize sets the size
+func (m OSSDeletedObjectsModel) SetSize(width, height int) OSSDeletedObjectsModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// BucketName returns the bucket of the listed objects
+func (m OSSDeletedObjectsModel) BucketName() string {
+	return m.bucketName
+}
+
+// Prefix returns the folder of the listed objects
+func (m OSSDeletedObjectsModel) Prefix() string {
+	return m.prefix
+}
+
+// SelectedMarker returns the delete marker of the selected object
+func (m OSSDeletedObjectsModel) SelectedMarker() *service.ObjectVersion {
+	if v, ok := m.table.SelectedRowData().(service.ObjectVersion); ok {
+		return &v
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m OSSDeletedObjectsModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m OSSDeletedObjectsModel) Update(msg tea.Msg) (OSSDeletedObjectsModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.keys.Versions):
+			if v := m.SelectedMarker(); v != nil {
+				bucketName, objectKey := m.bucketName, v.Key
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageOSSObjectVersions,
+						Data: OSSObjectNavData{BucketName: bucketName, Object: oss.ObjectProperties{Key: objectKey}},
+					}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Restore):
+			if v := m.SelectedMarker(); v != nil {
+				bucketName, marker := m.bucketName, *v
+				return m, func() tea.Msg {
+					return OSSUndeleteRequestMsg{BucketName: bucketName, Marker: marker}
+				}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m OSSDeletedObjectsModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m OSSDeletedObjectsModel) Search(query string) OSSDeletedObjectsModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m OSSDeletedObjectsModel) Filter(query string) OSSDeletedObjectsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m OSSDeletedObjectsModel) NextSearchMatch() OSSDeletedObjectsModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m OSSDeletedObjectsModel) PrevSearchMatch() OSSDeletedObjectsModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// OSSUndeleteRequestMsg asks the app to confirm and remove the delete marker
+// of a deleted object
+type OSSUndeleteRequestMsg struct {
+	BucketName string
+	Marker     service.ObjectVersion
+}
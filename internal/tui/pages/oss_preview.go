@@ -0,0 +1,166 @@
+package pages
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/theme"
+	"aliyun-tui-viewer/internal/tui/components"
+)
+
+// OSSPreviewMaxBytes is the most content fetched for an object preview
+const OSSPreviewMaxBytes = 256 * 1024
+
+// OSSObjectNavData identifies the object to open on an OSS object page
+type OSSObjectNavData struct {
+	BucketName string
+	Object     oss.ObjectProperties
+}
+
+// OSSObjectPreviewModel shows the text content of an OSS object
+type OSSObjectPreviewModel struct {
+	viewport   components.ViewportModel
+	bucketName string
+	object     oss.ObjectProperties
+	syntax     string
+	truncated  bool
+	notice     string // Shown instead of the content when it can't be previewed
+	width      int
+	height     int
+}
+
+// NewOSSObjectPreviewModel creates a new OSS object preview model
+func NewOSSObjectPreviewModel(bucketName string, object oss.ObjectProperties) OSSObjectPreviewModel {
+	return OSSObjectPreviewModel{
+		viewport:   components.NewTextViewportModel(object.Key, "", components.SyntaxPlain),
+		bucketName: bucketName,
+		object:     object,
+	}
+}
+
+// SetData sets the fetched object content
+func (m OSSObjectPreviewModel) SetData(content *service.ObjectContent) OSSObjectPreviewModel {
+	data := content.Data
+	if content.Truncated {
+		data = trimPartialRune(data)
+	}
+	if !isText(data) {
+		m.notice = fmt.Sprintf(i18n.T(i18n.KeyOSSPreviewBinary), content.Key)
+		return m
+	}
+
+	text, syntax := detectPreviewSyntax(content.Key, data)
+	m.syntax = syntax
+	m.truncated = content.Truncated
+	m.notice = ""
+	m.viewport = m.viewport.SetText(text, syntax)
+	return m
+}
+
+// trimPartialRune drops a multi-byte character cut in half by a ranged read
+func trimPartialRune(data []byte) []byte {
+	for i := 0; i < utf8.UTFMax && len(data) > 0; i++ {
+		if utf8.Valid(data) {
+			return data
+		}
+		data = data[:len(data)-1]
+	}
+	return data
+}
+
+// isText reports whether data looks like UTF-8 text rather than binary
+func isText(data []byte) bool {
+	return utf8.Valid(data) && bytes.IndexByte(data, 0) < 0
+}
+
+// detectPreviewSyntax picks the highlighting for an object from its key and content.
+// Valid JSON is re-indented so single-line documents stay readable
+func detectPreviewSyntax(key string, data []byte) (string, string) {
+	trimmed := bytes.TrimSpace(data)
+	ext := strings.ToLower(path.Ext(key))
+
+	switch {
+	case ext == ".yaml" || ext == ".yml":
+		return string(data), components.SyntaxYAML
+	case ext == ".json" || (len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') && json.Valid(trimmed)):
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, trimmed, "", "  "); err == nil {
+			return indented.String(), components.SyntaxJSON
+		}
+		return string(data), components.SyntaxJSON
+	default:
+		return string(data), components.SyntaxPlain
+	}
+}
+
+// SetSize sets the size
+func (m OSSObjectPreviewModel) SetSize(width, height int) OSSObjectPreviewModel {
+	m.width = width
+	m.height = height
+	m.viewport = m.viewport.SetSize(width, height-1) // Account for object info line
+	return m
+}
+
+// Init implements tea.Model
+func (m OSSObjectPreviewModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m OSSObjectPreviewModel) Update(msg tea.Msg) (OSSObjectPreviewModel, tea.Cmd) {
+	if m.notice != "" {
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m OSSObjectPreviewModel) View() string {
+	th := theme.Current()
+	if m.notice != "" {
+		return lipgloss.NewStyle().
+			Foreground(th.SubtleText).
+			Padding(1, 2).
+			Render(m.notice)
+	}
+
+	info := fmt.Sprintf("oss://%s/%s | %s | %s", m.bucketName, m.object.Key, FormatSize(m.object.Size), m.syntax)
+	if m.truncated {
+		info += " | " + fmt.Sprintf(i18n.T(i18n.KeyOSSPreviewTruncated), FormatSize(OSSPreviewMaxBytes))
+	}
+	infoLine := lipgloss.NewStyle().
+		Foreground(th.Secondary).
+		Render(" " + info + " ")
+
+	return m.viewport.View() + "\n" + infoLine
+}
+
+// Search searches in the preview
+func (m OSSObjectPreviewModel) Search(query string) OSSObjectPreviewModel {
+	m.viewport = m.viewport.Search(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m OSSObjectPreviewModel) NextSearchMatch() OSSObjectPreviewModel {
+	m.viewport = m.viewport.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m OSSObjectPreviewModel) PrevSearchMatch() OSSObjectPreviewModel {
+	m.viewport = m.viewport.PrevSearchMatch()
+	return m
+}
@@ -0,0 +1,225 @@
+package pages
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// OSSScanNavData identifies the bucket and prefix to scan. Search is set for
+// a prefix search, where the prefix need not end at a folder boundary
+type OSSScanNavData struct {
+	BucketName string
+	Prefix     string
+	Search     bool
+}
+
+// Location returns the scanned bucket and prefix as an oss:// URL, with a
+// trailing * for prefix searches
+func (d OSSScanNavData) Location() string {
+	location := fmt.Sprintf("oss://%s/%s", d.BucketName, d.Prefix)
+	if d.Search {
+		location += "*"
+	}
+	return location
+}
+
+// OSSScanRequestMsg asks the app to scan every object under a prefix. With
+// Prompt set the user is asked for the prefix first, starting from Prefix
+type OSSScanRequestMsg struct {
+	BucketName string
+	Prefix     string
+	Prompt     bool
+}
+
+// OSSObjectScanModel lists every object found under a prefix, across all
+// folder levels
+type OSSObjectScanModel struct {
+	table   components.TableModel
+	objects []oss.ObjectProperties
+	scan    OSSScanNavData
+	result  *service.ObjectScanResult
+	total   int64 // Bytes in objects
+	width   int
+	height  int
+	keys    OSSObjectsKeyMap
+}
+
+// NewOSSObjectScanModel creates a new object scan model
+func NewOSSObjectScanModel(scan OSSScanNavData) OSSObjectScanModel {
+	columns := []components.ColumnSpec{
+		{Title: "Object Key", Width: 80},
+		{Title: "Size", Width: 24, Format: components.FormatSize},
+		{Title: "Last Modified", Width: 22},
+		{Title: "Storage Class", Width: 14},
+		{Title: "Restore", Width: 28},
+		{Title: "ETag", Width: 36},
+	}
+
+	return OSSObjectScanModel{
+		table: components.NewTableModelFromSpecs(columns, fmt.Sprintf("Scanning %s", scan.Location())).SetBarColumns(1),
+		scan:  scan,
+		keys:  DefaultOSSObjectsKeyMap(),
+	}
+}
+
+// Scan returns the bucket and prefix being scanned
+func (m OSSObjectScanModel) Scan() OSSScanNavData {
+	return m.scan
+}
+
+// SetData sets the scanned objects
+func (m OSSObjectScanModel) SetData(result *service.ObjectScanResult) OSSObjectScanModel {
+	m.result = result
+	m.objects = result.Objects
+	m.total = 0
+
+	values := make([][]interface{}, len(m.objects))
+	rowData := make([]interface{}, len(m.objects))
+
+	for i, obj := range m.objects {
+		values[i] = []interface{}{
+			obj.Key,
+			obj.Size,
+			components.FormatTime(obj.LastModified),
+			obj.StorageClass,
+			valueOrDash(service.RestoreStatusOf(obj).String()),
+			obj.ETag,
+		}
+		rowData[i] = obj
+		m.total += obj.Size
+	}
+
+	m.table = m.table.SetValues(values)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("Objects in %s", m.scan.Location()))
+	return m
+}
+
+// SetSize sets the size
+func (m OSSObjectScanModel) SetSize(width, height int) OSSObjectScanModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height-2) // Account for the summary line
+	return m
+}
+
+// SelectedObject returns the selected object
+func (m OSSObjectScanModel) SelectedObject() *oss.ObjectProperties {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.objects) {
+		return &m.objects[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m OSSObjectScanModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m OSSObjectScanModel) Update(msg tea.Msg) (OSSObjectScanModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		obj := m.SelectedObject()
+		switch {
+		case key.Matches(msg, m.keys.Enter):
+			if obj != nil {
+				object := *obj
+				return m, func() tea.Msg {
+					return types.NavigateMsg{Page: types.PageOSSObjectDetail, Data: object}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Download):
+			// Folder placeholder objects have nothing to download
+			if obj != nil && !strings.HasSuffix(obj.Key, "/") {
+				bucketName, object := m.scan.BucketName, *obj
+				return m, func() tea.Msg {
+					return OSSDownloadRequestMsg{BucketName: bucketName, Object: object}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Restore):
+			if obj != nil {
+				if _, ok := service.ArchiveRestoreOptionsFor(obj.StorageClass); ok {
+					bucketName, object := m.scan.BucketName, *obj
+					return m, func() tea.Msg {
+						return OSSArchiveRestoreRequestMsg{BucketName: bucketName, Object: object}
+					}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Versions):
+			if obj != nil {
+				bucketName, object := m.scan.BucketName, *obj
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageOSSObjectVersions,
+						Data: OSSObjectNavData{BucketName: bucketName, Object: object},
+					}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Preview):
+			if obj != nil && !strings.HasSuffix(obj.Key, "/") {
+				bucketName, object := m.scan.BucketName, *obj
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageOSSObjectPreview,
+						Data: OSSObjectNavData{BucketName: bucketName, Object: object},
+					}
+				}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m OSSObjectScanModel) View() string {
+	if m.result == nil {
+		return m.table.View()
+	}
+
+	summary := fmt.Sprintf(" %d objects | %s | %d prefixes scanned ", len(m.objects), FormatSize(m.total), m.result.Partitions)
+	if m.result.Truncated {
+		summary += "| stopped at the object limit "
+	}
+	return m.table.View() + "\n" + ossPaginationStyle().Render(summary)
+}
+
+// Search searches in the list
+func (m OSSObjectScanModel) Search(query string) OSSObjectScanModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m OSSObjectScanModel) Filter(query string) OSSObjectScanModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m OSSObjectScanModel) NextSearchMatch() OSSObjectScanModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m OSSObjectScanModel) PrevSearchMatch() OSSObjectScanModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
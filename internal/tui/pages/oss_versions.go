@@ -0,0 +1,221 @@
+package pages
+
+import (
+	"fmt"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// OSSObjectVersionsModel lists the versions of an object in a versioned
+// bucket, including delete markers
+type OSSObjectVersionsModel struct {
+	table      components.TableModel
+	versions   []service.ObjectVersion
+	bucketName string
+	objectKey  string
+	width      int
+	height     int
+	keys       OSSObjectVersionsKeyMap
+}
+
+// OSSObjectVersionsKeyMap defines key bindings
+type OSSObjectVersionsKeyMap struct {
+	Enter    key.Binding
+	Download key.Binding
+	Restore  key.Binding
+}
+
+// DefaultOSSObjectVersionsKeyMap returns default key bindings
+func DefaultOSSObjectVersionsKeyMap() OSSObjectVersionsKeyMap {
+	return OSSObjectVersionsKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "details"),
+		),
+		Download: key.NewBinding(
+			key.WithKeys("d", "s"),
+			key.WithHelp("d/s", "download version"),
+		),
+		Restore: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "restore version"),
+		),
+	}
+}
+
+// NewOSSObjectVersionsModel creates a new object versions model
+func NewOSSObjectVersionsModel(bucketName, objectKey string) OSSObjectVersionsModel {
+	columns := []table.Column{
+		{Title: "Version ID", Width: 36},
+		{Title: "Size", Width: 24},
+		{Title: "Last Modified", Width: 22},
+		{Title: "Storage Class", Width: 14},
+		{Title: "State", Width: 14},
+		{Title: "ETag", Width: 36},
+	}
+
+	return OSSObjectVersionsModel{
+		table:      components.NewTableModel(columns, fmt.Sprintf("Versions of oss://%s/%s", bucketName, objectKey)).SetBarColumns(1),
+		bucketName: bucketName,
+		objectKey:  objectKey,
+		keys:       DefaultOSSObjectVersionsKeyMap(),
+	}
+}
+
+// SetData sets the versions, newest first
+func (m OSSObjectVersionsModel) SetData(versions []service.ObjectVersion) OSSObjectVersionsModel {
+	m.versions = versions
+
+	rows := make([]table.Row, len(versions))
+	rowData := make([]interface{}, len(versions))
+
+	for i, v := range versions {
+		size, state := FormatSize(v.Size), ""
+		if v.DeleteMarker {
+			size, state = "-", i18n.T(i18n.KeyOSSDeleteMarker)
+		}
+		if v.IsLatest {
+			if state != "" {
+				state += ", "
+			}
+			state += i18n.T(i18n.KeyOSSVersionCurrent)
+		}
+		rows[i] = table.Row{
+			v.VersionID,
+			size,
+			components.FormatTime(v.LastModified),
+			valueOrDash(v.StorageClass),
+			state,
+			valueOrDash(v.ETag),
+		}
+		rowData[i] = v
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("Versions of oss://%s/%s (%d)", m.bucketName, m.objectKey, len(versions)))
+	return m
+}
+
+// SetSize sets the size
+func (m OSSObjectVersionsModel) SetSize(width, height int) OSSObjectVersionsModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// BucketName returns the bucket of the listed object
+func (m OSSObjectVersionsModel) BucketName() string {
+	return m.bucketName
+}
+
+// ObjectKey returns the key of the listed object
+func (m OSSObjectVersionsModel) ObjectKey() string {
+	return m.objectKey
+}
+
+// SelectedVersion returns the selected version
+func (m OSSObjectVersionsModel) SelectedVersion() *service.ObjectVersion {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.versions) {
+		return &m.versions[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m OSSObjectVersionsModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m OSSObjectVersionsModel) Update(msg tea.Msg) (OSSObjectVersionsModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.keys.Enter):
+			if v := m.SelectedVersion(); v != nil {
+				version := *v
+				return m, func() tea.Msg {
+					return types.NavigateMsg{Page: types.PageOSSObjectDetail, Data: version}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Download):
+			// Delete markers have no content
+			if v := m.SelectedVersion(); v != nil && !v.DeleteMarker {
+				bucketName, version := m.bucketName, *v
+				return m, func() tea.Msg {
+					return OSSDownloadRequestMsg{
+						BucketName: bucketName,
+						Object: oss.ObjectProperties{
+							Key:          version.Key,
+							Size:         version.Size,
+							ETag:         version.ETag,
+							LastModified: version.LastModified,
+							StorageClass: version.StorageClass,
+						},
+						VersionID: version.VersionID,
+					}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Restore):
+			// Only a previous version with content can become the current one
+			if v := m.SelectedVersion(); v != nil && !v.DeleteMarker && !v.IsLatest {
+				bucketName, version := m.bucketName, *v
+				return m, func() tea.Msg {
+					return OSSRestoreVersionRequestMsg{BucketName: bucketName, Version: version}
+				}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m OSSObjectVersionsModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m OSSObjectVersionsModel) Search(query string) OSSObjectVersionsModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m OSSObjectVersionsModel) Filter(query string) OSSObjectVersionsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m OSSObjectVersionsModel) NextSearchMatch() OSSObjectVersionsModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m OSSObjectVersionsModel) PrevSearchMatch() OSSObjectVersionsModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// OSSRestoreVersionRequestMsg asks the app to confirm and restore a previous
+// version of an object
+type OSSRestoreVersionRequestMsg struct {
+	BucketName string
+	Version    service.ObjectVersion
+}
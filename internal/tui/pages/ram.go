@@ -0,0 +1,602 @@
+package pages
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
+
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// RAMPolicyRef identifies a policy whose document should be loaded
+type RAMPolicyRef struct {
+	Name string
+	Type string
+}
+
+// RAMUsersModel represents the RAM users list page
+type RAMUsersModel struct {
+	table  components.TableModel
+	users  []service.RAMUserDetail
+	width  int
+	height int
+	keys   RAMUsersKeyMap
+}
+
+// RAMUsersKeyMap defines key bindings
+type RAMUsersKeyMap struct {
+	Enter    key.Binding
+	View     key.Binding
+	Roles    key.Binding
+	Policies key.Binding
+}
+
+// DefaultRAMUsersKeyMap returns default key bindings
+func DefaultRAMUsersKeyMap() RAMUsersKeyMap {
+	return RAMUsersKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "attached policies"),
+		),
+		View: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "details"),
+		),
+		Roles: key.NewBinding(
+			key.WithKeys("o"),
+			key.WithHelp("o", "roles"),
+		),
+		Policies: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "policies"),
+		),
+	}
+}
+
+// NewRAMUsersModel creates a new RAM users model
+func NewRAMUsersModel() RAMUsersModel {
+	columns := []table.Column{
+		{Title: "User Name", Width: 25},
+		{Title: "Display Name", Width: 25},
+		{Title: "Active Keys", Width: 11},
+		{Title: "Oldest Key Age", Width: 14},
+		{Title: "MFA", Width: 12},
+		{Title: "Created", Width: 20},
+		{Title: "Comments", Width: 30},
+	}
+
+	return RAMUsersModel{
+		table: components.NewTableModel(columns, "RAM Users"),
+		keys:  DefaultRAMUsersKeyMap(),
+	}
+}
+
+// SetData sets the RAM users data
+func (m RAMUsersModel) SetData(users []service.RAMUserDetail) RAMUsersModel {
+	m.users = users
+	now := time.Now()
+
+	rows := make([]table.Row, len(users))
+	rowData := make([]interface{}, len(users))
+
+	for i, user := range users {
+		activeKeys := fmt.Sprintf("%d", user.ActiveKeyCount())
+		keyAge := "-"
+		if user.KeysErr != nil {
+			activeKeys, keyAge = "?", "?"
+		} else if age, ok := user.OldestActiveKeyAge(now); ok {
+			keyAge = formatKeyAge(age)
+		}
+
+		rows[i] = table.Row{
+			user.User.UserName,
+			user.User.DisplayName,
+			activeKeys,
+			keyAge,
+			formatMFAStatus(user),
+			formatRAMDate(user.User.CreateDate),
+			user.User.Comments,
+		}
+		rowData[i] = user
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m RAMUsersModel) SetSize(width, height int) RAMUsersModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedUser returns the selected user
+func (m RAMUsersModel) SelectedUser() *service.RAMUserDetail {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.users) {
+		return &m.users[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m RAMUsersModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m RAMUsersModel) Update(msg tea.Msg) (RAMUsersModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.Enter):
+			if user := m.SelectedUser(); user != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageRAMUserPolicies,
+						Data: user.User.UserName,
+					}
+				}
+			}
+
+		case key.Matches(msg, m.keys.View):
+			if user := m.SelectedUser(); user != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageRAMDetail,
+						Data: *user,
+					}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Roles):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageRAMRoles}
+			}
+
+		case key.Matches(msg, m.keys.Policies):
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageRAMPolicies}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m RAMUsersModel) View() string {
+	return m.table.View()
+}
+
+// Table returns the list's table, used to render it outside the TUI
+func (m RAMUsersModel) Table() components.TableModel {
+	return m.table
+}
+
+// Search searches in the list
+func (m RAMUsersModel) Search(query string) RAMUsersModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m RAMUsersModel) Filter(query string) RAMUsersModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m RAMUsersModel) NextSearchMatch() RAMUsersModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m RAMUsersModel) PrevSearchMatch() RAMUsersModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// RAMRolesModel represents the RAM roles list page
+type RAMRolesModel struct {
+	table  components.TableModel
+	roles  []ram.Role
+	width  int
+	height int
+	keys   RAMRolesKeyMap
+}
+
+// RAMRolesKeyMap defines key bindings
+type RAMRolesKeyMap struct {
+	Enter    key.Binding
+	Policies key.Binding
+}
+
+// DefaultRAMRolesKeyMap returns default key bindings
+func DefaultRAMRolesKeyMap() RAMRolesKeyMap {
+	return RAMRolesKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "details"),
+		),
+		Policies: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "attached policies"),
+		),
+	}
+}
+
+// NewRAMRolesModel creates a new RAM roles model
+func NewRAMRolesModel() RAMRolesModel {
+	columns := []table.Column{
+		{Title: "Role Name", Width: 30},
+		{Title: "ARN", Width: 50},
+		{Title: "Max Session", Width: 11},
+		{Title: "Created", Width: 20},
+		{Title: "Description", Width: 30},
+	}
+
+	return RAMRolesModel{
+		table: components.NewTableModel(columns, "RAM Roles"),
+		keys:  DefaultRAMRolesKeyMap(),
+	}
+}
+
+// SetData sets the RAM roles data
+func (m RAMRolesModel) SetData(roles []ram.Role) RAMRolesModel {
+	m.roles = roles
+
+	rows := make([]table.Row, len(roles))
+	rowData := make([]interface{}, len(roles))
+
+	for i, role := range roles {
+		maxSession := "-"
+		if role.MaxSessionDuration > 0 {
+			maxSession = (time.Duration(role.MaxSessionDuration) * time.Second).String()
+		}
+
+		rows[i] = table.Row{
+			role.RoleName,
+			role.Arn,
+			maxSession,
+			formatRAMDate(role.CreateDate),
+			role.Description,
+		}
+		rowData[i] = role
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m RAMRolesModel) SetSize(width, height int) RAMRolesModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedRole returns the selected role
+func (m RAMRolesModel) SelectedRole() *ram.Role {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.roles) {
+		return &m.roles[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m RAMRolesModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m RAMRolesModel) Update(msg tea.Msg) (RAMRolesModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.Enter):
+			if role := m.SelectedRole(); role != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageRAMDetail,
+						Data: *role,
+					}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Policies):
+			if role := m.SelectedRole(); role != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageRAMRolePolicies,
+						Data: role.RoleName,
+					}
+				}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m RAMRolesModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m RAMRolesModel) Search(query string) RAMRolesModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m RAMRolesModel) Filter(query string) RAMRolesModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m RAMRolesModel) NextSearchMatch() RAMRolesModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m RAMRolesModel) PrevSearchMatch() RAMRolesModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// RAMPoliciesModel represents a RAM policies list: either every policy in
+// the account or the policies attached to one user or role
+type RAMPoliciesModel struct {
+	table    components.TableModel
+	policies []ram.Policy
+	userName string // Set when listing a user's attached policies
+	roleName string // Set when listing a role's attached policies
+	width    int
+	height   int
+	keys     RAMPoliciesKeyMap
+}
+
+// RAMPoliciesKeyMap defines key bindings
+type RAMPoliciesKeyMap struct {
+	Enter key.Binding
+}
+
+// DefaultRAMPoliciesKeyMap returns default key bindings
+func DefaultRAMPoliciesKeyMap() RAMPoliciesKeyMap {
+	return RAMPoliciesKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "policy document"),
+		),
+	}
+}
+
+// NewRAMPoliciesModel creates a model listing every policy in the account
+func NewRAMPoliciesModel() RAMPoliciesModel {
+	columns := []table.Column{
+		{Title: "Policy Name", Width: 35},
+		{Title: "Type", Width: 8},
+		{Title: "Attachments", Width: 11},
+		{Title: "Default Version", Width: 15},
+		{Title: "Updated", Width: 20},
+		{Title: "Description", Width: 40},
+	}
+
+	return RAMPoliciesModel{
+		table: components.NewTableModel(columns, "RAM Policies"),
+		keys:  DefaultRAMPoliciesKeyMap(),
+	}
+}
+
+// NewRAMUserPoliciesModel creates a model listing the policies attached to a user
+func NewRAMUserPoliciesModel() RAMPoliciesModel {
+	return newRAMAttachedPoliciesModel("RAM User Policies")
+}
+
+// NewRAMRolePoliciesModel creates a model listing the policies attached to a role
+func NewRAMRolePoliciesModel() RAMPoliciesModel {
+	return newRAMAttachedPoliciesModel("RAM Role Policies")
+}
+
+// newRAMAttachedPoliciesModel creates a model listing the policies attached
+// to a user or a role
+func newRAMAttachedPoliciesModel(title string) RAMPoliciesModel {
+	columns := []table.Column{
+		{Title: "Policy Name", Width: 35},
+		{Title: "Type", Width: 8},
+		{Title: "Attached", Width: 20},
+		{Title: "Default Version", Width: 15},
+		{Title: "Description", Width: 40},
+	}
+
+	return RAMPoliciesModel{
+		table: components.NewTableModel(columns, title),
+		keys:  DefaultRAMPoliciesKeyMap(),
+	}
+}
+
+// SetData sets the policies of the account
+func (m RAMPoliciesModel) SetData(policies []ram.Policy) RAMPoliciesModel {
+	m.policies = policies
+	m.userName = ""
+	m.roleName = ""
+
+	rows := make([]table.Row, len(policies))
+	rowData := make([]interface{}, len(policies))
+
+	for i, policy := range policies {
+		rows[i] = table.Row{
+			policy.PolicyName,
+			policy.PolicyType,
+			fmt.Sprintf("%d", policy.AttachmentCount),
+			policy.DefaultVersion,
+			formatRAMDate(policy.UpdateDate),
+			policy.Description,
+		}
+		rowData[i] = policy
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetUserData sets the policies attached to a user
+func (m RAMPoliciesModel) SetUserData(policies []ram.Policy, userName string) RAMPoliciesModel {
+	m.userName = userName
+	m.roleName = ""
+	return m.setAttachedData(policies, fmt.Sprintf("Policies for RAM user: %s", userName))
+}
+
+// SetRoleData sets the policies attached to a role
+func (m RAMPoliciesModel) SetRoleData(policies []ram.Policy, roleName string) RAMPoliciesModel {
+	m.userName = ""
+	m.roleName = roleName
+	return m.setAttachedData(policies, fmt.Sprintf("Policies for RAM role: %s", roleName))
+}
+
+// setAttachedData sets policies attached to a user or a role
+func (m RAMPoliciesModel) setAttachedData(policies []ram.Policy, title string) RAMPoliciesModel {
+	m.policies = policies
+
+	rows := make([]table.Row, len(policies))
+	rowData := make([]interface{}, len(policies))
+
+	for i, policy := range policies {
+		rows[i] = table.Row{
+			policy.PolicyName,
+			policy.PolicyType,
+			formatRAMDate(policy.AttachDate),
+			policy.DefaultVersion,
+			policy.Description,
+		}
+		rowData[i] = policy
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(title)
+	return m
+}
+
+// SetSize sets the size
+func (m RAMPoliciesModel) SetSize(width, height int) RAMPoliciesModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedPolicy returns the selected policy
+func (m RAMPoliciesModel) SelectedPolicy() *ram.Policy {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.policies) {
+		return &m.policies[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m RAMPoliciesModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m RAMPoliciesModel) Update(msg tea.Msg) (RAMPoliciesModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if key.Matches(msg, m.keys.Enter) {
+			if policy := m.SelectedPolicy(); policy != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageRAMDetail,
+						Data: RAMPolicyRef{Name: policy.PolicyName, Type: policy.PolicyType},
+					}
+				}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m RAMPoliciesModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m RAMPoliciesModel) Search(query string) RAMPoliciesModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m RAMPoliciesModel) Filter(query string) RAMPoliciesModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m RAMPoliciesModel) NextSearchMatch() RAMPoliciesModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m RAMPoliciesModel) PrevSearchMatch() RAMPoliciesModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// formatKeyAge formats an access key age in whole days
+func formatKeyAge(age time.Duration) string {
+	days := int(age.Hours() / 24)
+	if days == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", days)
+}
+
+// formatMFAStatus formats whether a user has an MFA device bound
+func formatMFAStatus(user service.RAMUserDetail) string {
+	switch {
+	case user.MFAErr != nil:
+		return "?"
+	case user.MFADevice == nil:
+		return "Disabled"
+	case user.MFADevice.Type != "":
+		return fmt.Sprintf("Enabled (%s)", user.MFADevice.Type)
+	default:
+		return "Enabled"
+	}
+}
+
+// formatRAMDate trims a RAM timestamp such as "2024-01-02T03:04:05Z" for display
+func formatRAMDate(date string) string {
+	return components.FormatTimeString(date)
+}
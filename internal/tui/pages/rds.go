@@ -16,12 +16,14 @@ import (
 
 // RDSListModel represents the RDS instances list page
 type RDSListModel struct {
-	table            components.TableModel
-	instances        []rds.DBInstance
+	table             components.TableModel
+	instances         []rds.DBInstance
 	detailedInstances []service.RDSInstanceDetail
-	width            int
-	height           int
-	keys             RDSListKeyMap
+	width             int
+	height            int
+	keys              RDSListKeyMap
+	showRegion        bool       // Region column, when listing all regions
+	tagFilter         *TagFilter // Set by t, nil to show every resource
 }
 
 // RDSListKeyMap defines key bindings
@@ -29,6 +31,13 @@ type RDSListKeyMap struct {
 	Enter     key.Binding
 	Databases key.Binding
 	Accounts  key.Binding
+	Metrics   key.Binding
+	Backups   key.Binding
+	SlowLog   key.Binding
+	ConnTest  key.Binding
+	Probe     key.Binding
+	Forward   key.Binding
+	TagFilter key.Binding
 }
 
 // DefaultRDSListKeyMap returns default key bindings
@@ -46,6 +55,22 @@ func DefaultRDSListKeyMap() RDSListKeyMap {
 			key.WithKeys("A"),
 			key.WithHelp("A", "accounts"),
 		),
+		Metrics: key.NewBinding(
+			key.WithKeys("M"),
+			key.WithHelp("M", "metrics"),
+		),
+		Backups: key.NewBinding(
+			key.WithKeys("B"),
+			key.WithHelp("B", "backups"),
+		),
+		SlowLog: key.NewBinding(
+			key.WithKeys("L"),
+			key.WithHelp("L", "slow queries"),
+		),
+		ConnTest:  connTestBinding(),
+		Probe:     probeBinding(),
+		Forward:   portForwardBinding(),
+		TagFilter: tagFilterBinding(),
 	}
 }
 
@@ -87,12 +112,15 @@ func (m RDSListModel) SetData(instances []rds.DBInstance) RDSListModel {
 			inst.DBInstanceStatus,
 			inst.DBInstanceDescription,
 		}
+		if m.showRegion {
+			rows[i] = append(rows[i], inst.RegionId)
+		}
 		rowData[i] = inst
 	}
 
 	m.table = m.table.SetRows(rows)
 	m.table = m.table.SetRowData(rowData)
-	return m
+	return m.applyTagFilter()
 }
 
 // SetDetailedData sets the RDS instances data with network info
@@ -125,11 +153,55 @@ func (m RDSListModel) SetDetailedData(detailedInstances []service.RDSInstanceDet
 			detail.Instance.DBInstanceStatus,
 			detail.Instance.DBInstanceDescription,
 		}
+		if m.showRegion {
+			rows[i] = append(rows[i], detail.Instance.RegionId)
+		}
 		rowData[i] = detail
 	}
 
 	m.table = m.table.SetRows(rows)
 	m.table = m.table.SetRowData(rowData)
+	return m.applyTagFilter()
+}
+
+// selectedHost returns the address to test the selected instance at: the
+// public one, reachable from outside the VPC, when the instance has one
+func (m RDSListModel) selectedHost() string {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.detailedInstances) {
+		d := m.detailedInstances[idx]
+		if d.PublicConnectionStr != "" {
+			return d.PublicConnectionStr
+		}
+		if d.InternalConnectionStr != "" {
+			return d.InternalConnectionStr
+		}
+	}
+	if inst := m.SelectedInstance(); inst != nil {
+		return inst.ConnectionString
+	}
+	return ""
+}
+
+// selectedInternalHost returns the internal endpoint of the selected
+// instance, the one a bastion in its VPC connects to
+func (m RDSListModel) selectedInternalHost() string {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.detailedInstances) && m.detailedInstances[idx].InternalConnectionStr != "" {
+		return m.detailedInstances[idx].InternalConnectionStr
+	}
+	if inst := m.SelectedInstance(); inst != nil {
+		return inst.ConnectionString
+	}
+	return ""
+}
+
+// SetShowRegion adds a Region column, used when listing all regions
+func (m RDSListModel) SetShowRegion(show bool) RDSListModel {
+	if show && !m.showRegion {
+		m.table = m.table.SetColumns(append(m.table.Columns(), regionColumn()))
+	}
+	m.showRegion = show
 	return m
 }
 
@@ -150,6 +222,25 @@ func (m RDSListModel) SelectedInstance() *rds.DBInstance {
 	return nil
 }
 
+// SetTagFilter shows only the resources carrying the filter's tag, or every
+// resource again when f is nil
+func (m RDSListModel) SetTagFilter(f *TagFilter) RDSListModel {
+	m.tagFilter = f
+	return m.applyTagFilter()
+}
+
+// TagFilter returns the active tag filter, or nil
+func (m RDSListModel) TagFilter() *TagFilter {
+	return m.tagFilter
+}
+
+// applyTagFilter applies the tag filter to the current rows
+func (m RDSListModel) applyTagFilter() RDSListModel {
+	instances := m.instances
+	m.table = applyTagFilter(m.table, m.tagFilter, func(i int) string { return instances[i].DBInstanceId })
+	return m
+}
+
 // Init implements tea.Model
 func (m RDSListModel) Init() tea.Cmd {
 	return nil
@@ -189,6 +280,55 @@ func (m RDSListModel) Update(msg tea.Msg) (RDSListModel, tea.Cmd) {
 					}
 				}
 			}
+
+		case key.Matches(msg, m.keys.Metrics):
+			if inst := m.SelectedInstance(); inst != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageRDSMetrics,
+						Data: *inst,
+					}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Backups):
+			if inst := m.SelectedInstance(); inst != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageRDSBackups,
+						Data: inst.DBInstanceId,
+					}
+				}
+			}
+
+		case key.Matches(msg, m.keys.SlowLog):
+			if inst := m.SelectedInstance(); inst != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageRDSSlowLog,
+						Data: RDSSlowLogNavData{InstanceID: inst.DBInstanceId, Days: service.RDSSlowLogDefaultDays},
+					}
+				}
+			}
+
+		case key.Matches(msg, m.keys.ConnTest):
+			if inst := m.SelectedInstance(); inst != nil {
+				engine := inst.Engine
+				return m, requestConnTest(inst.DBInstanceId, m.selectedHost(), service.DefaultPortForEngine(engine), service.ConnProtocolForEngine(engine))
+			}
+
+		case key.Matches(msg, m.keys.Probe):
+			if inst := m.SelectedInstance(); inst != nil {
+				return m, requestProbe(inst.DBInstanceId, m.selectedHost(), service.DefaultPortForEngine(inst.Engine))
+			}
+
+		case key.Matches(msg, m.keys.Forward):
+			if inst := m.SelectedInstance(); inst != nil {
+				return m, requestPortForward(inst.DBInstanceId, m.selectedInternalHost(), service.DefaultPortForEngine(inst.Engine))
+			}
+
+		case key.Matches(msg, m.keys.TagFilter):
+			return m, requestTagFilter(m.tagFilter)
 		}
 	}
 
@@ -202,12 +342,23 @@ func (m RDSListModel) View() string {
 	return m.table.View()
 }
 
+// Table returns the list's table, used to render it outside the TUI
+func (m RDSListModel) Table() components.TableModel {
+	return m.table
+}
+
 // Search searches in the list
 func (m RDSListModel) Search(query string) RDSListModel {
 	m.table = m.table.Search(query)
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m RDSListModel) Filter(query string) RDSListModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m RDSListModel) NextSearchMatch() RDSListModel {
 	m.table = m.table.NextSearchMatch()
@@ -312,6 +463,12 @@ func (m RDSDatabasesModel) Search(query string) RDSDatabasesModel {
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m RDSDatabasesModel) Filter(query string) RDSDatabasesModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m RDSDatabasesModel) NextSearchMatch() RDSDatabasesModel {
 	m.table = m.table.NextSearchMatch()
@@ -416,6 +573,12 @@ func (m RDSAccountsModel) Search(query string) RDSAccountsModel {
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m RDSAccountsModel) Filter(query string) RDSAccountsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m RDSAccountsModel) NextSearchMatch() RDSAccountsModel {
 	m.table = m.table.NextSearchMatch()
@@ -0,0 +1,320 @@
+package pages
+
+import (
+	"fmt"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// RDSBackupsModel represents the RDS backup sets page
+type RDSBackupsModel struct {
+	table      components.TableModel
+	backups    []rds.Backup
+	instanceId string
+	width      int
+	height     int
+	keys       RDSBackupsKeyMap
+}
+
+// RDSBackupsKeyMap defines key bindings
+type RDSBackupsKeyMap struct {
+	Enter   key.Binding
+	Binlogs key.Binding
+}
+
+// DefaultRDSBackupsKeyMap returns default key bindings
+func DefaultRDSBackupsKeyMap() RDSBackupsKeyMap {
+	return RDSBackupsKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "details"),
+		),
+		Binlogs: key.NewBinding(
+			key.WithKeys("b"),
+			key.WithHelp("b", "binlogs"),
+		),
+	}
+}
+
+// NewRDSBackupsModel creates a new RDS backups model
+func NewRDSBackupsModel() RDSBackupsModel {
+	columns := []components.ColumnSpec{
+		{Title: "Backup ID", Width: 14},
+		{Title: "Started", Width: 22},
+		{Title: "Finished", Width: 22},
+		{Title: "Size", Width: 12, Format: components.FormatSize},
+		{Title: "Method", Width: 10},
+		{Title: "Type", Width: 12},
+		{Title: "Mode", Width: 10},
+		{Title: "Scale", Width: 10},
+		{Title: "Status", Width: 10},
+	}
+
+	return RDSBackupsModel{
+		table: components.NewTableModelFromSpecs(columns, "RDS Backups"),
+		keys:  DefaultRDSBackupsKeyMap(),
+	}
+}
+
+// SetData sets the backups data. yy copies the download URL of a backup,
+// the internal one when it has no public one
+func (m RDSBackupsModel) SetData(backups []rds.Backup, instanceId string) RDSBackupsModel {
+	m.backups = backups
+	m.instanceId = instanceId
+
+	values := make([][]interface{}, len(backups))
+	rowData := make([]interface{}, len(backups))
+
+	for i, b := range backups {
+		values[i] = []interface{}{
+			b.BackupId,
+			components.FormatTimeString(b.BackupStartTime),
+			components.FormatTimeString(b.BackupEndTime),
+			b.BackupSize,
+			b.BackupMethod,
+			b.BackupType,
+			b.BackupMode,
+			valueOrDash(b.BackupScale),
+			b.BackupStatus,
+		}
+		rowData[i] = backupDownloadData(b.BackupDownloadURL, b.BackupIntranetDownloadURL, b)
+	}
+
+	m.table = m.table.SetValues(values)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("Backups for RDS: %s (last %d days)", instanceId, int(service.RDSBackupWindow.Hours()/24)))
+	return m
+}
+
+// SetSize sets the size
+func (m RDSBackupsModel) SetSize(width, height int) RDSBackupsModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedBackup returns the selected backup
+func (m RDSBackupsModel) SelectedBackup() *rds.Backup {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.backups) {
+		return &m.backups[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m RDSBackupsModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m RDSBackupsModel) Update(msg tea.Msg) (RDSBackupsModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.keys.Enter):
+			if b := m.SelectedBackup(); b != nil {
+				backup := *b
+				return m, func() tea.Msg {
+					return types.NavigateMsg{Page: types.PageRDSJSONDetail, Data: backup}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Binlogs):
+			instanceId := m.instanceId
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageRDSBinlogs, Data: instanceId}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m RDSBackupsModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m RDSBackupsModel) Search(query string) RDSBackupsModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m RDSBackupsModel) Filter(query string) RDSBackupsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m RDSBackupsModel) NextSearchMatch() RDSBackupsModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m RDSBackupsModel) PrevSearchMatch() RDSBackupsModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// RDSBinlogsModel represents the RDS binlog files page
+type RDSBinlogsModel struct {
+	table      components.TableModel
+	files      []rds.BinLogFile
+	instanceId string
+	width      int
+	height     int
+	keys       RDSBinlogsKeyMap
+}
+
+// RDSBinlogsKeyMap defines key bindings
+type RDSBinlogsKeyMap struct {
+	Enter key.Binding
+}
+
+// DefaultRDSBinlogsKeyMap returns default key bindings
+func DefaultRDSBinlogsKeyMap() RDSBinlogsKeyMap {
+	return RDSBinlogsKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "details"),
+		),
+	}
+}
+
+// NewRDSBinlogsModel creates a new RDS binlogs model
+func NewRDSBinlogsModel() RDSBinlogsModel {
+	columns := []components.ColumnSpec{
+		{Title: "File", Width: 24},
+		{Title: "Begins", Width: 22},
+		{Title: "Ends", Width: 22},
+		{Title: "Size", Width: 12, Format: components.FormatSize},
+		{Title: "Node", Width: 12},
+		{Title: "Upload", Width: 12},
+		{Title: "Link Expires", Width: 22},
+	}
+
+	return RDSBinlogsModel{
+		table: components.NewTableModelFromSpecs(columns, "RDS Binlogs"),
+		keys:  DefaultRDSBinlogsKeyMap(),
+	}
+}
+
+// SetData sets the binlog files. yy copies the download URL of a file, the
+// internal one when it has no public one
+func (m RDSBinlogsModel) SetData(files []rds.BinLogFile, instanceId string) RDSBinlogsModel {
+	m.files = files
+	m.instanceId = instanceId
+
+	values := make([][]interface{}, len(files))
+	rowData := make([]interface{}, len(files))
+
+	for i, f := range files {
+		values[i] = []interface{}{
+			f.LogFileName,
+			components.FormatTimeString(f.LogBeginTime),
+			components.FormatTimeString(f.LogEndTime),
+			f.FileSize,
+			valueOrDash(f.HostInstanceID),
+			valueOrDash(f.RemoteStatus),
+			components.FormatTimeString(f.LinkExpiredTime),
+		}
+		rowData[i] = backupDownloadData(f.DownloadLink, f.IntranetDownloadLink, f)
+	}
+
+	m.table = m.table.SetValues(values)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("Binlogs for RDS: %s (last %d days)", instanceId, int(service.RDSBackupWindow.Hours()/24)))
+	return m
+}
+
+// SetSize sets the size
+func (m RDSBinlogsModel) SetSize(width, height int) RDSBinlogsModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedFile returns the selected binlog file
+func (m RDSBinlogsModel) SelectedFile() *rds.BinLogFile {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.files) {
+		return &m.files[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m RDSBinlogsModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m RDSBinlogsModel) Update(msg tea.Msg) (RDSBinlogsModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		if f := m.SelectedFile(); f != nil {
+			file := *f
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageRDSJSONDetail, Data: file}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m RDSBinlogsModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m RDSBinlogsModel) Search(query string) RDSBinlogsModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m RDSBinlogsModel) Filter(query string) RDSBinlogsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m RDSBinlogsModel) NextSearchMatch() RDSBinlogsModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m RDSBinlogsModel) PrevSearchMatch() RDSBinlogsModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// backupDownloadData is the row data of a downloadable file: its public URL,
+// else its internal URL, else the item itself when it has no link yet
+func backupDownloadData(publicURL, intranetURL string, item interface{}) interface{} {
+	switch {
+	case publicURL != "":
+		return components.TextContent(publicURL)
+	case intranetURL != "":
+		return components.TextContent(intranetURL)
+	default:
+		return item
+	}
+}
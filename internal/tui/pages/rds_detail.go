@@ -0,0 +1,410 @@
+package pages
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// whitelistIPsShown is how many entries a whitelist row lists before eliding
+// the rest
+const whitelistIPsShown = 4
+
+// summarizeIPList summarizes a comma separated IP whitelist by count and
+// first entries
+func summarizeIPList(list string) string {
+	var ips []string
+	for _, ip := range strings.Split(list, ",") {
+		if ip = strings.TrimSpace(ip); ip != "" {
+			ips = append(ips, ip)
+		}
+	}
+	if len(ips) == 0 {
+		return "-"
+	}
+	count := len(ips)
+	if count > whitelistIPsShown {
+		ips = append(ips[:whitelistIPsShown], "…")
+	}
+	return fmt.Sprintf("%d: %s", count, strings.Join(ips, ", "))
+}
+
+// RDSDetailModel is the formatted detail page of an RDS instance. The
+// specification, endpoints and whitelists come from the instance attributes,
+// which load after the page opens
+type RDSDetailModel struct {
+	instance rds.DBInstance
+	names    *service.NameResolver // Resolves referenced IDs to names, may be nil
+	attrs    *service.RDSInstanceAttributes
+	attrErr  error
+	view     SectionDetailModel
+	keys     RDSDetailKeyMap
+}
+
+// RDSDetailKeyMap defines the RDS detail keys besides the navigation
+type RDSDetailKeyMap struct {
+	JSON        key.Binding
+	Databases   key.Binding
+	Accounts    key.Binding
+	Metrics     key.Binding
+	Maintenance key.Binding
+	Connect     key.Binding
+}
+
+// DefaultRDSDetailKeyMap returns default key bindings, those of the RDS list
+// with v for the JSON view and W to change the maintenance window
+func DefaultRDSDetailKeyMap() RDSDetailKeyMap {
+	list := DefaultRDSListKeyMap()
+	return RDSDetailKeyMap{
+		JSON: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "JSON"),
+		),
+		Databases:   list.Databases,
+		Accounts:    list.Accounts,
+		Metrics:     list.Metrics,
+		Maintenance: maintenanceWindowKey(),
+		Connect:     connectBinding(),
+	}
+}
+
+// NewRDSDetailModel creates a new RDS detail model
+func NewRDSDetailModel(instance rds.DBInstance) RDSDetailModel {
+	m := RDSDetailModel{
+		instance: instance,
+		view:     NewSectionDetailModel(),
+		keys:     DefaultRDSDetailKeyMap(),
+	}
+	return m.rebuild()
+}
+
+// NewRDSDetailModelFromInterface creates a new RDS detail model from
+// interface{}, and false when data is not an RDS instance
+func NewRDSDetailModelFromInterface(data interface{}) (RDSDetailModel, bool) {
+	if instance, ok := data.(rds.DBInstance); ok {
+		return NewRDSDetailModel(instance), true
+	}
+	return RDSDetailModel{}, false
+}
+
+// InstanceID returns the ID of the shown instance
+func (m RDSDetailModel) InstanceID() string {
+	return m.instance.DBInstanceId
+}
+
+// NameRefs returns the IDs referenced by the instance
+func (m RDSDetailModel) NameRefs() service.NameRefs {
+	refs := make(service.NameRefs)
+	refs.Add(service.KindVPC, m.instance.VpcId)
+	refs.Add(service.KindVSwitch, m.instance.VSwitchId)
+	refs.Add(service.KindResourceGroup, m.instance.ResourceGroupId)
+	return refs
+}
+
+// SetNames sets the resolver used to show the names of referenced resources
+func (m RDSDetailModel) SetNames(names *service.NameResolver) RDSDetailModel {
+	m.names = names
+	if m.instance.DBInstanceId == "" {
+		return m
+	}
+	return m.rebuild()
+}
+
+// SetAttributes sets the instance attributes, or the error that prevented
+// loading them
+func (m RDSDetailModel) SetAttributes(attrs *service.RDSInstanceAttributes, err error) RDSDetailModel {
+	m.attrs = attrs
+	m.attrErr = err
+	return m.rebuild()
+}
+
+// rebuild renders the sections from the current data
+func (m RDSDetailModel) rebuild() RDSDetailModel {
+	inst := m.instance
+	var attr rds.DBInstanceAttribute
+	if m.attrs != nil {
+		attr = m.attrs.Attribute
+	}
+
+	basicInfo := DetailSection{
+		Title: i18n.T(i18n.KeySectionBasicInfo),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelInstanceID), Value: inst.DBInstanceId},
+			{Label: i18n.T(i18n.KeyLabelInstanceName), Value: valueOrDash(inst.DBInstanceDescription)},
+			{Label: i18n.T(i18n.KeyLabelInstanceStatus), Value: valueOrDash(inst.DBInstanceStatus), Status: true},
+			{Label: i18n.T(i18n.KeyLabelEngineVersion), Value: strings.TrimSpace(inst.Engine + " " + inst.EngineVersion)},
+			{Label: i18n.T(i18n.KeyColType), Value: m.formatRole()},
+			{Label: i18n.T(i18n.KeyColRegion), Value: valueOrDash(inst.RegionId)},
+			{Label: i18n.T(i18n.KeyLabelAvailZone), Value: m.formatZones()},
+			{Label: i18n.T(i18n.KeyColCreatedAt), Value: components.FormatTimeString(inst.CreateTime)},
+			{Label: i18n.T(i18n.KeyLabelDeleteProtection), Value: fmt.Sprintf("%t", inst.DeletionProtection)},
+		},
+	}
+
+	spec := DetailSection{Title: i18n.T(i18n.KeySectionSpec)}
+	if row, ok := m.placeholder(spec.Title); ok {
+		spec.Rows = []DetailRow{{Label: i18n.T(i18n.KeyColSpec), Value: valueOrDash(inst.DBInstanceClass)}, row}
+	} else {
+		spec.Rows = []DetailRow{
+			{Label: i18n.T(i18n.KeyColSpec), Value: valueOrDash(attr.DBInstanceClass)},
+			{Label: i18n.T(i18n.KeyLabelEdition), Value: valueOrDash(attr.Category)},
+			{Label: i18n.T(i18n.KeyLabelCPUMemory), Value: fmt.Sprintf("%s vCPU / %d MB", valueOrDash(attr.DBInstanceCPU), attr.DBInstanceMemory)},
+			{Label: i18n.T(i18n.KeyLabelStorage), Value: m.formatStorage(attr)},
+			{Label: i18n.T(i18n.KeyLabelMaxConnections), Value: fmt.Sprintf("%d", attr.MaxConnections)},
+			{Label: i18n.T(i18n.KeyLabelMaxIOPS), Value: fmt.Sprintf("%d", attr.MaxIOPS)},
+		}
+	}
+
+	network := DetailSection{
+		Title: i18n.T(i18n.KeySectionNetwork),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelNetworkType), Value: valueOrDash(inst.InstanceNetworkType)},
+			{Label: i18n.T(i18n.KeyLabelVPC), Value: valueOrDash(m.names.Label(service.KindVPC, inst.VpcId))},
+			{Label: i18n.T(i18n.KeyLabelVSwitch), Value: valueOrDash(m.names.Label(service.KindVSwitch, inst.VSwitchId))},
+		},
+	}
+
+	maintenance := DetailSection{Title: i18n.T(i18n.KeySectionMaintenance)}
+	if row, ok := m.placeholder(maintenance.Title); ok {
+		maintenance.Rows = []DetailRow{row}
+	} else {
+		maintenance.Rows = []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelMaintenance), Value: formatMaintenanceWindow(attr.MaintainTime)},
+			{Label: i18n.T(i18n.KeyLabelMinorUpgrade), Value: valueOrDash(attr.AutoUpgradeMinorVersion)},
+			{Label: i18n.T(i18n.KeyLabelKernelVersion), Value: valueOrDash(attr.CurrentKernelVersion)},
+		}
+	}
+
+	billing := DetailSection{
+		Title: i18n.T(i18n.KeySectionBilling),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelChargeType), Value: m.formatPayType()},
+			{Label: i18n.T(i18n.KeyLabelExpireTime), Value: m.formatExpiry()},
+		},
+	}
+
+	groupInfo := DetailSection{
+		Title: i18n.T(i18n.KeySectionGroupInfo),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelResourceGroup), Value: valueOrDash(m.names.Label(service.KindResourceGroup, inst.ResourceGroupId))},
+		},
+	}
+
+	m.view = m.view.SetSections([]DetailSection{basicInfo, spec, m.endpointsSection(), network, m.whitelistSection(), maintenance, billing, groupInfo})
+	return m
+}
+
+// placeholder returns the row shown while the attributes load or when they
+// failed, and false once they are loaded
+func (m RDSDetailModel) placeholder(label string) (DetailRow, bool) {
+	switch {
+	case m.attrErr != nil:
+		return DetailRow{Label: label, Value: fmt.Sprintf("%s: %v", i18n.T(i18n.KeyDetailUnavailable), m.attrErr)}, true
+	case m.attrs == nil:
+		return DetailRow{Label: label, Value: i18n.T(i18n.KeyActionLoading)}, true
+	}
+	return DetailRow{}, false
+}
+
+// endpointsSection lists the connection endpoints, one row each
+func (m RDSDetailModel) endpointsSection() DetailSection {
+	section := DetailSection{Title: i18n.T(i18n.KeySectionEndpoints)}
+	if row, ok := m.placeholder(section.Title); ok {
+		section.Rows = []DetailRow{row}
+		return section
+	}
+
+	for _, info := range m.attrs.NetInfo {
+		value := fmt.Sprintf("%s:%s", info.ConnectionString, info.Port)
+		if info.IPAddress != "" {
+			value += fmt.Sprintf("  (%s)", info.IPAddress)
+		}
+		if info.ConnectionStringType != "" && info.ConnectionStringType != "Normal" {
+			value += "  " + info.ConnectionStringType
+		}
+		section.Rows = append(section.Rows, DetailRow{Label: valueOrDash(info.IPType), Value: value})
+	}
+	if len(section.Rows) == 0 {
+		section.Rows = []DetailRow{{Label: section.Title, Value: "-"}}
+	}
+	return section
+}
+
+// connectRequest asks to open the client at the public endpoint when there is
+// one. Until the endpoints load, the list's address with the engine's default
+// port is suggested
+func (m RDSDetailModel) connectRequest() ConnectRequestMsg {
+	var infos []endpointInfo
+	if m.attrs != nil {
+		for _, info := range m.attrs.NetInfo {
+			infos = append(infos, endpointInfo{ipType: info.IPType, address: net.JoinHostPort(info.ConnectionString, info.Port)})
+		}
+	}
+	fallback := ""
+	if m.instance.ConnectionString != "" {
+		fallback = net.JoinHostPort(m.instance.ConnectionString, strconv.Itoa(service.DefaultPortForEngine(m.instance.Engine)))
+	}
+	return ConnectRequestMsg{
+		InstanceID: m.instance.DBInstanceId,
+		Engine:     m.instance.Engine,
+		Address:    preferredEndpoint(infos, fallback),
+	}
+}
+
+// whitelistSection summarizes the IP whitelist groups, one row each
+func (m RDSDetailModel) whitelistSection() DetailSection {
+	section := DetailSection{Title: i18n.T(i18n.KeySectionWhitelist)}
+	if row, ok := m.placeholder(section.Title); ok {
+		section.Rows = []DetailRow{row}
+		return section
+	}
+
+	for _, group := range m.attrs.Whitelists {
+		// Hidden groups are maintained by Alibaba Cloud services
+		if group.DBInstanceIPArrayAttribute == "hidden" {
+			continue
+		}
+		section.Rows = append(section.Rows, DetailRow{Label: group.DBInstanceIPArrayName, Value: summarizeIPList(group.SecurityIPList)})
+	}
+	if len(section.Rows) == 0 {
+		section.Rows = []DetailRow{{Label: section.Title, Value: "-"}}
+	}
+	return section
+}
+
+// formatRole shows whether the instance is a primary, read-only or guard
+// instance, with its primary for the others
+func (m RDSDetailModel) formatRole() string {
+	if m.instance.MasterInstanceId == "" {
+		return valueOrDash(m.instance.DBInstanceType)
+	}
+	return fmt.Sprintf("%s (%s)", valueOrDash(m.instance.DBInstanceType), m.instance.MasterInstanceId)
+}
+
+// formatZones shows the primary zone, with the secondary zones once the
+// attributes are loaded
+func (m RDSDetailModel) formatZones() string {
+	zones := []string{valueOrDash(m.instance.ZoneId)}
+	if m.attrs != nil {
+		for _, zone := range m.attrs.Attribute.SlaveZones.SlaveZone {
+			if zone.ZoneId != "" && zone.ZoneId != m.instance.ZoneId {
+				zones = append(zones, zone.ZoneId)
+			}
+		}
+	}
+	return strings.Join(zones, ", ")
+}
+
+// formatStorage shows the used and total storage and the storage type. The
+// used storage is reported in bytes
+func (m RDSDetailModel) formatStorage(attr rds.DBInstanceAttribute) string {
+	storage := fmt.Sprintf("%d GB", attr.DBInstanceStorage)
+	if attr.DBInstanceDiskUsed != "" {
+		if used, err := strconv.ParseInt(attr.DBInstanceDiskUsed, 10, 64); err == nil {
+			storage = fmt.Sprintf("%s / %s", FormatSize(used), storage)
+		}
+	}
+	if attr.DBInstanceStorageType != "" {
+		storage += fmt.Sprintf(" (%s)", attr.DBInstanceStorageType)
+	}
+	return storage
+}
+
+// formatPayType shows the billing method in the wording of the ECS detail
+func (m RDSDetailModel) formatPayType() string {
+	switch m.instance.PayType {
+	case "Prepaid":
+		return i18n.T(i18n.KeyChargePrePaid)
+	case "Postpaid":
+		return i18n.T(i18n.KeyChargePostPaid)
+	default:
+		return valueOrDash(m.instance.PayType)
+	}
+}
+
+// formatExpiry shows when a subscription instance expires
+func (m RDSDetailModel) formatExpiry() string {
+	if m.instance.PayType != "Prepaid" {
+		return "-"
+	}
+	return components.FormatTimeString(m.instance.ExpireTime)
+}
+
+// SetSize sets the size of the detail view
+func (m RDSDetailModel) SetSize(width, height int) RDSDetailModel {
+	m.view = m.view.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m RDSDetailModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m RDSDetailModel) Update(msg tea.Msg) (RDSDetailModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		var nav *types.NavigateMsg
+		switch {
+		case key.Matches(msg, m.keys.JSON):
+			var data interface{} = m.instance
+			if m.attrs != nil {
+				data = m.attrs
+			}
+			nav = &types.NavigateMsg{Page: types.PageRDSJSONDetail, Data: data}
+		case key.Matches(msg, m.keys.Databases):
+			nav = &types.NavigateMsg{Page: types.PageRDSDatabases, Data: m.instance.DBInstanceId}
+		case key.Matches(msg, m.keys.Accounts):
+			nav = &types.NavigateMsg{Page: types.PageRDSAccounts, Data: m.instance.DBInstanceId}
+		case key.Matches(msg, m.keys.Metrics):
+			nav = &types.NavigateMsg{Page: types.PageRDSMetrics, Data: m.instance}
+		case key.Matches(msg, m.keys.Maintenance):
+			req := MaintenanceWindowRequestMsg{Page: types.PageRDSDetail, InstanceID: m.instance.DBInstanceId}
+			if m.attrs != nil {
+				req.Current = m.attrs.Attribute.MaintainTime
+			}
+			return m, func() tea.Msg { return req }
+		case key.Matches(msg, m.keys.Connect):
+			req := m.connectRequest()
+			return m, func() tea.Msg { return req }
+		}
+		if nav != nil {
+			return m, func() tea.Msg { return *nav }
+		}
+	}
+
+	var cmd tea.Cmd
+	m.view, cmd = m.view.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m RDSDetailModel) View() string {
+	return m.view.View()
+}
+
+// Search placeholder for interface compatibility
+func (m RDSDetailModel) Search(query string) RDSDetailModel {
+	return m
+}
+
+// NextSearchMatch placeholder
+func (m RDSDetailModel) NextSearchMatch() RDSDetailModel {
+	return m
+}
+
+// PrevSearchMatch placeholder
+func (m RDSDetailModel) PrevSearchMatch() RDSDetailModel {
+	return m
+}
@@ -0,0 +1,222 @@
+package pages
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// Columns of the slow log table sorted by keys
+const (
+	slowLogColExecutions = 2
+	slowLogColAvgTime    = 3
+)
+
+// RDSSlowLogModel summarizes the slow queries of an RDS instance per SQL
+// template over a date range
+type RDSSlowLogModel struct {
+	table      components.TableModel
+	summaries  []service.SlowQuerySummary
+	instanceId string
+	days       int
+	width      int
+	height     int
+	keys       RDSSlowLogKeyMap
+}
+
+// RDSSlowLogKeyMap defines key bindings
+type RDSSlowLogKeyMap struct {
+	Enter        key.Binding
+	Range        key.Binding
+	ByExecutions key.Binding
+	ByLatency    key.Binding
+}
+
+// DefaultRDSSlowLogKeyMap returns default key bindings
+func DefaultRDSSlowLogKeyMap() RDSSlowLogKeyMap {
+	return RDSSlowLogKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "details"),
+		),
+		Range: key.NewBinding(
+			key.WithKeys("d"),
+			key.WithHelp("d", "date range"),
+		),
+		ByExecutions: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "most executed"),
+		),
+		ByLatency: key.NewBinding(
+			key.WithKeys("l"),
+			key.WithHelp("l", "slowest"),
+		),
+	}
+}
+
+// RDSSlowLogNavData identifies the instance and date range of the slow log page
+type RDSSlowLogNavData struct {
+	InstanceID string
+	Days       int
+}
+
+// NewRDSSlowLogModel creates a new RDS slow log model
+func NewRDSSlowLogModel(instanceId string, days int) RDSSlowLogModel {
+	columns := []components.ColumnSpec{
+		{Title: "SQL Template", Width: 60},
+		{Title: "Database", Width: 14},
+		{Title: "Executions", Width: 11},
+		{Title: "Avg Time (s)", Width: 13},
+		{Title: "Max Time (s)", Width: 13},
+		{Title: "Rows Examined", Width: 14},
+		{Title: "Avg Examined", Width: 13},
+		{Title: "Rows Returned", Width: 14},
+	}
+
+	return RDSSlowLogModel{
+		table:      components.NewTableModelFromSpecs(columns, "RDS Slow Queries"),
+		instanceId: instanceId,
+		days:       days,
+		keys:       DefaultRDSSlowLogKeyMap(),
+	}
+}
+
+// SetData sets the slow query summaries, most executed first. yy copies the
+// SQL template
+func (m RDSSlowLogModel) SetData(summaries []service.SlowQuerySummary) RDSSlowLogModel {
+	m.summaries = summaries
+
+	values := make([][]interface{}, len(summaries))
+	rowData := make([]interface{}, len(summaries))
+
+	for i, s := range summaries {
+		values[i] = []interface{}{
+			strings.Join(strings.Fields(s.SQLText), " "),
+			valueOrDash(s.DBName),
+			s.Executions,
+			formatSeconds(s.AvgTime()),
+			formatSeconds(s.MaxTime),
+			s.RowsExamined,
+			s.AvgRowsExamined(),
+			s.RowsReturned,
+		}
+		rowData[i] = components.TextContent(s.SQLText)
+	}
+
+	m.table = m.table.SetValues(values)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("Slow queries of RDS: %s (last %d days, %d templates)", m.instanceId, m.days, len(summaries)))
+	return m
+}
+
+// SetSize sets the size
+func (m RDSSlowLogModel) SetSize(width, height int) RDSSlowLogModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// InstanceID returns the instance whose slow queries are shown
+func (m RDSSlowLogModel) InstanceID() string {
+	return m.instanceId
+}
+
+// Days returns the date range of the page in days
+func (m RDSSlowLogModel) Days() int {
+	return m.days
+}
+
+// SelectedSummary returns the selected SQL template
+func (m RDSSlowLogModel) SelectedSummary() *service.SlowQuerySummary {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.summaries) {
+		return &m.summaries[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m RDSSlowLogModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m RDSSlowLogModel) Update(msg tea.Msg) (RDSSlowLogModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.keys.Enter):
+			if s := m.SelectedSummary(); s != nil {
+				summary := *s
+				return m, func() tea.Msg {
+					return types.NavigateMsg{Page: types.PageRDSJSONDetail, Data: summary}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Range):
+			req := RDSSlowLogRangeRequestMsg{InstanceID: m.instanceId, Days: m.days}
+			return m, func() tea.Msg { return req }
+
+		case key.Matches(msg, m.keys.ByExecutions):
+			m.table = m.table.SetSort(slowLogColExecutions, components.SortDescending)
+			return m, nil
+
+		case key.Matches(msg, m.keys.ByLatency):
+			m.table = m.table.SetSort(slowLogColAvgTime, components.SortDescending)
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m RDSSlowLogModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m RDSSlowLogModel) Search(query string) RDSSlowLogModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m RDSSlowLogModel) Filter(query string) RDSSlowLogModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m RDSSlowLogModel) NextSearchMatch() RDSSlowLogModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m RDSSlowLogModel) PrevSearchMatch() RDSSlowLogModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// formatSeconds shows a duration as seconds with millisecond precision, which
+// sorts numerically
+func formatSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+// RDSSlowLogRangeRequestMsg asks the app to pick another date range for the
+// slow log page
+type RDSSlowLogRangeRequestMsg struct {
+	InstanceID string
+	Days       int
+}
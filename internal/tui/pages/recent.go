@@ -0,0 +1,147 @@
+package pages
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/config"
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/tui/components"
+)
+
+// RecentOpenRequestMsg requests reopening the detail page of a recently
+// viewed resource, in the profile and region it was viewed in
+type RecentOpenRequestMsg struct {
+	Item config.RecentResource
+}
+
+// RecentModel represents the recently viewed resources page
+type RecentModel struct {
+	table  components.TableModel
+	items  []config.RecentResource
+	width  int
+	height int
+	keys   RecentKeyMap
+}
+
+// RecentKeyMap defines key bindings
+type RecentKeyMap struct {
+	Enter key.Binding
+}
+
+// DefaultRecentKeyMap returns default key bindings
+func DefaultRecentKeyMap() RecentKeyMap {
+	return RecentKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "open"),
+		),
+	}
+}
+
+// NewRecentModel creates a new recently viewed resources model
+func NewRecentModel() RecentModel {
+	columns := []components.ColumnSpec{
+		{Title: "Type", Width: 14},
+		{Title: "Name", Width: 30},
+		{Title: "ID", Width: 28},
+		{Title: "Profile", Width: 16},
+		{Title: "Region", Width: 16},
+		{Title: "Viewed", Width: 20},
+	}
+
+	return RecentModel{
+		table: components.NewTableModelFromSpecs(columns, i18n.T(i18n.KeyPageRecent)),
+		keys:  DefaultRecentKeyMap(),
+	}
+}
+
+// SetData sets the recently viewed resources, newest first
+func (m RecentModel) SetData(items []config.RecentResource) RecentModel {
+	m.items = items
+
+	values := make([][]interface{}, len(items))
+	rowData := make([]interface{}, len(items))
+	for i, item := range items {
+		values[i] = []interface{}{
+			item.Type,
+			item.Name,
+			item.ID,
+			item.Profile,
+			item.Region,
+			components.FormatTime(item.ViewedAt),
+		}
+		rowData[i] = item
+	}
+
+	m.table = m.table.SetValues(values)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m RecentModel) SetSize(width, height int) RecentModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m RecentModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m RecentModel) Update(msg tea.Msg) (RecentModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		if item, ok := m.selectedItem(); ok {
+			return m, func() tea.Msg {
+				return RecentOpenRequestMsg{Item: item}
+			}
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// selectedItem returns the resource of the selected row
+func (m RecentModel) selectedItem() (config.RecentResource, bool) {
+	idx := m.table.SelectedRow()
+	if idx < 0 || idx >= len(m.items) {
+		return config.RecentResource{}, false
+	}
+	return m.items[idx], true
+}
+
+// View implements tea.Model
+func (m RecentModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m RecentModel) Search(query string) RecentModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m RecentModel) Filter(query string) RecentModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m RecentModel) NextSearchMatch() RecentModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m RecentModel) PrevSearchMatch() RecentModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
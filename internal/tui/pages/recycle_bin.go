@@ -0,0 +1,171 @@
+package pages
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// RecycleBinModel lists the ECS and RDS instances of the region that expired,
+// are locked or are scheduled to be released
+type RecycleBinModel struct {
+	table     components.TableModel
+	resources []service.RecycledResource
+	width     int
+	height    int
+	keys      RecycleBinKeyMap
+}
+
+// RecycleBinKeyMap defines key bindings
+type RecycleBinKeyMap struct {
+	Enter   key.Binding
+	Restore key.Binding
+}
+
+// DefaultRecycleBinKeyMap returns default key bindings
+func DefaultRecycleBinKeyMap() RecycleBinKeyMap {
+	return RecycleBinKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "details"),
+		),
+		Restore: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "restore"),
+		),
+	}
+}
+
+// NewRecycleBinModel creates a new recycle bin model
+func NewRecycleBinModel() RecycleBinModel {
+	columns := []table.Column{
+		{Title: "Product", Width: 8},
+		{Title: "Instance ID", Width: 28},
+		{Title: "Name", Width: 30},
+		{Title: "Status", Width: 12},
+		{Title: "Reason", Width: 30},
+		{Title: "Expires / Releases", Width: 22},
+	}
+
+	return RecycleBinModel{
+		table: components.NewTableModel(columns, "Recycle Bin"),
+		keys:  DefaultRecycleBinKeyMap(),
+	}
+}
+
+// SetData sets the recycled resources
+func (m RecycleBinModel) SetData(resources []service.RecycledResource) RecycleBinModel {
+	m.resources = resources
+
+	rows := make([]table.Row, len(resources))
+	rowData := make([]interface{}, len(resources))
+
+	for i, r := range resources {
+		rows[i] = table.Row{
+			r.Product,
+			r.ID,
+			valueOrDash(r.Name),
+			valueOrDash(r.Status),
+			r.Reason,
+			components.FormatTimeString(r.Time),
+		}
+		rowData[i] = r
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("Recycle Bin (%d)", len(resources)))
+	return m
+}
+
+// SetSize sets the size
+func (m RecycleBinModel) SetSize(width, height int) RecycleBinModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedResource returns the selected resource
+func (m RecycleBinModel) SelectedResource() *service.RecycledResource {
+	if r, ok := m.table.SelectedRowData().(service.RecycledResource); ok {
+		return &r
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m RecycleBinModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m RecycleBinModel) Update(msg tea.Msg) (RecycleBinModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.keys.Enter):
+			if r := m.SelectedResource(); r != nil {
+				page := types.PageECSJSONDetail
+				if r.Product == service.RecycledProductRDS {
+					page = types.PageRDSJSONDetail
+				}
+				raw := r.Raw
+				return m, func() tea.Msg {
+					return types.NavigateMsg{Page: page, Data: raw}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Restore):
+			if r := m.SelectedResource(); r != nil {
+				resource := *r
+				return m, func() tea.Msg {
+					return RecycleRestoreRequestMsg{Resource: resource}
+				}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m RecycleBinModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m RecycleBinModel) Search(query string) RecycleBinModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m RecycleBinModel) Filter(query string) RecycleBinModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m RecycleBinModel) NextSearchMatch() RecycleBinModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m RecycleBinModel) PrevSearchMatch() RecycleBinModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// RecycleRestoreRequestMsg asks the app to keep a recycled resource from being released
+type RecycleRestoreRequestMsg struct {
+	Resource service.RecycledResource
+}
@@ -9,23 +9,33 @@ import (
 
 	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
 
+	"aliyun-tui-viewer/internal/service"
 	"aliyun-tui-viewer/internal/tui/components"
 	"aliyun-tui-viewer/internal/tui/types"
 )
 
 // RedisListModel represents the Redis instances list page
 type RedisListModel struct {
-	table     components.TableModel
-	instances []r_kvstore.KVStoreInstance
-	width     int
-	height    int
-	keys      RedisListKeyMap
+	table      components.TableModel
+	instances  []r_kvstore.KVStoreInstance
+	width      int
+	height     int
+	keys       RedisListKeyMap
+	showRegion bool       // Region column, when listing all regions
+	tagFilter  *TagFilter // Set by t, nil to show every resource
 }
 
 // RedisListKeyMap defines key bindings
 type RedisListKeyMap struct {
-	Enter    key.Binding
-	Accounts key.Binding
+	Enter      key.Binding
+	Accounts   key.Binding
+	Parameters key.Binding
+	Metrics    key.Binding
+	Backups    key.Binding
+	ConnTest   key.Binding
+	Probe      key.Binding
+	Forward    key.Binding
+	TagFilter  key.Binding
 }
 
 // DefaultRedisListKeyMap returns default key bindings
@@ -39,6 +49,22 @@ func DefaultRedisListKeyMap() RedisListKeyMap {
 			key.WithKeys("A"),
 			key.WithHelp("A", "accounts"),
 		),
+		Parameters: key.NewBinding(
+			key.WithKeys("P"),
+			key.WithHelp("P", "parameters"),
+		),
+		Metrics: key.NewBinding(
+			key.WithKeys("M"),
+			key.WithHelp("M", "performance"),
+		),
+		Backups: key.NewBinding(
+			key.WithKeys("B"),
+			key.WithHelp("B", "backups"),
+		),
+		ConnTest:  connTestBinding(),
+		Probe:     probeBinding(),
+		Forward:   portForwardBinding(),
+		TagFilter: tagFilterBinding(),
 	}
 }
 
@@ -80,11 +106,23 @@ func (m RedisListModel) SetData(instances []r_kvstore.KVStoreInstance) RedisList
 			inst.InstanceStatus,
 			connection,
 		}
+		if m.showRegion {
+			rows[i] = append(rows[i], inst.RegionId)
+		}
 		rowData[i] = inst
 	}
 
 	m.table = m.table.SetRows(rows)
 	m.table = m.table.SetRowData(rowData)
+	return m.applyTagFilter()
+}
+
+// SetShowRegion adds a Region column, used when listing all regions
+func (m RedisListModel) SetShowRegion(show bool) RedisListModel {
+	if show && !m.showRegion {
+		m.table = m.table.SetColumns(append(m.table.Columns(), regionColumn()))
+	}
+	m.showRegion = show
 	return m
 }
 
@@ -105,6 +143,25 @@ func (m RedisListModel) SelectedInstance() *r_kvstore.KVStoreInstance {
 	return nil
 }
 
+// SetTagFilter shows only the resources carrying the filter's tag, or every
+// resource again when f is nil
+func (m RedisListModel) SetTagFilter(f *TagFilter) RedisListModel {
+	m.tagFilter = f
+	return m.applyTagFilter()
+}
+
+// TagFilter returns the active tag filter, or nil
+func (m RedisListModel) TagFilter() *TagFilter {
+	return m.tagFilter
+}
+
+// applyTagFilter applies the tag filter to the current rows
+func (m RedisListModel) applyTagFilter() RedisListModel {
+	instances := m.instances
+	m.table = applyTagFilter(m.table, m.tagFilter, func(i int) string { return instances[i].InstanceId })
+	return m
+}
+
 // Init implements tea.Model
 func (m RedisListModel) Init() tea.Cmd {
 	return nil
@@ -134,6 +191,54 @@ func (m RedisListModel) Update(msg tea.Msg) (RedisListModel, tea.Cmd) {
 					}
 				}
 			}
+
+		case key.Matches(msg, m.keys.Parameters):
+			if inst := m.SelectedInstance(); inst != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageRedisParameters,
+						Data: inst.InstanceId,
+					}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Metrics):
+			if inst := m.SelectedInstance(); inst != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageRedisMetrics,
+						Data: *inst,
+					}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Backups):
+			if inst := m.SelectedInstance(); inst != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{
+						Page: types.PageRedisBackups,
+						Data: inst.InstanceId,
+					}
+				}
+			}
+
+		case key.Matches(msg, m.keys.ConnTest):
+			if inst := m.SelectedInstance(); inst != nil {
+				return m, requestConnTest(inst.InstanceId, inst.ConnectionDomain, int(inst.Port), service.ConnProtocolRedis)
+			}
+
+		case key.Matches(msg, m.keys.Probe):
+			if inst := m.SelectedInstance(); inst != nil {
+				return m, requestProbe(inst.InstanceId, inst.ConnectionDomain, int(inst.Port))
+			}
+
+		case key.Matches(msg, m.keys.Forward):
+			if inst := m.SelectedInstance(); inst != nil {
+				return m, requestPortForward(inst.InstanceId, inst.ConnectionDomain, int(inst.Port))
+			}
+
+		case key.Matches(msg, m.keys.TagFilter):
+			return m, requestTagFilter(m.tagFilter)
 		}
 	}
 
@@ -147,12 +252,23 @@ func (m RedisListModel) View() string {
 	return m.table.View()
 }
 
+// Table returns the list's table, used to render it outside the TUI
+func (m RedisListModel) Table() components.TableModel {
+	return m.table
+}
+
 // Search searches in the list
 func (m RedisListModel) Search(query string) RedisListModel {
 	m.table = m.table.Search(query)
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m RedisListModel) Filter(query string) RedisListModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m RedisListModel) NextSearchMatch() RedisListModel {
 	m.table = m.table.NextSearchMatch()
@@ -245,6 +361,12 @@ func (m RedisAccountsModel) Search(query string) RedisAccountsModel {
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m RedisAccountsModel) Filter(query string) RedisAccountsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m RedisAccountsModel) NextSearchMatch() RedisAccountsModel {
 	m.table = m.table.NextSearchMatch()
@@ -257,3 +379,253 @@ func (m RedisAccountsModel) PrevSearchMatch() RedisAccountsModel {
 	return m
 }
 
+
+// yesNo formats a flag for a table cell
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
+
+// RedisParametersModel represents the Redis parameters page
+type RedisParametersModel struct {
+	table      components.TableModel
+	params     []service.RedisParameter
+	instanceId string
+	width      int
+	height     int
+}
+
+// NewRedisParametersModel creates a new Redis parameters model
+func NewRedisParametersModel() RedisParametersModel {
+	columns := []table.Column{
+		{Title: "Parameter", Width: 35},
+		{Title: "Value", Width: 25},
+		{Title: "Modifiable", Width: 11},
+		{Title: "Restart", Width: 8},
+		{Title: "Allowed Values", Width: 25},
+		{Title: "Description", Width: 50},
+	}
+
+	return RedisParametersModel{
+		table: components.NewTableModel(columns, "Redis Parameters"),
+	}
+}
+
+// SetData sets the parameters data
+func (m RedisParametersModel) SetData(params []service.RedisParameter, instanceId string) RedisParametersModel {
+	m.params = params
+	m.instanceId = instanceId
+
+	rows := make([]table.Row, len(params))
+	rowData := make([]interface{}, len(params))
+
+	for i, param := range params {
+		rows[i] = table.Row{
+			param.Name,
+			param.Value,
+			yesNo(param.Modifiable),
+			yesNo(param.ForceRestart),
+			param.CheckingCode,
+			param.Description,
+		}
+		rowData[i] = param
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("Parameters for Redis: %s", instanceId))
+	return m
+}
+
+// SetSize sets the size
+func (m RedisParametersModel) SetSize(width, height int) RedisParametersModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m RedisParametersModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m RedisParametersModel) Update(msg tea.Msg) (RedisParametersModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m RedisParametersModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m RedisParametersModel) Search(query string) RedisParametersModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m RedisParametersModel) Filter(query string) RedisParametersModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m RedisParametersModel) NextSearchMatch() RedisParametersModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m RedisParametersModel) PrevSearchMatch() RedisParametersModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// RedisBackupsModel represents the Redis backups page
+type RedisBackupsModel struct {
+	table      components.TableModel
+	backups    []r_kvstore.Backup
+	instanceId string
+	width      int
+	height     int
+	keys       RedisBackupsKeyMap
+}
+
+// RedisBackupsKeyMap defines key bindings
+type RedisBackupsKeyMap struct {
+	Enter key.Binding
+}
+
+// DefaultRedisBackupsKeyMap returns default key bindings
+func DefaultRedisBackupsKeyMap() RedisBackupsKeyMap {
+	return RedisBackupsKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "details"),
+		),
+	}
+}
+
+// NewRedisBackupsModel creates a new Redis backups model
+func NewRedisBackupsModel() RedisBackupsModel {
+	columns := []components.ColumnSpec{
+		{Title: "Backup ID", Width: 14},
+		{Title: "Started", Width: 22},
+		{Title: "Finished", Width: 22},
+		{Title: "Size", Width: 12, Format: components.FormatSize},
+		{Title: "Method", Width: 10},
+		{Title: "Type", Width: 12},
+		{Title: "Mode", Width: 10},
+		{Title: "Status", Width: 10},
+		{Title: "Node", Width: 25},
+	}
+
+	return RedisBackupsModel{
+		table: components.NewTableModelFromSpecs(columns, "Redis Backups"),
+		keys:  DefaultRedisBackupsKeyMap(),
+	}
+}
+
+// SetData sets the backups data. yy copies the download URL of a backup,
+// the internal one when it has no public one
+func (m RedisBackupsModel) SetData(backups []r_kvstore.Backup, instanceId string) RedisBackupsModel {
+	m.backups = backups
+	m.instanceId = instanceId
+
+	values := make([][]interface{}, len(backups))
+	rowData := make([]interface{}, len(backups))
+
+	for i, b := range backups {
+		values[i] = []interface{}{
+			fmt.Sprintf("%d", b.BackupId),
+			components.FormatTimeString(b.BackupStartTime),
+			components.FormatTimeString(b.BackupEndTime),
+			b.BackupSize,
+			b.BackupMethod,
+			b.BackupType,
+			b.BackupMode,
+			b.BackupStatus,
+			valueOrDash(b.NodeInstanceId),
+		}
+		rowData[i] = backupDownloadData(b.BackupDownloadURL, b.BackupIntranetDownloadURL, b)
+	}
+
+	m.table = m.table.SetValues(values)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("Backups for Redis: %s (last %d days)", instanceId, int(service.RedisBackupWindow.Hours()/24)))
+	return m
+}
+
+// SetSize sets the size
+func (m RedisBackupsModel) SetSize(width, height int) RedisBackupsModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedBackup returns the selected backup
+func (m RedisBackupsModel) SelectedBackup() *r_kvstore.Backup {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.backups) {
+		return &m.backups[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m RedisBackupsModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m RedisBackupsModel) Update(msg tea.Msg) (RedisBackupsModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		if b := m.SelectedBackup(); b != nil {
+			backup := *b
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageRedisJSONDetail, Data: backup}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m RedisBackupsModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m RedisBackupsModel) Search(query string) RedisBackupsModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m RedisBackupsModel) Filter(query string) RedisBackupsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m RedisBackupsModel) NextSearchMatch() RedisBackupsModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m RedisBackupsModel) PrevSearchMatch() RedisBackupsModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
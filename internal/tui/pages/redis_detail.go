@@ -0,0 +1,370 @@
+package pages
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// RedisDetailModel is the formatted detail page of a Redis instance. The
+// endpoints, whitelists and maintenance window come from the instance
+// attributes, which load after the page opens
+type RedisDetailModel struct {
+	instance r_kvstore.KVStoreInstance
+	names    *service.NameResolver // Resolves referenced IDs to names, may be nil
+	attrs    *service.RedisInstanceAttributes
+	attrErr  error
+	view     SectionDetailModel
+	keys     RedisDetailKeyMap
+}
+
+// RedisDetailKeyMap defines the Redis detail keys besides the navigation
+type RedisDetailKeyMap struct {
+	JSON        key.Binding
+	Accounts    key.Binding
+	Maintenance key.Binding
+	Connect     key.Binding
+}
+
+// DefaultRedisDetailKeyMap returns default key bindings
+func DefaultRedisDetailKeyMap() RedisDetailKeyMap {
+	return RedisDetailKeyMap{
+		JSON: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "JSON"),
+		),
+		Accounts:    DefaultRedisListKeyMap().Accounts,
+		Maintenance: maintenanceWindowKey(),
+		Connect:     connectBinding(),
+	}
+}
+
+// NewRedisDetailModel creates a new Redis detail model
+func NewRedisDetailModel(instance r_kvstore.KVStoreInstance) RedisDetailModel {
+	m := RedisDetailModel{
+		instance: instance,
+		view:     NewSectionDetailModel(),
+		keys:     DefaultRedisDetailKeyMap(),
+	}
+	return m.rebuild()
+}
+
+// NewRedisDetailModelFromInterface creates a new Redis detail model from
+// interface{}, and false when data is not a Redis instance
+func NewRedisDetailModelFromInterface(data interface{}) (RedisDetailModel, bool) {
+	if instance, ok := data.(r_kvstore.KVStoreInstance); ok {
+		return NewRedisDetailModel(instance), true
+	}
+	return RedisDetailModel{}, false
+}
+
+// InstanceID returns the ID of the shown instance
+func (m RedisDetailModel) InstanceID() string {
+	return m.instance.InstanceId
+}
+
+// NameRefs returns the IDs referenced by the instance
+func (m RedisDetailModel) NameRefs() service.NameRefs {
+	refs := make(service.NameRefs)
+	refs.Add(service.KindVPC, m.instance.VpcId)
+	refs.Add(service.KindVSwitch, m.instance.VSwitchId)
+	refs.Add(service.KindResourceGroup, m.instance.ResourceGroupId)
+	return refs
+}
+
+// SetNames sets the resolver used to show the names of referenced resources
+func (m RedisDetailModel) SetNames(names *service.NameResolver) RedisDetailModel {
+	m.names = names
+	if m.instance.InstanceId == "" {
+		return m
+	}
+	return m.rebuild()
+}
+
+// SetAttributes sets the instance attributes, or the error that prevented
+// loading them
+func (m RedisDetailModel) SetAttributes(attrs *service.RedisInstanceAttributes, err error) RedisDetailModel {
+	m.attrs = attrs
+	m.attrErr = err
+	return m.rebuild()
+}
+
+// rebuild renders the sections from the current data
+func (m RedisDetailModel) rebuild() RedisDetailModel {
+	inst := m.instance
+
+	basicInfo := DetailSection{
+		Title: i18n.T(i18n.KeySectionBasicInfo),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelInstanceID), Value: inst.InstanceId},
+			{Label: i18n.T(i18n.KeyLabelInstanceName), Value: valueOrDash(inst.InstanceName)},
+			{Label: i18n.T(i18n.KeyLabelInstanceStatus), Value: valueOrDash(inst.InstanceStatus), Status: true},
+			{Label: i18n.T(i18n.KeyLabelEngineVersion), Value: strings.TrimSpace(inst.InstanceType + " " + inst.EngineVersion)},
+			{Label: i18n.T(i18n.KeyLabelArchitecture), Value: m.formatArchitecture()},
+			{Label: i18n.T(i18n.KeyColRegion), Value: valueOrDash(inst.RegionId)},
+			{Label: i18n.T(i18n.KeyLabelAvailZone), Value: m.formatZones()},
+			{Label: i18n.T(i18n.KeyColCreatedAt), Value: components.FormatTimeString(inst.CreateTime)},
+		},
+	}
+
+	spec := DetailSection{
+		Title: i18n.T(i18n.KeySectionSpec),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyColSpec), Value: valueOrDash(inst.InstanceClass)},
+			{Label: i18n.T(i18n.KeyColCapacity), Value: fmt.Sprintf("%d MB", inst.Capacity)},
+			{Label: i18n.T(i18n.KeyLabelBandwidth), Value: fmt.Sprintf("%d MB/s", inst.Bandwidth)},
+			{Label: i18n.T(i18n.KeyLabelMaxConnections), Value: fmt.Sprintf("%d", inst.Connections)},
+			{Label: i18n.T(i18n.KeyLabelQPS), Value: fmt.Sprintf("%d", inst.QPS)},
+		},
+	}
+	if inst.ShardCount > 0 {
+		spec.Rows = append(spec.Rows, DetailRow{Label: i18n.T(i18n.KeyLabelShards), Value: fmt.Sprintf("%d", inst.ShardCount)})
+	}
+
+	network := DetailSection{
+		Title: i18n.T(i18n.KeySectionNetwork),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelNetworkType), Value: valueOrDash(inst.NetworkType)},
+			{Label: i18n.T(i18n.KeyLabelVPC), Value: valueOrDash(m.names.Label(service.KindVPC, inst.VpcId))},
+			{Label: i18n.T(i18n.KeyLabelVSwitch), Value: valueOrDash(m.names.Label(service.KindVSwitch, inst.VSwitchId))},
+		},
+	}
+
+	maintenance := DetailSection{Title: i18n.T(i18n.KeySectionMaintenance)}
+	if row, ok := m.placeholder(maintenance.Title); ok {
+		maintenance.Rows = []DetailRow{row}
+	} else {
+		attr := m.attrs.Attribute
+		window := "-"
+		if attr.MaintainStartTime != "" {
+			window = formatMaintenanceWindow(service.MaintenanceWindow{Start: attr.MaintainStartTime, End: attr.MaintainEndTime}.String())
+		}
+		maintenance.Rows = []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelMaintenance), Value: window},
+			{Label: i18n.T(i18n.KeyLabelDeleteProtection), Value: fmt.Sprintf("%t", attr.InstanceReleaseProtection)},
+		}
+	}
+
+	billing := DetailSection{
+		Title: i18n.T(i18n.KeySectionBilling),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelChargeType), Value: m.formatChargeType()},
+			{Label: i18n.T(i18n.KeyLabelExpireTime), Value: m.formatExpiry()},
+		},
+	}
+
+	groupInfo := DetailSection{
+		Title: i18n.T(i18n.KeySectionGroupInfo),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelResourceGroup), Value: valueOrDash(m.names.Label(service.KindResourceGroup, inst.ResourceGroupId))},
+			{Label: i18n.T(i18n.KeyLabelTags), Value: m.formatTags()},
+		},
+	}
+
+	m.view = m.view.SetSections([]DetailSection{basicInfo, spec, m.endpointsSection(), network, m.whitelistSection(), maintenance, billing, groupInfo})
+	return m
+}
+
+// placeholder returns the row shown while the attributes load or when they
+// failed, and false once they are loaded
+func (m RedisDetailModel) placeholder(label string) (DetailRow, bool) {
+	switch {
+	case m.attrErr != nil:
+		return DetailRow{Label: label, Value: fmt.Sprintf("%s: %v", i18n.T(i18n.KeyDetailUnavailable), m.attrErr)}, true
+	case m.attrs == nil:
+		return DetailRow{Label: label, Value: i18n.T(i18n.KeyActionLoading)}, true
+	}
+	return DetailRow{}, false
+}
+
+// endpointsSection lists the connection endpoints, one row each. Until they
+// load, the list entry's connection domain is shown
+func (m RedisDetailModel) endpointsSection() DetailSection {
+	section := DetailSection{Title: i18n.T(i18n.KeySectionEndpoints)}
+	if row, ok := m.placeholder(section.Title); ok {
+		if m.instance.ConnectionDomain != "" {
+			section.Rows = append(section.Rows, DetailRow{Label: "Private", Value: fmt.Sprintf("%s:%d", m.instance.ConnectionDomain, m.instance.Port)})
+		}
+		section.Rows = append(section.Rows, row)
+		return section
+	}
+
+	for _, info := range m.attrs.NetInfo {
+		value := fmt.Sprintf("%s:%s", info.ConnectionString, info.Port)
+		if info.IPAddress != "" {
+			value += fmt.Sprintf("  (%s)", info.IPAddress)
+		}
+		section.Rows = append(section.Rows, DetailRow{Label: valueOrDash(info.IPType), Value: value})
+	}
+	if len(section.Rows) == 0 {
+		section.Rows = []DetailRow{{Label: section.Title, Value: "-"}}
+	}
+	return section
+}
+
+// connectRequest asks to open redis-cli at the public endpoint when there is
+// one, as the default account, which is named after the instance
+func (m RedisDetailModel) connectRequest() ConnectRequestMsg {
+	var infos []endpointInfo
+	if m.attrs != nil {
+		for _, info := range m.attrs.NetInfo {
+			infos = append(infos, endpointInfo{ipType: info.IPType, address: net.JoinHostPort(info.ConnectionString, info.Port)})
+		}
+	}
+	fallback := ""
+	if m.instance.ConnectionDomain != "" {
+		fallback = net.JoinHostPort(m.instance.ConnectionDomain, strconv.FormatInt(m.instance.Port, 10))
+	}
+	return ConnectRequestMsg{
+		InstanceID: m.instance.InstanceId,
+		Engine:     "Redis",
+		Address:    preferredEndpoint(infos, fallback),
+		Account:    m.instance.InstanceId,
+	}
+}
+
+// whitelistSection summarizes the IP whitelist groups, one row each
+func (m RedisDetailModel) whitelistSection() DetailSection {
+	section := DetailSection{Title: i18n.T(i18n.KeySectionWhitelist)}
+	if row, ok := m.placeholder(section.Title); ok {
+		section.Rows = []DetailRow{row}
+		return section
+	}
+
+	for _, group := range m.attrs.Whitelists {
+		// Hidden groups are maintained by Alibaba Cloud services
+		if group.SecurityIpGroupAttribute == "hidden" {
+			continue
+		}
+		section.Rows = append(section.Rows, DetailRow{Label: group.SecurityIpGroupName, Value: summarizeIPList(group.SecurityIpList)})
+	}
+	if len(section.Rows) == 0 {
+		section.Rows = []DetailRow{{Label: section.Title, Value: "-"}}
+	}
+	return section
+}
+
+// formatArchitecture shows the architecture with the node type, e.g.
+// "cluster (double)"
+func (m RedisDetailModel) formatArchitecture() string {
+	if m.instance.NodeType == "" {
+		return valueOrDash(m.instance.ArchitectureType)
+	}
+	return fmt.Sprintf("%s (%s)", valueOrDash(m.instance.ArchitectureType), m.instance.NodeType)
+}
+
+// formatZones shows the primary zone with the secondary zone of multi-zone
+// instances
+func (m RedisDetailModel) formatZones() string {
+	if m.instance.SecondaryZoneId == "" || m.instance.SecondaryZoneId == m.instance.ZoneId {
+		return valueOrDash(m.instance.ZoneId)
+	}
+	return fmt.Sprintf("%s, %s", valueOrDash(m.instance.ZoneId), m.instance.SecondaryZoneId)
+}
+
+// formatChargeType shows the billing method in the wording of the ECS detail
+func (m RedisDetailModel) formatChargeType() string {
+	switch m.instance.ChargeType {
+	case "PrePaid":
+		return i18n.T(i18n.KeyChargePrePaid)
+	case "PostPaid":
+		return i18n.T(i18n.KeyChargePostPaid)
+	default:
+		return valueOrDash(m.instance.ChargeType)
+	}
+}
+
+// formatExpiry shows when a subscription instance expires
+func (m RedisDetailModel) formatExpiry() string {
+	if m.instance.ChargeType != "PrePaid" {
+		return "-"
+	}
+	return components.FormatTimeString(m.instance.EndTime)
+}
+
+// formatTags formats the instance tags as "key: value" pairs
+func (m RedisDetailModel) formatTags() string {
+	if len(m.instance.Tags.Tag) == 0 {
+		return "-"
+	}
+	tags := make([]string, len(m.instance.Tags.Tag))
+	for i, tag := range m.instance.Tags.Tag {
+		tags[i] = fmt.Sprintf("%s: %s", tag.Key, tag.Value)
+	}
+	return strings.Join(tags, ", ")
+}
+
+// SetSize sets the size of the detail view
+func (m RedisDetailModel) SetSize(width, height int) RedisDetailModel {
+	m.view = m.view.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m RedisDetailModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m RedisDetailModel) Update(msg tea.Msg) (RedisDetailModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		var nav *types.NavigateMsg
+		switch {
+		case key.Matches(msg, m.keys.JSON):
+			var data interface{} = m.instance
+			if m.attrs != nil {
+				data = m.attrs
+			}
+			nav = &types.NavigateMsg{Page: types.PageRedisJSONDetail, Data: data}
+		case key.Matches(msg, m.keys.Accounts):
+			nav = &types.NavigateMsg{Page: types.PageRedisAccounts, Data: m.instance.InstanceId}
+		case key.Matches(msg, m.keys.Maintenance):
+			req := MaintenanceWindowRequestMsg{Page: types.PageRedisDetail, InstanceID: m.instance.InstanceId}
+			if attr := m.attrs; attr != nil && attr.Attribute.MaintainStartTime != "" {
+				req.Current = service.MaintenanceWindow{Start: attr.Attribute.MaintainStartTime, End: attr.Attribute.MaintainEndTime}.String()
+			}
+			return m, func() tea.Msg { return req }
+		case key.Matches(msg, m.keys.Connect):
+			req := m.connectRequest()
+			return m, func() tea.Msg { return req }
+		}
+		if nav != nil {
+			return m, func() tea.Msg { return *nav }
+		}
+	}
+
+	var cmd tea.Cmd
+	m.view, cmd = m.view.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m RedisDetailModel) View() string {
+	return m.view.View()
+}
+
+// Search placeholder for interface compatibility
+func (m RedisDetailModel) Search(query string) RedisDetailModel {
+	return m
+}
+
+// NextSearchMatch placeholder
+func (m RedisDetailModel) NextSearchMatch() RedisDetailModel {
+	return m
+}
+
+// PrevSearchMatch placeholder
+func (m RedisDetailModel) PrevSearchMatch() RedisDetailModel {
+	return m
+}
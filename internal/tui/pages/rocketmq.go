@@ -12,6 +12,49 @@ import (
 	"aliyun-tui-viewer/internal/tui/types"
 )
 
+// rocketMQInstanceType names the edition of an instance
+func rocketMQInstanceType(instanceType int32) string {
+	switch instanceType {
+	case 1:
+		return "Standard"
+	case 2:
+		return "Professional"
+	default:
+		return fmt.Sprintf("%d", instanceType)
+	}
+}
+
+// rocketMQEdition names the edition of an instance; 5.x instances report it
+// as a series name rather than a type code
+func rocketMQEdition(inst service.RocketMQInstance) string {
+	if inst.Edition != "" {
+		return inst.Edition
+	}
+	return rocketMQInstanceType(inst.InstanceType)
+}
+
+// rocketMQInstanceStatus names the status code of an instance
+func rocketMQInstanceStatus(status int32) string {
+	switch status {
+	case 0:
+		return "Creating"
+	case 5:
+		return "Running"
+	case 6:
+		return "Expired"
+	case 7:
+		return "Releasing"
+	default:
+		return fmt.Sprintf("%d", status)
+	}
+}
+
+// formatRocketMQDelay formats how far a consumer group is behind, given in
+// milliseconds
+func formatRocketMQDelay(ms int64) string {
+	return components.FormatCell(components.FormatDuration, ms)
+}
+
 // RocketMQListModel represents the RocketMQ instances list page
 type RocketMQListModel struct {
 	table     components.TableModel
@@ -51,7 +94,8 @@ func NewRocketMQListModel() RocketMQListModel {
 	columns := []table.Column{
 		{Title: "Instance ID", Width: 40},
 		{Title: "Name", Width: 35},
-		{Title: "Type", Width: 15},
+		{Title: "Type", Width: 20},
+		{Title: "API Version", Width: 12},
 		{Title: "Status", Width: 12},
 		{Title: "Region", Width: 18},
 	}
@@ -70,32 +114,12 @@ func (m RocketMQListModel) SetData(instances []service.RocketMQInstance) RocketM
 	rowData := make([]interface{}, len(instances))
 
 	for i, inst := range instances {
-		// Convert int32 values to string
-		instanceType := fmt.Sprintf("%d", inst.InstanceType)
-		switch inst.InstanceType {
-		case 1:
-			instanceType = "Standard"
-		case 2:
-			instanceType = "Professional"
-		}
-
-		instanceStatus := fmt.Sprintf("%d", inst.InstanceStatus)
-		switch inst.InstanceStatus {
-		case 0:
-			instanceStatus = "Creating"
-		case 5:
-			instanceStatus = "Running"
-		case 6:
-			instanceStatus = "Expired"
-		case 7:
-			instanceStatus = "Releasing"
-		}
-
 		rows[i] = table.Row{
 			inst.InstanceId,
 			inst.InstanceName,
-			instanceType,
-			instanceStatus,
+			rocketMQEdition(inst),
+			inst.APIVersion,
+			rocketMQInstanceStatus(inst.InstanceStatus),
 			inst.RegionId,
 		}
 		rowData[i] = inst
@@ -175,12 +199,23 @@ func (m RocketMQListModel) View() string {
 	return m.table.View()
 }
 
+// Table returns the list's table, used to render it outside the TUI
+func (m RocketMQListModel) Table() components.TableModel {
+	return m.table
+}
+
 // Search searches in the list
 func (m RocketMQListModel) Search(query string) RocketMQListModel {
 	m.table = m.table.Search(query)
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m RocketMQListModel) Filter(query string) RocketMQListModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m RocketMQListModel) NextSearchMatch() RocketMQListModel {
 	m.table = m.table.NextSearchMatch()
@@ -200,6 +235,27 @@ type RocketMQTopicsModel struct {
 	instanceId string
 	width      int
 	height     int
+	keys       RocketMQTopicsKeyMap
+}
+
+// RocketMQTopicsKeyMap defines key bindings for the topics page
+type RocketMQTopicsKeyMap struct {
+	Enter    key.Binding
+	Messages key.Binding
+}
+
+// DefaultRocketMQTopicsKeyMap returns default key bindings
+func DefaultRocketMQTopicsKeyMap() RocketMQTopicsKeyMap {
+	return RocketMQTopicsKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "subscribed groups"),
+		),
+		Messages: key.NewBinding(
+			key.WithKeys("m"),
+			key.WithHelp("m", "messages"),
+		),
+	}
 }
 
 // NewRocketMQTopicsModel creates a new RocketMQ topics model
@@ -207,16 +263,19 @@ func NewRocketMQTopicsModel() RocketMQTopicsModel {
 	columns := []table.Column{
 		{Title: "Topic Name", Width: 40},
 		{Title: "Message Type", Width: 15},
+		{Title: "Lag", Width: 12},
+		{Title: "Groups", Width: 8},
 		{Title: "Status", Width: 12},
-		{Title: "Remark", Width: 40},
+		{Title: "Remark", Width: 30},
 	}
 
 	return RocketMQTopicsModel{
 		table: components.NewTableModel(columns, "RocketMQ Topics"),
+		keys:  DefaultRocketMQTopicsKeyMap(),
 	}
 }
 
-// SetData sets the topics data
+// SetData sets the topics data. Topics whose lag could not be read show "?"
 func (m RocketMQTopicsModel) SetData(topics []service.RocketMQTopic, instanceId string) RocketMQTopicsModel {
 	m.topics = topics
 	m.instanceId = instanceId
@@ -244,9 +303,17 @@ func (m RocketMQTopicsModel) SetData(topics []service.RocketMQTopic, instanceId
 			messageType = "Ordered"
 		}
 
+		lag, groups := "?", "?"
+		if topic.LagErr == nil {
+			lag = fmt.Sprintf("%d", topic.TotalDiff)
+			groups = fmt.Sprintf("%d", len(topic.Subscriptions))
+		}
+
 		rows[i] = table.Row{
 			topic.Topic,
 			messageType,
+			lag,
+			groups,
 			status,
 			topic.Remark,
 		}
@@ -259,6 +326,15 @@ func (m RocketMQTopicsModel) SetData(topics []service.RocketMQTopic, instanceId
 	return m
 }
 
+// SelectedTopic returns the selected topic
+func (m RocketMQTopicsModel) SelectedTopic() *service.RocketMQTopic {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.topics) {
+		return &m.topics[idx]
+	}
+	return nil
+}
+
 // SetSize sets the size
 func (m RocketMQTopicsModel) SetSize(width, height int) RocketMQTopicsModel {
 	m.width = width
@@ -274,6 +350,30 @@ func (m RocketMQTopicsModel) Init() tea.Cmd {
 
 // Update implements tea.Model
 func (m RocketMQTopicsModel) Update(msg tea.Msg) (RocketMQTopicsModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.Enter):
+			if topic := m.SelectedTopic(); topic != nil && topic.LagErr == nil {
+				data := RocketMQLagData{
+					Title: fmt.Sprintf("Groups subscribed to %s", topic.Topic),
+					Lags:  topic.Subscriptions,
+				}
+				return m, func() tea.Msg {
+					return types.NavigateMsg{Page: types.PageRocketMQLag, Data: data}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Messages):
+			if topic := m.SelectedTopic(); topic != nil {
+				ref := RocketMQTopicRef{InstanceId: m.instanceId, Topic: topic.Topic}
+				return m, func() tea.Msg {
+					return types.NavigateMsg{Page: types.PageRocketMQMessages, Data: ref}
+				}
+			}
+		}
+	}
+
 	var cmd tea.Cmd
 	m.table, cmd = m.table.Update(msg)
 	return m, cmd
@@ -290,6 +390,12 @@ func (m RocketMQTopicsModel) Search(query string) RocketMQTopicsModel {
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m RocketMQTopicsModel) Filter(query string) RocketMQTopicsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m RocketMQTopicsModel) NextSearchMatch() RocketMQTopicsModel {
 	m.table = m.table.NextSearchMatch()
@@ -309,23 +415,45 @@ type RocketMQGroupsModel struct {
 	instanceId string
 	width      int
 	height     int
+	keys       RocketMQGroupsKeyMap
+}
+
+// RocketMQGroupsKeyMap defines key bindings for the groups page
+type RocketMQGroupsKeyMap struct {
+	Enter key.Binding
+}
+
+// DefaultRocketMQGroupsKeyMap returns default key bindings
+func DefaultRocketMQGroupsKeyMap() RocketMQGroupsKeyMap {
+	return RocketMQGroupsKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "lag per topic"),
+		),
+	}
 }
 
 // NewRocketMQGroupsModel creates a new RocketMQ groups model
 func NewRocketMQGroupsModel() RocketMQGroupsModel {
 	columns := []table.Column{
 		{Title: "Group ID", Width: 40},
-		{Title: "Type", Width: 15},
-		{Title: "Status", Width: 12},
-		{Title: "Remark", Width: 40},
+		{Title: "Type", Width: 8},
+		{Title: "Online", Width: 8},
+		{Title: "Lag", Width: 12},
+		{Title: "Delay", Width: 12},
+		{Title: "Consume TPS", Width: 12},
+		{Title: "Last Consumed", Width: 20},
+		{Title: "Remark", Width: 30},
 	}
 
 	return RocketMQGroupsModel{
 		table: components.NewTableModel(columns, "RocketMQ Groups"),
+		keys:  DefaultRocketMQGroupsKeyMap(),
 	}
 }
 
-// SetData sets the groups data
+// SetData sets the groups data. Groups whose accumulation could not be read
+// show "?" as their lag
 func (m RocketMQGroupsModel) SetData(groups []service.RocketMQGroup, instanceId string) RocketMQGroupsModel {
 	m.groups = groups
 	m.instanceId = instanceId
@@ -334,10 +462,30 @@ func (m RocketMQGroupsModel) SetData(groups []service.RocketMQGroup, instanceId
 	rowData := make([]interface{}, len(groups))
 
 	for i, group := range groups {
+		online, lag, delay, tps, lastConsumed := "?", "?", "?", "?", "?"
+		if group.Lag != nil {
+			online = "No"
+			if group.Lag.Online {
+				online = "Yes"
+			}
+			lag = fmt.Sprintf("%d", group.Lag.TotalDiff)
+			delay = formatRocketMQDelay(group.Lag.DelayTime)
+			tps = fmt.Sprintf("%.1f", group.Lag.ConsumeTps)
+			if group.APIVersion == service.RocketMQAPIVersion5 {
+				// The 5.x API reports neither the client connections nor the TPS
+				online, tps = "-", "-"
+			}
+			lastConsumed = formatUnixMillis(group.Lag.LastTimestamp)
+		}
+
 		rows[i] = table.Row{
 			group.GroupId,
 			group.GroupType,
-			"Active",
+			online,
+			lag,
+			delay,
+			tps,
+			lastConsumed,
 			group.Remark,
 		}
 		rowData[i] = group
@@ -349,6 +497,15 @@ func (m RocketMQGroupsModel) SetData(groups []service.RocketMQGroup, instanceId
 	return m
 }
 
+// SelectedGroup returns the selected group
+func (m RocketMQGroupsModel) SelectedGroup() *service.RocketMQGroup {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.groups) {
+		return &m.groups[idx]
+	}
+	return nil
+}
+
 // SetSize sets the size
 func (m RocketMQGroupsModel) SetSize(width, height int) RocketMQGroupsModel {
 	m.width = width
@@ -364,6 +521,18 @@ func (m RocketMQGroupsModel) Init() tea.Cmd {
 
 // Update implements tea.Model
 func (m RocketMQGroupsModel) Update(msg tea.Msg) (RocketMQGroupsModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		if group := m.SelectedGroup(); group != nil && group.Lag != nil {
+			data := RocketMQLagData{
+				Title: fmt.Sprintf("Lag of %s per topic", group.GroupId),
+				Lags:  group.Lag.Topics,
+			}
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageRocketMQLag, Data: data}
+			}
+		}
+	}
+
 	var cmd tea.Cmd
 	m.table, cmd = m.table.Update(msg)
 	return m, cmd
@@ -380,6 +549,12 @@ func (m RocketMQGroupsModel) Search(query string) RocketMQGroupsModel {
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m RocketMQGroupsModel) Filter(query string) RocketMQGroupsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m RocketMQGroupsModel) NextSearchMatch() RocketMQGroupsModel {
 	m.table = m.table.NextSearchMatch()
@@ -392,3 +567,236 @@ func (m RocketMQGroupsModel) PrevSearchMatch() RocketMQGroupsModel {
 	return m
 }
 
+// RocketMQLagData is the accumulation shown by the lag page: the topics of a
+// group or the groups of a topic
+type RocketMQLagData struct {
+	Title string
+	Lags  []service.RocketMQTopicLag
+}
+
+// RocketMQTopicRef identifies a topic whose messages to browse
+type RocketMQTopicRef struct {
+	InstanceId string
+	Topic      string
+}
+
+// RocketMQLagModel represents the page listing the accumulation of consumer
+// groups per topic
+type RocketMQLagModel struct {
+	table  components.TableModel
+	width  int
+	height int
+}
+
+// NewRocketMQLagModel creates a new RocketMQ lag model
+func NewRocketMQLagModel() RocketMQLagModel {
+	columns := []components.ColumnSpec{
+		{Title: "Topic", Width: 40},
+		{Title: "Group ID", Width: 40},
+		{Title: "Lag", Width: 12},
+		{Title: "Delay", Width: 12, Format: components.FormatDuration},
+		{Title: "Last Consumed", Width: 20},
+	}
+
+	return RocketMQLagModel{
+		table: components.NewTableModelFromSpecs(columns, "RocketMQ Consumer Lag"),
+	}
+}
+
+// SetData sets the accumulation data
+func (m RocketMQLagModel) SetData(data RocketMQLagData) RocketMQLagModel {
+	values := make([][]interface{}, len(data.Lags))
+	rowData := make([]interface{}, len(data.Lags))
+
+	for i, lag := range data.Lags {
+		values[i] = []interface{}{
+			lag.Topic,
+			lag.GroupId,
+			lag.TotalDiff,
+			lag.DelayTime,
+			formatUnixMillis(lag.LastTimestamp),
+		}
+		rowData[i] = lag
+	}
+
+	m.table = m.table.SetValues(values)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(data.Title)
+	return m
+}
+
+// SetSize sets the size
+func (m RocketMQLagModel) SetSize(width, height int) RocketMQLagModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m RocketMQLagModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m RocketMQLagModel) Update(msg tea.Msg) (RocketMQLagModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m RocketMQLagModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m RocketMQLagModel) Search(query string) RocketMQLagModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m RocketMQLagModel) Filter(query string) RocketMQLagModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m RocketMQLagModel) NextSearchMatch() RocketMQLagModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m RocketMQLagModel) PrevSearchMatch() RocketMQLagModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// RocketMQMessagesModel represents the page browsing the messages of a topic
+type RocketMQMessagesModel struct {
+	table    components.TableModel
+	messages []service.RocketMQMessage
+	width    int
+	height   int
+	keys     RocketMQMessagesKeyMap
+}
+
+// RocketMQMessagesKeyMap defines key bindings for the messages page
+type RocketMQMessagesKeyMap struct {
+	Enter key.Binding
+}
+
+// DefaultRocketMQMessagesKeyMap returns default key bindings
+func DefaultRocketMQMessagesKeyMap() RocketMQMessagesKeyMap {
+	return RocketMQMessagesKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "details"),
+		),
+	}
+}
+
+// NewRocketMQMessagesModel creates a new RocketMQ messages model
+func NewRocketMQMessagesModel() RocketMQMessagesModel {
+	columns := []table.Column{
+		{Title: "Message ID", Width: 34},
+		{Title: "Tag", Width: 16},
+		{Title: "Keys", Width: 24},
+		{Title: "Stored", Width: 20},
+		{Title: "Born Host", Width: 22},
+		{Title: "Size", Width: 8},
+		{Title: "Reconsumed", Width: 10},
+	}
+
+	return RocketMQMessagesModel{
+		table: components.NewTableModel(columns, "RocketMQ Messages"),
+		keys:  DefaultRocketMQMessagesKeyMap(),
+	}
+}
+
+// SetData sets the messages data
+func (m RocketMQMessagesModel) SetData(messages []service.RocketMQMessage, topic string) RocketMQMessagesModel {
+	m.messages = messages
+
+	rows := make([]table.Row, len(messages))
+	rowData := make([]interface{}, len(messages))
+
+	for i, msg := range messages {
+		rows[i] = table.Row{
+			msg.MsgId,
+			valueOrDash(msg.Tag),
+			valueOrDash(msg.Keys),
+			formatUnixMillis(msg.StoreTimestamp),
+			valueOrDash(msg.BornHost),
+			fmt.Sprintf("%d", msg.StoreSize),
+			fmt.Sprintf("%d", msg.ReconsumeTimes),
+		}
+		rowData[i] = msg
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf("Messages of %s (last hour)", topic))
+	return m
+}
+
+// SetSize sets the size
+func (m RocketMQMessagesModel) SetSize(width, height int) RocketMQMessagesModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m RocketMQMessagesModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m RocketMQMessagesModel) Update(msg tea.Msg) (RocketMQMessagesModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		idx := m.table.SelectedRow()
+		if idx >= 0 && idx < len(m.messages) {
+			message := m.messages[idx]
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageRocketMQJSONDetail, Data: message}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m RocketMQMessagesModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m RocketMQMessagesModel) Search(query string) RocketMQMessagesModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m RocketMQMessagesModel) Filter(query string) RocketMQMessagesModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m RocketMQMessagesModel) NextSearchMatch() RocketMQMessagesModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m RocketMQMessagesModel) PrevSearchMatch() RocketMQMessagesModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
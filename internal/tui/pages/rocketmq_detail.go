@@ -0,0 +1,200 @@
+package pages
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// RocketMQDetailModel is the formatted detail page of a RocketMQ instance. The
+// endpoints and edition limits come from the instance base info, which loads
+// after the page opens. RocketMQ 4.x instances have no maintenance window or
+// IP whitelist, so only their endpoints and limits are shown besides the list
+// entry
+type RocketMQDetailModel struct {
+	instance service.RocketMQInstance
+	info     *service.RocketMQInstanceInfo
+	infoErr  error
+	view     SectionDetailModel
+	keys     RocketMQDetailKeyMap
+}
+
+// RocketMQDetailKeyMap defines the RocketMQ detail keys besides the navigation
+type RocketMQDetailKeyMap struct {
+	JSON   key.Binding
+	Topics key.Binding
+	Groups key.Binding
+}
+
+// DefaultRocketMQDetailKeyMap returns default key bindings
+func DefaultRocketMQDetailKeyMap() RocketMQDetailKeyMap {
+	listKeys := DefaultRocketMQListKeyMap()
+	return RocketMQDetailKeyMap{
+		JSON: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "JSON"),
+		),
+		Topics: listKeys.Topics,
+		Groups: listKeys.Groups,
+	}
+}
+
+// NewRocketMQDetailModel creates a new RocketMQ detail model
+func NewRocketMQDetailModel(instance service.RocketMQInstance) RocketMQDetailModel {
+	m := RocketMQDetailModel{
+		instance: instance,
+		view:     NewSectionDetailModel(),
+		keys:     DefaultRocketMQDetailKeyMap(),
+	}
+	return m.rebuild()
+}
+
+// NewRocketMQDetailModelFromInterface creates a new RocketMQ detail model from
+// interface{}, and false when data is not a RocketMQ instance
+func NewRocketMQDetailModelFromInterface(data interface{}) (RocketMQDetailModel, bool) {
+	if instance, ok := data.(service.RocketMQInstance); ok {
+		return NewRocketMQDetailModel(instance), true
+	}
+	return RocketMQDetailModel{}, false
+}
+
+// InstanceID returns the ID of the shown instance
+func (m RocketMQDetailModel) InstanceID() string {
+	return m.instance.InstanceId
+}
+
+// SetInfo sets the instance base info, or the error that prevented loading it
+func (m RocketMQDetailModel) SetInfo(info *service.RocketMQInstanceInfo, err error) RocketMQDetailModel {
+	m.info = info
+	m.infoErr = err
+	return m.rebuild()
+}
+
+// rebuild renders the sections from the current data
+func (m RocketMQDetailModel) rebuild() RocketMQDetailModel {
+	inst := m.instance
+
+	basicInfo := DetailSection{
+		Title: i18n.T(i18n.KeySectionBasicInfo),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelInstanceID), Value: inst.InstanceId},
+			{Label: i18n.T(i18n.KeyLabelInstanceName), Value: valueOrDash(inst.InstanceName)},
+			{Label: i18n.T(i18n.KeyLabelInstanceStatus), Value: rocketMQInstanceStatus(inst.InstanceStatus), Status: true},
+			{Label: i18n.T(i18n.KeyLabelEdition), Value: rocketMQEdition(inst)},
+			{Label: i18n.T(i18n.KeyLabelAPIVersion), Value: valueOrDash(inst.APIVersion)},
+			{Label: i18n.T(i18n.KeyColRegion), Value: valueOrDash(inst.RegionId)},
+			{Label: i18n.T(i18n.KeyColCreatedAt), Value: formatUnixMillis(inst.CreateTime)},
+			{Label: i18n.T(i18n.KeyColRemark), Value: valueOrDash(inst.Remark)},
+		},
+	}
+
+	endpoints := DetailSection{Title: i18n.T(i18n.KeySectionEndpoints)}
+	spec := DetailSection{Title: i18n.T(i18n.KeySectionSpec)}
+	switch {
+	case m.infoErr != nil:
+		row := DetailRow{Label: endpoints.Title, Value: fmt.Sprintf("%s: %v", i18n.T(i18n.KeyDetailUnavailable), m.infoErr)}
+		endpoints.Rows = []DetailRow{row}
+		spec.Rows = []DetailRow{{Label: spec.Title, Value: row.Value}}
+	case m.info == nil:
+		endpoints.Rows = []DetailRow{{Label: endpoints.Title, Value: i18n.T(i18n.KeyActionLoading)}}
+		spec.Rows = []DetailRow{{Label: spec.Title, Value: i18n.T(i18n.KeyActionLoading)}}
+	default:
+		info := m.info
+		for _, endpoint := range []struct{ label, value string }{
+			{"TCP", info.TcpEndpoint},
+			{"TCP (Internet)", info.TcpInternetEndpoint},
+			{"HTTP", info.HttpInternalEndpoint},
+			{"HTTP (Internet)", info.HttpInternetEndpoint},
+			{"HTTPS (Internet)", info.HttpInternetSecureEndpoint},
+		} {
+			if endpoint.value != "" {
+				endpoints.Rows = append(endpoints.Rows, DetailRow{Label: endpoint.label, Value: endpoint.value})
+			}
+		}
+		if len(endpoints.Rows) == 0 {
+			endpoints.Rows = []DetailRow{{Label: endpoints.Title, Value: "-"}}
+		}
+		spec.Rows = []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelMaxTPS), Value: fmt.Sprintf("%d", info.MaxTps)},
+			{Label: i18n.T(i18n.KeyLabelTopicCapacity), Value: fmt.Sprintf("%d", info.TopicCapacity)},
+			{Label: i18n.T(i18n.KeyLabelIndependentNaming), Value: fmt.Sprintf("%t", info.IndependentNaming)},
+		}
+	}
+
+	releaseTime := inst.ReleaseTime
+	if m.info != nil && m.info.ReleaseTime != 0 {
+		releaseTime = m.info.ReleaseTime
+	}
+	billing := DetailSection{
+		Title: i18n.T(i18n.KeySectionBilling),
+		Rows: []DetailRow{
+			// Only set for expired subscription instances awaiting release
+			{Label: i18n.T(i18n.KeyLabelReleaseTime), Value: formatUnixMillis(releaseTime)},
+		},
+	}
+
+	m.view = m.view.SetSections([]DetailSection{basicInfo, endpoints, spec, billing})
+	return m
+}
+
+// SetSize sets the size of the detail view
+func (m RocketMQDetailModel) SetSize(width, height int) RocketMQDetailModel {
+	m.view = m.view.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m RocketMQDetailModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m RocketMQDetailModel) Update(msg tea.Msg) (RocketMQDetailModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		var nav *types.NavigateMsg
+		switch {
+		case key.Matches(msg, m.keys.JSON):
+			var data interface{} = m.instance
+			if m.info != nil {
+				data = m.info
+			}
+			nav = &types.NavigateMsg{Page: types.PageRocketMQJSONDetail, Data: data}
+		case key.Matches(msg, m.keys.Topics):
+			nav = &types.NavigateMsg{Page: types.PageRocketMQTopics, Data: m.instance.InstanceId}
+		case key.Matches(msg, m.keys.Groups):
+			nav = &types.NavigateMsg{Page: types.PageRocketMQGroups, Data: m.instance.InstanceId}
+		}
+		if nav != nil {
+			return m, func() tea.Msg { return *nav }
+		}
+	}
+
+	var cmd tea.Cmd
+	m.view, cmd = m.view.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m RocketMQDetailModel) View() string {
+	return m.view.View()
+}
+
+// Search placeholder for interface compatibility
+func (m RocketMQDetailModel) Search(query string) RocketMQDetailModel {
+	return m
+}
+
+// NextSearchMatch placeholder
+func (m RocketMQDetailModel) NextSearchMatch() RocketMQDetailModel {
+	return m
+}
+
+// PrevSearchMatch placeholder
+func (m RocketMQDetailModel) PrevSearchMatch() RocketMQDetailModel {
+	return m
+}
@@ -0,0 +1,327 @@
+package pages
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/theme"
+	"aliyun-tui-viewer/internal/tui/components"
+)
+
+// SectionDetailModel is a formatted detail view of labelled rows grouped in
+// sections, navigated row by row like the ECS detail. Resource detail pages
+// build the sections, handle their own keys and delegate the rest to it
+type SectionDetailModel struct {
+	sections       []DetailSection
+	viewport       viewport.Model
+	width          int
+	height         int
+	keys           SectionDetailKeyMap
+	currentSection int
+	currentRow     int
+	zoomed         bool            // Only the focused section is rendered
+	anchors        []sectionAnchor // Title lines of rendered sections, for the sticky header
+	yankLastTime   time.Time
+	yankCount      int
+}
+
+// SectionDetailKeyMap defines the navigation keys of a sectioned detail view
+type SectionDetailKeyMap struct {
+	Up          key.Binding
+	Down        key.Binding
+	NextSection key.Binding
+	PrevSection key.Binding
+	PageUp      key.Binding
+	PageDown    key.Binding
+	Top         key.Binding
+	Bottom      key.Binding
+	Yank        key.Binding
+	Zoom        key.Binding
+}
+
+// DefaultSectionDetailKeyMap returns default key bindings, the same as the
+// ECS detail navigation
+func DefaultSectionDetailKeyMap() SectionDetailKeyMap {
+	ecs := DefaultECSDetailKeyMap()
+	return SectionDetailKeyMap{
+		Up:          ecs.Up,
+		Down:        ecs.Down,
+		NextSection: ecs.NextSection,
+		PrevSection: ecs.PrevSection,
+		PageUp:      ecs.PageUp,
+		PageDown:    ecs.PageDown,
+		Top:         ecs.Top,
+		Bottom:      ecs.Bottom,
+		Yank:        ecs.Yank,
+		Zoom:        ecs.Zoom,
+	}
+}
+
+// NewSectionDetailModel creates an empty sectioned detail view
+func NewSectionDetailModel() SectionDetailModel {
+	return SectionDetailModel{
+		keys:     DefaultSectionDetailKeyMap(),
+		viewport: viewport.New(80, 20), // Initial size, will be updated by SetSize
+	}
+}
+
+// SetSections replaces the sections, keeping the focused row when it still
+// exists, e.g. when rows are filled in by data loaded later
+func (m SectionDetailModel) SetSections(sections []DetailSection) SectionDetailModel {
+	m.sections = sections
+	if m.currentSection >= len(m.sections) {
+		m.currentSection, m.currentRow = 0, 0
+	} else if m.currentRow >= len(m.sections[m.currentSection].Rows) {
+		m.currentRow = 0
+	}
+	m.updateViewportContent()
+	return m
+}
+
+// SetSize sets the size of the detail view
+func (m SectionDetailModel) SetSize(width, height int) SectionDetailModel {
+	m.width = width
+	m.height = height
+	m.viewport.Width = width
+	m.viewport.Height = height
+	m.updateViewportContent()
+	return m
+}
+
+// updateViewportContent renders all sections and sets the content to viewport
+func (m *SectionDetailModel) updateViewportContent() {
+	if len(m.sections) == 0 {
+		return
+	}
+
+	var sections []string
+	m.anchors = nil
+	line := 0
+	for i, section := range m.sections {
+		if m.zoomed && i != m.currentSection {
+			continue
+		}
+		rendered := m.renderSection(section, i, i == m.currentSection)
+		sections = append(sections, rendered)
+		m.anchors = append(m.anchors, sectionAnchor{line: line, title: section.Title})
+		line += lipgloss.Height(rendered)
+	}
+
+	m.viewport.SetContent(lipgloss.JoinVertical(lipgloss.Left, sections...))
+}
+
+// ensureSelectedVisible adjusts viewport scroll to keep selected row visible
+func (m *SectionDetailModel) ensureSelectedVisible() {
+	// Title, margin, border and padding above the rows, and padding, border
+	// and margin below them
+	linePos := 0
+	for i := 0; i < m.currentSection && !m.zoomed; i++ {
+		linePos += 4 + len(m.sections[i].Rows) + 3
+	}
+	linePos += 4 + m.currentRow
+
+	viewportTop := m.viewport.YOffset
+	viewportBottom := viewportTop + m.viewport.Height - 1
+
+	// Scroll if needed, leaving the first line for the sticky section title
+	if linePos-1 < viewportTop {
+		m.viewport.SetYOffset(linePos - 1)
+	} else if linePos > viewportBottom {
+		m.viewport.SetYOffset(linePos - m.viewport.Height + 1)
+	}
+}
+
+// sectionRowAt returns the section and row at a line of sections rendered as
+// titled boxes, found from the anchors of their titles; when zoomed, the
+// current section is the only one. The rows start below the title with its
+// margin and the border with its padding, and are taken to be a line each
+func sectionRowAt(sections []DetailSection, anchors []sectionAnchor, current int, zoomed bool, line int) (int, int, bool) {
+	for i, anchor := range anchors {
+		section := i
+		if zoomed {
+			section = current
+		}
+		if section >= len(sections) {
+			break
+		}
+		if row := line - anchor.line - 4; row >= 0 && row < len(sections[section].Rows) {
+			return section, row, true
+		}
+	}
+	return 0, 0, false
+}
+
+// Update handles the navigation keys and clicks on rows
+func (m SectionDetailModel) Update(msg tea.Msg) (SectionDetailModel, tea.Cmd) {
+	var cmd tea.Cmd
+	if mouse, ok := msg.(tea.MouseMsg); ok && components.IsClick(mouse) {
+		// A click focuses the row under it
+		if section, row, ok := sectionRowAt(m.sections, m.anchors, m.currentSection, m.zoomed, m.viewport.YOffset+mouse.Y); ok {
+			m.currentSection, m.currentRow = section, row
+			m.updateViewportContent()
+		}
+		return m, nil
+	}
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || len(m.sections) == 0 {
+		m.viewport, cmd = m.viewport.Update(msg)
+		return m, cmd
+	}
+
+	switch {
+	case key.Matches(keyMsg, m.keys.Down):
+		section := m.sections[m.currentSection]
+		if m.currentRow < len(section.Rows)-1 {
+			m.currentRow++
+		} else if m.currentSection < len(m.sections)-1 {
+			m.currentSection++
+			m.currentRow = 0
+		}
+	case key.Matches(keyMsg, m.keys.Up):
+		if m.currentRow > 0 {
+			m.currentRow--
+		} else if m.currentSection > 0 {
+			m.currentSection--
+			m.currentRow = len(m.sections[m.currentSection].Rows) - 1
+		}
+	case key.Matches(keyMsg, m.keys.NextSection):
+		if m.currentSection < len(m.sections)-1 {
+			m.currentSection++
+			m.currentRow = 0
+		}
+	case key.Matches(keyMsg, m.keys.PrevSection):
+		if m.currentSection > 0 {
+			m.currentSection--
+			m.currentRow = 0
+		}
+	case key.Matches(keyMsg, m.keys.Zoom):
+		m.zoomed = !m.zoomed
+		m.viewport.GotoTop()
+	case key.Matches(keyMsg, m.keys.Top):
+		m.currentSection, m.currentRow = 0, 0
+		m.viewport.GotoTop()
+	case key.Matches(keyMsg, m.keys.Bottom):
+		m.currentSection = len(m.sections) - 1
+		m.currentRow = len(m.sections[m.currentSection].Rows) - 1
+		m.viewport.GotoBottom()
+	case key.Matches(keyMsg, m.keys.Yank):
+		// Handle double-y for yank
+		now := time.Now()
+		if now.Sub(m.yankLastTime) < 500*time.Millisecond {
+			m.yankCount++
+		} else {
+			m.yankCount = 1
+		}
+		m.yankLastTime = now
+
+		if m.yankCount >= 2 {
+			m.yankCount = 0
+			if rows := m.sections[m.currentSection].Rows; m.currentRow < len(rows) {
+				// Copied as-is, so endpoints and IDs paste without JSON quotes
+				value := components.TextContent(rows[m.currentRow].Value)
+				return m, func() tea.Msg {
+					return components.CopyDataMsg{Data: value}
+				}
+			}
+		}
+		return m, nil
+	default:
+		// Page keys and anything else scroll the viewport
+		m.viewport, cmd = m.viewport.Update(msg)
+		return m, cmd
+	}
+
+	m.updateViewportContent()
+	m.ensureSelectedVisible()
+	return m, nil
+}
+
+// View renders the sections with the title of the section scrolled into
+// pinned to the first line
+func (m SectionDetailModel) View() string {
+	if len(m.sections) == 0 {
+		return i18n.T(i18n.KeyActionLoading)
+	}
+	stickyStyle := lipgloss.NewStyle().Bold(true).Foreground(theme.Current().Primary)
+	return pinSectionTitle(m.viewport.View(), m.viewport.YOffset, m.anchors, stickyStyle)
+}
+
+// renderSection renders a section as a titled box, highlighted when focused
+func (m SectionDetailModel) renderSection(section DetailSection, sectionIdx int, isFocused bool) string {
+	th := theme.Current()
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(th.SubtleText).MarginBottom(1)
+	borderFg := th.Border
+	if isFocused {
+		titleStyle = titleStyle.Foreground(th.Primary)
+		borderFg = th.Primary
+	}
+
+	innerWidth := m.width - 8
+	if innerWidth < 40 {
+		innerWidth = 40
+	}
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderFg).
+		Padding(1, 2).
+		MarginBottom(1).
+		Width(innerWidth)
+
+	rows := make([]string, len(section.Rows))
+	for i, row := range section.Rows {
+		rows[i] = m.renderRow(row, isFocused && i == m.currentRow)
+	}
+
+	title := section.Title
+	if m.zoomed {
+		title += lipgloss.NewStyle().Foreground(th.MutedText).Render(fmt.Sprintf("  [%d/%d] %s", sectionIdx+1, len(m.sections), i18n.T(i18n.KeySectionZoomHint)))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, titleStyle.Render(title), boxStyle.Render(lipgloss.JoinVertical(lipgloss.Left, rows...)), "")
+}
+
+// renderRow renders a label and value, with a colored indicator for status
+// rows
+func (m SectionDetailModel) renderRow(row DetailRow, isSelected bool) string {
+	th := theme.Current()
+	if isSelected {
+		rowWidth := m.width - 12
+		if rowWidth < 40 {
+			rowWidth = 40
+		}
+		selectedStyle := lipgloss.NewStyle().Foreground(th.OnPrimary).Background(th.Primary).Bold(true)
+		value := row.Value
+		if row.Status {
+			value = "● " + value
+		}
+		content := lipgloss.JoinHorizontal(lipgloss.Top, selectedStyle.Width(18).Render(row.Label), selectedStyle.Render(value))
+		return lipgloss.NewStyle().Background(th.Primary).Width(rowWidth).Render(content)
+	}
+
+	value := lipgloss.NewStyle().Foreground(th.Text).Render(row.Value)
+	if row.Status {
+		value = lipgloss.NewStyle().Foreground(statusColor(row.Value)).Bold(true).Render("● " + row.Value)
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, lipgloss.NewStyle().Foreground(th.SubtleText).Width(18).Render(row.Label), value)
+}
+
+// statusColor returns the indicator color of a resource status: green for
+// running states, red for stopped or locked ones and amber for the rest,
+// which are usually transitions
+func statusColor(status string) lipgloss.Color {
+	th := theme.Current()
+	switch strings.ToLower(status) {
+	case "running", "active", "normal", "online", "available":
+		return th.Success
+	case "stopped", "inactive", "locked", "expired", "deleted", "offline":
+		return th.Error
+	default:
+		return th.Warning
+	}
+}
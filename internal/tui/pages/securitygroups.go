@@ -9,6 +9,7 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 
+	"aliyun-tui-viewer/internal/service"
 	"aliyun-tui-viewer/internal/tui/components"
 	"aliyun-tui-viewer/internal/tui/types"
 )
@@ -17,6 +18,7 @@ import (
 type SecurityGroupsModel struct {
 	table          components.TableModel
 	securityGroups []ecs.SecurityGroup
+	names          *service.NameResolver
 	title          string
 	width          int
 	height         int
@@ -49,7 +51,7 @@ func NewSecurityGroupsModel() SecurityGroupsModel {
 		{Title: "Security Group ID", Width: 25},
 		{Title: "Name", Width: 25},
 		{Title: "Description", Width: 30},
-		{Title: "VPC ID", Width: 25},
+		{Title: "VPC", Width: 25},
 		{Title: "Type", Width: 12},
 		{Title: "Created", Width: 20},
 	}
@@ -73,9 +75,9 @@ func (m SecurityGroupsModel) SetData(groups []ecs.SecurityGroup) SecurityGroupsM
 			sg.SecurityGroupId,
 			sg.SecurityGroupName,
 			sg.Description,
-			sg.VpcId,
+			m.names.Label(service.KindVPC, sg.VpcId),
 			sg.SecurityGroupType,
-			sg.CreationTime,
+			components.FormatTimeString(sg.CreationTime),
 		}
 		rowData[i] = sg
 	}
@@ -85,6 +87,24 @@ func (m SecurityGroupsModel) SetData(groups []ecs.SecurityGroup) SecurityGroupsM
 	return m
 }
 
+// SetNames sets the resolver used to show VPC names, re-rendering the rows
+func (m SecurityGroupsModel) SetNames(names *service.NameResolver) SecurityGroupsModel {
+	m.names = names
+	cursor := m.table.SelectedRow()
+	m = m.SetData(m.securityGroups)
+	m.table = m.table.SetCursor(cursor)
+	return m
+}
+
+// NameRefs returns the IDs referenced by the rows
+func (m SecurityGroupsModel) NameRefs() service.NameRefs {
+	refs := make(service.NameRefs)
+	for _, sg := range m.securityGroups {
+		refs.Add(service.KindVPC, sg.VpcId)
+	}
+	return refs
+}
+
 // SetSize sets the size
 func (m SecurityGroupsModel) SetSize(width, height int) SecurityGroupsModel {
 	m.width = width
@@ -151,12 +171,23 @@ func (m SecurityGroupsModel) View() string {
 	return m.table.View()
 }
 
+// Table returns the list's table, used to render it outside the TUI
+func (m SecurityGroupsModel) Table() components.TableModel {
+	return m.table
+}
+
 // Search searches in the list
 func (m SecurityGroupsModel) Search(query string) SecurityGroupsModel {
 	m.table = m.table.Search(query)
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m SecurityGroupsModel) Filter(query string) SecurityGroupsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m SecurityGroupsModel) NextSearchMatch() SecurityGroupsModel {
 	m.table = m.table.NextSearchMatch()
@@ -173,8 +204,53 @@ func (m SecurityGroupsModel) PrevSearchMatch() SecurityGroupsModel {
 type SecurityGroupRulesModel struct {
 	table           components.TableModel
 	securityGroupId string
+	response        *ecs.DescribeSecurityGroupAttributeResponse
+	names           *service.NameResolver
 	width           int
 	height          int
+	keys            SecurityGroupRulesKeyMap
+}
+
+// SecurityGroupRulesKeyMap defines key bindings for rule management
+type SecurityGroupRulesKeyMap struct {
+	Add    key.Binding
+	Edit   key.Binding
+	Delete key.Binding
+}
+
+// DefaultSecurityGroupRulesKeyMap returns default key bindings
+func DefaultSecurityGroupRulesKeyMap() SecurityGroupRulesKeyMap {
+	return SecurityGroupRulesKeyMap{
+		Add: key.NewBinding(
+			key.WithKeys("a"),
+			key.WithHelp("a", "add rule"),
+		),
+		Edit: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "edit rule"),
+		),
+		Delete: key.NewBinding(
+			key.WithKeys("d"),
+			key.WithHelp("d", "revoke rule"),
+		),
+	}
+}
+
+// SecurityGroupRuleAction identifies a write action on a security group rule
+type SecurityGroupRuleAction int
+
+const (
+	SecurityGroupRuleAdd SecurityGroupRuleAction = iota
+	SecurityGroupRuleEdit
+	SecurityGroupRuleRevoke
+)
+
+// SecurityGroupRuleActionMsg asks the app to start a write flow for a rule.
+// Rule is nil for SecurityGroupRuleAdd
+type SecurityGroupRuleActionMsg struct {
+	Action          SecurityGroupRuleAction
+	SecurityGroupId string
+	Rule            *ecs.Permission
 }
 
 // NewSecurityGroupRulesModel creates a new security group rules model
@@ -194,12 +270,14 @@ func NewSecurityGroupRulesModel(securityGroupId string) SecurityGroupRulesModel
 	return SecurityGroupRulesModel{
 		table:           components.NewTableModel(columns, title),
 		securityGroupId: securityGroupId,
+		keys:            DefaultSecurityGroupRulesKeyMap(),
 	}
 }
 
 // SetData sets the security group rules data
 func (m SecurityGroupRulesModel) SetData(response *ecs.DescribeSecurityGroupAttributeResponse) SecurityGroupRulesModel {
 	m.securityGroupId = response.SecurityGroupId
+	m.response = response
 
 	var rows []table.Row
 	var rowData []interface{}
@@ -208,7 +286,7 @@ func (m SecurityGroupRulesModel) SetData(response *ecs.DescribeSecurityGroupAttr
 		// Determine source/dest
 		sourceDest := rule.SourceCidrIp
 		if sourceDest == "" {
-			sourceDest = rule.SourceGroupId
+			sourceDest = m.names.Label(service.KindSecurityGroup, rule.SourceGroupId)
 		}
 
 		direction := "Ingress"
@@ -217,7 +295,7 @@ func (m SecurityGroupRulesModel) SetData(response *ecs.DescribeSecurityGroupAttr
 			if rule.DestCidrIp != "" {
 				sourceDest = rule.DestCidrIp
 			} else if rule.DestGroupId != "" {
-				sourceDest = rule.DestGroupId
+				sourceDest = m.names.Label(service.KindSecurityGroup, rule.DestGroupId)
 			}
 		}
 
@@ -236,9 +314,39 @@ func (m SecurityGroupRulesModel) SetData(response *ecs.DescribeSecurityGroupAttr
 	m.table = m.table.SetRows(rows)
 	m.table = m.table.SetRowData(rowData)
 	m.table = m.table.SetTitle(fmt.Sprintf("Security Group Rules: %s", m.securityGroupId))
+
+	// Searching for an IP or a port also finds the rules covering it
+	rules := response.Permissions.Permission
+	m.table = m.table.SetSearchMatcher(func(index int, query string) bool {
+		return index >= 0 && index < len(rules) && service.SecurityGroupRuleMatches(rules[index], query)
+	})
+	return m
+}
+
+// SetNames sets the resolver used to show referenced group names, re-rendering the rows
+func (m SecurityGroupRulesModel) SetNames(names *service.NameResolver) SecurityGroupRulesModel {
+	m.names = names
+	if m.response == nil {
+		return m
+	}
+	cursor := m.table.SelectedRow()
+	m = m.SetData(m.response)
+	m.table = m.table.SetCursor(cursor)
 	return m
 }
 
+// NameRefs returns the security groups referenced by the rules
+func (m SecurityGroupRulesModel) NameRefs() service.NameRefs {
+	refs := make(service.NameRefs)
+	if m.response == nil {
+		return refs
+	}
+	for _, rule := range m.response.Permissions.Permission {
+		refs.Add(service.KindSecurityGroup, rule.SourceGroupId, rule.DestGroupId)
+	}
+	return refs
+}
+
 // SetSize sets the size
 func (m SecurityGroupRulesModel) SetSize(width, height int) SecurityGroupRulesModel {
 	m.width = width
@@ -247,6 +355,24 @@ func (m SecurityGroupRulesModel) SetSize(width, height int) SecurityGroupRulesMo
 	return m
 }
 
+// SecurityGroupId returns the security group whose rules are shown
+func (m SecurityGroupRulesModel) SecurityGroupId() string {
+	return m.securityGroupId
+}
+
+// SelectedRule returns the selected rule
+func (m SecurityGroupRulesModel) SelectedRule() *ecs.Permission {
+	if m.response == nil {
+		return nil
+	}
+	idx := m.table.SelectedRow()
+	if rules := m.response.Permissions.Permission; idx >= 0 && idx < len(rules) {
+		rule := rules[idx]
+		return &rule
+	}
+	return nil
+}
+
 // Init implements tea.Model
 func (m SecurityGroupRulesModel) Init() tea.Cmd {
 	return nil
@@ -254,6 +380,30 @@ func (m SecurityGroupRulesModel) Init() tea.Cmd {
 
 // Update implements tea.Model
 func (m SecurityGroupRulesModel) Update(msg tea.Msg) (SecurityGroupRulesModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && m.response != nil {
+		action := SecurityGroupRuleAction(-1)
+		switch {
+		case key.Matches(msg, m.keys.Add):
+			action = SecurityGroupRuleAdd
+		case key.Matches(msg, m.keys.Edit):
+			action = SecurityGroupRuleEdit
+		case key.Matches(msg, m.keys.Delete):
+			action = SecurityGroupRuleRevoke
+		}
+		if action >= 0 {
+			var rule *ecs.Permission
+			if action != SecurityGroupRuleAdd {
+				if rule = m.SelectedRule(); rule == nil {
+					return m, nil
+				}
+			}
+			securityGroupId := m.securityGroupId
+			return m, func() tea.Msg {
+				return SecurityGroupRuleActionMsg{Action: action, SecurityGroupId: securityGroupId, Rule: rule}
+			}
+		}
+	}
+
 	var cmd tea.Cmd
 	m.table, cmd = m.table.Update(msg)
 	return m, cmd
@@ -270,6 +420,12 @@ func (m SecurityGroupRulesModel) Search(query string) SecurityGroupRulesModel {
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m SecurityGroupRulesModel) Filter(query string) SecurityGroupRulesModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m SecurityGroupRulesModel) NextSearchMatch() SecurityGroupRulesModel {
 	m.table = m.table.NextSearchMatch()
@@ -22,6 +22,8 @@ type SLBListModel struct {
 	width         int
 	height        int
 	keys          SLBListKeyMap
+	showRegion    bool       // Region column, when listing all regions
+	tagFilter     *TagFilter // Set by t, nil to show every resource
 }
 
 // SLBListKeyMap defines key bindings
@@ -30,6 +32,8 @@ type SLBListKeyMap struct {
 	Listeners      key.Binding
 	VServerGroups  key.Binding
 	DefaultServers key.Binding
+	TagFilter      key.Binding
+	Probe          key.Binding
 }
 
 // DefaultSLBListKeyMap returns default key bindings
@@ -51,17 +55,19 @@ func DefaultSLBListKeyMap() SLBListKeyMap {
 			key.WithKeys("s"),
 			key.WithHelp("s", "default servers"),
 		),
+		TagFilter: tagFilterBinding(),
+		Probe:     probeBinding(),
 	}
 }
 
 // NewSLBListModel creates a new SLB list model
 func NewSLBListModel() SLBListModel {
 	columns := []table.Column{
-		{Title: "SLB ID", Width: 25},
-		{Title: "Name", Width: 30},
-		{Title: "IP Address", Width: 20},
-		{Title: "Type", Width: 15},
-		{Title: "Status", Width: 12},
+		{Title: i18n.T(i18n.KeyColSLBID), Width: 25},
+		{Title: i18n.T(i18n.KeyColName), Width: 30},
+		{Title: i18n.T(i18n.KeyColAddress), Width: 20},
+		{Title: i18n.T(i18n.KeyColAddressType), Width: 15},
+		{Title: i18n.T(i18n.KeyColStatus), Width: 12},
 	}
 
 	return SLBListModel{
@@ -85,11 +91,23 @@ func (m SLBListModel) SetData(lbs []slb.LoadBalancer) SLBListModel {
 			lb.LoadBalancerSpec,
 			lb.LoadBalancerStatus,
 		}
+		if m.showRegion {
+			rows[i] = append(rows[i], lb.RegionId)
+		}
 		rowData[i] = lb
 	}
 
 	m.table = m.table.SetRows(rows)
 	m.table = m.table.SetRowData(rowData)
+	return m.applyTagFilter()
+}
+
+// SetShowRegion adds a Region column, used when listing all regions
+func (m SLBListModel) SetShowRegion(show bool) SLBListModel {
+	if show && !m.showRegion {
+		m.table = m.table.SetColumns(append(m.table.Columns(), regionColumn()))
+	}
+	m.showRegion = show
 	return m
 }
 
@@ -110,6 +128,25 @@ func (m SLBListModel) SelectedLoadBalancer() *slb.LoadBalancer {
 	return nil
 }
 
+// SetTagFilter shows only the resources carrying the filter's tag, or every
+// resource again when f is nil
+func (m SLBListModel) SetTagFilter(f *TagFilter) SLBListModel {
+	m.tagFilter = f
+	return m.applyTagFilter()
+}
+
+// TagFilter returns the active tag filter, or nil
+func (m SLBListModel) TagFilter() *TagFilter {
+	return m.tagFilter
+}
+
+// applyTagFilter applies the tag filter to the current rows
+func (m SLBListModel) applyTagFilter() SLBListModel {
+	loadBalancers := m.loadBalancers
+	m.table = applyTagFilter(m.table, m.tagFilter, func(i int) string { return loadBalancers[i].LoadBalancerId })
+	return m
+}
+
 // Init implements tea.Model
 func (m SLBListModel) Init() tea.Cmd {
 	return nil
@@ -159,6 +196,14 @@ func (m SLBListModel) Update(msg tea.Msg) (SLBListModel, tea.Cmd) {
 					}
 				}
 			}
+
+		case key.Matches(msg, m.keys.TagFilter):
+			return m, requestTagFilter(m.tagFilter)
+
+		case key.Matches(msg, m.keys.Probe):
+			if lb := m.SelectedLoadBalancer(); lb != nil {
+				return m, requestProbe(lb.LoadBalancerId, lb.Address, 0)
+			}
 		}
 	}
 
@@ -172,12 +217,23 @@ func (m SLBListModel) View() string {
 	return m.table.View()
 }
 
+// Table returns the list's table, used to render it outside the TUI
+func (m SLBListModel) Table() components.TableModel {
+	return m.table
+}
+
 // Search searches in the list
 func (m SLBListModel) Search(query string) SLBListModel {
 	m.table = m.table.Search(query)
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m SLBListModel) Filter(query string) SLBListModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m SLBListModel) NextSearchMatch() SLBListModel {
 	m.table = m.table.NextSearchMatch()
@@ -202,7 +258,8 @@ type SLBListenersModel struct {
 
 // SLBListenersKeyMap defines key bindings for SLB listeners
 type SLBListenersKeyMap struct {
-	Enter key.Binding
+	Enter       key.Binding
+	Certificate key.Binding
 }
 
 // DefaultSLBListenersKeyMap returns default key bindings
@@ -212,9 +269,20 @@ func DefaultSLBListenersKeyMap() SLBListenersKeyMap {
 			key.WithKeys("enter"),
 			key.WithHelp("enter", "forwarding rules"),
 		),
+		Certificate: key.NewBinding(
+			key.WithKeys("C"),
+			key.WithHelp("C", "change certificate"),
+		),
 	}
 }
 
+// SLBCertRotateRequestMsg asks the app to bind an HTTPS listener to another
+// server certificate, an existing one or one uploaded first
+type SLBCertRotateRequestMsg struct {
+	LoadBalancerID string
+	Listener       service.ListenerDetail
+}
+
 // ListenerNavData contains the data needed to navigate to forwarding rules
 type ListenerNavData struct {
 	LoadBalancerId string
@@ -225,13 +293,14 @@ type ListenerNavData struct {
 // NewSLBListenersModel creates a new SLB listeners model
 func NewSLBListenersModel() SLBListenersModel {
 	columns := []table.Column{
-		{Title: "Protocol", Width: 10},
-		{Title: "Port", Width: 10},
-		{Title: "Backend Port", Width: 12},
-		{Title: "Status", Width: 12},
-		{Title: "Health Check", Width: 12},
-		{Title: "Scheduler", Width: 12},
-		{Title: "VServer Group", Width: 30},
+		{Title: i18n.T(i18n.KeyColProtocol), Width: 10},
+		{Title: i18n.T(i18n.KeyColPort), Width: 10},
+		{Title: i18n.T(i18n.KeyColBackendPort), Width: 12},
+		{Title: i18n.T(i18n.KeyColStatus), Width: 12},
+		{Title: i18n.T(i18n.KeyColHealthCheck), Width: 12},
+		{Title: i18n.T(i18n.KeyColScheduler), Width: 12},
+		{Title: i18n.T(i18n.KeyColVServerGroup), Width: 30},
+		{Title: i18n.T(i18n.KeyColCertificate), Width: 26},
 	}
 
 	return SLBListenersModel{
@@ -275,6 +344,11 @@ func (m SLBListenersModel) SetData(listeners []service.ListenerDetail, loadBalan
 			vServerGroup = listener.VServerGroupId
 		}
 
+		certificate := "--"
+		if listener.ServerCertificateId != "" {
+			certificate = listener.ServerCertificateId
+		}
+
 		rows[i] = table.Row{
 			listener.Protocol,
 			fmt.Sprintf("%d", listener.Port),
@@ -283,6 +357,7 @@ func (m SLBListenersModel) SetData(listeners []service.ListenerDetail, loadBalan
 			listener.HealthCheck,
 			listener.Scheduler,
 			vServerGroup,
+			certificate,
 		}
 		rowData[i] = listener
 	}
@@ -327,6 +402,12 @@ func (m SLBListenersModel) Update(msg tea.Msg) (SLBListenersModel, tea.Cmd) {
 					}
 				}
 			}
+
+		case key.Matches(msg, m.keys.Certificate):
+			if listener := m.SelectedListener(); listener != nil {
+				req := SLBCertRotateRequestMsg{LoadBalancerID: m.loadBalancerId, Listener: *listener}
+				return m, func() tea.Msg { return req }
+			}
 		}
 	}
 
@@ -346,6 +427,12 @@ func (m SLBListenersModel) Search(query string) SLBListenersModel {
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m SLBListenersModel) Filter(query string) SLBListenersModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m SLBListenersModel) NextSearchMatch() SLBListenersModel {
 	m.table = m.table.NextSearchMatch()
@@ -370,7 +457,9 @@ type SLBVServerGroupsModel struct {
 
 // SLBVServerGroupsKeyMap defines key bindings
 type SLBVServerGroupsKeyMap struct {
-	Enter key.Binding
+	Enter       key.Binding
+	NewGroup    key.Binding
+	AddBackends key.Binding
 }
 
 // DefaultSLBVServerGroupsKeyMap returns default key bindings
@@ -380,6 +469,14 @@ func DefaultSLBVServerGroupsKeyMap() SLBVServerGroupsKeyMap {
 			key.WithKeys("enter"),
 			key.WithHelp("enter", "backend servers"),
 		),
+		NewGroup: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "create group with marked ECS"),
+		),
+		AddBackends: key.NewBinding(
+			key.WithKeys("a"),
+			key.WithHelp("a", "add marked ECS"),
+		),
 	}
 }
 
@@ -393,7 +490,7 @@ func NewSLBVServerGroupsModel() SLBVServerGroupsModel {
 	}
 
 	return SLBVServerGroupsModel{
-		table: components.NewTableModel(columns, i18n.T(i18n.KeySLBVServerGroupList)),
+		table: components.NewTableModel(columns, i18n.T(i18n.KeySLBVServerGroupList)).SetBarColumns(3),
 		keys:  DefaultSLBVServerGroupsKeyMap(),
 	}
 }
@@ -448,7 +545,7 @@ func (m SLBVServerGroupsModel) SetData(groups []service.VServerGroupDetail, load
 
 	m.table = m.table.SetRows(rows)
 	m.table = m.table.SetRowData(rowData)
-	m.table = m.table.SetTitle(fmt.Sprintf("虚拟服务器组 - SLB: %s", loadBalancerId))
+	m.table = m.table.SetTitle(fmt.Sprintf("%s - SLB: %s", i18n.T(i18n.KeyPageVServerGroups), loadBalancerId))
 	return m
 }
 
@@ -460,6 +557,11 @@ func (m SLBVServerGroupsModel) SetSize(width, height int) SLBVServerGroupsModel
 	return m
 }
 
+// LoadBalancerID returns the ID of the SLB whose groups are shown
+func (m SLBVServerGroupsModel) LoadBalancerID() string {
+	return m.loadBalancerId
+}
+
 // SelectedVServerGroup returns the selected VServer group
 func (m SLBVServerGroupsModel) SelectedVServerGroup() *service.VServerGroupDetail {
 	idx := m.table.SelectedRow()
@@ -488,6 +590,23 @@ func (m SLBVServerGroupsModel) Update(msg tea.Msg) (SLBVServerGroupsModel, tea.C
 					}
 				}
 			}
+
+		case key.Matches(msg, m.keys.NewGroup):
+			// The app fills in the instances marked in the ECS list
+			loadBalancerId := m.loadBalancerId
+			return m, func() tea.Msg {
+				return SLBBackendAddRequestMsg{LoadBalancerID: loadBalancerId}
+			}
+
+		case key.Matches(msg, m.keys.AddBackends):
+			if vsg := m.SelectedVServerGroup(); vsg != nil {
+				req := SLBBackendAddRequestMsg{
+					LoadBalancerID:   m.loadBalancerId,
+					VServerGroupID:   vsg.VServerGroupId,
+					VServerGroupName: vsg.VServerGroupName,
+				}
+				return m, func() tea.Msg { return req }
+			}
 		}
 	}
 
@@ -507,6 +626,12 @@ func (m SLBVServerGroupsModel) Search(query string) SLBVServerGroupsModel {
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m SLBVServerGroupsModel) Filter(query string) SLBVServerGroupsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m SLBVServerGroupsModel) NextSearchMatch() SLBVServerGroupsModel {
 	m.table = m.table.NextSearchMatch()
@@ -531,18 +656,18 @@ type SLBBackendServersModel struct {
 // NewSLBBackendServersModel creates a new SLB backend servers model
 func NewSLBBackendServersModel() SLBBackendServersModel {
 	columns := []table.Column{
-		{Title: "Server ID", Width: 25},
-		{Title: "ECS Name", Width: 25},
-		{Title: "Port", Width: 8},
-		{Title: "Weight", Width: 8},
-		{Title: "Type", Width: 10},
-		{Title: "Private IP", Width: 15},
-		{Title: "Public IP", Width: 15},
-		{Title: "Description", Width: 20},
+		{Title: i18n.T(i18n.KeyColServerID), Width: 25},
+		{Title: i18n.T(i18n.KeyColServerName), Width: 25},
+		{Title: i18n.T(i18n.KeyColPort), Width: 8},
+		{Title: i18n.T(i18n.KeyColWeight), Width: 18},
+		{Title: i18n.T(i18n.KeyColType), Width: 10},
+		{Title: i18n.T(i18n.KeyColPrivateIP), Width: 15},
+		{Title: i18n.T(i18n.KeyColPublicIP), Width: 15},
+		{Title: i18n.T(i18n.KeyColDescription), Width: 20},
 	}
 
 	return SLBBackendServersModel{
-		table: components.NewTableModel(columns, "Backend Servers"),
+		table: components.NewTableModel(columns, "Backend Servers").SetBarColumns(3),
 	}
 }
 
@@ -605,6 +730,12 @@ func (m SLBBackendServersModel) Search(query string) SLBBackendServersModel {
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m SLBBackendServersModel) Filter(query string) SLBBackendServersModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m SLBBackendServersModel) NextSearchMatch() SLBBackendServersModel {
 	m.table = m.table.NextSearchMatch()
@@ -704,7 +835,7 @@ func (m SLBForwardingRulesModel) SetData(rules []service.ForwardingRuleDetail, l
 
 	m.table = m.table.SetRows(rows)
 	m.table = m.table.SetRowData(rowData)
-	m.table = m.table.SetTitle(fmt.Sprintf("转发策略列表 - %s:%d", loadBalancerId, listenerPort))
+	m.table = m.table.SetTitle(fmt.Sprintf("%s - %s:%d", i18n.T(i18n.KeyPageForwardRules), loadBalancerId, listenerPort))
 	return m
 }
 
@@ -739,7 +870,7 @@ func (m SLBForwardingRulesModel) Update(msg tea.Msg) (SLBForwardingRulesModel, t
 			if rule := m.SelectedRule(); rule != nil {
 				return m, func() tea.Msg {
 					return types.NavigateMsg{
-						Page: types.PageSLBDetail,
+						Page: types.PageSLBJSONDetail,
 						Data: *rule,
 					}
 				}
@@ -763,6 +894,12 @@ func (m SLBForwardingRulesModel) Search(query string) SLBForwardingRulesModel {
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m SLBForwardingRulesModel) Filter(query string) SLBForwardingRulesModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m SLBForwardingRulesModel) NextSearchMatch() SLBForwardingRulesModel {
 	m.table = m.table.NextSearchMatch()
@@ -779,6 +916,7 @@ func (m SLBForwardingRulesModel) PrevSearchMatch() SLBForwardingRulesModel {
 type SLBDefaultServersModel struct {
 	table          components.TableModel
 	servers        []service.DefaultServerDetail
+	names          *service.NameResolver
 	loadBalancerId string
 	width          int
 	height         int
@@ -805,14 +943,14 @@ func NewSLBDefaultServersModel() SLBDefaultServersModel {
 	columns := []table.Column{
 		{Title: i18n.T(i18n.KeyColServerIDName), Width: 35},
 		{Title: i18n.T(i18n.KeyColZone), Width: 18},
-		{Title: "VPC", Width: 26},
+		{Title: i18n.T(i18n.KeyColVPC), Width: 26},
 		{Title: i18n.T(i18n.KeyColPublicPrivateIP), Width: 30},
 		{Title: i18n.T(i18n.KeyColStatus), Width: 10},
-		{Title: i18n.T(i18n.KeyColWeight), Width: 8},
+		{Title: i18n.T(i18n.KeyColWeight), Width: 18},
 	}
 
 	return SLBDefaultServersModel{
-		table: components.NewTableModel(columns, i18n.T(i18n.KeySLBDefaultServerGroup)),
+		table: components.NewTableModel(columns, i18n.T(i18n.KeySLBDefaultServerGroup)).SetBarColumns(5),
 		keys:  DefaultSLBDefaultServersKeyMap(),
 	}
 }
@@ -841,7 +979,7 @@ func (m SLBDefaultServersModel) SetData(servers []service.DefaultServerDetail, l
 		rows[i] = table.Row{
 			idName,
 			server.Zone,
-			server.VpcId,
+			m.names.Label(service.KindVPC, server.VpcId),
 			ipAddr,
 			server.Status,
 			fmt.Sprintf("%d", server.Weight),
@@ -851,10 +989,28 @@ func (m SLBDefaultServersModel) SetData(servers []service.DefaultServerDetail, l
 
 	m.table = m.table.SetRows(rows)
 	m.table = m.table.SetRowData(rowData)
-	m.table = m.table.SetTitle(fmt.Sprintf("默认服务器组 - SLB: %s", loadBalancerId))
+	m.table = m.table.SetTitle(fmt.Sprintf("%s - SLB: %s", i18n.T(i18n.KeyPageDefaultServers), loadBalancerId))
+	return m
+}
+
+// SetNames sets the resolver used to show VPC names, re-rendering the rows
+func (m SLBDefaultServersModel) SetNames(names *service.NameResolver) SLBDefaultServersModel {
+	m.names = names
+	cursor := m.table.SelectedRow()
+	m = m.SetData(m.servers, m.loadBalancerId)
+	m.table = m.table.SetCursor(cursor)
 	return m
 }
 
+// NameRefs returns the IDs referenced by the rows
+func (m SLBDefaultServersModel) NameRefs() service.NameRefs {
+	refs := make(service.NameRefs)
+	for _, server := range m.servers {
+		refs.Add(service.KindVPC, server.VpcId)
+	}
+	return refs
+}
+
 // SetSize sets the size
 func (m SLBDefaultServersModel) SetSize(width, height int) SLBDefaultServersModel {
 	m.width = width
@@ -886,7 +1042,7 @@ func (m SLBDefaultServersModel) Update(msg tea.Msg) (SLBDefaultServersModel, tea
 			if server := m.SelectedServer(); server != nil {
 				return m, func() tea.Msg {
 					return types.NavigateMsg{
-						Page: types.PageSLBDetail,
+						Page: types.PageSLBJSONDetail,
 						Data: *server,
 					}
 				}
@@ -910,6 +1066,12 @@ func (m SLBDefaultServersModel) Search(query string) SLBDefaultServersModel {
 	return m
 }
 
+// Filter hides rows that do not match the filter query
+func (m SLBDefaultServersModel) Filter(query string) SLBDefaultServersModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
 // NextSearchMatch moves to next search match
 func (m SLBDefaultServersModel) NextSearchMatch() SLBDefaultServersModel {
 	m.table = m.table.NextSearchMatch()
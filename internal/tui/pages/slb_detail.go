@@ -0,0 +1,301 @@
+package pages
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// SLBDetailModel is the formatted detail page of an SLB instance. Listeners,
+// default servers and the expiry come from DescribeLoadBalancerAttribute,
+// which loads after the page opens
+type SLBDetailModel struct {
+	lb        slb.LoadBalancer
+	names     *service.NameResolver // Resolves referenced IDs to names, may be nil
+	attribute *slb.DescribeLoadBalancerAttributeResponse
+	attrErr   error
+	view      SectionDetailModel
+	keys      SLBDetailKeyMap
+}
+
+// SLBDetailKeyMap defines the SLB detail keys besides the navigation
+type SLBDetailKeyMap struct {
+	JSON           key.Binding
+	Listeners      key.Binding
+	DefaultServers key.Binding
+}
+
+// DefaultSLBDetailKeyMap returns default key bindings. v opens the JSON view
+// as on the ECS list, so VServer groups stay on the SLB list
+func DefaultSLBDetailKeyMap() SLBDetailKeyMap {
+	list := DefaultSLBListKeyMap()
+	return SLBDetailKeyMap{
+		JSON: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "JSON"),
+		),
+		Listeners:      list.Listeners,
+		DefaultServers: list.DefaultServers,
+	}
+}
+
+// NewSLBDetailModel creates a new SLB detail model
+func NewSLBDetailModel(lb slb.LoadBalancer) SLBDetailModel {
+	m := SLBDetailModel{
+		lb:   lb,
+		view: NewSectionDetailModel(),
+		keys: DefaultSLBDetailKeyMap(),
+	}
+	return m.rebuild()
+}
+
+// NewSLBDetailModelFromInterface creates a new SLB detail model from
+// interface{}, and false when data is not a load balancer
+func NewSLBDetailModelFromInterface(data interface{}) (SLBDetailModel, bool) {
+	if lb, ok := data.(slb.LoadBalancer); ok {
+		return NewSLBDetailModel(lb), true
+	}
+	return SLBDetailModel{}, false
+}
+
+// LoadBalancerID returns the ID of the shown load balancer
+func (m SLBDetailModel) LoadBalancerID() string {
+	return m.lb.LoadBalancerId
+}
+
+// NameRefs returns the IDs referenced by the load balancer
+func (m SLBDetailModel) NameRefs() service.NameRefs {
+	refs := make(service.NameRefs)
+	refs.Add(service.KindVPC, m.lb.VpcId)
+	refs.Add(service.KindVSwitch, m.lb.VSwitchId)
+	refs.Add(service.KindResourceGroup, m.lb.ResourceGroupId)
+	return refs
+}
+
+// SetNames sets the resolver used to show the names of referenced resources
+func (m SLBDetailModel) SetNames(names *service.NameResolver) SLBDetailModel {
+	m.names = names
+	if m.lb.LoadBalancerId == "" {
+		return m
+	}
+	return m.rebuild()
+}
+
+// SetAttribute sets the load balancer attributes, or the error that
+// prevented loading them
+func (m SLBDetailModel) SetAttribute(attribute *slb.DescribeLoadBalancerAttributeResponse, err error) SLBDetailModel {
+	m.attribute = attribute
+	m.attrErr = err
+	return m.rebuild()
+}
+
+// rebuild renders the sections from the current data
+func (m SLBDetailModel) rebuild() SLBDetailModel {
+	lb := m.lb
+
+	basicInfo := DetailSection{
+		Title: i18n.T(i18n.KeySectionBasicInfo),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelInstanceID), Value: lb.LoadBalancerId},
+			{Label: i18n.T(i18n.KeyLabelInstanceName), Value: valueOrDash(lb.LoadBalancerName)},
+			{Label: i18n.T(i18n.KeyLabelInstanceStatus), Value: valueOrDash(lb.LoadBalancerStatus), Status: true},
+			{Label: i18n.T(i18n.KeyColSpec), Value: valueOrDash(lb.LoadBalancerSpec)},
+			{Label: i18n.T(i18n.KeyColRegion), Value: valueOrDash(lb.RegionId)},
+			{Label: i18n.T(i18n.KeyColCreatedAt), Value: components.FormatTimeString(lb.CreateTime)},
+			{Label: i18n.T(i18n.KeyLabelDeleteProtection), Value: valueOrDash(lb.DeleteProtection)},
+			{Label: i18n.T(i18n.KeyLabelModProtection), Value: m.formatModProtection()},
+		},
+	}
+
+	network := DetailSection{
+		Title: i18n.T(i18n.KeySectionNetwork),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyColAddress), Value: valueOrDash(lb.Address)},
+			{Label: i18n.T(i18n.KeyColAddressType), Value: valueOrDash(lb.AddressType)},
+			{Label: i18n.T(i18n.KeyLabelIPVersion), Value: valueOrDash(lb.AddressIPVersion)},
+			{Label: i18n.T(i18n.KeyLabelNetworkType), Value: valueOrDash(lb.NetworkType)},
+			{Label: i18n.T(i18n.KeyLabelVPC), Value: valueOrDash(m.names.Label(service.KindVPC, lb.VpcId))},
+			{Label: i18n.T(i18n.KeyLabelVSwitch), Value: valueOrDash(m.names.Label(service.KindVSwitch, lb.VSwitchId))},
+			{Label: i18n.T(i18n.KeyLabelMasterZone), Value: valueOrDash(lb.MasterZoneId)},
+			{Label: i18n.T(i18n.KeyLabelSlaveZone), Value: valueOrDash(lb.SlaveZoneId)},
+		},
+	}
+
+	billing := DetailSection{
+		Title: i18n.T(i18n.KeySectionBilling),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelChargeType), Value: m.formatPayType()},
+			{Label: i18n.T(i18n.KeyLabelSpecCharge), Value: valueOrDash(lb.InstanceChargeType)},
+			{Label: i18n.T(i18n.KeyLabelBandwidthCharge), Value: valueOrDash(lb.InternetChargeType)},
+			{Label: i18n.T(i18n.KeyLabelBandwidth), Value: m.formatBandwidth()},
+			{Label: i18n.T(i18n.KeyLabelExpireTime), Value: m.formatExpiry()},
+		},
+	}
+
+	groupInfo := DetailSection{
+		Title: i18n.T(i18n.KeySectionGroupInfo),
+		Rows: []DetailRow{
+			{Label: i18n.T(i18n.KeyLabelResourceGroup), Value: valueOrDash(m.names.Label(service.KindResourceGroup, lb.ResourceGroupId))},
+			{Label: i18n.T(i18n.KeyLabelTags), Value: m.formatTags()},
+		},
+	}
+
+	m.view = m.view.SetSections([]DetailSection{basicInfo, network, m.listenersSection(), billing, groupInfo})
+	return m
+}
+
+// listenersSection summarizes the listeners one row each, with the number of
+// default servers
+func (m SLBDetailModel) listenersSection() DetailSection {
+	section := DetailSection{Title: i18n.T(i18n.KeySectionListeners)}
+	switch {
+	case m.attrErr != nil:
+		section.Rows = []DetailRow{{Label: i18n.T(i18n.KeySectionListeners), Value: fmt.Sprintf("%s: %v", i18n.T(i18n.KeyDetailUnavailable), m.attrErr)}}
+		return section
+	case m.attribute == nil:
+		section.Rows = []DetailRow{{Label: i18n.T(i18n.KeySectionListeners), Value: i18n.T(i18n.KeyActionLoading)}}
+		return section
+	}
+
+	listeners := m.attribute.ListenerPortsAndProtocol.ListenerPortAndProtocol
+	section.Title = fmt.Sprintf("%s (%d)", section.Title, len(listeners))
+	for _, listener := range listeners {
+		var details []string
+		if listener.ListenerForward == "on" {
+			details = append(details, fmt.Sprintf("→ %d", listener.ForwardPort))
+		}
+		if listener.Description != "" {
+			details = append(details, listener.Description)
+		}
+		section.Rows = append(section.Rows, DetailRow{
+			Label: fmt.Sprintf("%s:%d", strings.ToUpper(listener.ListenerProtocol), listener.ListenerPort),
+			Value: valueOrDash(strings.Join(details, "  ")),
+		})
+	}
+	if len(listeners) == 0 {
+		section.Rows = append(section.Rows, DetailRow{Label: i18n.T(i18n.KeySectionListeners), Value: i18n.T(i18n.KeySLBNoListeners)})
+	}
+	section.Rows = append(section.Rows, DetailRow{
+		Label: i18n.T(i18n.KeyLabelDefaultServers),
+		Value: fmt.Sprintf("%d", len(m.attribute.BackendServers.BackendServer)),
+	})
+	return section
+}
+
+// formatModProtection shows the modification protection with its reason
+func (m SLBDetailModel) formatModProtection() string {
+	if m.lb.ModificationProtectionReason == "" {
+		return valueOrDash(m.lb.ModificationProtectionStatus)
+	}
+	return fmt.Sprintf("%s (%s)", m.lb.ModificationProtectionStatus, m.lb.ModificationProtectionReason)
+}
+
+// formatPayType shows the billing method in the wording of the ECS detail
+func (m SLBDetailModel) formatPayType() string {
+	switch m.lb.PayType {
+	case "PrePay":
+		return i18n.T(i18n.KeyChargePrePaid)
+	case "PayOnDemand":
+		return i18n.T(i18n.KeyChargePostPaid)
+	default:
+		return valueOrDash(m.lb.PayType)
+	}
+}
+
+// formatBandwidth shows the bandwidth cap, which pay-by-traffic instances
+// report as -1 or 0
+func (m SLBDetailModel) formatBandwidth() string {
+	if m.lb.Bandwidth <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d Mbps", m.lb.Bandwidth)
+}
+
+// formatExpiry shows when a subscription instance expires and whether it
+// renews automatically
+func (m SLBDetailModel) formatExpiry() string {
+	if m.lb.PayType != "PrePay" {
+		return "-"
+	}
+	if m.attribute == nil {
+		return i18n.T(i18n.KeyActionLoading)
+	}
+	if m.attribute.RenewalStatus == "" {
+		return components.FormatTimeString(m.attribute.EndTime)
+	}
+	return fmt.Sprintf("%s (%s)", components.FormatTimeString(m.attribute.EndTime), m.attribute.RenewalStatus)
+}
+
+// formatTags formats the load balancer tags as "key: value" pairs
+func (m SLBDetailModel) formatTags() string {
+	if len(m.lb.Tags.Tag) == 0 {
+		return "-"
+	}
+	tags := make([]string, len(m.lb.Tags.Tag))
+	for i, tag := range m.lb.Tags.Tag {
+		tags[i] = fmt.Sprintf("%s: %s", tag.TagKey, tag.TagValue)
+	}
+	return strings.Join(tags, ", ")
+}
+
+// SetSize sets the size of the detail view
+func (m SLBDetailModel) SetSize(width, height int) SLBDetailModel {
+	m.view = m.view.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m SLBDetailModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m SLBDetailModel) Update(msg tea.Msg) (SLBDetailModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		var nav *types.NavigateMsg
+		switch {
+		case key.Matches(msg, m.keys.JSON):
+			nav = &types.NavigateMsg{Page: types.PageSLBJSONDetail, Data: m.lb}
+		case key.Matches(msg, m.keys.Listeners):
+			nav = &types.NavigateMsg{Page: types.PageSLBListeners, Data: m.lb.LoadBalancerId}
+		case key.Matches(msg, m.keys.DefaultServers):
+			nav = &types.NavigateMsg{Page: types.PageSLBDefaultServers, Data: m.lb.LoadBalancerId}
+		}
+		if nav != nil {
+			return m, func() tea.Msg { return *nav }
+		}
+	}
+
+	var cmd tea.Cmd
+	m.view, cmd = m.view.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m SLBDetailModel) View() string {
+	return m.view.View()
+}
+
+// Search placeholder for interface compatibility
+func (m SLBDetailModel) Search(query string) SLBDetailModel {
+	return m
+}
+
+// NextSearchMatch placeholder
+func (m SLBDetailModel) NextSearchMatch() SLBDetailModel {
+	return m
+}
+
+// PrevSearchMatch placeholder
+func (m SLBDetailModel) PrevSearchMatch() SLBDetailModel {
+	return m
+}
@@ -0,0 +1,754 @@
+package pages
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// SLSProjectsModel represents the Log Service projects list page
+type SLSProjectsModel struct {
+	table    components.TableModel
+	projects []service.SLSProject
+	width    int
+	height   int
+	keys     SLSProjectsKeyMap
+}
+
+// SLSProjectsKeyMap defines key bindings
+type SLSProjectsKeyMap struct {
+	Enter         key.Binding
+	MachineGroups key.Binding
+	Coverage      key.Binding
+	Detail        key.Binding
+}
+
+// DefaultSLSProjectsKeyMap returns default key bindings
+func DefaultSLSProjectsKeyMap() SLSProjectsKeyMap {
+	return SLSProjectsKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "logtail configs"),
+		),
+		MachineGroups: key.NewBinding(
+			key.WithKeys("m"),
+			key.WithHelp("m", "machine groups"),
+		),
+		Coverage: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "ECS coverage"),
+		),
+		Detail: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "details"),
+		),
+	}
+}
+
+// NewSLSProjectsModel creates a new Log Service projects model
+func NewSLSProjectsModel() SLSProjectsModel {
+	columns := []table.Column{
+		{Title: "Project", Width: 36},
+		{Title: "Status", Width: 10},
+		{Title: "Region", Width: 16},
+		{Title: "Created", Width: 20},
+		{Title: "Description", Width: 40},
+	}
+
+	return SLSProjectsModel{
+		table: components.NewTableModel(columns, "Log Service Projects"),
+		keys:  DefaultSLSProjectsKeyMap(),
+	}
+}
+
+// SetData sets the projects data
+func (m SLSProjectsModel) SetData(projects []service.SLSProject) SLSProjectsModel {
+	m.projects = projects
+
+	rows := make([]table.Row, len(projects))
+	rowData := make([]interface{}, len(projects))
+
+	for i, project := range projects {
+		rows[i] = table.Row{
+			project.ProjectName,
+			project.Status,
+			project.Region,
+			components.FormatTimeString(project.CreateTime),
+			project.Description,
+		}
+		rowData[i] = project
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m SLSProjectsModel) SetSize(width, height int) SLSProjectsModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedProject returns the selected project
+func (m SLSProjectsModel) SelectedProject() *service.SLSProject {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.projects) {
+		return &m.projects[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m SLSProjectsModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m SLSProjectsModel) Update(msg tea.Msg) (SLSProjectsModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		var page types.PageType
+		switch {
+		case key.Matches(msg, m.keys.Enter):
+			page = types.PageSLSLogtailConfigs
+		case key.Matches(msg, m.keys.MachineGroups):
+			page = types.PageSLSMachineGroups
+		case key.Matches(msg, m.keys.Coverage):
+			page = types.PageSLSCoverage
+		case key.Matches(msg, m.keys.Detail):
+			if project := m.SelectedProject(); project != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{Page: types.PageSLSDetail, Data: *project}
+				}
+			}
+		}
+		if project := m.SelectedProject(); project != nil && page != types.PageMenu {
+			name := project.ProjectName
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: page, Data: name}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m SLSProjectsModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m SLSProjectsModel) Search(query string) SLSProjectsModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m SLSProjectsModel) Filter(query string) SLSProjectsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m SLSProjectsModel) NextSearchMatch() SLSProjectsModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m SLSProjectsModel) PrevSearchMatch() SLSProjectsModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// SLSLogtailConfigsModel represents the Logtail configs of a project
+type SLSLogtailConfigsModel struct {
+	table   components.TableModel
+	project string
+	configs []service.SLSLogtailConfig
+	width   int
+	height  int
+	keys    SLSListKeyMap
+}
+
+// SLSListKeyMap defines key bindings of the Logtail lists
+type SLSListKeyMap struct {
+	Enter  key.Binding
+	Detail key.Binding
+}
+
+// DefaultSLSListKeyMap returns default key bindings
+func DefaultSLSListKeyMap() SLSListKeyMap {
+	return SLSListKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "details"),
+		),
+		Detail: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "details"),
+		),
+	}
+}
+
+// NewSLSLogtailConfigsModel creates a new Logtail configs model for a project
+func NewSLSLogtailConfigsModel(project string) SLSLogtailConfigsModel {
+	columns := []table.Column{
+		{Title: "Config", Width: 30},
+		{Title: "Input", Width: 10},
+		{Title: "Log Path", Width: 30},
+		{Title: "File Pattern", Width: 18},
+		{Title: "Logstore", Width: 24},
+		{Title: "Machine Groups", Width: 30},
+		{Title: "Modified", Width: 20},
+	}
+
+	return SLSLogtailConfigsModel{
+		table:   components.NewTableModel(columns, fmt.Sprintf("Logtail Configs: %s", project)),
+		project: project,
+		keys:    DefaultSLSListKeyMap(),
+	}
+}
+
+// Project returns the project shown
+func (m SLSLogtailConfigsModel) Project() string {
+	return m.project
+}
+
+// SetData sets the Logtail configs data
+func (m SLSLogtailConfigsModel) SetData(configs []service.SLSLogtailConfig) SLSLogtailConfigsModel {
+	m.configs = configs
+
+	rows := make([]table.Row, len(configs))
+	rowData := make([]interface{}, len(configs))
+
+	for i, config := range configs {
+		groups := "-"
+		if len(config.MachineGroups) > 0 {
+			groups = strings.Join(config.MachineGroups, ", ")
+		}
+
+		rows[i] = table.Row{
+			config.ConfigName,
+			config.InputType,
+			logtailInputField(config, "logPath"),
+			logtailInputField(config, "filePattern"),
+			config.OutputDetail.LogstoreName,
+			groups,
+			formatUnixTime(config.LastModifyTime),
+		}
+		rowData[i] = config
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m SLSLogtailConfigsModel) SetSize(width, height int) SLSLogtailConfigsModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedConfig returns the selected config
+func (m SLSLogtailConfigsModel) SelectedConfig() *service.SLSLogtailConfig {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.configs) {
+		return &m.configs[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m SLSLogtailConfigsModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m SLSLogtailConfigsModel) Update(msg tea.Msg) (SLSLogtailConfigsModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && (key.Matches(msg, m.keys.Enter) || key.Matches(msg, m.keys.Detail)) {
+		if config := m.SelectedConfig(); config != nil {
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageSLSDetail, Data: *config}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m SLSLogtailConfigsModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m SLSLogtailConfigsModel) Search(query string) SLSLogtailConfigsModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m SLSLogtailConfigsModel) Filter(query string) SLSLogtailConfigsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m SLSLogtailConfigsModel) NextSearchMatch() SLSLogtailConfigsModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m SLSLogtailConfigsModel) PrevSearchMatch() SLSLogtailConfigsModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// SLSMachineGroupsModel represents the machine groups of a project
+type SLSMachineGroupsModel struct {
+	table   components.TableModel
+	project string
+	groups  []service.SLSMachineGroup
+	width   int
+	height  int
+	keys    SLSListKeyMap
+}
+
+// NewSLSMachineGroupsModel creates a new machine groups model for a project
+func NewSLSMachineGroupsModel(project string) SLSMachineGroupsModel {
+	columns := []table.Column{
+		{Title: "Machine Group", Width: 30},
+		{Title: "Identify By", Width: 12},
+		{Title: "Machines", Width: 9},
+		{Title: "Heartbeat OK", Width: 12},
+		{Title: "Configs", Width: 40},
+		{Title: "Topic", Width: 16},
+		{Title: "Modified", Width: 20},
+	}
+
+	return SLSMachineGroupsModel{
+		table:   components.NewTableModel(columns, fmt.Sprintf("Machine Groups: %s", project)),
+		project: project,
+		keys:    DefaultSLSListKeyMap(),
+	}
+}
+
+// Project returns the project shown
+func (m SLSMachineGroupsModel) Project() string {
+	return m.project
+}
+
+// SetData sets the machine groups data
+func (m SLSMachineGroupsModel) SetData(groups []service.SLSMachineGroup) SLSMachineGroupsModel {
+	m.groups = groups
+
+	rows := make([]table.Row, len(groups))
+	rowData := make([]interface{}, len(groups))
+
+	for i, group := range groups {
+		configs := "-"
+		if len(group.Configs) > 0 {
+			configs = strings.Join(group.Configs, ", ")
+		}
+
+		rows[i] = table.Row{
+			group.GroupName,
+			group.MachineIdentifyType,
+			fmt.Sprintf("%d", len(group.Machines)),
+			fmt.Sprintf("%d/%d", group.HeartbeatCount(), len(group.Machines)),
+			configs,
+			group.GroupAttribute.GroupTopic,
+			formatUnixTime(group.LastModifyTime),
+		}
+		rowData[i] = group
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m SLSMachineGroupsModel) SetSize(width, height int) SLSMachineGroupsModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedGroup returns the selected machine group
+func (m SLSMachineGroupsModel) SelectedGroup() *service.SLSMachineGroup {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.groups) {
+		return &m.groups[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m SLSMachineGroupsModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m SLSMachineGroupsModel) Update(msg tea.Msg) (SLSMachineGroupsModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.keys.Enter):
+			if group := m.SelectedGroup(); group != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{Page: types.PageSLSMachines, Data: *group}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Detail):
+			if group := m.SelectedGroup(); group != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{Page: types.PageSLSDetail, Data: *group}
+				}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m SLSMachineGroupsModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m SLSMachineGroupsModel) Search(query string) SLSMachineGroupsModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m SLSMachineGroupsModel) Filter(query string) SLSMachineGroupsModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m SLSMachineGroupsModel) NextSearchMatch() SLSMachineGroupsModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m SLSMachineGroupsModel) PrevSearchMatch() SLSMachineGroupsModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// SLSMachinesModel represents the machines of a machine group
+type SLSMachinesModel struct {
+	table    components.TableModel
+	machines []service.SLSMachine
+	width    int
+	height   int
+	keys     SLSListKeyMap
+}
+
+// NewSLSMachinesModel creates a new machines model showing a machine group
+func NewSLSMachinesModel(group service.SLSMachineGroup) SLSMachinesModel {
+	columns := []table.Column{
+		{Title: "IP", Width: 16},
+		{Title: "Instance ID", Width: 24},
+		{Title: "Instance Name", Width: 30},
+		{Title: "Identifier", Width: 36},
+		{Title: "Heartbeat", Width: 24},
+	}
+
+	m := SLSMachinesModel{
+		table: components.NewTableModel(columns, fmt.Sprintf("Machines: %s", group.GroupName)),
+		keys:  DefaultSLSListKeyMap(),
+	}
+	m.machines = group.Machines
+
+	rows := make([]table.Row, len(group.Machines))
+	rowData := make([]interface{}, len(group.Machines))
+
+	for i, machine := range group.Machines {
+		identifier := machine.UserDefinedID
+		if identifier == "" {
+			identifier = machine.UniqueID
+		}
+
+		rows[i] = table.Row{
+			machine.IP,
+			valueOrDash(machine.InstanceID),
+			valueOrDash(machine.InstanceName),
+			valueOrDash(identifier),
+			formatHeartbeat(&group.Machines[i]),
+		}
+		rowData[i] = machine
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m SLSMachinesModel) SetSize(width, height int) SLSMachinesModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedMachine returns the selected machine
+func (m SLSMachinesModel) SelectedMachine() *service.SLSMachine {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.machines) {
+		return &m.machines[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m SLSMachinesModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m SLSMachinesModel) Update(msg tea.Msg) (SLSMachinesModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && (key.Matches(msg, m.keys.Enter) || key.Matches(msg, m.keys.Detail)) {
+		if machine := m.SelectedMachine(); machine != nil {
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageSLSDetail, Data: *machine}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m SLSMachinesModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m SLSMachinesModel) Search(query string) SLSMachinesModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m SLSMachinesModel) Filter(query string) SLSMachinesModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m SLSMachinesModel) NextSearchMatch() SLSMachinesModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m SLSMachinesModel) PrevSearchMatch() SLSMachinesModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// SLSCoverageModel shows which ECS instances of the region a project's
+// Logtail setup covers
+type SLSCoverageModel struct {
+	table    components.TableModel
+	project  string
+	coverage []service.LogtailCoverage
+	width    int
+	height   int
+	keys     SLSListKeyMap
+}
+
+// NewSLSCoverageModel creates a new coverage model for a project
+func NewSLSCoverageModel(project string) SLSCoverageModel {
+	columns := []table.Column{
+		{Title: "Instance ID", Width: 24},
+		{Title: "Name", Width: 28},
+		{Title: "Private IP", Width: 16},
+		{Title: "Status", Width: 10},
+		{Title: "Machine Groups", Width: 28},
+		{Title: "Configs", Width: 32},
+		{Title: "Heartbeat", Width: 24},
+	}
+
+	return SLSCoverageModel{
+		table:   components.NewTableModel(columns, fmt.Sprintf("Logtail Coverage: %s", project)),
+		project: project,
+		keys:    DefaultSLSListKeyMap(),
+	}
+}
+
+// Project returns the project shown
+func (m SLSCoverageModel) Project() string {
+	return m.project
+}
+
+// SetData sets the coverage data
+func (m SLSCoverageModel) SetData(coverage []service.LogtailCoverage) SLSCoverageModel {
+	m.coverage = coverage
+
+	rows := make([]table.Row, len(coverage))
+	rowData := make([]interface{}, len(coverage))
+
+	for i, c := range coverage {
+		groups, configs := "-", "-"
+		if len(c.MachineGroups) > 0 {
+			groups = strings.Join(c.MachineGroups, ", ")
+		}
+		if len(c.Configs) > 0 {
+			configs = strings.Join(c.Configs, ", ")
+		}
+		heartbeat := "Not in any group"
+		if c.Heartbeat != nil {
+			heartbeat = formatHeartbeat(c.Heartbeat)
+		}
+
+		rows[i] = table.Row{
+			c.Instance.InstanceId,
+			c.Instance.InstanceName,
+			valueOrDash(c.PrivateIP),
+			c.Instance.Status,
+			groups,
+			configs,
+			heartbeat,
+		}
+		rowData[i] = c
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m SLSCoverageModel) SetSize(width, height int) SLSCoverageModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedCoverage returns the selected instance's coverage
+func (m SLSCoverageModel) SelectedCoverage() *service.LogtailCoverage {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.coverage) {
+		return &m.coverage[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m SLSCoverageModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m SLSCoverageModel) Update(msg tea.Msg) (SLSCoverageModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && (key.Matches(msg, m.keys.Enter) || key.Matches(msg, m.keys.Detail)) {
+		if c := m.SelectedCoverage(); c != nil {
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageSLSDetail, Data: *c}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m SLSCoverageModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m SLSCoverageModel) Search(query string) SLSCoverageModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m SLSCoverageModel) Filter(query string) SLSCoverageModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m SLSCoverageModel) NextSearchMatch() SLSCoverageModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m SLSCoverageModel) PrevSearchMatch() SLSCoverageModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// logtailInputField returns a string field of a config's input detail, or "-"
+func logtailInputField(config service.SLSLogtailConfig, field string) string {
+	if value, ok := config.InputDetail[field].(string); ok && value != "" {
+		return value
+	}
+	return "-"
+}
+
+// formatHeartbeat describes a machine's last heartbeat, e.g. "OK (1m ago)"
+func formatHeartbeat(machine *service.SLSMachine) string {
+	if machine.LastHeartbeatTime == 0 {
+		return "No heartbeat"
+	}
+	age := time.Since(time.Unix(machine.LastHeartbeatTime, 0)).Round(time.Minute)
+	if machine.HeartbeatOK() {
+		return fmt.Sprintf("OK (%s ago)", formatWindow(max(age, time.Minute)))
+	}
+	return fmt.Sprintf("Stale (%s ago)", formatWindow(age))
+}
+
+// formatUnixTime formats Unix seconds, or "-" for 0
+func formatUnixTime(seconds int64) string {
+	if seconds == 0 {
+		return "-"
+	}
+	return components.FormatTime(time.Unix(seconds, 0))
+}
+
+// valueOrDash returns value, or "-" when it is empty
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -0,0 +1,371 @@
+package pages
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/types"
+)
+
+// TagFilter limits a list page to the resources carrying a tag
+type TagFilter struct {
+	Key         string
+	Value       string
+	ResourceIDs map[string]bool
+}
+
+// String formats the filter's tag as key=value
+func (f *TagFilter) String() string {
+	return f.Key + "=" + f.Value
+}
+
+// TagFilterRequestMsg is sent when t is pressed on a list page that can be
+// filtered by tag. Current is the active filter, if any
+type TagFilterRequestMsg struct {
+	Current *TagFilter
+}
+
+// tagFilterBinding returns the key binding filtering a list by tag
+func tagFilterBinding() key.Binding {
+	return key.NewBinding(
+		key.WithKeys("t"),
+		key.WithHelp("t", "filter by tag"),
+	)
+}
+
+// requestTagFilter returns the command asking the app for a tag filter
+func requestTagFilter(current *TagFilter) tea.Cmd {
+	return func() tea.Msg {
+		return TagFilterRequestMsg{Current: current}
+	}
+}
+
+// applyTagFilter hides the rows whose resource does not carry the filter's
+// tag. id returns the resource ID of the row at an index passed to SetRows
+func applyTagFilter(t components.TableModel, f *TagFilter, id func(index int) string) components.TableModel {
+	if f == nil {
+		return t.SetRowFilter("", nil)
+	}
+	return t.SetRowFilter("tag "+f.String(), func(index int) bool {
+		return f.ResourceIDs[id(index)]
+	})
+}
+
+// OpenTaggedListMsg asks the app to open a product's list page filtered by a tag
+type OpenTaggedListMsg struct {
+	Page   types.PageType
+	Filter *TagFilter
+}
+
+// tagListPage returns the list page showing a product's resources
+func tagListPage(product string) (types.PageType, bool) {
+	switch product {
+	case service.TagProductECS:
+		return types.PageECSList, true
+	case service.TagProductRDS:
+		return types.PageRDSList, true
+	case service.TagProductSLB:
+		return types.PageSLBList, true
+	case service.TagProductRedis:
+		return types.PageRedisList, true
+	}
+	return types.PageMenu, false
+}
+
+// TagBrowserModel lists the tag keys and values used by ECS, RDS, SLB and
+// Redis resources
+type TagBrowserModel struct {
+	table  components.TableModel
+	tags   []service.TagPair
+	width  int
+	height int
+	keys   TagBrowserKeyMap
+}
+
+// TagBrowserKeyMap defines key bindings
+type TagBrowserKeyMap struct {
+	Enter key.Binding
+}
+
+// DefaultTagBrowserKeyMap returns default key bindings
+func DefaultTagBrowserKeyMap() TagBrowserKeyMap {
+	return TagBrowserKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "tagged resources"),
+		),
+	}
+}
+
+// NewTagBrowserModel creates a new tag browser model
+func NewTagBrowserModel() TagBrowserModel {
+	columns := []table.Column{
+		{Title: "Tag Key", Width: 32},
+		{Title: "Tag Value", Width: 40},
+		{Title: "Used By", Width: 24},
+	}
+
+	return TagBrowserModel{
+		table: components.NewTableModel(columns, "Resource Tags"),
+		keys:  DefaultTagBrowserKeyMap(),
+	}
+}
+
+// SetData sets the tags data
+func (m TagBrowserModel) SetData(tags []service.TagPair) TagBrowserModel {
+	m.tags = tags
+
+	rows := make([]table.Row, len(tags))
+	rowData := make([]interface{}, len(tags))
+
+	for i, t := range tags {
+		rows[i] = table.Row{
+			t.Key,
+			valueOrDash(t.Value),
+			strings.Join(t.Products, ", "),
+		}
+		rowData[i] = t
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m TagBrowserModel) SetSize(width, height int) TagBrowserModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// SelectedTag returns the selected tag
+func (m TagBrowserModel) SelectedTag() *service.TagPair {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.tags) {
+		return &m.tags[idx]
+	}
+	return nil
+}
+
+// Init implements tea.Model
+func (m TagBrowserModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m TagBrowserModel) Update(msg tea.Msg) (TagBrowserModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Enter) {
+		if t := m.SelectedTag(); t != nil {
+			return m, func() tea.Msg {
+				return types.NavigateMsg{Page: types.PageTagResources, Data: *t}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m TagBrowserModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m TagBrowserModel) Search(query string) TagBrowserModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m TagBrowserModel) Filter(query string) TagBrowserModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m TagBrowserModel) NextSearchMatch() TagBrowserModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m TagBrowserModel) PrevSearchMatch() TagBrowserModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
+
+// TagResourcesModel lists the resources carrying a tag
+type TagResourcesModel struct {
+	table     components.TableModel
+	tag       service.TagPair
+	resources []service.TaggedResource
+	width     int
+	height    int
+	keys      TagResourcesKeyMap
+}
+
+// TagResourcesKeyMap defines key bindings
+type TagResourcesKeyMap struct {
+	Enter   key.Binding
+	Details key.Binding
+}
+
+// DefaultTagResourcesKeyMap returns default key bindings
+func DefaultTagResourcesKeyMap() TagResourcesKeyMap {
+	return TagResourcesKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "open list"),
+		),
+		Details: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "details"),
+		),
+	}
+}
+
+// NewTagResourcesModel creates a new tagged resources model for a tag
+func NewTagResourcesModel(tag service.TagPair) TagResourcesModel {
+	columns := []table.Column{
+		{Title: "Product", Width: 8},
+		{Title: "Resource ID", Width: 28},
+		{Title: "Region", Width: 16},
+		{Title: "Tags", Width: 60},
+	}
+
+	return TagResourcesModel{
+		table: components.NewTableModel(columns, fmt.Sprintf("Resources tagged %s", tag)),
+		tag:   tag,
+		keys:  DefaultTagResourcesKeyMap(),
+	}
+}
+
+// SetData sets the tagged resources data
+func (m TagResourcesModel) SetData(resources []service.TaggedResource) TagResourcesModel {
+	m.resources = resources
+
+	rows := make([]table.Row, len(resources))
+	rowData := make([]interface{}, len(resources))
+
+	for i, r := range resources {
+		tags := make([]string, len(r.Tags))
+		for j, t := range r.Tags {
+			tags[j] = t.Key + "=" + t.Value
+		}
+		rows[i] = table.Row{
+			r.Product,
+			r.ID,
+			r.Region,
+			strings.Join(tags, ", "),
+		}
+		rowData[i] = r
+	}
+
+	m.table = m.table.SetRows(rows)
+	m.table = m.table.SetRowData(rowData)
+	return m
+}
+
+// SetSize sets the size
+func (m TagResourcesModel) SetSize(width, height int) TagResourcesModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// Tag returns the tag whose resources are shown
+func (m TagResourcesModel) Tag() service.TagPair {
+	return m.tag
+}
+
+// SelectedResource returns the selected resource
+func (m TagResourcesModel) SelectedResource() *service.TaggedResource {
+	idx := m.table.SelectedRow()
+	if idx >= 0 && idx < len(m.resources) {
+		return &m.resources[idx]
+	}
+	return nil
+}
+
+// tagFilter returns a filter matching the shown resources
+func (m TagResourcesModel) tagFilter() *TagFilter {
+	ids := make(map[string]bool, len(m.resources))
+	for _, r := range m.resources {
+		ids[r.ID] = true
+	}
+	return &TagFilter{Key: m.tag.Key, Value: m.tag.Value, ResourceIDs: ids}
+}
+
+// Init implements tea.Model
+func (m TagResourcesModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m TagResourcesModel) Update(msg tea.Msg) (TagResourcesModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.keys.Enter):
+			// Open the product's list, filtered to the resources with the tag
+			if r := m.SelectedResource(); r != nil {
+				if page, ok := tagListPage(r.Product); ok {
+					filter := m.tagFilter()
+					return m, func() tea.Msg {
+						return OpenTaggedListMsg{Page: page, Filter: filter}
+					}
+				}
+			}
+
+		case key.Matches(msg, m.keys.Details):
+			if r := m.SelectedResource(); r != nil {
+				return m, func() tea.Msg {
+					return types.NavigateMsg{Page: types.PageTagDetail, Data: *r}
+				}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m TagResourcesModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m TagResourcesModel) Search(query string) TagResourcesModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m TagResourcesModel) Filter(query string) TagResourcesModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m TagResourcesModel) NextSearchMatch() TagResourcesModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m TagResourcesModel) PrevSearchMatch() TagResourcesModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
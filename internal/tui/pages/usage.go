@@ -0,0 +1,132 @@
+package pages
+
+import (
+	"fmt"
+	"sort"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/config"
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/tui/components"
+)
+
+// UsageModel represents the usage statistics page: the pages opened and
+// actions taken most, counted on this machine only
+type UsageModel struct {
+	table  components.TableModel
+	width  int
+	height int
+}
+
+// usageRow is a page or action with how often it was used
+type usageRow struct {
+	Kind  string `json:"kind"`
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// NewUsageModel creates a new usage statistics model
+func NewUsageModel() UsageModel {
+	columns := []components.ColumnSpec{
+		{Title: "Kind", Width: 8},
+		{Title: "Name", Width: 36},
+		{Title: "Count", Width: 8},
+		{Title: "Share", Width: 8, Format: components.FormatPercent},
+	}
+
+	return UsageModel{
+		table: components.NewTableModelFromSpecs(columns, i18n.T(i18n.KeyPageUsage)),
+	}
+}
+
+// SetData sets the usage statistics, most used first within pages and
+// within actions
+func (m UsageModel) SetData(stats *config.UsageStats) UsageModel {
+	var values [][]interface{}
+	var rowData []interface{}
+	for _, group := range []struct {
+		kind   string
+		counts map[string]int
+	}{
+		{"Page", stats.Pages},
+		{"Action", stats.Actions},
+	} {
+		total := 0
+		rows := make([]usageRow, 0, len(group.counts))
+		for name, count := range group.counts {
+			total += count
+			rows = append(rows, usageRow{Kind: group.kind, Name: name, Count: count})
+		}
+		sort.Slice(rows, func(i, j int) bool {
+			if rows[i].Count != rows[j].Count {
+				return rows[i].Count > rows[j].Count
+			}
+			return rows[i].Name < rows[j].Name
+		})
+
+		for _, r := range rows {
+			values = append(values, []interface{}{
+				r.Kind,
+				r.Name,
+				r.Count,
+				float64(r.Count) * 100 / float64(total),
+			})
+			rowData = append(rowData, r)
+		}
+	}
+
+	m.table = m.table.SetValues(values)
+	m.table = m.table.SetRowData(rowData)
+	m.table = m.table.SetTitle(fmt.Sprintf(i18n.T(i18n.KeyUsageTitle), stats.Since.Local().Format("2006-01-02")))
+	return m
+}
+
+// SetSize sets the size
+func (m UsageModel) SetSize(width, height int) UsageModel {
+	m.width = width
+	m.height = height
+	m.table = m.table.SetSize(width, height)
+	return m
+}
+
+// Init implements tea.Model
+func (m UsageModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m UsageModel) Update(msg tea.Msg) (UsageModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+// View implements tea.Model
+func (m UsageModel) View() string {
+	return m.table.View()
+}
+
+// Search searches in the list
+func (m UsageModel) Search(query string) UsageModel {
+	m.table = m.table.Search(query)
+	return m
+}
+
+// Filter hides rows that do not match the filter query
+func (m UsageModel) Filter(query string) UsageModel {
+	m.table = m.table.Filter(query)
+	return m
+}
+
+// NextSearchMatch moves to next search match
+func (m UsageModel) NextSearchMatch() UsageModel {
+	m.table = m.table.NextSearchMatch()
+	return m
+}
+
+// PrevSearchMatch moves to previous search match
+func (m UsageModel) PrevSearchMatch() UsageModel {
+	m.table = m.table.PrevSearchMatch()
+	return m
+}
@@ -0,0 +1,121 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+)
+
+// pageProducts maps the pages of the services to the product whose APIs
+// they call, so a permission error on a page marks its product
+var pageProducts = map[PageType]string{
+	PageECSList:                service.ProductECS,
+	PageECSDetail:              service.ProductECS,
+	PageECSJSONDetail:          service.ProductECS,
+	PageSecurityGroups:         service.ProductECS,
+	PageSecurityGroupRules:     service.ProductECS,
+	PageSecurityGroupInstances: service.ProductECS,
+	PageInstanceSecurityGroups: service.ProductECS,
+	PageNATList:                service.ProductVPC,
+	PageNATDetail:              service.ProductVPC,
+	PageSNATEntries:            service.ProductVPC,
+	PageDNATEntries:            service.ProductVPC,
+	PageDNSDomains:             service.ProductDNS,
+	PageDNSRecords:             service.ProductDNS,
+	PageSLBList:                service.ProductSLB,
+	PageSLBDetail:              service.ProductSLB,
+	PageSLBListeners:           service.ProductSLB,
+	PageSLBVServerGroups:       service.ProductSLB,
+	PageSLBBackendServers:      service.ProductSLB,
+	PageSLBForwardingRules:     service.ProductSLB,
+	PageALBList:                service.ProductALB,
+	PageALBDetail:              service.ProductALB,
+	PageALBListeners:           service.ProductALB,
+	PageALBRules:               service.ProductALB,
+	PageALBServerGroups:        service.ProductALB,
+	PageNLBList:                service.ProductNLB,
+	PageNLBDetail:              service.ProductNLB,
+	PageNLBListeners:           service.ProductNLB,
+	PageNLBServerGroups:        service.ProductNLB,
+	PageACKClusters:            service.ProductACK,
+	PageACKClusterDetail:       service.ProductACK,
+	PageACKNodePools:           service.ProductACK,
+	PageACKNodes:               service.ProductACK,
+	PageACRInstances:           service.ProductACR,
+	PageACRNamespaces:          service.ProductACR,
+	PageACRRepositories:        service.ProductACR,
+	PageACRTags:                service.ProductACR,
+	PageFCServices:             service.ProductFC,
+	PageFCFunctions:            service.ProductFC,
+	PageOSSBuckets:             service.ProductOSS,
+	PageOSSObjects:             service.ProductOSS,
+	PageRDSList:                service.ProductRDS,
+	PageRDSDetail:              service.ProductRDS,
+	PageRDSDatabases:           service.ProductRDS,
+	PageRDSAccounts:            service.ProductRDS,
+	PageRedisList:              service.ProductRedis,
+	PageRedisDetail:            service.ProductRedis,
+	PageRedisAccounts:          service.ProductRedis,
+	PageMongoDBList:            service.ProductMongoDB,
+	PageMongoDBAccounts:        service.ProductMongoDB,
+	PageElasticsearchList:      service.ProductElasticsearch,
+	PageKafkaList:              service.ProductKafka,
+	PageKafkaTopics:            service.ProductKafka,
+	PageKafkaGroups:            service.ProductKafka,
+	PageRocketMQList:           service.ProductRocketMQ,
+	PageRocketMQTopics:         service.ProductRocketMQ,
+	PageRocketMQGroups:         service.ProductRocketMQ,
+	PageRAMUsers:               service.ProductRAM,
+	PageRAMRoles:               service.ProductRAM,
+	PageRAMPolicies:            service.ProductRAM,
+	PageSLSProjects:            service.ProductSLS,
+	PageSLSLogtailConfigs:      service.ProductSLS,
+	PageSLSMachineGroups:       service.ProductSLS,
+	PageConfigRules:            service.ProductConfig,
+	PageConfigResults:          service.ProductConfig,
+	PageTagBrowser:             service.ProductTags,
+	PageTagResources:           service.ProductTags,
+	PageBilling:                service.ProductBilling,
+	PageBillingDetail:          service.ProductBilling,
+}
+
+// notePermissionError marks the product of the current page when err says
+// the profile has no permission for it. It reports whether the product was
+// already marked, so that the error needs no modal again and only shows on
+// the mode line
+func (m Model) notePermissionError(err error) (Model, bool) {
+	product, ok := pageProducts[m.currentPage]
+	if !ok || m.services == nil || !service.IsPermissionDenied(err) {
+		return m, false
+	}
+	if m.services.Permissions.Deny(product) {
+		return m, false
+	}
+	m.modeLine = m.modeLine.SetPageInfo(fmt.Sprintf(i18n.T(i18n.KeyNoPermissionFor), product))
+	return m, true
+}
+
+// refreshDeniedMarks marks the menu's services whose product the profile
+// turned out to have no permission for, whenever those products change
+func (m Model) refreshDeniedMarks() Model {
+	if m.services == nil {
+		return m
+	}
+	products := m.services.Permissions.Products()
+	key := m.profile + "|" + strings.Join(products, ",")
+	if key == m.deniedKey {
+		return m
+	}
+	m.deniedKey = key
+
+	denied := make(map[PageType]bool)
+	for page, product := range pageProducts {
+		if m.services.Permissions.Denied(product) {
+			denied[page] = true
+		}
+	}
+	m.menuPage = m.menuPage.SetDenied(denied)
+	return m
+}
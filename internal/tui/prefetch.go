@@ -0,0 +1,255 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+)
+
+const (
+	// prefetchConcurrency is how many prefetches run at once, so that they
+	// leave room for the page being opened
+	prefetchConcurrency = 2
+	// prefetchCount is how many of the most opened list pages are prefetched
+	prefetchCount = 3
+	// prefetchMaxAge is how old a prefetched page gets before showing the
+	// menu fetches it again
+	prefetchMaxAge = 2 * time.Minute
+)
+
+// prefetcher fetches the data of a list page and wraps it in the message the
+// page loads from
+type prefetcher struct {
+	fetch  func(context.Context, *Services) (any, error)
+	loaded func(any) tea.Msg
+}
+
+// newPrefetcher builds a prefetcher from a typed fetch function
+func newPrefetcher[T any](fetch func(context.Context, *Services) ([]T, error), loaded func([]T) tea.Msg) prefetcher {
+	return prefetcher{
+		fetch: func(ctx context.Context, s *Services) (any, error) {
+			return fetch(ctx, s)
+		},
+		loaded: func(data any) tea.Msg {
+			return loaded(data.([]T))
+		},
+	}
+}
+
+// prefetchOrder lists the pages that can be prefetched, in the order they
+// are picked among equally opened ones
+var prefetchOrder = []PageType{PageECSList, PageSLBList, PageRDSList, PageRedisList}
+
+var prefetchers = map[PageType]prefetcher{
+	PageECSList: newPrefetcher(
+		func(ctx context.Context, s *Services) ([]ecs.Instance, error) { return s.ECS.FetchInstances(ctx) },
+		func(instances []ecs.Instance) tea.Msg { return ECSInstancesLoadedMsg{Instances: instances} }),
+	PageSLBList: newPrefetcher(
+		func(ctx context.Context, s *Services) ([]slb.LoadBalancer, error) { return s.SLB.FetchInstances(ctx) },
+		func(lbs []slb.LoadBalancer) tea.Msg { return SLBInstancesLoadedMsg{LoadBalancers: lbs} }),
+	PageRDSList: newPrefetcher(
+		func(ctx context.Context, s *Services) ([]service.RDSInstanceDetail, error) {
+			return s.RDS.FetchDetailedInstances(ctx)
+		},
+		func(instances []service.RDSInstanceDetail) tea.Msg {
+			return RDSDetailedInstancesLoadedMsg{Instances: instances}
+		}),
+	PageRedisList: newPrefetcher(
+		func(ctx context.Context, s *Services) ([]r_kvstore.KVStoreInstance, error) {
+			return s.Redis.FetchInstances(ctx)
+		},
+		func(instances []r_kvstore.KVStoreInstance) tea.Msg {
+			return RedisInstancesLoadedMsg{Instances: instances}
+		}),
+}
+
+// prefetchEntry is the data of a prefetched page
+type prefetchEntry struct {
+	data      any
+	fetchedAt time.Time
+}
+
+// prefetchCache holds prefetched list pages by profile, region and page. One
+// cache is shared by every copy of the model
+type prefetchCache struct {
+	mu       sync.Mutex
+	entries  map[string]prefetchEntry
+	inFlight map[string]bool
+	slots    chan struct{} // Bounds the prefetches running at once
+}
+
+func newPrefetchCache() *prefetchCache {
+	return &prefetchCache{
+		entries:  make(map[string]prefetchEntry),
+		inFlight: make(map[string]bool),
+		slots:    make(chan struct{}, prefetchConcurrency),
+	}
+}
+
+// prefetchKey identifies a page of a profile and region in the cache
+func prefetchKey(profile, region string, page PageType) string {
+	return profile + "|" + region + "|" + page.String()
+}
+
+// get returns the cached data of a page
+func (c *prefetchCache) get(key string) (prefetchEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	return entry, ok
+}
+
+// begin marks a page as being fetched, unless it already is or its data is
+// younger than maxAge. It reports whether the caller should fetch it
+func (c *prefetchCache) begin(key string, maxAge time.Duration) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.inFlight[key] {
+		return false
+	}
+	if entry, ok := c.entries[key]; ok && time.Since(entry.fetchedAt) < maxAge {
+		return false
+	}
+	c.inFlight[key] = true
+	return true
+}
+
+// finish stores the data of a fetched page; a failed fetch keeps the old data
+func (c *prefetchCache) finish(key string, data any, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.inFlight, key)
+	if err == nil {
+		c.entries[key] = prefetchEntry{data: data, fetchedAt: time.Now()}
+	}
+}
+
+// drop removes the data of one page
+func (c *prefetchCache) drop(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+// invalidate drops the pages of a profile and region, or of every region of
+// the profile when region is ""
+func (c *prefetchCache) invalidate(profile, region string) {
+	prefix := profile + "|"
+	if region != "" {
+		prefix += region + "|"
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// PagePrefetchedMsg reports a list page fetched in the background
+type PagePrefetchedMsg struct {
+	Key  string
+	Page PageType
+	Data any
+	Err  error
+}
+
+// prefetchPage creates a command fetching a list page into the cache, once a
+// prefetch slot is free
+func prefetchPage(ctx context.Context, cache *prefetchCache, services *Services, key string, page PageType) tea.Cmd {
+	p := prefetchers[page]
+	return func() tea.Msg {
+		cache.slots <- struct{}{}
+		data, err := p.fetch(ctx, services)
+		<-cache.slots
+
+		cache.finish(key, data, err)
+		return PagePrefetchedMsg{Key: key, Page: page, Data: data, Err: err}
+	}
+}
+
+// prefetchTargets returns the prefetchCount list pages opened most
+func (m Model) prefetchTargets() []PageType {
+	targets := slices.Clone(prefetchOrder)
+	sort.SliceStable(targets, func(i, j int) bool {
+		return m.usage.Pages[targets[i].String()] > m.usage.Pages[targets[j].String()]
+	})
+	return targets[:prefetchCount]
+}
+
+// startPrefetch fetches the most opened list pages of the current profile and
+// region in the background while the menu shows, unless they were fetched
+// recently. All Regions lists are not prefetched
+func (m Model) startPrefetch() tea.Cmd {
+	if m.currentPage != PageMenu || m.allRegions {
+		return nil
+	}
+	var cmds []tea.Cmd
+	for _, page := range m.prefetchTargets() {
+		key := prefetchKey(m.profile, m.region, page)
+		if m.prefetch.begin(key, prefetchMaxAge) {
+			cmds = append(cmds, prefetchPage(m.sessionCtx(), m.prefetch, m.services, key, page))
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// loadOrServePrefetched returns the command loading a list page: load, or
+// when the page was prefetched its cached data at once, marked with its age
+// in the mode line, while it is fetched again in the background
+func (m Model) loadOrServePrefetched(page PageType, load tea.Cmd) (Model, tea.Cmd) {
+	key := prefetchKey(m.profile, m.region, page)
+	entry, ok := m.prefetch.get(key)
+	if !ok {
+		return m, load
+	}
+
+	cached := prefetchers[page].loaded(entry.data)
+	m.staleKey = key
+	m.modeLine = m.modeLine.SetPageInfo(fmt.Sprintf(i18n.T(i18n.KeyPrefetchStale), time.Since(entry.fetchedAt).Round(time.Second)))
+
+	// A prefetch still running revalidates the page when it lands. Otherwise
+	// the page is fetched past the service cache, which holds the same data
+	var revalidate tea.Cmd
+	if m.prefetch.begin(key, 0) {
+		m.services.Cache.Drop(cachedPages[page].resource)
+		revalidate = prefetchPage(m.sessionCtx(), m.prefetch, m.services, key, page)
+	}
+	return m, tea.Batch(func() tea.Msg { return cached }, revalidate)
+}
+
+// handlePagePrefetched replaces the cached data of the page being shown with
+// the fetched data. Other prefetches only fill the cache
+func (m Model) handlePagePrefetched(msg PagePrefetchedMsg) (tea.Model, tea.Cmd) {
+	if msg.Key != m.staleKey {
+		return m, nil
+	}
+	m.staleKey = ""
+	if m.currentPage != msg.Page {
+		return m, nil
+	}
+
+	if msg.Err != nil {
+		if entry, ok := m.prefetch.get(msg.Key); ok {
+			m.modeLine = m.modeLine.SetPageInfo(fmt.Sprintf(i18n.T(i18n.KeyPrefetchRefreshFailed), time.Since(entry.fetchedAt).Round(time.Second), msg.Err))
+		}
+		return m, nil
+	}
+	m.modeLine = m.modeLine.SetPageInfo("")
+	fresh := prefetchers[msg.Page].loaded(msg.Data)
+	return m, func() tea.Msg { return fresh }
+}
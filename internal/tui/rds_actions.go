@@ -0,0 +1,47 @@
+package tui
+
+import (
+	"fmt"
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/pages"
+)
+
+// Action IDs for RDS modals
+const (
+	actionRDSSlowLogRange = "rds.slow_log_range"
+)
+
+// handleRDSSlowLogRangeRequest opens the picker of slow log date ranges
+func (m Model) handleRDSSlowLogRangeRequest(msg pages.RDSSlowLogRangeRequestMsg) (Model, tea.Cmd) {
+	options := make([]components.SelectOption, len(service.RDSSlowLogRanges))
+	for i, days := range service.RDSSlowLogRanges {
+		label := fmt.Sprintf(i18n.T(i18n.KeyRDSSlowLogRangeDays), days)
+		if days == 1 {
+			label = i18n.T(i18n.KeyRDSSlowLogRangeToday)
+		}
+		options[i] = components.SelectOption{Value: strconv.Itoa(days), Label: label}
+	}
+	m.modal = components.NewSelectModal(actionRDSSlowLogRange,
+		fmt.Sprintf(i18n.T(i18n.KeyRDSSlowLogRangeTitle), msg.InstanceID),
+		options, strconv.Itoa(msg.Days), msg)
+	return m, nil
+}
+
+// handleRDSSlowLogRangeSelected reloads the slow log page for the picked range
+func (m Model) handleRDSSlowLogRangeSelected(msg components.OptionSelectedMsg) (Model, tea.Cmd) {
+	req, ok := msg.Data.(pages.RDSSlowLogRangeRequestMsg)
+	days, err := strconv.Atoi(msg.Value)
+	if !ok || err != nil || days == req.Days || m.currentPage != PageRDSSlowLog {
+		return m, nil
+	}
+	m.rdsSlowLogPage = pages.NewRDSSlowLogModel(req.InstanceID, days)
+	m.rdsSlowLogPage = m.rdsSlowLogPage.SetSize(m.width, m.height-1)
+	m.loading = true
+	return m, LoadRDSSlowLogs(m.loadCtx(), m.services.RDS, req.InstanceID, days)
+}
@@ -0,0 +1,47 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/config"
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/pages"
+)
+
+// isMutatingRequest reports whether msg is a page's request to start an
+// action that changes cloud resources. Read-only mode refuses these, so no
+// form, picker or confirmation of a change ever opens
+func isMutatingRequest(msg tea.Msg) bool {
+	switch msg.(type) {
+	case pages.SecurityGroupRuleActionMsg,
+		pages.DNSRecordActionMsg,
+		pages.DNSImportApplyRequestMsg,
+		pages.SLBBackendAddRequestMsg,
+		pages.SLBCertRotateRequestMsg,
+		pages.MaintenanceWindowRequestMsg,
+		pages.RecycleRestoreRequestMsg,
+		pages.OSSCopyRequestMsg,
+		pages.OSSArchiveRestoreRequestMsg,
+		pages.OSSRestoreVersionRequestMsg,
+		pages.OSSUndeleteRequestMsg:
+		return true
+	}
+	return false
+}
+
+// refuseInReadOnly shows why a change was not started
+func (m Model) refuseInReadOnly() (tea.Model, tea.Cmd) {
+	m.modal = components.NewErrorModal(i18n.T(i18n.KeyReadOnlyRefused))
+	return m, nil
+}
+
+// applyReadOnly turns read-only mode on for a loaded profile that sets it,
+// or for every profile with --read-only, and shows it in the header and
+// mode line
+func (m Model) applyReadOnly(cfg *config.Config) Model {
+	m.readOnly = m.readOnlyFlag || cfg.ReadOnly
+	m.header = m.header.SetReadOnly(m.readOnly)
+	m.modeLine = m.modeLine.SetReadOnly(m.readOnly)
+	return m
+}
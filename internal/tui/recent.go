@@ -0,0 +1,191 @@
+package tui
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/dds"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+
+	"aliyun-tui-viewer/internal/client"
+	"aliyun-tui-viewer/internal/config"
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/pages"
+)
+
+// actionRecentOpen is the action ID of the recently viewed resources picker
+const actionRecentOpen = "recent.open"
+
+// recentDetailPages maps the type of a recently viewed resource to the
+// detail page it is reopened on
+var recentDetailPages = map[string]PageType{
+	"ECS":           PageECSDetail,
+	"SLB":           PageSLBDetail,
+	"RDS":           PageRDSDetail,
+	"Redis":         PageRedisDetail,
+	"RocketMQ":      PageRocketMQDetail,
+	"MongoDB":       PageMongoDBDetail,
+	"Elasticsearch": PageElasticsearchDetail,
+	"Kafka":         PageKafkaDetail,
+}
+
+// recentResourceOf describes the resource shown by a detail page, false for
+// data no detail page is recorded for
+func recentResourceOf(data interface{}) (config.RecentResource, bool) {
+	switch v := data.(type) {
+	case ecs.Instance:
+		return config.RecentResource{Type: "ECS", ID: v.InstanceId, Name: v.InstanceName, Region: v.RegionId}, true
+	case slb.LoadBalancer:
+		return config.RecentResource{Type: "SLB", ID: v.LoadBalancerId, Name: v.LoadBalancerName, Region: v.RegionId}, true
+	case rds.DBInstance:
+		return config.RecentResource{Type: "RDS", ID: v.DBInstanceId, Name: v.DBInstanceDescription, Region: v.RegionId}, true
+	case r_kvstore.KVStoreInstance:
+		return config.RecentResource{Type: "Redis", ID: v.InstanceId, Name: v.InstanceName, Region: v.RegionId}, true
+	case service.RocketMQInstance:
+		return config.RecentResource{Type: "RocketMQ", ID: v.InstanceId, Name: v.InstanceName, Region: v.RegionId}, true
+	case dds.DBInstance:
+		return config.RecentResource{Type: "MongoDB", ID: v.DBInstanceId, Name: v.DBInstanceDescription, Region: v.RegionId}, true
+	case service.ElasticsearchInstance:
+		return config.RecentResource{Type: "Elasticsearch", ID: v.InstanceId, Name: v.Description, Region: v.RegionId}, true
+	case alikafka.InstanceVO:
+		return config.RecentResource{Type: "Kafka", ID: v.InstanceId, Name: v.Name, Region: v.RegionId}, true
+	}
+	return config.RecentResource{}, false
+}
+
+// recordRecent adds the resource a detail page was opened for to the
+// recently viewed history
+func (m Model) recordRecent(page PageType, data interface{}) Model {
+	item, ok := recentResourceOf(data)
+	if !ok || recentDetailPages[item.Type] != page {
+		return m
+	}
+	if item.Region == "" {
+		item.Region = m.region
+	}
+	item.Profile = m.profile
+	item.ViewedAt = time.Now()
+
+	m.recent.Add(item)
+	_ = m.recent.Save() // Ignore save errors
+	return m
+}
+
+// recentLabel is how a recently viewed resource is listed in the picker
+func recentLabel(item config.RecentResource) string {
+	return fmt.Sprintf("%-13s %s  %s  %s/%s", item.Type, item.Name, item.ID, item.Profile, item.Region)
+}
+
+// openRecentPicker offers the recently viewed resources to jump back to
+func (m Model) openRecentPicker() (Model, tea.Cmd) {
+	if len(m.recent.Items) == 0 {
+		m.modal = components.NewInfoModal(i18n.T(i18n.KeyRecentEmpty))
+		return m, nil
+	}
+
+	options := make([]components.SelectOption, len(m.recent.Items))
+	for i, item := range m.recent.Items {
+		options[i] = components.SelectOption{Value: strconv.Itoa(i), Label: recentLabel(item)}
+	}
+	m.modal = components.NewSelectModal(actionRecentOpen, i18n.T(i18n.KeyRecentPick), options, "", m.recent.Items)
+	return m, nil
+}
+
+// handleRecentSelected reopens the resource picked from the recent picker
+func (m Model) handleRecentSelected(msg components.OptionSelectedMsg) (Model, tea.Cmd) {
+	items, _ := msg.Data.([]config.RecentResource)
+	i, err := strconv.Atoi(msg.Value)
+	if err != nil || i < 0 || i >= len(items) {
+		return m, nil
+	}
+	return m.handleRecentOpenRequest(pages.RecentOpenRequestMsg{Item: items[i]})
+}
+
+// handleRecentOpenRequest switches to the profile and region a resource was
+// viewed in when they differ from the current ones, then loads it afresh
+func (m Model) handleRecentOpenRequest(msg pages.RecentOpenRequestMsg) (Model, tea.Cmd) {
+	item := msg.Item
+	if _, ok := recentDetailPages[item.Type]; !ok {
+		return m, nil
+	}
+
+	if item.Profile != m.profile {
+		next, err := m.useProfile(item.Profile, item.Region)
+		if err != nil {
+			m.modal = components.NewErrorModal(fmt.Sprintf(i18n.T(i18n.KeyRecentSwitchFailed), item.Profile, item.Region, err))
+			return m, nil
+		}
+		m = next
+	} else if item.Region != m.region || m.allRegions {
+		next, err := m.switchRegion(item.Region)
+		if err != nil {
+			m.modal = components.NewErrorModal(fmt.Sprintf(i18n.T(i18n.KeyRecentSwitchFailed), item.Profile, item.Region, err))
+			return m, nil
+		}
+		m = next
+	}
+
+	m.loading = true
+	return m, LoadRecentResource(m.loadCtx(), m.services, item)
+}
+
+// handleRecentResourceLoaded opens the detail page of a reloaded resource
+func (m Model) handleRecentResourceLoaded(msg RecentResourceLoadedMsg) (Model, tea.Cmd) {
+	m.loading = false
+	if msg.Data == nil {
+		m.modal = components.NewErrorModal(fmt.Sprintf(i18n.T(i18n.KeyRecentGone),
+			msg.Item.Type, msg.Item.ID, msg.Item.Profile, msg.Item.Region))
+		return m, nil
+	}
+	return m.navigateTo(recentDetailPages[msg.Item.Type], msg.Data)
+}
+
+// useProfile points clients and services at a profile and region without
+// making the profile current in the config file, clears the cached pages
+// and returns to the menu
+func (m Model) useProfile(profile, region string) (Model, error) {
+	cfg, err := config.LoadProfileConfig(profile, region)
+	if err != nil {
+		return m, err
+	}
+
+	newClients, err := client.NewAliyunClients(client.NewProfileConfig(cfg))
+	if err != nil {
+		return m, err
+	}
+
+	// Update clients and recreate services
+	m.clients = newClients
+	m.services = buildServices(newClients)
+	m.finderService = newFinderService(m.services)
+
+	// Clear cached data first
+	m = m.clearCachedData()
+
+	// Update profile, region and mode line AFTER clearing cache
+	m.profile = cfg.Profile
+	m.region = cfg.RegionID
+	m.allRegions = false
+	m.header = m.header.SetProfile(cfg.Profile).SetRole(cfg.AssumedRole()).SetRegion(cfg.RegionID).SetTitle(i18n.T(i18n.KeyAppTitle))
+	m.modeLine = m.modeLine.SetProfile(cfg.Profile).SetRegion(cfg.RegionID)
+	m = m.applyReadOnly(cfg)
+
+	// Update region service for new profile (cache is per-profile)
+	m.regionService = service.NewRegionService(client.TeaCredential(newClients.GetConfig().Credentials), cfg.Profile)
+
+	// Set page state
+	m.currentPage = PageMenu
+	m.leaveAllPages()
+	m.previousPages = []PageType{}
+	m.hasAlternate = false
+	return m, nil
+}
@@ -0,0 +1,42 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/pages"
+)
+
+// Action IDs for recycle bin modals
+const (
+	actionRecycleCancelRelease = "recycle.cancel_release"
+)
+
+// handleRecycleRestoreRequest asks for confirmation before cancelling a
+// scheduled release. Expired instances are only kept by renewing them, which
+// is charged, so for those it points to the console instead
+func (m Model) handleRecycleRestoreRequest(msg pages.RecycleRestoreRequestMsg) (Model, tea.Cmd) {
+	r := msg.Resource
+	if !r.Cancellable {
+		m.modal = components.NewInfoModal(fmt.Sprintf(i18n.T(i18n.KeyRecycleRenewInConsole), r.Product, r.ID, r.Reason))
+		return m, nil
+	}
+	m.modal = components.NewConfirmModal(actionRecycleCancelRelease,
+		fmt.Sprintf(i18n.T(i18n.KeyRecycleConfirmCancel), r.ID, r.Name, r.Time),
+		r)
+	return m, nil
+}
+
+// handleECSReleaseCancelled reports a cancelled release and lists the recycle
+// bin again, which no longer includes the instance
+func (m Model) handleECSReleaseCancelled(msg ECSReleaseCancelledMsg) (Model, tea.Cmd) {
+	m.modal = components.NewSuccessModal(fmt.Sprintf(i18n.T(i18n.KeyRecycleReleaseCancelled), msg.InstanceId))
+	m.invalidatePage(PageECSList)
+	if m.currentPage == PageRecycleBin {
+		return m, LoadRecycleBin(m.loadCtx(), m.services.ECS, m.services.RDS)
+	}
+	return m, nil
+}
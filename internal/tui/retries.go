@@ -0,0 +1,49 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/client"
+	"aliyun-tui-viewer/internal/i18n"
+)
+
+// loadingTickInterval is how often the loading view checks for API calls
+// being retried
+const loadingTickInterval = 500 * time.Millisecond
+
+// loadingTickMsg redraws the loading view while a page loads
+type loadingTickMsg struct{}
+
+// scheduleLoadingTick starts the loading view's ticker unless it is already
+// running
+func (m Model) scheduleLoadingTick() (Model, tea.Cmd) {
+	if m.loadingTicking {
+		return m, nil
+	}
+	m.loadingTicking = true
+	return m, tea.Tick(loadingTickInterval, func(time.Time) tea.Msg {
+		return loadingTickMsg{}
+	})
+}
+
+// handleLoadingTick keeps ticking until the page has loaded
+func (m Model) handleLoadingTick() (Model, tea.Cmd) {
+	m.loadingTicking = false
+	if !m.loading {
+		return m, nil
+	}
+	return m.scheduleLoadingTick()
+}
+
+// loadingText is the loading view's message, telling when a throttled or
+// failed call is being retried
+func loadingText() string {
+	text := i18n.T(i18n.KeyActionLoading)
+	if status, ok := client.Retrying(); ok {
+		text += "\n" + fmt.Sprintf(i18n.T(i18n.KeyActionRetrying), status.Attempt, status.Max)
+	}
+	return text
+}
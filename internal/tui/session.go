@@ -0,0 +1,138 @@
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/config"
+)
+
+// loadSession loads the configuration and pages of the session saved on the
+// last quit. A non-empty profile or region overrides the session's own; the
+// detail page it was left on is only reopened in the same profile and region.
+// Without a saved session it starts as usual
+func loadSession(profile, region string) (*config.Config, []PageType, *config.RecentResource, error) {
+	session := config.LoadState().Session
+	if session == nil {
+		cfg, err := config.LoadProfileConfig(profile, region)
+		return cfg, nil, nil, err
+	}
+
+	if profile == "" {
+		profile = session.Profile
+	}
+	if region == "" {
+		region = session.Region
+	}
+	cfg, err := config.LoadProfileConfig(profile, region)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var pageList []PageType
+	for _, name := range session.Pages {
+		if page, ok := workspacePageNames[name]; ok {
+			pageList = append(pageList, page)
+		}
+	}
+
+	resource := session.Resource
+	if resource != nil && (resource.Profile != cfg.Profile || resource.Region != cfg.RegionID) {
+		resource = nil
+	}
+	return cfg, pageList, resource, nil
+}
+
+// SaveSession saves where the program's final model was left, for --resume
+// to restore on the next start
+func SaveSession(final tea.Model) {
+	m, ok := final.(Model)
+	if !ok {
+		return
+	}
+
+	state := config.LoadState()
+	state.Session = m.session()
+	_ = state.Save() // Ignore save errors
+}
+
+// session describes the current profile, region and back stack. The stack
+// is kept up to the first page that needs the page before it to reopen;
+// when that is a detail page reloadable by ID, its resource is kept too
+func (m Model) session() *config.Session {
+	s := &config.Session{Profile: m.profile, Region: m.region, SavedAt: time.Now()}
+
+	stack := append(append([]PageType{}, m.previousPages...), m.currentPage)
+	for _, page := range stack {
+		if page == PageMenu {
+			continue
+		}
+		if name, ok := workspacePageName(page); ok {
+			s.Pages = append(s.Pages, name)
+			continue
+		}
+		if item, ok := m.detailResource(page); ok {
+			s.Resource = &item
+		}
+		break
+	}
+	return s
+}
+
+// workspacePageName returns the workspace page name opening page, the first
+// in sorted order when it has aliases
+func workspacePageName(page PageType) (string, bool) {
+	for _, name := range workspacePageNameList() {
+		if workspacePageNames[name] == page {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// detailResource returns the recently viewed resource shown by a detail page
+func (m Model) detailResource(page PageType) (config.RecentResource, bool) {
+	var typ, id string
+	switch page {
+	case PageECSDetail:
+		typ, id = "ECS", m.ecsDetailPage.InstanceID()
+	case PageSLBDetail:
+		typ, id = "SLB", m.slbDetailPage.LoadBalancerID()
+	case PageRDSDetail:
+		typ, id = "RDS", m.rdsDetailPage.InstanceID()
+	case PageRedisDetail:
+		typ, id = "Redis", m.redisDetailPage.InstanceID()
+	case PageRocketMQDetail:
+		typ, id = "RocketMQ", m.rocketmqDetailPage.InstanceID()
+	case PageMongoDBDetail:
+		typ, id = "MongoDB", m.mongoDetailPage.InstanceID()
+	case PageElasticsearchDetail:
+		typ, id = "Elasticsearch", m.esDetailPage.InstanceID()
+	case PageKafkaDetail:
+		typ, id = "Kafka", m.kafkaDetailPage.InstanceID()
+	default:
+		return config.RecentResource{}, false
+	}
+
+	// Opening the page recorded it, with the region it was viewed in
+	for _, item := range m.recent.Items {
+		if item.Type == typ && item.ID == id && item.Profile == m.profile {
+			return item, true
+		}
+	}
+	return config.RecentResource{}, false
+}
+
+// openResumedResource reloads the detail page a resumed session was left on,
+// once the screen is sized and its list pages have finished loading
+func (m Model) openResumedResource() (Model, tea.Cmd) {
+	if m.width == 0 || m.loading || m.modal.Visible {
+		return m, nil
+	}
+
+	item := *m.resumeResource
+	m.resumeResource = nil
+	m.loading = true
+	return m, LoadRecentResource(m.loadCtx(), m.services, item)
+}
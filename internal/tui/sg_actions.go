@@ -0,0 +1,191 @@
+package tui
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/pages"
+)
+
+// Action IDs for security group rule modals
+const (
+	actionSGAddRule    = "sg.add_rule"
+	actionSGEditRule   = "sg.edit_rule"
+	actionSGRevokeRule = "sg.revoke_rule"
+)
+
+// sgRuleProtocols are the protocols accepted by the rule form
+var sgRuleProtocols = []string{"TCP", "UDP", "ICMP", "GRE", "ALL"}
+
+// sgRuleCidr returns the CIDR a rule allows, the source of an ingress rule
+// or the destination of an egress rule
+func sgRuleCidr(rule ecs.Permission) string {
+	if rule.Direction == "egress" {
+		return rule.DestCidrIp
+	}
+	return rule.SourceCidrIp
+}
+
+// sgRuleFormFields returns the form fields for adding or editing a rule
+func sgRuleFormFields(rule *ecs.Permission) []components.FormField {
+	fields := []components.FormField{
+		{Key: "direction", Label: i18n.T(i18n.KeyColDirection), Value: "ingress", Placeholder: "ingress, egress"},
+		{Key: "protocol", Label: i18n.T(i18n.KeyColProtocol), Value: "TCP", Placeholder: strings.Join(sgRuleProtocols, ", ")},
+		{Key: "ports", Label: i18n.T(i18n.KeyColPortRange), Placeholder: "22/22, 80, 8000/9000"},
+		{Key: "cidr", Label: i18n.T(i18n.KeyLabelCIDR), Placeholder: "0.0.0.0/0, 10.0.0.0/8"},
+		{Key: "policy", Label: i18n.T(i18n.KeyColPolicy), Value: "accept", Placeholder: "accept, drop"},
+		{Key: "priority", Label: i18n.T(i18n.KeyColPriority), Value: "1", Placeholder: "1-100"},
+		{Key: "description", Label: i18n.T(i18n.KeyColDescription)},
+	}
+	if rule != nil {
+		fields[0].Value = rule.Direction
+		fields[1].Value = rule.IpProtocol
+		fields[2].Value = rule.PortRange
+		fields[3].Value = sgRuleCidr(*rule)
+		fields[4].Value = strings.ToLower(rule.Policy)
+		fields[5].Value = rule.Priority
+		fields[6].Value = rule.Description
+	}
+	return fields
+}
+
+// parseSGRuleForm converts submitted form values into a rule input. A single
+// port is expanded to a range, and protocols without ports get -1/-1
+func parseSGRuleForm(values map[string]string) (service.SecurityGroupRuleInput, error) {
+	input := service.SecurityGroupRuleInput{
+		Direction:   strings.ToLower(values["direction"]),
+		IpProtocol:  strings.ToUpper(values["protocol"]),
+		PortRange:   values["ports"],
+		CidrIp:      values["cidr"],
+		Policy:      strings.ToLower(values["policy"]),
+		Priority:    values["priority"],
+		Description: values["description"],
+	}
+	if input.Direction == "" || input.IpProtocol == "" || input.CidrIp == "" {
+		return input, fmt.Errorf("%s", i18n.T(i18n.KeySGFieldRequired))
+	}
+	if input.Direction != "ingress" && input.Direction != "egress" {
+		return input, fmt.Errorf(i18n.T(i18n.KeySGInvalidDirection), values["direction"])
+	}
+	validProtocol := false
+	for _, p := range sgRuleProtocols {
+		validProtocol = validProtocol || input.IpProtocol == p
+	}
+	if !validProtocol {
+		return input, fmt.Errorf(i18n.T(i18n.KeySGInvalidProtocol), values["protocol"])
+	}
+
+	switch {
+	case input.IpProtocol == "ICMP" || input.IpProtocol == "GRE" || input.IpProtocol == "ALL":
+		if input.PortRange == "" {
+			input.PortRange = "-1/-1"
+		}
+	case !strings.Contains(input.PortRange, "/"):
+		input.PortRange = input.PortRange + "/" + input.PortRange
+	}
+	if input.PortRange != "-1/-1" {
+		start, end, _ := strings.Cut(input.PortRange, "/")
+		from, err1 := strconv.Atoi(start)
+		to, err2 := strconv.Atoi(end)
+		if err1 != nil || err2 != nil || from < 1 || to > 65535 || from > to {
+			return input, fmt.Errorf(i18n.T(i18n.KeySGInvalidPortRange), values["ports"])
+		}
+	}
+
+	if _, _, err := net.ParseCIDR(input.CidrIp); err != nil && net.ParseIP(input.CidrIp) == nil {
+		return input, fmt.Errorf(i18n.T(i18n.KeySGInvalidCIDR), input.CidrIp)
+	}
+	if input.Policy == "" {
+		input.Policy = "accept"
+	}
+	if input.Policy != "accept" && input.Policy != "drop" {
+		return input, fmt.Errorf(i18n.T(i18n.KeySGInvalidPolicy), values["policy"])
+	}
+	if input.Priority != "" {
+		n, err := strconv.Atoi(input.Priority)
+		if err != nil || n < 1 || n > 100 {
+			return input, fmt.Errorf(i18n.T(i18n.KeySGInvalidPriority), input.Priority)
+		}
+	}
+	return input, nil
+}
+
+// sgRuleEdit is the form data of an edited rule
+type sgRuleEdit struct {
+	SecurityGroupId string
+	Rule            ecs.Permission
+}
+
+// handleSGRuleAction opens the modal for a write action requested from the
+// security group rules page
+func (m Model) handleSGRuleAction(msg pages.SecurityGroupRuleActionMsg) (Model, tea.Cmd) {
+	switch msg.Action {
+	case pages.SecurityGroupRuleAdd:
+		m.modal = components.NewFormModal(actionSGAddRule,
+			fmt.Sprintf("%s - %s", i18n.T(i18n.KeySGAddRule), msg.SecurityGroupId),
+			sgRuleFormFields(nil), msg.SecurityGroupId)
+
+	case pages.SecurityGroupRuleEdit:
+		if msg.Rule == nil {
+			return m, nil
+		}
+		if sgRuleCidr(*msg.Rule) == "" {
+			m.modal = components.NewErrorModal(i18n.T(i18n.KeySGGroupRuleReadOnly))
+			return m, nil
+		}
+		m.modal = components.NewFormModal(actionSGEditRule,
+			fmt.Sprintf("%s - %s", i18n.T(i18n.KeySGEditRule), msg.Rule.SecurityGroupRuleId),
+			sgRuleFormFields(msg.Rule), sgRuleEdit{SecurityGroupId: msg.SecurityGroupId, Rule: *msg.Rule})
+
+	case pages.SecurityGroupRuleRevoke:
+		if msg.Rule == nil {
+			return m, nil
+		}
+		target := sgRuleCidr(*msg.Rule)
+		if target == "" {
+			target = msg.Rule.SourceGroupId + msg.Rule.DestGroupId + msg.Rule.SourcePrefixListId + msg.Rule.DestPrefixListId
+		}
+		m.modal = components.NewConfirmModal(actionSGRevokeRule,
+			fmt.Sprintf(i18n.T(i18n.KeySGConfirmRevoke),
+				msg.Rule.Direction, msg.Rule.IpProtocol, msg.Rule.PortRange, target, msg.Rule.Policy),
+			sgRuleEdit{SecurityGroupId: msg.SecurityGroupId, Rule: *msg.Rule})
+	}
+
+	return m, nil
+}
+
+// handleSGFormSubmitted runs the add/modify command for a submitted rule form
+func (m Model) handleSGFormSubmitted(msg components.FormSubmittedMsg) (Model, tea.Cmd) {
+	input, err := parseSGRuleForm(msg.Values)
+	if err != nil {
+		m.modal = components.NewErrorModal(err.Error())
+		return m, nil
+	}
+
+	switch msg.ID {
+	case actionSGAddRule:
+		if securityGroupId, ok := msg.Data.(string); ok {
+			m.loading = true
+			return m, AddSecurityGroupRule(m.services.ECS, securityGroupId, input)
+		}
+	case actionSGEditRule:
+		if edit, ok := msg.Data.(sgRuleEdit); ok {
+			if input.Direction != edit.Rule.Direction {
+				m.modal = components.NewErrorModal(i18n.T(i18n.KeySGDirectionFixed))
+				return m, nil
+			}
+			m.loading = true
+			return m, ModifySecurityGroupRule(m.services.ECS, edit.SecurityGroupId, edit.Rule.SecurityGroupRuleId, input)
+		}
+	}
+	return m, nil
+}
@@ -0,0 +1,343 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/pages"
+)
+
+// Action IDs for SLB backend modals
+const (
+	actionSLBBackendLB    = "slb.backend_lb"
+	actionSLBBackendGroup = "slb.backend_group"
+	actionSLBBackendAdd   = "slb.backend_add"
+	actionSLBCertPick     = "slb.cert_pick"
+	actionSLBCertUpload   = "slb.cert_upload"
+	actionSLBCertBind     = "slb.cert_bind"
+)
+
+// slbUploadCertOption is the certificate picker's value for uploading one
+const slbUploadCertOption = "+upload"
+
+// certExpiryWarning is how close to its expiry a certificate is flagged
+const certExpiryWarning = 30 * 24 * time.Hour
+
+// slbNewGroupOption is the group picker's value for creating a new group
+const slbNewGroupOption = "+new"
+
+// Defaults of the backend form, the SLB console's
+const (
+	defaultSLBBackendPort   = "80"
+	defaultSLBBackendWeight = "100"
+)
+
+// handleSLBBackendAddRequest starts adding ECS backends to an SLB, with the
+// instances marked in the ECS list when the request has none. Without an SLB
+// the SLB instances are loaded to pick from
+func (m Model) handleSLBBackendAddRequest(msg pages.SLBBackendAddRequestMsg) (Model, tea.Cmd) {
+	if len(msg.Instances) == 0 {
+		msg.Instances = m.ecsListPage.MarkedInstances()
+	}
+	if len(msg.Instances) == 0 {
+		m.modal = components.NewErrorModal(i18n.T(i18n.KeySLBBackendNoneMarked))
+		return m, nil
+	}
+	if msg.LoadBalancerID != "" {
+		return m.openSLBBackendForm(msg), nil
+	}
+	m.loading = true
+	return m, LoadSLBBackendTargets(m.loadCtx(), m.services.SLB, msg)
+}
+
+// handleSLBBackendTargetsLoaded offers the SLB instances to add backends to
+func (m Model) handleSLBBackendTargetsLoaded(msg SLBBackendTargetsLoadedMsg) (Model, tea.Cmd) {
+	m.loading = false
+	if len(msg.LoadBalancers) == 0 {
+		m.modal = components.NewErrorModal(i18n.T(i18n.KeySLBBackendNoSLB))
+		return m, nil
+	}
+	options := make([]components.SelectOption, len(msg.LoadBalancers))
+	for i, lb := range msg.LoadBalancers {
+		options[i] = components.SelectOption{
+			Value: lb.LoadBalancerId,
+			Label: fmt.Sprintf("%s  %s  %s", lb.LoadBalancerId, lb.Address, lb.LoadBalancerName),
+		}
+	}
+	m.modal = components.NewSelectModal(actionSLBBackendLB,
+		fmt.Sprintf(i18n.T(i18n.KeySLBBackendPickSLB), len(msg.Request.Instances)),
+		options, "", msg.Request)
+	return m, nil
+}
+
+// handleSLBBackendLBSelected loads the VServer groups of the picked SLB
+func (m Model) handleSLBBackendLBSelected(msg components.OptionSelectedMsg) (Model, tea.Cmd) {
+	req, ok := msg.Data.(pages.SLBBackendAddRequestMsg)
+	if !ok {
+		return m, nil
+	}
+	req.LoadBalancerID = msg.Value
+	m.loading = true
+	return m, LoadSLBBackendGroups(m.loadCtx(), m.services.SLB, req)
+}
+
+// handleSLBBackendGroupsLoaded offers the VServer groups of the SLB, after
+// the option to create a new one
+func (m Model) handleSLBBackendGroupsLoaded(msg SLBBackendGroupsLoadedMsg) (Model, tea.Cmd) {
+	m.loading = false
+	options := []components.SelectOption{{Value: slbNewGroupOption, Label: i18n.T(i18n.KeySLBBackendNewGroup)}}
+	for _, group := range msg.VServerGroups {
+		options = append(options, components.SelectOption{
+			Value: group.VServerGroupId,
+			Label: fmt.Sprintf("%s  %s", group.VServerGroupId, group.VServerGroupName),
+		})
+	}
+	m.modal = components.NewSelectModal(actionSLBBackendGroup,
+		fmt.Sprintf(i18n.T(i18n.KeySLBBackendPickGroup), msg.Request.LoadBalancerID),
+		options, slbNewGroupOption, msg)
+	return m, nil
+}
+
+// handleSLBBackendGroupSelected asks for the port and weight of the backends
+// in the picked group
+func (m Model) handleSLBBackendGroupSelected(msg components.OptionSelectedMsg) (Model, tea.Cmd) {
+	loaded, ok := msg.Data.(SLBBackendGroupsLoadedMsg)
+	if !ok {
+		return m, nil
+	}
+	req := loaded.Request
+	for _, group := range loaded.VServerGroups {
+		if group.VServerGroupId == msg.Value {
+			req.VServerGroupID, req.VServerGroupName = group.VServerGroupId, group.VServerGroupName
+		}
+	}
+	return m.openSLBBackendForm(req), nil
+}
+
+// openSLBBackendForm asks for the port and weight of the backends, and the
+// name of the group when it is new
+func (m Model) openSLBBackendForm(req pages.SLBBackendAddRequestMsg) Model {
+	fields := []components.FormField{
+		{Key: "port", Label: i18n.T(i18n.KeySLBBackendPort), Value: defaultSLBBackendPort, Placeholder: "1-65535"},
+		{Key: "weight", Label: i18n.T(i18n.KeySLBBackendWeight), Value: defaultSLBBackendWeight, Placeholder: "0-100"},
+	}
+	group := req.VServerGroupID
+	if req.VServerGroupName != "" {
+		group = fmt.Sprintf("%s / %s", req.VServerGroupID, req.VServerGroupName)
+	}
+	title := fmt.Sprintf(i18n.T(i18n.KeySLBBackendAddTitle), len(req.Instances), group)
+	if req.VServerGroupID == "" {
+		fields = append([]components.FormField{{Key: "name", Label: i18n.T(i18n.KeySLBBackendGroupName)}}, fields...)
+		title = fmt.Sprintf(i18n.T(i18n.KeySLBBackendCreateTitle), req.LoadBalancerID, len(req.Instances))
+	}
+	m.modal = components.NewFormModal(actionSLBBackendAdd, title, fields, req)
+	return m
+}
+
+// handleSLBBackendAddSubmitted registers the instances with the entered port
+// and weight, creating the group first when it is new
+func (m Model) handleSLBBackendAddSubmitted(msg components.FormSubmittedMsg) (Model, tea.Cmd) {
+	req, ok := msg.Data.(pages.SLBBackendAddRequestMsg)
+	if !ok {
+		return m, nil
+	}
+	name := strings.TrimSpace(msg.Values["name"])
+	if req.VServerGroupID == "" && name == "" {
+		m.modal = components.NewErrorModal(i18n.T(i18n.KeySLBBackendNameRequired))
+		return m, nil
+	}
+	port, err := strconv.Atoi(strings.TrimSpace(msg.Values["port"]))
+	if err != nil || port < 1 || port > 65535 {
+		m.modal = components.NewErrorModal(fmt.Sprintf(i18n.T(i18n.KeySLBBackendBadPort), msg.Values["port"]))
+		return m, nil
+	}
+	weight, err := strconv.Atoi(strings.TrimSpace(msg.Values["weight"]))
+	if err != nil || weight < 0 || weight > 100 {
+		m.modal = components.NewErrorModal(fmt.Sprintf(i18n.T(i18n.KeySLBBackendBadWeight), msg.Values["weight"]))
+		return m, nil
+	}
+
+	backends := make([]service.VServerBackend, len(req.Instances))
+	for i, inst := range req.Instances {
+		backends[i] = service.VServerBackend{ServerID: inst.InstanceId, Port: port, Weight: weight}
+	}
+	m.loading = true
+	return m, AddSLBBackends(m.services.SLB, req, name, backends)
+}
+
+// handleSLBBackendsAdded reports the registered backends, clears the ECS
+// marks and refreshes the VServer groups page when it shows the SLB
+func (m Model) handleSLBBackendsAdded(msg SLBBackendsAddedMsg) (Model, tea.Cmd) {
+	m.loading = false
+	format := i18n.KeySLBBackendsAdded
+	if msg.Created {
+		format = i18n.KeySLBBackendGroupCreated
+	}
+	m.modal = components.NewSuccessModal(fmt.Sprintf(i18n.T(format), msg.Count, msg.VServerGroupID, msg.LoadBalancerID))
+	m.ecsListPage = m.ecsListPage.ClearMarks()
+	m.invalidatePage(PageSLBList)
+
+	if m.currentPage == PageSLBVServerGroups && m.slbVServerPage.LoadBalancerID() == msg.LoadBalancerID {
+		m.loading = true
+		return m, LoadSLBVServerGroups(m.loadCtx(), m.services.SLB, msg.LoadBalancerID)
+	}
+	return m, nil
+}
+
+// slbCertBind is the data of the bind confirmation
+type slbCertBind struct {
+	request     pages.SLBCertRotateRequestMsg
+	certificate slb.ServerCertificate
+}
+
+// certExpiryLabel describes when a certificate expires, flagged when it
+// has expired or expires within certExpiryWarning
+func certExpiryLabel(cert slb.ServerCertificate) string {
+	expiry := service.CertificateExpiry(cert)
+	if expiry.IsZero() {
+		return "-"
+	}
+	left := time.Until(expiry)
+	switch {
+	case left <= 0:
+		return fmt.Sprintf(i18n.T(i18n.KeySLBCertExpired), expiry.Format("2006-01-02"))
+	case left < certExpiryWarning:
+		return fmt.Sprintf(i18n.T(i18n.KeySLBCertExpiresSoon), expiry.Format("2006-01-02"), int(left.Hours()/24))
+	default:
+		return fmt.Sprintf(i18n.T(i18n.KeySLBCertExpires), expiry.Format("2006-01-02"), int(left.Hours()/24))
+	}
+}
+
+// handleSLBCertRotateRequest loads the server certificates to bind an HTTPS
+// listener to
+func (m Model) handleSLBCertRotateRequest(msg pages.SLBCertRotateRequestMsg) (Model, tea.Cmd) {
+	if msg.Listener.Protocol != "HTTPS" {
+		m.modal = components.NewErrorModal(i18n.T(i18n.KeySLBCertHTTPSOnly))
+		return m, nil
+	}
+	m.loading = true
+	return m, LoadSLBCertificates(m.loadCtx(), m.services.SLB, msg)
+}
+
+// handleSLBCertificatesLoaded offers the certificates, latest expiring first,
+// after the option to upload a new one
+func (m Model) handleSLBCertificatesLoaded(msg SLBCertificatesLoadedMsg) (Model, tea.Cmd) {
+	m.loading = false
+	certs := append([]slb.ServerCertificate{}, msg.Certificates...)
+	sort.SliceStable(certs, func(i, j int) bool {
+		return service.CertificateExpiry(certs[i]).After(service.CertificateExpiry(certs[j]))
+	})
+
+	options := []components.SelectOption{{Value: slbUploadCertOption, Label: i18n.T(i18n.KeySLBCertUploadOption)}}
+	for _, cert := range certs {
+		label := fmt.Sprintf("%s  %s  %s  %s", cert.ServerCertificateId, cert.ServerCertificateName, cert.CommonName, certExpiryLabel(cert))
+		if cert.ServerCertificateId == msg.Request.Listener.ServerCertificateId {
+			label += "  " + i18n.T(i18n.KeySLBCertCurrent)
+		}
+		options = append(options, components.SelectOption{Value: cert.ServerCertificateId, Label: label})
+	}
+	m.modal = components.NewSelectModal(actionSLBCertPick,
+		fmt.Sprintf(i18n.T(i18n.KeySLBCertPick), msg.Request.Listener.Port, msg.Request.LoadBalancerID),
+		options, slbUploadCertOption, SLBCertificatesLoadedMsg{Request: msg.Request, Certificates: certs})
+	return m, nil
+}
+
+// handleSLBCertPicked asks for the certificate to upload, or confirms
+// binding the listener to the picked one
+func (m Model) handleSLBCertPicked(msg components.OptionSelectedMsg) (Model, tea.Cmd) {
+	loaded, ok := msg.Data.(SLBCertificatesLoadedMsg)
+	if !ok {
+		return m, nil
+	}
+	if msg.Value == slbUploadCertOption {
+		m.modal = components.NewFormModal(actionSLBCertUpload,
+			fmt.Sprintf(i18n.T(i18n.KeySLBCertUploadTitle), loaded.Request.Listener.Port, loaded.Request.LoadBalancerID),
+			[]components.FormField{
+				{Key: "name", Label: i18n.T(i18n.KeySLBCertName), Placeholder: i18n.T(i18n.KeySLBCertNameHint)},
+				{Key: "certificate", Label: i18n.T(i18n.KeySLBCertCertificate), Placeholder: i18n.T(i18n.KeySLBCertPEMHint)},
+				{Key: "key", Label: i18n.T(i18n.KeySLBCertPrivateKey), Placeholder: i18n.T(i18n.KeySLBCertPEMHint)},
+			},
+			loaded.Request)
+		return m, nil
+	}
+	for _, cert := range loaded.Certificates {
+		if cert.ServerCertificateId == msg.Value {
+			return m.confirmSLBCertBind(loaded.Request, cert), nil
+		}
+	}
+	return m, nil
+}
+
+// handleSLBCertUploadSubmitted checks that the certificate and key match,
+// then uploads them
+func (m Model) handleSLBCertUploadSubmitted(msg components.FormSubmittedMsg) (Model, tea.Cmd) {
+	req, ok := msg.Data.(pages.SLBCertRotateRequestMsg)
+	if !ok {
+		return m, nil
+	}
+	certPEM, err := service.ReadPEMInput(msg.Values["certificate"])
+	if err != nil {
+		m.modal = components.NewErrorModal(err.Error())
+		return m, nil
+	}
+	keyPEM, err := service.ReadPEMInput(msg.Values["key"])
+	if err != nil {
+		m.modal = components.NewErrorModal(err.Error())
+		return m, nil
+	}
+	bundle, err := service.LoadCertificateBundle(certPEM, keyPEM)
+	if err != nil {
+		m.modal = components.NewErrorModal(err.Error())
+		return m, nil
+	}
+
+	// Named after the domain and its expiry by default, e.g. example.com-20270101
+	name := strings.TrimSpace(msg.Values["name"])
+	if name == "" {
+		name = fmt.Sprintf("%s-%s", strings.ReplaceAll(bundle.Leaf.Subject.CommonName, "*", "wildcard"), bundle.Leaf.NotAfter.Format("20060102"))
+	}
+	m.loading = true
+	return m, UploadSLBCertificate(m.services.SLB, req, name, bundle)
+}
+
+// handleSLBCertUploaded confirms binding the listener to the uploaded
+// certificate
+func (m Model) handleSLBCertUploaded(msg SLBCertUploadedMsg) (Model, tea.Cmd) {
+	m.loading = false
+	return m.confirmSLBCertBind(msg.Request, msg.Certificate), nil
+}
+
+// confirmSLBCertBind asks before the listener serves cert, with its expiry
+func (m Model) confirmSLBCertBind(req pages.SLBCertRotateRequestMsg, cert slb.ServerCertificate) Model {
+	current := req.Listener.ServerCertificateId
+	if current == "" {
+		current = "-"
+	}
+	text := fmt.Sprintf(i18n.T(i18n.KeySLBCertBindConfirm),
+		req.Listener.Port, req.LoadBalancerID, cert.ServerCertificateId, cert.CommonName, certExpiryLabel(cert), current)
+	m.modal = components.NewConfirmModal(actionSLBCertBind, text, slbCertBind{request: req, certificate: cert})
+	return m
+}
+
+// handleSLBCertBound reports the new certificate and reloads the listeners
+// when they show the SLB
+func (m Model) handleSLBCertBound(msg SLBCertBoundMsg) (Model, tea.Cmd) {
+	m.loading = false
+	m.modal = components.NewSuccessModal(fmt.Sprintf(i18n.T(i18n.KeySLBCertBound), msg.Port, msg.LoadBalancerID, msg.CertificateID))
+	m.invalidatePage(PageSLBList)
+	if m.currentPage == PageSLBListeners && m.slbListenersPage.GetLoadBalancerId() == msg.LoadBalancerID {
+		m.loading = true
+		return m, LoadSLBListeners(m.loadCtx(), m.services.SLB, msg.LoadBalancerID)
+	}
+	return m, nil
+}
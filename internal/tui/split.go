@@ -0,0 +1,232 @@
+package tui
+
+import (
+	"github.com/charmbracelet/lipgloss"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/theme"
+	"aliyun-tui-viewer/internal/tui/pages"
+)
+
+// minSplitWidth is the narrowest terminal the split view divides; below it
+// the list keeps the whole width
+const minSplitWidth = 100
+
+// toggleSplit turns the split view on or off: the list on the left and a
+// preview of its highlighted row on the right
+func (m Model) toggleSplit() Model {
+	m.split = !m.split
+	m.previewData = nil
+	m.preview = pages.DetailModel{}
+	return m.fitSplit()
+}
+
+// splitShown reports whether the current page shows the split view
+func (m Model) splitShown() bool {
+	if !m.split || m.width < minSplitWidth {
+		return false
+	}
+	_, ok := m.previewSelection()
+	return ok
+}
+
+// splitWidths returns the widths of the list and of the preview
+func (m Model) splitWidths() (int, int) {
+	left := m.width / 2
+	return left, m.width - left
+}
+
+// fitSplit sizes the current page to the left pane while the split view
+// shows and to the whole width otherwise
+func (m Model) fitSplit() Model {
+	width := m.width
+	if m.splitShown() {
+		width, _ = m.splitWidths()
+	}
+	// The pages are sized from m.width, so it is narrowed for the call
+	sized := m
+	sized.width = width
+	sized = sized.updateCurrentPageSize(m.height - 1)
+	sized.width = m.width
+	return sized
+}
+
+// refreshPreview keeps the split view's preview on the highlighted row of
+// the list, and the list in the left pane
+func (m Model) refreshPreview() Model {
+	if !m.splitShown() {
+		return m
+	}
+	m = m.fitSplit()
+
+	data, _ := m.previewSelection()
+	_, width := m.splitWidths()
+	if data != m.previewData || m.previewWidth != width {
+		m.previewData = data
+		m.previewWidth = width
+		m.preview = pages.NewDetailModel(i18n.T(i18n.KeySplitPreview), data).SetSize(width, m.height-1)
+	}
+	return m
+}
+
+// splitView renders content, the current list, next to the preview
+func (m Model) splitView(content string) string {
+	left, right := m.splitWidths()
+	preview := m.preview.View()
+	if m.previewData == nil {
+		preview = lipgloss.NewStyle().
+			Foreground(theme.Current().MutedText).
+			Padding(1, 2).
+			Render(i18n.T(i18n.KeySplitNothing))
+	}
+	return lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		lipgloss.NewStyle().Width(left).MaxWidth(left).Render(content),
+		lipgloss.NewStyle().Width(right).MaxWidth(right).Render(preview),
+	)
+}
+
+// previewSelection returns the highlighted row of the current list page for
+// the preview, nil when the list is empty. It reports false on pages without
+// a preview
+func (m Model) previewSelection() (interface{}, bool) {
+	switch m.currentPage {
+	case PageECSList:
+		return previewOf(m.ecsListPage.SelectedInstance()), true
+	case PageECSDisks:
+		return previewOf(m.ecsDiskPage.SelectedDisk()), true
+	case PageECSNetworkInterfaces:
+		return previewOf(m.ecsENIPage.SelectedENI()), true
+	case PageSecurityGroups:
+		return previewOf(m.sgListPage.SelectedSecurityGroup()), true
+	case PageSecurityGroupRules:
+		return previewOf(m.sgRulesPage.SelectedRule()), true
+	case PageSecurityGroupInstances:
+		return previewOf(m.sgInstancesPage.SelectedInstance()), true
+	case PageInstanceSecurityGroups:
+		return previewOf(m.instSGPage.SelectedSecurityGroup()), true
+	case PageDNSDomains:
+		return previewOf(m.dnsDomainsPage.SelectedDomain()), true
+	case PageDNSRecords:
+		return previewOf(m.dnsRecordsPage.SelectedRecord()), true
+	case PageSLBList:
+		return previewOf(m.slbListPage.SelectedLoadBalancer()), true
+	case PageSLBListeners:
+		return previewOf(m.slbListenersPage.SelectedListener()), true
+	case PageSLBVServerGroups:
+		return previewOf(m.slbVServerPage.SelectedVServerGroup()), true
+	case PageSLBForwardingRules:
+		return previewOf(m.slbForwardingRulesPage.SelectedRule()), true
+	case PageSLBDefaultServers:
+		return previewOf(m.slbDefaultServersPage.SelectedServer()), true
+	case PageOSSBuckets:
+		return previewOf(m.ossBucketsPage.SelectedBucket()), true
+	case PageOSSObjects:
+		return previewOf(m.ossObjectsPage.SelectedObject()), true
+	case PageRDSList:
+		return previewOf(m.rdsListPage.SelectedInstance()), true
+	case PageRedisList:
+		return previewOf(m.redisListPage.SelectedInstance()), true
+	case PageRocketMQList:
+		return previewOf(m.rocketmqListPage.SelectedInstance()), true
+	case PageRocketMQTopics:
+		return previewOf(m.rocketmqTopicsPage.SelectedTopic()), true
+	case PageRocketMQGroups:
+		return previewOf(m.rocketmqGroupsPage.SelectedGroup()), true
+	case PageJobs:
+		return previewOf(m.jobsPage.SelectedJob()), true
+	case PageRAMUsers:
+		return previewOf(m.ramUsersPage.SelectedUser()), true
+	case PageRAMRoles:
+		return previewOf(m.ramRolesPage.SelectedRole()), true
+	case PageRAMPolicies:
+		return previewOf(m.ramPoliciesPage.SelectedPolicy()), true
+	case PageRAMUserPolicies:
+		return previewOf(m.ramUserPoliciesPage.SelectedPolicy()), true
+	case PageSLSProjects:
+		return previewOf(m.slsProjectsPage.SelectedProject()), true
+	case PageSLSLogtailConfigs:
+		return previewOf(m.slsConfigsPage.SelectedConfig()), true
+	case PageSLSMachineGroups:
+		return previewOf(m.slsGroupsPage.SelectedGroup()), true
+	case PageSLSMachines:
+		return previewOf(m.slsMachinesPage.SelectedMachine()), true
+	case PageSLSCoverage:
+		return previewOf(m.slsCoveragePage.SelectedCoverage()), true
+	case PageConfigRules:
+		return previewOf(m.configRulesPage.SelectedRule()), true
+	case PageConfigResults:
+		return previewOf(m.configResultsPage.SelectedResult()), true
+	case PageTagBrowser:
+		return previewOf(m.tagBrowserPage.SelectedTag()), true
+	case PageTagResources:
+		return previewOf(m.tagResourcesPage.SelectedResource()), true
+	case PageDNSDangling:
+		return previewOf(m.dnsDanglingPage.SelectedRecord()), true
+	case PageRAMRolePolicies:
+		return previewOf(m.ramRolePoliciesPage.SelectedPolicy()), true
+	case PageNATList:
+		return previewOf(m.natListPage.SelectedGateway()), true
+	case PageDNATEntries:
+		return previewOf(m.dnatEntriesPage.SelectedEntry()), true
+	case PageOSSObjectVersions:
+		return previewOf(m.ossVersionsPage.SelectedVersion()), true
+	case PageALBList:
+		return previewOf(m.albListPage.SelectedLoadBalancer()), true
+	case PageALBListeners:
+		return previewOf(m.albListenersPage.SelectedListener()), true
+	case PageALBRules:
+		return previewOf(m.albRulesPage.SelectedRule()), true
+	case PageALBServerGroups:
+		return previewOf(m.albServerGroupsPage.SelectedServerGroup()), true
+	case PageNLBList:
+		return previewOf(m.nlbListPage.SelectedLoadBalancer()), true
+	case PageNLBListeners:
+		return previewOf(m.nlbListenersPage.SelectedListener()), true
+	case PageNLBServerGroups:
+		return previewOf(m.nlbServerGroupsPage.SelectedServerGroup()), true
+	case PageACKClusters:
+		return previewOf(m.ackClustersPage.SelectedCluster()), true
+	case PageACKNodePools:
+		return previewOf(m.ackNodePoolsPage.SelectedNodePool()), true
+	case PageACKNodes:
+		return previewOf(m.ackNodesPage.SelectedNode()), true
+	case PageOSSObjectScan:
+		return previewOf(m.ossScanPage.SelectedObject()), true
+	case PageACRInstances:
+		return previewOf(m.acrInstancesPage.SelectedInstance()), true
+	case PageFCServices:
+		return previewOf(m.fcServicesPage.SelectedService()), true
+	case PageFCFunctions:
+		return previewOf(m.fcFunctionsPage.SelectedFunction()), true
+	case PageMongoDBList:
+		return previewOf(m.mongoListPage.SelectedInstance()), true
+	case PageElasticsearchList:
+		return previewOf(m.esListPage.SelectedInstance()), true
+	case PageKafkaList:
+		return previewOf(m.kafkaListPage.SelectedInstance()), true
+	case PageRecycleBin:
+		return previewOf(m.recycleBinPage.SelectedResource()), true
+	case PageOSSDeletedObjects:
+		return previewOf(m.ossDeletedPage.SelectedMarker()), true
+	case PageRedisBackups:
+		return previewOf(m.redisBackupsPage.SelectedBackup()), true
+	case PageRDSBackups:
+		return previewOf(m.rdsBackupsPage.SelectedBackup()), true
+	case PageRDSBinlogs:
+		return previewOf(m.rdsBinlogsPage.SelectedFile()), true
+	case PageRDSSlowLog:
+		return previewOf(m.rdsSlowLogPage.SelectedSummary()), true
+	case PageAPITrace:
+		return previewOf(m.apiTracePage.SelectedCall()), true
+	}
+	return nil, false
+}
+
+// previewOf returns a highlighted row for the preview, nil when there is none
+func previewOf[T any](item *T) interface{} {
+	if item == nil {
+		return nil
+	}
+	return item
+}
@@ -2,32 +2,8 @@ package tui
 
 import (
 	"github.com/charmbracelet/lipgloss"
-)
 
-// Color palette
-var (
-	// Primary colors
-	PrimaryColor   = lipgloss.Color("#7C3AED") // Purple
-	SecondaryColor = lipgloss.Color("#06B6D4") // Cyan
-	AccentColor    = lipgloss.Color("#F59E0B") // Amber
-
-	// Status colors
-	SuccessColor = lipgloss.Color("#10B981") // Green
-	WarningColor = lipgloss.Color("#F59E0B") // Amber
-	ErrorColor   = lipgloss.Color("#EF4444") // Red
-	InfoColor    = lipgloss.Color("#3B82F6") // Blue
-
-	// Neutral colors
-	TextColor       = lipgloss.Color("#E5E7EB") // Light gray
-	SubtleTextColor = lipgloss.Color("#9CA3AF") // Gray
-	MutedTextColor  = lipgloss.Color("#6B7280") // Dark gray
-	BorderColor     = lipgloss.Color("#374151") // Dark border
-	HighlightBg     = lipgloss.Color("#1F2937") // Highlight background
-
-	// Special colors
-	SelectedBg     = lipgloss.Color("#374151")
-	SearchMatchBg  = lipgloss.Color("#854D0E") // Dark yellow
-	CurrentMatchBg = lipgloss.Color("#CA8A04") // Bright yellow
+	"aliyun-tui-viewer/internal/theme"
 )
 
 // Styles contains all application styles
@@ -97,8 +73,9 @@ type Styles struct {
 	FocusedBorder lipgloss.Style
 }
 
-// DefaultStyles returns the default style set
+// DefaultStyles returns the style set of the current theme
 func DefaultStyles() *Styles {
+	th := theme.Current()
 	s := &Styles{}
 
 	// App container
@@ -107,193 +84,201 @@ func DefaultStyles() *Styles {
 	// Header and title
 	s.Title = lipgloss.NewStyle().
 		Bold(true).
-		Foreground(PrimaryColor).
+		Foreground(th.Primary).
 		MarginBottom(1)
 
 	s.Subtitle = lipgloss.NewStyle().
-		Foreground(SecondaryColor)
+		Foreground(th.Secondary)
 
 	s.Description = lipgloss.NewStyle().
-		Foreground(SubtleTextColor)
+		Foreground(th.SubtleText)
 
 	// Table styles
 	s.TableHeader = lipgloss.NewStyle().
 		Bold(true).
-		Foreground(AccentColor).
+		Foreground(th.Accent).
 		BorderStyle(lipgloss.NormalBorder()).
 		BorderBottom(true).
-		BorderForeground(BorderColor)
+		BorderForeground(th.Border)
 
 	s.TableCell = lipgloss.NewStyle().
-		Foreground(TextColor).
+		Foreground(th.Text).
 		Padding(0, 1)
 
 	s.TableSelectedRow = lipgloss.NewStyle().
-		Background(SelectedBg).
-		Foreground(TextColor).
+		Background(th.Selected).
+		Foreground(th.Text).
 		Bold(true)
 
 	s.TableBorder = lipgloss.NewStyle().
 		BorderStyle(lipgloss.RoundedBorder()).
-		BorderForeground(BorderColor)
+		BorderForeground(th.Border)
 
 	// List/Menu styles
 	s.MenuItem = lipgloss.NewStyle().
-		Foreground(TextColor).
+		Foreground(th.Text).
 		PaddingLeft(2)
 
 	s.MenuItemSelected = lipgloss.NewStyle().
-		Foreground(AccentColor).
+		Foreground(th.Accent).
 		Bold(true).
 		PaddingLeft(2)
 
 	s.MenuShortcut = lipgloss.NewStyle().
-		Foreground(PrimaryColor).
+		Foreground(th.Primary).
 		Bold(true)
 
 	// Detail view styles
 	s.JSONKey = lipgloss.NewStyle().
-		Foreground(SecondaryColor)
+		Foreground(th.Secondary)
 
 	s.JSONValue = lipgloss.NewStyle().
-		Foreground(TextColor)
+		Foreground(th.Text)
 
 	s.JSONString = lipgloss.NewStyle().
-		Foreground(SuccessColor)
+		Foreground(th.Success)
 
 	s.JSONNumber = lipgloss.NewStyle().
-		Foreground(AccentColor)
+		Foreground(th.Accent)
 
 	s.JSONBoolean = lipgloss.NewStyle().
-		Foreground(PrimaryColor)
+		Foreground(th.Primary)
 
 	s.JSONNull = lipgloss.NewStyle().
-		Foreground(MutedTextColor).
+		Foreground(th.MutedText).
 		Italic(true)
 
 	// Search styles
 	s.SearchBar = lipgloss.NewStyle().
 		BorderStyle(lipgloss.RoundedBorder()).
-		BorderForeground(PrimaryColor).
+		BorderForeground(th.Primary).
 		Padding(0, 1)
 
 	s.SearchMatch = lipgloss.NewStyle().
-		Background(SearchMatchBg).
-		Foreground(TextColor)
+		Background(th.SearchMatch).
+		Foreground(th.Text)
 
 	s.SearchCurrent = lipgloss.NewStyle().
-		Background(CurrentMatchBg).
-		Foreground(lipgloss.Color("#000000")).
+		Background(th.CurrentMatch).
+		Foreground(th.OnMatch).
 		Bold(true)
 
 	s.SearchLabel = lipgloss.NewStyle().
-		Foreground(AccentColor).
+		Foreground(th.Accent).
 		Bold(true)
 
 	s.SearchNoResult = lipgloss.NewStyle().
-		Foreground(ErrorColor).
+		Foreground(th.Error).
 		Italic(true)
 
 	// Mode line styles
 	s.ModeLine = lipgloss.NewStyle().
-		Background(HighlightBg).
-		Foreground(TextColor).
+		Background(th.Bar).
+		Foreground(th.Text).
 		Padding(0, 1)
 
 	s.ModeLineProfile = lipgloss.NewStyle().
-		Foreground(PrimaryColor).
+		Foreground(th.Primary).
 		Bold(true)
 
 	s.ModeLineHelp = lipgloss.NewStyle().
-		Foreground(SubtleTextColor)
+		Foreground(th.SubtleText)
 
 	s.ModeLineInfo = lipgloss.NewStyle().
-		Foreground(SecondaryColor)
+		Foreground(th.Secondary)
 
 	// Modal styles
 	s.ModalOverlay = lipgloss.NewStyle().
-		Background(lipgloss.Color("#000000"))
+		Background(th.Overlay)
 
 	s.ModalContent = lipgloss.NewStyle().
 		BorderStyle(lipgloss.RoundedBorder()).
-		BorderForeground(PrimaryColor).
+		BorderForeground(th.Primary).
 		Padding(1, 2).
-		Background(HighlightBg)
+		Background(th.Bar)
 
 	s.ModalTitle = lipgloss.NewStyle().
 		Bold(true).
-		Foreground(PrimaryColor).
+		Foreground(th.Primary).
 		MarginBottom(1)
 
 	s.ModalButton = lipgloss.NewStyle().
-		Foreground(TextColor).
-		Background(BorderColor).
+		Foreground(th.Text).
+		Background(th.Border).
 		Padding(0, 2).
 		MarginRight(1)
 
 	// Status styles
 	s.StatusSuccess = lipgloss.NewStyle().
-		Foreground(SuccessColor)
+		Foreground(th.Success)
 
 	s.StatusWarning = lipgloss.NewStyle().
-		Foreground(WarningColor)
+		Foreground(th.Warning)
 
 	s.StatusError = lipgloss.NewStyle().
-		Foreground(ErrorColor)
+		Foreground(th.Error)
 
 	s.StatusInfo = lipgloss.NewStyle().
-		Foreground(InfoColor)
+		Foreground(th.Info)
 
 	// Spinner/Loading
 	s.Spinner = lipgloss.NewStyle().
-		Foreground(PrimaryColor)
+		Foreground(th.Primary)
 
 	// Help
 	s.HelpKey = lipgloss.NewStyle().
-		Foreground(AccentColor).
+		Foreground(th.Accent).
 		Bold(true)
 
 	s.HelpDesc = lipgloss.NewStyle().
-		Foreground(SubtleTextColor)
+		Foreground(th.SubtleText)
 
 	s.HelpSep = lipgloss.NewStyle().
-		Foreground(MutedTextColor)
+		Foreground(th.MutedText)
 
 	// Border
 	s.Border = lipgloss.NewStyle().
 		BorderStyle(lipgloss.RoundedBorder()).
-		BorderForeground(BorderColor)
+		BorderForeground(th.Border)
 
 	s.FocusedBorder = lipgloss.NewStyle().
 		BorderStyle(lipgloss.RoundedBorder()).
-		BorderForeground(PrimaryColor)
+		BorderForeground(th.Primary)
 
 	return s
 }
 
-// GlobalStyles is the default style instance
-var GlobalStyles = DefaultStyles()
+// globalStyles caches the style instance of GlobalStyles
+var globalStyles *Styles
+
+// GlobalStyles returns the style instance of the current theme
+func GlobalStyles() *Styles {
+	if globalStyles == nil {
+		globalStyles = DefaultStyles()
+	}
+	return globalStyles
+}
 
 // Helper functions for common styling operations
 
 // RenderTitle renders a title with the default style
 func RenderTitle(title string) string {
-	return GlobalStyles.Title.Render(title)
+	return GlobalStyles().Title.Render(title)
 }
 
 // RenderError renders an error message
 func RenderError(msg string) string {
-	return GlobalStyles.StatusError.Render("Error: " + msg)
+	return GlobalStyles().StatusError.Render("Error: " + msg)
 }
 
 // RenderSuccess renders a success message
 func RenderSuccess(msg string) string {
-	return GlobalStyles.StatusSuccess.Render(msg)
+	return GlobalStyles().StatusSuccess.Render(msg)
 }
 
 // RenderInfo renders an info message
 func RenderInfo(msg string) string {
-	return GlobalStyles.StatusInfo.Render(msg)
+	return GlobalStyles().StatusInfo.Render(msg)
 }
 
 // CenterHorizontally centers content horizontally within the given width
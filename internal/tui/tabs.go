@@ -0,0 +1,144 @@
+package tui
+
+import (
+	"strconv"
+	"strings"
+
+	"aliyun-tui-viewer/internal/i18n"
+)
+
+// maxTabs is how many tabs can be open, one for each of alt+1 to alt+9
+const maxTabs = 9
+
+// tabCount returns how many tabs are open
+func (m Model) tabCount() int {
+	return max(1, len(m.tabs))
+}
+
+// newTab opens a tab on the main menu, with the profile and region of the
+// current one, and switches to it. The current tab keeps its pages and
+// navigation stack
+func (m Model) newTab() Model {
+	if m.loading || m.tabCount() >= maxTabs {
+		return m
+	}
+	m.leaveAllPages()
+	tabs := m.savedTabs()
+
+	m.currentPage = PageMenu
+	m.previousPages = []PageType{}
+	m.hasAlternate = false
+	m.emptyState = nil
+	m.finderSearch = nil
+	m.staleKey = ""
+	m.err = nil
+	m.header = m.header.SetTitle(i18n.T(i18n.KeyAppTitle))
+	m.modeLine = m.modeLine.SetPage(PageMenu)
+
+	m.tabs = append(tabs, Model{})
+	m.tab = len(m.tabs) - 1
+	return m.showTab()
+}
+
+// closeTab closes the current tab and switches to the one after it, or the
+// one before when it was the last. The only tab cannot be closed
+func (m Model) closeTab() Model {
+	if m.loading || m.tabCount() <= 1 {
+		return m
+	}
+	m.leaveAllPages()
+	tabs := m.savedTabs()
+	tabs = append(tabs[:m.tab], tabs[m.tab+1:]...)
+	target := min(m.tab, len(tabs)-1)
+
+	next := m.withSession(tabs[target])
+	next.tabs = tabs
+	next.tab = target
+	if len(tabs) == 1 {
+		next.tabs = nil
+		next.tab = 0
+	}
+	return next.showTab()
+}
+
+// switchTab switches to the tab at index as it was left. Like ctrl+^, it
+// waits for the current page to finish loading
+func (m Model) switchTab(index int) Model {
+	if m.loading || index == m.tab || index < 0 || index >= len(m.tabs) {
+		return m
+	}
+	// The calls of the tab being left are cancelled, so none reports into
+	// the pages of the other
+	m.leaveAllPages()
+	tabs := m.savedTabs()
+
+	next := m.withSession(tabs[index])
+	next.tabs = tabs
+	next.tab = index
+	return next.showTab()
+}
+
+// goToTab switches to the tab numbered by an alt+1 to alt+9 key
+func (m Model) goToTab(key string) Model {
+	n, _ := strconv.Atoi(strings.TrimPrefix(key, "alt+"))
+	return m.switchTab(n - 1)
+}
+
+// savedTabs returns a copy of the tabs with the current one saved in its
+// place, creating the list when the first tab is opened
+func (m Model) savedTabs() []Model {
+	tabs := append([]Model(nil), m.tabs...)
+	if len(tabs) == 0 {
+		tabs = []Model{{}}
+	}
+	saved := m
+	saved.tabs = nil
+	tabs[m.tab] = saved
+	return tabs
+}
+
+// withSession returns tab with the state of m that belongs to the whole
+// session rather than to a tab: the terminal, the overlays and the timers
+func (m Model) withSession(tab Model) Model {
+	tab.width, tab.height = m.width, m.height
+	tab.focused = m.focused
+	tab.modal = m.modal
+	tab.help = m.help
+	tab.columns = m.columns
+	tab.columnLayouts = m.columnLayouts
+	tab.jobsTicking = m.jobsTicking
+	tab.loadingTicking = m.loadingTicking
+	tab.traceTicking = m.traceTicking
+	tab.workspacePages = m.workspacePages
+	tab.resumeResource = m.resumeResource
+	tab.split = m.split
+	return tab
+}
+
+// showTab sizes the current tab to the terminal, which may have been resized
+// while it was in the background
+func (m Model) showTab() Model {
+	m.header = m.header.SetWidth(m.width)
+	m.modeLine = m.modeLine.SetWidth(m.width)
+	m.menuPage = m.menuPage.SetSize(m.width, m.height-3)
+	m.previewData = nil
+	return m.fitSplit()
+}
+
+// refreshTabs names the tabs of the tab bar after their current pages
+func (m Model) refreshTabs() Model {
+	if len(m.tabs) == 0 {
+		m.header = m.header.SetTabs(nil, 0)
+		return m
+	}
+	titles := make([]string, len(m.tabs))
+	for i, tab := range m.tabs {
+		page := tab.currentPage
+		if i == m.tab {
+			page = m.currentPage
+		}
+		titles[i] = m.getPageTitle(page)
+	}
+	m.header = m.header.SetTabs(titles, m.tab)
+	return m
+}
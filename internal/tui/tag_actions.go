@@ -0,0 +1,79 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/pages"
+)
+
+// Action IDs for tag modals
+const (
+	actionTagFilter = "tag.filter"
+)
+
+// handleTagFilterRequest prompts for the tag filtering the current list page
+func (m Model) handleTagFilterRequest(msg pages.TagFilterRequestMsg) (Model, tea.Cmd) {
+	value := ""
+	if msg.Current != nil {
+		value = msg.Current.String()
+	}
+
+	m.modal = components.NewFormModal(actionTagFilter,
+		fmt.Sprintf("%s - %s", i18n.T(i18n.KeyTagFilter), m.getPageTitle(m.currentPage)),
+		[]components.FormField{
+			{Key: "tag", Label: i18n.T(i18n.KeyTagFilterLabel), Value: value, Placeholder: "env=prod"},
+		},
+		m.currentPage)
+	return m, nil
+}
+
+// handleTagFilterSubmitted clears the tag filter of the page, or looks up the
+// resources carrying the entered tag
+func (m Model) handleTagFilterSubmitted(msg components.FormSubmittedMsg) (Model, tea.Cmd) {
+	page, ok := msg.Data.(PageType)
+	if !ok {
+		return m, nil
+	}
+
+	entered := strings.TrimSpace(msg.Values["tag"])
+	if entered == "" {
+		return m.setTagFilter(page, nil), nil
+	}
+	tagKey, tagValue, ok := strings.Cut(entered, "=")
+	tagKey = strings.TrimSpace(tagKey)
+	if !ok || tagKey == "" {
+		m.modal = components.NewErrorModal(fmt.Sprintf(i18n.T(i18n.KeyTagFilterInvalid), entered))
+		return m, nil
+	}
+
+	m.loading = true
+	return m, LoadTagFilter(m.loadCtx(), m.regionService, m.clients, m.services.Tags, page,
+		tagKey, strings.TrimSpace(tagValue), m.allRegions)
+}
+
+// setTagFilter applies a tag filter to a list page, nil clearing it
+func (m Model) setTagFilter(page PageType, filter *pages.TagFilter) Model {
+	switch page {
+	case PageECSList:
+		m.ecsListPage = m.ecsListPage.SetTagFilter(filter)
+	case PageRDSList:
+		m.rdsListPage = m.rdsListPage.SetTagFilter(filter)
+	case PageSLBList:
+		m.slbListPage = m.slbListPage.SetTagFilter(filter)
+	case PageRedisList:
+		m.redisListPage = m.redisListPage.SetTagFilter(filter)
+	}
+	return m
+}
+
+// openTaggedList opens a product's list page filtered to the resources of the
+// tag browser
+func (m Model) openTaggedList(msg pages.OpenTaggedListMsg) (Model, tea.Cmd) {
+	m, cmd := m.navigateTo(msg.Page, nil)
+	return m.setTagFilter(msg.Page, msg.Filter), cmd
+}
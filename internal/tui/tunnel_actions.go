@@ -0,0 +1,155 @@
+package tui
+
+import (
+	"fmt"
+	"net"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/i18n"
+	"aliyun-tui-viewer/internal/jobs"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/pages"
+)
+
+// Action IDs for port forward modals
+const (
+	actionPortForwardBastion = "tunnel.bastion"
+	actionPortForward        = "tunnel.forward"
+)
+
+// defaultBastionUser is the SSH user suggested for a bastion, the default
+// user of Alibaba Cloud Linux images
+const defaultBastionUser = "root"
+
+// bastionPick is the data of the bastion picker: the request and the
+// address of each offered instance
+type bastionPick struct {
+	request   pages.PortForwardRequestMsg
+	addresses map[string]string
+}
+
+// handlePortForwardRequest loads the ECS instances to pick the bastion from
+func (m Model) handlePortForwardRequest(msg pages.PortForwardRequestMsg) (Model, tea.Cmd) {
+	m.loading = true
+	return m, LoadBastions(m.loadCtx(), m.services.ECS, msg)
+}
+
+// handleBastionsLoaded offers the running instances with an address as
+// bastions, those with a public address first
+func (m Model) handleBastionsLoaded(msg BastionsLoadedMsg) (Model, tea.Cmd) {
+	m.loading = false
+	pick := bastionPick{request: msg.Request, addresses: make(map[string]string)}
+	var public, private []components.SelectOption
+	for _, inst := range msg.Instances {
+		address := service.InstanceAddress(inst)
+		if inst.Status != "Running" || address == "" {
+			continue
+		}
+		pick.addresses[inst.InstanceId] = address
+		option := components.SelectOption{
+			Value: inst.InstanceId,
+			Label: fmt.Sprintf("%s  %s  %s", address, inst.InstanceId, inst.InstanceName),
+		}
+		if len(inst.PublicIpAddress.IpAddress) > 0 || inst.EipAddress.IpAddress != "" {
+			public = append(public, option)
+		} else {
+			private = append(private, option)
+		}
+	}
+	options := append(public, private...)
+	if len(options) == 0 {
+		m.modal = components.NewErrorModal(i18n.T(i18n.KeyPortForwardNoBastion))
+		return m, nil
+	}
+
+	m.modal = components.NewSelectModal(actionPortForwardBastion,
+		fmt.Sprintf(i18n.T(i18n.KeyPortForwardBastion), msg.Request.InstanceID),
+		options, "", pick)
+	return m, nil
+}
+
+// handleBastionSelected asks for the local port, the endpoint and the SSH
+// login of the picked bastion. The local port defaults to the endpoint's
+func (m Model) handleBastionSelected(msg components.OptionSelectedMsg) (Model, tea.Cmd) {
+	pick, ok := msg.Data.(bastionPick)
+	if !ok {
+		return m, nil
+	}
+	localPort := ""
+	if _, port, err := net.SplitHostPort(pick.request.Endpoint); err == nil {
+		localPort = port
+	}
+
+	m.modal = components.NewFormModal(actionPortForward,
+		fmt.Sprintf(i18n.T(i18n.KeyPortForwardTitle), pick.request.InstanceID),
+		[]components.FormField{
+			{Key: "local_port", Label: i18n.T(i18n.KeyPortForwardLocalPort), Value: localPort},
+			{Key: "endpoint", Label: i18n.T(i18n.KeyPortForwardEndpoint), Value: pick.request.Endpoint, Placeholder: "host:port"},
+			{Key: "bastion", Label: i18n.T(i18n.KeyPortForwardBastionHost), Value: defaultBastionUser + "@" + pick.addresses[msg.Value]},
+		},
+		pick.request)
+	return m, nil
+}
+
+// handlePortForwardSubmitted opens the tunnel as a job, which the jobs page
+// shows and closes
+func (m Model) handlePortForwardSubmitted(msg components.FormSubmittedMsg) (Model, tea.Cmd) {
+	if _, ok := msg.Data.(pages.PortForwardRequestMsg); !ok {
+		return m, nil
+	}
+	localPort, err := strconv.Atoi(strings.TrimSpace(msg.Values["local_port"]))
+	if err != nil || localPort < 1 || localPort > 65535 {
+		m.modal = components.NewErrorModal(i18n.T(i18n.KeyPortForwardBadPort))
+		return m, nil
+	}
+	endpoint := strings.TrimSpace(msg.Values["endpoint"])
+	if _, _, err := net.SplitHostPort(endpoint); err != nil {
+		m.modal = components.NewErrorModal(i18n.T(i18n.KeyConnTestNoAddress))
+		return m, nil
+	}
+	bastion, bastionPort, ok := parseBastion(msg.Values["bastion"])
+	if !ok {
+		m.modal = components.NewErrorModal(i18n.T(i18n.KeyPortForwardBadBastion))
+		return m, nil
+	}
+	if err := service.CheckLocalPort(localPort); err != nil {
+		m.modal = components.NewErrorModal(fmt.Sprintf(i18n.T(i18n.KeyPortForwardPortBusy), localPort, err))
+		return m, nil
+	}
+	if _, err := exec.LookPath("ssh"); err != nil {
+		m.modal = components.NewErrorModal(i18n.T(i18n.KeyPortForwardNoSSH))
+		return m, nil
+	}
+
+	spec := service.TunnelSpec{LocalPort: localPort, Remote: endpoint, Bastion: bastion, BastionPort: bastionPort}
+	m, _, cmd := m.startJob(fmt.Sprintf(i18n.T(i18n.KeyJobTunnel), spec), jobs.UnitItems, runTunnel(m.tunnels, spec))
+	m.modeLine = m.modeLine.SetPageInfo(fmt.Sprintf(i18n.T(i18n.KeyPortForwardStarted), spec))
+	return m, cmd
+}
+
+// parseBastion splits a [user@]host[:port] bastion into the ssh destination
+// and its port, 0 when not given
+func parseBastion(value string) (string, int, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.ContainsAny(value, " \t") {
+		return "", 0, false
+	}
+	user, hostPort := "", value
+	if i := strings.LastIndex(value, "@"); i >= 0 {
+		user, hostPort = value[:i+1], value[i+1:]
+	}
+	host, portText, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return value, 0, hostPort != "" // No port given
+	}
+	port, err := strconv.Atoi(portText)
+	if err != nil || port < 1 || port > 65535 || host == "" {
+		return "", 0, false
+	}
+	return user + host, port, true
+}
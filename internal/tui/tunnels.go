@@ -0,0 +1,78 @@
+package tui
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/jobs"
+	"aliyun-tui-viewer/internal/service"
+)
+
+// tunnelSet keeps the ssh processes of open port forwards, so they can be
+// torn down when the TUI exits. It is safe for concurrent use
+type tunnelSet struct {
+	mu    sync.Mutex
+	procs map[*exec.Cmd]struct{}
+}
+
+// newTunnelSet creates an empty tunnel set
+func newTunnelSet() *tunnelSet {
+	return &tunnelSet{procs: make(map[*exec.Cmd]struct{})}
+}
+
+func (s *tunnelSet) add(cmd *exec.Cmd) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.procs[cmd] = struct{}{}
+}
+
+func (s *tunnelSet) remove(cmd *exec.Cmd) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.procs, cmd)
+}
+
+// closeAll kills the ssh process of every open tunnel
+func (s *tunnelSet) closeAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for cmd := range s.procs {
+		cmd.Process.Kill()
+	}
+	s.procs = make(map[*exec.Cmd]struct{})
+}
+
+// runTunnel holds a port forward open as a job until ssh exits or the job is
+// cancelled. A tunnel that fails, e.g. because the bastion refuses the key,
+// also reports ssh's error in a modal
+func runTunnel(set *tunnelSet, spec service.TunnelSpec) jobRunner {
+	return func(ctx context.Context, report jobs.Reporter) (tea.Msg, error) {
+		cmd := exec.CommandContext(ctx, "ssh", spec.SSHArgs()...)
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		if err := cmd.Start(); err != nil {
+			return ErrorMsg{Err: err}, err
+		}
+		set.add(cmd)
+		defer set.remove(cmd)
+
+		err := cmd.Wait()
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if err != nil {
+			if lines := strings.Split(strings.TrimSpace(stderr.String()), "\n"); lines[len(lines)-1] != "" {
+				err = fmt.Errorf("ssh: %s", lines[len(lines)-1])
+			}
+			err = fmt.Errorf("tunnel %s closed: %w", spec, err)
+			return ErrorMsg{Err: err}, err
+		}
+		return nil, nil
+	}
+}
@@ -26,6 +26,7 @@ const (
 	PageOSSBuckets
 	PageOSSObjects
 	PageOSSObjectDetail
+	PageOSSObjectPreview // Text content preview of an OSS object
 	PageRDSList
 	PageRDSDetail
 	PageRDSDatabases
@@ -38,6 +39,92 @@ const (
 	PageRocketMQTopics
 	PageRocketMQGroups
 	PageResourceFinder // Resource finder results page
+	PageJobs           // Background jobs page
+	PageRAMUsers
+	PageRAMRoles
+	PageRAMPolicies
+	PageRAMUserPolicies
+	PageRAMDetail
+	PageECSMetrics
+	PageRDSMetrics
+	PageSLSProjects
+	PageSLSLogtailConfigs
+	PageSLSMachineGroups
+	PageSLSMachines
+	PageSLSCoverage
+	PageSLSDetail
+	PageConfigRules
+	PageConfigResults
+	PageConfigDetail
+	PageTagBrowser
+	PageTagResources
+	PageTagDetail
+	PageBilling
+	PageBillingDetail
+	PageDNSDangling
+	PageRAMRolePolicies
+	PageNATList
+	PageNATDetail
+	PageSNATEntries
+	PageDNATEntries
+	PageOSSObjectVersions
+	PageALBList
+	PageALBDetail
+	PageALBListeners
+	PageALBRules
+	PageALBRuleDetail
+	PageALBServerGroups
+	PageNLBList
+	PageNLBDetail
+	PageNLBListeners
+	PageNLBServerGroups
+	PageLBServers
+	PageOSSBucketDetail
+	PageACKClusters
+	PageACKClusterDetail
+	PageACKNodePools
+	PageACKNodes
+	PageOSSObjectScan
+	PageACRInstances
+	PageACRNamespaces
+	PageACRRepositories
+	PageACRTags
+	PageACRTagDetail
+	PageFCServices
+	PageFCFunctions
+	PageFCFunctionDetail
+	PageSLBJSONDetail
+	PageMongoDBList
+	PageMongoDBDetail
+	PageMongoDBJSONDetail
+	PageMongoDBAccounts
+	PageRDSJSONDetail
+	PageRedisJSONDetail
+	PageRocketMQJSONDetail
+	PageElasticsearchList
+	PageElasticsearchDetail
+	PageElasticsearchJSONDetail
+	PageZoneCapacity
+	PageKafkaList
+	PageKafkaDetail
+	PageKafkaJSONDetail
+	PageKafkaTopics
+	PageKafkaGroups
+	PageRocketMQLag
+	PageRocketMQMessages
+	PageRedisParameters
+	PageRedisMetrics
+	PageRecycleBin
+	PageOSSDeletedObjects
+	PageRedisBackups
+	PageRDSBackups
+	PageRDSBinlogs
+	PageRDSSlowLog
+	PageChangelog
+	PageRecent
+	PageUsage
+	PageDNSImport
+	PageAPITrace
 )
 
 // String returns the string representation of PageType
@@ -87,6 +174,8 @@ func (p PageType) String() string {
 		return "OSS Objects"
 	case PageOSSObjectDetail:
 		return "OSS Object Detail"
+	case PageOSSObjectPreview:
+		return "OSS Object Preview"
 	case PageRDSList:
 		return "RDS Instances"
 	case PageRDSDetail:
@@ -111,6 +200,178 @@ func (p PageType) String() string {
 		return "RocketMQ Groups"
 	case PageResourceFinder:
 		return "Resource Finder"
+	case PageJobs:
+		return "Background Jobs"
+	case PageRAMUsers:
+		return "RAM Users"
+	case PageRAMRoles:
+		return "RAM Roles"
+	case PageRAMPolicies:
+		return "RAM Policies"
+	case PageRAMUserPolicies:
+		return "RAM User Policies"
+	case PageRAMDetail:
+		return "RAM Detail"
+	case PageECSMetrics:
+		return "ECS Metrics"
+	case PageRDSMetrics:
+		return "RDS Metrics"
+	case PageSLSProjects:
+		return "SLS Projects"
+	case PageSLSLogtailConfigs:
+		return "SLS Logtail Configs"
+	case PageSLSMachineGroups:
+		return "SLS Machine Groups"
+	case PageSLSMachines:
+		return "SLS Machines"
+	case PageSLSCoverage:
+		return "SLS Coverage"
+	case PageSLSDetail:
+		return "SLS Detail"
+	case PageConfigRules:
+		return "Cloud Config Rules"
+	case PageConfigResults:
+		return "Cloud Config Results"
+	case PageConfigDetail:
+		return "Cloud Config Detail"
+	case PageTagBrowser:
+		return "Resource Tags"
+	case PageTagResources:
+		return "Tagged Resources"
+	case PageTagDetail:
+		return "Tagged Resource Detail"
+	case PageBilling:
+		return "Billing"
+	case PageBillingDetail:
+		return "BillingDetail"
+	case PageDNSDangling:
+		return "DNSDangling"
+	case PageRAMRolePolicies:
+		return "RAMRolePolicies"
+	case PageNATList:
+		return "NATList"
+	case PageNATDetail:
+		return "NATDetail"
+	case PageSNATEntries:
+		return "SNATEntries"
+	case PageDNATEntries:
+		return "DNATEntries"
+	case PageOSSObjectVersions:
+		return "OSSObjectVersions"
+	case PageALBList:
+		return "ALBList"
+	case PageALBDetail:
+		return "ALBDetail"
+	case PageALBListeners:
+		return "ALBListeners"
+	case PageALBRules:
+		return "ALBRules"
+	case PageALBRuleDetail:
+		return "ALBRuleDetail"
+	case PageALBServerGroups:
+		return "ALBServerGroups"
+	case PageNLBList:
+		return "NLBList"
+	case PageNLBDetail:
+		return "NLBDetail"
+	case PageNLBListeners:
+		return "NLBListeners"
+	case PageNLBServerGroups:
+		return "NLBServerGroups"
+	case PageLBServers:
+		return "LBServers"
+	case PageOSSBucketDetail:
+		return "OSSBucketDetail"
+	case PageACKClusters:
+		return "ACKClusters"
+	case PageACKClusterDetail:
+		return "ACKClusterDetail"
+	case PageACKNodePools:
+		return "ACKNodePools"
+	case PageACKNodes:
+		return "ACKNodes"
+	case PageOSSObjectScan:
+		return "OSSObjectScan"
+	case PageACRInstances:
+		return "ACRInstances"
+	case PageACRNamespaces:
+		return "ACRNamespaces"
+	case PageACRRepositories:
+		return "ACRRepositories"
+	case PageACRTags:
+		return "ACRTags"
+	case PageACRTagDetail:
+		return "ACRTagDetail"
+	case PageFCServices:
+		return "FCServices"
+	case PageFCFunctions:
+		return "FCFunctions"
+	case PageFCFunctionDetail:
+		return "FCFunctionDetail"
+	case PageSLBJSONDetail:
+		return "SLBJSONDetail"
+	case PageMongoDBList:
+		return "MongoDBList"
+	case PageMongoDBDetail:
+		return "MongoDBDetail"
+	case PageMongoDBJSONDetail:
+		return "MongoDBJSONDetail"
+	case PageMongoDBAccounts:
+		return "MongoDBAccounts"
+	case PageRDSJSONDetail:
+		return "RDSJSONDetail"
+	case PageRedisJSONDetail:
+		return "RedisJSONDetail"
+	case PageRocketMQJSONDetail:
+		return "RocketMQJSONDetail"
+	case PageElasticsearchList:
+		return "ElasticsearchList"
+	case PageElasticsearchDetail:
+		return "ElasticsearchDetail"
+	case PageElasticsearchJSONDetail:
+		return "ElasticsearchJSONDetail"
+	case PageZoneCapacity:
+		return "ZoneCapacity"
+	case PageKafkaList:
+		return "KafkaList"
+	case PageKafkaDetail:
+		return "KafkaDetail"
+	case PageKafkaJSONDetail:
+		return "KafkaJSONDetail"
+	case PageKafkaTopics:
+		return "KafkaTopics"
+	case PageKafkaGroups:
+		return "KafkaGroups"
+	case PageRocketMQLag:
+		return "RocketMQLag"
+	case PageRocketMQMessages:
+		return "RocketMQMessages"
+	case PageRedisParameters:
+		return "RedisParameters"
+	case PageRedisMetrics:
+		return "RedisMetrics"
+	case PageRecycleBin:
+		return "RecycleBin"
+	case PageOSSDeletedObjects:
+		return "OSSDeletedObjects"
+	case PageRedisBackups:
+		return "RedisBackups"
+	case PageRDSBackups:
+		return "RDSBackups"
+	case PageRDSBinlogs:
+		return "RDSBinlogs"
+	case PageRDSSlowLog:
+		return "RDSSlowLog"
+	case PageChangelog:
+		return "Changelog"
+	case PageRecent:
+		return "Recent"
+	case PageUsage:
+		return "Usage"
+	case PageDNSImport:
+		return "DNSImport"
+	case PageAPITrace:
+		return "APITrace"
 	default:
 		return "Unknown"
 	}
@@ -5,17 +5,28 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"runtime"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/atotto/clipboard"
 
 	"aliyun-tui-viewer/internal/config"
+	"aliyun-tui-viewer/internal/tui/components"
 )
 
+// exportData renders data for the clipboard, editor or pager: raw text content
+// is passed through as-is, anything else is marshaled as indented JSON
+func exportData(data interface{}) ([]byte, error) {
+	if text, ok := data.(components.TextContent); ok {
+		return []byte(text), nil
+	}
+	return json.MarshalIndent(data, "", "  ")
+}
+
 // CopyToClipboard copies data to clipboard
 func CopyToClipboard(data interface{}) tea.Cmd {
 	return func() tea.Msg {
-		jsonData, err := json.MarshalIndent(data, "", "  ")
+		jsonData, err := exportData(data)
 		if err != nil {
 			return ErrorMsg{Err: fmt.Errorf("marshaling data: %w", err)}
 		}
@@ -28,6 +39,34 @@ func CopyToClipboard(data interface{}) tea.Cmd {
 	}
 }
 
+// OpenInBrowser opens url in the default browser without waiting for it
+func OpenInBrowser(url string) tea.Cmd {
+	return func() tea.Msg {
+		var cmd *exec.Cmd
+		switch runtime.GOOS {
+		case "darwin":
+			cmd = exec.Command("open", url)
+		case "windows":
+			cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+		default:
+			cmd = exec.Command("xdg-open", url)
+		}
+		if err := cmd.Start(); err != nil {
+			return ErrorMsg{Err: fmt.Errorf("opening browser: %w", err)}
+		}
+		go cmd.Wait() // Reap the launcher once it exits
+		return BrowserOpenedMsg{}
+	}
+}
+
+// RingBell rings the terminal bell
+func RingBell() tea.Cmd {
+	return func() tea.Msg {
+		fmt.Fprint(os.Stdout, "\a")
+		return nil
+	}
+}
+
 // OpenInEditor opens data in the configured external editor
 func OpenInEditor(data interface{}) tea.Cmd {
 	return tea.ExecProcess(createEditorCmd(data), func(err error) tea.Msg {
@@ -53,7 +92,7 @@ func createEditorCmd(data interface{}) *exec.Cmd {
 	}
 
 	// Write data to temp file
-	jsonData, err := json.MarshalIndent(data, "", "  ")
+	jsonData, err := exportData(data)
 	if err != nil {
 		return exec.Command("echo", "Error marshaling data")
 	}
@@ -91,7 +130,7 @@ func createPagerCmd(data interface{}) *exec.Cmd {
 	}
 
 	// Write data to temp file
-	jsonData, err := json.MarshalIndent(data, "", "  ")
+	jsonData, err := exportData(data)
 	if err != nil {
 		return exec.Command("echo", "Error marshaling data")
 	}
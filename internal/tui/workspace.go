@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"aliyun-tui-viewer/internal/config"
+)
+
+// workspacePageNames maps the page names accepted in a workspace's "pages"
+// list to the top-level pages they open
+var workspacePageNames = map[string]PageType{
+	"ecs":             PageECSList,
+	"zones":           PageZoneCapacity,
+	"security-groups": PageSecurityGroups,
+	"sg":              PageSecurityGroups,
+	"dns":             PageDNSDomains,
+	"slb":             PageSLBList,
+	"alb":             PageALBList,
+	"nlb":             PageNLBList,
+	"ack":             PageACKClusters,
+	"acr":             PageACRInstances,
+	"fc":              PageFCServices,
+	"oss":             PageOSSBuckets,
+	"rds":             PageRDSList,
+	"redis":           PageRedisList,
+	"mongodb":         PageMongoDBList,
+	"elasticsearch":   PageElasticsearchList,
+	"kafka":           PageKafkaList,
+	"rocketmq":        PageRocketMQList,
+	"ram":             PageRAMUsers,
+	"sls":             PageSLSProjects,
+	"config":          PageConfigRules,
+	"tags":            PageTagBrowser,
+	"nat":             PageNATList,
+	"billing":         PageBilling,
+	"jobs":            PageJobs,
+}
+
+// loadWorkspace loads the named workspace's configuration and resolves its
+// pages. A non-empty profile or region overrides the workspace's own
+func loadWorkspace(name, profile, region string) (*config.Config, []PageType, error) {
+	ws, err := config.GetWorkspace(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if profile != "" {
+		ws.Profile = profile
+	}
+	if region != "" {
+		ws.Region = region
+	}
+
+	var pageList []PageType
+	for _, pageName := range ws.Pages {
+		page, ok := workspacePageNames[strings.ToLower(pageName)]
+		if !ok {
+			return nil, nil, fmt.Errorf("workspace '%s': unknown page '%s', expected one of: %s",
+				ws.Name, pageName, strings.Join(workspacePageNameList(), ", "))
+		}
+		pageList = append(pageList, page)
+	}
+
+	cfg, err := ws.LoadConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	return cfg, pageList, nil
+}
+
+// workspacePageNameList returns the accepted page names in sorted order
+func workspacePageNameList() []string {
+	names := make([]string, 0, len(workspacePageNames))
+	for name := range workspacePageNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// openNextWorkspacePage opens the next pending workspace page once the
+// screen is sized and the previous page has finished loading. Pages are
+// pushed onto the back stack, so q steps back through the earlier ones
+func (m Model) openNextWorkspacePage() (Model, tea.Cmd) {
+	if m.width == 0 || m.loading || m.modal.Visible {
+		return m, nil
+	}
+
+	page := m.workspacePages[0]
+	m.workspacePages = m.workspacePages[1:]
+	if page == m.currentPage {
+		return m, nil
+	}
+	return m.navigateTo(page, nil)
+}
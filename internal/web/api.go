@@ -0,0 +1,121 @@
+package web
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+)
+
+// apiResource describes one resource type in the API index
+type apiResource struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// apiIndex is the response of GET /api/
+type apiIndex struct {
+	Profile   string        `json:"profile"`
+	Region    string        `json:"region"`
+	Resources []apiResource `json:"resources"`
+}
+
+// apiList is the response of GET /api/{resource}
+type apiList struct {
+	Resource  string    `json:"resource"`
+	FetchedAt time.Time `json:"fetchedAt"`
+	Count     int       `json:"count"`
+	Items     any       `json:"items"`
+}
+
+// apiItem is the response of GET /api/{resource}/{id}
+type apiItem struct {
+	Resource  string    `json:"resource"`
+	FetchedAt time.Time `json:"fetchedAt"`
+	Item      any       `json:"item"`
+}
+
+// apiError is the body of every failed API request
+type apiError struct {
+	Error string `json:"error"`
+}
+
+// handleAPIIndex lists the resource types served by the API
+func (s *Server) handleAPIIndex(w http.ResponseWriter, r *http.Request) {
+	index := apiIndex{
+		Profile:   s.profile,
+		Region:    s.clients.GetConfig().RegionID,
+		Resources: make([]apiResource, len(inventories)),
+	}
+	for i, inv := range inventories {
+		index.Resources[i] = apiResource{Name: inv.Name, Title: inv.Title, URL: "/api/" + inv.Name}
+	}
+	writeJSON(w, http.StatusOK, index)
+}
+
+// handleAPIList returns every item of a resource type as returned by the
+// service layer. ?refresh fetches the list again instead of using the cache
+func (s *Server) handleAPIList(w http.ResponseWriter, r *http.Request) {
+	inv := findInventory(r.PathValue("inventory"))
+	if inv == nil {
+		writeJSON(w, http.StatusNotFound, apiError{Error: "unknown resource " + r.PathValue("inventory")})
+		return
+	}
+
+	items, fetchedAt, ok := s.apiFetch(w, r, inv)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, apiList{
+		Resource:  inv.Name,
+		FetchedAt: fetchedAt,
+		Count:     inv.count(items),
+		Items:     items,
+	})
+}
+
+// handleAPIDescribe returns one item of a resource type by its ID: instance
+// ID, security group ID, domain name, bucket name or RAM user name
+func (s *Server) handleAPIDescribe(w http.ResponseWriter, r *http.Request) {
+	inv := findInventory(r.PathValue("inventory"))
+	if inv == nil {
+		writeJSON(w, http.StatusNotFound, apiError{Error: "unknown resource " + r.PathValue("inventory")})
+		return
+	}
+
+	items, fetchedAt, ok := s.apiFetch(w, r, inv)
+	if !ok {
+		return
+	}
+	id := r.PathValue("id")
+	item, found := inv.find(items, id)
+	if !found {
+		writeJSON(w, http.StatusNotFound, apiError{Error: inv.Name + " " + id + " not found"})
+		return
+	}
+	writeJSON(w, http.StatusOK, apiItem{Resource: inv.Name, FetchedAt: fetchedAt, Item: item})
+}
+
+// apiFetch returns the cached items of inv, writing a 502 response when they
+// could not be fetched
+func (s *Server) apiFetch(w http.ResponseWriter, r *http.Request, inv *inventory) (any, time.Time, bool) {
+	items, fetchedAt, err := s.cache.get(r.Context(), inv, s.clients, r.URL.Query().Has("refresh"))
+	if err != nil {
+		log.Printf("fetching %s: %v", inv.Name, err)
+		writeJSON(w, http.StatusBadGateway, apiError{Error: err.Error()})
+		return nil, time.Time{}, false
+	}
+	return items, fetchedAt, true
+}
+
+// writeJSON writes v as an indented JSON response
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		log.Printf("encoding response: %v", err)
+	}
+}
@@ -0,0 +1,189 @@
+package web
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+
+	"aliyun-tui-viewer/internal/client"
+	"aliyun-tui-viewer/internal/service"
+	"aliyun-tui-viewer/internal/tui/components"
+	"aliyun-tui-viewer/internal/tui/pages"
+)
+
+// cacheTTL is how long a fetched inventory is served before it is fetched again
+const cacheTTL = 5 * time.Minute
+
+// inventory is one resource list served by the web view and the API. Rows are
+// built by the same page models as the TUI, so both show identical columns
+type inventory struct {
+	Name  string // URL path segment
+	Title string
+	fetch func(ctx context.Context, clients *client.AliyunClients) (any, error)
+	table func(items any) components.TableModel
+	count func(items any) int
+	find  func(items any, id string) (any, bool)
+}
+
+// newInventory builds an inventory from a typed fetch function, the page
+// model rendering its table and the ID of one item
+func newInventory[T any](name, title string, fetch func(context.Context, *client.AliyunClients) ([]T, error), table func([]T) components.TableModel, id func(T) string) inventory {
+	return inventory{
+		Name:  name,
+		Title: title,
+		fetch: func(ctx context.Context, c *client.AliyunClients) (any, error) {
+			return fetch(ctx, c)
+		},
+		table: func(items any) components.TableModel {
+			return table(items.([]T))
+		},
+		count: func(items any) int {
+			return len(items.([]T))
+		},
+		find: func(items any, want string) (any, bool) {
+			for _, item := range items.([]T) {
+				if id(item) == want {
+					return item, true
+				}
+			}
+			return nil, false
+		},
+	}
+}
+
+// inventories are the lists served, in navigation order
+var inventories = []inventory{
+	newInventory("ecs", "ECS Instances",
+		func(ctx context.Context, c *client.AliyunClients) ([]ecs.Instance, error) {
+			return service.NewECSService(c.ECS).FetchInstances(ctx)
+		},
+		func(items []ecs.Instance) components.TableModel {
+			return pages.NewECSListModel().SetData(items).Table()
+		},
+		func(item ecs.Instance) string { return item.InstanceId }),
+	newInventory("security-groups", "Security Groups",
+		func(ctx context.Context, c *client.AliyunClients) ([]ecs.SecurityGroup, error) {
+			return service.NewECSService(c.ECS).FetchSecurityGroups(ctx)
+		},
+		func(items []ecs.SecurityGroup) components.TableModel {
+			return pages.NewSecurityGroupsModel().SetData(items).Table()
+		},
+		func(item ecs.SecurityGroup) string { return item.SecurityGroupId }),
+	newInventory("dns", "DNS Domains",
+		func(ctx context.Context, c *client.AliyunClients) ([]alidns.DomainInDescribeDomains, error) {
+			return service.NewDNSService(c.DNS).FetchDomains(ctx)
+		},
+		func(items []alidns.DomainInDescribeDomains) components.TableModel {
+			return pages.NewDNSDomainsModel().SetData(items).Table()
+		},
+		func(item alidns.DomainInDescribeDomains) string { return item.DomainName }),
+	newInventory("slb", "SLB Instances",
+		func(ctx context.Context, c *client.AliyunClients) ([]slb.LoadBalancer, error) {
+			return service.NewSLBService(c.SLB).FetchInstances(ctx)
+		},
+		func(items []slb.LoadBalancer) components.TableModel {
+			return pages.NewSLBListModel().SetData(items).Table()
+		},
+		func(item slb.LoadBalancer) string { return item.LoadBalancerId }),
+	newInventory("oss", "OSS Buckets",
+		func(ctx context.Context, c *client.AliyunClients) ([]oss.BucketProperties, error) {
+			cfg := c.GetConfig()
+			svc := service.NewOSSServiceWithCredentials(c.OSS, cfg.AccessKeyID, cfg.AccessKeySecret, cfg.OssEndpoint, client.OSSCredentialsOption(cfg.Credentials))
+			return svc.FetchBuckets(ctx)
+		},
+		func(items []oss.BucketProperties) components.TableModel {
+			return pages.NewOSSBucketsModel().SetData(items).Table()
+		},
+		func(item oss.BucketProperties) string { return item.Name }),
+	newInventory("rds", "RDS Instances",
+		func(ctx context.Context, c *client.AliyunClients) ([]service.RDSInstanceDetail, error) {
+			return service.NewRDSService(c.RDS).FetchDetailedInstances(ctx)
+		},
+		func(items []service.RDSInstanceDetail) components.TableModel {
+			return pages.NewRDSListModel().SetDetailedData(items).Table()
+		},
+		func(item service.RDSInstanceDetail) string { return item.Instance.DBInstanceId }),
+	newInventory("redis", "Redis Instances",
+		func(ctx context.Context, c *client.AliyunClients) ([]r_kvstore.KVStoreInstance, error) {
+			return service.NewRedisService(c.Redis).FetchInstances(ctx)
+		},
+		func(items []r_kvstore.KVStoreInstance) components.TableModel {
+			return pages.NewRedisListModel().SetData(items).Table()
+		},
+		func(item r_kvstore.KVStoreInstance) string { return item.InstanceId }),
+	newInventory("rocketmq", "RocketMQ Instances",
+		func(ctx context.Context, c *client.AliyunClients) ([]service.RocketMQInstance, error) {
+			return service.NewRocketMQService(c.RocketMQ, c.RocketMQ5).FetchInstances(ctx)
+		},
+		func(items []service.RocketMQInstance) components.TableModel {
+			return pages.NewRocketMQListModel().SetData(items).Table()
+		},
+		func(item service.RocketMQInstance) string { return item.InstanceId }),
+	newInventory("ram-users", "RAM Users",
+		func(ctx context.Context, c *client.AliyunClients) ([]service.RAMUserDetail, error) {
+			return service.NewRAMService(c.RAM).FetchDetailedUsers(ctx)
+		},
+		func(items []service.RAMUserDetail) components.TableModel {
+			return pages.NewRAMUsersModel().SetData(items).Table()
+		},
+		func(item service.RAMUserDetail) string { return item.User.UserName }),
+}
+
+// findInventory returns the inventory served at name, or nil
+func findInventory(name string) *inventory {
+	for i := range inventories {
+		if inventories[i].Name == name {
+			return &inventories[i]
+		}
+	}
+	return nil
+}
+
+// cacheEntry is the last successful fetch of one inventory
+type cacheEntry struct {
+	mu        sync.Mutex // Held while fetching, so concurrent requests share one fetch
+	items     any
+	fetchedAt time.Time
+}
+
+// inventoryCache keeps fetched inventories for cacheTTL. The web view and the
+// API share it. Failed fetches are not cached, so the next request retries
+type inventoryCache struct {
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+func newInventoryCache() *inventoryCache {
+	return &inventoryCache{entries: make(map[string]*cacheEntry)}
+}
+
+// get returns the cached items of inv, fetching them when missing, expired or
+// when refresh is set. The fetch is bound to ctx, e.g. the request it is for
+func (c *inventoryCache) get(ctx context.Context, inv *inventory, clients *client.AliyunClients, refresh bool) (any, time.Time, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[inv.Name]
+	if !ok {
+		entry = &cacheEntry{}
+		c.entries[inv.Name] = entry
+	}
+	c.mu.Unlock()
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	if !refresh && !entry.fetchedAt.IsZero() && time.Since(entry.fetchedAt) < cacheTTL {
+		return entry.items, entry.fetchedAt, nil
+	}
+
+	items, err := inv.fetch(ctx, clients)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	entry.items, entry.fetchedAt = items, time.Now()
+	return entry.items, entry.fetchedAt, nil
+}
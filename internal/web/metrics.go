@@ -0,0 +1,352 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+
+	"aliyun-tui-viewer/internal/client"
+	"aliyun-tui-viewer/internal/service"
+)
+
+// expiryWindow is how far ahead subscription resources are reported as expiring
+const expiryWindow = 30 * 24 * time.Hour
+
+// resourceFacts is what the summary needs to know of one resource
+type resourceFacts struct {
+	ID        string
+	Name      string
+	Status    string
+	ExpiresAt time.Time // Zero for pay-as-you-go resources
+}
+
+// summarySource is an inventory the summary counts, with how to read the
+// facts of its items
+type summarySource struct {
+	inv   *inventory
+	facts func(items any) []resourceFacts
+}
+
+// eipInventory lists the EIPs. The web view has no EIP table, so it is only
+// fetched for the summary
+var eipInventory = inventory{
+	Name:  "eip",
+	Title: "Elastic IP Addresses",
+	fetch: func(ctx context.Context, c *client.AliyunClients) (any, error) {
+		return service.NewEIPService(c.VPC).FetchEIPs(ctx)
+	},
+}
+
+// summarySources are the inventories counted, in output order
+var summarySources = []summarySource{
+	{findInventory("ecs"), func(items any) []resourceFacts {
+		var facts []resourceFacts
+		for _, inst := range items.([]ecs.Instance) {
+			f := resourceFacts{ID: inst.InstanceId, Name: inst.InstanceName, Status: inst.Status}
+			if inst.InstanceChargeType == "PrePaid" {
+				f.ExpiresAt = parseExpiry(inst.ExpiredTime)
+			}
+			facts = append(facts, f)
+		}
+		return facts
+	}},
+	{findInventory("rds"), func(items any) []resourceFacts {
+		var facts []resourceFacts
+		for _, d := range items.([]service.RDSInstanceDetail) {
+			inst := d.Instance
+			f := resourceFacts{ID: inst.DBInstanceId, Name: inst.DBInstanceDescription, Status: inst.DBInstanceStatus}
+			if inst.PayType == "Prepaid" {
+				f.ExpiresAt = parseExpiry(inst.ExpireTime)
+			}
+			facts = append(facts, f)
+		}
+		return facts
+	}},
+	{findInventory("slb"), func(items any) []resourceFacts {
+		var facts []resourceFacts
+		for _, lb := range items.([]slb.LoadBalancer) {
+			facts = append(facts, resourceFacts{ID: lb.LoadBalancerId, Name: lb.LoadBalancerName, Status: lb.LoadBalancerStatus})
+		}
+		return facts
+	}},
+	{findInventory("redis"), func(items any) []resourceFacts {
+		var facts []resourceFacts
+		for _, inst := range items.([]r_kvstore.KVStoreInstance) {
+			f := resourceFacts{ID: inst.InstanceId, Name: inst.InstanceName, Status: inst.InstanceStatus}
+			if inst.ChargeType == "PrePaid" {
+				f.ExpiresAt = parseExpiry(inst.EndTime)
+			}
+			facts = append(facts, f)
+		}
+		return facts
+	}},
+	{&eipInventory, func(items any) []resourceFacts {
+		var facts []resourceFacts
+		for _, eip := range items.([]vpc.EipAddress) {
+			f := resourceFacts{ID: eip.AllocationId, Name: eip.Name, Status: eip.Status}
+			if eip.ChargeType == "PrePaid" {
+				f.ExpiresAt = parseExpiry(eip.ExpiredTime)
+			}
+			facts = append(facts, f)
+		}
+		return facts
+	}},
+}
+
+// parseExpiry parses an expiry time as the APIs return it, with or without
+// seconds, zero when it is empty or unreadable
+func parseExpiry(value string) time.Time {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04Z07:00"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// inventorySummary is the response of GET /api/summary and the source of
+// GET /metrics
+type inventorySummary struct {
+	Profile     string            `json:"profile"`
+	Region      string            `json:"region"`
+	RefreshedAt time.Time         `json:"refreshedAt"`
+	Resources   []resourceSummary `json:"resources"`
+	Expiring    []expiringItem    `json:"expiring"`    // Within expiryWindow, or already expired
+	EIPBindings map[string]int    `json:"eipBindings"` // Bound EIPs by instance type, "" for unbound
+}
+
+// resourceSummary counts the resources of one inventory
+type resourceSummary struct {
+	Resource  string         `json:"resource"`
+	Count     int            `json:"count"`
+	ByStatus  map[string]int `json:"byStatus"`
+	FetchedAt time.Time      `json:"fetchedAt"`
+	Error     string         `json:"error,omitempty"` // The last fetch failed; the counts are from the one before
+}
+
+// expiringItem is a subscription resource close to its expiry
+type expiringItem struct {
+	Resource  string    `json:"resource"`
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	DaysLeft  int       `json:"daysLeft"`
+}
+
+// summaryState keeps the latest summary, refreshed in the background when
+// StartRefresh was called and on request otherwise
+type summaryState struct {
+	mu         sync.Mutex
+	summary    *inventorySummary
+	last       map[string]resourceSummary // Last successful counts, kept across failed fetches
+	background bool
+}
+
+// StartRefresh refetches the summarized inventories every interval in the
+// background, starting now, so scrapes never wait for the Alibaba Cloud APIs
+func (s *Server) StartRefresh(interval time.Duration) {
+	s.summary.mu.Lock()
+	s.summary.background = true
+	s.summary.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			s.refreshSummary(context.Background(), true)
+			<-ticker.C
+		}
+	}()
+}
+
+// currentSummary returns the summary refreshed in the background or, before
+// the first refresh or without StartRefresh, one built from the cache within
+// ctx
+func (s *Server) currentSummary(ctx context.Context) *inventorySummary {
+	s.summary.mu.Lock()
+	summary, background := s.summary.summary, s.summary.background
+	s.summary.mu.Unlock()
+	if summary != nil && background {
+		return summary
+	}
+	return s.refreshSummary(ctx, false)
+}
+
+// refreshSummary fetches the summarized inventories, through the cache unless
+// force is set, and stores the summary built from them. The fetches are
+// bound to ctx
+func (s *Server) refreshSummary(ctx context.Context, force bool) *inventorySummary {
+	now := time.Now()
+	summary := &inventorySummary{
+		Profile:     s.profile,
+		Region:      s.clients.GetConfig().RegionID,
+		RefreshedAt: now,
+		EIPBindings: make(map[string]int),
+	}
+
+	s.summary.mu.Lock()
+	if s.summary.last == nil {
+		s.summary.last = make(map[string]resourceSummary)
+	}
+	s.summary.mu.Unlock()
+
+	for _, src := range summarySources {
+		items, fetchedAt, err := s.cache.get(ctx, src.inv, s.clients, force)
+		if err != nil {
+			log.Printf("refreshing %s: %v", src.inv.Name, err)
+			s.summary.mu.Lock()
+			rs := s.summary.last[src.inv.Name]
+			s.summary.mu.Unlock()
+			rs.Resource, rs.Error = src.inv.Name, err.Error()
+			summary.Resources = append(summary.Resources, rs)
+			continue
+		}
+
+		rs := resourceSummary{Resource: src.inv.Name, ByStatus: make(map[string]int), FetchedAt: fetchedAt}
+		for _, f := range src.facts(items) {
+			rs.Count++
+			rs.ByStatus[f.Status]++
+			if !f.ExpiresAt.IsZero() && f.ExpiresAt.Sub(now) < expiryWindow {
+				summary.Expiring = append(summary.Expiring, expiringItem{
+					Resource:  src.inv.Name,
+					ID:        f.ID,
+					Name:      f.Name,
+					ExpiresAt: f.ExpiresAt,
+					DaysLeft:  int(f.ExpiresAt.Sub(now).Hours() / 24),
+				})
+			}
+		}
+		if src.inv == &eipInventory {
+			for _, eip := range items.([]vpc.EipAddress) {
+				summary.EIPBindings[eip.InstanceType]++
+			}
+		}
+
+		s.summary.mu.Lock()
+		s.summary.last[src.inv.Name] = rs
+		s.summary.mu.Unlock()
+		summary.Resources = append(summary.Resources, rs)
+	}
+	sort.Slice(summary.Expiring, func(i, j int) bool {
+		return summary.Expiring[i].ExpiresAt.Before(summary.Expiring[j].ExpiresAt)
+	})
+
+	s.summary.mu.Lock()
+	s.summary.summary = summary
+	s.summary.mu.Unlock()
+	return summary
+}
+
+// handleAPISummary returns the resource counts, expiring resources and EIP
+// bindings
+func (s *Server) handleAPISummary(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.currentSummary(r.Context()))
+}
+
+// handleMetrics serves the summary in the Prometheus text format
+func (s *Server) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	summary := s.currentSummary(r.Context())
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	writeMetrics(w, summary)
+}
+
+// metricWriter writes the samples of Prometheus metrics, each family headed
+// by its HELP and TYPE lines
+type metricWriter struct {
+	b      strings.Builder
+	labels [][2]string // Labels of every sample
+}
+
+// family starts a metric family
+func (m *metricWriter) family(name, help string) {
+	fmt.Fprintf(&m.b, "# HELP %s %s\n# TYPE %s gauge\n", name, help, name)
+}
+
+// sample writes one sample; labels are name, value pairs
+func (m *metricWriter) sample(name string, value float64, labels ...string) {
+	pairs := append([][2]string{}, m.labels...)
+	for i := 0; i+1 < len(labels); i += 2 {
+		pairs = append(pairs, [2]string{labels[i], labels[i+1]})
+	}
+	parts := make([]string, len(pairs))
+	for i, p := range pairs {
+		parts[i] = p[0] + `="` + labelEscaper.Replace(p[1]) + `"`
+	}
+	fmt.Fprintf(&m.b, "%s{%s} %s\n", name, strings.Join(parts, ","), strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// labelEscaper escapes label values as the text format expects
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// writeMetrics writes a summary as Prometheus metrics
+func writeMetrics(w http.ResponseWriter, summary *inventorySummary) {
+	m := &metricWriter{labels: [][2]string{{"profile", summary.Profile}, {"region", summary.Region}}}
+
+	m.family("alidash_resources", "Resources by type and status.")
+	for _, rs := range summary.Resources {
+		statuses := make([]string, 0, len(rs.ByStatus))
+		for status := range rs.ByStatus {
+			statuses = append(statuses, status)
+		}
+		sort.Strings(statuses)
+		for _, status := range statuses {
+			m.sample("alidash_resources", float64(rs.ByStatus[status]), "resource", rs.Resource, "status", status)
+		}
+	}
+
+	m.family("alidash_inventory_up", "Whether the last fetch of an inventory succeeded.")
+	for _, rs := range summary.Resources {
+		up := 1.0
+		if rs.Error != "" {
+			up = 0
+		}
+		m.sample("alidash_inventory_up", up, "resource", rs.Resource)
+	}
+
+	m.family("alidash_inventory_fetched_timestamp_seconds", "When an inventory was last fetched successfully.")
+	for _, rs := range summary.Resources {
+		if !rs.FetchedAt.IsZero() {
+			m.sample("alidash_inventory_fetched_timestamp_seconds", float64(rs.FetchedAt.Unix()), "resource", rs.Resource)
+		}
+	}
+
+	m.family("alidash_resources_expiring", "Subscription resources expiring within 30 days or already expired.")
+	expiring := make(map[string]int)
+	for _, item := range summary.Expiring {
+		expiring[item.Resource]++
+	}
+	for _, rs := range summary.Resources {
+		m.sample("alidash_resources_expiring", float64(expiring[rs.Resource]), "resource", rs.Resource)
+	}
+
+	m.family("alidash_resource_expiry_timestamp_seconds", "When an expiring subscription resource expires.")
+	for _, item := range summary.Expiring {
+		m.sample("alidash_resource_expiry_timestamp_seconds", float64(item.ExpiresAt.Unix()),
+			"resource", item.Resource, "id", item.ID, "name", item.Name)
+	}
+
+	m.family("alidash_eip_bindings", "EIPs by the type of instance they are bound to, empty for unbound EIPs.")
+	types := make([]string, 0, len(summary.EIPBindings))
+	for t := range summary.EIPBindings {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	for _, t := range types {
+		m.sample("alidash_eip_bindings", float64(summary.EIPBindings[t]), "instance_type", t)
+	}
+
+	if _, err := w.Write([]byte(m.b.String())); err != nil {
+		log.Printf("writing metrics: %v", err)
+	}
+}
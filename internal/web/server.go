@@ -0,0 +1,131 @@
+package web
+
+import (
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+	"time"
+
+	"aliyun-tui-viewer/internal/client"
+)
+
+// Server serves a read-only HTML view of the same inventory the TUI shows,
+// the same data as JSON under /api/, and resource counts as Prometheus
+// metrics under /metrics. It only answers GET requests and never calls a
+// mutating API
+type Server struct {
+	clients *client.AliyunClients
+	profile string
+	cache   *inventoryCache
+	tmpl    *template.Template
+	summary summaryState
+}
+
+// NewServer creates a web view server for a profile's clients
+func NewServer(clients *client.AliyunClients, profile string) *Server {
+	return &Server{
+		clients: clients,
+		profile: profile,
+		cache:   newInventoryCache(),
+		tmpl:    template.Must(template.New("page").Parse(pageTemplate)),
+	}
+}
+
+// Handler returns the HTTP handler serving the web view and the API
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", s.handleIndex)
+	mux.HandleFunc("GET /{inventory}", s.handleInventory)
+	mux.HandleFunc("GET /metrics", s.handleMetrics)
+	mux.HandleFunc("GET /api/{$}", s.handleAPIIndex)
+	mux.HandleFunc("GET /api/summary", s.handleAPISummary)
+	mux.HandleFunc("GET /api/{inventory}", s.handleAPIList)
+	mux.HandleFunc("GET /api/{inventory}/{id}", s.handleAPIDescribe)
+	return mux
+}
+
+// ListenAndServe serves the web view on addr until the server fails
+func (s *Server) ListenAndServe(addr string) error {
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return server.ListenAndServe()
+}
+
+// pageData is the data rendered by pageTemplate
+type pageData struct {
+	Profile     string
+	Region      string
+	Inventories []inventory
+	Current     string // Name of the inventory shown, empty on the index
+
+	Title     string
+	Query     string
+	Columns   []string
+	Rows      [][]string
+	Total     int
+	FetchedAt string
+	Error     string
+}
+
+// handleIndex lists the available inventories
+func (s *Server) handleIndex(w http.ResponseWriter, r *http.Request) {
+	s.render(w, http.StatusOK, s.newPageData("alidash"))
+}
+
+// handleInventory renders one inventory as a table. ?q= filters the rows with
+// the same syntax as the TUI filter (words and column:value terms) and
+// ?refresh fetches the inventory again instead of using the cache
+func (s *Server) handleInventory(w http.ResponseWriter, r *http.Request) {
+	inv := findInventory(r.PathValue("inventory"))
+	if inv == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	data := s.newPageData(inv.Title)
+	data.Current = inv.Name
+	data.Query = r.URL.Query().Get("q")
+
+	items, fetchedAt, err := s.cache.get(r.Context(), inv, s.clients, r.URL.Query().Has("refresh"))
+	if err != nil {
+		log.Printf("fetching %s: %v", inv.Name, err)
+		data.Error = err.Error()
+		s.render(w, http.StatusBadGateway, data)
+		return
+	}
+
+	table := inv.table(items).Filter(data.Query)
+	for _, column := range table.Columns() {
+		data.Columns = append(data.Columns, column.Title)
+	}
+	for _, row := range table.Rows() {
+		data.Rows = append(data.Rows, []string(row))
+	}
+	data.Total = table.TotalRowCount()
+	data.FetchedAt = fetchedAt.Format("2006-01-02 15:04:05")
+	s.render(w, http.StatusOK, data)
+}
+
+// newPageData returns the page data shared by every page
+func (s *Server) newPageData(title string) pageData {
+	return pageData{
+		Profile:     s.profile,
+		Region:      s.clients.GetConfig().RegionID,
+		Inventories: inventories,
+		Title:       title,
+	}
+}
+
+// render writes data through the page template
+func (s *Server) render(w http.ResponseWriter, status int, data pageData) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := s.tmpl.Execute(w, data); err != nil {
+		log.Printf("rendering %s: %v", data.Title, err)
+		fmt.Fprintf(w, "<p>rendering failed: %s</p>", template.HTMLEscapeString(err.Error()))
+	}
+}
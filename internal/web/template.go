@@ -0,0 +1,55 @@
+package web
+
+// pageTemplate renders the index and inventory pages
+const pageTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}} - alidash</title>
+<style>
+  body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 0; color: #1F2937; }
+  header { background: #7C3AED; color: #FFFFFF; padding: 10px 16px; }
+  header span { opacity: 0.8; margin-left: 12px; font-size: 0.9em; }
+  nav { background: #F3F4F6; padding: 8px 16px; border-bottom: 1px solid #E5E7EB; }
+  nav a { margin-right: 14px; color: #4B5563; text-decoration: none; }
+  nav a.current { color: #7C3AED; font-weight: bold; }
+  main { padding: 16px; }
+  form { margin-bottom: 12px; }
+  input[type=search] { width: 360px; padding: 4px 6px; }
+  .meta { color: #6B7280; font-size: 0.9em; margin-bottom: 8px; }
+  .error { color: #B91C1C; }
+  table { border-collapse: collapse; font-size: 0.9em; }
+  th { text-align: left; color: #B45309; border-bottom: 2px solid #E5E7EB; padding: 4px 10px; }
+  td { border-bottom: 1px solid #F3F4F6; padding: 4px 10px; white-space: nowrap; }
+  tr:hover td { background: #F5F3FF; }
+</style>
+</head>
+<body>
+<header><strong>alidash</strong><span>profile: {{.Profile}}</span><span>region: {{.Region}}</span></header>
+<nav>{{range .Inventories}}<a href="/{{.Name}}"{{if eq .Name $.Current}} class="current"{{end}}>{{.Title}}</a>{{end}}</nav>
+<main>
+{{if .Current}}
+  <h2>{{.Title}}</h2>
+  <form method="get" action="/{{.Current}}">
+    <input type="search" name="q" value="{{.Query}}" placeholder="Search, e.g. web status:running" autofocus>
+    <button type="submit">Search</button>
+    {{if .Query}}<a href="/{{.Current}}">Clear</a>{{end}}
+  </form>
+  {{if .Error}}
+    <p class="error">Failed to load: {{.Error}}</p>
+  {{else}}
+    <div class="meta">{{len .Rows}} of {{.Total}} rows &middot; fetched {{.FetchedAt}} &middot; <a href="/{{.Current}}?refresh{{if .Query}}&amp;q={{.Query}}{{end}}">refresh</a></div>
+    <table>
+      <thead><tr>{{range .Columns}}<th>{{.}}</th>{{end}}</tr></thead>
+      <tbody>{{range .Rows}}<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>{{end}}</tbody>
+    </table>
+  {{end}}
+{{else}}
+  <h2>Inventory</h2>
+  <ul>{{range .Inventories}}<li><a href="/{{.Name}}">{{.Title}}</a></li>{{end}}</ul>
+  <p class="meta">Read-only view. Lists are cached for 5 minutes; use refresh on a list to fetch it again. The same lists are served as JSON under <a href="/api/">/api/</a>.</p>
+{{end}}
+</main>
+</body>
+</html>
+`